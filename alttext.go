@@ -0,0 +1,167 @@
+package emojiparser
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// AltTextPhrases are the templates AltText builds descriptions from. Each
+// template is a fmt format with a single %s. Empty fields use the English
+// defaults, so a translation only needs to set what it changes.
+type AltTextPhrases struct {
+	// Emoji describes a unicode or text emoji by its name. The default is
+	// "%s emoji".
+	Emoji string
+	// Custom describes a custom emoji by its name. The default is
+	// "custom emoji %s".
+	Custom string
+	// Animated is applied to the description of an animated custom emoji.
+	// The default is "%s, animated".
+	Animated string
+	// Flag describes a flag by its region name. The default is "flag of %s".
+	Flag string
+	// Female and Male are applied to the description of a gendered emoji
+	// whose name does not already say so. The defaults are "%s, female" and
+	// "%s, male".
+	Female, Male string
+
+	// Region, when set, names the region with an uppercase ISO 3166 code
+	// such as "BG", or "GBSCT" for a subdivision. Codes it reports false for
+	// get the English name, or the code itself.
+	Region func(code string) (string, bool)
+	// Tone, when set, names a skin tone instead of SkinTone.Description.
+	Tone func(SkinTone) string
+}
+
+// The last code points of an emoji ZWJ sequence that makes it female or male.
+const (
+	femaleSign = "\u200d\u2640"
+	maleSign   = "\u200d\u2642"
+)
+
+// AltText describes emoji for screen readers using the default parser.
+func AltText(e ParsedEmoji) string {
+	return defaultParser.AltText(e)
+}
+
+// AltText describes emoji in words for screen readers and image alt
+// attributes: "smiley emoji", "thumbup emoji, dark skin tone", "custom emoji
+// partyblob, animated", or "flag of Bulgaria". Names are spoken with their
+// underscores as spaces, and skin tones and the gender of ZWJ sequences are
+// added when the name leaves them out. WithAltTextPhrases replaces the
+// English phrases.
+func (p *DiscordEmojiParser) AltText(e ParsedEmoji) string {
+	phrases := p.opts.AltTextPhrases
+	if e.Type == EmojiTypeCustom {
+		description := phrase(phrases.Custom, "custom emoji %s", spokenName(e.Name))
+		if e.Animated {
+			description = phrase(phrases.Animated, "%s, animated", description)
+		}
+		return description
+	}
+	if code, ok := regionCode(e.Unicode); ok {
+		return phrase(phrases.Flag, "flag of %s", phrases.region(code))
+	}
+
+	name := e.Name
+	for {
+		base, _, ok := cutSkinToneSuffix(name)
+		if !ok {
+			break
+		}
+		name = base
+	}
+	spoken := spokenName(name)
+	if name == "" {
+		spoken = codePointNames(e.Unicode)
+	}
+	description := phrase(phrases.Emoji, "%s emoji", spoken)
+	for _, r := range e.Unicode {
+		if tone, ok := skinToneOf(r); ok {
+			description += ", " + phrases.tone(tone)
+		}
+	}
+	emoji := strings.TrimSuffix(e.Unicode, variationSelector16)
+	switch {
+	case strings.HasSuffix(emoji, femaleSign) && !namesGender(name, "woman", "women", "female"):
+		description = phrase(phrases.Female, "%s, female", description)
+	case strings.HasSuffix(emoji, maleSign) && !namesGender(name, "man", "men", "male"):
+		description = phrase(phrases.Male, "%s, male", description)
+	}
+	return description
+}
+
+// DescribeAll describes every emoji in content using the default parser.
+func DescribeAll(content string) []string {
+	return defaultParser.DescribeAll(content)
+}
+
+// DescribeAll returns the AltText of every emoji Parse finds in content, in
+// order.
+func (p *DiscordEmojiParser) DescribeAll(content string) []string {
+	results := p.Parse(content)
+	descriptions := make([]string, len(results))
+	for i, emoji := range results {
+		descriptions[i] = p.AltText(emoji)
+	}
+	return descriptions
+}
+
+// phrase formats arg with template, or with fallback if template is empty.
+func phrase(template, fallback, arg string) string {
+	if template == "" {
+		template = fallback
+	}
+	return fmt.Sprintf(template, arg)
+}
+
+// region returns the name of the region with the uppercase code.
+func (a AltTextPhrases) region(code string) string {
+	if a.Region != nil {
+		if name, ok := a.Region(code); ok {
+			return name
+		}
+	}
+	if name, ok := regionNames[code]; ok {
+		return name
+	}
+	return code
+}
+
+// tone returns the name of a skin tone.
+func (a AltTextPhrases) tone(tone SkinTone) string {
+	if a.Tone != nil {
+		return a.Tone(tone)
+	}
+	return tone.Description()
+}
+
+// regionCode returns the uppercase letters of a flag emoji's regional
+// indicators or tags, such as "BG" or "GBSCT".
+func regionCode(emoji string) (string, bool) {
+	if emoji == "" || flagSequence(emoji) != len(emoji) {
+		return "", false
+	}
+	code := make([]byte, 0, utf8.RuneCountInString(emoji))
+	for _, r := range emoji {
+		if letter, ok := regionalIndicatorLetter(r); ok {
+			code = append(code, letter)
+		} else if letter, ok := tagLetter(r); ok {
+			code = append(code, letter)
+		}
+	}
+	return strings.ToUpper(string(code)), true
+}
+
+// namesGender reports whether one of the words of name is one of words.
+func namesGender(name string, words ...string) bool {
+	for _, part := range strings.Split(name, "_") {
+		for _, word := range words {
+			if part == word {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+package emojiparser_test
+
+import (
+	"strings"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestDescribeAll(t *testing.T) {
+	cases := map[string]string{
+		"😃":     "smiley emoji",
+		"👍🏿":    "thumbup emoji, dark skin tone",
+		"🇧🇬 🇺🇸": "flag of Bulgaria|flag of United States",
+		"🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F": "flag of Scotland",
+		"<a:party_blob:12345678901234567>":                              "custom emoji party blob, animated",
+		"<:pepe:12345678901234567> :smile:":                             "custom emoji pepe|smile emoji",
+		"🤷\u200d♀\ufe0f":                                                "woman shrugging emoji",
+		"🧜\u200d♀\ufe0f":                                                "mermaid emoji, female",
+		"🧑🏿\u200d🤝\u200d🧑🏻":                                             "people holding hands emoji, dark skin tone, light skin tone",
+		"no emojis here":                                                "",
+	}
+	for input, want := range cases {
+		if got := strings.Join(emojiparser.DescribeAll(input), "|"); got != want {
+			t.Fatalf("DescribeAll(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestAltTextPhrases(t *testing.T) {
+	parser := newTestParser(t, emojiparser.WithAltTextPhrases(emojiparser.AltTextPhrases{
+		Emoji:    "емоджи %s",
+		Custom:   "персонализирано емоджи %s",
+		Animated: "%s, анимирано",
+		Flag:     "знаме на %s",
+		Region: func(code string) (string, bool) {
+			return "България", code == "BG"
+		},
+		Tone: func(tone emojiparser.SkinTone) string {
+			return "тон " + tone.String()[len("tone"):]
+		},
+	}))
+	got := parser.DescribeAll("😃 👍🏿 🇧🇬 🇺🇸 <a:blob:12345678901234567>")
+	want := []string{"емоджи smiley", "емоджи thumbup, тон 5", "знаме на България", "знаме на United States", "персонализирано емоджи blob, анимирано"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Fatalf("DescribeAll = %q, want %q", got, want)
+	}
+}
+
+func TestAltTextUnknownFlag(t *testing.T) {
+	results := emojiparser.Parse("🇿🇿")
+	if len(results) != 1 {
+		t.Fatalf("Parse found %d emojis, want 1", len(results))
+	}
+	if got := emojiparser.AltText(results[0]); got != "flag of ZZ" {
+		t.Fatalf("AltText = %q, want %q", got, "flag of ZZ")
+	}
+}
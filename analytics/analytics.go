@@ -0,0 +1,225 @@
+// Package analytics computes emoji usage statistics from message exports,
+// such as the messages.csv files in the channel folders of Discord's data
+// package.
+package analytics
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+// EmojiCount is the number of uses of one emoji.
+type EmojiCount struct {
+	Key   string // the unicode sequence, or the ID of a custom emoji
+	Name  string
+	Type  emojiparser.EmojiType // custom, or unicode for text and unicode uses
+	Count int
+}
+
+// Stats accumulates emoji usage over messages. The zero value is ready to
+// use.
+type Stats struct {
+	Messages          int // messages added
+	MessagesWithEmoji int
+	Emojis            int // emoji uses in all messages
+	Skipped           int // malformed rows that were left out
+
+	// First and Last are the timestamps of the earliest and latest message
+	// added with a timestamp.
+	First, Last time.Time
+
+	counts map[string]*EmojiCount
+}
+
+// Add records one message and the emojis parsed from it. A zero timestamp is
+// ignored for First and Last. Text emojis are counted with the unicode emoji
+// they stand for, so :smile: and 😄 are one entry.
+func (s *Stats) Add(timestamp time.Time, results []emojiparser.ParsedEmoji) {
+	s.Messages++
+	if !timestamp.IsZero() {
+		if s.First.IsZero() || timestamp.Before(s.First) {
+			s.First = timestamp
+		}
+		if timestamp.After(s.Last) {
+			s.Last = timestamp
+		}
+	}
+	if len(results) == 0 {
+		return
+	}
+
+	s.MessagesWithEmoji++
+	s.Emojis += len(results)
+	if s.counts == nil {
+		s.counts = make(map[string]*EmojiCount)
+	}
+	for _, result := range results {
+		key, typ := result.Unicode, emojiparser.EmojiTypeUnicode
+		if result.Type == emojiparser.EmojiTypeCustom && result.ID != nil {
+			key, typ = *result.ID, emojiparser.EmojiTypeCustom
+		}
+		count, ok := s.counts[key]
+		if !ok {
+			count = &EmojiCount{Key: key, Name: result.Name, Type: typ}
+			s.counts[key] = count
+		}
+		count.Count++
+	}
+}
+
+// Top returns the n most used emojis, most used first and ties ordered by
+// key. A negative n returns all of them.
+func (s *Stats) Top(n int) []EmojiCount {
+	counts := make([]EmojiCount, 0, len(s.counts))
+	for _, count := range s.counts {
+		counts = append(counts, *count)
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return counts[i].Key < counts[j].Key
+	})
+	if n >= 0 && n < len(counts) {
+		counts = counts[:n]
+	}
+	return counts
+}
+
+// AnalyzeOption configures AnalyzeMessagesCSV.
+type AnalyzeOption func(*analyzeOptions)
+
+type analyzeOptions struct {
+	parser       *emojiparser.DiscordEmojiParser
+	since, until time.Time
+	stats        *Stats
+}
+
+// WithParser parses messages with parser instead of the default parser, for
+// example one with custom emojis registered.
+func WithParser(parser *emojiparser.DiscordEmojiParser) AnalyzeOption {
+	return func(o *analyzeOptions) {
+		o.parser = parser
+	}
+}
+
+// WithSince leaves out messages sent before t.
+func WithSince(t time.Time) AnalyzeOption {
+	return func(o *analyzeOptions) {
+		o.since = t
+	}
+}
+
+// WithUntil leaves out messages sent at or after t.
+func WithUntil(t time.Time) AnalyzeOption {
+	return func(o *analyzeOptions) {
+		o.until = t
+	}
+}
+
+// WithStats adds to stats instead of a new Stats, so that several files can
+// be analyzed into one result.
+func WithStats(stats *Stats) AnalyzeOption {
+	return func(o *analyzeOptions) {
+		o.stats = stats
+	}
+}
+
+// timestampLayouts are the formats accepted in the Timestamp column. Data
+// packages use the first; the second is RFC 3339.
+var timestampLayouts = []string{
+	"2006-01-02 15:04:05.999999999-07:00",
+	time.RFC3339Nano,
+}
+
+// AnalyzeMessagesCSV reads a messages.csv export from r row by row and adds
+// the emojis in the Contents column of every message to the returned Stats.
+// The header row must name the ID, Timestamp, and Contents columns; other
+// columns are ignored. With WithSince or WithUntil, messages outside the
+// range are left out without being counted.
+//
+// Rows that cannot be read, lack a column, or have a timestamp that cannot
+// be parsed are counted in Stats.Skipped instead of failing the run. An error
+// is returned only if the header is missing or unusable or r fails.
+func AnalyzeMessagesCSV(r io.Reader, opts ...AnalyzeOption) (*Stats, error) {
+	var options analyzeOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	parse := emojiparser.Parse
+	if options.parser != nil {
+		parse = options.parser.Parse
+	}
+	stats := options.stats
+	if stats == nil {
+		stats = &Stats{}
+	}
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+	columns := map[string]int{"id": -1, "timestamp": -1, "contents": -1}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\uFEFF")))
+		if _, ok := columns[name]; ok {
+			columns[name] = i
+		}
+	}
+	for name, i := range columns {
+		if i < 0 {
+			return nil, fmt.Errorf("read header: missing %s column", name)
+		}
+	}
+	width := max(columns["id"], columns["timestamp"], columns["contents"]) + 1
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return stats, nil
+		}
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			stats.Skipped++
+			continue
+		}
+		if err != nil {
+			return stats, fmt.Errorf("read messages: %w", err)
+		}
+		if len(record) < width {
+			stats.Skipped++
+			continue
+		}
+
+		timestamp, ok := parseTimestamp(record[columns["timestamp"]])
+		if !ok {
+			stats.Skipped++
+			continue
+		}
+		if !options.since.IsZero() && timestamp.Before(options.since) ||
+			!options.until.IsZero() && !timestamp.Before(options.until) {
+			continue
+		}
+		stats.Add(timestamp, parse(record[columns["contents"]]))
+	}
+}
+
+func parseTimestamp(value string) (time.Time, bool) {
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
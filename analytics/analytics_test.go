@@ -0,0 +1,82 @@
+package analytics_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+	"github.com/x1xo/emoji-parser/analytics"
+)
+
+const messagesCSV = `ID,Timestamp,Contents,Attachments
+1001,2023-01-05 10:00:00.000000+00:00,"hello, world 😄",
+1002,2023-02-10 12:30:00.123000+00:00,"tada :tada: and 😄 <:pepe:12345678901234567>",
+1003,2023-03-01 08:00:00.000000+00:00,no emoji here,
+1004,2023-03-02 08:00:00.000000+00:00,bad "quote 🎉,
+1005,not a time,😄,
+1006
+1007,2023-04-01 09:00:00+00:00,"multi
+line 🎉, with ""quotes""",https://cdn.example/file.png
+`
+
+func TestAnalyzeMessagesCSV(t *testing.T) {
+	stats, err := analytics.AnalyzeMessagesCSV(strings.NewReader(messagesCSV))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stats.Messages != 4 || stats.MessagesWithEmoji != 3 || stats.Emojis != 5 || stats.Skipped != 3 {
+		t.Fatalf("stats = %+v", stats)
+	}
+
+	top := stats.Top(-1)
+	want := []analytics.EmojiCount{
+		{Key: "🎉", Name: "tada", Type: emojiparser.EmojiTypeUnicode, Count: 2},
+		{Key: "😄", Name: "smile", Type: emojiparser.EmojiTypeUnicode, Count: 2},
+		{Key: "12345678901234567", Name: "pepe", Type: emojiparser.EmojiTypeCustom, Count: 1},
+	}
+	if !slices.Equal(top, want) {
+		t.Fatalf("top = %+v, want %+v", top, want)
+	}
+	if got := stats.Top(1); !slices.Equal(got, want[:1]) {
+		t.Fatalf("Top(1) = %+v", got)
+	}
+
+	first := time.Date(2023, 1, 5, 10, 0, 0, 0, time.UTC)
+	last := time.Date(2023, 4, 1, 9, 0, 0, 0, time.UTC)
+	if !stats.First.Equal(first) || !stats.Last.Equal(last) {
+		t.Fatalf("range = %v .. %v", stats.First, stats.Last)
+	}
+}
+
+func TestAnalyzeMessagesCSVDateFilter(t *testing.T) {
+	stats, err := analytics.AnalyzeMessagesCSV(strings.NewReader(messagesCSV),
+		analytics.WithSince(time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)),
+		analytics.WithUntil(time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stats.Messages != 2 || stats.Emojis != 3 {
+		t.Fatalf("stats = %+v", stats)
+	}
+
+	total := &analytics.Stats{}
+	for range 2 {
+		if _, err := analytics.AnalyzeMessagesCSV(strings.NewReader(messagesCSV), analytics.WithStats(total)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if total.Messages != 8 {
+		t.Fatalf("accumulated %d messages over two files, want 8", total.Messages)
+	}
+}
+
+func TestAnalyzeMessagesCSVHeader(t *testing.T) {
+	for _, input := range []string{"", "ID,Contents\n1,😄\n"} {
+		if _, err := analytics.AnalyzeMessagesCSV(strings.NewReader(input)); err == nil {
+			t.Fatalf("AnalyzeMessagesCSV(%q): expected an error", input)
+		}
+	}
+}
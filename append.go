@@ -0,0 +1,65 @@
+package emojiparser
+
+// AppendParse appends the emojis in content to dst using the default parser.
+func AppendParse(dst []ParsedEmoji, content string) []ParsedEmoji {
+	return defaultParser.AppendParse(dst, content)
+}
+
+// AppendParseUnicode appends the unicode emojis in content to dst using the
+// default parser.
+func AppendParseUnicode(dst []ParsedEmoji, content string, skipRanges []ParsedEmoji) []ParsedEmoji {
+	return defaultParser.AppendParseUnicode(dst, content, skipRanges)
+}
+
+// AppendParseTextRepresentation appends the text emojis in content to dst
+// using the default parser.
+func AppendParseTextRepresentation(dst []ParsedEmoji, content string, skipRanges []ParsedEmoji) []ParsedEmoji {
+	return defaultParser.AppendParseTextRepresentation(dst, content, skipRanges)
+}
+
+// AppendParseDiscordCustom appends the custom emojis in content to dst using
+// the default parser.
+func AppendParseDiscordCustom(dst []ParsedEmoji, content string) []ParsedEmoji {
+	return defaultParser.AppendParseDiscordCustom(dst, content)
+}
+
+// AppendParse appends the results of p.Parse(content) to dst and returns the
+// extended slice, like the standard library's append functions. The elements
+// of dst are kept, and the result shares dst's backing array when it has
+// room, so a buffer reused as AppendParse(buf[:0], content) stops growing once
+// it fits the largest message. The results' links are still allocated.
+func (p *DiscordEmojiParser) AppendParse(dst []ParsedEmoji, content string) []ParsedEmoji {
+	if !p.beginParse(content) {
+		return dst
+	}
+	return p.appendKinds(dst, content, scanAll, nil)
+}
+
+// AppendParseUnicode is the append form of ParseUnicode.
+func (p *DiscordEmojiParser) AppendParseUnicode(dst []ParsedEmoji, content string, skipRanges []ParsedEmoji) []ParsedEmoji {
+	return p.appendKinds(dst, content, scanUnicode, skipRanges)
+}
+
+// AppendParseTextRepresentation is the append form of
+// ParseTextRepresentation.
+func (p *DiscordEmojiParser) AppendParseTextRepresentation(dst []ParsedEmoji, content string, skipRanges []ParsedEmoji) []ParsedEmoji {
+	return p.appendKinds(dst, content, scanText, skipRanges)
+}
+
+// AppendParseDiscordCustom is the append form of ParseDiscordCustom.
+func (p *DiscordEmojiParser) AppendParseDiscordCustom(dst []ParsedEmoji, content string) []ParsedEmoji {
+	return p.appendKinds(dst, content, scanCustom, nil)
+}
+
+// appendKinds appends the emojis of the given kinds in content to dst, with
+// positions in content.
+func (p *DiscordEmojiParser) appendKinds(dst []ParsedEmoji, content string, kinds scanKinds, skipRanges []ParsedEmoji) []ParsedEmoji {
+	state := p.state.Load()
+	if stripped, m := p.rewriteContent(content); m != nil {
+		n := len(dst)
+		dst = p.appendTokens(dst, state, stripped, kinds, m.strippedRanges(skipRanges))
+		m.restore(dst[n:])
+		return dst
+	}
+	return p.appendTokens(dst, state, content, kinds, skipRanges)
+}
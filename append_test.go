@@ -0,0 +1,48 @@
+package emojiparser_test
+
+import (
+	"reflect"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestAppendParse(t *testing.T) {
+	prefix := emojiparser.Parse("🔥")
+	for _, content := range countInputs {
+		dst := append([]emojiparser.ParsedEmoji(nil), prefix...)
+		got := emojiparser.AppendParse(dst, content)
+		want := append(append([]emojiparser.ParsedEmoji(nil), prefix...), emojiparser.Parse(content)...)
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("AppendParse(%q) = %v, want %v", content, got, want)
+		}
+	}
+}
+
+func TestAppendParseReusesBuffer(t *testing.T) {
+	content := "😄 :tada: <:pepe:12345678901234567>"
+	buf := make([]emojiparser.ParsedEmoji, 0, 8)
+	got := emojiparser.AppendParse(buf, content)
+	if len(got) != 3 || &got[0] != &buf[:1][0] {
+		t.Fatalf("AppendParse did not append into the buffer: %v", got)
+	}
+}
+
+func TestAppendParseTypes(t *testing.T) {
+	content := "😄 <:smile:12345678901234567> :tada: 🔥"
+	custom := emojiparser.ParseDiscordCustom(content)
+	cases := []struct {
+		name string
+		got  []emojiparser.ParsedEmoji
+		want []emojiparser.ParsedEmoji
+	}{
+		{"unicode", emojiparser.AppendParseUnicode(nil, content, custom), emojiparser.ParseUnicode(content, custom)},
+		{"text", emojiparser.AppendParseTextRepresentation(nil, content, custom), emojiparser.ParseTextRepresentation(content, custom)},
+		{"custom", emojiparser.AppendParseDiscordCustom(nil, content), custom},
+	}
+	for _, c := range cases {
+		if !reflect.DeepEqual(c.got, c.want) {
+			t.Fatalf("%s: got %v, want %v", c.name, c.got, c.want)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package emojiparser
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"unicode/utf8"
+)
+
+//go:generate go run ./internal/cmd/assetgen -dir assets -go assets_tables_gen.go
+
+// Asset file names, relative to the root of the file system they are read from.
+const (
+	unicodeEmojisFile    = "UnicodeEmojis.json"
+	unicodeEmojisSVGFile = "UnicodeEmojisSVG.json"
+	assetsMetaFile       = "meta.json"
+)
+
+// assetsMeta mirrors meta.json, which the asset-generation tool writes next
+// to the emoji tables. The counts are only used as allocation hints.
+type assetsMeta struct {
+	UnicodeEmojis    int `json:"UnicodeEmojis"`
+	UnicodeEmojisSVG int `json:"UnicodeEmojisSVG"`
+}
+
+// ReloadAssets loads UnicodeEmojis.json and UnicodeEmojisSVG.json from the
+// root of fsys, rebuilds the lookup indexes, and swaps them in atomically.
+// Parses running concurrently see either the old or the new tables, never a
+// mix. If the files cannot be loaded or fail validation, the parser keeps its
+// current tables and the returned error describes the problem.
+func (p *DiscordEmojiParser) ReloadAssets(fsys fs.FS) error {
+	assets, err := parseAssets(fsys)
+	if err != nil {
+		return err
+	}
+
+	state, err := newParserState(assets)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	p.swapState(state)
+	p.mu.Unlock()
+	return nil
+}
+
+// Assets returns the parser's current tables. The parser only keeps the
+// indexes it parses with, so the tables are rebuilt from them on every call;
+// the returned maps are the caller's to modify.
+func (p *DiscordEmojiParser) Assets() *Assets {
+	return p.state.Load().assets()
+}
+
+// parseAssets loads and parses the asset files at the root of fsys.
+// A missing meta.json only disables pre-sizing of the maps.
+func parseAssets(fsys fs.FS) (*Assets, error) {
+	meta, err := parseAssetsMeta(fsys, assetsMetaFile)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("parse %s: %w", assetsMetaFile, err)
+	}
+
+	unicodeEmojis, err := parseJSONMap(fsys, unicodeEmojisFile, meta.UnicodeEmojis)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s: %w", unicodeEmojisFile, err)
+	}
+
+	unicodeEmojisSVG, err := parseJSONMap(fsys, unicodeEmojisSVGFile, meta.UnicodeEmojisSVG)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s: %w", unicodeEmojisSVGFile, err)
+	}
+
+	return &Assets{
+		UnicodeEmojis:    unicodeEmojis,
+		UnicodeEmojisSVG: unicodeEmojisSVG,
+	}, nil
+}
+
+// validateAssets reports the first problem that would make assets unusable
+// for parsing.
+func validateAssets(assets *Assets) error {
+	if assets == nil {
+		return errors.New("validate assets: nil assets")
+	}
+	if len(assets.UnicodeEmojis) == 0 {
+		return fmt.Errorf("validate %s: no entries", unicodeEmojisFile)
+	}
+	if err := validateUnicodeEmojis(assets.UnicodeEmojis); err != nil {
+		return err
+	}
+
+	names := 0
+	for _, value := range assets.UnicodeEmojis {
+		if containsNonASCII(value) {
+			names++
+		}
+	}
+	if names == 0 {
+		return fmt.Errorf("validate %s: no name maps to a unicode emoji", unicodeEmojisFile)
+	}
+
+	return validateUnicodeEmojisSVG(assets.UnicodeEmojisSVG)
+}
+
+func validateUnicodeEmojis(entries map[string]string) error {
+	for key, value := range entries {
+		if key == "" || value == "" {
+			return fmt.Errorf("validate %s: empty key or value in entry %q: %q", unicodeEmojisFile, key, value)
+		}
+		if !utf8.ValidString(key) || !utf8.ValidString(value) {
+			return fmt.Errorf("validate %s: invalid UTF-8 in entry %q", unicodeEmojisFile, key)
+		}
+		if !containsNonASCII(key) && !containsNonASCII(value) {
+			return fmt.Errorf("validate %s: entry %q: %q maps a name to a name", unicodeEmojisFile, key, value)
+		}
+	}
+	return nil
+}
+
+func validateUnicodeEmojisSVG(entries map[string]string) error {
+	for key, value := range entries {
+		if !isCodePointKey(key) {
+			return fmt.Errorf("validate %s: key %q is not a dash-separated lowercase hex code point list", unicodeEmojisSVGFile, key)
+		}
+		if value == "" {
+			return fmt.Errorf("validate %s: empty hash for %q", unicodeEmojisSVGFile, key)
+		}
+	}
+	return nil
+}
+
+// isCodePointKey reports whether key has the form produced by toCodePoint
+// with a "-" separator.
+func isCodePointKey(key string) bool {
+	if key == "" {
+		return false
+	}
+	digits := 0
+	for i := 0; i < len(key); i++ {
+		c := key[i]
+		switch {
+		case c >= '0' && c <= '9', c >= 'a' && c <= 'f':
+			digits++
+		case c == '-' && digits > 0:
+			digits = 0
+		default:
+			return false
+		}
+	}
+	return digits > 0
+}
+
+func parseAssetsMeta(fsys fs.FS, path string) (assetsMeta, error) {
+	var meta assetsMeta
+	file, err := fsys.Open(path)
+	if err != nil {
+		return meta, err
+	}
+	defer file.Close()
+
+	if err := json.NewDecoder(file).Decode(&meta); err != nil {
+		return meta, err
+	}
+	return meta, nil
+}
+
+// parseJSONMap streams a flat JSON object of string values into a map sized
+// for sizeHint entries, without reading the whole file into memory first.
+func parseJSONMap(fsys fs.FS, path string, sizeHint int) (map[string]string, error) {
+	file, err := fsys.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return decodeJSONMap(file, sizeHint)
+}
+
+func decodeJSONMap(r io.Reader, sizeHint int) (map[string]string, error) {
+	dec := json.NewDecoder(r)
+	if err := expectDelim(dec, '{'); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string, sizeHint)
+	for dec.More() {
+		key, err := decodeString(dec)
+		if err != nil {
+			return nil, err
+		}
+		value, err := decodeString(dec)
+		if err != nil {
+			return nil, fmt.Errorf("value for %q: %w", key, err)
+		}
+		result[key] = value
+	}
+
+	if err := expectDelim(dec, '}'); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != want {
+		return fmt.Errorf("expected %q at offset %d, got %v", want, dec.InputOffset(), tok)
+	}
+	return nil
+}
+
+func decodeString(dec *json.Decoder) (string, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return "", err
+	}
+	value, ok := tok.(string)
+	if !ok {
+		return "", fmt.Errorf("expected string at offset %d, got %v", dec.InputOffset(), tok)
+	}
+	return value, nil
+}
@@ -0,0 +1,26 @@
+//go:build !emojigen
+
+package emojiparser
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:embed assets/*.json
+var assetsFS embed.FS
+
+// embeddedAssets returns the embedded assets directory as a file system rooted
+// at the asset files.
+func embeddedAssets() fs.FS {
+	sub, err := fs.Sub(assetsFS, "assets")
+	if err != nil {
+		panic(err)
+	}
+	return sub
+}
+
+// defaultAssets loads the tables every new parser starts from.
+func defaultAssets() (*Assets, error) {
+	return parseAssets(embeddedAssets())
+}
@@ -0,0 +1,19 @@
+//go:build emojigen
+
+package emojiparser
+
+// defaultAssets returns the tables generated into assets_tables_gen.go, so
+// parsers built with the emojigen tag do no file or JSON work at startup.
+func defaultAssets() (*Assets, error) {
+	return generatedAssets(), nil
+}
+
+// unpackTable builds a map from a generated table of alternating keys and
+// values.
+func unpackTable(pairs []string) map[string]string {
+	table := make(map[string]string, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		table[pairs[i]] = pairs[i+1]
+	}
+	return table
+}
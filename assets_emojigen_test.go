@@ -0,0 +1,23 @@
+//go:build emojigen
+
+package emojiparser
+
+import (
+	"maps"
+	"os"
+	"testing"
+)
+
+func TestGeneratedAssetsMatchJSON(t *testing.T) {
+	want, err := parseAssets(os.DirFS("assets"))
+	if err != nil {
+		t.Fatalf("parse JSON assets: %v", err)
+	}
+	got := generatedAssets()
+	if !maps.Equal(got.UnicodeEmojis, want.UnicodeEmojis) {
+		t.Fatalf("generated UnicodeEmojis differ from %s; run go generate", unicodeEmojisFile)
+	}
+	if !maps.Equal(got.UnicodeEmojisSVG, want.UnicodeEmojisSVG) {
+		t.Fatalf("generated UnicodeEmojisSVG differ from %s; run go generate", unicodeEmojisSVGFile)
+	}
+}
@@ -0,0 +1,93 @@
+//go:build !emojigen
+
+package emojiparser
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+)
+
+// readAllJSONMap is the loader parseJSONMap replaced; it is kept here as the
+// reference implementation for comparison and benchmarking.
+func readAllJSONMap(path string) (map[string]string, error) {
+	file, err := embeddedAssets().Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]string
+	if err := json.Unmarshal(content, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func TestParseJSONMapMatchesReadAll(t *testing.T) {
+	meta, err := parseAssetsMeta(embeddedAssets(), assetsMetaFile)
+	if err != nil {
+		t.Fatalf("parse meta: %v", err)
+	}
+	hints := map[string]int{
+		unicodeEmojisFile:    meta.UnicodeEmojis,
+		unicodeEmojisSVGFile: meta.UnicodeEmojisSVG,
+	}
+	for path, hint := range hints {
+		want, err := readAllJSONMap(path)
+		if err != nil {
+			t.Fatalf("reference loader %s: %v", path, err)
+		}
+		got, err := parseJSONMap(embeddedAssets(), path, hint)
+		if err != nil {
+			t.Fatalf("parseJSONMap %s: %v", path, err)
+		}
+		if len(got) != len(want) {
+			t.Fatalf("%s: expected %d entries, got %d", path, len(want), len(got))
+		}
+		if len(got) != hint {
+			t.Fatalf("%s: meta.json records %d entries, decoded %d", path, hint, len(got))
+		}
+		for key, value := range want {
+			if got[key] != value {
+				t.Fatalf("%s: key %q expected %q, got %q", path, key, value, got[key])
+			}
+		}
+	}
+}
+
+func TestDecodeJSONMapRejectsNonString(t *testing.T) {
+	cases := []string{`[]`, `{"a": 1}`, `{"a": "b"`, `{"a": {"b": "c"}}`}
+	for _, input := range cases {
+		if _, err := decodeJSONMap(strings.NewReader(input), 0); err == nil {
+			t.Fatalf("expected error for %s", input)
+		}
+	}
+}
+
+func BenchmarkParseAssets(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := parseAssets(embeddedAssets()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseAssetsReadAll(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := readAllJSONMap(unicodeEmojisFile); err != nil {
+			b.Fatal(err)
+		}
+		if _, err := readAllJSONMap(unicodeEmojisSVGFile); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
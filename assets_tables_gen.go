@@ -0,0 +1,12825 @@
+// Code generated by assetgen; DO NOT EDIT.
+
+//go:build emojigen
+
+package emojiparser
+
+// generatedAssets returns the emoji tables compiled into the binary.
+func generatedAssets() *Assets {
+	return &Assets{
+		UnicodeEmojis:    unpackTable(packedUnicodeEmojis[:]),
+		UnicodeEmojisSVG: unpackTable(packedUnicodeEmojisSVG[:]),
+	}
+}
+
+var packedUnicodeEmojis = [...]string{
+	"#️⃣", "hash",
+	"*️⃣", "keycap_asterisk",
+	"+1", "👍",
+	"+1_tone1", "👍🏻",
+	"+1_tone2", "👍🏼",
+	"+1_tone3", "👍🏽",
+	"+1_tone4", "👍🏾",
+	"+1_tone5", "👍🏿",
+	"-1", "👎",
+	"0️⃣", "zero",
+	"100", "💯",
+	"1234", "🔢",
+	"1️⃣", "one",
+	"2️⃣", "two",
+	"3️⃣", "three",
+	"4️⃣", "four",
+	"5️⃣", "five",
+	"6️⃣", "six",
+	"7️⃣", "seven",
+	"8ball", "🎱",
+	"8️⃣", "eight",
+	"9️⃣", "nine",
+	"_1_tone1", "👎🏻",
+	"_1_tone2", "👎🏼",
+	"_1_tone3", "👎🏽",
+	"_1_tone4", "👎🏾",
+	"_1_tone5", "👎🏿",
+	"a", "🅰️",
+	"ab", "🆎",
+	"abacus", "🧮",
+	"abc", "🔤",
+	"abcd", "🔡",
+	"accept", "🉑",
+	"accordion", "🪗",
+	"adhesive_bandage", "🩹",
+	"admission_tickets", "🎟️",
+	"adult", "🧑",
+	"adult_dark_skin_tone", "🧑🏿",
+	"adult_light_skin_tone", "🧑🏻",
+	"adult_medium_dark_skin_tone", "🧑🏾",
+	"adult_medium_light_skin_tone", "🧑🏼",
+	"adult_medium_skin_tone", "🧑🏽",
+	"adult_tone1", "🧑🏻",
+	"adult_tone2", "🧑🏼",
+	"adult_tone3", "🧑🏽",
+	"adult_tone4", "🧑🏾",
+	"adult_tone5", "🧑🏿",
+	"aerial_tramway", "🚡",
+	"airplane", "✈️",
+	"airplane_arriving", "🛬",
+	"airplane_departure", "🛫",
+	"airplane_small", "🛩️",
+	"alarm_clock", "⏰",
+	"alembic", "⚗️",
+	"alien", "👽",
+	"ambulance", "🚑",
+	"amphora", "🏺",
+	"anatomical_heart", "🫀",
+	"anchor", "⚓",
+	"angel", "👼",
+	"angel_tone1", "👼🏻",
+	"angel_tone2", "👼🏼",
+	"angel_tone3", "👼🏽",
+	"angel_tone4", "👼🏾",
+	"angel_tone5", "👼🏿",
+	"anger", "💢",
+	"anger_right", "🗯️",
+	"angry", "😠",
+	"anguished", "😧",
+	"ant", "🐜",
+	"apple", "🍎",
+	"aquarius", "♒",
+	"archery", "🏹",
+	"aries", "♈",
+	"arrow_backward", "◀️",
+	"arrow_double_down", "⏬",
+	"arrow_double_up", "⏫",
+	"arrow_down", "⬇️",
+	"arrow_down_small", "🔽",
+	"arrow_forward", "▶️",
+	"arrow_heading_down", "⤵️",
+	"arrow_heading_up", "⤴️",
+	"arrow_left", "⬅️",
+	"arrow_lower_left", "↙️",
+	"arrow_lower_right", "↘️",
+	"arrow_right", "➡️",
+	"arrow_right_hook", "↪️",
+	"arrow_up", "⬆️",
+	"arrow_up_down", "↕️",
+	"arrow_up_small", "🔼",
+	"arrow_upper_left", "↖️",
+	"arrow_upper_right", "↗️",
+	"arrows_clockwise", "🔃",
+	"arrows_counterclockwise", "🔄",
+	"art", "🎨",
+	"articulated_lorry", "🚛",
+	"artist", "🧑\u200d🎨",
+	"artist_dark_skin_tone", "🧑🏿\u200d🎨",
+	"artist_light_skin_tone", "🧑🏻\u200d🎨",
+	"artist_medium_dark_skin_tone", "🧑🏾\u200d🎨",
+	"artist_medium_light_skin_tone", "🧑🏼\u200d🎨",
+	"artist_medium_skin_tone", "🧑🏽\u200d🎨",
+	"artist_tone1", "🧑🏻\u200d🎨",
+	"artist_tone2", "🧑🏼\u200d🎨",
+	"artist_tone3", "🧑🏽\u200d🎨",
+	"artist_tone4", "🧑🏾\u200d🎨",
+	"artist_tone5", "🧑🏿\u200d🎨",
+	"asterisk", "*️⃣",
+	"astonished", "😲",
+	"astronaut", "🧑\u200d🚀",
+	"astronaut_dark_skin_tone", "🧑🏿\u200d🚀",
+	"astronaut_light_skin_tone", "🧑🏻\u200d🚀",
+	"astronaut_medium_dark_skin_tone", "🧑🏾\u200d🚀",
+	"astronaut_medium_light_skin_tone", "🧑🏼\u200d🚀",
+	"astronaut_medium_skin_tone", "🧑🏽\u200d🚀",
+	"astronaut_tone1", "🧑🏻\u200d🚀",
+	"astronaut_tone2", "🧑🏼\u200d🚀",
+	"astronaut_tone3", "🧑🏽\u200d🚀",
+	"astronaut_tone4", "🧑🏾\u200d🚀",
+	"astronaut_tone5", "🧑🏿\u200d🚀",
+	"athletic_shoe", "👟",
+	"atm", "🏧",
+	"atom", "⚛️",
+	"atom_symbol", "⚛️",
+	"auto_rickshaw", "🛺",
+	"avocado", "🥑",
+	"axe", "🪓",
+	"b", "🅱️",
+	"baby", "👶",
+	"baby_bottle", "🍼",
+	"baby_chick", "🐤",
+	"baby_symbol", "🚼",
+	"baby_tone1", "👶🏻",
+	"baby_tone2", "👶🏼",
+	"baby_tone3", "👶🏽",
+	"baby_tone4", "👶🏾",
+	"baby_tone5", "👶🏿",
+	"back", "🔙",
+	"back_of_hand", "🤚",
+	"back_of_hand_tone1", "🤚🏻",
+	"back_of_hand_tone2", "🤚🏼",
+	"back_of_hand_tone3", "🤚🏽",
+	"back_of_hand_tone4", "🤚🏾",
+	"back_of_hand_tone5", "🤚🏿",
+	"bacon", "🥓",
+	"badger", "🦡",
+	"badminton", "🏸",
+	"bagel", "🥯",
+	"baggage_claim", "🛄",
+	"baguette_bread", "🥖",
+	"ballet_shoes", "🩰",
+	"balloon", "🎈",
+	"ballot_box", "🗳️",
+	"ballot_box_with_ballot", "🗳️",
+	"ballot_box_with_check", "☑️",
+	"bamboo", "🎍",
+	"banana", "🍌",
+	"bangbang", "‼️",
+	"banjo", "🪕",
+	"bank", "🏦",
+	"bar_chart", "📊",
+	"barber", "💈",
+	"baseball", "⚾",
+	"basket", "🧺",
+	"basketball", "🏀",
+	"basketball_player", "⛹️",
+	"basketball_player_tone1", "⛹🏻",
+	"basketball_player_tone2", "⛹🏼",
+	"basketball_player_tone3", "⛹🏽",
+	"basketball_player_tone4", "⛹🏾",
+	"basketball_player_tone5", "⛹🏿",
+	"bat", "🦇",
+	"bath", "🛀",
+	"bath_tone1", "🛀🏻",
+	"bath_tone2", "🛀🏼",
+	"bath_tone3", "🛀🏽",
+	"bath_tone4", "🛀🏾",
+	"bath_tone5", "🛀🏿",
+	"bathtub", "🛁",
+	"battery", "🔋",
+	"beach", "🏖️",
+	"beach_umbrella", "⛱️",
+	"beach_with_umbrella", "🏖️",
+	"beans", "🫘",
+	"bear", "🐻",
+	"bearded_person", "🧔",
+	"bearded_person_dark_skin_tone", "🧔🏿",
+	"bearded_person_light_skin_tone", "🧔🏻",
+	"bearded_person_medium_dark_skin_tone", "🧔🏾",
+	"bearded_person_medium_light_skin_tone", "🧔🏼",
+	"bearded_person_medium_skin_tone", "🧔🏽",
+	"bearded_person_tone1", "🧔🏻",
+	"bearded_person_tone2", "🧔🏼",
+	"bearded_person_tone3", "🧔🏽",
+	"bearded_person_tone4", "🧔🏾",
+	"bearded_person_tone5", "🧔🏿",
+	"beaver", "🦫",
+	"bed", "🛏️",
+	"bee", "🐝",
+	"beer", "🍺",
+	"beers", "🍻",
+	"beetle", "🪲",
+	"beginner", "🔰",
+	"bell", "🔔",
+	"bell_pepper", "🫑",
+	"bellhop", "🛎️",
+	"bellhop_bell", "🛎️",
+	"bento", "🍱",
+	"beverage_box", "🧃",
+	"bicyclist", "🚴",
+	"bicyclist_tone1", "🚴🏻",
+	"bicyclist_tone2", "🚴🏼",
+	"bicyclist_tone3", "🚴🏽",
+	"bicyclist_tone4", "🚴🏾",
+	"bicyclist_tone5", "🚴🏿",
+	"bike", "🚲",
+	"bikini", "👙",
+	"billed_cap", "🧢",
+	"biohazard", "☣️",
+	"biohazard_sign", "☣️",
+	"bird", "🐦",
+	"birthday", "🎂",
+	"bison", "🦬",
+	"biting_lip", "🫦",
+	"black_cat", "🐈\u200d⬛",
+	"black_circle", "⚫",
+	"black_heart", "🖤",
+	"black_joker", "🃏",
+	"black_large_square", "⬛",
+	"black_medium_small_square", "◾",
+	"black_medium_square", "◼️",
+	"black_nib", "✒️",
+	"black_small_square", "▪️",
+	"black_square_button", "🔲",
+	"blond_haired_man", "👱\u200d♂️",
+	"blond_haired_man_dark_skin_tone", "👱🏿\u200d♂️",
+	"blond_haired_man_light_skin_tone", "👱🏻\u200d♂️",
+	"blond_haired_man_medium_dark_skin_tone", "👱🏾\u200d♂️",
+	"blond_haired_man_medium_light_skin_tone", "👱🏼\u200d♂️",
+	"blond_haired_man_medium_skin_tone", "👱🏽\u200d♂️",
+	"blond_haired_man_tone1", "👱🏻\u200d♂️",
+	"blond_haired_man_tone2", "👱🏼\u200d♂️",
+	"blond_haired_man_tone3", "👱🏽\u200d♂️",
+	"blond_haired_man_tone4", "👱🏾\u200d♂️",
+	"blond_haired_man_tone5", "👱🏿\u200d♂️",
+	"blond_haired_person", "👱",
+	"blond_haired_person_tone1", "👱🏻",
+	"blond_haired_person_tone2", "👱🏼",
+	"blond_haired_person_tone3", "👱🏽",
+	"blond_haired_person_tone4", "👱🏾",
+	"blond_haired_person_tone5", "👱🏿",
+	"blond_haired_woman", "👱\u200d♀️",
+	"blond_haired_woman_dark_skin_tone", "👱🏿\u200d♀️",
+	"blond_haired_woman_light_skin_tone", "👱🏻\u200d♀️",
+	"blond_haired_woman_medium_dark_skin_tone", "👱🏾\u200d♀️",
+	"blond_haired_woman_medium_light_skin_tone", "👱🏼\u200d♀️",
+	"blond_haired_woman_medium_skin_tone", "👱🏽\u200d♀️",
+	"blond_haired_woman_tone1", "👱🏻\u200d♀️",
+	"blond_haired_woman_tone2", "👱🏼\u200d♀️",
+	"blond_haired_woman_tone3", "👱🏽\u200d♀️",
+	"blond_haired_woman_tone4", "👱🏾\u200d♀️",
+	"blond_haired_woman_tone5", "👱🏿\u200d♀️",
+	"blossom", "🌼",
+	"blowfish", "🐡",
+	"blue_book", "📘",
+	"blue_car", "🚙",
+	"blue_circle", "🔵",
+	"blue_heart", "💙",
+	"blue_square", "🟦",
+	"blueberries", "🫐",
+	"blush", "😊",
+	"boar", "🐗",
+	"bomb", "💣",
+	"bone", "🦴",
+	"book", "📖",
+	"bookmark", "🔖",
+	"bookmark_tabs", "📑",
+	"books", "📚",
+	"boom", "💥",
+	"boomerang", "🪃",
+	"boot", "👢",
+	"bottle_with_popping_cork", "🍾",
+	"bouquet", "💐",
+	"bow", "🙇",
+	"bow_and_arrow", "🏹",
+	"bow_tone1", "🙇🏻",
+	"bow_tone2", "🙇🏼",
+	"bow_tone3", "🙇🏽",
+	"bow_tone4", "🙇🏾",
+	"bow_tone5", "🙇🏿",
+	"bowl_with_spoon", "🥣",
+	"bowling", "🎳",
+	"boxing_glove", "🥊",
+	"boxing_gloves", "🥊",
+	"boy", "👦",
+	"boy_tone1", "👦🏻",
+	"boy_tone2", "👦🏼",
+	"boy_tone3", "👦🏽",
+	"boy_tone4", "👦🏾",
+	"boy_tone5", "👦🏿",
+	"brain", "🧠",
+	"bread", "🍞",
+	"breast_feeding", "🤱",
+	"breast_feeding_dark_skin_tone", "🤱🏿",
+	"breast_feeding_light_skin_tone", "🤱🏻",
+	"breast_feeding_medium_dark_skin_tone", "🤱🏾",
+	"breast_feeding_medium_light_skin_tone", "🤱🏼",
+	"breast_feeding_medium_skin_tone", "🤱🏽",
+	"breast_feeding_tone1", "🤱🏻",
+	"breast_feeding_tone2", "🤱🏼",
+	"breast_feeding_tone3", "🤱🏽",
+	"breast_feeding_tone4", "🤱🏾",
+	"breast_feeding_tone5", "🤱🏿",
+	"bricks", "🧱",
+	"bride_with_veil", "👰\u200d♀️",
+	"bridge_at_night", "🌉",
+	"briefcase", "💼",
+	"briefs", "🩲",
+	"broccoli", "🥦",
+	"broken_heart", "💔",
+	"broom", "🧹",
+	"brown_circle", "🟤",
+	"brown_heart", "🤎",
+	"brown_square", "🟫",
+	"bubble_tea", "🧋",
+	"bubbles", "🫧",
+	"bucket", "🪣",
+	"bug", "🐛",
+	"building_construction", "🏗️",
+	"bulb", "💡",
+	"bullettrain_front", "🚅",
+	"bullettrain_side", "🚄",
+	"burrito", "🌯",
+	"bus", "🚌",
+	"busstop", "🚏",
+	"bust_in_silhouette", "👤",
+	"busts_in_silhouette", "👥",
+	"butter", "🧈",
+	"butterfly", "🦋",
+	"cactus", "🌵",
+	"cake", "🍰",
+	"calendar", "📆",
+	"calendar_spiral", "🗓️",
+	"call_me", "🤙",
+	"call_me_hand", "🤙",
+	"call_me_hand_tone1", "🤙🏻",
+	"call_me_hand_tone2", "🤙🏼",
+	"call_me_hand_tone3", "🤙🏽",
+	"call_me_hand_tone4", "🤙🏾",
+	"call_me_hand_tone5", "🤙🏿",
+	"call_me_tone1", "🤙🏻",
+	"call_me_tone2", "🤙🏼",
+	"call_me_tone3", "🤙🏽",
+	"call_me_tone4", "🤙🏾",
+	"call_me_tone5", "🤙🏿",
+	"calling", "📲",
+	"camel", "🐫",
+	"camera", "📷",
+	"camera_with_flash", "📸",
+	"camping", "🏕️",
+	"cancer", "♋",
+	"candle", "🕯️",
+	"candy", "🍬",
+	"canned_food", "🥫",
+	"canoe", "🛶",
+	"capital_abcd", "🔠",
+	"capricorn", "♑",
+	"card_box", "🗃️",
+	"card_file_box", "🗃️",
+	"card_index", "📇",
+	"card_index_dividers", "🗂️",
+	"carousel_horse", "🎠",
+	"carpentry_saw", "🪚",
+	"carrot", "🥕",
+	"cartwheel", "🤸",
+	"cartwheel_tone1", "🤸🏻",
+	"cartwheel_tone2", "🤸🏼",
+	"cartwheel_tone3", "🤸🏽",
+	"cartwheel_tone4", "🤸🏾",
+	"cartwheel_tone5", "🤸🏿",
+	"cat", "🐱",
+	"cat2", "🐈",
+	"cd", "💿",
+	"chains", "⛓️",
+	"chair", "🪑",
+	"champagne", "🍾",
+	"champagne_glass", "🥂",
+	"chart", "💹",
+	"chart_with_downwards_trend", "📉",
+	"chart_with_upwards_trend", "📈",
+	"checkered_flag", "🏁",
+	"cheese", "🧀",
+	"cheese_wedge", "🧀",
+	"cherries", "🍒",
+	"cherry_blossom", "🌸",
+	"chess_pawn", "♟️",
+	"chestnut", "🌰",
+	"chicken", "🐔",
+	"child", "🧒",
+	"child_dark_skin_tone", "🧒🏿",
+	"child_light_skin_tone", "🧒🏻",
+	"child_medium_dark_skin_tone", "🧒🏾",
+	"child_medium_light_skin_tone", "🧒🏼",
+	"child_medium_skin_tone", "🧒🏽",
+	"child_tone1", "🧒🏻",
+	"child_tone2", "🧒🏼",
+	"child_tone3", "🧒🏽",
+	"child_tone4", "🧒🏾",
+	"child_tone5", "🧒🏿",
+	"children_crossing", "🚸",
+	"chipmunk", "🐿️",
+	"chocolate_bar", "🍫",
+	"chopsticks", "🥢",
+	"christmas_tree", "🎄",
+	"church", "⛪",
+	"cinema", "🎦",
+	"circus_tent", "🎪",
+	"city_dusk", "🌆",
+	"city_sunrise", "🌇",
+	"city_sunset", "🌇",
+	"cityscape", "🏙️",
+	"cl", "🆑",
+	"clap", "👏",
+	"clap_tone1", "👏🏻",
+	"clap_tone2", "👏🏼",
+	"clap_tone3", "👏🏽",
+	"clap_tone4", "👏🏾",
+	"clap_tone5", "👏🏿",
+	"clapper", "🎬",
+	"classical_building", "🏛️",
+	"clinking_glass", "🥂",
+	"clipboard", "📋",
+	"clock", "🕰️",
+	"clock1", "🕐",
+	"clock10", "🕙",
+	"clock1030", "🕥",
+	"clock11", "🕚",
+	"clock1130", "🕦",
+	"clock12", "🕛",
+	"clock1230", "🕧",
+	"clock130", "🕜",
+	"clock2", "🕑",
+	"clock230", "🕝",
+	"clock3", "🕒",
+	"clock330", "🕞",
+	"clock4", "🕓",
+	"clock430", "🕟",
+	"clock5", "🕔",
+	"clock530", "🕠",
+	"clock6", "🕕",
+	"clock630", "🕡",
+	"clock7", "🕖",
+	"clock730", "🕢",
+	"clock8", "🕗",
+	"clock830", "🕣",
+	"clock9", "🕘",
+	"clock930", "🕤",
+	"closed_book", "📕",
+	"closed_lock_with_key", "🔐",
+	"closed_umbrella", "🌂",
+	"cloud", "☁️",
+	"cloud_lightning", "🌩️",
+	"cloud_rain", "🌧️",
+	"cloud_snow", "🌨️",
+	"cloud_tornado", "🌪️",
+	"cloud_with_lightning", "🌩️",
+	"cloud_with_rain", "🌧️",
+	"cloud_with_snow", "🌨️",
+	"cloud_with_tornado", "🌪️",
+	"clown", "🤡",
+	"clown_face", "🤡",
+	"clubs", "♣️",
+	"coat", "🧥",
+	"cockroach", "🪳",
+	"cocktail", "🍸",
+	"coconut", "🥥",
+	"coffee", "☕",
+	"coffin", "⚰️",
+	"coin", "🪙",
+	"cold_face", "🥶",
+	"cold_sweat", "😰",
+	"comet", "☄️",
+	"compass", "🧭",
+	"compression", "🗜️",
+	"computer", "💻",
+	"confetti_ball", "🎊",
+	"confounded", "😖",
+	"confused", "😕",
+	"congratulations", "㊗️",
+	"construction", "🚧",
+	"construction_site", "🏗️",
+	"construction_worker", "👷",
+	"construction_worker_tone1", "👷🏻",
+	"construction_worker_tone2", "👷🏼",
+	"construction_worker_tone3", "👷🏽",
+	"construction_worker_tone4", "👷🏾",
+	"construction_worker_tone5", "👷🏿",
+	"control_knobs", "🎛️",
+	"convenience_store", "🏪",
+	"cook", "🧑\u200d🍳",
+	"cook_dark_skin_tone", "🧑🏿\u200d🍳",
+	"cook_light_skin_tone", "🧑🏻\u200d🍳",
+	"cook_medium_dark_skin_tone", "🧑🏾\u200d🍳",
+	"cook_medium_light_skin_tone", "🧑🏼\u200d🍳",
+	"cook_medium_skin_tone", "🧑🏽\u200d🍳",
+	"cook_tone1", "🧑🏻\u200d🍳",
+	"cook_tone2", "🧑🏼\u200d🍳",
+	"cook_tone3", "🧑🏽\u200d🍳",
+	"cook_tone4", "🧑🏾\u200d🍳",
+	"cook_tone5", "🧑🏿\u200d🍳",
+	"cookie", "🍪",
+	"cooking", "🍳",
+	"cool", "🆒",
+	"cop", "👮",
+	"cop_tone1", "👮🏻",
+	"cop_tone2", "👮🏼",
+	"cop_tone3", "👮🏽",
+	"cop_tone4", "👮🏾",
+	"cop_tone5", "👮🏿",
+	"copyright", "©️",
+	"coral", "🪸",
+	"corn", "🌽",
+	"couch", "🛋️",
+	"couch_and_lamp", "🛋️",
+	"couple", "👫",
+	"couple_mm", "👨\u200d❤️\u200d👨",
+	"couple_with_heart", "💑",
+	"couple_with_heart_dark_skin_tone", "💑🏿",
+	"couple_with_heart_light_skin_tone", "💑🏻",
+	"couple_with_heart_man_man_dark_skin_tone", "👨🏿\u200d❤️\u200d👨🏿",
+	"couple_with_heart_man_man_dark_skin_tone_light_skin_tone", "👨🏿\u200d❤️\u200d👨🏻",
+	"couple_with_heart_man_man_dark_skin_tone_medium_dark_skin_tone", "👨🏿\u200d❤️\u200d👨🏾",
+	"couple_with_heart_man_man_dark_skin_tone_medium_light_skin_tone", "👨🏿\u200d❤️\u200d👨🏼",
+	"couple_with_heart_man_man_dark_skin_tone_medium_skin_tone", "👨🏿\u200d❤️\u200d👨🏽",
+	"couple_with_heart_man_man_light_skin_tone", "👨🏻\u200d❤️\u200d👨🏻",
+	"couple_with_heart_man_man_light_skin_tone_dark_skin_tone", "👨🏻\u200d❤️\u200d👨🏿",
+	"couple_with_heart_man_man_light_skin_tone_medium_dark_skin_tone", "👨🏻\u200d❤️\u200d👨🏾",
+	"couple_with_heart_man_man_light_skin_tone_medium_light_skin_tone", "👨🏻\u200d❤️\u200d👨🏼",
+	"couple_with_heart_man_man_light_skin_tone_medium_skin_tone", "👨🏻\u200d❤️\u200d👨🏽",
+	"couple_with_heart_man_man_medium_dark_skin_tone", "👨🏾\u200d❤️\u200d👨🏾",
+	"couple_with_heart_man_man_medium_dark_skin_tone_dark_skin_tone", "👨🏾\u200d❤️\u200d👨🏿",
+	"couple_with_heart_man_man_medium_dark_skin_tone_light_skin_tone", "👨🏾\u200d❤️\u200d👨🏻",
+	"couple_with_heart_man_man_medium_dark_skin_tone_medium_light_skin_tone", "👨🏾\u200d❤️\u200d👨🏼",
+	"couple_with_heart_man_man_medium_dark_skin_tone_medium_skin_tone", "👨🏾\u200d❤️\u200d👨🏽",
+	"couple_with_heart_man_man_medium_light_skin_tone", "👨🏼\u200d❤️\u200d👨🏼",
+	"couple_with_heart_man_man_medium_light_skin_tone_dark_skin_tone", "👨🏼\u200d❤️\u200d👨🏿",
+	"couple_with_heart_man_man_medium_light_skin_tone_light_skin_tone", "👨🏼\u200d❤️\u200d👨🏻",
+	"couple_with_heart_man_man_medium_light_skin_tone_medium_dark_skin_tone", "👨🏼\u200d❤️\u200d👨🏾",
+	"couple_with_heart_man_man_medium_light_skin_tone_medium_skin_tone", "👨🏼\u200d❤️\u200d👨🏽",
+	"couple_with_heart_man_man_medium_skin_tone", "👨🏽\u200d❤️\u200d👨🏽",
+	"couple_with_heart_man_man_medium_skin_tone_dark_skin_tone", "👨🏽\u200d❤️\u200d👨🏿",
+	"couple_with_heart_man_man_medium_skin_tone_light_skin_tone", "👨🏽\u200d❤️\u200d👨🏻",
+	"couple_with_heart_man_man_medium_skin_tone_medium_dark_skin_tone", "👨🏽\u200d❤️\u200d👨🏾",
+	"couple_with_heart_man_man_medium_skin_tone_medium_light_skin_tone", "👨🏽\u200d❤️\u200d👨🏼",
+	"couple_with_heart_man_man_tone1", "👨🏻\u200d❤️\u200d👨🏻",
+	"couple_with_heart_man_man_tone1_tone2", "👨🏻\u200d❤️\u200d👨🏼",
+	"couple_with_heart_man_man_tone1_tone3", "👨🏻\u200d❤️\u200d👨🏽",
+	"couple_with_heart_man_man_tone1_tone4", "👨🏻\u200d❤️\u200d👨🏾",
+	"couple_with_heart_man_man_tone1_tone5", "👨🏻\u200d❤️\u200d👨🏿",
+	"couple_with_heart_man_man_tone2", "👨🏼\u200d❤️\u200d👨🏼",
+	"couple_with_heart_man_man_tone2_tone1", "👨🏼\u200d❤️\u200d👨🏻",
+	"couple_with_heart_man_man_tone2_tone3", "👨🏼\u200d❤️\u200d👨🏽",
+	"couple_with_heart_man_man_tone2_tone4", "👨🏼\u200d❤️\u200d👨🏾",
+	"couple_with_heart_man_man_tone2_tone5", "👨🏼\u200d❤️\u200d👨🏿",
+	"couple_with_heart_man_man_tone3", "👨🏽\u200d❤️\u200d👨🏽",
+	"couple_with_heart_man_man_tone3_tone1", "👨🏽\u200d❤️\u200d👨🏻",
+	"couple_with_heart_man_man_tone3_tone2", "👨🏽\u200d❤️\u200d👨🏼",
+	"couple_with_heart_man_man_tone3_tone4", "👨🏽\u200d❤️\u200d👨🏾",
+	"couple_with_heart_man_man_tone3_tone5", "👨🏽\u200d❤️\u200d👨🏿",
+	"couple_with_heart_man_man_tone4", "👨🏾\u200d❤️\u200d👨🏾",
+	"couple_with_heart_man_man_tone4_tone1", "👨🏾\u200d❤️\u200d👨🏻",
+	"couple_with_heart_man_man_tone4_tone2", "👨🏾\u200d❤️\u200d👨🏼",
+	"couple_with_heart_man_man_tone4_tone3", "👨🏾\u200d❤️\u200d👨🏽",
+	"couple_with_heart_man_man_tone4_tone5", "👨🏾\u200d❤️\u200d👨🏿",
+	"couple_with_heart_man_man_tone5", "👨🏿\u200d❤️\u200d👨🏿",
+	"couple_with_heart_man_man_tone5_tone1", "👨🏿\u200d❤️\u200d👨🏻",
+	"couple_with_heart_man_man_tone5_tone2", "👨🏿\u200d❤️\u200d👨🏼",
+	"couple_with_heart_man_man_tone5_tone3", "👨🏿\u200d❤️\u200d👨🏽",
+	"couple_with_heart_man_man_tone5_tone4", "👨🏿\u200d❤️\u200d👨🏾",
+	"couple_with_heart_medium_dark_skin_tone", "💑🏾",
+	"couple_with_heart_medium_light_skin_tone", "💑🏼",
+	"couple_with_heart_medium_skin_tone", "💑🏽",
+	"couple_with_heart_mm", "👨\u200d❤️\u200d👨",
+	"couple_with_heart_person_person_dark_skin_tone_light_skin_tone", "🧑🏿\u200d❤️\u200d🧑🏻",
+	"couple_with_heart_person_person_dark_skin_tone_medium_dark_skin_tone", "🧑🏿\u200d❤️\u200d🧑🏾",
+	"couple_with_heart_person_person_dark_skin_tone_medium_light_skin_tone", "🧑🏿\u200d❤️\u200d🧑🏼",
+	"couple_with_heart_person_person_dark_skin_tone_medium_skin_tone", "🧑🏿\u200d❤️\u200d🧑🏽",
+	"couple_with_heart_person_person_light_skin_tone_dark_skin_tone", "🧑🏻\u200d❤️\u200d🧑🏿",
+	"couple_with_heart_person_person_light_skin_tone_medium_dark_skin_tone", "🧑🏻\u200d❤️\u200d🧑🏾",
+	"couple_with_heart_person_person_light_skin_tone_medium_light_skin_tone", "🧑🏻\u200d❤️\u200d🧑🏼",
+	"couple_with_heart_person_person_light_skin_tone_medium_skin_tone", "🧑🏻\u200d❤️\u200d🧑🏽",
+	"couple_with_heart_person_person_medium_dark_skin_tone_dark_skin_tone", "🧑🏾\u200d❤️\u200d🧑🏿",
+	"couple_with_heart_person_person_medium_dark_skin_tone_light_skin_tone", "🧑🏾\u200d❤️\u200d🧑🏻",
+	"couple_with_heart_person_person_medium_dark_skin_tone_medium_light_skin_tone", "🧑🏾\u200d❤️\u200d🧑🏼",
+	"couple_with_heart_person_person_medium_dark_skin_tone_medium_skin_tone", "🧑🏾\u200d❤️\u200d🧑🏽",
+	"couple_with_heart_person_person_medium_light_skin_tone_dark_skin_tone", "🧑🏼\u200d❤️\u200d🧑🏿",
+	"couple_with_heart_person_person_medium_light_skin_tone_light_skin_tone", "🧑🏼\u200d❤️\u200d🧑🏻",
+	"couple_with_heart_person_person_medium_light_skin_tone_medium_dark_skin_tone", "🧑🏼\u200d❤️\u200d🧑🏾",
+	"couple_with_heart_person_person_medium_light_skin_tone_medium_skin_tone", "🧑🏼\u200d❤️\u200d🧑🏽",
+	"couple_with_heart_person_person_medium_skin_tone_dark_skin_tone", "🧑🏽\u200d❤️\u200d🧑🏿",
+	"couple_with_heart_person_person_medium_skin_tone_light_skin_tone", "🧑🏽\u200d❤️\u200d🧑🏻",
+	"couple_with_heart_person_person_medium_skin_tone_medium_dark_skin_tone", "🧑🏽\u200d❤️\u200d🧑🏾",
+	"couple_with_heart_person_person_medium_skin_tone_medium_light_skin_tone", "🧑🏽\u200d❤️\u200d🧑🏼",
+	"couple_with_heart_person_person_tone1_tone2", "🧑🏻\u200d❤️\u200d🧑🏼",
+	"couple_with_heart_person_person_tone1_tone3", "🧑🏻\u200d❤️\u200d🧑🏽",
+	"couple_with_heart_person_person_tone1_tone4", "🧑🏻\u200d❤️\u200d🧑🏾",
+	"couple_with_heart_person_person_tone1_tone5", "🧑🏻\u200d❤️\u200d🧑🏿",
+	"couple_with_heart_person_person_tone2_tone1", "🧑🏼\u200d❤️\u200d🧑🏻",
+	"couple_with_heart_person_person_tone2_tone3", "🧑🏼\u200d❤️\u200d🧑🏽",
+	"couple_with_heart_person_person_tone2_tone4", "🧑🏼\u200d❤️\u200d🧑🏾",
+	"couple_with_heart_person_person_tone2_tone5", "🧑🏼\u200d❤️\u200d🧑🏿",
+	"couple_with_heart_person_person_tone3_tone1", "🧑🏽\u200d❤️\u200d🧑🏻",
+	"couple_with_heart_person_person_tone3_tone2", "🧑🏽\u200d❤️\u200d🧑🏼",
+	"couple_with_heart_person_person_tone3_tone4", "🧑🏽\u200d❤️\u200d🧑🏾",
+	"couple_with_heart_person_person_tone3_tone5", "🧑🏽\u200d❤️\u200d🧑🏿",
+	"couple_with_heart_person_person_tone4_tone1", "🧑🏾\u200d❤️\u200d🧑🏻",
+	"couple_with_heart_person_person_tone4_tone2", "🧑🏾\u200d❤️\u200d🧑🏼",
+	"couple_with_heart_person_person_tone4_tone3", "🧑🏾\u200d❤️\u200d🧑🏽",
+	"couple_with_heart_person_person_tone4_tone5", "🧑🏾\u200d❤️\u200d🧑🏿",
+	"couple_with_heart_person_person_tone5_tone1", "🧑🏿\u200d❤️\u200d🧑🏻",
+	"couple_with_heart_person_person_tone5_tone2", "🧑🏿\u200d❤️\u200d🧑🏼",
+	"couple_with_heart_person_person_tone5_tone3", "🧑🏿\u200d❤️\u200d🧑🏽",
+	"couple_with_heart_person_person_tone5_tone4", "🧑🏿\u200d❤️\u200d🧑🏾",
+	"couple_with_heart_tone1", "💑🏻",
+	"couple_with_heart_tone2", "💑🏼",
+	"couple_with_heart_tone3", "💑🏽",
+	"couple_with_heart_tone4", "💑🏾",
+	"couple_with_heart_tone5", "💑🏿",
+	"couple_with_heart_woman_man", "👩\u200d❤️\u200d👨",
+	"couple_with_heart_woman_man_dark_skin_tone", "👩🏿\u200d❤️\u200d👨🏿",
+	"couple_with_heart_woman_man_dark_skin_tone_light_skin_tone", "👩🏿\u200d❤️\u200d👨🏻",
+	"couple_with_heart_woman_man_dark_skin_tone_medium_dark_skin_tone", "👩🏿\u200d❤️\u200d👨🏾",
+	"couple_with_heart_woman_man_dark_skin_tone_medium_light_skin_tone", "👩🏿\u200d❤️\u200d👨🏼",
+	"couple_with_heart_woman_man_dark_skin_tone_medium_skin_tone", "👩🏿\u200d❤️\u200d👨🏽",
+	"couple_with_heart_woman_man_light_skin_tone", "👩🏻\u200d❤️\u200d👨🏻",
+	"couple_with_heart_woman_man_light_skin_tone_dark_skin_tone", "👩🏻\u200d❤️\u200d👨🏿",
+	"couple_with_heart_woman_man_light_skin_tone_medium_dark_skin_tone", "👩🏻\u200d❤️\u200d👨🏾",
+	"couple_with_heart_woman_man_light_skin_tone_medium_light_skin_tone", "👩🏻\u200d❤️\u200d👨🏼",
+	"couple_with_heart_woman_man_light_skin_tone_medium_skin_tone", "👩🏻\u200d❤️\u200d👨🏽",
+	"couple_with_heart_woman_man_medium_dark_skin_tone", "👩🏾\u200d❤️\u200d👨🏾",
+	"couple_with_heart_woman_man_medium_dark_skin_tone_dark_skin_tone", "👩🏾\u200d❤️\u200d👨🏿",
+	"couple_with_heart_woman_man_medium_dark_skin_tone_light_skin_tone", "👩🏾\u200d❤️\u200d👨🏻",
+	"couple_with_heart_woman_man_medium_dark_skin_tone_medium_light_skin_tone", "👩🏾\u200d❤️\u200d👨🏼",
+	"couple_with_heart_woman_man_medium_dark_skin_tone_medium_skin_tone", "👩🏾\u200d❤️\u200d👨🏽",
+	"couple_with_heart_woman_man_medium_light_skin_tone", "👩🏼\u200d❤️\u200d👨🏼",
+	"couple_with_heart_woman_man_medium_light_skin_tone_dark_skin_tone", "👩🏼\u200d❤️\u200d👨🏿",
+	"couple_with_heart_woman_man_medium_light_skin_tone_light_skin_tone", "👩🏼\u200d❤️\u200d👨🏻",
+	"couple_with_heart_woman_man_medium_light_skin_tone_medium_dark_skin_tone", "👩🏼\u200d❤️\u200d👨🏾",
+	"couple_with_heart_woman_man_medium_light_skin_tone_medium_skin_tone", "👩🏼\u200d❤️\u200d👨🏽",
+	"couple_with_heart_woman_man_medium_skin_tone", "👩🏽\u200d❤️\u200d👨🏽",
+	"couple_with_heart_woman_man_medium_skin_tone_dark_skin_tone", "👩🏽\u200d❤️\u200d👨🏿",
+	"couple_with_heart_woman_man_medium_skin_tone_light_skin_tone", "👩🏽\u200d❤️\u200d👨🏻",
+	"couple_with_heart_woman_man_medium_skin_tone_medium_dark_skin_tone", "👩🏽\u200d❤️\u200d👨🏾",
+	"couple_with_heart_woman_man_medium_skin_tone_medium_light_skin_tone", "👩🏽\u200d❤️\u200d👨🏼",
+	"couple_with_heart_woman_man_tone1", "👩🏻\u200d❤️\u200d👨🏻",
+	"couple_with_heart_woman_man_tone1_tone2", "👩🏻\u200d❤️\u200d👨🏼",
+	"couple_with_heart_woman_man_tone1_tone3", "👩🏻\u200d❤️\u200d👨🏽",
+	"couple_with_heart_woman_man_tone1_tone4", "👩🏻\u200d❤️\u200d👨🏾",
+	"couple_with_heart_woman_man_tone1_tone5", "👩🏻\u200d❤️\u200d👨🏿",
+	"couple_with_heart_woman_man_tone2", "👩🏼\u200d❤️\u200d👨🏼",
+	"couple_with_heart_woman_man_tone2_tone1", "👩🏼\u200d❤️\u200d👨🏻",
+	"couple_with_heart_woman_man_tone2_tone3", "👩🏼\u200d❤️\u200d👨🏽",
+	"couple_with_heart_woman_man_tone2_tone4", "👩🏼\u200d❤️\u200d👨🏾",
+	"couple_with_heart_woman_man_tone2_tone5", "👩🏼\u200d❤️\u200d👨🏿",
+	"couple_with_heart_woman_man_tone3", "👩🏽\u200d❤️\u200d👨🏽",
+	"couple_with_heart_woman_man_tone3_tone1", "👩🏽\u200d❤️\u200d👨🏻",
+	"couple_with_heart_woman_man_tone3_tone2", "👩🏽\u200d❤️\u200d👨🏼",
+	"couple_with_heart_woman_man_tone3_tone4", "👩🏽\u200d❤️\u200d👨🏾",
+	"couple_with_heart_woman_man_tone3_tone5", "👩🏽\u200d❤️\u200d👨🏿",
+	"couple_with_heart_woman_man_tone4", "👩🏾\u200d❤️\u200d👨🏾",
+	"couple_with_heart_woman_man_tone4_tone1", "👩🏾\u200d❤️\u200d👨🏻",
+	"couple_with_heart_woman_man_tone4_tone2", "👩🏾\u200d❤️\u200d👨🏼",
+	"couple_with_heart_woman_man_tone4_tone3", "👩🏾\u200d❤️\u200d👨🏽",
+	"couple_with_heart_woman_man_tone4_tone5", "👩🏾\u200d❤️\u200d👨🏿",
+	"couple_with_heart_woman_man_tone5", "👩🏿\u200d❤️\u200d👨🏿",
+	"couple_with_heart_woman_man_tone5_tone1", "👩🏿\u200d❤️\u200d👨🏻",
+	"couple_with_heart_woman_man_tone5_tone2", "👩🏿\u200d❤️\u200d👨🏼",
+	"couple_with_heart_woman_man_tone5_tone3", "👩🏿\u200d❤️\u200d👨🏽",
+	"couple_with_heart_woman_man_tone5_tone4", "👩🏿\u200d❤️\u200d👨🏾",
+	"couple_with_heart_woman_woman_dark_skin_tone", "👩🏿\u200d❤️\u200d👩🏿",
+	"couple_with_heart_woman_woman_dark_skin_tone_light_skin_tone", "👩🏿\u200d❤️\u200d👩🏻",
+	"couple_with_heart_woman_woman_dark_skin_tone_medium_dark_skin_tone", "👩🏿\u200d❤️\u200d👩🏾",
+	"couple_with_heart_woman_woman_dark_skin_tone_medium_light_skin_tone", "👩🏿\u200d❤️\u200d👩🏼",
+	"couple_with_heart_woman_woman_dark_skin_tone_medium_skin_tone", "👩🏿\u200d❤️\u200d👩🏽",
+	"couple_with_heart_woman_woman_light_skin_tone", "👩🏻\u200d❤️\u200d👩🏻",
+	"couple_with_heart_woman_woman_light_skin_tone_dark_skin_tone", "👩🏻\u200d❤️\u200d👩🏿",
+	"couple_with_heart_woman_woman_light_skin_tone_medium_dark_skin_tone", "👩🏻\u200d❤️\u200d👩🏾",
+	"couple_with_heart_woman_woman_light_skin_tone_medium_light_skin_tone", "👩🏻\u200d❤️\u200d👩🏼",
+	"couple_with_heart_woman_woman_light_skin_tone_medium_skin_tone", "👩🏻\u200d❤️\u200d👩🏽",
+	"couple_with_heart_woman_woman_medium_dark_skin_tone", "👩🏾\u200d❤️\u200d👩🏾",
+	"couple_with_heart_woman_woman_medium_dark_skin_tone_dark_skin_tone", "👩🏾\u200d❤️\u200d👩🏿",
+	"couple_with_heart_woman_woman_medium_dark_skin_tone_light_skin_tone", "👩🏾\u200d❤️\u200d👩🏻",
+	"couple_with_heart_woman_woman_medium_dark_skin_tone_medium_light_skin_tone", "👩🏾\u200d❤️\u200d👩🏼",
+	"couple_with_heart_woman_woman_medium_dark_skin_tone_medium_skin_tone", "👩🏾\u200d❤️\u200d👩🏽",
+	"couple_with_heart_woman_woman_medium_light_skin_tone", "👩🏼\u200d❤️\u200d👩🏼",
+	"couple_with_heart_woman_woman_medium_light_skin_tone_dark_skin_tone", "👩🏼\u200d❤️\u200d👩🏿",
+	"couple_with_heart_woman_woman_medium_light_skin_tone_light_skin_tone", "👩🏼\u200d❤️\u200d👩🏻",
+	"couple_with_heart_woman_woman_medium_light_skin_tone_medium_dark_skin_tone", "👩🏼\u200d❤️\u200d👩🏾",
+	"couple_with_heart_woman_woman_medium_light_skin_tone_medium_skin_tone", "👩🏼\u200d❤️\u200d👩🏽",
+	"couple_with_heart_woman_woman_medium_skin_tone", "👩🏽\u200d❤️\u200d👩🏽",
+	"couple_with_heart_woman_woman_medium_skin_tone_dark_skin_tone", "👩🏽\u200d❤️\u200d👩🏿",
+	"couple_with_heart_woman_woman_medium_skin_tone_light_skin_tone", "👩🏽\u200d❤️\u200d👩🏻",
+	"couple_with_heart_woman_woman_medium_skin_tone_medium_dark_skin_tone", "👩🏽\u200d❤️\u200d👩🏾",
+	"couple_with_heart_woman_woman_medium_skin_tone_medium_light_skin_tone", "👩🏽\u200d❤️\u200d👩🏼",
+	"couple_with_heart_woman_woman_tone1", "👩🏻\u200d❤️\u200d👩🏻",
+	"couple_with_heart_woman_woman_tone1_tone2", "👩🏻\u200d❤️\u200d👩🏼",
+	"couple_with_heart_woman_woman_tone1_tone3", "👩🏻\u200d❤️\u200d👩🏽",
+	"couple_with_heart_woman_woman_tone1_tone4", "👩🏻\u200d❤️\u200d👩🏾",
+	"couple_with_heart_woman_woman_tone1_tone5", "👩🏻\u200d❤️\u200d👩🏿",
+	"couple_with_heart_woman_woman_tone2", "👩🏼\u200d❤️\u200d👩🏼",
+	"couple_with_heart_woman_woman_tone2_tone1", "👩🏼\u200d❤️\u200d👩🏻",
+	"couple_with_heart_woman_woman_tone2_tone3", "👩🏼\u200d❤️\u200d👩🏽",
+	"couple_with_heart_woman_woman_tone2_tone4", "👩🏼\u200d❤️\u200d👩🏾",
+	"couple_with_heart_woman_woman_tone2_tone5", "👩🏼\u200d❤️\u200d👩🏿",
+	"couple_with_heart_woman_woman_tone3", "👩🏽\u200d❤️\u200d👩🏽",
+	"couple_with_heart_woman_woman_tone3_tone1", "👩🏽\u200d❤️\u200d👩🏻",
+	"couple_with_heart_woman_woman_tone3_tone2", "👩🏽\u200d❤️\u200d👩🏼",
+	"couple_with_heart_woman_woman_tone3_tone4", "👩🏽\u200d❤️\u200d👩🏾",
+	"couple_with_heart_woman_woman_tone3_tone5", "👩🏽\u200d❤️\u200d👩🏿",
+	"couple_with_heart_woman_woman_tone4", "👩🏾\u200d❤️\u200d👩🏾",
+	"couple_with_heart_woman_woman_tone4_tone1", "👩🏾\u200d❤️\u200d👩🏻",
+	"couple_with_heart_woman_woman_tone4_tone2", "👩🏾\u200d❤️\u200d👩🏼",
+	"couple_with_heart_woman_woman_tone4_tone3", "👩🏾\u200d❤️\u200d👩🏽",
+	"couple_with_heart_woman_woman_tone4_tone5", "👩🏾\u200d❤️\u200d👩🏿",
+	"couple_with_heart_woman_woman_tone5", "👩🏿\u200d❤️\u200d👩🏿",
+	"couple_with_heart_woman_woman_tone5_tone1", "👩🏿\u200d❤️\u200d👩🏻",
+	"couple_with_heart_woman_woman_tone5_tone2", "👩🏿\u200d❤️\u200d👩🏼",
+	"couple_with_heart_woman_woman_tone5_tone3", "👩🏿\u200d❤️\u200d👩🏽",
+	"couple_with_heart_woman_woman_tone5_tone4", "👩🏿\u200d❤️\u200d👩🏾",
+	"couple_with_heart_ww", "👩\u200d❤️\u200d👩",
+	"couple_ww", "👩\u200d❤️\u200d👩",
+	"couplekiss", "💏",
+	"couplekiss_mm", "👨\u200d❤️\u200d💋\u200d👨",
+	"couplekiss_ww", "👩\u200d❤️\u200d💋\u200d👩",
+	"cow", "🐮",
+	"cow2", "🐄",
+	"cowboy", "🤠",
+	"crab", "🦀",
+	"crayon", "🖍️",
+	"credit_card", "💳",
+	"crescent_moon", "🌙",
+	"cricket", "🦗",
+	"cricket_bat_ball", "🏏",
+	"cricket_game", "🏏",
+	"crocodile", "🐊",
+	"croissant", "🥐",
+	"cross", "✝️",
+	"crossed_flags", "🎌",
+	"crossed_swords", "⚔️",
+	"crown", "👑",
+	"cruise_ship", "🛳️",
+	"crutch", "🩼",
+	"cry", "😢",
+	"crying_cat_face", "😿",
+	"crystal_ball", "🔮",
+	"cucumber", "🥒",
+	"cup_with_straw", "🥤",
+	"cupcake", "🧁",
+	"cupid", "💘",
+	"curling_stone", "🥌",
+	"curly_loop", "➰",
+	"currency_exchange", "💱",
+	"curry", "🍛",
+	"custard", "🍮",
+	"customs", "🛃",
+	"cut_of_meat", "🥩",
+	"cyclone", "🌀",
+	"dagger", "🗡️",
+	"dagger_knife", "🗡️",
+	"dancer", "💃",
+	"dancer_tone1", "💃🏻",
+	"dancer_tone2", "💃🏼",
+	"dancer_tone3", "💃🏽",
+	"dancer_tone4", "💃🏾",
+	"dancer_tone5", "💃🏿",
+	"dancers", "👯",
+	"dango", "🍡",
+	"dark_sunglasses", "🕶️",
+	"dart", "🎯",
+	"dash", "💨",
+	"date", "📅",
+	"deaf_man", "🧏\u200d♂️",
+	"deaf_man_dark_skin_tone", "🧏🏿\u200d♂️",
+	"deaf_man_light_skin_tone", "🧏🏻\u200d♂️",
+	"deaf_man_medium_dark_skin_tone", "🧏🏾\u200d♂️",
+	"deaf_man_medium_light_skin_tone", "🧏🏼\u200d♂️",
+	"deaf_man_medium_skin_tone", "🧏🏽\u200d♂️",
+	"deaf_man_tone1", "🧏🏻\u200d♂️",
+	"deaf_man_tone2", "🧏🏼\u200d♂️",
+	"deaf_man_tone3", "🧏🏽\u200d♂️",
+	"deaf_man_tone4", "🧏🏾\u200d♂️",
+	"deaf_man_tone5", "🧏🏿\u200d♂️",
+	"deaf_person", "🧏",
+	"deaf_person_dark_skin_tone", "🧏🏿",
+	"deaf_person_light_skin_tone", "🧏🏻",
+	"deaf_person_medium_dark_skin_tone", "🧏🏾",
+	"deaf_person_medium_light_skin_tone", "🧏🏼",
+	"deaf_person_medium_skin_tone", "🧏🏽",
+	"deaf_person_tone1", "🧏🏻",
+	"deaf_person_tone2", "🧏🏼",
+	"deaf_person_tone3", "🧏🏽",
+	"deaf_person_tone4", "🧏🏾",
+	"deaf_person_tone5", "🧏🏿",
+	"deaf_woman", "🧏\u200d♀️",
+	"deaf_woman_dark_skin_tone", "🧏🏿\u200d♀️",
+	"deaf_woman_light_skin_tone", "🧏🏻\u200d♀️",
+	"deaf_woman_medium_dark_skin_tone", "🧏🏾\u200d♀️",
+	"deaf_woman_medium_light_skin_tone", "🧏🏼\u200d♀️",
+	"deaf_woman_medium_skin_tone", "🧏🏽\u200d♀️",
+	"deaf_woman_tone1", "🧏🏻\u200d♀️",
+	"deaf_woman_tone2", "🧏🏼\u200d♀️",
+	"deaf_woman_tone3", "🧏🏽\u200d♀️",
+	"deaf_woman_tone4", "🧏🏾\u200d♀️",
+	"deaf_woman_tone5", "🧏🏿\u200d♀️",
+	"deciduous_tree", "🌳",
+	"deer", "🦌",
+	"department_store", "🏬",
+	"derelict_house_building", "🏚️",
+	"desert", "🏜️",
+	"desert_island", "🏝️",
+	"desktop", "🖥️",
+	"desktop_computer", "🖥️",
+	"detective", "🕵️",
+	"detective_tone1", "🕵🏻",
+	"detective_tone2", "🕵🏼",
+	"detective_tone3", "🕵🏽",
+	"detective_tone4", "🕵🏾",
+	"detective_tone5", "🕵🏿",
+	"diamond_shape_with_a_dot_inside", "💠",
+	"diamonds", "♦️",
+	"disappointed", "😞",
+	"disappointed_relieved", "😥",
+	"disguised_face", "🥸",
+	"dividers", "🗂️",
+	"diving_mask", "🤿",
+	"diya_lamp", "🪔",
+	"dizzy", "💫",
+	"dizzy_face", "😵",
+	"dna", "🧬",
+	"do_not_litter", "🚯",
+	"dodo", "🦤",
+	"dog", "🐶",
+	"dog2", "🐕",
+	"dollar", "💵",
+	"dolls", "🎎",
+	"dolphin", "🐬",
+	"door", "🚪",
+	"dotted_line_face", "🫥",
+	"double_vertical_bar", "⏸️",
+	"doughnut", "🍩",
+	"dove", "🕊️",
+	"dove_of_peace", "🕊️",
+	"dragon", "🐉",
+	"dragon_face", "🐲",
+	"dress", "👗",
+	"dromedary_camel", "🐪",
+	"drool", "🤤",
+	"drooling_face", "🤤",
+	"drop_of_blood", "🩸",
+	"droplet", "💧",
+	"drum", "🥁",
+	"drum_with_drumsticks", "🥁",
+	"duck", "🦆",
+	"dumpling", "🥟",
+	"dvd", "📀",
+	"e_mail", "📧",
+	"eagle", "🦅",
+	"ear", "👂",
+	"ear_of_rice", "🌾",
+	"ear_tone1", "👂🏻",
+	"ear_tone2", "👂🏼",
+	"ear_tone3", "👂🏽",
+	"ear_tone4", "👂🏾",
+	"ear_tone5", "👂🏿",
+	"ear_with_hearing_aid", "🦻",
+	"ear_with_hearing_aid_dark_skin_tone", "🦻🏿",
+	"ear_with_hearing_aid_light_skin_tone", "🦻🏻",
+	"ear_with_hearing_aid_medium_dark_skin_tone", "🦻🏾",
+	"ear_with_hearing_aid_medium_light_skin_tone", "🦻🏼",
+	"ear_with_hearing_aid_medium_skin_tone", "🦻🏽",
+	"ear_with_hearing_aid_tone1", "🦻🏻",
+	"ear_with_hearing_aid_tone2", "🦻🏼",
+	"ear_with_hearing_aid_tone3", "🦻🏽",
+	"ear_with_hearing_aid_tone4", "🦻🏾",
+	"ear_with_hearing_aid_tone5", "🦻🏿",
+	"earth_africa", "🌍",
+	"earth_americas", "🌎",
+	"earth_asia", "🌏",
+	"egg", "🥚",
+	"eggplant", "🍆",
+	"eight", "8️⃣",
+	"eight_pointed_black_star", "✴️",
+	"eight_spoked_asterisk", "✳️",
+	"eject", "⏏️",
+	"eject_symbol", "⏏️",
+	"electric_plug", "🔌",
+	"elephant", "🐘",
+	"elevator", "🛗",
+	"elf", "🧝",
+	"elf_dark_skin_tone", "🧝🏿",
+	"elf_light_skin_tone", "🧝🏻",
+	"elf_medium_dark_skin_tone", "🧝🏾",
+	"elf_medium_light_skin_tone", "🧝🏼",
+	"elf_medium_skin_tone", "🧝🏽",
+	"elf_tone1", "🧝🏻",
+	"elf_tone2", "🧝🏼",
+	"elf_tone3", "🧝🏽",
+	"elf_tone4", "🧝🏾",
+	"elf_tone5", "🧝🏿",
+	"email", "📧",
+	"empty_nest", "🪹",
+	"end", "🔚",
+	"england", "🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f",
+	"envelope", "✉️",
+	"envelope_with_arrow", "📩",
+	"euro", "💶",
+	"european_castle", "🏰",
+	"european_post_office", "🏤",
+	"evergreen_tree", "🌲",
+	"exclamation", "❗",
+	"expecting_woman", "🤰",
+	"expecting_woman_tone1", "🤰🏻",
+	"expecting_woman_tone2", "🤰🏼",
+	"expecting_woman_tone3", "🤰🏽",
+	"expecting_woman_tone4", "🤰🏾",
+	"expecting_woman_tone5", "🤰🏿",
+	"exploding_head", "🤯",
+	"expressionless", "😑",
+	"eye", "👁️",
+	"eye_in_speech_bubble", "👁\u200d🗨",
+	"eyeglasses", "👓",
+	"eyes", "👀",
+	"face_exhaling", "😮\u200d💨",
+	"face_holding_back_tears", "🥹",
+	"face_in_clouds", "😶\u200d🌫️",
+	"face_palm", "🤦",
+	"face_palm_tone1", "🤦🏻",
+	"face_palm_tone2", "🤦🏼",
+	"face_palm_tone3", "🤦🏽",
+	"face_palm_tone4", "🤦🏾",
+	"face_palm_tone5", "🤦🏿",
+	"face_vomiting", "🤮",
+	"face_with_cowboy_hat", "🤠",
+	"face_with_diagonal_mouth", "🫤",
+	"face_with_hand_over_mouth", "🤭",
+	"face_with_head_bandage", "🤕",
+	"face_with_monocle", "🧐",
+	"face_with_open_eyes_and_hand_over_mouth", "🫢",
+	"face_with_peeking_eye", "🫣",
+	"face_with_raised_eyebrow", "🤨",
+	"face_with_rolling_eyes", "🙄",
+	"face_with_spiral_eyes", "😵\u200d💫",
+	"face_with_symbols_over_mouth", "🤬",
+	"face_with_thermometer", "🤒",
+	"facepalm", "🤦",
+	"facepalm_tone1", "🤦🏻",
+	"facepalm_tone2", "🤦🏼",
+	"facepalm_tone3", "🤦🏽",
+	"facepalm_tone4", "🤦🏾",
+	"facepalm_tone5", "🤦🏿",
+	"factory", "🏭",
+	"factory_worker", "🧑\u200d🏭",
+	"factory_worker_dark_skin_tone", "🧑🏿\u200d🏭",
+	"factory_worker_light_skin_tone", "🧑🏻\u200d🏭",
+	"factory_worker_medium_dark_skin_tone", "🧑🏾\u200d🏭",
+	"factory_worker_medium_light_skin_tone", "🧑🏼\u200d🏭",
+	"factory_worker_medium_skin_tone", "🧑🏽\u200d🏭",
+	"factory_worker_tone1", "🧑🏻\u200d🏭",
+	"factory_worker_tone2", "🧑🏼\u200d🏭",
+	"factory_worker_tone3", "🧑🏽\u200d🏭",
+	"factory_worker_tone4", "🧑🏾\u200d🏭",
+	"factory_worker_tone5", "🧑🏿\u200d🏭",
+	"fairy", "🧚",
+	"fairy_dark_skin_tone", "🧚🏿",
+	"fairy_light_skin_tone", "🧚🏻",
+	"fairy_medium_dark_skin_tone", "🧚🏾",
+	"fairy_medium_light_skin_tone", "🧚🏼",
+	"fairy_medium_skin_tone", "🧚🏽",
+	"fairy_tone1", "🧚🏻",
+	"fairy_tone2", "🧚🏼",
+	"fairy_tone3", "🧚🏽",
+	"fairy_tone4", "🧚🏾",
+	"fairy_tone5", "🧚🏿",
+	"falafel", "🧆",
+	"fallen_leaf", "🍂",
+	"family", "👪",
+	"family_man_boy", "👨\u200d👦",
+	"family_man_boy_boy", "👨\u200d👦\u200d👦",
+	"family_man_girl", "👨\u200d👧",
+	"family_man_girl_boy", "👨\u200d👧\u200d👦",
+	"family_man_girl_girl", "👨\u200d👧\u200d👧",
+	"family_man_woman_boy", "👨\u200d👩\u200d👦",
+	"family_mmb", "👨\u200d👨\u200d👦",
+	"family_mmbb", "👨\u200d👨\u200d👦\u200d👦",
+	"family_mmg", "👨\u200d👨\u200d👧",
+	"family_mmgb", "👨\u200d👨\u200d👧\u200d👦",
+	"family_mmgg", "👨\u200d👨\u200d👧\u200d👧",
+	"family_mwbb", "👨\u200d👩\u200d👦\u200d👦",
+	"family_mwg", "👨\u200d👩\u200d👧",
+	"family_mwgb", "👨\u200d👩\u200d👧\u200d👦",
+	"family_mwgg", "👨\u200d👩\u200d👧\u200d👧",
+	"family_woman_boy", "👩\u200d👦",
+	"family_woman_boy_boy", "👩\u200d👦\u200d👦",
+	"family_woman_girl", "👩\u200d👧",
+	"family_woman_girl_boy", "👩\u200d👧\u200d👦",
+	"family_woman_girl_girl", "👩\u200d👧\u200d👧",
+	"family_wwb", "👩\u200d👩\u200d👦",
+	"family_wwbb", "👩\u200d👩\u200d👦\u200d👦",
+	"family_wwg", "👩\u200d👩\u200d👧",
+	"family_wwgb", "👩\u200d👩\u200d👧\u200d👦",
+	"family_wwgg", "👩\u200d👩\u200d👧\u200d👧",
+	"farmer", "🧑\u200d🌾",
+	"farmer_dark_skin_tone", "🧑🏿\u200d🌾",
+	"farmer_light_skin_tone", "🧑🏻\u200d🌾",
+	"farmer_medium_dark_skin_tone", "🧑🏾\u200d🌾",
+	"farmer_medium_light_skin_tone", "🧑🏼\u200d🌾",
+	"farmer_medium_skin_tone", "🧑🏽\u200d🌾",
+	"farmer_tone1", "🧑🏻\u200d🌾",
+	"farmer_tone2", "🧑🏼\u200d🌾",
+	"farmer_tone3", "🧑🏽\u200d🌾",
+	"farmer_tone4", "🧑🏾\u200d🌾",
+	"farmer_tone5", "🧑🏿\u200d🌾",
+	"fast_forward", "⏩",
+	"fax", "📠",
+	"fearful", "😨",
+	"feather", "🪶",
+	"feet", "🐾",
+	"female_sign", "♀️",
+	"fencer", "🤺",
+	"fencing", "🤺",
+	"ferris_wheel", "🎡",
+	"ferry", "⛴️",
+	"field_hockey", "🏑",
+	"file_cabinet", "🗄️",
+	"file_folder", "📁",
+	"film_frames", "🎞️",
+	"film_projector", "📽️",
+	"fingers_crossed", "🤞",
+	"fingers_crossed_tone1", "🤞🏻",
+	"fingers_crossed_tone2", "🤞🏼",
+	"fingers_crossed_tone3", "🤞🏽",
+	"fingers_crossed_tone4", "🤞🏾",
+	"fingers_crossed_tone5", "🤞🏿",
+	"fire", "🔥",
+	"fire_engine", "🚒",
+	"fire_extinguisher", "🧯",
+	"firecracker", "🧨",
+	"firefighter", "🧑\u200d🚒",
+	"firefighter_dark_skin_tone", "🧑🏿\u200d🚒",
+	"firefighter_light_skin_tone", "🧑🏻\u200d🚒",
+	"firefighter_medium_dark_skin_tone", "🧑🏾\u200d🚒",
+	"firefighter_medium_light_skin_tone", "🧑🏼\u200d🚒",
+	"firefighter_medium_skin_tone", "🧑🏽\u200d🚒",
+	"firefighter_tone1", "🧑🏻\u200d🚒",
+	"firefighter_tone2", "🧑🏼\u200d🚒",
+	"firefighter_tone3", "🧑🏽\u200d🚒",
+	"firefighter_tone4", "🧑🏾\u200d🚒",
+	"firefighter_tone5", "🧑🏿\u200d🚒",
+	"fireworks", "🎆",
+	"first_place", "🥇",
+	"first_place_medal", "🥇",
+	"first_quarter_moon", "🌓",
+	"first_quarter_moon_with_face", "🌛",
+	"fish", "🐟",
+	"fish_cake", "🍥",
+	"fishing_pole_and_fish", "🎣",
+	"fist", "✊",
+	"fist_tone1", "✊🏻",
+	"fist_tone2", "✊🏼",
+	"fist_tone3", "✊🏽",
+	"fist_tone4", "✊🏾",
+	"fist_tone5", "✊🏿",
+	"five", "5️⃣",
+	"flag_ac", "🇦🇨",
+	"flag_ad", "🇦🇩",
+	"flag_ae", "🇦🇪",
+	"flag_af", "🇦🇫",
+	"flag_ag", "🇦🇬",
+	"flag_ai", "🇦🇮",
+	"flag_al", "🇦🇱",
+	"flag_am", "🇦🇲",
+	"flag_ao", "🇦🇴",
+	"flag_aq", "🇦🇶",
+	"flag_ar", "🇦🇷",
+	"flag_as", "🇦🇸",
+	"flag_at", "🇦🇹",
+	"flag_au", "🇦🇺",
+	"flag_aw", "🇦🇼",
+	"flag_ax", "🇦🇽",
+	"flag_az", "🇦🇿",
+	"flag_ba", "🇧🇦",
+	"flag_bb", "🇧🇧",
+	"flag_bd", "🇧🇩",
+	"flag_be", "🇧🇪",
+	"flag_bf", "🇧🇫",
+	"flag_bg", "🇧🇬",
+	"flag_bh", "🇧🇭",
+	"flag_bi", "🇧🇮",
+	"flag_bj", "🇧🇯",
+	"flag_bl", "🇧🇱",
+	"flag_black", "🏴",
+	"flag_bm", "🇧🇲",
+	"flag_bn", "🇧🇳",
+	"flag_bo", "🇧🇴",
+	"flag_bq", "🇧🇶",
+	"flag_br", "🇧🇷",
+	"flag_bs", "🇧🇸",
+	"flag_bt", "🇧🇹",
+	"flag_bv", "🇧🇻",
+	"flag_bw", "🇧🇼",
+	"flag_by", "🇧🇾",
+	"flag_bz", "🇧🇿",
+	"flag_ca", "🇨🇦",
+	"flag_cc", "🇨🇨",
+	"flag_cd", "🇨🇩",
+	"flag_cf", "🇨🇫",
+	"flag_cg", "🇨🇬",
+	"flag_ch", "🇨🇭",
+	"flag_ci", "🇨🇮",
+	"flag_ck", "🇨🇰",
+	"flag_cl", "🇨🇱",
+	"flag_cm", "🇨🇲",
+	"flag_cn", "🇨🇳",
+	"flag_co", "🇨🇴",
+	"flag_cp", "🇨🇵",
+	"flag_cr", "🇨🇷",
+	"flag_cu", "🇨🇺",
+	"flag_cv", "🇨🇻",
+	"flag_cw", "🇨🇼",
+	"flag_cx", "🇨🇽",
+	"flag_cy", "🇨🇾",
+	"flag_cz", "🇨🇿",
+	"flag_de", "🇩🇪",
+	"flag_dg", "🇩🇬",
+	"flag_dj", "🇩🇯",
+	"flag_dk", "🇩🇰",
+	"flag_dm", "🇩🇲",
+	"flag_do", "🇩🇴",
+	"flag_dz", "🇩🇿",
+	"flag_ea", "🇪🇦",
+	"flag_ec", "🇪🇨",
+	"flag_ee", "🇪🇪",
+	"flag_eg", "🇪🇬",
+	"flag_eh", "🇪🇭",
+	"flag_er", "🇪🇷",
+	"flag_es", "🇪🇸",
+	"flag_et", "🇪🇹",
+	"flag_eu", "🇪🇺",
+	"flag_fi", "🇫🇮",
+	"flag_fj", "🇫🇯",
+	"flag_fk", "🇫🇰",
+	"flag_fm", "🇫🇲",
+	"flag_fo", "🇫🇴",
+	"flag_fr", "🇫🇷",
+	"flag_ga", "🇬🇦",
+	"flag_gb", "🇬🇧",
+	"flag_gd", "🇬🇩",
+	"flag_ge", "🇬🇪",
+	"flag_gf", "🇬🇫",
+	"flag_gg", "🇬🇬",
+	"flag_gh", "🇬🇭",
+	"flag_gi", "🇬🇮",
+	"flag_gl", "🇬🇱",
+	"flag_gm", "🇬🇲",
+	"flag_gn", "🇬🇳",
+	"flag_gp", "🇬🇵",
+	"flag_gq", "🇬🇶",
+	"flag_gr", "🇬🇷",
+	"flag_gs", "🇬🇸",
+	"flag_gt", "🇬🇹",
+	"flag_gu", "🇬🇺",
+	"flag_gw", "🇬🇼",
+	"flag_gy", "🇬🇾",
+	"flag_hk", "🇭🇰",
+	"flag_hm", "🇭🇲",
+	"flag_hn", "🇭🇳",
+	"flag_hr", "🇭🇷",
+	"flag_ht", "🇭🇹",
+	"flag_hu", "🇭🇺",
+	"flag_ic", "🇮🇨",
+	"flag_id", "🇮🇩",
+	"flag_ie", "🇮🇪",
+	"flag_il", "🇮🇱",
+	"flag_im", "🇮🇲",
+	"flag_in", "🇮🇳",
+	"flag_io", "🇮🇴",
+	"flag_iq", "🇮🇶",
+	"flag_ir", "🇮🇷",
+	"flag_is", "🇮🇸",
+	"flag_it", "🇮🇹",
+	"flag_je", "🇯🇪",
+	"flag_jm", "🇯🇲",
+	"flag_jo", "🇯🇴",
+	"flag_jp", "🇯🇵",
+	"flag_ke", "🇰🇪",
+	"flag_kg", "🇰🇬",
+	"flag_kh", "🇰🇭",
+	"flag_ki", "🇰🇮",
+	"flag_km", "🇰🇲",
+	"flag_kn", "🇰🇳",
+	"flag_kp", "🇰🇵",
+	"flag_kr", "🇰🇷",
+	"flag_kw", "🇰🇼",
+	"flag_ky", "🇰🇾",
+	"flag_kz", "🇰🇿",
+	"flag_la", "🇱🇦",
+	"flag_lb", "🇱🇧",
+	"flag_lc", "🇱🇨",
+	"flag_li", "🇱🇮",
+	"flag_lk", "🇱🇰",
+	"flag_lr", "🇱🇷",
+	"flag_ls", "🇱🇸",
+	"flag_lt", "🇱🇹",
+	"flag_lu", "🇱🇺",
+	"flag_lv", "🇱🇻",
+	"flag_ly", "🇱🇾",
+	"flag_ma", "🇲🇦",
+	"flag_mc", "🇲🇨",
+	"flag_md", "🇲🇩",
+	"flag_me", "🇲🇪",
+	"flag_mf", "🇲🇫",
+	"flag_mg", "🇲🇬",
+	"flag_mh", "🇲🇭",
+	"flag_mk", "🇲🇰",
+	"flag_ml", "🇲🇱",
+	"flag_mm", "🇲🇲",
+	"flag_mn", "🇲🇳",
+	"flag_mo", "🇲🇴",
+	"flag_mp", "🇲🇵",
+	"flag_mq", "🇲🇶",
+	"flag_mr", "🇲🇷",
+	"flag_ms", "🇲🇸",
+	"flag_mt", "🇲🇹",
+	"flag_mu", "🇲🇺",
+	"flag_mv", "🇲🇻",
+	"flag_mw", "🇲🇼",
+	"flag_mx", "🇲🇽",
+	"flag_my", "🇲🇾",
+	"flag_mz", "🇲🇿",
+	"flag_na", "🇳🇦",
+	"flag_nc", "🇳🇨",
+	"flag_ne", "🇳🇪",
+	"flag_nf", "🇳🇫",
+	"flag_ng", "🇳🇬",
+	"flag_ni", "🇳🇮",
+	"flag_nl", "🇳🇱",
+	"flag_no", "🇳🇴",
+	"flag_np", "🇳🇵",
+	"flag_nr", "🇳🇷",
+	"flag_nu", "🇳🇺",
+	"flag_nz", "🇳🇿",
+	"flag_om", "🇴🇲",
+	"flag_pa", "🇵🇦",
+	"flag_pe", "🇵🇪",
+	"flag_pf", "🇵🇫",
+	"flag_pg", "🇵🇬",
+	"flag_ph", "🇵🇭",
+	"flag_pk", "🇵🇰",
+	"flag_pl", "🇵🇱",
+	"flag_pm", "🇵🇲",
+	"flag_pn", "🇵🇳",
+	"flag_pr", "🇵🇷",
+	"flag_ps", "🇵🇸",
+	"flag_pt", "🇵🇹",
+	"flag_pw", "🇵🇼",
+	"flag_py", "🇵🇾",
+	"flag_qa", "🇶🇦",
+	"flag_re", "🇷🇪",
+	"flag_ro", "🇷🇴",
+	"flag_rs", "🇷🇸",
+	"flag_ru", "🇷🇺",
+	"flag_rw", "🇷🇼",
+	"flag_sa", "🇸🇦",
+	"flag_sb", "🇸🇧",
+	"flag_sc", "🇸🇨",
+	"flag_sd", "🇸🇩",
+	"flag_se", "🇸🇪",
+	"flag_sg", "🇸🇬",
+	"flag_sh", "🇸🇭",
+	"flag_si", "🇸🇮",
+	"flag_sj", "🇸🇯",
+	"flag_sk", "🇸🇰",
+	"flag_sl", "🇸🇱",
+	"flag_sm", "🇸🇲",
+	"flag_sn", "🇸🇳",
+	"flag_so", "🇸🇴",
+	"flag_sr", "🇸🇷",
+	"flag_ss", "🇸🇸",
+	"flag_st", "🇸🇹",
+	"flag_sv", "🇸🇻",
+	"flag_sx", "🇸🇽",
+	"flag_sy", "🇸🇾",
+	"flag_sz", "🇸🇿",
+	"flag_ta", "🇹🇦",
+	"flag_tc", "🇹🇨",
+	"flag_td", "🇹🇩",
+	"flag_tf", "🇹🇫",
+	"flag_tg", "🇹🇬",
+	"flag_th", "🇹🇭",
+	"flag_tj", "🇹🇯",
+	"flag_tk", "🇹🇰",
+	"flag_tl", "🇹🇱",
+	"flag_tm", "🇹🇲",
+	"flag_tn", "🇹🇳",
+	"flag_to", "🇹🇴",
+	"flag_tr", "🇹🇷",
+	"flag_tt", "🇹🇹",
+	"flag_tv", "🇹🇻",
+	"flag_tw", "🇹🇼",
+	"flag_tz", "🇹🇿",
+	"flag_ua", "🇺🇦",
+	"flag_ug", "🇺🇬",
+	"flag_um", "🇺🇲",
+	"flag_us", "🇺🇸",
+	"flag_uy", "🇺🇾",
+	"flag_uz", "🇺🇿",
+	"flag_va", "🇻🇦",
+	"flag_vc", "🇻🇨",
+	"flag_ve", "🇻🇪",
+	"flag_vg", "🇻🇬",
+	"flag_vi", "🇻🇮",
+	"flag_vn", "🇻🇳",
+	"flag_vu", "🇻🇺",
+	"flag_wf", "🇼🇫",
+	"flag_white", "🏳️",
+	"flag_ws", "🇼🇸",
+	"flag_xk", "🇽🇰",
+	"flag_ye", "🇾🇪",
+	"flag_yt", "🇾🇹",
+	"flag_za", "🇿🇦",
+	"flag_zm", "🇿🇲",
+	"flag_zw", "🇿🇼",
+	"flags", "🎏",
+	"flame", "🔥",
+	"flamingo", "🦩",
+	"flan", "🍮",
+	"flashlight", "🔦",
+	"flatbread", "🫓",
+	"fleur_de_lis", "⚜️",
+	"floppy_disk", "💾",
+	"flower_playing_cards", "🎴",
+	"flushed", "😳",
+	"fly", "🪰",
+	"flying_disc", "🥏",
+	"flying_saucer", "🛸",
+	"fog", "🌫️",
+	"foggy", "🌁",
+	"fondue", "🫕",
+	"foot", "🦶",
+	"foot_dark_skin_tone", "🦶🏿",
+	"foot_light_skin_tone", "🦶🏻",
+	"foot_medium_dark_skin_tone", "🦶🏾",
+	"foot_medium_light_skin_tone", "🦶🏼",
+	"foot_medium_skin_tone", "🦶🏽",
+	"foot_tone1", "🦶🏻",
+	"foot_tone2", "🦶🏼",
+	"foot_tone3", "🦶🏽",
+	"foot_tone4", "🦶🏾",
+	"foot_tone5", "🦶🏿",
+	"football", "🏈",
+	"footprints", "👣",
+	"fork_and_knife", "🍴",
+	"fork_and_knife_with_plate", "🍽️",
+	"fork_knife_plate", "🍽️",
+	"fortune_cookie", "🥠",
+	"fountain", "⛲",
+	"four", "4️⃣",
+	"four_leaf_clover", "🍀",
+	"fox", "🦊",
+	"fox_face", "🦊",
+	"frame_photo", "🖼️",
+	"frame_with_picture", "🖼️",
+	"free", "🆓",
+	"french_bread", "🥖",
+	"fried_shrimp", "🍤",
+	"fries", "🍟",
+	"frog", "🐸",
+	"frowning", "😦",
+	"frowning2", "☹️",
+	"fuelpump", "⛽",
+	"full_moon", "🌕",
+	"full_moon_with_face", "🌝",
+	"funeral_urn", "⚱️",
+	"game_die", "🎲",
+	"garlic", "🧄",
+	"gay_pride_flag", "🏳️\u200d🌈",
+	"gear", "⚙️",
+	"gem", "💎",
+	"gemini", "♊",
+	"genie", "🧞",
+	"ghost", "👻",
+	"gift", "🎁",
+	"gift_heart", "💝",
+	"giraffe", "🦒",
+	"girl", "👧",
+	"girl_tone1", "👧🏻",
+	"girl_tone2", "👧🏼",
+	"girl_tone3", "👧🏽",
+	"girl_tone4", "👧🏾",
+	"girl_tone5", "👧🏿",
+	"glass_of_milk", "🥛",
+	"globe_with_meridians", "🌐",
+	"gloves", "🧤",
+	"goal", "🥅",
+	"goal_net", "🥅",
+	"goat", "🐐",
+	"goggles", "🥽",
+	"golf", "⛳",
+	"golfer", "🏌️",
+	"gorilla", "🦍",
+	"grandma", "👵",
+	"grandma_tone1", "👵🏻",
+	"grandma_tone2", "👵🏼",
+	"grandma_tone3", "👵🏽",
+	"grandma_tone4", "👵🏾",
+	"grandma_tone5", "👵🏿",
+	"grapes", "🍇",
+	"green_apple", "🍏",
+	"green_book", "📗",
+	"green_circle", "🟢",
+	"green_heart", "💚",
+	"green_salad", "🥗",
+	"green_square", "🟩",
+	"grey_exclamation", "❕",
+	"grey_question", "❔",
+	"grimacing", "😬",
+	"grin", "😁",
+	"grinning", "😀",
+	"guard", "💂",
+	"guard_tone1", "💂🏻",
+	"guard_tone2", "💂🏼",
+	"guard_tone3", "💂🏽",
+	"guard_tone4", "💂🏾",
+	"guard_tone5", "💂🏿",
+	"guardsman", "💂",
+	"guardsman_tone1", "💂🏻",
+	"guardsman_tone2", "💂🏼",
+	"guardsman_tone3", "💂🏽",
+	"guardsman_tone4", "💂🏾",
+	"guardsman_tone5", "💂🏿",
+	"guide_dog", "🦮",
+	"guitar", "🎸",
+	"gun", "🔫",
+	"haircut", "💇",
+	"haircut_tone1", "💇🏻",
+	"haircut_tone2", "💇🏼",
+	"haircut_tone3", "💇🏽",
+	"haircut_tone4", "💇🏾",
+	"haircut_tone5", "💇🏿",
+	"hamburger", "🍔",
+	"hammer", "🔨",
+	"hammer_and_pick", "⚒️",
+	"hammer_and_wrench", "🛠️",
+	"hammer_pick", "⚒️",
+	"hamsa", "🪬",
+	"hamster", "🐹",
+	"hand_splayed", "🖐️",
+	"hand_splayed_tone1", "🖐🏻",
+	"hand_splayed_tone2", "🖐🏼",
+	"hand_splayed_tone3", "🖐🏽",
+	"hand_splayed_tone4", "🖐🏾",
+	"hand_splayed_tone5", "🖐🏿",
+	"hand_with_index_and_middle_finger_crossed", "🤞",
+	"hand_with_index_and_middle_fingers_crossed_tone1", "🤞🏻",
+	"hand_with_index_and_middle_fingers_crossed_tone2", "🤞🏼",
+	"hand_with_index_and_middle_fingers_crossed_tone3", "🤞🏽",
+	"hand_with_index_and_middle_fingers_crossed_tone4", "🤞🏾",
+	"hand_with_index_and_middle_fingers_crossed_tone5", "🤞🏿",
+	"hand_with_index_finger_and_thumb_crossed", "🫰",
+	"hand_with_index_finger_and_thumb_crossed_dark_skin_tone", "🫰🏿",
+	"hand_with_index_finger_and_thumb_crossed_light_skin_tone", "🫰🏻",
+	"hand_with_index_finger_and_thumb_crossed_medium_dark_skin_tone", "🫰🏾",
+	"hand_with_index_finger_and_thumb_crossed_medium_light_skin_tone", "🫰🏼",
+	"hand_with_index_finger_and_thumb_crossed_medium_skin_tone", "🫰🏽",
+	"hand_with_index_finger_and_thumb_crossed_tone1", "🫰🏻",
+	"hand_with_index_finger_and_thumb_crossed_tone2", "🫰🏼",
+	"hand_with_index_finger_and_thumb_crossed_tone3", "🫰🏽",
+	"hand_with_index_finger_and_thumb_crossed_tone4", "🫰🏾",
+	"hand_with_index_finger_and_thumb_crossed_tone5", "🫰🏿",
+	"handbag", "👜",
+	"handball", "🤾",
+	"handball_tone1", "🤾🏻",
+	"handball_tone2", "🤾🏼",
+	"handball_tone3", "🤾🏽",
+	"handball_tone4", "🤾🏾",
+	"handball_tone5", "🤾🏿",
+	"handshake", "🤝",
+	"handshake_dark_skin_tone", "🤝🏿",
+	"handshake_dark_skin_tone_light_skin_tone", "🫱🏿\u200d🫲🏻",
+	"handshake_dark_skin_tone_medium_dark_skin_tone", "🫱🏿\u200d🫲🏾",
+	"handshake_dark_skin_tone_medium_light_skin_tone", "🫱🏿\u200d🫲🏼",
+	"handshake_dark_skin_tone_medium_skin_tone", "🫱🏿\u200d🫲🏽",
+	"handshake_light_skin_tone", "🤝🏻",
+	"handshake_light_skin_tone_dark_skin_tone", "🫱🏻\u200d🫲🏿",
+	"handshake_light_skin_tone_medium_dark_skin_tone", "🫱🏻\u200d🫲🏾",
+	"handshake_light_skin_tone_medium_light_skin_tone", "🫱🏻\u200d🫲🏼",
+	"handshake_light_skin_tone_medium_skin_tone", "🫱🏻\u200d🫲🏽",
+	"handshake_medium_dark_skin_tone", "🤝🏾",
+	"handshake_medium_dark_skin_tone_dark_skin_tone", "🫱🏾\u200d🫲🏿",
+	"handshake_medium_dark_skin_tone_light_skin_tone", "🫱🏾\u200d🫲🏻",
+	"handshake_medium_dark_skin_tone_medium_light_skin_tone", "🫱🏾\u200d🫲🏼",
+	"handshake_medium_dark_skin_tone_medium_skin_tone", "🫱🏾\u200d🫲🏽",
+	"handshake_medium_light_skin_tone", "🤝🏼",
+	"handshake_medium_light_skin_tone_dark_skin_tone", "🫱🏼\u200d🫲🏿",
+	"handshake_medium_light_skin_tone_light_skin_tone", "🫱🏼\u200d🫲🏻",
+	"handshake_medium_light_skin_tone_medium_dark_skin_tone", "🫱🏼\u200d🫲🏾",
+	"handshake_medium_light_skin_tone_medium_skin_tone", "🫱🏼\u200d🫲🏽",
+	"handshake_medium_skin_tone", "🤝🏽",
+	"handshake_medium_skin_tone_dark_skin_tone", "🫱🏽\u200d🫲🏿",
+	"handshake_medium_skin_tone_light_skin_tone", "🫱🏽\u200d🫲🏻",
+	"handshake_medium_skin_tone_medium_dark_skin_tone", "🫱🏽\u200d🫲🏾",
+	"handshake_medium_skin_tone_medium_light_skin_tone", "🫱🏽\u200d🫲🏼",
+	"handshake_tone1_tone2", "🫱🏻\u200d🫲🏼",
+	"handshake_tone1_tone3", "🫱🏻\u200d🫲🏽",
+	"handshake_tone1_tone4", "🫱🏻\u200d🫲🏾",
+	"handshake_tone1_tone5", "🫱🏻\u200d🫲🏿",
+	"handshake_tone2_tone1", "🫱🏼\u200d🫲🏻",
+	"handshake_tone2_tone3", "🫱🏼\u200d🫲🏽",
+	"handshake_tone2_tone4", "🫱🏼\u200d🫲🏾",
+	"handshake_tone2_tone5", "🫱🏼\u200d🫲🏿",
+	"handshake_tone3_tone1", "🫱🏽\u200d🫲🏻",
+	"handshake_tone3_tone2", "🫱🏽\u200d🫲🏼",
+	"handshake_tone3_tone4", "🫱🏽\u200d🫲🏾",
+	"handshake_tone3_tone5", "🫱🏽\u200d🫲🏿",
+	"handshake_tone4_tone1", "🫱🏾\u200d🫲🏻",
+	"handshake_tone4_tone2", "🫱🏾\u200d🫲🏼",
+	"handshake_tone4_tone3", "🫱🏾\u200d🫲🏽",
+	"handshake_tone4_tone5", "🫱🏾\u200d🫲🏿",
+	"handshake_tone5_tone1", "🫱🏿\u200d🫲🏻",
+	"handshake_tone5_tone2", "🫱🏿\u200d🫲🏼",
+	"handshake_tone5_tone3", "🫱🏿\u200d🫲🏽",
+	"handshake_tone5_tone4", "🫱🏿\u200d🫲🏾",
+	"hankey", "💩",
+	"hash", "#️⃣",
+	"hatched_chick", "🐥",
+	"hatching_chick", "🐣",
+	"head_bandage", "🤕",
+	"headphones", "🎧",
+	"headstone", "🪦",
+	"health_worker", "🧑\u200d⚕️",
+	"health_worker_dark_skin_tone", "🧑🏿\u200d⚕️",
+	"health_worker_light_skin_tone", "🧑🏻\u200d⚕️",
+	"health_worker_medium_dark_skin_tone", "🧑🏾\u200d⚕️",
+	"health_worker_medium_light_skin_tone", "🧑🏼\u200d⚕️",
+	"health_worker_medium_skin_tone", "🧑🏽\u200d⚕️",
+	"health_worker_tone1", "🧑🏻\u200d⚕️",
+	"health_worker_tone2", "🧑🏼\u200d⚕️",
+	"health_worker_tone3", "🧑🏽\u200d⚕️",
+	"health_worker_tone4", "🧑🏾\u200d⚕️",
+	"health_worker_tone5", "🧑🏿\u200d⚕️",
+	"hear_no_evil", "🙉",
+	"heart", "❤️",
+	"heart_decoration", "💟",
+	"heart_exclamation", "❣️",
+	"heart_eyes", "😍",
+	"heart_eyes_cat", "😻",
+	"heart_hands", "🫶",
+	"heart_hands_dark_skin_tone", "🫶🏿",
+	"heart_hands_light_skin_tone", "🫶🏻",
+	"heart_hands_medium_dark_skin_tone", "🫶🏾",
+	"heart_hands_medium_light_skin_tone", "🫶🏼",
+	"heart_hands_medium_skin_tone", "🫶🏽",
+	"heart_hands_tone1", "🫶🏻",
+	"heart_hands_tone2", "🫶🏼",
+	"heart_hands_tone3", "🫶🏽",
+	"heart_hands_tone4", "🫶🏾",
+	"heart_hands_tone5", "🫶🏿",
+	"heart_on_fire", "❤️\u200d🔥",
+	"heartbeat", "💓",
+	"heartpulse", "💗",
+	"hearts", "♥️",
+	"heavy_check_mark", "✔️",
+	"heavy_division_sign", "➗",
+	"heavy_dollar_sign", "💲",
+	"heavy_equals_sign", "🟰",
+	"heavy_heart_exclamation_mark_ornament", "❣️",
+	"heavy_minus_sign", "➖",
+	"heavy_multiplication_x", "✖️",
+	"heavy_plus_sign", "➕",
+	"hedgehog", "🦔",
+	"helicopter", "🚁",
+	"helmet_with_cross", "⛑️",
+	"helmet_with_white_cross", "⛑️",
+	"herb", "🌿",
+	"hibiscus", "🌺",
+	"high_brightness", "🔆",
+	"high_heel", "👠",
+	"hiking_boot", "🥾",
+	"hindu_temple", "🛕",
+	"hippopotamus", "🦛",
+	"hockey", "🏒",
+	"hole", "🕳️",
+	"homes", "🏘️",
+	"honey_pot", "🍯",
+	"hook", "🪝",
+	"horse", "🐴",
+	"horse_racing", "🏇",
+	"horse_racing_tone1", "🏇🏻",
+	"horse_racing_tone2", "🏇🏼",
+	"horse_racing_tone3", "🏇🏽",
+	"horse_racing_tone4", "🏇🏾",
+	"horse_racing_tone5", "🏇🏿",
+	"hospital", "🏥",
+	"hot_dog", "🌭",
+	"hot_face", "🥵",
+	"hot_pepper", "🌶️",
+	"hotdog", "🌭",
+	"hotel", "🏨",
+	"hotsprings", "♨️",
+	"hourglass", "⌛",
+	"hourglass_flowing_sand", "⏳",
+	"house", "🏠",
+	"house_abandoned", "🏚️",
+	"house_buildings", "🏘️",
+	"house_with_garden", "🏡",
+	"hugging", "🤗",
+	"hugging_face", "🤗",
+	"hushed", "😯",
+	"hut", "🛖",
+	"ice_cream", "🍨",
+	"ice_cube", "🧊",
+	"ice_skate", "⛸️",
+	"icecream", "🍦",
+	"id", "🆔",
+	"identification_card", "🪪",
+	"ideograph_advantage", "🉐",
+	"imp", "👿",
+	"inbox_tray", "📥",
+	"incoming_envelope", "📨",
+	"index_pointing_at_the_viewer", "🫵",
+	"index_pointing_at_the_viewer_dark_skin_tone", "🫵🏿",
+	"index_pointing_at_the_viewer_light_skin_tone", "🫵🏻",
+	"index_pointing_at_the_viewer_medium_dark_skin_tone", "🫵🏾",
+	"index_pointing_at_the_viewer_medium_light_skin_tone", "🫵🏼",
+	"index_pointing_at_the_viewer_medium_skin_tone", "🫵🏽",
+	"index_pointing_at_the_viewer_tone1", "🫵🏻",
+	"index_pointing_at_the_viewer_tone2", "🫵🏼",
+	"index_pointing_at_the_viewer_tone3", "🫵🏽",
+	"index_pointing_at_the_viewer_tone4", "🫵🏾",
+	"index_pointing_at_the_viewer_tone5", "🫵🏿",
+	"infinity", "♾️",
+	"information_desk_person", "💁",
+	"information_desk_person_tone1", "💁🏻",
+	"information_desk_person_tone2", "💁🏼",
+	"information_desk_person_tone3", "💁🏽",
+	"information_desk_person_tone4", "💁🏾",
+	"information_desk_person_tone5", "💁🏿",
+	"information_source", "ℹ️",
+	"innocent", "😇",
+	"interrobang", "⁉️",
+	"iphone", "📱",
+	"island", "🏝️",
+	"izakaya_lantern", "🏮",
+	"jack_o_lantern", "🎃",
+	"japan", "🗾",
+	"japanese_castle", "🏯",
+	"japanese_goblin", "👺",
+	"japanese_ogre", "👹",
+	"jar", "🫙",
+	"jeans", "👖",
+	"jigsaw", "🧩",
+	"joy", "😂",
+	"joy_cat", "😹",
+	"joystick", "🕹️",
+	"judge", "🧑\u200d⚖️",
+	"judge_dark_skin_tone", "🧑🏿\u200d⚖️",
+	"judge_light_skin_tone", "🧑🏻\u200d⚖️",
+	"judge_medium_dark_skin_tone", "🧑🏾\u200d⚖️",
+	"judge_medium_light_skin_tone", "🧑🏼\u200d⚖️",
+	"judge_medium_skin_tone", "🧑🏽\u200d⚖️",
+	"judge_tone1", "🧑🏻\u200d⚖️",
+	"judge_tone2", "🧑🏼\u200d⚖️",
+	"judge_tone3", "🧑🏽\u200d⚖️",
+	"judge_tone4", "🧑🏾\u200d⚖️",
+	"judge_tone5", "🧑🏿\u200d⚖️",
+	"juggler", "🤹",
+	"juggler_tone1", "🤹🏻",
+	"juggler_tone2", "🤹🏼",
+	"juggler_tone3", "🤹🏽",
+	"juggler_tone4", "🤹🏾",
+	"juggler_tone5", "🤹🏿",
+	"juggling", "🤹",
+	"juggling_tone1", "🤹🏻",
+	"juggling_tone2", "🤹🏼",
+	"juggling_tone3", "🤹🏽",
+	"juggling_tone4", "🤹🏾",
+	"juggling_tone5", "🤹🏿",
+	"kaaba", "🕋",
+	"kangaroo", "🦘",
+	"karate_uniform", "🥋",
+	"kayak", "🛶",
+	"key", "🔑",
+	"key2", "🗝️",
+	"keyboard", "⌨️",
+	"keycap_asterisk", "*️⃣",
+	"keycap_ten", "🔟",
+	"kimono", "👘",
+	"kiss", "💋",
+	"kiss_dark_skin_tone", "💏🏿",
+	"kiss_light_skin_tone", "💏🏻",
+	"kiss_man_man_dark_skin_tone", "👨🏿\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_man_man_dark_skin_tone_light_skin_tone", "👨🏿\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_man_man_dark_skin_tone_medium_dark_skin_tone", "👨🏿\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_man_man_dark_skin_tone_medium_light_skin_tone", "👨🏿\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_man_man_dark_skin_tone_medium_skin_tone", "👨🏿\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_man_man_light_skin_tone", "👨🏻\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_man_man_light_skin_tone_dark_skin_tone", "👨🏻\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_man_man_light_skin_tone_medium_dark_skin_tone", "👨🏻\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_man_man_light_skin_tone_medium_light_skin_tone", "👨🏻\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_man_man_light_skin_tone_medium_skin_tone", "👨🏻\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_man_man_medium_dark_skin_tone", "👨🏾\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_man_man_medium_dark_skin_tone_dark_skin_tone", "👨🏾\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_man_man_medium_dark_skin_tone_light_skin_tone", "👨🏾\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_man_man_medium_dark_skin_tone_medium_light_skin_tone", "👨🏾\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_man_man_medium_dark_skin_tone_medium_skin_tone", "👨🏾\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_man_man_medium_light_skin_tone", "👨🏼\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_man_man_medium_light_skin_tone_dark_skin_tone", "👨🏼\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_man_man_medium_light_skin_tone_light_skin_tone", "👨🏼\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_man_man_medium_light_skin_tone_medium_dark_skin_tone", "👨🏼\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_man_man_medium_light_skin_tone_medium_skin_tone", "👨🏼\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_man_man_medium_skin_tone", "👨🏽\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_man_man_medium_skin_tone_dark_skin_tone", "👨🏽\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_man_man_medium_skin_tone_light_skin_tone", "👨🏽\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_man_man_medium_skin_tone_medium_dark_skin_tone", "👨🏽\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_man_man_medium_skin_tone_medium_light_skin_tone", "👨🏽\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_man_man_tone1", "👨🏻\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_man_man_tone1_tone2", "👨🏻\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_man_man_tone1_tone3", "👨🏻\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_man_man_tone1_tone4", "👨🏻\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_man_man_tone1_tone5", "👨🏻\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_man_man_tone2", "👨🏼\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_man_man_tone2_tone1", "👨🏼\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_man_man_tone2_tone3", "👨🏼\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_man_man_tone2_tone4", "👨🏼\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_man_man_tone2_tone5", "👨🏼\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_man_man_tone3", "👨🏽\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_man_man_tone3_tone1", "👨🏽\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_man_man_tone3_tone2", "👨🏽\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_man_man_tone3_tone4", "👨🏽\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_man_man_tone3_tone5", "👨🏽\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_man_man_tone4", "👨🏾\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_man_man_tone4_tone1", "👨🏾\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_man_man_tone4_tone2", "👨🏾\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_man_man_tone4_tone3", "👨🏾\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_man_man_tone4_tone5", "👨🏾\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_man_man_tone5", "👨🏿\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_man_man_tone5_tone1", "👨🏿\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_man_man_tone5_tone2", "👨🏿\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_man_man_tone5_tone3", "👨🏿\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_man_man_tone5_tone4", "👨🏿\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_medium_dark_skin_tone", "💏🏾",
+	"kiss_medium_light_skin_tone", "💏🏼",
+	"kiss_medium_skin_tone", "💏🏽",
+	"kiss_mm", "👨\u200d❤️\u200d💋\u200d👨",
+	"kiss_person_person_dark_skin_tone_light_skin_tone", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏻",
+	"kiss_person_person_dark_skin_tone_medium_dark_skin_tone", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏾",
+	"kiss_person_person_dark_skin_tone_medium_light_skin_tone", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏼",
+	"kiss_person_person_dark_skin_tone_medium_skin_tone", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏽",
+	"kiss_person_person_light_skin_tone_dark_skin_tone", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏿",
+	"kiss_person_person_light_skin_tone_medium_dark_skin_tone", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏾",
+	"kiss_person_person_light_skin_tone_medium_light_skin_tone", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏼",
+	"kiss_person_person_light_skin_tone_medium_skin_tone", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏽",
+	"kiss_person_person_medium_dark_skin_tone_dark_skin_tone", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏿",
+	"kiss_person_person_medium_dark_skin_tone_light_skin_tone", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏻",
+	"kiss_person_person_medium_dark_skin_tone_medium_light_skin_tone", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏼",
+	"kiss_person_person_medium_dark_skin_tone_medium_skin_tone", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏽",
+	"kiss_person_person_medium_light_skin_tone_dark_skin_tone", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏿",
+	"kiss_person_person_medium_light_skin_tone_light_skin_tone", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏻",
+	"kiss_person_person_medium_light_skin_tone_medium_dark_skin_tone", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏾",
+	"kiss_person_person_medium_light_skin_tone_medium_skin_tone", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏽",
+	"kiss_person_person_medium_skin_tone_dark_skin_tone", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏿",
+	"kiss_person_person_medium_skin_tone_light_skin_tone", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏻",
+	"kiss_person_person_medium_skin_tone_medium_dark_skin_tone", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏾",
+	"kiss_person_person_medium_skin_tone_medium_light_skin_tone", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏼",
+	"kiss_person_person_tone1_tone2", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏼",
+	"kiss_person_person_tone1_tone3", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏽",
+	"kiss_person_person_tone1_tone4", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏾",
+	"kiss_person_person_tone1_tone5", "🧑🏻\u200d❤️\u200d💋\u200d🧑🏿",
+	"kiss_person_person_tone2_tone1", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏻",
+	"kiss_person_person_tone2_tone3", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏽",
+	"kiss_person_person_tone2_tone4", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏾",
+	"kiss_person_person_tone2_tone5", "🧑🏼\u200d❤️\u200d💋\u200d🧑🏿",
+	"kiss_person_person_tone3_tone1", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏻",
+	"kiss_person_person_tone3_tone2", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏼",
+	"kiss_person_person_tone3_tone4", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏾",
+	"kiss_person_person_tone3_tone5", "🧑🏽\u200d❤️\u200d💋\u200d🧑🏿",
+	"kiss_person_person_tone4_tone1", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏻",
+	"kiss_person_person_tone4_tone2", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏼",
+	"kiss_person_person_tone4_tone3", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏽",
+	"kiss_person_person_tone4_tone5", "🧑🏾\u200d❤️\u200d💋\u200d🧑🏿",
+	"kiss_person_person_tone5_tone1", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏻",
+	"kiss_person_person_tone5_tone2", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏼",
+	"kiss_person_person_tone5_tone3", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏽",
+	"kiss_person_person_tone5_tone4", "🧑🏿\u200d❤️\u200d💋\u200d🧑🏾",
+	"kiss_tone1", "💏🏻",
+	"kiss_tone2", "💏🏼",
+	"kiss_tone3", "💏🏽",
+	"kiss_tone4", "💏🏾",
+	"kiss_tone5", "💏🏿",
+	"kiss_woman_man", "👩\u200d❤️\u200d💋\u200d👨",
+	"kiss_woman_man_dark_skin_tone", "👩🏿\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_woman_man_dark_skin_tone_light_skin_tone", "👩🏿\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_woman_man_dark_skin_tone_medium_dark_skin_tone", "👩🏿\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_woman_man_dark_skin_tone_medium_light_skin_tone", "👩🏿\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_woman_man_dark_skin_tone_medium_skin_tone", "👩🏿\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_woman_man_light_skin_tone", "👩🏻\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_woman_man_light_skin_tone_dark_skin_tone", "👩🏻\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_woman_man_light_skin_tone_medium_dark_skin_tone", "👩🏻\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_woman_man_light_skin_tone_medium_light_skin_tone", "👩🏻\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_woman_man_light_skin_tone_medium_skin_tone", "👩🏻\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_woman_man_medium_dark_skin_tone", "👩🏾\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_woman_man_medium_dark_skin_tone_dark_skin_tone", "👩🏾\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_woman_man_medium_dark_skin_tone_light_skin_tone", "👩🏾\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_woman_man_medium_dark_skin_tone_medium_light_skin_tone", "👩🏾\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_woman_man_medium_dark_skin_tone_medium_skin_tone", "👩🏾\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_woman_man_medium_light_skin_tone", "👩🏼\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_woman_man_medium_light_skin_tone_dark_skin_tone", "👩🏼\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_woman_man_medium_light_skin_tone_light_skin_tone", "👩🏼\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_woman_man_medium_light_skin_tone_medium_dark_skin_tone", "👩🏼\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_woman_man_medium_light_skin_tone_medium_skin_tone", "👩🏼\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_woman_man_medium_skin_tone", "👩🏽\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_woman_man_medium_skin_tone_dark_skin_tone", "👩🏽\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_woman_man_medium_skin_tone_light_skin_tone", "👩🏽\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_woman_man_medium_skin_tone_medium_dark_skin_tone", "👩🏽\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_woman_man_medium_skin_tone_medium_light_skin_tone", "👩🏽\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_woman_man_tone1", "👩🏻\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_woman_man_tone1_tone2", "👩🏻\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_woman_man_tone1_tone3", "👩🏻\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_woman_man_tone1_tone4", "👩🏻\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_woman_man_tone1_tone5", "👩🏻\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_woman_man_tone2", "👩🏼\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_woman_man_tone2_tone1", "👩🏼\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_woman_man_tone2_tone3", "👩🏼\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_woman_man_tone2_tone4", "👩🏼\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_woman_man_tone2_tone5", "👩🏼\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_woman_man_tone3", "👩🏽\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_woman_man_tone3_tone1", "👩🏽\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_woman_man_tone3_tone2", "👩🏽\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_woman_man_tone3_tone4", "👩🏽\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_woman_man_tone3_tone5", "👩🏽\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_woman_man_tone4", "👩🏾\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_woman_man_tone4_tone1", "👩🏾\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_woman_man_tone4_tone2", "👩🏾\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_woman_man_tone4_tone3", "👩🏾\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_woman_man_tone4_tone5", "👩🏾\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_woman_man_tone5", "👩🏿\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_woman_man_tone5_tone1", "👩🏿\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_woman_man_tone5_tone2", "👩🏿\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_woman_man_tone5_tone3", "👩🏿\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_woman_man_tone5_tone4", "👩🏿\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_woman_woman_dark_skin_tone", "👩🏿\u200d❤️\u200d💋\u200d👩🏿",
+	"kiss_woman_woman_dark_skin_tone_light_skin_tone", "👩🏿\u200d❤️\u200d💋\u200d👩🏻",
+	"kiss_woman_woman_dark_skin_tone_medium_dark_skin_tone", "👩🏿\u200d❤️\u200d💋\u200d👩🏾",
+	"kiss_woman_woman_dark_skin_tone_medium_light_skin_tone", "👩🏿\u200d❤️\u200d💋\u200d👩🏼",
+	"kiss_woman_woman_dark_skin_tone_medium_skin_tone", "👩🏿\u200d❤️\u200d💋\u200d👩🏽",
+	"kiss_woman_woman_light_skin_tone", "👩🏻\u200d❤️\u200d💋\u200d👩🏻",
+	"kiss_woman_woman_light_skin_tone_dark_skin_tone", "👩🏻\u200d❤️\u200d💋\u200d👩🏿",
+	"kiss_woman_woman_light_skin_tone_medium_dark_skin_tone", "👩🏻\u200d❤️\u200d💋\u200d👩🏾",
+	"kiss_woman_woman_light_skin_tone_medium_light_skin_tone", "👩🏻\u200d❤️\u200d💋\u200d👩🏼",
+	"kiss_woman_woman_light_skin_tone_medium_skin_tone", "👩🏻\u200d❤️\u200d💋\u200d👩🏽",
+	"kiss_woman_woman_medium_dark_skin_tone", "👩🏾\u200d❤️\u200d💋\u200d👩🏾",
+	"kiss_woman_woman_medium_dark_skin_tone_dark_skin_tone", "👩🏾\u200d❤️\u200d💋\u200d👩🏿",
+	"kiss_woman_woman_medium_dark_skin_tone_light_skin_tone", "👩🏾\u200d❤️\u200d💋\u200d👩🏻",
+	"kiss_woman_woman_medium_dark_skin_tone_medium_light_skin_tone", "👩🏾\u200d❤️\u200d💋\u200d👩🏼",
+	"kiss_woman_woman_medium_dark_skin_tone_medium_skin_tone", "👩🏾\u200d❤️\u200d💋\u200d👩🏽",
+	"kiss_woman_woman_medium_light_skin_tone", "👩🏼\u200d❤️\u200d💋\u200d👩🏼",
+	"kiss_woman_woman_medium_light_skin_tone_dark_skin_tone", "👩🏼\u200d❤️\u200d💋\u200d👩🏿",
+	"kiss_woman_woman_medium_light_skin_tone_light_skin_tone", "👩🏼\u200d❤️\u200d💋\u200d👩🏻",
+	"kiss_woman_woman_medium_light_skin_tone_medium_dark_skin_tone", "👩🏼\u200d❤️\u200d💋\u200d👩🏾",
+	"kiss_woman_woman_medium_light_skin_tone_medium_skin_tone", "👩🏼\u200d❤️\u200d💋\u200d👩🏽",
+	"kiss_woman_woman_medium_skin_tone", "👩🏽\u200d❤️\u200d💋\u200d👩🏽",
+	"kiss_woman_woman_medium_skin_tone_dark_skin_tone", "👩🏽\u200d❤️\u200d💋\u200d👩🏿",
+	"kiss_woman_woman_medium_skin_tone_light_skin_tone", "👩🏽\u200d❤️\u200d💋\u200d👩🏻",
+	"kiss_woman_woman_medium_skin_tone_medium_dark_skin_tone", "👩🏽\u200d❤️\u200d💋\u200d👩🏾",
+	"kiss_woman_woman_medium_skin_tone_medium_light_skin_tone", "👩🏽\u200d❤️\u200d💋\u200d👩🏼",
+	"kiss_woman_woman_tone1", "👩🏻\u200d❤️\u200d💋\u200d👩🏻",
+	"kiss_woman_woman_tone1_tone2", "👩🏻\u200d❤️\u200d💋\u200d👩🏼",
+	"kiss_woman_woman_tone1_tone3", "👩🏻\u200d❤️\u200d💋\u200d👩🏽",
+	"kiss_woman_woman_tone1_tone4", "👩🏻\u200d❤️\u200d💋\u200d👩🏾",
+	"kiss_woman_woman_tone1_tone5", "👩🏻\u200d❤️\u200d💋\u200d👩🏿",
+	"kiss_woman_woman_tone2", "👩🏼\u200d❤️\u200d💋\u200d👩🏼",
+	"kiss_woman_woman_tone2_tone1", "👩🏼\u200d❤️\u200d💋\u200d👩🏻",
+	"kiss_woman_woman_tone2_tone3", "👩🏼\u200d❤️\u200d💋\u200d👩🏽",
+	"kiss_woman_woman_tone2_tone4", "👩🏼\u200d❤️\u200d💋\u200d👩🏾",
+	"kiss_woman_woman_tone2_tone5", "👩🏼\u200d❤️\u200d💋\u200d👩🏿",
+	"kiss_woman_woman_tone3", "👩🏽\u200d❤️\u200d💋\u200d👩🏽",
+	"kiss_woman_woman_tone3_tone1", "👩🏽\u200d❤️\u200d💋\u200d👩🏻",
+	"kiss_woman_woman_tone3_tone2", "👩🏽\u200d❤️\u200d💋\u200d👩🏼",
+	"kiss_woman_woman_tone3_tone4", "👩🏽\u200d❤️\u200d💋\u200d👩🏾",
+	"kiss_woman_woman_tone3_tone5", "👩🏽\u200d❤️\u200d💋\u200d👩🏿",
+	"kiss_woman_woman_tone4", "👩🏾\u200d❤️\u200d💋\u200d👩🏾",
+	"kiss_woman_woman_tone4_tone1", "👩🏾\u200d❤️\u200d💋\u200d👩🏻",
+	"kiss_woman_woman_tone4_tone2", "👩🏾\u200d❤️\u200d💋\u200d👩🏼",
+	"kiss_woman_woman_tone4_tone3", "👩🏾\u200d❤️\u200d💋\u200d👩🏽",
+	"kiss_woman_woman_tone4_tone5", "👩🏾\u200d❤️\u200d💋\u200d👩🏿",
+	"kiss_woman_woman_tone5", "👩🏿\u200d❤️\u200d💋\u200d👩🏿",
+	"kiss_woman_woman_tone5_tone1", "👩🏿\u200d❤️\u200d💋\u200d👩🏻",
+	"kiss_woman_woman_tone5_tone2", "👩🏿\u200d❤️\u200d💋\u200d👩🏼",
+	"kiss_woman_woman_tone5_tone3", "👩🏿\u200d❤️\u200d💋\u200d👩🏽",
+	"kiss_woman_woman_tone5_tone4", "👩🏿\u200d❤️\u200d💋\u200d👩🏾",
+	"kiss_ww", "👩\u200d❤️\u200d💋\u200d👩",
+	"kissing", "😗",
+	"kissing_cat", "😽",
+	"kissing_closed_eyes", "😚",
+	"kissing_heart", "😘",
+	"kissing_smiling_eyes", "😙",
+	"kite", "🪁",
+	"kiwi", "🥝",
+	"kiwifruit", "🥝",
+	"knife", "🔪",
+	"knot", "🪢",
+	"koala", "🐨",
+	"koko", "🈁",
+	"lab_coat", "🥼",
+	"label", "🏷️",
+	"lacrosse", "🥍",
+	"ladder", "🪜",
+	"lady_beetle", "🐞",
+	"large_blue_diamond", "🔷",
+	"large_orange_diamond", "🔶",
+	"last_quarter_moon", "🌗",
+	"last_quarter_moon_with_face", "🌜",
+	"latin_cross", "✝️",
+	"laughing", "😆",
+	"leafy_green", "🥬",
+	"leaves", "🍃",
+	"ledger", "📒",
+	"left_facing_fist", "🤛",
+	"left_facing_fist_tone1", "🤛🏻",
+	"left_facing_fist_tone2", "🤛🏼",
+	"left_facing_fist_tone3", "🤛🏽",
+	"left_facing_fist_tone4", "🤛🏾",
+	"left_facing_fist_tone5", "🤛🏿",
+	"left_fist", "🤛",
+	"left_fist_tone1", "🤛🏻",
+	"left_fist_tone2", "🤛🏼",
+	"left_fist_tone3", "🤛🏽",
+	"left_fist_tone4", "🤛🏾",
+	"left_fist_tone5", "🤛🏿",
+	"left_luggage", "🛅",
+	"left_right_arrow", "↔️",
+	"left_speech_bubble", "🗨️",
+	"leftwards_arrow_with_hook", "↩️",
+	"leftwards_hand", "🫲",
+	"leftwards_hand_dark_skin_tone", "🫲🏿",
+	"leftwards_hand_light_skin_tone", "🫲🏻",
+	"leftwards_hand_medium_dark_skin_tone", "🫲🏾",
+	"leftwards_hand_medium_light_skin_tone", "🫲🏼",
+	"leftwards_hand_medium_skin_tone", "🫲🏽",
+	"leftwards_hand_tone1", "🫲🏻",
+	"leftwards_hand_tone2", "🫲🏼",
+	"leftwards_hand_tone3", "🫲🏽",
+	"leftwards_hand_tone4", "🫲🏾",
+	"leftwards_hand_tone5", "🫲🏿",
+	"leg", "🦵",
+	"leg_dark_skin_tone", "🦵🏿",
+	"leg_light_skin_tone", "🦵🏻",
+	"leg_medium_dark_skin_tone", "🦵🏾",
+	"leg_medium_light_skin_tone", "🦵🏼",
+	"leg_medium_skin_tone", "🦵🏽",
+	"leg_tone1", "🦵🏻",
+	"leg_tone2", "🦵🏼",
+	"leg_tone3", "🦵🏽",
+	"leg_tone4", "🦵🏾",
+	"leg_tone5", "🦵🏿",
+	"lemon", "🍋",
+	"leo", "♌",
+	"leopard", "🐆",
+	"level_slider", "🎚️",
+	"levitate", "🕴️",
+	"levitate_tone1", "🕴🏻",
+	"levitate_tone2", "🕴🏼",
+	"levitate_tone3", "🕴🏽",
+	"levitate_tone4", "🕴🏾",
+	"levitate_tone5", "🕴🏿",
+	"liar", "🤥",
+	"libra", "♎",
+	"lifter", "🏋️",
+	"lifter_tone1", "🏋🏻",
+	"lifter_tone2", "🏋🏼",
+	"lifter_tone3", "🏋🏽",
+	"lifter_tone4", "🏋🏾",
+	"lifter_tone5", "🏋🏿",
+	"light_rail", "🚈",
+	"link", "🔗",
+	"linked_paperclips", "🖇️",
+	"lion", "🦁",
+	"lion_face", "🦁",
+	"lips", "👄",
+	"lipstick", "💄",
+	"lizard", "🦎",
+	"llama", "🦙",
+	"lobster", "🦞",
+	"lock", "🔒",
+	"lock_with_ink_pen", "🔏",
+	"lollipop", "🍭",
+	"long_drum", "🪘",
+	"loop", "➿",
+	"lotus", "🪷",
+	"loud_sound", "🔊",
+	"loudspeaker", "📢",
+	"love_hotel", "🏩",
+	"love_letter", "💌",
+	"love_you_gesture", "🤟",
+	"love_you_gesture_dark_skin_tone", "🤟🏿",
+	"love_you_gesture_light_skin_tone", "🤟🏻",
+	"love_you_gesture_medium_dark_skin_tone", "🤟🏾",
+	"love_you_gesture_medium_light_skin_tone", "🤟🏼",
+	"love_you_gesture_medium_skin_tone", "🤟🏽",
+	"love_you_gesture_tone1", "🤟🏻",
+	"love_you_gesture_tone2", "🤟🏼",
+	"love_you_gesture_tone3", "🤟🏽",
+	"love_you_gesture_tone4", "🤟🏾",
+	"love_you_gesture_tone5", "🤟🏿",
+	"low_battery", "🪫",
+	"low_brightness", "🔅",
+	"lower_left_ballpoint_pen", "🖊️",
+	"lower_left_crayon", "🖍️",
+	"lower_left_fountain_pen", "🖋️",
+	"lower_left_paintbrush", "🖌️",
+	"luggage", "🧳",
+	"lungs", "🫁",
+	"lying_face", "🤥",
+	"m", "Ⓜ️",
+	"mag", "🔍",
+	"mag_right", "🔎",
+	"mage", "🧙",
+	"mage_dark_skin_tone", "🧙🏿",
+	"mage_light_skin_tone", "🧙🏻",
+	"mage_medium_dark_skin_tone", "🧙🏾",
+	"mage_medium_light_skin_tone", "🧙🏼",
+	"mage_medium_skin_tone", "🧙🏽",
+	"mage_tone1", "🧙🏻",
+	"mage_tone2", "🧙🏼",
+	"mage_tone3", "🧙🏽",
+	"mage_tone4", "🧙🏾",
+	"mage_tone5", "🧙🏿",
+	"magic_wand", "🪄",
+	"magnet", "🧲",
+	"mahjong", "🀄",
+	"mailbox", "📫",
+	"mailbox_closed", "📪",
+	"mailbox_with_mail", "📬",
+	"mailbox_with_no_mail", "📭",
+	"male_dancer", "🕺",
+	"male_dancer_tone1", "🕺🏻",
+	"male_dancer_tone2", "🕺🏼",
+	"male_dancer_tone3", "🕺🏽",
+	"male_dancer_tone4", "🕺🏾",
+	"male_dancer_tone5", "🕺🏿",
+	"male_sign", "♂️",
+	"mammoth", "🦣",
+	"man", "👨",
+	"man_artist", "👨\u200d🎨",
+	"man_artist_dark_skin_tone", "👨🏿\u200d🎨",
+	"man_artist_light_skin_tone", "👨🏻\u200d🎨",
+	"man_artist_medium_dark_skin_tone", "👨🏾\u200d🎨",
+	"man_artist_medium_light_skin_tone", "👨🏼\u200d🎨",
+	"man_artist_medium_skin_tone", "👨🏽\u200d🎨",
+	"man_artist_tone1", "👨🏻\u200d🎨",
+	"man_artist_tone2", "👨🏼\u200d🎨",
+	"man_artist_tone3", "👨🏽\u200d🎨",
+	"man_artist_tone4", "👨🏾\u200d🎨",
+	"man_artist_tone5", "👨🏿\u200d🎨",
+	"man_astronaut", "👨\u200d🚀",
+	"man_astronaut_dark_skin_tone", "👨🏿\u200d🚀",
+	"man_astronaut_light_skin_tone", "👨🏻\u200d🚀",
+	"man_astronaut_medium_dark_skin_tone", "👨🏾\u200d🚀",
+	"man_astronaut_medium_light_skin_tone", "👨🏼\u200d🚀",
+	"man_astronaut_medium_skin_tone", "👨🏽\u200d🚀",
+	"man_astronaut_tone1", "👨🏻\u200d🚀",
+	"man_astronaut_tone2", "👨🏼\u200d🚀",
+	"man_astronaut_tone3", "👨🏽\u200d🚀",
+	"man_astronaut_tone4", "👨🏾\u200d🚀",
+	"man_astronaut_tone5", "👨🏿\u200d🚀",
+	"man_bald", "👨\u200d🦲",
+	"man_bald_dark_skin_tone", "👨🏿\u200d🦲",
+	"man_bald_light_skin_tone", "👨🏻\u200d🦲",
+	"man_bald_medium_dark_skin_tone", "👨🏾\u200d🦲",
+	"man_bald_medium_light_skin_tone", "👨🏼\u200d🦲",
+	"man_bald_medium_skin_tone", "👨🏽\u200d🦲",
+	"man_bald_tone1", "👨🏻\u200d🦲",
+	"man_bald_tone2", "👨🏼\u200d🦲",
+	"man_bald_tone3", "👨🏽\u200d🦲",
+	"man_bald_tone4", "👨🏾\u200d🦲",
+	"man_bald_tone5", "👨🏿\u200d🦲",
+	"man_beard", "🧔\u200d♂️",
+	"man_biking", "🚴\u200d♂️",
+	"man_biking_dark_skin_tone", "🚴🏿\u200d♂️",
+	"man_biking_light_skin_tone", "🚴🏻\u200d♂️",
+	"man_biking_medium_dark_skin_tone", "🚴🏾\u200d♂️",
+	"man_biking_medium_light_skin_tone", "🚴🏼\u200d♂️",
+	"man_biking_medium_skin_tone", "🚴🏽\u200d♂️",
+	"man_biking_tone1", "🚴🏻\u200d♂️",
+	"man_biking_tone2", "🚴🏼\u200d♂️",
+	"man_biking_tone3", "🚴🏽\u200d♂️",
+	"man_biking_tone4", "🚴🏾\u200d♂️",
+	"man_biking_tone5", "🚴🏿\u200d♂️",
+	"man_bouncing_ball", "⛹️\u200d♂️",
+	"man_bouncing_ball_dark_skin_tone", "⛹🏿\u200d♂️",
+	"man_bouncing_ball_light_skin_tone", "⛹🏻\u200d♂️",
+	"man_bouncing_ball_medium_dark_skin_tone", "⛹🏾\u200d♂️",
+	"man_bouncing_ball_medium_light_skin_tone", "⛹🏼\u200d♂️",
+	"man_bouncing_ball_medium_skin_tone", "⛹🏽\u200d♂️",
+	"man_bouncing_ball_tone1", "⛹🏻\u200d♂️",
+	"man_bouncing_ball_tone2", "⛹🏼\u200d♂️",
+	"man_bouncing_ball_tone3", "⛹🏽\u200d♂️",
+	"man_bouncing_ball_tone4", "⛹🏾\u200d♂️",
+	"man_bouncing_ball_tone5", "⛹🏿\u200d♂️",
+	"man_bowing", "🙇\u200d♂️",
+	"man_bowing_dark_skin_tone", "🙇🏿\u200d♂️",
+	"man_bowing_light_skin_tone", "🙇🏻\u200d♂️",
+	"man_bowing_medium_dark_skin_tone", "🙇🏾\u200d♂️",
+	"man_bowing_medium_light_skin_tone", "🙇🏼\u200d♂️",
+	"man_bowing_medium_skin_tone", "🙇🏽\u200d♂️",
+	"man_bowing_tone1", "🙇🏻\u200d♂️",
+	"man_bowing_tone2", "🙇🏼\u200d♂️",
+	"man_bowing_tone3", "🙇🏽\u200d♂️",
+	"man_bowing_tone4", "🙇🏾\u200d♂️",
+	"man_bowing_tone5", "🙇🏿\u200d♂️",
+	"man_cartwheeling", "🤸\u200d♂️",
+	"man_cartwheeling_dark_skin_tone", "🤸🏿\u200d♂️",
+	"man_cartwheeling_light_skin_tone", "🤸🏻\u200d♂️",
+	"man_cartwheeling_medium_dark_skin_tone", "🤸🏾\u200d♂️",
+	"man_cartwheeling_medium_light_skin_tone", "🤸🏼\u200d♂️",
+	"man_cartwheeling_medium_skin_tone", "🤸🏽\u200d♂️",
+	"man_cartwheeling_tone1", "🤸🏻\u200d♂️",
+	"man_cartwheeling_tone2", "🤸🏼\u200d♂️",
+	"man_cartwheeling_tone3", "🤸🏽\u200d♂️",
+	"man_cartwheeling_tone4", "🤸🏾\u200d♂️",
+	"man_cartwheeling_tone5", "🤸🏿\u200d♂️",
+	"man_climbing", "🧗\u200d♂️",
+	"man_climbing_dark_skin_tone", "🧗🏿\u200d♂️",
+	"man_climbing_light_skin_tone", "🧗🏻\u200d♂️",
+	"man_climbing_medium_dark_skin_tone", "🧗🏾\u200d♂️",
+	"man_climbing_medium_light_skin_tone", "🧗🏼\u200d♂️",
+	"man_climbing_medium_skin_tone", "🧗🏽\u200d♂️",
+	"man_climbing_tone1", "🧗🏻\u200d♂️",
+	"man_climbing_tone2", "🧗🏼\u200d♂️",
+	"man_climbing_tone3", "🧗🏽\u200d♂️",
+	"man_climbing_tone4", "🧗🏾\u200d♂️",
+	"man_climbing_tone5", "🧗🏿\u200d♂️",
+	"man_construction_worker", "👷\u200d♂️",
+	"man_construction_worker_dark_skin_tone", "👷🏿\u200d♂️",
+	"man_construction_worker_light_skin_tone", "👷🏻\u200d♂️",
+	"man_construction_worker_medium_dark_skin_tone", "👷🏾\u200d♂️",
+	"man_construction_worker_medium_light_skin_tone", "👷🏼\u200d♂️",
+	"man_construction_worker_medium_skin_tone", "👷🏽\u200d♂️",
+	"man_construction_worker_tone1", "👷🏻\u200d♂️",
+	"man_construction_worker_tone2", "👷🏼\u200d♂️",
+	"man_construction_worker_tone3", "👷🏽\u200d♂️",
+	"man_construction_worker_tone4", "👷🏾\u200d♂️",
+	"man_construction_worker_tone5", "👷🏿\u200d♂️",
+	"man_cook", "👨\u200d🍳",
+	"man_cook_dark_skin_tone", "👨🏿\u200d🍳",
+	"man_cook_light_skin_tone", "👨🏻\u200d🍳",
+	"man_cook_medium_dark_skin_tone", "👨🏾\u200d🍳",
+	"man_cook_medium_light_skin_tone", "👨🏼\u200d🍳",
+	"man_cook_medium_skin_tone", "👨🏽\u200d🍳",
+	"man_cook_tone1", "👨🏻\u200d🍳",
+	"man_cook_tone2", "👨🏼\u200d🍳",
+	"man_cook_tone3", "👨🏽\u200d🍳",
+	"man_cook_tone4", "👨🏾\u200d🍳",
+	"man_cook_tone5", "👨🏿\u200d🍳",
+	"man_curly_haired", "👨\u200d🦱",
+	"man_curly_haired_dark_skin_tone", "👨🏿\u200d🦱",
+	"man_curly_haired_light_skin_tone", "👨🏻\u200d🦱",
+	"man_curly_haired_medium_dark_skin_tone", "👨🏾\u200d🦱",
+	"man_curly_haired_medium_light_skin_tone", "👨🏼\u200d🦱",
+	"man_curly_haired_medium_skin_tone", "👨🏽\u200d🦱",
+	"man_curly_haired_tone1", "👨🏻\u200d🦱",
+	"man_curly_haired_tone2", "👨🏼\u200d🦱",
+	"man_curly_haired_tone3", "👨🏽\u200d🦱",
+	"man_curly_haired_tone4", "👨🏾\u200d🦱",
+	"man_curly_haired_tone5", "👨🏿\u200d🦱",
+	"man_dancing", "🕺",
+	"man_dancing_tone1", "🕺🏻",
+	"man_dancing_tone2", "🕺🏼",
+	"man_dancing_tone3", "🕺🏽",
+	"man_dancing_tone4", "🕺🏾",
+	"man_dancing_tone5", "🕺🏿",
+	"man_dark_skin_tone_beard", "🧔🏿\u200d♂️",
+	"man_detective", "🕵️\u200d♂️",
+	"man_detective_dark_skin_tone", "🕵🏿\u200d♂️",
+	"man_detective_light_skin_tone", "🕵🏻\u200d♂️",
+	"man_detective_medium_dark_skin_tone", "🕵🏾\u200d♂️",
+	"man_detective_medium_light_skin_tone", "🕵🏼\u200d♂️",
+	"man_detective_medium_skin_tone", "🕵🏽\u200d♂️",
+	"man_detective_tone1", "🕵🏻\u200d♂️",
+	"man_detective_tone2", "🕵🏼\u200d♂️",
+	"man_detective_tone3", "🕵🏽\u200d♂️",
+	"man_detective_tone4", "🕵🏾\u200d♂️",
+	"man_detective_tone5", "🕵🏿\u200d♂️",
+	"man_elf", "🧝\u200d♂️",
+	"man_elf_dark_skin_tone", "🧝🏿\u200d♂️",
+	"man_elf_light_skin_tone", "🧝🏻\u200d♂️",
+	"man_elf_medium_dark_skin_tone", "🧝🏾\u200d♂️",
+	"man_elf_medium_light_skin_tone", "🧝🏼\u200d♂️",
+	"man_elf_medium_skin_tone", "🧝🏽\u200d♂️",
+	"man_elf_tone1", "🧝🏻\u200d♂️",
+	"man_elf_tone2", "🧝🏼\u200d♂️",
+	"man_elf_tone3", "🧝🏽\u200d♂️",
+	"man_elf_tone4", "🧝🏾\u200d♂️",
+	"man_elf_tone5", "🧝🏿\u200d♂️",
+	"man_facepalming", "🤦\u200d♂️",
+	"man_facepalming_dark_skin_tone", "🤦🏿\u200d♂️",
+	"man_facepalming_light_skin_tone", "🤦🏻\u200d♂️",
+	"man_facepalming_medium_dark_skin_tone", "🤦🏾\u200d♂️",
+	"man_facepalming_medium_light_skin_tone", "🤦🏼\u200d♂️",
+	"man_facepalming_medium_skin_tone", "🤦🏽\u200d♂️",
+	"man_facepalming_tone1", "🤦🏻\u200d♂️",
+	"man_facepalming_tone2", "🤦🏼\u200d♂️",
+	"man_facepalming_tone3", "🤦🏽\u200d♂️",
+	"man_facepalming_tone4", "🤦🏾\u200d♂️",
+	"man_facepalming_tone5", "🤦🏿\u200d♂️",
+	"man_factory_worker", "👨\u200d🏭",
+	"man_factory_worker_dark_skin_tone", "👨🏿\u200d🏭",
+	"man_factory_worker_light_skin_tone", "👨🏻\u200d🏭",
+	"man_factory_worker_medium_dark_skin_tone", "👨🏾\u200d🏭",
+	"man_factory_worker_medium_light_skin_tone", "👨🏼\u200d🏭",
+	"man_factory_worker_medium_skin_tone", "👨🏽\u200d🏭",
+	"man_factory_worker_tone1", "👨🏻\u200d🏭",
+	"man_factory_worker_tone2", "👨🏼\u200d🏭",
+	"man_factory_worker_tone3", "👨🏽\u200d🏭",
+	"man_factory_worker_tone4", "👨🏾\u200d🏭",
+	"man_factory_worker_tone5", "👨🏿\u200d🏭",
+	"man_fairy", "🧚\u200d♂️",
+	"man_fairy_dark_skin_tone", "🧚🏿\u200d♂️",
+	"man_fairy_light_skin_tone", "🧚🏻\u200d♂️",
+	"man_fairy_medium_dark_skin_tone", "🧚🏾\u200d♂️",
+	"man_fairy_medium_light_skin_tone", "🧚🏼\u200d♂️",
+	"man_fairy_medium_skin_tone", "🧚🏽\u200d♂️",
+	"man_fairy_tone1", "🧚🏻\u200d♂️",
+	"man_fairy_tone2", "🧚🏼\u200d♂️",
+	"man_fairy_tone3", "🧚🏽\u200d♂️",
+	"man_fairy_tone4", "🧚🏾\u200d♂️",
+	"man_fairy_tone5", "🧚🏿\u200d♂️",
+	"man_farmer", "👨\u200d🌾",
+	"man_farmer_dark_skin_tone", "👨🏿\u200d🌾",
+	"man_farmer_light_skin_tone", "👨🏻\u200d🌾",
+	"man_farmer_medium_dark_skin_tone", "👨🏾\u200d🌾",
+	"man_farmer_medium_light_skin_tone", "👨🏼\u200d🌾",
+	"man_farmer_medium_skin_tone", "👨🏽\u200d🌾",
+	"man_farmer_tone1", "👨🏻\u200d🌾",
+	"man_farmer_tone2", "👨🏼\u200d🌾",
+	"man_farmer_tone3", "👨🏽\u200d🌾",
+	"man_farmer_tone4", "👨🏾\u200d🌾",
+	"man_farmer_tone5", "👨🏿\u200d🌾",
+	"man_feeding_baby", "👨\u200d🍼",
+	"man_feeding_baby_dark_skin_tone", "👨🏿\u200d🍼",
+	"man_feeding_baby_light_skin_tone", "👨🏻\u200d🍼",
+	"man_feeding_baby_medium_dark_skin_tone", "👨🏾\u200d🍼",
+	"man_feeding_baby_medium_light_skin_tone", "👨🏼\u200d🍼",
+	"man_feeding_baby_medium_skin_tone", "👨🏽\u200d🍼",
+	"man_feeding_baby_tone1", "👨🏻\u200d🍼",
+	"man_feeding_baby_tone2", "👨🏼\u200d🍼",
+	"man_feeding_baby_tone3", "👨🏽\u200d🍼",
+	"man_feeding_baby_tone4", "👨🏾\u200d🍼",
+	"man_feeding_baby_tone5", "👨🏿\u200d🍼",
+	"man_firefighter", "👨\u200d🚒",
+	"man_firefighter_dark_skin_tone", "👨🏿\u200d🚒",
+	"man_firefighter_light_skin_tone", "👨🏻\u200d🚒",
+	"man_firefighter_medium_dark_skin_tone", "👨🏾\u200d🚒",
+	"man_firefighter_medium_light_skin_tone", "👨🏼\u200d🚒",
+	"man_firefighter_medium_skin_tone", "👨🏽\u200d🚒",
+	"man_firefighter_tone1", "👨🏻\u200d🚒",
+	"man_firefighter_tone2", "👨🏼\u200d🚒",
+	"man_firefighter_tone3", "👨🏽\u200d🚒",
+	"man_firefighter_tone4", "👨🏾\u200d🚒",
+	"man_firefighter_tone5", "👨🏿\u200d🚒",
+	"man_frowning", "🙍\u200d♂️",
+	"man_frowning_dark_skin_tone", "🙍🏿\u200d♂️",
+	"man_frowning_light_skin_tone", "🙍🏻\u200d♂️",
+	"man_frowning_medium_dark_skin_tone", "🙍🏾\u200d♂️",
+	"man_frowning_medium_light_skin_tone", "🙍🏼\u200d♂️",
+	"man_frowning_medium_skin_tone", "🙍🏽\u200d♂️",
+	"man_frowning_tone1", "🙍🏻\u200d♂️",
+	"man_frowning_tone2", "🙍🏼\u200d♂️",
+	"man_frowning_tone3", "🙍🏽\u200d♂️",
+	"man_frowning_tone4", "🙍🏾\u200d♂️",
+	"man_frowning_tone5", "🙍🏿\u200d♂️",
+	"man_genie", "🧞\u200d♂️",
+	"man_gesturing_no", "🙅\u200d♂️",
+	"man_gesturing_no_dark_skin_tone", "🙅🏿\u200d♂️",
+	"man_gesturing_no_light_skin_tone", "🙅🏻\u200d♂️",
+	"man_gesturing_no_medium_dark_skin_tone", "🙅🏾\u200d♂️",
+	"man_gesturing_no_medium_light_skin_tone", "🙅🏼\u200d♂️",
+	"man_gesturing_no_medium_skin_tone", "🙅🏽\u200d♂️",
+	"man_gesturing_no_tone1", "🙅🏻\u200d♂️",
+	"man_gesturing_no_tone2", "🙅🏼\u200d♂️",
+	"man_gesturing_no_tone3", "🙅🏽\u200d♂️",
+	"man_gesturing_no_tone4", "🙅🏾\u200d♂️",
+	"man_gesturing_no_tone5", "🙅🏿\u200d♂️",
+	"man_gesturing_ok", "🙆\u200d♂️",
+	"man_gesturing_ok_dark_skin_tone", "🙆🏿\u200d♂️",
+	"man_gesturing_ok_light_skin_tone", "🙆🏻\u200d♂️",
+	"man_gesturing_ok_medium_dark_skin_tone", "🙆🏾\u200d♂️",
+	"man_gesturing_ok_medium_light_skin_tone", "🙆🏼\u200d♂️",
+	"man_gesturing_ok_medium_skin_tone", "🙆🏽\u200d♂️",
+	"man_gesturing_ok_tone1", "🙆🏻\u200d♂️",
+	"man_gesturing_ok_tone2", "🙆🏼\u200d♂️",
+	"man_gesturing_ok_tone3", "🙆🏽\u200d♂️",
+	"man_gesturing_ok_tone4", "🙆🏾\u200d♂️",
+	"man_gesturing_ok_tone5", "🙆🏿\u200d♂️",
+	"man_getting_face_massage", "💆\u200d♂️",
+	"man_getting_face_massage_dark_skin_tone", "💆🏿\u200d♂️",
+	"man_getting_face_massage_light_skin_tone", "💆🏻\u200d♂️",
+	"man_getting_face_massage_medium_dark_skin_tone", "💆🏾\u200d♂️",
+	"man_getting_face_massage_medium_light_skin_tone", "💆🏼\u200d♂️",
+	"man_getting_face_massage_medium_skin_tone", "💆🏽\u200d♂️",
+	"man_getting_face_massage_tone1", "💆🏻\u200d♂️",
+	"man_getting_face_massage_tone2", "💆🏼\u200d♂️",
+	"man_getting_face_massage_tone3", "💆🏽\u200d♂️",
+	"man_getting_face_massage_tone4", "💆🏾\u200d♂️",
+	"man_getting_face_massage_tone5", "💆🏿\u200d♂️",
+	"man_getting_haircut", "💇\u200d♂️",
+	"man_getting_haircut_dark_skin_tone", "💇🏿\u200d♂️",
+	"man_getting_haircut_light_skin_tone", "💇🏻\u200d♂️",
+	"man_getting_haircut_medium_dark_skin_tone", "💇🏾\u200d♂️",
+	"man_getting_haircut_medium_light_skin_tone", "💇🏼\u200d♂️",
+	"man_getting_haircut_medium_skin_tone", "💇🏽\u200d♂️",
+	"man_getting_haircut_tone1", "💇🏻\u200d♂️",
+	"man_getting_haircut_tone2", "💇🏼\u200d♂️",
+	"man_getting_haircut_tone3", "💇🏽\u200d♂️",
+	"man_getting_haircut_tone4", "💇🏾\u200d♂️",
+	"man_getting_haircut_tone5", "💇🏿\u200d♂️",
+	"man_golfing", "🏌️\u200d♂️",
+	"man_golfing_dark_skin_tone", "🏌🏿\u200d♂️",
+	"man_golfing_light_skin_tone", "🏌🏻\u200d♂️",
+	"man_golfing_medium_dark_skin_tone", "🏌🏾\u200d♂️",
+	"man_golfing_medium_light_skin_tone", "🏌🏼\u200d♂️",
+	"man_golfing_medium_skin_tone", "🏌🏽\u200d♂️",
+	"man_golfing_tone1", "🏌🏻\u200d♂️",
+	"man_golfing_tone2", "🏌🏼\u200d♂️",
+	"man_golfing_tone3", "🏌🏽\u200d♂️",
+	"man_golfing_tone4", "🏌🏾\u200d♂️",
+	"man_golfing_tone5", "🏌🏿\u200d♂️",
+	"man_guard", "💂\u200d♂️",
+	"man_guard_dark_skin_tone", "💂🏿\u200d♂️",
+	"man_guard_light_skin_tone", "💂🏻\u200d♂️",
+	"man_guard_medium_dark_skin_tone", "💂🏾\u200d♂️",
+	"man_guard_medium_light_skin_tone", "💂🏼\u200d♂️",
+	"man_guard_medium_skin_tone", "💂🏽\u200d♂️",
+	"man_guard_tone1", "💂🏻\u200d♂️",
+	"man_guard_tone2", "💂🏼\u200d♂️",
+	"man_guard_tone3", "💂🏽\u200d♂️",
+	"man_guard_tone4", "💂🏾\u200d♂️",
+	"man_guard_tone5", "💂🏿\u200d♂️",
+	"man_health_worker", "👨\u200d⚕️",
+	"man_health_worker_dark_skin_tone", "👨🏿\u200d⚕️",
+	"man_health_worker_light_skin_tone", "👨🏻\u200d⚕️",
+	"man_health_worker_medium_dark_skin_tone", "👨🏾\u200d⚕️",
+	"man_health_worker_medium_light_skin_tone", "👨🏼\u200d⚕️",
+	"man_health_worker_medium_skin_tone", "👨🏽\u200d⚕️",
+	"man_health_worker_tone1", "👨🏻\u200d⚕️",
+	"man_health_worker_tone2", "👨🏼\u200d⚕️",
+	"man_health_worker_tone3", "👨🏽\u200d⚕️",
+	"man_health_worker_tone4", "👨🏾\u200d⚕️",
+	"man_health_worker_tone5", "👨🏿\u200d⚕️",
+	"man_in_business_suit_levitating", "🕴️",
+	"man_in_business_suit_levitating_dark_skin_tone", "🕴🏿",
+	"man_in_business_suit_levitating_light_skin_tone", "🕴🏻",
+	"man_in_business_suit_levitating_medium_dark_skin_tone", "🕴🏾",
+	"man_in_business_suit_levitating_medium_light_skin_tone", "🕴🏼",
+	"man_in_business_suit_levitating_medium_skin_tone", "🕴🏽",
+	"man_in_business_suit_levitating_tone1", "🕴🏻",
+	"man_in_business_suit_levitating_tone2", "🕴🏼",
+	"man_in_business_suit_levitating_tone3", "🕴🏽",
+	"man_in_business_suit_levitating_tone4", "🕴🏾",
+	"man_in_business_suit_levitating_tone5", "🕴🏿",
+	"man_in_lotus_position", "🧘\u200d♂️",
+	"man_in_lotus_position_dark_skin_tone", "🧘🏿\u200d♂️",
+	"man_in_lotus_position_light_skin_tone", "🧘🏻\u200d♂️",
+	"man_in_lotus_position_medium_dark_skin_tone", "🧘🏾\u200d♂️",
+	"man_in_lotus_position_medium_light_skin_tone", "🧘🏼\u200d♂️",
+	"man_in_lotus_position_medium_skin_tone", "🧘🏽\u200d♂️",
+	"man_in_lotus_position_tone1", "🧘🏻\u200d♂️",
+	"man_in_lotus_position_tone2", "🧘🏼\u200d♂️",
+	"man_in_lotus_position_tone3", "🧘🏽\u200d♂️",
+	"man_in_lotus_position_tone4", "🧘🏾\u200d♂️",
+	"man_in_lotus_position_tone5", "🧘🏿\u200d♂️",
+	"man_in_manual_wheelchair", "👨\u200d🦽",
+	"man_in_manual_wheelchair_dark_skin_tone", "👨🏿\u200d🦽",
+	"man_in_manual_wheelchair_light_skin_tone", "👨🏻\u200d🦽",
+	"man_in_manual_wheelchair_medium_dark_skin_tone", "👨🏾\u200d🦽",
+	"man_in_manual_wheelchair_medium_light_skin_tone", "👨🏼\u200d🦽",
+	"man_in_manual_wheelchair_medium_skin_tone", "👨🏽\u200d🦽",
+	"man_in_manual_wheelchair_tone1", "👨🏻\u200d🦽",
+	"man_in_manual_wheelchair_tone2", "👨🏼\u200d🦽",
+	"man_in_manual_wheelchair_tone3", "👨🏽\u200d🦽",
+	"man_in_manual_wheelchair_tone4", "👨🏾\u200d🦽",
+	"man_in_manual_wheelchair_tone5", "👨🏿\u200d🦽",
+	"man_in_motorized_wheelchair", "👨\u200d🦼",
+	"man_in_motorized_wheelchair_dark_skin_tone", "👨🏿\u200d🦼",
+	"man_in_motorized_wheelchair_light_skin_tone", "👨🏻\u200d🦼",
+	"man_in_motorized_wheelchair_medium_dark_skin_tone", "👨🏾\u200d🦼",
+	"man_in_motorized_wheelchair_medium_light_skin_tone", "👨🏼\u200d🦼",
+	"man_in_motorized_wheelchair_medium_skin_tone", "👨🏽\u200d🦼",
+	"man_in_motorized_wheelchair_tone1", "👨🏻\u200d🦼",
+	"man_in_motorized_wheelchair_tone2", "👨🏼\u200d🦼",
+	"man_in_motorized_wheelchair_tone3", "👨🏽\u200d🦼",
+	"man_in_motorized_wheelchair_tone4", "👨🏾\u200d🦼",
+	"man_in_motorized_wheelchair_tone5", "👨🏿\u200d🦼",
+	"man_in_steamy_room", "🧖\u200d♂️",
+	"man_in_steamy_room_dark_skin_tone", "🧖🏿\u200d♂️",
+	"man_in_steamy_room_light_skin_tone", "🧖🏻\u200d♂️",
+	"man_in_steamy_room_medium_dark_skin_tone", "🧖🏾\u200d♂️",
+	"man_in_steamy_room_medium_light_skin_tone", "🧖🏼\u200d♂️",
+	"man_in_steamy_room_medium_skin_tone", "🧖🏽\u200d♂️",
+	"man_in_steamy_room_tone1", "🧖🏻\u200d♂️",
+	"man_in_steamy_room_tone2", "🧖🏼\u200d♂️",
+	"man_in_steamy_room_tone3", "🧖🏽\u200d♂️",
+	"man_in_steamy_room_tone4", "🧖🏾\u200d♂️",
+	"man_in_steamy_room_tone5", "🧖🏿\u200d♂️",
+	"man_in_tuxedo", "🤵\u200d♂️",
+	"man_in_tuxedo_dark_skin_tone", "🤵🏿\u200d♂️",
+	"man_in_tuxedo_light_skin_tone", "🤵🏻\u200d♂️",
+	"man_in_tuxedo_medium_dark_skin_tone", "🤵🏾\u200d♂️",
+	"man_in_tuxedo_medium_light_skin_tone", "🤵🏼\u200d♂️",
+	"man_in_tuxedo_medium_skin_tone", "🤵🏽\u200d♂️",
+	"man_in_tuxedo_tone1", "🤵🏻\u200d♂️",
+	"man_in_tuxedo_tone2", "🤵🏼\u200d♂️",
+	"man_in_tuxedo_tone3", "🤵🏽\u200d♂️",
+	"man_in_tuxedo_tone4", "🤵🏾\u200d♂️",
+	"man_in_tuxedo_tone5", "🤵🏿\u200d♂️",
+	"man_judge", "👨\u200d⚖️",
+	"man_judge_dark_skin_tone", "👨🏿\u200d⚖️",
+	"man_judge_light_skin_tone", "👨🏻\u200d⚖️",
+	"man_judge_medium_dark_skin_tone", "👨🏾\u200d⚖️",
+	"man_judge_medium_light_skin_tone", "👨🏼\u200d⚖️",
+	"man_judge_medium_skin_tone", "👨🏽\u200d⚖️",
+	"man_judge_tone1", "👨🏻\u200d⚖️",
+	"man_judge_tone2", "👨🏼\u200d⚖️",
+	"man_judge_tone3", "👨🏽\u200d⚖️",
+	"man_judge_tone4", "👨🏾\u200d⚖️",
+	"man_judge_tone5", "👨🏿\u200d⚖️",
+	"man_juggling", "🤹\u200d♂️",
+	"man_juggling_dark_skin_tone", "🤹🏿\u200d♂️",
+	"man_juggling_light_skin_tone", "🤹🏻\u200d♂️",
+	"man_juggling_medium_dark_skin_tone", "🤹🏾\u200d♂️",
+	"man_juggling_medium_light_skin_tone", "🤹🏼\u200d♂️",
+	"man_juggling_medium_skin_tone", "🤹🏽\u200d♂️",
+	"man_juggling_tone1", "🤹🏻\u200d♂️",
+	"man_juggling_tone2", "🤹🏼\u200d♂️",
+	"man_juggling_tone3", "🤹🏽\u200d♂️",
+	"man_juggling_tone4", "🤹🏾\u200d♂️",
+	"man_juggling_tone5", "🤹🏿\u200d♂️",
+	"man_kneeling", "🧎\u200d♂️",
+	"man_kneeling_dark_skin_tone", "🧎🏿\u200d♂️",
+	"man_kneeling_light_skin_tone", "🧎🏻\u200d♂️",
+	"man_kneeling_medium_dark_skin_tone", "🧎🏾\u200d♂️",
+	"man_kneeling_medium_light_skin_tone", "🧎🏼\u200d♂️",
+	"man_kneeling_medium_skin_tone", "🧎🏽\u200d♂️",
+	"man_kneeling_tone1", "🧎🏻\u200d♂️",
+	"man_kneeling_tone2", "🧎🏼\u200d♂️",
+	"man_kneeling_tone3", "🧎🏽\u200d♂️",
+	"man_kneeling_tone4", "🧎🏾\u200d♂️",
+	"man_kneeling_tone5", "🧎🏿\u200d♂️",
+	"man_lifting_weights", "🏋️\u200d♂️",
+	"man_lifting_weights_dark_skin_tone", "🏋🏿\u200d♂️",
+	"man_lifting_weights_light_skin_tone", "🏋🏻\u200d♂️",
+	"man_lifting_weights_medium_dark_skin_tone", "🏋🏾\u200d♂️",
+	"man_lifting_weights_medium_light_skin_tone", "🏋🏼\u200d♂️",
+	"man_lifting_weights_medium_skin_tone", "🏋🏽\u200d♂️",
+	"man_lifting_weights_tone1", "🏋🏻\u200d♂️",
+	"man_lifting_weights_tone2", "🏋🏼\u200d♂️",
+	"man_lifting_weights_tone3", "🏋🏽\u200d♂️",
+	"man_lifting_weights_tone4", "🏋🏾\u200d♂️",
+	"man_lifting_weights_tone5", "🏋🏿\u200d♂️",
+	"man_light_skin_tone_beard", "🧔🏻\u200d♂️",
+	"man_mage", "🧙\u200d♂️",
+	"man_mage_dark_skin_tone", "🧙🏿\u200d♂️",
+	"man_mage_light_skin_tone", "🧙🏻\u200d♂️",
+	"man_mage_medium_dark_skin_tone", "🧙🏾\u200d♂️",
+	"man_mage_medium_light_skin_tone", "🧙🏼\u200d♂️",
+	"man_mage_medium_skin_tone", "🧙🏽\u200d♂️",
+	"man_mage_tone1", "🧙🏻\u200d♂️",
+	"man_mage_tone2", "🧙🏼\u200d♂️",
+	"man_mage_tone3", "🧙🏽\u200d♂️",
+	"man_mage_tone4", "🧙🏾\u200d♂️",
+	"man_mage_tone5", "🧙🏿\u200d♂️",
+	"man_mechanic", "👨\u200d🔧",
+	"man_mechanic_dark_skin_tone", "👨🏿\u200d🔧",
+	"man_mechanic_light_skin_tone", "👨🏻\u200d🔧",
+	"man_mechanic_medium_dark_skin_tone", "👨🏾\u200d🔧",
+	"man_mechanic_medium_light_skin_tone", "👨🏼\u200d🔧",
+	"man_mechanic_medium_skin_tone", "👨🏽\u200d🔧",
+	"man_mechanic_tone1", "👨🏻\u200d🔧",
+	"man_mechanic_tone2", "👨🏼\u200d🔧",
+	"man_mechanic_tone3", "👨🏽\u200d🔧",
+	"man_mechanic_tone4", "👨🏾\u200d🔧",
+	"man_mechanic_tone5", "👨🏿\u200d🔧",
+	"man_medium_dark_skin_tone_beard", "🧔🏾\u200d♂️",
+	"man_medium_light_skin_tone_beard", "🧔🏼\u200d♂️",
+	"man_medium_skin_tone_beard", "🧔🏽\u200d♂️",
+	"man_mountain_biking", "🚵\u200d♂️",
+	"man_mountain_biking_dark_skin_tone", "🚵🏿\u200d♂️",
+	"man_mountain_biking_light_skin_tone", "🚵🏻\u200d♂️",
+	"man_mountain_biking_medium_dark_skin_tone", "🚵🏾\u200d♂️",
+	"man_mountain_biking_medium_light_skin_tone", "🚵🏼\u200d♂️",
+	"man_mountain_biking_medium_skin_tone", "🚵🏽\u200d♂️",
+	"man_mountain_biking_tone1", "🚵🏻\u200d♂️",
+	"man_mountain_biking_tone2", "🚵🏼\u200d♂️",
+	"man_mountain_biking_tone3", "🚵🏽\u200d♂️",
+	"man_mountain_biking_tone4", "🚵🏾\u200d♂️",
+	"man_mountain_biking_tone5", "🚵🏿\u200d♂️",
+	"man_office_worker", "👨\u200d💼",
+	"man_office_worker_dark_skin_tone", "👨🏿\u200d💼",
+	"man_office_worker_light_skin_tone", "👨🏻\u200d💼",
+	"man_office_worker_medium_dark_skin_tone", "👨🏾\u200d💼",
+	"man_office_worker_medium_light_skin_tone", "👨🏼\u200d💼",
+	"man_office_worker_medium_skin_tone", "👨🏽\u200d💼",
+	"man_office_worker_tone1", "👨🏻\u200d💼",
+	"man_office_worker_tone2", "👨🏼\u200d💼",
+	"man_office_worker_tone3", "👨🏽\u200d💼",
+	"man_office_worker_tone4", "👨🏾\u200d💼",
+	"man_office_worker_tone5", "👨🏿\u200d💼",
+	"man_pilot", "👨\u200d✈️",
+	"man_pilot_dark_skin_tone", "👨🏿\u200d✈️",
+	"man_pilot_light_skin_tone", "👨🏻\u200d✈️",
+	"man_pilot_medium_dark_skin_tone", "👨🏾\u200d✈️",
+	"man_pilot_medium_light_skin_tone", "👨🏼\u200d✈️",
+	"man_pilot_medium_skin_tone", "👨🏽\u200d✈️",
+	"man_pilot_tone1", "👨🏻\u200d✈️",
+	"man_pilot_tone2", "👨🏼\u200d✈️",
+	"man_pilot_tone3", "👨🏽\u200d✈️",
+	"man_pilot_tone4", "👨🏾\u200d✈️",
+	"man_pilot_tone5", "👨🏿\u200d✈️",
+	"man_playing_handball", "🤾\u200d♂️",
+	"man_playing_handball_dark_skin_tone", "🤾🏿\u200d♂️",
+	"man_playing_handball_light_skin_tone", "🤾🏻\u200d♂️",
+	"man_playing_handball_medium_dark_skin_tone", "🤾🏾\u200d♂️",
+	"man_playing_handball_medium_light_skin_tone", "🤾🏼\u200d♂️",
+	"man_playing_handball_medium_skin_tone", "🤾🏽\u200d♂️",
+	"man_playing_handball_tone1", "🤾🏻\u200d♂️",
+	"man_playing_handball_tone2", "🤾🏼\u200d♂️",
+	"man_playing_handball_tone3", "🤾🏽\u200d♂️",
+	"man_playing_handball_tone4", "🤾🏾\u200d♂️",
+	"man_playing_handball_tone5", "🤾🏿\u200d♂️",
+	"man_playing_water_polo", "🤽\u200d♂️",
+	"man_playing_water_polo_dark_skin_tone", "🤽🏿\u200d♂️",
+	"man_playing_water_polo_light_skin_tone", "🤽🏻\u200d♂️",
+	"man_playing_water_polo_medium_dark_skin_tone", "🤽🏾\u200d♂️",
+	"man_playing_water_polo_medium_light_skin_tone", "🤽🏼\u200d♂️",
+	"man_playing_water_polo_medium_skin_tone", "🤽🏽\u200d♂️",
+	"man_playing_water_polo_tone1", "🤽🏻\u200d♂️",
+	"man_playing_water_polo_tone2", "🤽🏼\u200d♂️",
+	"man_playing_water_polo_tone3", "🤽🏽\u200d♂️",
+	"man_playing_water_polo_tone4", "🤽🏾\u200d♂️",
+	"man_playing_water_polo_tone5", "🤽🏿\u200d♂️",
+	"man_police_officer", "👮\u200d♂️",
+	"man_police_officer_dark_skin_tone", "👮🏿\u200d♂️",
+	"man_police_officer_light_skin_tone", "👮🏻\u200d♂️",
+	"man_police_officer_medium_dark_skin_tone", "👮🏾\u200d♂️",
+	"man_police_officer_medium_light_skin_tone", "👮🏼\u200d♂️",
+	"man_police_officer_medium_skin_tone", "👮🏽\u200d♂️",
+	"man_police_officer_tone1", "👮🏻\u200d♂️",
+	"man_police_officer_tone2", "👮🏼\u200d♂️",
+	"man_police_officer_tone3", "👮🏽\u200d♂️",
+	"man_police_officer_tone4", "👮🏾\u200d♂️",
+	"man_police_officer_tone5", "👮🏿\u200d♂️",
+	"man_pouting", "🙎\u200d♂️",
+	"man_pouting_dark_skin_tone", "🙎🏿\u200d♂️",
+	"man_pouting_light_skin_tone", "🙎🏻\u200d♂️",
+	"man_pouting_medium_dark_skin_tone", "🙎🏾\u200d♂️",
+	"man_pouting_medium_light_skin_tone", "🙎🏼\u200d♂️",
+	"man_pouting_medium_skin_tone", "🙎🏽\u200d♂️",
+	"man_pouting_tone1", "🙎🏻\u200d♂️",
+	"man_pouting_tone2", "🙎🏼\u200d♂️",
+	"man_pouting_tone3", "🙎🏽\u200d♂️",
+	"man_pouting_tone4", "🙎🏾\u200d♂️",
+	"man_pouting_tone5", "🙎🏿\u200d♂️",
+	"man_raising_hand", "🙋\u200d♂️",
+	"man_raising_hand_dark_skin_tone", "🙋🏿\u200d♂️",
+	"man_raising_hand_light_skin_tone", "🙋🏻\u200d♂️",
+	"man_raising_hand_medium_dark_skin_tone", "🙋🏾\u200d♂️",
+	"man_raising_hand_medium_light_skin_tone", "🙋🏼\u200d♂️",
+	"man_raising_hand_medium_skin_tone", "🙋🏽\u200d♂️",
+	"man_raising_hand_tone1", "🙋🏻\u200d♂️",
+	"man_raising_hand_tone2", "🙋🏼\u200d♂️",
+	"man_raising_hand_tone3", "🙋🏽\u200d♂️",
+	"man_raising_hand_tone4", "🙋🏾\u200d♂️",
+	"man_raising_hand_tone5", "🙋🏿\u200d♂️",
+	"man_red_haired", "👨\u200d🦰",
+	"man_red_haired_dark_skin_tone", "👨🏿\u200d🦰",
+	"man_red_haired_light_skin_tone", "👨🏻\u200d🦰",
+	"man_red_haired_medium_dark_skin_tone", "👨🏾\u200d🦰",
+	"man_red_haired_medium_light_skin_tone", "👨🏼\u200d🦰",
+	"man_red_haired_medium_skin_tone", "👨🏽\u200d🦰",
+	"man_red_haired_tone1", "👨🏻\u200d🦰",
+	"man_red_haired_tone2", "👨🏼\u200d🦰",
+	"man_red_haired_tone3", "👨🏽\u200d🦰",
+	"man_red_haired_tone4", "👨🏾\u200d🦰",
+	"man_red_haired_tone5", "👨🏿\u200d🦰",
+	"man_rowing_boat", "🚣\u200d♂️",
+	"man_rowing_boat_dark_skin_tone", "🚣🏿\u200d♂️",
+	"man_rowing_boat_light_skin_tone", "🚣🏻\u200d♂️",
+	"man_rowing_boat_medium_dark_skin_tone", "🚣🏾\u200d♂️",
+	"man_rowing_boat_medium_light_skin_tone", "🚣🏼\u200d♂️",
+	"man_rowing_boat_medium_skin_tone", "🚣🏽\u200d♂️",
+	"man_rowing_boat_tone1", "🚣🏻\u200d♂️",
+	"man_rowing_boat_tone2", "🚣🏼\u200d♂️",
+	"man_rowing_boat_tone3", "🚣🏽\u200d♂️",
+	"man_rowing_boat_tone4", "🚣🏾\u200d♂️",
+	"man_rowing_boat_tone5", "🚣🏿\u200d♂️",
+	"man_running", "🏃\u200d♂️",
+	"man_running_dark_skin_tone", "🏃🏿\u200d♂️",
+	"man_running_light_skin_tone", "🏃🏻\u200d♂️",
+	"man_running_medium_dark_skin_tone", "🏃🏾\u200d♂️",
+	"man_running_medium_light_skin_tone", "🏃🏼\u200d♂️",
+	"man_running_medium_skin_tone", "🏃🏽\u200d♂️",
+	"man_running_tone1", "🏃🏻\u200d♂️",
+	"man_running_tone2", "🏃🏼\u200d♂️",
+	"man_running_tone3", "🏃🏽\u200d♂️",
+	"man_running_tone4", "🏃🏾\u200d♂️",
+	"man_running_tone5", "🏃🏿\u200d♂️",
+	"man_scientist", "👨\u200d🔬",
+	"man_scientist_dark_skin_tone", "👨🏿\u200d🔬",
+	"man_scientist_light_skin_tone", "👨🏻\u200d🔬",
+	"man_scientist_medium_dark_skin_tone", "👨🏾\u200d🔬",
+	"man_scientist_medium_light_skin_tone", "👨🏼\u200d🔬",
+	"man_scientist_medium_skin_tone", "👨🏽\u200d🔬",
+	"man_scientist_tone1", "👨🏻\u200d🔬",
+	"man_scientist_tone2", "👨🏼\u200d🔬",
+	"man_scientist_tone3", "👨🏽\u200d🔬",
+	"man_scientist_tone4", "👨🏾\u200d🔬",
+	"man_scientist_tone5", "👨🏿\u200d🔬",
+	"man_shrugging", "🤷\u200d♂️",
+	"man_shrugging_dark_skin_tone", "🤷🏿\u200d♂️",
+	"man_shrugging_light_skin_tone", "🤷🏻\u200d♂️",
+	"man_shrugging_medium_dark_skin_tone", "🤷🏾\u200d♂️",
+	"man_shrugging_medium_light_skin_tone", "🤷🏼\u200d♂️",
+	"man_shrugging_medium_skin_tone", "🤷🏽\u200d♂️",
+	"man_shrugging_tone1", "🤷🏻\u200d♂️",
+	"man_shrugging_tone2", "🤷🏼\u200d♂️",
+	"man_shrugging_tone3", "🤷🏽\u200d♂️",
+	"man_shrugging_tone4", "🤷🏾\u200d♂️",
+	"man_shrugging_tone5", "🤷🏿\u200d♂️",
+	"man_singer", "👨\u200d🎤",
+	"man_singer_dark_skin_tone", "👨🏿\u200d🎤",
+	"man_singer_light_skin_tone", "👨🏻\u200d🎤",
+	"man_singer_medium_dark_skin_tone", "👨🏾\u200d🎤",
+	"man_singer_medium_light_skin_tone", "👨🏼\u200d🎤",
+	"man_singer_medium_skin_tone", "👨🏽\u200d🎤",
+	"man_singer_tone1", "👨🏻\u200d🎤",
+	"man_singer_tone2", "👨🏼\u200d🎤",
+	"man_singer_tone3", "👨🏽\u200d🎤",
+	"man_singer_tone4", "👨🏾\u200d🎤",
+	"man_singer_tone5", "👨🏿\u200d🎤",
+	"man_standing", "🧍\u200d♂️",
+	"man_standing_dark_skin_tone", "🧍🏿\u200d♂️",
+	"man_standing_light_skin_tone", "🧍🏻\u200d♂️",
+	"man_standing_medium_dark_skin_tone", "🧍🏾\u200d♂️",
+	"man_standing_medium_light_skin_tone", "🧍🏼\u200d♂️",
+	"man_standing_medium_skin_tone", "🧍🏽\u200d♂️",
+	"man_standing_tone1", "🧍🏻\u200d♂️",
+	"man_standing_tone2", "🧍🏼\u200d♂️",
+	"man_standing_tone3", "🧍🏽\u200d♂️",
+	"man_standing_tone4", "🧍🏾\u200d♂️",
+	"man_standing_tone5", "🧍🏿\u200d♂️",
+	"man_student", "👨\u200d🎓",
+	"man_student_dark_skin_tone", "👨🏿\u200d🎓",
+	"man_student_light_skin_tone", "👨🏻\u200d🎓",
+	"man_student_medium_dark_skin_tone", "👨🏾\u200d🎓",
+	"man_student_medium_light_skin_tone", "👨🏼\u200d🎓",
+	"man_student_medium_skin_tone", "👨🏽\u200d🎓",
+	"man_student_tone1", "👨🏻\u200d🎓",
+	"man_student_tone2", "👨🏼\u200d🎓",
+	"man_student_tone3", "👨🏽\u200d🎓",
+	"man_student_tone4", "👨🏾\u200d🎓",
+	"man_student_tone5", "👨🏿\u200d🎓",
+	"man_superhero", "🦸\u200d♂️",
+	"man_superhero_dark_skin_tone", "🦸🏿\u200d♂️",
+	"man_superhero_light_skin_tone", "🦸🏻\u200d♂️",
+	"man_superhero_medium_dark_skin_tone", "🦸🏾\u200d♂️",
+	"man_superhero_medium_light_skin_tone", "🦸🏼\u200d♂️",
+	"man_superhero_medium_skin_tone", "🦸🏽\u200d♂️",
+	"man_superhero_tone1", "🦸🏻\u200d♂️",
+	"man_superhero_tone2", "🦸🏼\u200d♂️",
+	"man_superhero_tone3", "🦸🏽\u200d♂️",
+	"man_superhero_tone4", "🦸🏾\u200d♂️",
+	"man_superhero_tone5", "🦸🏿\u200d♂️",
+	"man_supervillain", "🦹\u200d♂️",
+	"man_supervillain_dark_skin_tone", "🦹🏿\u200d♂️",
+	"man_supervillain_light_skin_tone", "🦹🏻\u200d♂️",
+	"man_supervillain_medium_dark_skin_tone", "🦹🏾\u200d♂️",
+	"man_supervillain_medium_light_skin_tone", "🦹🏼\u200d♂️",
+	"man_supervillain_medium_skin_tone", "🦹🏽\u200d♂️",
+	"man_supervillain_tone1", "🦹🏻\u200d♂️",
+	"man_supervillain_tone2", "🦹🏼\u200d♂️",
+	"man_supervillain_tone3", "🦹🏽\u200d♂️",
+	"man_supervillain_tone4", "🦹🏾\u200d♂️",
+	"man_supervillain_tone5", "🦹🏿\u200d♂️",
+	"man_surfing", "🏄\u200d♂️",
+	"man_surfing_dark_skin_tone", "🏄🏿\u200d♂️",
+	"man_surfing_light_skin_tone", "🏄🏻\u200d♂️",
+	"man_surfing_medium_dark_skin_tone", "🏄🏾\u200d♂️",
+	"man_surfing_medium_light_skin_tone", "🏄🏼\u200d♂️",
+	"man_surfing_medium_skin_tone", "🏄🏽\u200d♂️",
+	"man_surfing_tone1", "🏄🏻\u200d♂️",
+	"man_surfing_tone2", "🏄🏼\u200d♂️",
+	"man_surfing_tone3", "🏄🏽\u200d♂️",
+	"man_surfing_tone4", "🏄🏾\u200d♂️",
+	"man_surfing_tone5", "🏄🏿\u200d♂️",
+	"man_swimming", "🏊\u200d♂️",
+	"man_swimming_dark_skin_tone", "🏊🏿\u200d♂️",
+	"man_swimming_light_skin_tone", "🏊🏻\u200d♂️",
+	"man_swimming_medium_dark_skin_tone", "🏊🏾\u200d♂️",
+	"man_swimming_medium_light_skin_tone", "🏊🏼\u200d♂️",
+	"man_swimming_medium_skin_tone", "🏊🏽\u200d♂️",
+	"man_swimming_tone1", "🏊🏻\u200d♂️",
+	"man_swimming_tone2", "🏊🏼\u200d♂️",
+	"man_swimming_tone3", "🏊🏽\u200d♂️",
+	"man_swimming_tone4", "🏊🏾\u200d♂️",
+	"man_swimming_tone5", "🏊🏿\u200d♂️",
+	"man_teacher", "👨\u200d🏫",
+	"man_teacher_dark_skin_tone", "👨🏿\u200d🏫",
+	"man_teacher_light_skin_tone", "👨🏻\u200d🏫",
+	"man_teacher_medium_dark_skin_tone", "👨🏾\u200d🏫",
+	"man_teacher_medium_light_skin_tone", "👨🏼\u200d🏫",
+	"man_teacher_medium_skin_tone", "👨🏽\u200d🏫",
+	"man_teacher_tone1", "👨🏻\u200d🏫",
+	"man_teacher_tone2", "👨🏼\u200d🏫",
+	"man_teacher_tone3", "👨🏽\u200d🏫",
+	"man_teacher_tone4", "👨🏾\u200d🏫",
+	"man_teacher_tone5", "👨🏿\u200d🏫",
+	"man_technologist", "👨\u200d💻",
+	"man_technologist_dark_skin_tone", "👨🏿\u200d💻",
+	"man_technologist_light_skin_tone", "👨🏻\u200d💻",
+	"man_technologist_medium_dark_skin_tone", "👨🏾\u200d💻",
+	"man_technologist_medium_light_skin_tone", "👨🏼\u200d💻",
+	"man_technologist_medium_skin_tone", "👨🏽\u200d💻",
+	"man_technologist_tone1", "👨🏻\u200d💻",
+	"man_technologist_tone2", "👨🏼\u200d💻",
+	"man_technologist_tone3", "👨🏽\u200d💻",
+	"man_technologist_tone4", "👨🏾\u200d💻",
+	"man_technologist_tone5", "👨🏿\u200d💻",
+	"man_tipping_hand", "💁\u200d♂️",
+	"man_tipping_hand_dark_skin_tone", "💁🏿\u200d♂️",
+	"man_tipping_hand_light_skin_tone", "💁🏻\u200d♂️",
+	"man_tipping_hand_medium_dark_skin_tone", "💁🏾\u200d♂️",
+	"man_tipping_hand_medium_light_skin_tone", "💁🏼\u200d♂️",
+	"man_tipping_hand_medium_skin_tone", "💁🏽\u200d♂️",
+	"man_tipping_hand_tone1", "💁🏻\u200d♂️",
+	"man_tipping_hand_tone2", "💁🏼\u200d♂️",
+	"man_tipping_hand_tone3", "💁🏽\u200d♂️",
+	"man_tipping_hand_tone4", "💁🏾\u200d♂️",
+	"man_tipping_hand_tone5", "💁🏿\u200d♂️",
+	"man_tone1", "👨🏻",
+	"man_tone1_beard", "🧔🏻\u200d♂️",
+	"man_tone2", "👨🏼",
+	"man_tone2_beard", "🧔🏼\u200d♂️",
+	"man_tone3", "👨🏽",
+	"man_tone3_beard", "🧔🏽\u200d♂️",
+	"man_tone4", "👨🏾",
+	"man_tone4_beard", "🧔🏾\u200d♂️",
+	"man_tone5", "👨🏿",
+	"man_tone5_beard", "🧔🏿\u200d♂️",
+	"man_vampire", "🧛\u200d♂️",
+	"man_vampire_dark_skin_tone", "🧛🏿\u200d♂️",
+	"man_vampire_light_skin_tone", "🧛🏻\u200d♂️",
+	"man_vampire_medium_dark_skin_tone", "🧛🏾\u200d♂️",
+	"man_vampire_medium_light_skin_tone", "🧛🏼\u200d♂️",
+	"man_vampire_medium_skin_tone", "🧛🏽\u200d♂️",
+	"man_vampire_tone1", "🧛🏻\u200d♂️",
+	"man_vampire_tone2", "🧛🏼\u200d♂️",
+	"man_vampire_tone3", "🧛🏽\u200d♂️",
+	"man_vampire_tone4", "🧛🏾\u200d♂️",
+	"man_vampire_tone5", "🧛🏿\u200d♂️",
+	"man_walking", "🚶\u200d♂️",
+	"man_walking_dark_skin_tone", "🚶🏿\u200d♂️",
+	"man_walking_light_skin_tone", "🚶🏻\u200d♂️",
+	"man_walking_medium_dark_skin_tone", "🚶🏾\u200d♂️",
+	"man_walking_medium_light_skin_tone", "🚶🏼\u200d♂️",
+	"man_walking_medium_skin_tone", "🚶🏽\u200d♂️",
+	"man_walking_tone1", "🚶🏻\u200d♂️",
+	"man_walking_tone2", "🚶🏼\u200d♂️",
+	"man_walking_tone3", "🚶🏽\u200d♂️",
+	"man_walking_tone4", "🚶🏾\u200d♂️",
+	"man_walking_tone5", "🚶🏿\u200d♂️",
+	"man_wearing_turban", "👳\u200d♂️",
+	"man_wearing_turban_dark_skin_tone", "👳🏿\u200d♂️",
+	"man_wearing_turban_light_skin_tone", "👳🏻\u200d♂️",
+	"man_wearing_turban_medium_dark_skin_tone", "👳🏾\u200d♂️",
+	"man_wearing_turban_medium_light_skin_tone", "👳🏼\u200d♂️",
+	"man_wearing_turban_medium_skin_tone", "👳🏽\u200d♂️",
+	"man_wearing_turban_tone1", "👳🏻\u200d♂️",
+	"man_wearing_turban_tone2", "👳🏼\u200d♂️",
+	"man_wearing_turban_tone3", "👳🏽\u200d♂️",
+	"man_wearing_turban_tone4", "👳🏾\u200d♂️",
+	"man_wearing_turban_tone5", "👳🏿\u200d♂️",
+	"man_white_haired", "👨\u200d🦳",
+	"man_white_haired_dark_skin_tone", "👨🏿\u200d🦳",
+	"man_white_haired_light_skin_tone", "👨🏻\u200d🦳",
+	"man_white_haired_medium_dark_skin_tone", "👨🏾\u200d🦳",
+	"man_white_haired_medium_light_skin_tone", "👨🏼\u200d🦳",
+	"man_white_haired_medium_skin_tone", "👨🏽\u200d🦳",
+	"man_white_haired_tone1", "👨🏻\u200d🦳",
+	"man_white_haired_tone2", "👨🏼\u200d🦳",
+	"man_white_haired_tone3", "👨🏽\u200d🦳",
+	"man_white_haired_tone4", "👨🏾\u200d🦳",
+	"man_white_haired_tone5", "👨🏿\u200d🦳",
+	"man_with_chinese_cap", "👲",
+	"man_with_chinese_cap_tone1", "👲🏻",
+	"man_with_chinese_cap_tone2", "👲🏼",
+	"man_with_chinese_cap_tone3", "👲🏽",
+	"man_with_chinese_cap_tone4", "👲🏾",
+	"man_with_chinese_cap_tone5", "👲🏿",
+	"man_with_gua_pi_mao", "👲",
+	"man_with_gua_pi_mao_tone1", "👲🏻",
+	"man_with_gua_pi_mao_tone2", "👲🏼",
+	"man_with_gua_pi_mao_tone3", "👲🏽",
+	"man_with_gua_pi_mao_tone4", "👲🏾",
+	"man_with_gua_pi_mao_tone5", "👲🏿",
+	"man_with_probing_cane", "👨\u200d🦯",
+	"man_with_probing_cane_dark_skin_tone", "👨🏿\u200d🦯",
+	"man_with_probing_cane_light_skin_tone", "👨🏻\u200d🦯",
+	"man_with_probing_cane_medium_dark_skin_tone", "👨🏾\u200d🦯",
+	"man_with_probing_cane_medium_light_skin_tone", "👨🏼\u200d🦯",
+	"man_with_probing_cane_medium_skin_tone", "👨🏽\u200d🦯",
+	"man_with_probing_cane_tone1", "👨🏻\u200d🦯",
+	"man_with_probing_cane_tone2", "👨🏼\u200d🦯",
+	"man_with_probing_cane_tone3", "👨🏽\u200d🦯",
+	"man_with_probing_cane_tone4", "👨🏾\u200d🦯",
+	"man_with_probing_cane_tone5", "👨🏿\u200d🦯",
+	"man_with_turban", "👳",
+	"man_with_turban_tone1", "👳🏻",
+	"man_with_turban_tone2", "👳🏼",
+	"man_with_turban_tone3", "👳🏽",
+	"man_with_turban_tone4", "👳🏾",
+	"man_with_turban_tone5", "👳🏿",
+	"man_with_veil", "👰\u200d♂️",
+	"man_with_veil_dark_skin_tone", "👰🏿\u200d♂️",
+	"man_with_veil_light_skin_tone", "👰🏻\u200d♂️",
+	"man_with_veil_medium_dark_skin_tone", "👰🏾\u200d♂️",
+	"man_with_veil_medium_light_skin_tone", "👰🏼\u200d♂️",
+	"man_with_veil_medium_skin_tone", "👰🏽\u200d♂️",
+	"man_with_veil_tone1", "👰🏻\u200d♂️",
+	"man_with_veil_tone2", "👰🏼\u200d♂️",
+	"man_with_veil_tone3", "👰🏽\u200d♂️",
+	"man_with_veil_tone4", "👰🏾\u200d♂️",
+	"man_with_veil_tone5", "👰🏿\u200d♂️",
+	"man_zombie", "🧟\u200d♂️",
+	"mango", "🥭",
+	"mans_shoe", "👞",
+	"mantlepiece_clock", "🕰️",
+	"manual_wheelchair", "🦽",
+	"map", "🗺️",
+	"maple_leaf", "🍁",
+	"martial_arts_uniform", "🥋",
+	"mask", "😷",
+	"massage", "💆",
+	"massage_tone1", "💆🏻",
+	"massage_tone2", "💆🏼",
+	"massage_tone3", "💆🏽",
+	"massage_tone4", "💆🏾",
+	"massage_tone5", "💆🏿",
+	"mate", "🧉",
+	"meat_on_bone", "🍖",
+	"mechanic", "🧑\u200d🔧",
+	"mechanic_dark_skin_tone", "🧑🏿\u200d🔧",
+	"mechanic_light_skin_tone", "🧑🏻\u200d🔧",
+	"mechanic_medium_dark_skin_tone", "🧑🏾\u200d🔧",
+	"mechanic_medium_light_skin_tone", "🧑🏼\u200d🔧",
+	"mechanic_medium_skin_tone", "🧑🏽\u200d🔧",
+	"mechanic_tone1", "🧑🏻\u200d🔧",
+	"mechanic_tone2", "🧑🏼\u200d🔧",
+	"mechanic_tone3", "🧑🏽\u200d🔧",
+	"mechanic_tone4", "🧑🏾\u200d🔧",
+	"mechanic_tone5", "🧑🏿\u200d🔧",
+	"mechanical_arm", "🦾",
+	"mechanical_leg", "🦿",
+	"medal", "🏅",
+	"medical_symbol", "⚕️",
+	"mega", "📣",
+	"melon", "🍈",
+	"melting_face", "🫠",
+	"memo", "📝",
+	"men_holding_hands_dark_skin_tone", "👬🏿",
+	"men_holding_hands_dark_skin_tone_light_skin_tone", "👨🏿\u200d🤝\u200d👨🏻",
+	"men_holding_hands_dark_skin_tone_medium_dark_skin_tone", "👨🏿\u200d🤝\u200d👨🏾",
+	"men_holding_hands_dark_skin_tone_medium_light_skin_tone", "👨🏿\u200d🤝\u200d👨🏼",
+	"men_holding_hands_dark_skin_tone_medium_skin_tone", "👨🏿\u200d🤝\u200d👨🏽",
+	"men_holding_hands_light_skin_tone", "👬🏻",
+	"men_holding_hands_light_skin_tone_dark_skin_tone", "👨🏻\u200d🤝\u200d👨🏿",
+	"men_holding_hands_light_skin_tone_medium_dark_skin_tone", "👨🏻\u200d🤝\u200d👨🏾",
+	"men_holding_hands_light_skin_tone_medium_light_skin_tone", "👨🏻\u200d🤝\u200d👨🏼",
+	"men_holding_hands_light_skin_tone_medium_skin_tone", "👨🏻\u200d🤝\u200d👨🏽",
+	"men_holding_hands_medium_dark_skin_tone", "👬🏾",
+	"men_holding_hands_medium_dark_skin_tone_dark_skin_tone", "👨🏾\u200d🤝\u200d👨🏿",
+	"men_holding_hands_medium_dark_skin_tone_light_skin_tone", "👨🏾\u200d🤝\u200d👨🏻",
+	"men_holding_hands_medium_dark_skin_tone_medium_light_skin_tone", "👨🏾\u200d🤝\u200d👨🏼",
+	"men_holding_hands_medium_dark_skin_tone_medium_skin_tone", "👨🏾\u200d🤝\u200d👨🏽",
+	"men_holding_hands_medium_light_skin_tone", "👬🏼",
+	"men_holding_hands_medium_light_skin_tone_dark_skin_tone", "👨🏼\u200d🤝\u200d👨🏿",
+	"men_holding_hands_medium_light_skin_tone_light_skin_tone", "👨🏼\u200d🤝\u200d👨🏻",
+	"men_holding_hands_medium_light_skin_tone_medium_dark_skin_tone", "👨🏼\u200d🤝\u200d👨🏾",
+	"men_holding_hands_medium_light_skin_tone_medium_skin_tone", "👨🏼\u200d🤝\u200d👨🏽",
+	"men_holding_hands_medium_skin_tone", "👬🏽",
+	"men_holding_hands_medium_skin_tone_dark_skin_tone", "👨🏽\u200d🤝\u200d👨🏿",
+	"men_holding_hands_medium_skin_tone_light_skin_tone", "👨🏽\u200d🤝\u200d👨🏻",
+	"men_holding_hands_medium_skin_tone_medium_dark_skin_tone", "👨🏽\u200d🤝\u200d👨🏾",
+	"men_holding_hands_medium_skin_tone_medium_light_skin_tone", "👨🏽\u200d🤝\u200d👨🏼",
+	"men_holding_hands_tone1", "👬🏻",
+	"men_holding_hands_tone1_tone2", "👨🏻\u200d🤝\u200d👨🏼",
+	"men_holding_hands_tone1_tone3", "👨🏻\u200d🤝\u200d👨🏽",
+	"men_holding_hands_tone1_tone4", "👨🏻\u200d🤝\u200d👨🏾",
+	"men_holding_hands_tone1_tone5", "👨🏻\u200d🤝\u200d👨🏿",
+	"men_holding_hands_tone2", "👬🏼",
+	"men_holding_hands_tone2_tone1", "👨🏼\u200d🤝\u200d👨🏻",
+	"men_holding_hands_tone2_tone3", "👨🏼\u200d🤝\u200d👨🏽",
+	"men_holding_hands_tone2_tone4", "👨🏼\u200d🤝\u200d👨🏾",
+	"men_holding_hands_tone2_tone5", "👨🏼\u200d🤝\u200d👨🏿",
+	"men_holding_hands_tone3", "👬🏽",
+	"men_holding_hands_tone3_tone1", "👨🏽\u200d🤝\u200d👨🏻",
+	"men_holding_hands_tone3_tone2", "👨🏽\u200d🤝\u200d👨🏼",
+	"men_holding_hands_tone3_tone4", "👨🏽\u200d🤝\u200d👨🏾",
+	"men_holding_hands_tone3_tone5", "👨🏽\u200d🤝\u200d👨🏿",
+	"men_holding_hands_tone4", "👬🏾",
+	"men_holding_hands_tone4_tone1", "👨🏾\u200d🤝\u200d👨🏻",
+	"men_holding_hands_tone4_tone2", "👨🏾\u200d🤝\u200d👨🏼",
+	"men_holding_hands_tone4_tone3", "👨🏾\u200d🤝\u200d👨🏽",
+	"men_holding_hands_tone4_tone5", "👨🏾\u200d🤝\u200d👨🏿",
+	"men_holding_hands_tone5", "👬🏿",
+	"men_holding_hands_tone5_tone1", "👨🏿\u200d🤝\u200d👨🏻",
+	"men_holding_hands_tone5_tone2", "👨🏿\u200d🤝\u200d👨🏼",
+	"men_holding_hands_tone5_tone3", "👨🏿\u200d🤝\u200d👨🏽",
+	"men_holding_hands_tone5_tone4", "👨🏿\u200d🤝\u200d👨🏾",
+	"men_with_bunny_ears_partying", "👯\u200d♂️",
+	"men_wrestling", "🤼\u200d♂️",
+	"mending_heart", "❤️\u200d🩹",
+	"menorah", "🕎",
+	"mens", "🚹",
+	"mermaid", "🧜\u200d♀️",
+	"mermaid_dark_skin_tone", "🧜🏿\u200d♀️",
+	"mermaid_light_skin_tone", "🧜🏻\u200d♀️",
+	"mermaid_medium_dark_skin_tone", "🧜🏾\u200d♀️",
+	"mermaid_medium_light_skin_tone", "🧜🏼\u200d♀️",
+	"mermaid_medium_skin_tone", "🧜🏽\u200d♀️",
+	"mermaid_tone1", "🧜🏻\u200d♀️",
+	"mermaid_tone2", "🧜🏼\u200d♀️",
+	"mermaid_tone3", "🧜🏽\u200d♀️",
+	"mermaid_tone4", "🧜🏾\u200d♀️",
+	"mermaid_tone5", "🧜🏿\u200d♀️",
+	"merman", "🧜\u200d♂️",
+	"merman_dark_skin_tone", "🧜🏿\u200d♂️",
+	"merman_light_skin_tone", "🧜🏻\u200d♂️",
+	"merman_medium_dark_skin_tone", "🧜🏾\u200d♂️",
+	"merman_medium_light_skin_tone", "🧜🏼\u200d♂️",
+	"merman_medium_skin_tone", "🧜🏽\u200d♂️",
+	"merman_tone1", "🧜🏻\u200d♂️",
+	"merman_tone2", "🧜🏼\u200d♂️",
+	"merman_tone3", "🧜🏽\u200d♂️",
+	"merman_tone4", "🧜🏾\u200d♂️",
+	"merman_tone5", "🧜🏿\u200d♂️",
+	"merperson", "🧜",
+	"merperson_dark_skin_tone", "🧜🏿",
+	"merperson_light_skin_tone", "🧜🏻",
+	"merperson_medium_dark_skin_tone", "🧜🏾",
+	"merperson_medium_light_skin_tone", "🧜🏼",
+	"merperson_medium_skin_tone", "🧜🏽",
+	"merperson_tone1", "🧜🏻",
+	"merperson_tone2", "🧜🏼",
+	"merperson_tone3", "🧜🏽",
+	"merperson_tone4", "🧜🏾",
+	"merperson_tone5", "🧜🏿",
+	"metal", "🤘",
+	"metal_tone1", "🤘🏻",
+	"metal_tone2", "🤘🏼",
+	"metal_tone3", "🤘🏽",
+	"metal_tone4", "🤘🏾",
+	"metal_tone5", "🤘🏿",
+	"metro", "🚇",
+	"microbe", "🦠",
+	"microphone", "🎤",
+	"microphone2", "🎙️",
+	"microscope", "🔬",
+	"middle_finger", "🖕",
+	"middle_finger_tone1", "🖕🏻",
+	"middle_finger_tone2", "🖕🏼",
+	"middle_finger_tone3", "🖕🏽",
+	"middle_finger_tone4", "🖕🏾",
+	"middle_finger_tone5", "🖕🏿",
+	"military_helmet", "🪖",
+	"military_medal", "🎖️",
+	"milk", "🥛",
+	"milky_way", "🌌",
+	"minibus", "🚐",
+	"minidisc", "💽",
+	"mirror", "🪞",
+	"mirror_ball", "🪩",
+	"mobile_phone", "📱",
+	"mobile_phone_off", "📴",
+	"money_mouth", "🤑",
+	"money_mouth_face", "🤑",
+	"money_with_wings", "💸",
+	"moneybag", "💰",
+	"monkey", "🐒",
+	"monkey_face", "🐵",
+	"monorail", "🚝",
+	"moon_cake", "🥮",
+	"mortar_board", "🎓",
+	"mosque", "🕌",
+	"mosquito", "🦟",
+	"mother_christmas", "🤶",
+	"mother_christmas_tone1", "🤶🏻",
+	"mother_christmas_tone2", "🤶🏼",
+	"mother_christmas_tone3", "🤶🏽",
+	"mother_christmas_tone4", "🤶🏾",
+	"mother_christmas_tone5", "🤶🏿",
+	"motor_scooter", "🛵",
+	"motorbike", "🛵",
+	"motorboat", "🛥️",
+	"motorcycle", "🏍️",
+	"motorized_wheelchair", "🦼",
+	"motorway", "🛣️",
+	"mount_fuji", "🗻",
+	"mountain", "⛰️",
+	"mountain_bicyclist", "🚵",
+	"mountain_bicyclist_tone1", "🚵🏻",
+	"mountain_bicyclist_tone2", "🚵🏼",
+	"mountain_bicyclist_tone3", "🚵🏽",
+	"mountain_bicyclist_tone4", "🚵🏾",
+	"mountain_bicyclist_tone5", "🚵🏿",
+	"mountain_cableway", "🚠",
+	"mountain_railway", "🚞",
+	"mountain_snow", "🏔️",
+	"mouse", "🐭",
+	"mouse2", "🐁",
+	"mouse_three_button", "🖱️",
+	"mouse_trap", "🪤",
+	"movie_camera", "🎥",
+	"moyai", "🗿",
+	"mrs_claus", "🤶",
+	"mrs_claus_tone1", "🤶🏻",
+	"mrs_claus_tone2", "🤶🏼",
+	"mrs_claus_tone3", "🤶🏽",
+	"mrs_claus_tone4", "🤶🏾",
+	"mrs_claus_tone5", "🤶🏿",
+	"muscle", "💪",
+	"muscle_tone1", "💪🏻",
+	"muscle_tone2", "💪🏼",
+	"muscle_tone3", "💪🏽",
+	"muscle_tone4", "💪🏾",
+	"muscle_tone5", "💪🏿",
+	"mushroom", "🍄",
+	"musical_keyboard", "🎹",
+	"musical_note", "🎵",
+	"musical_score", "🎼",
+	"mute", "🔇",
+	"mx_claus", "🧑\u200d🎄",
+	"mx_claus_dark_skin_tone", "🧑🏿\u200d🎄",
+	"mx_claus_light_skin_tone", "🧑🏻\u200d🎄",
+	"mx_claus_medium_dark_skin_tone", "🧑🏾\u200d🎄",
+	"mx_claus_medium_light_skin_tone", "🧑🏼\u200d🎄",
+	"mx_claus_medium_skin_tone", "🧑🏽\u200d🎄",
+	"mx_claus_tone1", "🧑🏻\u200d🎄",
+	"mx_claus_tone2", "🧑🏼\u200d🎄",
+	"mx_claus_tone3", "🧑🏽\u200d🎄",
+	"mx_claus_tone4", "🧑🏾\u200d🎄",
+	"mx_claus_tone5", "🧑🏿\u200d🎄",
+	"nail_care", "💅",
+	"nail_care_tone1", "💅🏻",
+	"nail_care_tone2", "💅🏼",
+	"nail_care_tone3", "💅🏽",
+	"nail_care_tone4", "💅🏾",
+	"nail_care_tone5", "💅🏿",
+	"name_badge", "📛",
+	"national_park", "🏞️",
+	"nauseated_face", "🤢",
+	"nazar_amulet", "🧿",
+	"necktie", "👔",
+	"negative_squared_cross_mark", "❎",
+	"nerd", "🤓",
+	"nerd_face", "🤓",
+	"nest_with_eggs", "🪺",
+	"nesting_dolls", "🪆",
+	"neutral_face", "😐",
+	"new", "🆕",
+	"new_moon", "🌑",
+	"new_moon_with_face", "🌚",
+	"newspaper", "📰",
+	"newspaper2", "🗞️",
+	"next_track", "⏭️",
+	"ng", "🆖",
+	"night_with_stars", "🌃",
+	"nine", "9️⃣",
+	"ninja", "🥷",
+	"ninja_dark_skin_tone", "🥷🏿",
+	"ninja_light_skin_tone", "🥷🏻",
+	"ninja_medium_dark_skin_tone", "🥷🏾",
+	"ninja_medium_light_skin_tone", "🥷🏼",
+	"ninja_medium_skin_tone", "🥷🏽",
+	"ninja_tone1", "🥷🏻",
+	"ninja_tone2", "🥷🏼",
+	"ninja_tone3", "🥷🏽",
+	"ninja_tone4", "🥷🏾",
+	"ninja_tone5", "🥷🏿",
+	"no_bell", "🔕",
+	"no_bicycles", "🚳",
+	"no_entry", "⛔",
+	"no_entry_sign", "🚫",
+	"no_good", "🙅",
+	"no_good_tone1", "🙅🏻",
+	"no_good_tone2", "🙅🏼",
+	"no_good_tone3", "🙅🏽",
+	"no_good_tone4", "🙅🏾",
+	"no_good_tone5", "🙅🏿",
+	"no_mobile_phones", "📵",
+	"no_mouth", "😶",
+	"no_pedestrians", "🚷",
+	"no_smoking", "🚭",
+	"non_potable_water", "🚱",
+	"nose", "👃",
+	"nose_tone1", "👃🏻",
+	"nose_tone2", "👃🏼",
+	"nose_tone3", "👃🏽",
+	"nose_tone4", "👃🏾",
+	"nose_tone5", "👃🏿",
+	"notebook", "📓",
+	"notebook_with_decorative_cover", "📔",
+	"notepad_spiral", "🗒️",
+	"notes", "🎶",
+	"nut_and_bolt", "🔩",
+	"o", "⭕",
+	"o2", "🅾️",
+	"ocean", "🌊",
+	"octagonal_sign", "🛑",
+	"octopus", "🐙",
+	"oden", "🍢",
+	"office", "🏢",
+	"office_worker", "🧑\u200d💼",
+	"office_worker_dark_skin_tone", "🧑🏿\u200d💼",
+	"office_worker_light_skin_tone", "🧑🏻\u200d💼",
+	"office_worker_medium_dark_skin_tone", "🧑🏾\u200d💼",
+	"office_worker_medium_light_skin_tone", "🧑🏼\u200d💼",
+	"office_worker_medium_skin_tone", "🧑🏽\u200d💼",
+	"office_worker_tone1", "🧑🏻\u200d💼",
+	"office_worker_tone2", "🧑🏼\u200d💼",
+	"office_worker_tone3", "🧑🏽\u200d💼",
+	"office_worker_tone4", "🧑🏾\u200d💼",
+	"office_worker_tone5", "🧑🏿\u200d💼",
+	"oil", "🛢️",
+	"oil_drum", "🛢️",
+	"ok", "🆗",
+	"ok_hand", "👌",
+	"ok_hand_tone1", "👌🏻",
+	"ok_hand_tone2", "👌🏼",
+	"ok_hand_tone3", "👌🏽",
+	"ok_hand_tone4", "👌🏾",
+	"ok_hand_tone5", "👌🏿",
+	"old_key", "🗝️",
+	"older_adult", "🧓",
+	"older_adult_dark_skin_tone", "🧓🏿",
+	"older_adult_light_skin_tone", "🧓🏻",
+	"older_adult_medium_dark_skin_tone", "🧓🏾",
+	"older_adult_medium_light_skin_tone", "🧓🏼",
+	"older_adult_medium_skin_tone", "🧓🏽",
+	"older_adult_tone1", "🧓🏻",
+	"older_adult_tone2", "🧓🏼",
+	"older_adult_tone3", "🧓🏽",
+	"older_adult_tone4", "🧓🏾",
+	"older_adult_tone5", "🧓🏿",
+	"older_man", "👴",
+	"older_man_tone1", "👴🏻",
+	"older_man_tone2", "👴🏼",
+	"older_man_tone3", "👴🏽",
+	"older_man_tone4", "👴🏾",
+	"older_man_tone5", "👴🏿",
+	"older_woman", "👵",
+	"older_woman_tone1", "👵🏻",
+	"older_woman_tone2", "👵🏼",
+	"older_woman_tone3", "👵🏽",
+	"older_woman_tone4", "👵🏾",
+	"older_woman_tone5", "👵🏿",
+	"olive", "🫒",
+	"om_symbol", "🕉️",
+	"on", "🔛",
+	"oncoming_automobile", "🚘",
+	"oncoming_bus", "🚍",
+	"oncoming_police_car", "🚔",
+	"oncoming_taxi", "🚖",
+	"one", "1️⃣",
+	"one_piece_swimsuit", "🩱",
+	"onion", "🧅",
+	"open_file_folder", "📂",
+	"open_hands", "👐",
+	"open_hands_tone1", "👐🏻",
+	"open_hands_tone2", "👐🏼",
+	"open_hands_tone3", "👐🏽",
+	"open_hands_tone4", "👐🏾",
+	"open_hands_tone5", "👐🏿",
+	"open_mouth", "😮",
+	"ophiuchus", "⛎",
+	"orange_book", "📙",
+	"orange_circle", "🟠",
+	"orange_heart", "🧡",
+	"orange_square", "🟧",
+	"orangutan", "🦧",
+	"orthodox_cross", "☦️",
+	"otter", "🦦",
+	"outbox_tray", "📤",
+	"owl", "🦉",
+	"ox", "🐂",
+	"oyster", "🦪",
+	"package", "📦",
+	"paella", "🥘",
+	"page_facing_up", "📄",
+	"page_with_curl", "📃",
+	"pager", "📟",
+	"paintbrush", "🖌️",
+	"palm_down_hand", "🫳",
+	"palm_down_hand_dark_skin_tone", "🫳🏿",
+	"palm_down_hand_light_skin_tone", "🫳🏻",
+	"palm_down_hand_medium_dark_skin_tone", "🫳🏾",
+	"palm_down_hand_medium_light_skin_tone", "🫳🏼",
+	"palm_down_hand_medium_skin_tone", "🫳🏽",
+	"palm_down_hand_tone1", "🫳🏻",
+	"palm_down_hand_tone2", "🫳🏼",
+	"palm_down_hand_tone3", "🫳🏽",
+	"palm_down_hand_tone4", "🫳🏾",
+	"palm_down_hand_tone5", "🫳🏿",
+	"palm_tree", "🌴",
+	"palm_up_hand", "🫴",
+	"palm_up_hand_dark_skin_tone", "🫴🏿",
+	"palm_up_hand_light_skin_tone", "🫴🏻",
+	"palm_up_hand_medium_dark_skin_tone", "🫴🏾",
+	"palm_up_hand_medium_light_skin_tone", "🫴🏼",
+	"palm_up_hand_medium_skin_tone", "🫴🏽",
+	"palm_up_hand_tone1", "🫴🏻",
+	"palm_up_hand_tone2", "🫴🏼",
+	"palm_up_hand_tone3", "🫴🏽",
+	"palm_up_hand_tone4", "🫴🏾",
+	"palm_up_hand_tone5", "🫴🏿",
+	"palms_up_together", "🤲",
+	"palms_up_together_dark_skin_tone", "🤲🏿",
+	"palms_up_together_light_skin_tone", "🤲🏻",
+	"palms_up_together_medium_dark_skin_tone", "🤲🏾",
+	"palms_up_together_medium_light_skin_tone", "🤲🏼",
+	"palms_up_together_medium_skin_tone", "🤲🏽",
+	"palms_up_together_tone1", "🤲🏻",
+	"palms_up_together_tone2", "🤲🏼",
+	"palms_up_together_tone3", "🤲🏽",
+	"palms_up_together_tone4", "🤲🏾",
+	"palms_up_together_tone5", "🤲🏿",
+	"pancakes", "🥞",
+	"panda_face", "🐼",
+	"paperclip", "📎",
+	"paperclips", "🖇️",
+	"parachute", "🪂",
+	"park", "🏞️",
+	"parking", "🅿️",
+	"parrot", "🦜",
+	"part_alternation_mark", "〽️",
+	"partly_sunny", "⛅",
+	"partying_face", "🥳",
+	"passenger_ship", "🛳️",
+	"passport_control", "🛂",
+	"pause_button", "⏸️",
+	"paw_prints", "🐾",
+	"peace", "☮️",
+	"peace_symbol", "☮️",
+	"peach", "🍑",
+	"peacock", "🦚",
+	"peanuts", "🥜",
+	"pear", "🍐",
+	"pen_ballpoint", "🖊️",
+	"pen_fountain", "🖋️",
+	"pencil", "📝",
+	"pencil2", "✏️",
+	"penguin", "🐧",
+	"pensive", "😔",
+	"people_holding_hands", "🧑\u200d🤝\u200d🧑",
+	"people_holding_hands_dark_skin_tone", "🧑🏿\u200d🤝\u200d🧑🏿",
+	"people_holding_hands_dark_skin_tone_light_skin_tone", "🧑🏿\u200d🤝\u200d🧑🏻",
+	"people_holding_hands_dark_skin_tone_medium_dark_skin_tone", "🧑🏿\u200d🤝\u200d🧑🏾",
+	"people_holding_hands_dark_skin_tone_medium_light_skin_tone", "🧑🏿\u200d🤝\u200d🧑🏼",
+	"people_holding_hands_dark_skin_tone_medium_skin_tone", "🧑🏿\u200d🤝\u200d🧑🏽",
+	"people_holding_hands_light_skin_tone", "🧑🏻\u200d🤝\u200d🧑🏻",
+	"people_holding_hands_light_skin_tone_dark_skin_tone", "🧑🏻\u200d🤝\u200d🧑🏿",
+	"people_holding_hands_light_skin_tone_medium_dark_skin_tone", "🧑🏻\u200d🤝\u200d🧑🏾",
+	"people_holding_hands_light_skin_tone_medium_light_skin_tone", "🧑🏻\u200d🤝\u200d🧑🏼",
+	"people_holding_hands_light_skin_tone_medium_skin_tone", "🧑🏻\u200d🤝\u200d🧑🏽",
+	"people_holding_hands_medium_dark_skin_tone", "🧑🏾\u200d🤝\u200d🧑🏾",
+	"people_holding_hands_medium_dark_skin_tone_dark_skin_tone", "🧑🏾\u200d🤝\u200d🧑🏿",
+	"people_holding_hands_medium_dark_skin_tone_light_skin_tone", "🧑🏾\u200d🤝\u200d🧑🏻",
+	"people_holding_hands_medium_dark_skin_tone_medium_light_skin_tone", "🧑🏾\u200d🤝\u200d🧑🏼",
+	"people_holding_hands_medium_dark_skin_tone_medium_skin_tone", "🧑🏾\u200d🤝\u200d🧑🏽",
+	"people_holding_hands_medium_light_skin_tone", "🧑🏼\u200d🤝\u200d🧑🏼",
+	"people_holding_hands_medium_light_skin_tone_dark_skin_tone", "🧑🏼\u200d🤝\u200d🧑🏿",
+	"people_holding_hands_medium_light_skin_tone_light_skin_tone", "🧑🏼\u200d🤝\u200d🧑🏻",
+	"people_holding_hands_medium_light_skin_tone_medium_dark_skin_tone", "🧑🏼\u200d🤝\u200d🧑🏾",
+	"people_holding_hands_medium_light_skin_tone_medium_skin_tone", "🧑🏼\u200d🤝\u200d🧑🏽",
+	"people_holding_hands_medium_skin_tone", "🧑🏽\u200d🤝\u200d🧑🏽",
+	"people_holding_hands_medium_skin_tone_dark_skin_tone", "🧑🏽\u200d🤝\u200d🧑🏿",
+	"people_holding_hands_medium_skin_tone_light_skin_tone", "🧑🏽\u200d🤝\u200d🧑🏻",
+	"people_holding_hands_medium_skin_tone_medium_dark_skin_tone", "🧑🏽\u200d🤝\u200d🧑🏾",
+	"people_holding_hands_medium_skin_tone_medium_light_skin_tone", "🧑🏽\u200d🤝\u200d🧑🏼",
+	"people_holding_hands_tone1", "🧑🏻\u200d🤝\u200d🧑🏻",
+	"people_holding_hands_tone1_tone2", "🧑🏻\u200d🤝\u200d🧑🏼",
+	"people_holding_hands_tone1_tone3", "🧑🏻\u200d🤝\u200d🧑🏽",
+	"people_holding_hands_tone1_tone4", "🧑🏻\u200d🤝\u200d🧑🏾",
+	"people_holding_hands_tone1_tone5", "🧑🏻\u200d🤝\u200d🧑🏿",
+	"people_holding_hands_tone2", "🧑🏼\u200d🤝\u200d🧑🏼",
+	"people_holding_hands_tone2_tone1", "🧑🏼\u200d🤝\u200d🧑🏻",
+	"people_holding_hands_tone2_tone3", "🧑🏼\u200d🤝\u200d🧑🏽",
+	"people_holding_hands_tone2_tone4", "🧑🏼\u200d🤝\u200d🧑🏾",
+	"people_holding_hands_tone2_tone5", "🧑🏼\u200d🤝\u200d🧑🏿",
+	"people_holding_hands_tone3", "🧑🏽\u200d🤝\u200d🧑🏽",
+	"people_holding_hands_tone3_tone1", "🧑🏽\u200d🤝\u200d🧑🏻",
+	"people_holding_hands_tone3_tone2", "🧑🏽\u200d🤝\u200d🧑🏼",
+	"people_holding_hands_tone3_tone4", "🧑🏽\u200d🤝\u200d🧑🏾",
+	"people_holding_hands_tone3_tone5", "🧑🏽\u200d🤝\u200d🧑🏿",
+	"people_holding_hands_tone4", "🧑🏾\u200d🤝\u200d🧑🏾",
+	"people_holding_hands_tone4_tone1", "🧑🏾\u200d🤝\u200d🧑🏻",
+	"people_holding_hands_tone4_tone2", "🧑🏾\u200d🤝\u200d🧑🏼",
+	"people_holding_hands_tone4_tone3", "🧑🏾\u200d🤝\u200d🧑🏽",
+	"people_holding_hands_tone4_tone5", "🧑🏾\u200d🤝\u200d🧑🏿",
+	"people_holding_hands_tone5", "🧑🏿\u200d🤝\u200d🧑🏿",
+	"people_holding_hands_tone5_tone1", "🧑🏿\u200d🤝\u200d🧑🏻",
+	"people_holding_hands_tone5_tone2", "🧑🏿\u200d🤝\u200d🧑🏼",
+	"people_holding_hands_tone5_tone3", "🧑🏿\u200d🤝\u200d🧑🏽",
+	"people_holding_hands_tone5_tone4", "🧑🏿\u200d🤝\u200d🧑🏾",
+	"people_hugging", "🫂",
+	"people_with_bunny_ears_partying", "👯",
+	"people_wrestling", "🤼",
+	"performing_arts", "🎭",
+	"persevere", "😣",
+	"person_bald", "🧑\u200d🦲",
+	"person_biking", "🚴",
+	"person_biking_tone1", "🚴🏻",
+	"person_biking_tone2", "🚴🏼",
+	"person_biking_tone3", "🚴🏽",
+	"person_biking_tone4", "🚴🏾",
+	"person_biking_tone5", "🚴🏿",
+	"person_bouncing_ball", "⛹️",
+	"person_bouncing_ball_tone1", "⛹🏻",
+	"person_bouncing_ball_tone2", "⛹🏼",
+	"person_bouncing_ball_tone3", "⛹🏽",
+	"person_bouncing_ball_tone4", "⛹🏾",
+	"person_bouncing_ball_tone5", "⛹🏿",
+	"person_bowing", "🙇",
+	"person_bowing_tone1", "🙇🏻",
+	"person_bowing_tone2", "🙇🏼",
+	"person_bowing_tone3", "🙇🏽",
+	"person_bowing_tone4", "🙇🏾",
+	"person_bowing_tone5", "🙇🏿",
+	"person_climbing", "🧗",
+	"person_climbing_dark_skin_tone", "🧗🏿",
+	"person_climbing_light_skin_tone", "🧗🏻",
+	"person_climbing_medium_dark_skin_tone", "🧗🏾",
+	"person_climbing_medium_light_skin_tone", "🧗🏼",
+	"person_climbing_medium_skin_tone", "🧗🏽",
+	"person_climbing_tone1", "🧗🏻",
+	"person_climbing_tone2", "🧗🏼",
+	"person_climbing_tone3", "🧗🏽",
+	"person_climbing_tone4", "🧗🏾",
+	"person_climbing_tone5", "🧗🏿",
+	"person_curly_hair", "🧑\u200d🦱",
+	"person_dark_skin_tone_bald", "🧑🏿\u200d🦲",
+	"person_dark_skin_tone_curly_hair", "🧑🏿\u200d🦱",
+	"person_dark_skin_tone_red_hair", "🧑🏿\u200d🦰",
+	"person_dark_skin_tone_white_hair", "🧑🏿\u200d🦳",
+	"person_doing_cartwheel", "🤸",
+	"person_doing_cartwheel_tone1", "🤸🏻",
+	"person_doing_cartwheel_tone2", "🤸🏼",
+	"person_doing_cartwheel_tone3", "🤸🏽",
+	"person_doing_cartwheel_tone4", "🤸🏾",
+	"person_doing_cartwheel_tone5", "🤸🏿",
+	"person_facepalming", "🤦",
+	"person_facepalming_tone1", "🤦🏻",
+	"person_facepalming_tone2", "🤦🏼",
+	"person_facepalming_tone3", "🤦🏽",
+	"person_facepalming_tone4", "🤦🏾",
+	"person_facepalming_tone5", "🤦🏿",
+	"person_feeding_baby", "🧑\u200d🍼",
+	"person_feeding_baby_dark_skin_tone", "🧑🏿\u200d🍼",
+	"person_feeding_baby_light_skin_tone", "🧑🏻\u200d🍼",
+	"person_feeding_baby_medium_dark_skin_tone", "🧑🏾\u200d🍼",
+	"person_feeding_baby_medium_light_skin_tone", "🧑🏼\u200d🍼",
+	"person_feeding_baby_medium_skin_tone", "🧑🏽\u200d🍼",
+	"person_feeding_baby_tone1", "🧑🏻\u200d🍼",
+	"person_feeding_baby_tone2", "🧑🏼\u200d🍼",
+	"person_feeding_baby_tone3", "🧑🏽\u200d🍼",
+	"person_feeding_baby_tone4", "🧑🏾\u200d🍼",
+	"person_feeding_baby_tone5", "🧑🏿\u200d🍼",
+	"person_fencing", "🤺",
+	"person_frowning", "🙍",
+	"person_frowning_tone1", "🙍🏻",
+	"person_frowning_tone2", "🙍🏼",
+	"person_frowning_tone3", "🙍🏽",
+	"person_frowning_tone4", "🙍🏾",
+	"person_frowning_tone5", "🙍🏿",
+	"person_gesturing_no", "🙅",
+	"person_gesturing_no_tone1", "🙅🏻",
+	"person_gesturing_no_tone2", "🙅🏼",
+	"person_gesturing_no_tone3", "🙅🏽",
+	"person_gesturing_no_tone4", "🙅🏾",
+	"person_gesturing_no_tone5", "🙅🏿",
+	"person_gesturing_ok", "🙆",
+	"person_gesturing_ok_tone1", "🙆🏻",
+	"person_gesturing_ok_tone2", "🙆🏼",
+	"person_gesturing_ok_tone3", "🙆🏽",
+	"person_gesturing_ok_tone4", "🙆🏾",
+	"person_gesturing_ok_tone5", "🙆🏿",
+	"person_getting_haircut", "💇",
+	"person_getting_haircut_tone1", "💇🏻",
+	"person_getting_haircut_tone2", "💇🏼",
+	"person_getting_haircut_tone3", "💇🏽",
+	"person_getting_haircut_tone4", "💇🏾",
+	"person_getting_haircut_tone5", "💇🏿",
+	"person_getting_massage", "💆",
+	"person_getting_massage_tone1", "💆🏻",
+	"person_getting_massage_tone2", "💆🏼",
+	"person_getting_massage_tone3", "💆🏽",
+	"person_getting_massage_tone4", "💆🏾",
+	"person_getting_massage_tone5", "💆🏿",
+	"person_golfing", "🏌️",
+	"person_golfing_dark_skin_tone", "🏌🏿",
+	"person_golfing_light_skin_tone", "🏌🏻",
+	"person_golfing_medium_dark_skin_tone", "🏌🏾",
+	"person_golfing_medium_light_skin_tone", "🏌🏼",
+	"person_golfing_medium_skin_tone", "🏌🏽",
+	"person_golfing_tone1", "🏌🏻",
+	"person_golfing_tone2", "🏌🏼",
+	"person_golfing_tone3", "🏌🏽",
+	"person_golfing_tone4", "🏌🏾",
+	"person_golfing_tone5", "🏌🏿",
+	"person_in_bed_dark_skin_tone", "🛌🏿",
+	"person_in_bed_light_skin_tone", "🛌🏻",
+	"person_in_bed_medium_dark_skin_tone", "🛌🏾",
+	"person_in_bed_medium_light_skin_tone", "🛌🏼",
+	"person_in_bed_medium_skin_tone", "🛌🏽",
+	"person_in_bed_tone1", "🛌🏻",
+	"person_in_bed_tone2", "🛌🏼",
+	"person_in_bed_tone3", "🛌🏽",
+	"person_in_bed_tone4", "🛌🏾",
+	"person_in_bed_tone5", "🛌🏿",
+	"person_in_lotus_position", "🧘",
+	"person_in_lotus_position_dark_skin_tone", "🧘🏿",
+	"person_in_lotus_position_light_skin_tone", "🧘🏻",
+	"person_in_lotus_position_medium_dark_skin_tone", "🧘🏾",
+	"person_in_lotus_position_medium_light_skin_tone", "🧘🏼",
+	"person_in_lotus_position_medium_skin_tone", "🧘🏽",
+	"person_in_lotus_position_tone1", "🧘🏻",
+	"person_in_lotus_position_tone2", "🧘🏼",
+	"person_in_lotus_position_tone3", "🧘🏽",
+	"person_in_lotus_position_tone4", "🧘🏾",
+	"person_in_lotus_position_tone5", "🧘🏿",
+	"person_in_manual_wheelchair", "🧑\u200d🦽",
+	"person_in_manual_wheelchair_dark_skin_tone", "🧑🏿\u200d🦽",
+	"person_in_manual_wheelchair_light_skin_tone", "🧑🏻\u200d🦽",
+	"person_in_manual_wheelchair_medium_dark_skin_tone", "🧑🏾\u200d🦽",
+	"person_in_manual_wheelchair_medium_light_skin_tone", "🧑🏼\u200d🦽",
+	"person_in_manual_wheelchair_medium_skin_tone", "🧑🏽\u200d🦽",
+	"person_in_manual_wheelchair_tone1", "🧑🏻\u200d🦽",
+	"person_in_manual_wheelchair_tone2", "🧑🏼\u200d🦽",
+	"person_in_manual_wheelchair_tone3", "🧑🏽\u200d🦽",
+	"person_in_manual_wheelchair_tone4", "🧑🏾\u200d🦽",
+	"person_in_manual_wheelchair_tone5", "🧑🏿\u200d🦽",
+	"person_in_motorized_wheelchair", "🧑\u200d🦼",
+	"person_in_motorized_wheelchair_dark_skin_tone", "🧑🏿\u200d🦼",
+	"person_in_motorized_wheelchair_light_skin_tone", "🧑🏻\u200d🦼",
+	"person_in_motorized_wheelchair_medium_dark_skin_tone", "🧑🏾\u200d🦼",
+	"person_in_motorized_wheelchair_medium_light_skin_tone", "🧑🏼\u200d🦼",
+	"person_in_motorized_wheelchair_medium_skin_tone", "🧑🏽\u200d🦼",
+	"person_in_motorized_wheelchair_tone1", "🧑🏻\u200d🦼",
+	"person_in_motorized_wheelchair_tone2", "🧑🏼\u200d🦼",
+	"person_in_motorized_wheelchair_tone3", "🧑🏽\u200d🦼",
+	"person_in_motorized_wheelchair_tone4", "🧑🏾\u200d🦼",
+	"person_in_motorized_wheelchair_tone5", "🧑🏿\u200d🦼",
+	"person_in_steamy_room", "🧖",
+	"person_in_steamy_room_dark_skin_tone", "🧖🏿",
+	"person_in_steamy_room_light_skin_tone", "🧖🏻",
+	"person_in_steamy_room_medium_dark_skin_tone", "🧖🏾",
+	"person_in_steamy_room_medium_light_skin_tone", "🧖🏼",
+	"person_in_steamy_room_medium_skin_tone", "🧖🏽",
+	"person_in_steamy_room_tone1", "🧖🏻",
+	"person_in_steamy_room_tone2", "🧖🏼",
+	"person_in_steamy_room_tone3", "🧖🏽",
+	"person_in_steamy_room_tone4", "🧖🏾",
+	"person_in_steamy_room_tone5", "🧖🏿",
+	"person_in_tuxedo", "🤵",
+	"person_in_tuxedo_tone1", "🤵🏻",
+	"person_in_tuxedo_tone2", "🤵🏼",
+	"person_in_tuxedo_tone3", "🤵🏽",
+	"person_in_tuxedo_tone4", "🤵🏾",
+	"person_in_tuxedo_tone5", "🤵🏿",
+	"person_juggling", "🤹",
+	"person_juggling_tone1", "🤹🏻",
+	"person_juggling_tone2", "🤹🏼",
+	"person_juggling_tone3", "🤹🏽",
+	"person_juggling_tone4", "🤹🏾",
+	"person_juggling_tone5", "🤹🏿",
+	"person_kneeling", "🧎",
+	"person_kneeling_dark_skin_tone", "🧎🏿",
+	"person_kneeling_light_skin_tone", "🧎🏻",
+	"person_kneeling_medium_dark_skin_tone", "🧎🏾",
+	"person_kneeling_medium_light_skin_tone", "🧎🏼",
+	"person_kneeling_medium_skin_tone", "🧎🏽",
+	"person_kneeling_tone1", "🧎🏻",
+	"person_kneeling_tone2", "🧎🏼",
+	"person_kneeling_tone3", "🧎🏽",
+	"person_kneeling_tone4", "🧎🏾",
+	"person_kneeling_tone5", "🧎🏿",
+	"person_lifting_weights", "🏋️",
+	"person_lifting_weights_tone1", "🏋🏻",
+	"person_lifting_weights_tone2", "🏋🏼",
+	"person_lifting_weights_tone3", "🏋🏽",
+	"person_lifting_weights_tone4", "🏋🏾",
+	"person_lifting_weights_tone5", "🏋🏿",
+	"person_light_skin_tone_bald", "🧑🏻\u200d🦲",
+	"person_light_skin_tone_curly_hair", "🧑🏻\u200d🦱",
+	"person_light_skin_tone_red_hair", "🧑🏻\u200d🦰",
+	"person_light_skin_tone_white_hair", "🧑🏻\u200d🦳",
+	"person_medium_dark_skin_tone_bald", "🧑🏾\u200d🦲",
+	"person_medium_dark_skin_tone_curly_hair", "🧑🏾\u200d🦱",
+	"person_medium_dark_skin_tone_red_hair", "🧑🏾\u200d🦰",
+	"person_medium_dark_skin_tone_white_hair", "🧑🏾\u200d🦳",
+	"person_medium_light_skin_tone_bald", "🧑🏼\u200d🦲",
+	"person_medium_light_skin_tone_curly_hair", "🧑🏼\u200d🦱",
+	"person_medium_light_skin_tone_red_hair", "🧑🏼\u200d🦰",
+	"person_medium_light_skin_tone_white_hair", "🧑🏼\u200d🦳",
+	"person_medium_skin_tone_bald", "🧑🏽\u200d🦲",
+	"person_medium_skin_tone_curly_hair", "🧑🏽\u200d🦱",
+	"person_medium_skin_tone_red_hair", "🧑🏽\u200d🦰",
+	"person_medium_skin_tone_white_hair", "🧑🏽\u200d🦳",
+	"person_mountain_biking", "🚵",
+	"person_mountain_biking_tone1", "🚵🏻",
+	"person_mountain_biking_tone2", "🚵🏼",
+	"person_mountain_biking_tone3", "🚵🏽",
+	"person_mountain_biking_tone4", "🚵🏾",
+	"person_mountain_biking_tone5", "🚵🏿",
+	"person_playing_handball", "🤾",
+	"person_playing_handball_tone1", "🤾🏻",
+	"person_playing_handball_tone2", "🤾🏼",
+	"person_playing_handball_tone3", "🤾🏽",
+	"person_playing_handball_tone4", "🤾🏾",
+	"person_playing_handball_tone5", "🤾🏿",
+	"person_playing_water_polo", "🤽",
+	"person_playing_water_polo_tone1", "🤽🏻",
+	"person_playing_water_polo_tone2", "🤽🏼",
+	"person_playing_water_polo_tone3", "🤽🏽",
+	"person_playing_water_polo_tone4", "🤽🏾",
+	"person_playing_water_polo_tone5", "🤽🏿",
+	"person_pouting", "🙎",
+	"person_pouting_tone1", "🙎🏻",
+	"person_pouting_tone2", "🙎🏼",
+	"person_pouting_tone3", "🙎🏽",
+	"person_pouting_tone4", "🙎🏾",
+	"person_pouting_tone5", "🙎🏿",
+	"person_raising_hand", "🙋",
+	"person_raising_hand_tone1", "🙋🏻",
+	"person_raising_hand_tone2", "🙋🏼",
+	"person_raising_hand_tone3", "🙋🏽",
+	"person_raising_hand_tone4", "🙋🏾",
+	"person_raising_hand_tone5", "🙋🏿",
+	"person_red_hair", "🧑\u200d🦰",
+	"person_rowing_boat", "🚣",
+	"person_rowing_boat_tone1", "🚣🏻",
+	"person_rowing_boat_tone2", "🚣🏼",
+	"person_rowing_boat_tone3", "🚣🏽",
+	"person_rowing_boat_tone4", "🚣🏾",
+	"person_rowing_boat_tone5", "🚣🏿",
+	"person_running", "🏃",
+	"person_running_tone1", "🏃🏻",
+	"person_running_tone2", "🏃🏼",
+	"person_running_tone3", "🏃🏽",
+	"person_running_tone4", "🏃🏾",
+	"person_running_tone5", "🏃🏿",
+	"person_shrugging", "🤷",
+	"person_shrugging_tone1", "🤷🏻",
+	"person_shrugging_tone2", "🤷🏼",
+	"person_shrugging_tone3", "🤷🏽",
+	"person_shrugging_tone4", "🤷🏾",
+	"person_shrugging_tone5", "🤷🏿",
+	"person_standing", "🧍",
+	"person_standing_dark_skin_tone", "🧍🏿",
+	"person_standing_light_skin_tone", "🧍🏻",
+	"person_standing_medium_dark_skin_tone", "🧍🏾",
+	"person_standing_medium_light_skin_tone", "🧍🏼",
+	"person_standing_medium_skin_tone", "🧍🏽",
+	"person_standing_tone1", "🧍🏻",
+	"person_standing_tone2", "🧍🏼",
+	"person_standing_tone3", "🧍🏽",
+	"person_standing_tone4", "🧍🏾",
+	"person_standing_tone5", "🧍🏿",
+	"person_surfing", "🏄",
+	"person_surfing_tone1", "🏄🏻",
+	"person_surfing_tone2", "🏄🏼",
+	"person_surfing_tone3", "🏄🏽",
+	"person_surfing_tone4", "🏄🏾",
+	"person_surfing_tone5", "🏄🏿",
+	"person_swimming", "🏊",
+	"person_swimming_tone1", "🏊🏻",
+	"person_swimming_tone2", "🏊🏼",
+	"person_swimming_tone3", "🏊🏽",
+	"person_swimming_tone4", "🏊🏾",
+	"person_swimming_tone5", "🏊🏿",
+	"person_tipping_hand", "💁",
+	"person_tipping_hand_tone1", "💁🏻",
+	"person_tipping_hand_tone2", "💁🏼",
+	"person_tipping_hand_tone3", "💁🏽",
+	"person_tipping_hand_tone4", "💁🏾",
+	"person_tipping_hand_tone5", "💁🏿",
+	"person_tone1_bald", "🧑🏻\u200d🦲",
+	"person_tone1_curly_hair", "🧑🏻\u200d🦱",
+	"person_tone1_red_hair", "🧑🏻\u200d🦰",
+	"person_tone1_white_hair", "🧑🏻\u200d🦳",
+	"person_tone2_bald", "🧑🏼\u200d🦲",
+	"person_tone2_curly_hair", "🧑🏼\u200d🦱",
+	"person_tone2_red_hair", "🧑🏼\u200d🦰",
+	"person_tone2_white_hair", "🧑🏼\u200d🦳",
+	"person_tone3_bald", "🧑🏽\u200d🦲",
+	"person_tone3_curly_hair", "🧑🏽\u200d🦱",
+	"person_tone3_red_hair", "🧑🏽\u200d🦰",
+	"person_tone3_white_hair", "🧑🏽\u200d🦳",
+	"person_tone4_bald", "🧑🏾\u200d🦲",
+	"person_tone4_curly_hair", "🧑🏾\u200d🦱",
+	"person_tone4_red_hair", "🧑🏾\u200d🦰",
+	"person_tone4_white_hair", "🧑🏾\u200d🦳",
+	"person_tone5_bald", "🧑🏿\u200d🦲",
+	"person_tone5_curly_hair", "🧑🏿\u200d🦱",
+	"person_tone5_red_hair", "🧑🏿\u200d🦰",
+	"person_tone5_white_hair", "🧑🏿\u200d🦳",
+	"person_walking", "🚶",
+	"person_walking_tone1", "🚶🏻",
+	"person_walking_tone2", "🚶🏼",
+	"person_walking_tone3", "🚶🏽",
+	"person_walking_tone4", "🚶🏾",
+	"person_walking_tone5", "🚶🏿",
+	"person_wearing_turban", "👳",
+	"person_wearing_turban_tone1", "👳🏻",
+	"person_wearing_turban_tone2", "👳🏼",
+	"person_wearing_turban_tone3", "👳🏽",
+	"person_wearing_turban_tone4", "👳🏾",
+	"person_wearing_turban_tone5", "👳🏿",
+	"person_white_hair", "🧑\u200d🦳",
+	"person_with_ball", "⛹️",
+	"person_with_ball_tone1", "⛹🏻",
+	"person_with_ball_tone2", "⛹🏼",
+	"person_with_ball_tone3", "⛹🏽",
+	"person_with_ball_tone4", "⛹🏾",
+	"person_with_ball_tone5", "⛹🏿",
+	"person_with_blond_hair", "👱",
+	"person_with_blond_hair_tone1", "👱🏻",
+	"person_with_blond_hair_tone2", "👱🏼",
+	"person_with_blond_hair_tone3", "👱🏽",
+	"person_with_blond_hair_tone4", "👱🏾",
+	"person_with_blond_hair_tone5", "👱🏿",
+	"person_with_crown", "🫅",
+	"person_with_crown_dark_skin_tone", "🫅🏿",
+	"person_with_crown_light_skin_tone", "🫅🏻",
+	"person_with_crown_medium_dark_skin_tone", "🫅🏾",
+	"person_with_crown_medium_light_skin_tone", "🫅🏼",
+	"person_with_crown_medium_skin_tone", "🫅🏽",
+	"person_with_crown_tone1", "🫅🏻",
+	"person_with_crown_tone2", "🫅🏼",
+	"person_with_crown_tone3", "🫅🏽",
+	"person_with_crown_tone4", "🫅🏾",
+	"person_with_crown_tone5", "🫅🏿",
+	"person_with_pouting_face", "🙎",
+	"person_with_pouting_face_tone1", "🙎🏻",
+	"person_with_pouting_face_tone2", "🙎🏼",
+	"person_with_pouting_face_tone3", "🙎🏽",
+	"person_with_pouting_face_tone4", "🙎🏾",
+	"person_with_pouting_face_tone5", "🙎🏿",
+	"person_with_probing_cane", "🧑\u200d🦯",
+	"person_with_probing_cane_dark_skin_tone", "🧑🏿\u200d🦯",
+	"person_with_probing_cane_light_skin_tone", "🧑🏻\u200d🦯",
+	"person_with_probing_cane_medium_dark_skin_tone", "🧑🏾\u200d🦯",
+	"person_with_probing_cane_medium_light_skin_tone", "🧑🏼\u200d🦯",
+	"person_with_probing_cane_medium_skin_tone", "🧑🏽\u200d🦯",
+	"person_with_probing_cane_tone1", "🧑🏻\u200d🦯",
+	"person_with_probing_cane_tone2", "🧑🏼\u200d🦯",
+	"person_with_probing_cane_tone3", "🧑🏽\u200d🦯",
+	"person_with_probing_cane_tone4", "🧑🏾\u200d🦯",
+	"person_with_probing_cane_tone5", "🧑🏿\u200d🦯",
+	"person_with_veil", "👰",
+	"person_with_veil_tone1", "👰🏻",
+	"person_with_veil_tone2", "👰🏼",
+	"person_with_veil_tone3", "👰🏽",
+	"person_with_veil_tone4", "👰🏾",
+	"person_with_veil_tone5", "👰🏿",
+	"petri_dish", "🧫",
+	"pick", "⛏️",
+	"pickup_truck", "🛻",
+	"pie", "🥧",
+	"pig", "🐷",
+	"pig2", "🐖",
+	"pig_nose", "🐽",
+	"pill", "💊",
+	"pilot", "🧑\u200d✈️",
+	"pilot_dark_skin_tone", "🧑🏿\u200d✈️",
+	"pilot_light_skin_tone", "🧑🏻\u200d✈️",
+	"pilot_medium_dark_skin_tone", "🧑🏾\u200d✈️",
+	"pilot_medium_light_skin_tone", "🧑🏼\u200d✈️",
+	"pilot_medium_skin_tone", "🧑🏽\u200d✈️",
+	"pilot_tone1", "🧑🏻\u200d✈️",
+	"pilot_tone2", "🧑🏼\u200d✈️",
+	"pilot_tone3", "🧑🏽\u200d✈️",
+	"pilot_tone4", "🧑🏾\u200d✈️",
+	"pilot_tone5", "🧑🏿\u200d✈️",
+	"pinched_fingers", "🤌",
+	"pinched_fingers_dark_skin_tone", "🤌🏿",
+	"pinched_fingers_light_skin_tone", "🤌🏻",
+	"pinched_fingers_medium_dark_skin_tone", "🤌🏾",
+	"pinched_fingers_medium_light_skin_tone", "🤌🏼",
+	"pinched_fingers_medium_skin_tone", "🤌🏽",
+	"pinched_fingers_tone1", "🤌🏻",
+	"pinched_fingers_tone2", "🤌🏼",
+	"pinched_fingers_tone3", "🤌🏽",
+	"pinched_fingers_tone4", "🤌🏾",
+	"pinched_fingers_tone5", "🤌🏿",
+	"pinching_hand", "🤏",
+	"pinching_hand_dark_skin_tone", "🤏🏿",
+	"pinching_hand_light_skin_tone", "🤏🏻",
+	"pinching_hand_medium_dark_skin_tone", "🤏🏾",
+	"pinching_hand_medium_light_skin_tone", "🤏🏼",
+	"pinching_hand_medium_skin_tone", "🤏🏽",
+	"pinching_hand_tone1", "🤏🏻",
+	"pinching_hand_tone2", "🤏🏼",
+	"pinching_hand_tone3", "🤏🏽",
+	"pinching_hand_tone4", "🤏🏾",
+	"pinching_hand_tone5", "🤏🏿",
+	"pineapple", "🍍",
+	"ping_pong", "🏓",
+	"pirate_flag", "🏴\u200d☠️",
+	"pisces", "♓",
+	"pizza", "🍕",
+	"piñata", "🪅",
+	"placard", "🪧",
+	"place_of_worship", "🛐",
+	"play_pause", "⏯️",
+	"playground_slide", "🛝",
+	"pleading_face", "🥺",
+	"plunger", "🪠",
+	"point_down", "👇",
+	"point_down_tone1", "👇🏻",
+	"point_down_tone2", "👇🏼",
+	"point_down_tone3", "👇🏽",
+	"point_down_tone4", "👇🏾",
+	"point_down_tone5", "👇🏿",
+	"point_left", "👈",
+	"point_left_tone1", "👈🏻",
+	"point_left_tone2", "👈🏼",
+	"point_left_tone3", "👈🏽",
+	"point_left_tone4", "👈🏾",
+	"point_left_tone5", "👈🏿",
+	"point_right", "👉",
+	"point_right_tone1", "👉🏻",
+	"point_right_tone2", "👉🏼",
+	"point_right_tone3", "👉🏽",
+	"point_right_tone4", "👉🏾",
+	"point_right_tone5", "👉🏿",
+	"point_up", "☝️",
+	"point_up_2", "👆",
+	"point_up_2_tone1", "👆🏻",
+	"point_up_2_tone2", "👆🏼",
+	"point_up_2_tone3", "👆🏽",
+	"point_up_2_tone4", "👆🏾",
+	"point_up_2_tone5", "👆🏿",
+	"point_up_tone1", "☝🏻",
+	"point_up_tone2", "☝🏼",
+	"point_up_tone3", "☝🏽",
+	"point_up_tone4", "☝🏾",
+	"point_up_tone5", "☝🏿",
+	"polar_bear", "🐻\u200d❄️",
+	"police_car", "🚓",
+	"police_officer", "👮",
+	"police_officer_tone1", "👮🏻",
+	"police_officer_tone2", "👮🏼",
+	"police_officer_tone3", "👮🏽",
+	"police_officer_tone4", "👮🏾",
+	"police_officer_tone5", "👮🏿",
+	"poo", "💩",
+	"poodle", "🐩",
+	"poop", "💩",
+	"popcorn", "🍿",
+	"post_office", "🏣",
+	"postal_horn", "📯",
+	"postbox", "📮",
+	"potable_water", "🚰",
+	"potato", "🥔",
+	"potted_plant", "🪴",
+	"pouch", "👝",
+	"poultry_leg", "🍗",
+	"pound", "💷",
+	"pouring_liquid", "🫗",
+	"pouting_cat", "😾",
+	"pray", "🙏",
+	"pray_tone1", "🙏🏻",
+	"pray_tone2", "🙏🏼",
+	"pray_tone3", "🙏🏽",
+	"pray_tone4", "🙏🏾",
+	"pray_tone5", "🙏🏿",
+	"prayer_beads", "📿",
+	"pregnant_man", "🫃",
+	"pregnant_man_dark_skin_tone", "🫃🏿",
+	"pregnant_man_light_skin_tone", "🫃🏻",
+	"pregnant_man_medium_dark_skin_tone", "🫃🏾",
+	"pregnant_man_medium_light_skin_tone", "🫃🏼",
+	"pregnant_man_medium_skin_tone", "🫃🏽",
+	"pregnant_man_tone1", "🫃🏻",
+	"pregnant_man_tone2", "🫃🏼",
+	"pregnant_man_tone3", "🫃🏽",
+	"pregnant_man_tone4", "🫃🏾",
+	"pregnant_man_tone5", "🫃🏿",
+	"pregnant_person", "🫄",
+	"pregnant_person_dark_skin_tone", "🫄🏿",
+	"pregnant_person_light_skin_tone", "🫄🏻",
+	"pregnant_person_medium_dark_skin_tone", "🫄🏾",
+	"pregnant_person_medium_light_skin_tone", "🫄🏼",
+	"pregnant_person_medium_skin_tone", "🫄🏽",
+	"pregnant_person_tone1", "🫄🏻",
+	"pregnant_person_tone2", "🫄🏼",
+	"pregnant_person_tone3", "🫄🏽",
+	"pregnant_person_tone4", "🫄🏾",
+	"pregnant_person_tone5", "🫄🏿",
+	"pregnant_woman", "🤰",
+	"pregnant_woman_tone1", "🤰🏻",
+	"pregnant_woman_tone2", "🤰🏼",
+	"pregnant_woman_tone3", "🤰🏽",
+	"pregnant_woman_tone4", "🤰🏾",
+	"pregnant_woman_tone5", "🤰🏿",
+	"pretzel", "🥨",
+	"previous_track", "⏮️",
+	"prince", "🤴",
+	"prince_tone1", "🤴🏻",
+	"prince_tone2", "🤴🏼",
+	"prince_tone3", "🤴🏽",
+	"prince_tone4", "🤴🏾",
+	"prince_tone5", "🤴🏿",
+	"princess", "👸",
+	"princess_tone1", "👸🏻",
+	"princess_tone2", "👸🏼",
+	"princess_tone3", "👸🏽",
+	"princess_tone4", "👸🏾",
+	"princess_tone5", "👸🏿",
+	"printer", "🖨️",
+	"probing_cane", "🦯",
+	"projector", "📽️",
+	"pudding", "🍮",
+	"punch", "👊",
+	"punch_tone1", "👊🏻",
+	"punch_tone2", "👊🏼",
+	"punch_tone3", "👊🏽",
+	"punch_tone4", "👊🏾",
+	"punch_tone5", "👊🏿",
+	"purple_circle", "🟣",
+	"purple_heart", "💜",
+	"purple_square", "🟪",
+	"purse", "👛",
+	"pushpin", "📌",
+	"put_litter_in_its_place", "🚮",
+	"question", "❓",
+	"rabbit", "🐰",
+	"rabbit2", "🐇",
+	"raccoon", "🦝",
+	"race_car", "🏎️",
+	"racehorse", "🐎",
+	"racing_car", "🏎️",
+	"racing_motorcycle", "🏍️",
+	"radio", "📻",
+	"radio_button", "🔘",
+	"radioactive", "☢️",
+	"radioactive_sign", "☢️",
+	"rage", "😡",
+	"railroad_track", "🛤️",
+	"railway_car", "🚃",
+	"railway_track", "🛤️",
+	"rainbow", "🌈",
+	"rainbow_flag", "🏳️\u200d🌈",
+	"raised_back_of_hand", "🤚",
+	"raised_back_of_hand_tone1", "🤚🏻",
+	"raised_back_of_hand_tone2", "🤚🏼",
+	"raised_back_of_hand_tone3", "🤚🏽",
+	"raised_back_of_hand_tone4", "🤚🏾",
+	"raised_back_of_hand_tone5", "🤚🏿",
+	"raised_hand", "✋",
+	"raised_hand_tone1", "✋🏻",
+	"raised_hand_tone2", "✋🏼",
+	"raised_hand_tone3", "✋🏽",
+	"raised_hand_tone4", "✋🏾",
+	"raised_hand_tone5", "✋🏿",
+	"raised_hand_with_fingers_splayed", "🖐️",
+	"raised_hand_with_fingers_splayed_tone1", "🖐🏻",
+	"raised_hand_with_fingers_splayed_tone2", "🖐🏼",
+	"raised_hand_with_fingers_splayed_tone3", "🖐🏽",
+	"raised_hand_with_fingers_splayed_tone4", "🖐🏾",
+	"raised_hand_with_fingers_splayed_tone5", "🖐🏿",
+	"raised_hand_with_part_between_middle_and_ring_fingers", "🖖",
+	"raised_hand_with_part_between_middle_and_ring_fingers_tone1", "🖖🏻",
+	"raised_hand_with_part_between_middle_and_ring_fingers_tone2", "🖖🏼",
+	"raised_hand_with_part_between_middle_and_ring_fingers_tone3", "🖖🏽",
+	"raised_hand_with_part_between_middle_and_ring_fingers_tone4", "🖖🏾",
+	"raised_hand_with_part_between_middle_and_ring_fingers_tone5", "🖖🏿",
+	"raised_hands", "🙌",
+	"raised_hands_tone1", "🙌🏻",
+	"raised_hands_tone2", "🙌🏼",
+	"raised_hands_tone3", "🙌🏽",
+	"raised_hands_tone4", "🙌🏾",
+	"raised_hands_tone5", "🙌🏿",
+	"raising_hand", "🙋",
+	"raising_hand_tone1", "🙋🏻",
+	"raising_hand_tone2", "🙋🏼",
+	"raising_hand_tone3", "🙋🏽",
+	"raising_hand_tone4", "🙋🏾",
+	"raising_hand_tone5", "🙋🏿",
+	"ram", "🐏",
+	"ramen", "🍜",
+	"rat", "🐀",
+	"razor", "🪒",
+	"receipt", "🧾",
+	"record_button", "⏺️",
+	"recycle", "♻️",
+	"red_car", "🚗",
+	"red_circle", "🔴",
+	"red_envelope", "🧧",
+	"red_square", "🟥",
+	"regional_indicator_a", "🇦",
+	"regional_indicator_b", "🇧",
+	"regional_indicator_c", "🇨",
+	"regional_indicator_d", "🇩",
+	"regional_indicator_e", "🇪",
+	"regional_indicator_f", "🇫",
+	"regional_indicator_g", "🇬",
+	"regional_indicator_h", "🇭",
+	"regional_indicator_i", "🇮",
+	"regional_indicator_j", "🇯",
+	"regional_indicator_k", "🇰",
+	"regional_indicator_l", "🇱",
+	"regional_indicator_m", "🇲",
+	"regional_indicator_n", "🇳",
+	"regional_indicator_o", "🇴",
+	"regional_indicator_p", "🇵",
+	"regional_indicator_q", "🇶",
+	"regional_indicator_r", "🇷",
+	"regional_indicator_s", "🇸",
+	"regional_indicator_t", "🇹",
+	"regional_indicator_u", "🇺",
+	"regional_indicator_v", "🇻",
+	"regional_indicator_w", "🇼",
+	"regional_indicator_x", "🇽",
+	"regional_indicator_y", "🇾",
+	"regional_indicator_z", "🇿",
+	"registered", "®️",
+	"relaxed", "☺️",
+	"relieved", "😌",
+	"reminder_ribbon", "🎗️",
+	"repeat", "🔁",
+	"repeat_one", "🔂",
+	"restroom", "🚻",
+	"reversed_hand_with_middle_finger_extended", "🖕",
+	"reversed_hand_with_middle_finger_extended_tone1", "🖕🏻",
+	"reversed_hand_with_middle_finger_extended_tone2", "🖕🏼",
+	"reversed_hand_with_middle_finger_extended_tone3", "🖕🏽",
+	"reversed_hand_with_middle_finger_extended_tone4", "🖕🏾",
+	"reversed_hand_with_middle_finger_extended_tone5", "🖕🏿",
+	"revolving_hearts", "💞",
+	"rewind", "⏪",
+	"rhino", "🦏",
+	"rhinoceros", "🦏",
+	"ribbon", "🎀",
+	"rice", "🍚",
+	"rice_ball", "🍙",
+	"rice_cracker", "🍘",
+	"rice_scene", "🎑",
+	"right_anger_bubble", "🗯️",
+	"right_facing_fist", "🤜",
+	"right_facing_fist_tone1", "🤜🏻",
+	"right_facing_fist_tone2", "🤜🏼",
+	"right_facing_fist_tone3", "🤜🏽",
+	"right_facing_fist_tone4", "🤜🏾",
+	"right_facing_fist_tone5", "🤜🏿",
+	"right_fist", "🤜",
+	"right_fist_tone1", "🤜🏻",
+	"right_fist_tone2", "🤜🏼",
+	"right_fist_tone3", "🤜🏽",
+	"right_fist_tone4", "🤜🏾",
+	"right_fist_tone5", "🤜🏿",
+	"rightwards_hand", "🫱",
+	"rightwards_hand_dark_skin_tone", "🫱🏿",
+	"rightwards_hand_light_skin_tone", "🫱🏻",
+	"rightwards_hand_medium_dark_skin_tone", "🫱🏾",
+	"rightwards_hand_medium_light_skin_tone", "🫱🏼",
+	"rightwards_hand_medium_skin_tone", "🫱🏽",
+	"rightwards_hand_tone1", "🫱🏻",
+	"rightwards_hand_tone2", "🫱🏼",
+	"rightwards_hand_tone3", "🫱🏽",
+	"rightwards_hand_tone4", "🫱🏾",
+	"rightwards_hand_tone5", "🫱🏿",
+	"ring", "💍",
+	"ring_buoy", "🛟",
+	"ringed_planet", "🪐",
+	"robot", "🤖",
+	"robot_face", "🤖",
+	"rock", "🪨",
+	"rocket", "🚀",
+	"rofl", "🤣",
+	"roll_of_paper", "🧻",
+	"rolled_up_newspaper", "🗞️",
+	"roller_coaster", "🎢",
+	"roller_skate", "🛼",
+	"rolling_eyes", "🙄",
+	"rolling_on_the_floor_laughing", "🤣",
+	"rooster", "🐓",
+	"rose", "🌹",
+	"rosette", "🏵️",
+	"rotating_light", "🚨",
+	"round_pushpin", "📍",
+	"rowboat", "🚣",
+	"rowboat_tone1", "🚣🏻",
+	"rowboat_tone2", "🚣🏼",
+	"rowboat_tone3", "🚣🏽",
+	"rowboat_tone4", "🚣🏾",
+	"rowboat_tone5", "🚣🏿",
+	"rugby_football", "🏉",
+	"runner", "🏃",
+	"runner_tone1", "🏃🏻",
+	"runner_tone2", "🏃🏼",
+	"runner_tone3", "🏃🏽",
+	"runner_tone4", "🏃🏾",
+	"runner_tone5", "🏃🏿",
+	"running_shirt_with_sash", "🎽",
+	"sa", "🈂️",
+	"safety_pin", "🧷",
+	"safety_vest", "🦺",
+	"sagittarius", "♐",
+	"sailboat", "⛵",
+	"sake", "🍶",
+	"salad", "🥗",
+	"salt", "🧂",
+	"saluting_face", "🫡",
+	"sandal", "👡",
+	"sandwich", "🥪",
+	"santa", "🎅",
+	"santa_tone1", "🎅🏻",
+	"santa_tone2", "🎅🏼",
+	"santa_tone3", "🎅🏽",
+	"santa_tone4", "🎅🏾",
+	"santa_tone5", "🎅🏿",
+	"sari", "🥻",
+	"satellite", "📡",
+	"satellite_orbital", "🛰️",
+	"satisfied", "😆",
+	"sauropod", "🦕",
+	"saxophone", "🎷",
+	"scales", "⚖️",
+	"scarf", "🧣",
+	"school", "🏫",
+	"school_satchel", "🎒",
+	"scientist", "🧑\u200d🔬",
+	"scientist_dark_skin_tone", "🧑🏿\u200d🔬",
+	"scientist_light_skin_tone", "🧑🏻\u200d🔬",
+	"scientist_medium_dark_skin_tone", "🧑🏾\u200d🔬",
+	"scientist_medium_light_skin_tone", "🧑🏼\u200d🔬",
+	"scientist_medium_skin_tone", "🧑🏽\u200d🔬",
+	"scientist_tone1", "🧑🏻\u200d🔬",
+	"scientist_tone2", "🧑🏼\u200d🔬",
+	"scientist_tone3", "🧑🏽\u200d🔬",
+	"scientist_tone4", "🧑🏾\u200d🔬",
+	"scientist_tone5", "🧑🏿\u200d🔬",
+	"scissors", "✂️",
+	"scooter", "🛴",
+	"scorpion", "🦂",
+	"scorpius", "♏",
+	"scotland", "🏴\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f",
+	"scream", "😱",
+	"scream_cat", "🙀",
+	"screwdriver", "🪛",
+	"scroll", "📜",
+	"seal", "🦭",
+	"seat", "💺",
+	"second_place", "🥈",
+	"second_place_medal", "🥈",
+	"secret", "㊙️",
+	"see_no_evil", "🙈",
+	"seedling", "🌱",
+	"selfie", "🤳",
+	"selfie_tone1", "🤳🏻",
+	"selfie_tone2", "🤳🏼",
+	"selfie_tone3", "🤳🏽",
+	"selfie_tone4", "🤳🏾",
+	"selfie_tone5", "🤳🏿",
+	"service_dog", "🐕\u200d🦺",
+	"seven", "7️⃣",
+	"sewing_needle", "🪡",
+	"shaking_hands", "🤝",
+	"shallow_pan_of_food", "🥘",
+	"shamrock", "☘️",
+	"shark", "🦈",
+	"shaved_ice", "🍧",
+	"sheep", "🐑",
+	"shell", "🐚",
+	"shelled_peanut", "🥜",
+	"shield", "🛡️",
+	"shinto_shrine", "⛩️",
+	"ship", "🚢",
+	"shirt", "👕",
+	"shit", "💩",
+	"shopping_bags", "🛍️",
+	"shopping_cart", "🛒",
+	"shopping_trolley", "🛒",
+	"shorts", "🩳",
+	"shower", "🚿",
+	"shrimp", "🦐",
+	"shrug", "🤷",
+	"shrug_tone1", "🤷🏻",
+	"shrug_tone2", "🤷🏼",
+	"shrug_tone3", "🤷🏽",
+	"shrug_tone4", "🤷🏾",
+	"shrug_tone5", "🤷🏿",
+	"shushing_face", "🤫",
+	"sick", "🤢",
+	"sign_of_the_horns", "🤘",
+	"sign_of_the_horns_tone1", "🤘🏻",
+	"sign_of_the_horns_tone2", "🤘🏼",
+	"sign_of_the_horns_tone3", "🤘🏽",
+	"sign_of_the_horns_tone4", "🤘🏾",
+	"sign_of_the_horns_tone5", "🤘🏿",
+	"signal_strength", "📶",
+	"singer", "🧑\u200d🎤",
+	"singer_dark_skin_tone", "🧑🏿\u200d🎤",
+	"singer_light_skin_tone", "🧑🏻\u200d🎤",
+	"singer_medium_dark_skin_tone", "🧑🏾\u200d🎤",
+	"singer_medium_light_skin_tone", "🧑🏼\u200d🎤",
+	"singer_medium_skin_tone", "🧑🏽\u200d🎤",
+	"singer_tone1", "🧑🏻\u200d🎤",
+	"singer_tone2", "🧑🏼\u200d🎤",
+	"singer_tone3", "🧑🏽\u200d🎤",
+	"singer_tone4", "🧑🏾\u200d🎤",
+	"singer_tone5", "🧑🏿\u200d🎤",
+	"six", "6️⃣",
+	"six_pointed_star", "🔯",
+	"skateboard", "🛹",
+	"skeleton", "💀",
+	"ski", "🎿",
+	"skier", "⛷️",
+	"skull", "💀",
+	"skull_and_crossbones", "☠️",
+	"skull_crossbones", "☠️",
+	"skunk", "🦨",
+	"sled", "🛷",
+	"sleeping", "😴",
+	"sleeping_accommodation", "🛌",
+	"sleepy", "😪",
+	"sleuth_or_spy", "🕵️",
+	"sleuth_or_spy_tone1", "🕵🏻",
+	"sleuth_or_spy_tone2", "🕵🏼",
+	"sleuth_or_spy_tone3", "🕵🏽",
+	"sleuth_or_spy_tone4", "🕵🏾",
+	"sleuth_or_spy_tone5", "🕵🏿",
+	"slight_frown", "🙁",
+	"slight_smile", "🙂",
+	"slightly_frowning_face", "🙁",
+	"slightly_smiling_face", "🙂",
+	"slot_machine", "🎰",
+	"sloth", "🦥",
+	"small_airplane", "🛩️",
+	"small_blue_diamond", "🔹",
+	"small_orange_diamond", "🔸",
+	"small_red_triangle", "🔺",
+	"small_red_triangle_down", "🔻",
+	"smile", "😄",
+	"smile_cat", "😸",
+	"smiley", "😃",
+	"smiley_cat", "😺",
+	"smiling_face_with_3_hearts", "🥰",
+	"smiling_face_with_tear", "🥲",
+	"smiling_imp", "😈",
+	"smirk", "😏",
+	"smirk_cat", "😼",
+	"smoking", "🚬",
+	"snail", "🐌",
+	"snake", "🐍",
+	"sneeze", "🤧",
+	"sneezing_face", "🤧",
+	"snow_capped_mountain", "🏔️",
+	"snowboarder", "🏂",
+	"snowboarder_dark_skin_tone", "🏂🏿",
+	"snowboarder_light_skin_tone", "🏂🏻",
+	"snowboarder_medium_dark_skin_tone", "🏂🏾",
+	"snowboarder_medium_light_skin_tone", "🏂🏼",
+	"snowboarder_medium_skin_tone", "🏂🏽",
+	"snowboarder_tone1", "🏂🏻",
+	"snowboarder_tone2", "🏂🏼",
+	"snowboarder_tone3", "🏂🏽",
+	"snowboarder_tone4", "🏂🏾",
+	"snowboarder_tone5", "🏂🏿",
+	"snowflake", "❄️",
+	"snowman", "⛄",
+	"snowman2", "☃️",
+	"soap", "🧼",
+	"sob", "😭",
+	"soccer", "⚽",
+	"socks", "🧦",
+	"softball", "🥎",
+	"soon", "🔜",
+	"sos", "🆘",
+	"sound", "🔉",
+	"space_invader", "👾",
+	"spades", "♠️",
+	"spaghetti", "🍝",
+	"sparkle", "❇️",
+	"sparkler", "🎇",
+	"sparkles", "✨",
+	"sparkling_heart", "💖",
+	"speak_no_evil", "🙊",
+	"speaker", "🔈",
+	"speaking_head", "🗣️",
+	"speaking_head_in_silhouette", "🗣️",
+	"speech_balloon", "💬",
+	"speech_left", "🗨️",
+	"speedboat", "🚤",
+	"spider", "🕷️",
+	"spider_web", "🕸️",
+	"spiral_calendar_pad", "🗓️",
+	"spiral_note_pad", "🗒️",
+	"sponge", "🧽",
+	"spoon", "🥄",
+	"sports_medal", "🏅",
+	"spy", "🕵️",
+	"spy_tone1", "🕵🏻",
+	"spy_tone2", "🕵🏼",
+	"spy_tone3", "🕵🏽",
+	"spy_tone4", "🕵🏾",
+	"spy_tone5", "🕵🏿",
+	"squeeze_bottle", "🧴",
+	"squid", "🦑",
+	"stadium", "🏟️",
+	"star", "⭐",
+	"star2", "🌟",
+	"star_and_crescent", "☪️",
+	"star_of_david", "✡️",
+	"star_struck", "🤩",
+	"stars", "🌠",
+	"station", "🚉",
+	"statue_of_liberty", "🗽",
+	"steam_locomotive", "🚂",
+	"stethoscope", "🩺",
+	"stew", "🍲",
+	"stop_button", "⏹️",
+	"stop_sign", "🛑",
+	"stopwatch", "⏱️",
+	"straight_ruler", "📏",
+	"strawberry", "🍓",
+	"stuck_out_tongue", "😛",
+	"stuck_out_tongue_closed_eyes", "😝",
+	"stuck_out_tongue_winking_eye", "😜",
+	"student", "🧑\u200d🎓",
+	"student_dark_skin_tone", "🧑🏿\u200d🎓",
+	"student_light_skin_tone", "🧑🏻\u200d🎓",
+	"student_medium_dark_skin_tone", "🧑🏾\u200d🎓",
+	"student_medium_light_skin_tone", "🧑🏼\u200d🎓",
+	"student_medium_skin_tone", "🧑🏽\u200d🎓",
+	"student_tone1", "🧑🏻\u200d🎓",
+	"student_tone2", "🧑🏼\u200d🎓",
+	"student_tone3", "🧑🏽\u200d🎓",
+	"student_tone4", "🧑🏾\u200d🎓",
+	"student_tone5", "🧑🏿\u200d🎓",
+	"studio_microphone", "🎙️",
+	"stuffed_flatbread", "🥙",
+	"stuffed_pita", "🥙",
+	"sun_with_face", "🌞",
+	"sunflower", "🌻",
+	"sunglasses", "😎",
+	"sunny", "☀️",
+	"sunrise", "🌅",
+	"sunrise_over_mountains", "🌄",
+	"superhero", "🦸",
+	"superhero_dark_skin_tone", "🦸🏿",
+	"superhero_light_skin_tone", "🦸🏻",
+	"superhero_medium_dark_skin_tone", "🦸🏾",
+	"superhero_medium_light_skin_tone", "🦸🏼",
+	"superhero_medium_skin_tone", "🦸🏽",
+	"superhero_tone1", "🦸🏻",
+	"superhero_tone2", "🦸🏼",
+	"superhero_tone3", "🦸🏽",
+	"superhero_tone4", "🦸🏾",
+	"superhero_tone5", "🦸🏿",
+	"supervillain", "🦹",
+	"supervillain_dark_skin_tone", "🦹🏿",
+	"supervillain_light_skin_tone", "🦹🏻",
+	"supervillain_medium_dark_skin_tone", "🦹🏾",
+	"supervillain_medium_light_skin_tone", "🦹🏼",
+	"supervillain_medium_skin_tone", "🦹🏽",
+	"supervillain_tone1", "🦹🏻",
+	"supervillain_tone2", "🦹🏼",
+	"supervillain_tone3", "🦹🏽",
+	"supervillain_tone4", "🦹🏾",
+	"supervillain_tone5", "🦹🏿",
+	"surfer", "🏄",
+	"surfer_tone1", "🏄🏻",
+	"surfer_tone2", "🏄🏼",
+	"surfer_tone3", "🏄🏽",
+	"surfer_tone4", "🏄🏾",
+	"surfer_tone5", "🏄🏿",
+	"sushi", "🍣",
+	"suspension_railway", "🚟",
+	"swan", "🦢",
+	"sweat", "😓",
+	"sweat_drops", "💦",
+	"sweat_smile", "😅",
+	"sweet_potato", "🍠",
+	"swimmer", "🏊",
+	"swimmer_tone1", "🏊🏻",
+	"swimmer_tone2", "🏊🏼",
+	"swimmer_tone3", "🏊🏽",
+	"swimmer_tone4", "🏊🏾",
+	"swimmer_tone5", "🏊🏿",
+	"symbols", "🔣",
+	"synagogue", "🕍",
+	"syringe", "💉",
+	"t_rex", "🦖",
+	"table_tennis", "🏓",
+	"taco", "🌮",
+	"tada", "🎉",
+	"takeout_box", "🥡",
+	"tamale", "🫔",
+	"tanabata_tree", "🎋",
+	"tangerine", "🍊",
+	"taurus", "♉",
+	"taxi", "🚕",
+	"tea", "🍵",
+	"teacher", "🧑\u200d🏫",
+	"teacher_dark_skin_tone", "🧑🏿\u200d🏫",
+	"teacher_light_skin_tone", "🧑🏻\u200d🏫",
+	"teacher_medium_dark_skin_tone", "🧑🏾\u200d🏫",
+	"teacher_medium_light_skin_tone", "🧑🏼\u200d🏫",
+	"teacher_medium_skin_tone", "🧑🏽\u200d🏫",
+	"teacher_tone1", "🧑🏻\u200d🏫",
+	"teacher_tone2", "🧑🏼\u200d🏫",
+	"teacher_tone3", "🧑🏽\u200d🏫",
+	"teacher_tone4", "🧑🏾\u200d🏫",
+	"teacher_tone5", "🧑🏿\u200d🏫",
+	"teapot", "🫖",
+	"technologist", "🧑\u200d💻",
+	"technologist_dark_skin_tone", "🧑🏿\u200d💻",
+	"technologist_light_skin_tone", "🧑🏻\u200d💻",
+	"technologist_medium_dark_skin_tone", "🧑🏾\u200d💻",
+	"technologist_medium_light_skin_tone", "🧑🏼\u200d💻",
+	"technologist_medium_skin_tone", "🧑🏽\u200d💻",
+	"technologist_tone1", "🧑🏻\u200d💻",
+	"technologist_tone2", "🧑🏼\u200d💻",
+	"technologist_tone3", "🧑🏽\u200d💻",
+	"technologist_tone4", "🧑🏾\u200d💻",
+	"technologist_tone5", "🧑🏿\u200d💻",
+	"teddy_bear", "🧸",
+	"telephone", "☎️",
+	"telephone_receiver", "📞",
+	"telescope", "🔭",
+	"tennis", "🎾",
+	"tent", "⛺",
+	"test_tube", "🧪",
+	"thermometer", "🌡️",
+	"thermometer_face", "🤒",
+	"thinking", "🤔",
+	"thinking_face", "🤔",
+	"third_place", "🥉",
+	"third_place_medal", "🥉",
+	"thong_sandal", "🩴",
+	"thought_balloon", "💭",
+	"thread", "🧵",
+	"three", "3️⃣",
+	"three_button_mouse", "🖱️",
+	"thumbdown", "👎",
+	"thumbdown_tone1", "👎🏻",
+	"thumbdown_tone2", "👎🏼",
+	"thumbdown_tone3", "👎🏽",
+	"thumbdown_tone4", "👎🏾",
+	"thumbdown_tone5", "👎🏿",
+	"thumbsdown", "👎",
+	"thumbsdown_tone1", "👎🏻",
+	"thumbsdown_tone2", "👎🏼",
+	"thumbsdown_tone3", "👎🏽",
+	"thumbsdown_tone4", "👎🏾",
+	"thumbsdown_tone5", "👎🏿",
+	"thumbsup", "👍",
+	"thumbsup_tone1", "👍🏻",
+	"thumbsup_tone2", "👍🏼",
+	"thumbsup_tone3", "👍🏽",
+	"thumbsup_tone4", "👍🏾",
+	"thumbsup_tone5", "👍🏿",
+	"thumbup", "👍",
+	"thumbup_tone1", "👍🏻",
+	"thumbup_tone2", "👍🏼",
+	"thumbup_tone3", "👍🏽",
+	"thumbup_tone4", "👍🏾",
+	"thumbup_tone5", "👍🏿",
+	"thunder_cloud_and_rain", "⛈️",
+	"thunder_cloud_rain", "⛈️",
+	"ticket", "🎫",
+	"tickets", "🎟️",
+	"tiger", "🐯",
+	"tiger2", "🐅",
+	"timer", "⏲️",
+	"timer_clock", "⏲️",
+	"tired_face", "😫",
+	"tm", "™️",
+	"toilet", "🚽",
+	"tokyo_tower", "🗼",
+	"tomato", "🍅",
+	"tongue", "👅",
+	"toolbox", "🧰",
+	"tools", "🛠️",
+	"tooth", "🦷",
+	"toothbrush", "🪥",
+	"top", "🔝",
+	"tophat", "🎩",
+	"track_next", "⏭️",
+	"track_previous", "⏮️",
+	"trackball", "🖲️",
+	"tractor", "🚜",
+	"traffic_light", "🚥",
+	"train", "🚋",
+	"train2", "🚆",
+	"tram", "🚊",
+	"transgender_flag", "🏳️\u200d⚧️",
+	"transgender_symbol", "⚧",
+	"triangular_flag_on_post", "🚩",
+	"triangular_ruler", "📐",
+	"trident", "🔱",
+	"triumph", "😤",
+	"troll", "🧌",
+	"trolleybus", "🚎",
+	"trophy", "🏆",
+	"tropical_drink", "🍹",
+	"tropical_fish", "🐠",
+	"truck", "🚚",
+	"trumpet", "🎺",
+	"tulip", "🌷",
+	"tumbler_glass", "🥃",
+	"turkey", "🦃",
+	"turtle", "🐢",
+	"tuxedo_tone1", "🤵🏻",
+	"tuxedo_tone2", "🤵🏼",
+	"tuxedo_tone3", "🤵🏽",
+	"tuxedo_tone4", "🤵🏾",
+	"tuxedo_tone5", "🤵🏿",
+	"tv", "📺",
+	"twisted_rightwards_arrows", "🔀",
+	"two", "2️⃣",
+	"two_hearts", "💕",
+	"two_men_holding_hands", "👬",
+	"two_women_holding_hands", "👭",
+	"u5272", "🈹",
+	"u5408", "🈴",
+	"u55b6", "🈺",
+	"u6307", "🈯",
+	"u6708", "🈷️",
+	"u6709", "🈶",
+	"u6e80", "🈵",
+	"u7121", "🈚",
+	"u7533", "🈸",
+	"u7981", "🈲",
+	"u7a7a", "🈳",
+	"umbrella", "☔",
+	"umbrella2", "☂️",
+	"umbrella_on_ground", "⛱️",
+	"unamused", "😒",
+	"underage", "🔞",
+	"unicorn", "🦄",
+	"unicorn_face", "🦄",
+	"united_nations", "🇺🇳",
+	"unlock", "🔓",
+	"up", "🆙",
+	"upside_down", "🙃",
+	"upside_down_face", "🙃",
+	"urn", "⚱️",
+	"v", "✌️",
+	"v_tone1", "✌🏻",
+	"v_tone2", "✌🏼",
+	"v_tone3", "✌🏽",
+	"v_tone4", "✌🏾",
+	"v_tone5", "✌🏿",
+	"vampire", "🧛",
+	"vampire_dark_skin_tone", "🧛🏿",
+	"vampire_light_skin_tone", "🧛🏻",
+	"vampire_medium_dark_skin_tone", "🧛🏾",
+	"vampire_medium_light_skin_tone", "🧛🏼",
+	"vampire_medium_skin_tone", "🧛🏽",
+	"vampire_tone1", "🧛🏻",
+	"vampire_tone2", "🧛🏼",
+	"vampire_tone3", "🧛🏽",
+	"vampire_tone4", "🧛🏾",
+	"vampire_tone5", "🧛🏿",
+	"vertical_traffic_light", "🚦",
+	"vhs", "📼",
+	"vibration_mode", "📳",
+	"video_camera", "📹",
+	"video_game", "🎮",
+	"violin", "🎻",
+	"virgo", "♍",
+	"volcano", "🌋",
+	"volleyball", "🏐",
+	"vs", "🆚",
+	"vulcan", "🖖",
+	"vulcan_tone1", "🖖🏻",
+	"vulcan_tone2", "🖖🏼",
+	"vulcan_tone3", "🖖🏽",
+	"vulcan_tone4", "🖖🏾",
+	"vulcan_tone5", "🖖🏿",
+	"waffle", "🧇",
+	"wales", "🏴\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f",
+	"walking", "🚶",
+	"walking_tone1", "🚶🏻",
+	"walking_tone2", "🚶🏼",
+	"walking_tone3", "🚶🏽",
+	"walking_tone4", "🚶🏾",
+	"walking_tone5", "🚶🏿",
+	"waning_crescent_moon", "🌘",
+	"waning_gibbous_moon", "🌖",
+	"warning", "⚠️",
+	"wastebasket", "🗑️",
+	"watch", "⌚",
+	"water_buffalo", "🐃",
+	"water_polo", "🤽",
+	"water_polo_tone1", "🤽🏻",
+	"water_polo_tone2", "🤽🏼",
+	"water_polo_tone3", "🤽🏽",
+	"water_polo_tone4", "🤽🏾",
+	"water_polo_tone5", "🤽🏿",
+	"watermelon", "🍉",
+	"wave", "👋",
+	"wave_tone1", "👋🏻",
+	"wave_tone2", "👋🏼",
+	"wave_tone3", "👋🏽",
+	"wave_tone4", "👋🏾",
+	"wave_tone5", "👋🏿",
+	"wavy_dash", "〰️",
+	"waxing_crescent_moon", "🌒",
+	"waxing_gibbous_moon", "🌔",
+	"wc", "🚾",
+	"weary", "😩",
+	"wedding", "💒",
+	"weight_lifter", "🏋️",
+	"weight_lifter_tone1", "🏋🏻",
+	"weight_lifter_tone2", "🏋🏼",
+	"weight_lifter_tone3", "🏋🏽",
+	"weight_lifter_tone4", "🏋🏾",
+	"weight_lifter_tone5", "🏋🏿",
+	"whale", "🐳",
+	"whale2", "🐋",
+	"wheel", "🛞",
+	"wheel_of_dharma", "☸️",
+	"wheelchair", "♿",
+	"whisky", "🥃",
+	"white_check_mark", "✅",
+	"white_circle", "⚪",
+	"white_flower", "💮",
+	"white_frowning_face", "☹️",
+	"white_heart", "🤍",
+	"white_large_square", "⬜",
+	"white_medium_small_square", "◽",
+	"white_medium_square", "◻️",
+	"white_small_square", "▫️",
+	"white_square_button", "🔳",
+	"white_sun_behind_cloud", "🌥️",
+	"white_sun_behind_cloud_with_rain", "🌦️",
+	"white_sun_cloud", "🌥️",
+	"white_sun_rain_cloud", "🌦️",
+	"white_sun_small_cloud", "🌤️",
+	"white_sun_with_small_cloud", "🌤️",
+	"wilted_flower", "🥀",
+	"wilted_rose", "🥀",
+	"wind_blowing_face", "🌬️",
+	"wind_chime", "🎐",
+	"window", "🪟",
+	"wine_glass", "🍷",
+	"wink", "😉",
+	"wolf", "🐺",
+	"woman", "👩",
+	"woman_and_man_holding_hands_dark_skin_tone", "👫🏿",
+	"woman_and_man_holding_hands_dark_skin_tone_light_skin_tone", "👩🏿\u200d🤝\u200d👨🏻",
+	"woman_and_man_holding_hands_dark_skin_tone_medium_dark_skin_tone", "👩🏿\u200d🤝\u200d👨🏾",
+	"woman_and_man_holding_hands_dark_skin_tone_medium_light_skin_tone", "👩🏿\u200d🤝\u200d👨🏼",
+	"woman_and_man_holding_hands_dark_skin_tone_medium_skin_tone", "👩🏿\u200d🤝\u200d👨🏽",
+	"woman_and_man_holding_hands_light_skin_tone", "👫🏻",
+	"woman_and_man_holding_hands_light_skin_tone_dark_skin_tone", "👩🏻\u200d🤝\u200d👨🏿",
+	"woman_and_man_holding_hands_light_skin_tone_medium_dark_skin_tone", "👩🏻\u200d🤝\u200d👨🏾",
+	"woman_and_man_holding_hands_light_skin_tone_medium_light_skin_tone", "👩🏻\u200d🤝\u200d👨🏼",
+	"woman_and_man_holding_hands_light_skin_tone_medium_skin_tone", "👩🏻\u200d🤝\u200d👨🏽",
+	"woman_and_man_holding_hands_medium_dark_skin_tone", "👫🏾",
+	"woman_and_man_holding_hands_medium_dark_skin_tone_dark_skin_tone", "👩🏾\u200d🤝\u200d👨🏿",
+	"woman_and_man_holding_hands_medium_dark_skin_tone_light_skin_tone", "👩🏾\u200d🤝\u200d👨🏻",
+	"woman_and_man_holding_hands_medium_dark_skin_tone_medium_light_skin_tone", "👩🏾\u200d🤝\u200d👨🏼",
+	"woman_and_man_holding_hands_medium_dark_skin_tone_medium_skin_tone", "👩🏾\u200d🤝\u200d👨🏽",
+	"woman_and_man_holding_hands_medium_light_skin_tone", "👫🏼",
+	"woman_and_man_holding_hands_medium_light_skin_tone_dark_skin_tone", "👩🏼\u200d🤝\u200d👨🏿",
+	"woman_and_man_holding_hands_medium_light_skin_tone_light_skin_tone", "👩🏼\u200d🤝\u200d👨🏻",
+	"woman_and_man_holding_hands_medium_light_skin_tone_medium_dark_skin_tone", "👩🏼\u200d🤝\u200d👨🏾",
+	"woman_and_man_holding_hands_medium_light_skin_tone_medium_skin_tone", "👩🏼\u200d🤝\u200d👨🏽",
+	"woman_and_man_holding_hands_medium_skin_tone", "👫🏽",
+	"woman_and_man_holding_hands_medium_skin_tone_dark_skin_tone", "👩🏽\u200d🤝\u200d👨🏿",
+	"woman_and_man_holding_hands_medium_skin_tone_light_skin_tone", "👩🏽\u200d🤝\u200d👨🏻",
+	"woman_and_man_holding_hands_medium_skin_tone_medium_dark_skin_tone", "👩🏽\u200d🤝\u200d👨🏾",
+	"woman_and_man_holding_hands_medium_skin_tone_medium_light_skin_tone", "👩🏽\u200d🤝\u200d👨🏼",
+	"woman_and_man_holding_hands_tone1", "👫🏻",
+	"woman_and_man_holding_hands_tone1_tone2", "👩🏻\u200d🤝\u200d👨🏼",
+	"woman_and_man_holding_hands_tone1_tone3", "👩🏻\u200d🤝\u200d👨🏽",
+	"woman_and_man_holding_hands_tone1_tone4", "👩🏻\u200d🤝\u200d👨🏾",
+	"woman_and_man_holding_hands_tone1_tone5", "👩🏻\u200d🤝\u200d👨🏿",
+	"woman_and_man_holding_hands_tone2", "👫🏼",
+	"woman_and_man_holding_hands_tone2_tone1", "👩🏼\u200d🤝\u200d👨🏻",
+	"woman_and_man_holding_hands_tone2_tone3", "👩🏼\u200d🤝\u200d👨🏽",
+	"woman_and_man_holding_hands_tone2_tone4", "👩🏼\u200d🤝\u200d👨🏾",
+	"woman_and_man_holding_hands_tone2_tone5", "👩🏼\u200d🤝\u200d👨🏿",
+	"woman_and_man_holding_hands_tone3", "👫🏽",
+	"woman_and_man_holding_hands_tone3_tone1", "👩🏽\u200d🤝\u200d👨🏻",
+	"woman_and_man_holding_hands_tone3_tone2", "👩🏽\u200d🤝\u200d👨🏼",
+	"woman_and_man_holding_hands_tone3_tone4", "👩🏽\u200d🤝\u200d👨🏾",
+	"woman_and_man_holding_hands_tone3_tone5", "👩🏽\u200d🤝\u200d👨🏿",
+	"woman_and_man_holding_hands_tone4", "👫🏾",
+	"woman_and_man_holding_hands_tone4_tone1", "👩🏾\u200d🤝\u200d👨🏻",
+	"woman_and_man_holding_hands_tone4_tone2", "👩🏾\u200d🤝\u200d👨🏼",
+	"woman_and_man_holding_hands_tone4_tone3", "👩🏾\u200d🤝\u200d👨🏽",
+	"woman_and_man_holding_hands_tone4_tone5", "👩🏾\u200d🤝\u200d👨🏿",
+	"woman_and_man_holding_hands_tone5", "👫🏿",
+	"woman_and_man_holding_hands_tone5_tone1", "👩🏿\u200d🤝\u200d👨🏻",
+	"woman_and_man_holding_hands_tone5_tone2", "👩🏿\u200d🤝\u200d👨🏼",
+	"woman_and_man_holding_hands_tone5_tone3", "👩🏿\u200d🤝\u200d👨🏽",
+	"woman_and_man_holding_hands_tone5_tone4", "👩🏿\u200d🤝\u200d👨🏾",
+	"woman_artist", "👩\u200d🎨",
+	"woman_artist_dark_skin_tone", "👩🏿\u200d🎨",
+	"woman_artist_light_skin_tone", "👩🏻\u200d🎨",
+	"woman_artist_medium_dark_skin_tone", "👩🏾\u200d🎨",
+	"woman_artist_medium_light_skin_tone", "👩🏼\u200d🎨",
+	"woman_artist_medium_skin_tone", "👩🏽\u200d🎨",
+	"woman_artist_tone1", "👩🏻\u200d🎨",
+	"woman_artist_tone2", "👩🏼\u200d🎨",
+	"woman_artist_tone3", "👩🏽\u200d🎨",
+	"woman_artist_tone4", "👩🏾\u200d🎨",
+	"woman_artist_tone5", "👩🏿\u200d🎨",
+	"woman_astronaut", "👩\u200d🚀",
+	"woman_astronaut_dark_skin_tone", "👩🏿\u200d🚀",
+	"woman_astronaut_light_skin_tone", "👩🏻\u200d🚀",
+	"woman_astronaut_medium_dark_skin_tone", "👩🏾\u200d🚀",
+	"woman_astronaut_medium_light_skin_tone", "👩🏼\u200d🚀",
+	"woman_astronaut_medium_skin_tone", "👩🏽\u200d🚀",
+	"woman_astronaut_tone1", "👩🏻\u200d🚀",
+	"woman_astronaut_tone2", "👩🏼\u200d🚀",
+	"woman_astronaut_tone3", "👩🏽\u200d🚀",
+	"woman_astronaut_tone4", "👩🏾\u200d🚀",
+	"woman_astronaut_tone5", "👩🏿\u200d🚀",
+	"woman_bald", "👩\u200d🦲",
+	"woman_bald_dark_skin_tone", "👩🏿\u200d🦲",
+	"woman_bald_light_skin_tone", "👩🏻\u200d🦲",
+	"woman_bald_medium_dark_skin_tone", "👩🏾\u200d🦲",
+	"woman_bald_medium_light_skin_tone", "👩🏼\u200d🦲",
+	"woman_bald_medium_skin_tone", "👩🏽\u200d🦲",
+	"woman_bald_tone1", "👩🏻\u200d🦲",
+	"woman_bald_tone2", "👩🏼\u200d🦲",
+	"woman_bald_tone3", "👩🏽\u200d🦲",
+	"woman_bald_tone4", "👩🏾\u200d🦲",
+	"woman_bald_tone5", "👩🏿\u200d🦲",
+	"woman_beard", "🧔\u200d♀️",
+	"woman_biking", "🚴\u200d♀️",
+	"woman_biking_dark_skin_tone", "🚴🏿\u200d♀️",
+	"woman_biking_light_skin_tone", "🚴🏻\u200d♀️",
+	"woman_biking_medium_dark_skin_tone", "🚴🏾\u200d♀️",
+	"woman_biking_medium_light_skin_tone", "🚴🏼\u200d♀️",
+	"woman_biking_medium_skin_tone", "🚴🏽\u200d♀️",
+	"woman_biking_tone1", "🚴🏻\u200d♀️",
+	"woman_biking_tone2", "🚴🏼\u200d♀️",
+	"woman_biking_tone3", "🚴🏽\u200d♀️",
+	"woman_biking_tone4", "🚴🏾\u200d♀️",
+	"woman_biking_tone5", "🚴🏿\u200d♀️",
+	"woman_bouncing_ball", "⛹️\u200d♀️",
+	"woman_bouncing_ball_dark_skin_tone", "⛹🏿\u200d♀️",
+	"woman_bouncing_ball_light_skin_tone", "⛹🏻\u200d♀️",
+	"woman_bouncing_ball_medium_dark_skin_tone", "⛹🏾\u200d♀️",
+	"woman_bouncing_ball_medium_light_skin_tone", "⛹🏼\u200d♀️",
+	"woman_bouncing_ball_medium_skin_tone", "⛹🏽\u200d♀️",
+	"woman_bouncing_ball_tone1", "⛹🏻\u200d♀️",
+	"woman_bouncing_ball_tone2", "⛹🏼\u200d♀️",
+	"woman_bouncing_ball_tone3", "⛹🏽\u200d♀️",
+	"woman_bouncing_ball_tone4", "⛹🏾\u200d♀️",
+	"woman_bouncing_ball_tone5", "⛹🏿\u200d♀️",
+	"woman_bowing", "🙇\u200d♀️",
+	"woman_bowing_dark_skin_tone", "🙇🏿\u200d♀️",
+	"woman_bowing_light_skin_tone", "🙇🏻\u200d♀️",
+	"woman_bowing_medium_dark_skin_tone", "🙇🏾\u200d♀️",
+	"woman_bowing_medium_light_skin_tone", "🙇🏼\u200d♀️",
+	"woman_bowing_medium_skin_tone", "🙇🏽\u200d♀️",
+	"woman_bowing_tone1", "🙇🏻\u200d♀️",
+	"woman_bowing_tone2", "🙇🏼\u200d♀️",
+	"woman_bowing_tone3", "🙇🏽\u200d♀️",
+	"woman_bowing_tone4", "🙇🏾\u200d♀️",
+	"woman_bowing_tone5", "🙇🏿\u200d♀️",
+	"woman_cartwheeling", "🤸\u200d♀️",
+	"woman_cartwheeling_dark_skin_tone", "🤸🏿\u200d♀️",
+	"woman_cartwheeling_light_skin_tone", "🤸🏻\u200d♀️",
+	"woman_cartwheeling_medium_dark_skin_tone", "🤸🏾\u200d♀️",
+	"woman_cartwheeling_medium_light_skin_tone", "🤸🏼\u200d♀️",
+	"woman_cartwheeling_medium_skin_tone", "🤸🏽\u200d♀️",
+	"woman_cartwheeling_tone1", "🤸🏻\u200d♀️",
+	"woman_cartwheeling_tone2", "🤸🏼\u200d♀️",
+	"woman_cartwheeling_tone3", "🤸🏽\u200d♀️",
+	"woman_cartwheeling_tone4", "🤸🏾\u200d♀️",
+	"woman_cartwheeling_tone5", "🤸🏿\u200d♀️",
+	"woman_climbing", "🧗\u200d♀️",
+	"woman_climbing_dark_skin_tone", "🧗🏿\u200d♀️",
+	"woman_climbing_light_skin_tone", "🧗🏻\u200d♀️",
+	"woman_climbing_medium_dark_skin_tone", "🧗🏾\u200d♀️",
+	"woman_climbing_medium_light_skin_tone", "🧗🏼\u200d♀️",
+	"woman_climbing_medium_skin_tone", "🧗🏽\u200d♀️",
+	"woman_climbing_tone1", "🧗🏻\u200d♀️",
+	"woman_climbing_tone2", "🧗🏼\u200d♀️",
+	"woman_climbing_tone3", "🧗🏽\u200d♀️",
+	"woman_climbing_tone4", "🧗🏾\u200d♀️",
+	"woman_climbing_tone5", "🧗🏿\u200d♀️",
+	"woman_construction_worker", "👷\u200d♀️",
+	"woman_construction_worker_dark_skin_tone", "👷🏿\u200d♀️",
+	"woman_construction_worker_light_skin_tone", "👷🏻\u200d♀️",
+	"woman_construction_worker_medium_dark_skin_tone", "👷🏾\u200d♀️",
+	"woman_construction_worker_medium_light_skin_tone", "👷🏼\u200d♀️",
+	"woman_construction_worker_medium_skin_tone", "👷🏽\u200d♀️",
+	"woman_construction_worker_tone1", "👷🏻\u200d♀️",
+	"woman_construction_worker_tone2", "👷🏼\u200d♀️",
+	"woman_construction_worker_tone3", "👷🏽\u200d♀️",
+	"woman_construction_worker_tone4", "👷🏾\u200d♀️",
+	"woman_construction_worker_tone5", "👷🏿\u200d♀️",
+	"woman_cook", "👩\u200d🍳",
+	"woman_cook_dark_skin_tone", "👩🏿\u200d🍳",
+	"woman_cook_light_skin_tone", "👩🏻\u200d🍳",
+	"woman_cook_medium_dark_skin_tone", "👩🏾\u200d🍳",
+	"woman_cook_medium_light_skin_tone", "👩🏼\u200d🍳",
+	"woman_cook_medium_skin_tone", "👩🏽\u200d🍳",
+	"woman_cook_tone1", "👩🏻\u200d🍳",
+	"woman_cook_tone2", "👩🏼\u200d🍳",
+	"woman_cook_tone3", "👩🏽\u200d🍳",
+	"woman_cook_tone4", "👩🏾\u200d🍳",
+	"woman_cook_tone5", "👩🏿\u200d🍳",
+	"woman_curly_haired", "👩\u200d🦱",
+	"woman_curly_haired_dark_skin_tone", "👩🏿\u200d🦱",
+	"woman_curly_haired_light_skin_tone", "👩🏻\u200d🦱",
+	"woman_curly_haired_medium_dark_skin_tone", "👩🏾\u200d🦱",
+	"woman_curly_haired_medium_light_skin_tone", "👩🏼\u200d🦱",
+	"woman_curly_haired_medium_skin_tone", "👩🏽\u200d🦱",
+	"woman_curly_haired_tone1", "👩🏻\u200d🦱",
+	"woman_curly_haired_tone2", "👩🏼\u200d🦱",
+	"woman_curly_haired_tone3", "👩🏽\u200d🦱",
+	"woman_curly_haired_tone4", "👩🏾\u200d🦱",
+	"woman_curly_haired_tone5", "👩🏿\u200d🦱",
+	"woman_dark_skin_tone_beard", "🧔🏿\u200d♀️",
+	"woman_detective", "🕵️\u200d♀️",
+	"woman_detective_dark_skin_tone", "🕵🏿\u200d♀️",
+	"woman_detective_light_skin_tone", "🕵🏻\u200d♀️",
+	"woman_detective_medium_dark_skin_tone", "🕵🏾\u200d♀️",
+	"woman_detective_medium_light_skin_tone", "🕵🏼\u200d♀️",
+	"woman_detective_medium_skin_tone", "🕵🏽\u200d♀️",
+	"woman_detective_tone1", "🕵🏻\u200d♀️",
+	"woman_detective_tone2", "🕵🏼\u200d♀️",
+	"woman_detective_tone3", "🕵🏽\u200d♀️",
+	"woman_detective_tone4", "🕵🏾\u200d♀️",
+	"woman_detective_tone5", "🕵🏿\u200d♀️",
+	"woman_elf", "🧝\u200d♀️",
+	"woman_elf_dark_skin_tone", "🧝🏿\u200d♀️",
+	"woman_elf_light_skin_tone", "🧝🏻\u200d♀️",
+	"woman_elf_medium_dark_skin_tone", "🧝🏾\u200d♀️",
+	"woman_elf_medium_light_skin_tone", "🧝🏼\u200d♀️",
+	"woman_elf_medium_skin_tone", "🧝🏽\u200d♀️",
+	"woman_elf_tone1", "🧝🏻\u200d♀️",
+	"woman_elf_tone2", "🧝🏼\u200d♀️",
+	"woman_elf_tone3", "🧝🏽\u200d♀️",
+	"woman_elf_tone4", "🧝🏾\u200d♀️",
+	"woman_elf_tone5", "🧝🏿\u200d♀️",
+	"woman_facepalming", "🤦\u200d♀️",
+	"woman_facepalming_dark_skin_tone", "🤦🏿\u200d♀️",
+	"woman_facepalming_light_skin_tone", "🤦🏻\u200d♀️",
+	"woman_facepalming_medium_dark_skin_tone", "🤦🏾\u200d♀️",
+	"woman_facepalming_medium_light_skin_tone", "🤦🏼\u200d♀️",
+	"woman_facepalming_medium_skin_tone", "🤦🏽\u200d♀️",
+	"woman_facepalming_tone1", "🤦🏻\u200d♀️",
+	"woman_facepalming_tone2", "🤦🏼\u200d♀️",
+	"woman_facepalming_tone3", "🤦🏽\u200d♀️",
+	"woman_facepalming_tone4", "🤦🏾\u200d♀️",
+	"woman_facepalming_tone5", "🤦🏿\u200d♀️",
+	"woman_factory_worker", "👩\u200d🏭",
+	"woman_factory_worker_dark_skin_tone", "👩🏿\u200d🏭",
+	"woman_factory_worker_light_skin_tone", "👩🏻\u200d🏭",
+	"woman_factory_worker_medium_dark_skin_tone", "👩🏾\u200d🏭",
+	"woman_factory_worker_medium_light_skin_tone", "👩🏼\u200d🏭",
+	"woman_factory_worker_medium_skin_tone", "👩🏽\u200d🏭",
+	"woman_factory_worker_tone1", "👩🏻\u200d🏭",
+	"woman_factory_worker_tone2", "👩🏼\u200d🏭",
+	"woman_factory_worker_tone3", "👩🏽\u200d🏭",
+	"woman_factory_worker_tone4", "👩🏾\u200d🏭",
+	"woman_factory_worker_tone5", "👩🏿\u200d🏭",
+	"woman_fairy", "🧚\u200d♀️",
+	"woman_fairy_dark_skin_tone", "🧚🏿\u200d♀️",
+	"woman_fairy_light_skin_tone", "🧚🏻\u200d♀️",
+	"woman_fairy_medium_dark_skin_tone", "🧚🏾\u200d♀️",
+	"woman_fairy_medium_light_skin_tone", "🧚🏼\u200d♀️",
+	"woman_fairy_medium_skin_tone", "🧚🏽\u200d♀️",
+	"woman_fairy_tone1", "🧚🏻\u200d♀️",
+	"woman_fairy_tone2", "🧚🏼\u200d♀️",
+	"woman_fairy_tone3", "🧚🏽\u200d♀️",
+	"woman_fairy_tone4", "🧚🏾\u200d♀️",
+	"woman_fairy_tone5", "🧚🏿\u200d♀️",
+	"woman_farmer", "👩\u200d🌾",
+	"woman_farmer_dark_skin_tone", "👩🏿\u200d🌾",
+	"woman_farmer_light_skin_tone", "👩🏻\u200d🌾",
+	"woman_farmer_medium_dark_skin_tone", "👩🏾\u200d🌾",
+	"woman_farmer_medium_light_skin_tone", "👩🏼\u200d🌾",
+	"woman_farmer_medium_skin_tone", "👩🏽\u200d🌾",
+	"woman_farmer_tone1", "👩🏻\u200d🌾",
+	"woman_farmer_tone2", "👩🏼\u200d🌾",
+	"woman_farmer_tone3", "👩🏽\u200d🌾",
+	"woman_farmer_tone4", "👩🏾\u200d🌾",
+	"woman_farmer_tone5", "👩🏿\u200d🌾",
+	"woman_feeding_baby", "👩\u200d🍼",
+	"woman_feeding_baby_dark_skin_tone", "👩🏿\u200d🍼",
+	"woman_feeding_baby_light_skin_tone", "👩🏻\u200d🍼",
+	"woman_feeding_baby_medium_dark_skin_tone", "👩🏾\u200d🍼",
+	"woman_feeding_baby_medium_light_skin_tone", "👩🏼\u200d🍼",
+	"woman_feeding_baby_medium_skin_tone", "👩🏽\u200d🍼",
+	"woman_feeding_baby_tone1", "👩🏻\u200d🍼",
+	"woman_feeding_baby_tone2", "👩🏼\u200d🍼",
+	"woman_feeding_baby_tone3", "👩🏽\u200d🍼",
+	"woman_feeding_baby_tone4", "👩🏾\u200d🍼",
+	"woman_feeding_baby_tone5", "👩🏿\u200d🍼",
+	"woman_firefighter", "👩\u200d🚒",
+	"woman_firefighter_dark_skin_tone", "👩🏿\u200d🚒",
+	"woman_firefighter_light_skin_tone", "👩🏻\u200d🚒",
+	"woman_firefighter_medium_dark_skin_tone", "👩🏾\u200d🚒",
+	"woman_firefighter_medium_light_skin_tone", "👩🏼\u200d🚒",
+	"woman_firefighter_medium_skin_tone", "👩🏽\u200d🚒",
+	"woman_firefighter_tone1", "👩🏻\u200d🚒",
+	"woman_firefighter_tone2", "👩🏼\u200d🚒",
+	"woman_firefighter_tone3", "👩🏽\u200d🚒",
+	"woman_firefighter_tone4", "👩🏾\u200d🚒",
+	"woman_firefighter_tone5", "👩🏿\u200d🚒",
+	"woman_frowning", "🙍\u200d♀️",
+	"woman_frowning_dark_skin_tone", "🙍🏿\u200d♀️",
+	"woman_frowning_light_skin_tone", "🙍🏻\u200d♀️",
+	"woman_frowning_medium_dark_skin_tone", "🙍🏾\u200d♀️",
+	"woman_frowning_medium_light_skin_tone", "🙍🏼\u200d♀️",
+	"woman_frowning_medium_skin_tone", "🙍🏽\u200d♀️",
+	"woman_frowning_tone1", "🙍🏻\u200d♀️",
+	"woman_frowning_tone2", "🙍🏼\u200d♀️",
+	"woman_frowning_tone3", "🙍🏽\u200d♀️",
+	"woman_frowning_tone4", "🙍🏾\u200d♀️",
+	"woman_frowning_tone5", "🙍🏿\u200d♀️",
+	"woman_genie", "🧞\u200d♀️",
+	"woman_gesturing_no", "🙅\u200d♀️",
+	"woman_gesturing_no_dark_skin_tone", "🙅🏿\u200d♀️",
+	"woman_gesturing_no_light_skin_tone", "🙅🏻\u200d♀️",
+	"woman_gesturing_no_medium_dark_skin_tone", "🙅🏾\u200d♀️",
+	"woman_gesturing_no_medium_light_skin_tone", "🙅🏼\u200d♀️",
+	"woman_gesturing_no_medium_skin_tone", "🙅🏽\u200d♀️",
+	"woman_gesturing_no_tone1", "🙅🏻\u200d♀️",
+	"woman_gesturing_no_tone2", "🙅🏼\u200d♀️",
+	"woman_gesturing_no_tone3", "🙅🏽\u200d♀️",
+	"woman_gesturing_no_tone4", "🙅🏾\u200d♀️",
+	"woman_gesturing_no_tone5", "🙅🏿\u200d♀️",
+	"woman_gesturing_ok", "🙆\u200d♀️",
+	"woman_gesturing_ok_dark_skin_tone", "🙆🏿\u200d♀️",
+	"woman_gesturing_ok_light_skin_tone", "🙆🏻\u200d♀️",
+	"woman_gesturing_ok_medium_dark_skin_tone", "🙆🏾\u200d♀️",
+	"woman_gesturing_ok_medium_light_skin_tone", "🙆🏼\u200d♀️",
+	"woman_gesturing_ok_medium_skin_tone", "🙆🏽\u200d♀️",
+	"woman_gesturing_ok_tone1", "🙆🏻\u200d♀️",
+	"woman_gesturing_ok_tone2", "🙆🏼\u200d♀️",
+	"woman_gesturing_ok_tone3", "🙆🏽\u200d♀️",
+	"woman_gesturing_ok_tone4", "🙆🏾\u200d♀️",
+	"woman_gesturing_ok_tone5", "🙆🏿\u200d♀️",
+	"woman_getting_face_massage", "💆\u200d♀️",
+	"woman_getting_face_massage_dark_skin_tone", "💆🏿\u200d♀️",
+	"woman_getting_face_massage_light_skin_tone", "💆🏻\u200d♀️",
+	"woman_getting_face_massage_medium_dark_skin_tone", "💆🏾\u200d♀️",
+	"woman_getting_face_massage_medium_light_skin_tone", "💆🏼\u200d♀️",
+	"woman_getting_face_massage_medium_skin_tone", "💆🏽\u200d♀️",
+	"woman_getting_face_massage_tone1", "💆🏻\u200d♀️",
+	"woman_getting_face_massage_tone2", "💆🏼\u200d♀️",
+	"woman_getting_face_massage_tone3", "💆🏽\u200d♀️",
+	"woman_getting_face_massage_tone4", "💆🏾\u200d♀️",
+	"woman_getting_face_massage_tone5", "💆🏿\u200d♀️",
+	"woman_getting_haircut", "💇\u200d♀️",
+	"woman_getting_haircut_dark_skin_tone", "💇🏿\u200d♀️",
+	"woman_getting_haircut_light_skin_tone", "💇🏻\u200d♀️",
+	"woman_getting_haircut_medium_dark_skin_tone", "💇🏾\u200d♀️",
+	"woman_getting_haircut_medium_light_skin_tone", "💇🏼\u200d♀️",
+	"woman_getting_haircut_medium_skin_tone", "💇🏽\u200d♀️",
+	"woman_getting_haircut_tone1", "💇🏻\u200d♀️",
+	"woman_getting_haircut_tone2", "💇🏼\u200d♀️",
+	"woman_getting_haircut_tone3", "💇🏽\u200d♀️",
+	"woman_getting_haircut_tone4", "💇🏾\u200d♀️",
+	"woman_getting_haircut_tone5", "💇🏿\u200d♀️",
+	"woman_golfing", "🏌️\u200d♀️",
+	"woman_golfing_dark_skin_tone", "🏌🏿\u200d♀️",
+	"woman_golfing_light_skin_tone", "🏌🏻\u200d♀️",
+	"woman_golfing_medium_dark_skin_tone", "🏌🏾\u200d♀️",
+	"woman_golfing_medium_light_skin_tone", "🏌🏼\u200d♀️",
+	"woman_golfing_medium_skin_tone", "🏌🏽\u200d♀️",
+	"woman_golfing_tone1", "🏌🏻\u200d♀️",
+	"woman_golfing_tone2", "🏌🏼\u200d♀️",
+	"woman_golfing_tone3", "🏌🏽\u200d♀️",
+	"woman_golfing_tone4", "🏌🏾\u200d♀️",
+	"woman_golfing_tone5", "🏌🏿\u200d♀️",
+	"woman_guard", "💂\u200d♀️",
+	"woman_guard_dark_skin_tone", "💂🏿\u200d♀️",
+	"woman_guard_light_skin_tone", "💂🏻\u200d♀️",
+	"woman_guard_medium_dark_skin_tone", "💂🏾\u200d♀️",
+	"woman_guard_medium_light_skin_tone", "💂🏼\u200d♀️",
+	"woman_guard_medium_skin_tone", "💂🏽\u200d♀️",
+	"woman_guard_tone1", "💂🏻\u200d♀️",
+	"woman_guard_tone2", "💂🏼\u200d♀️",
+	"woman_guard_tone3", "💂🏽\u200d♀️",
+	"woman_guard_tone4", "💂🏾\u200d♀️",
+	"woman_guard_tone5", "💂🏿\u200d♀️",
+	"woman_health_worker", "👩\u200d⚕️",
+	"woman_health_worker_dark_skin_tone", "👩🏿\u200d⚕️",
+	"woman_health_worker_light_skin_tone", "👩🏻\u200d⚕️",
+	"woman_health_worker_medium_dark_skin_tone", "👩🏾\u200d⚕️",
+	"woman_health_worker_medium_light_skin_tone", "👩🏼\u200d⚕️",
+	"woman_health_worker_medium_skin_tone", "👩🏽\u200d⚕️",
+	"woman_health_worker_tone1", "👩🏻\u200d⚕️",
+	"woman_health_worker_tone2", "👩🏼\u200d⚕️",
+	"woman_health_worker_tone3", "👩🏽\u200d⚕️",
+	"woman_health_worker_tone4", "👩🏾\u200d⚕️",
+	"woman_health_worker_tone5", "👩🏿\u200d⚕️",
+	"woman_in_lotus_position", "🧘\u200d♀️",
+	"woman_in_lotus_position_dark_skin_tone", "🧘🏿\u200d♀️",
+	"woman_in_lotus_position_light_skin_tone", "🧘🏻\u200d♀️",
+	"woman_in_lotus_position_medium_dark_skin_tone", "🧘🏾\u200d♀️",
+	"woman_in_lotus_position_medium_light_skin_tone", "🧘🏼\u200d♀️",
+	"woman_in_lotus_position_medium_skin_tone", "🧘🏽\u200d♀️",
+	"woman_in_lotus_position_tone1", "🧘🏻\u200d♀️",
+	"woman_in_lotus_position_tone2", "🧘🏼\u200d♀️",
+	"woman_in_lotus_position_tone3", "🧘🏽\u200d♀️",
+	"woman_in_lotus_position_tone4", "🧘🏾\u200d♀️",
+	"woman_in_lotus_position_tone5", "🧘🏿\u200d♀️",
+	"woman_in_manual_wheelchair", "👩\u200d🦽",
+	"woman_in_manual_wheelchair_dark_skin_tone", "👩🏿\u200d🦽",
+	"woman_in_manual_wheelchair_light_skin_tone", "👩🏻\u200d🦽",
+	"woman_in_manual_wheelchair_medium_dark_skin_tone", "👩🏾\u200d🦽",
+	"woman_in_manual_wheelchair_medium_light_skin_tone", "👩🏼\u200d🦽",
+	"woman_in_manual_wheelchair_medium_skin_tone", "👩🏽\u200d🦽",
+	"woman_in_manual_wheelchair_tone1", "👩🏻\u200d🦽",
+	"woman_in_manual_wheelchair_tone2", "👩🏼\u200d🦽",
+	"woman_in_manual_wheelchair_tone3", "👩🏽\u200d🦽",
+	"woman_in_manual_wheelchair_tone4", "👩🏾\u200d🦽",
+	"woman_in_manual_wheelchair_tone5", "👩🏿\u200d🦽",
+	"woman_in_motorized_wheelchair", "👩\u200d🦼",
+	"woman_in_motorized_wheelchair_dark_skin_tone", "👩🏿\u200d🦼",
+	"woman_in_motorized_wheelchair_light_skin_tone", "👩🏻\u200d🦼",
+	"woman_in_motorized_wheelchair_medium_dark_skin_tone", "👩🏾\u200d🦼",
+	"woman_in_motorized_wheelchair_medium_light_skin_tone", "👩🏼\u200d🦼",
+	"woman_in_motorized_wheelchair_medium_skin_tone", "👩🏽\u200d🦼",
+	"woman_in_motorized_wheelchair_tone1", "👩🏻\u200d🦼",
+	"woman_in_motorized_wheelchair_tone2", "👩🏼\u200d🦼",
+	"woman_in_motorized_wheelchair_tone3", "👩🏽\u200d🦼",
+	"woman_in_motorized_wheelchair_tone4", "👩🏾\u200d🦼",
+	"woman_in_motorized_wheelchair_tone5", "👩🏿\u200d🦼",
+	"woman_in_steamy_room", "🧖\u200d♀️",
+	"woman_in_steamy_room_dark_skin_tone", "🧖🏿\u200d♀️",
+	"woman_in_steamy_room_light_skin_tone", "🧖🏻\u200d♀️",
+	"woman_in_steamy_room_medium_dark_skin_tone", "🧖🏾\u200d♀️",
+	"woman_in_steamy_room_medium_light_skin_tone", "🧖🏼\u200d♀️",
+	"woman_in_steamy_room_medium_skin_tone", "🧖🏽\u200d♀️",
+	"woman_in_steamy_room_tone1", "🧖🏻\u200d♀️",
+	"woman_in_steamy_room_tone2", "🧖🏼\u200d♀️",
+	"woman_in_steamy_room_tone3", "🧖🏽\u200d♀️",
+	"woman_in_steamy_room_tone4", "🧖🏾\u200d♀️",
+	"woman_in_steamy_room_tone5", "🧖🏿\u200d♀️",
+	"woman_in_tuxedo", "🤵\u200d♀️",
+	"woman_in_tuxedo_dark_skin_tone", "🤵🏿\u200d♀️",
+	"woman_in_tuxedo_light_skin_tone", "🤵🏻\u200d♀️",
+	"woman_in_tuxedo_medium_dark_skin_tone", "🤵🏾\u200d♀️",
+	"woman_in_tuxedo_medium_light_skin_tone", "🤵🏼\u200d♀️",
+	"woman_in_tuxedo_medium_skin_tone", "🤵🏽\u200d♀️",
+	"woman_in_tuxedo_tone1", "🤵🏻\u200d♀️",
+	"woman_in_tuxedo_tone2", "🤵🏼\u200d♀️",
+	"woman_in_tuxedo_tone3", "🤵🏽\u200d♀️",
+	"woman_in_tuxedo_tone4", "🤵🏾\u200d♀️",
+	"woman_in_tuxedo_tone5", "🤵🏿\u200d♀️",
+	"woman_judge", "👩\u200d⚖️",
+	"woman_judge_dark_skin_tone", "👩🏿\u200d⚖️",
+	"woman_judge_light_skin_tone", "👩🏻\u200d⚖️",
+	"woman_judge_medium_dark_skin_tone", "👩🏾\u200d⚖️",
+	"woman_judge_medium_light_skin_tone", "👩🏼\u200d⚖️",
+	"woman_judge_medium_skin_tone", "👩🏽\u200d⚖️",
+	"woman_judge_tone1", "👩🏻\u200d⚖️",
+	"woman_judge_tone2", "👩🏼\u200d⚖️",
+	"woman_judge_tone3", "👩🏽\u200d⚖️",
+	"woman_judge_tone4", "👩🏾\u200d⚖️",
+	"woman_judge_tone5", "👩🏿\u200d⚖️",
+	"woman_juggling", "🤹\u200d♀️",
+	"woman_juggling_dark_skin_tone", "🤹🏿\u200d♀️",
+	"woman_juggling_light_skin_tone", "🤹🏻\u200d♀️",
+	"woman_juggling_medium_dark_skin_tone", "🤹🏾\u200d♀️",
+	"woman_juggling_medium_light_skin_tone", "🤹🏼\u200d♀️",
+	"woman_juggling_medium_skin_tone", "🤹🏽\u200d♀️",
+	"woman_juggling_tone1", "🤹🏻\u200d♀️",
+	"woman_juggling_tone2", "🤹🏼\u200d♀️",
+	"woman_juggling_tone3", "🤹🏽\u200d♀️",
+	"woman_juggling_tone4", "🤹🏾\u200d♀️",
+	"woman_juggling_tone5", "🤹🏿\u200d♀️",
+	"woman_kneeling", "🧎\u200d♀️",
+	"woman_kneeling_dark_skin_tone", "🧎🏿\u200d♀️",
+	"woman_kneeling_light_skin_tone", "🧎🏻\u200d♀️",
+	"woman_kneeling_medium_dark_skin_tone", "🧎🏾\u200d♀️",
+	"woman_kneeling_medium_light_skin_tone", "🧎🏼\u200d♀️",
+	"woman_kneeling_medium_skin_tone", "🧎🏽\u200d♀️",
+	"woman_kneeling_tone1", "🧎🏻\u200d♀️",
+	"woman_kneeling_tone2", "🧎🏼\u200d♀️",
+	"woman_kneeling_tone3", "🧎🏽\u200d♀️",
+	"woman_kneeling_tone4", "🧎🏾\u200d♀️",
+	"woman_kneeling_tone5", "🧎🏿\u200d♀️",
+	"woman_lifting_weights", "🏋️\u200d♀️",
+	"woman_lifting_weights_dark_skin_tone", "🏋🏿\u200d♀️",
+	"woman_lifting_weights_light_skin_tone", "🏋🏻\u200d♀️",
+	"woman_lifting_weights_medium_dark_skin_tone", "🏋🏾\u200d♀️",
+	"woman_lifting_weights_medium_light_skin_tone", "🏋🏼\u200d♀️",
+	"woman_lifting_weights_medium_skin_tone", "🏋🏽\u200d♀️",
+	"woman_lifting_weights_tone1", "🏋🏻\u200d♀️",
+	"woman_lifting_weights_tone2", "🏋🏼\u200d♀️",
+	"woman_lifting_weights_tone3", "🏋🏽\u200d♀️",
+	"woman_lifting_weights_tone4", "🏋🏾\u200d♀️",
+	"woman_lifting_weights_tone5", "🏋🏿\u200d♀️",
+	"woman_light_skin_tone_beard", "🧔🏻\u200d♀️",
+	"woman_mage", "🧙\u200d♀️",
+	"woman_mage_dark_skin_tone", "🧙🏿\u200d♀️",
+	"woman_mage_light_skin_tone", "🧙🏻\u200d♀️",
+	"woman_mage_medium_dark_skin_tone", "🧙🏾\u200d♀️",
+	"woman_mage_medium_light_skin_tone", "🧙🏼\u200d♀️",
+	"woman_mage_medium_skin_tone", "🧙🏽\u200d♀️",
+	"woman_mage_tone1", "🧙🏻\u200d♀️",
+	"woman_mage_tone2", "🧙🏼\u200d♀️",
+	"woman_mage_tone3", "🧙🏽\u200d♀️",
+	"woman_mage_tone4", "🧙🏾\u200d♀️",
+	"woman_mage_tone5", "🧙🏿\u200d♀️",
+	"woman_mechanic", "👩\u200d🔧",
+	"woman_mechanic_dark_skin_tone", "👩🏿\u200d🔧",
+	"woman_mechanic_light_skin_tone", "👩🏻\u200d🔧",
+	"woman_mechanic_medium_dark_skin_tone", "👩🏾\u200d🔧",
+	"woman_mechanic_medium_light_skin_tone", "👩🏼\u200d🔧",
+	"woman_mechanic_medium_skin_tone", "👩🏽\u200d🔧",
+	"woman_mechanic_tone1", "👩🏻\u200d🔧",
+	"woman_mechanic_tone2", "👩🏼\u200d🔧",
+	"woman_mechanic_tone3", "👩🏽\u200d🔧",
+	"woman_mechanic_tone4", "👩🏾\u200d🔧",
+	"woman_mechanic_tone5", "👩🏿\u200d🔧",
+	"woman_medium_dark_skin_tone_beard", "🧔🏾\u200d♀️",
+	"woman_medium_light_skin_tone_beard", "🧔🏼\u200d♀️",
+	"woman_medium_skin_tone_beard", "🧔🏽\u200d♀️",
+	"woman_mountain_biking", "🚵\u200d♀️",
+	"woman_mountain_biking_dark_skin_tone", "🚵🏿\u200d♀️",
+	"woman_mountain_biking_light_skin_tone", "🚵🏻\u200d♀️",
+	"woman_mountain_biking_medium_dark_skin_tone", "🚵🏾\u200d♀️",
+	"woman_mountain_biking_medium_light_skin_tone", "🚵🏼\u200d♀️",
+	"woman_mountain_biking_medium_skin_tone", "🚵🏽\u200d♀️",
+	"woman_mountain_biking_tone1", "🚵🏻\u200d♀️",
+	"woman_mountain_biking_tone2", "🚵🏼\u200d♀️",
+	"woman_mountain_biking_tone3", "🚵🏽\u200d♀️",
+	"woman_mountain_biking_tone4", "🚵🏾\u200d♀️",
+	"woman_mountain_biking_tone5", "🚵🏿\u200d♀️",
+	"woman_office_worker", "👩\u200d💼",
+	"woman_office_worker_dark_skin_tone", "👩🏿\u200d💼",
+	"woman_office_worker_light_skin_tone", "👩🏻\u200d💼",
+	"woman_office_worker_medium_dark_skin_tone", "👩🏾\u200d💼",
+	"woman_office_worker_medium_light_skin_tone", "👩🏼\u200d💼",
+	"woman_office_worker_medium_skin_tone", "👩🏽\u200d💼",
+	"woman_office_worker_tone1", "👩🏻\u200d💼",
+	"woman_office_worker_tone2", "👩🏼\u200d💼",
+	"woman_office_worker_tone3", "👩🏽\u200d💼",
+	"woman_office_worker_tone4", "👩🏾\u200d💼",
+	"woman_office_worker_tone5", "👩🏿\u200d💼",
+	"woman_pilot", "👩\u200d✈️",
+	"woman_pilot_dark_skin_tone", "👩🏿\u200d✈️",
+	"woman_pilot_light_skin_tone", "👩🏻\u200d✈️",
+	"woman_pilot_medium_dark_skin_tone", "👩🏾\u200d✈️",
+	"woman_pilot_medium_light_skin_tone", "👩🏼\u200d✈️",
+	"woman_pilot_medium_skin_tone", "👩🏽\u200d✈️",
+	"woman_pilot_tone1", "👩🏻\u200d✈️",
+	"woman_pilot_tone2", "👩🏼\u200d✈️",
+	"woman_pilot_tone3", "👩🏽\u200d✈️",
+	"woman_pilot_tone4", "👩🏾\u200d✈️",
+	"woman_pilot_tone5", "👩🏿\u200d✈️",
+	"woman_playing_handball", "🤾\u200d♀️",
+	"woman_playing_handball_dark_skin_tone", "🤾🏿\u200d♀️",
+	"woman_playing_handball_light_skin_tone", "🤾🏻\u200d♀️",
+	"woman_playing_handball_medium_dark_skin_tone", "🤾🏾\u200d♀️",
+	"woman_playing_handball_medium_light_skin_tone", "🤾🏼\u200d♀️",
+	"woman_playing_handball_medium_skin_tone", "🤾🏽\u200d♀️",
+	"woman_playing_handball_tone1", "🤾🏻\u200d♀️",
+	"woman_playing_handball_tone2", "🤾🏼\u200d♀️",
+	"woman_playing_handball_tone3", "🤾🏽\u200d♀️",
+	"woman_playing_handball_tone4", "🤾🏾\u200d♀️",
+	"woman_playing_handball_tone5", "🤾🏿\u200d♀️",
+	"woman_playing_water_polo", "🤽\u200d♀️",
+	"woman_playing_water_polo_dark_skin_tone", "🤽🏿\u200d♀️",
+	"woman_playing_water_polo_light_skin_tone", "🤽🏻\u200d♀️",
+	"woman_playing_water_polo_medium_dark_skin_tone", "🤽🏾\u200d♀️",
+	"woman_playing_water_polo_medium_light_skin_tone", "🤽🏼\u200d♀️",
+	"woman_playing_water_polo_medium_skin_tone", "🤽🏽\u200d♀️",
+	"woman_playing_water_polo_tone1", "🤽🏻\u200d♀️",
+	"woman_playing_water_polo_tone2", "🤽🏼\u200d♀️",
+	"woman_playing_water_polo_tone3", "🤽🏽\u200d♀️",
+	"woman_playing_water_polo_tone4", "🤽🏾\u200d♀️",
+	"woman_playing_water_polo_tone5", "🤽🏿\u200d♀️",
+	"woman_police_officer", "👮\u200d♀️",
+	"woman_police_officer_dark_skin_tone", "👮🏿\u200d♀️",
+	"woman_police_officer_light_skin_tone", "👮🏻\u200d♀️",
+	"woman_police_officer_medium_dark_skin_tone", "👮🏾\u200d♀️",
+	"woman_police_officer_medium_light_skin_tone", "👮🏼\u200d♀️",
+	"woman_police_officer_medium_skin_tone", "👮🏽\u200d♀️",
+	"woman_police_officer_tone1", "👮🏻\u200d♀️",
+	"woman_police_officer_tone2", "👮🏼\u200d♀️",
+	"woman_police_officer_tone3", "👮🏽\u200d♀️",
+	"woman_police_officer_tone4", "👮🏾\u200d♀️",
+	"woman_police_officer_tone5", "👮🏿\u200d♀️",
+	"woman_pouting", "🙎\u200d♀️",
+	"woman_pouting_dark_skin_tone", "🙎🏿\u200d♀️",
+	"woman_pouting_light_skin_tone", "🙎🏻\u200d♀️",
+	"woman_pouting_medium_dark_skin_tone", "🙎🏾\u200d♀️",
+	"woman_pouting_medium_light_skin_tone", "🙎🏼\u200d♀️",
+	"woman_pouting_medium_skin_tone", "🙎🏽\u200d♀️",
+	"woman_pouting_tone1", "🙎🏻\u200d♀️",
+	"woman_pouting_tone2", "🙎🏼\u200d♀️",
+	"woman_pouting_tone3", "🙎🏽\u200d♀️",
+	"woman_pouting_tone4", "🙎🏾\u200d♀️",
+	"woman_pouting_tone5", "🙎🏿\u200d♀️",
+	"woman_raising_hand", "🙋\u200d♀️",
+	"woman_raising_hand_dark_skin_tone", "🙋🏿\u200d♀️",
+	"woman_raising_hand_light_skin_tone", "🙋🏻\u200d♀️",
+	"woman_raising_hand_medium_dark_skin_tone", "🙋🏾\u200d♀️",
+	"woman_raising_hand_medium_light_skin_tone", "🙋🏼\u200d♀️",
+	"woman_raising_hand_medium_skin_tone", "🙋🏽\u200d♀️",
+	"woman_raising_hand_tone1", "🙋🏻\u200d♀️",
+	"woman_raising_hand_tone2", "🙋🏼\u200d♀️",
+	"woman_raising_hand_tone3", "🙋🏽\u200d♀️",
+	"woman_raising_hand_tone4", "🙋🏾\u200d♀️",
+	"woman_raising_hand_tone5", "🙋🏿\u200d♀️",
+	"woman_red_haired", "👩\u200d🦰",
+	"woman_red_haired_dark_skin_tone", "👩🏿\u200d🦰",
+	"woman_red_haired_light_skin_tone", "👩🏻\u200d🦰",
+	"woman_red_haired_medium_dark_skin_tone", "👩🏾\u200d🦰",
+	"woman_red_haired_medium_light_skin_tone", "👩🏼\u200d🦰",
+	"woman_red_haired_medium_skin_tone", "👩🏽\u200d🦰",
+	"woman_red_haired_tone1", "👩🏻\u200d🦰",
+	"woman_red_haired_tone2", "👩🏼\u200d🦰",
+	"woman_red_haired_tone3", "👩🏽\u200d🦰",
+	"woman_red_haired_tone4", "👩🏾\u200d🦰",
+	"woman_red_haired_tone5", "👩🏿\u200d🦰",
+	"woman_rowing_boat", "🚣\u200d♀️",
+	"woman_rowing_boat_dark_skin_tone", "🚣🏿\u200d♀️",
+	"woman_rowing_boat_light_skin_tone", "🚣🏻\u200d♀️",
+	"woman_rowing_boat_medium_dark_skin_tone", "🚣🏾\u200d♀️",
+	"woman_rowing_boat_medium_light_skin_tone", "🚣🏼\u200d♀️",
+	"woman_rowing_boat_medium_skin_tone", "🚣🏽\u200d♀️",
+	"woman_rowing_boat_tone1", "🚣🏻\u200d♀️",
+	"woman_rowing_boat_tone2", "🚣🏼\u200d♀️",
+	"woman_rowing_boat_tone3", "🚣🏽\u200d♀️",
+	"woman_rowing_boat_tone4", "🚣🏾\u200d♀️",
+	"woman_rowing_boat_tone5", "🚣🏿\u200d♀️",
+	"woman_running", "🏃\u200d♀️",
+	"woman_running_dark_skin_tone", "🏃🏿\u200d♀️",
+	"woman_running_light_skin_tone", "🏃🏻\u200d♀️",
+	"woman_running_medium_dark_skin_tone", "🏃🏾\u200d♀️",
+	"woman_running_medium_light_skin_tone", "🏃🏼\u200d♀️",
+	"woman_running_medium_skin_tone", "🏃🏽\u200d♀️",
+	"woman_running_tone1", "🏃🏻\u200d♀️",
+	"woman_running_tone2", "🏃🏼\u200d♀️",
+	"woman_running_tone3", "🏃🏽\u200d♀️",
+	"woman_running_tone4", "🏃🏾\u200d♀️",
+	"woman_running_tone5", "🏃🏿\u200d♀️",
+	"woman_scientist", "👩\u200d🔬",
+	"woman_scientist_dark_skin_tone", "👩🏿\u200d🔬",
+	"woman_scientist_light_skin_tone", "👩🏻\u200d🔬",
+	"woman_scientist_medium_dark_skin_tone", "👩🏾\u200d🔬",
+	"woman_scientist_medium_light_skin_tone", "👩🏼\u200d🔬",
+	"woman_scientist_medium_skin_tone", "👩🏽\u200d🔬",
+	"woman_scientist_tone1", "👩🏻\u200d🔬",
+	"woman_scientist_tone2", "👩🏼\u200d🔬",
+	"woman_scientist_tone3", "👩🏽\u200d🔬",
+	"woman_scientist_tone4", "👩🏾\u200d🔬",
+	"woman_scientist_tone5", "👩🏿\u200d🔬",
+	"woman_shrugging", "🤷\u200d♀️",
+	"woman_shrugging_dark_skin_tone", "🤷🏿\u200d♀️",
+	"woman_shrugging_light_skin_tone", "🤷🏻\u200d♀️",
+	"woman_shrugging_medium_dark_skin_tone", "🤷🏾\u200d♀️",
+	"woman_shrugging_medium_light_skin_tone", "🤷🏼\u200d♀️",
+	"woman_shrugging_medium_skin_tone", "🤷🏽\u200d♀️",
+	"woman_shrugging_tone1", "🤷🏻\u200d♀️",
+	"woman_shrugging_tone2", "🤷🏼\u200d♀️",
+	"woman_shrugging_tone3", "🤷🏽\u200d♀️",
+	"woman_shrugging_tone4", "🤷🏾\u200d♀️",
+	"woman_shrugging_tone5", "🤷🏿\u200d♀️",
+	"woman_singer", "👩\u200d🎤",
+	"woman_singer_dark_skin_tone", "👩🏿\u200d🎤",
+	"woman_singer_light_skin_tone", "👩🏻\u200d🎤",
+	"woman_singer_medium_dark_skin_tone", "👩🏾\u200d🎤",
+	"woman_singer_medium_light_skin_tone", "👩🏼\u200d🎤",
+	"woman_singer_medium_skin_tone", "👩🏽\u200d🎤",
+	"woman_singer_tone1", "👩🏻\u200d🎤",
+	"woman_singer_tone2", "👩🏼\u200d🎤",
+	"woman_singer_tone3", "👩🏽\u200d🎤",
+	"woman_singer_tone4", "👩🏾\u200d🎤",
+	"woman_singer_tone5", "👩🏿\u200d🎤",
+	"woman_standing", "🧍\u200d♀️",
+	"woman_standing_dark_skin_tone", "🧍🏿\u200d♀️",
+	"woman_standing_light_skin_tone", "🧍🏻\u200d♀️",
+	"woman_standing_medium_dark_skin_tone", "🧍🏾\u200d♀️",
+	"woman_standing_medium_light_skin_tone", "🧍🏼\u200d♀️",
+	"woman_standing_medium_skin_tone", "🧍🏽\u200d♀️",
+	"woman_standing_tone1", "🧍🏻\u200d♀️",
+	"woman_standing_tone2", "🧍🏼\u200d♀️",
+	"woman_standing_tone3", "🧍🏽\u200d♀️",
+	"woman_standing_tone4", "🧍🏾\u200d♀️",
+	"woman_standing_tone5", "🧍🏿\u200d♀️",
+	"woman_student", "👩\u200d🎓",
+	"woman_student_dark_skin_tone", "👩🏿\u200d🎓",
+	"woman_student_light_skin_tone", "👩🏻\u200d🎓",
+	"woman_student_medium_dark_skin_tone", "👩🏾\u200d🎓",
+	"woman_student_medium_light_skin_tone", "👩🏼\u200d🎓",
+	"woman_student_medium_skin_tone", "👩🏽\u200d🎓",
+	"woman_student_tone1", "👩🏻\u200d🎓",
+	"woman_student_tone2", "👩🏼\u200d🎓",
+	"woman_student_tone3", "👩🏽\u200d🎓",
+	"woman_student_tone4", "👩🏾\u200d🎓",
+	"woman_student_tone5", "👩🏿\u200d🎓",
+	"woman_superhero", "🦸\u200d♀️",
+	"woman_superhero_dark_skin_tone", "🦸🏿\u200d♀️",
+	"woman_superhero_light_skin_tone", "🦸🏻\u200d♀️",
+	"woman_superhero_medium_dark_skin_tone", "🦸🏾\u200d♀️",
+	"woman_superhero_medium_light_skin_tone", "🦸🏼\u200d♀️",
+	"woman_superhero_medium_skin_tone", "🦸🏽\u200d♀️",
+	"woman_superhero_tone1", "🦸🏻\u200d♀️",
+	"woman_superhero_tone2", "🦸🏼\u200d♀️",
+	"woman_superhero_tone3", "🦸🏽\u200d♀️",
+	"woman_superhero_tone4", "🦸🏾\u200d♀️",
+	"woman_superhero_tone5", "🦸🏿\u200d♀️",
+	"woman_supervillain", "🦹\u200d♀️",
+	"woman_supervillain_dark_skin_tone", "🦹🏿\u200d♀️",
+	"woman_supervillain_light_skin_tone", "🦹🏻\u200d♀️",
+	"woman_supervillain_medium_dark_skin_tone", "🦹🏾\u200d♀️",
+	"woman_supervillain_medium_light_skin_tone", "🦹🏼\u200d♀️",
+	"woman_supervillain_medium_skin_tone", "🦹🏽\u200d♀️",
+	"woman_supervillain_tone1", "🦹🏻\u200d♀️",
+	"woman_supervillain_tone2", "🦹🏼\u200d♀️",
+	"woman_supervillain_tone3", "🦹🏽\u200d♀️",
+	"woman_supervillain_tone4", "🦹🏾\u200d♀️",
+	"woman_supervillain_tone5", "🦹🏿\u200d♀️",
+	"woman_surfing", "🏄\u200d♀️",
+	"woman_surfing_dark_skin_tone", "🏄🏿\u200d♀️",
+	"woman_surfing_light_skin_tone", "🏄🏻\u200d♀️",
+	"woman_surfing_medium_dark_skin_tone", "🏄🏾\u200d♀️",
+	"woman_surfing_medium_light_skin_tone", "🏄🏼\u200d♀️",
+	"woman_surfing_medium_skin_tone", "🏄🏽\u200d♀️",
+	"woman_surfing_tone1", "🏄🏻\u200d♀️",
+	"woman_surfing_tone2", "🏄🏼\u200d♀️",
+	"woman_surfing_tone3", "🏄🏽\u200d♀️",
+	"woman_surfing_tone4", "🏄🏾\u200d♀️",
+	"woman_surfing_tone5", "🏄🏿\u200d♀️",
+	"woman_swimming", "🏊\u200d♀️",
+	"woman_swimming_dark_skin_tone", "🏊🏿\u200d♀️",
+	"woman_swimming_light_skin_tone", "🏊🏻\u200d♀️",
+	"woman_swimming_medium_dark_skin_tone", "🏊🏾\u200d♀️",
+	"woman_swimming_medium_light_skin_tone", "🏊🏼\u200d♀️",
+	"woman_swimming_medium_skin_tone", "🏊🏽\u200d♀️",
+	"woman_swimming_tone1", "🏊🏻\u200d♀️",
+	"woman_swimming_tone2", "🏊🏼\u200d♀️",
+	"woman_swimming_tone3", "🏊🏽\u200d♀️",
+	"woman_swimming_tone4", "🏊🏾\u200d♀️",
+	"woman_swimming_tone5", "🏊🏿\u200d♀️",
+	"woman_teacher", "👩\u200d🏫",
+	"woman_teacher_dark_skin_tone", "👩🏿\u200d🏫",
+	"woman_teacher_light_skin_tone", "👩🏻\u200d🏫",
+	"woman_teacher_medium_dark_skin_tone", "👩🏾\u200d🏫",
+	"woman_teacher_medium_light_skin_tone", "👩🏼\u200d🏫",
+	"woman_teacher_medium_skin_tone", "👩🏽\u200d🏫",
+	"woman_teacher_tone1", "👩🏻\u200d🏫",
+	"woman_teacher_tone2", "👩🏼\u200d🏫",
+	"woman_teacher_tone3", "👩🏽\u200d🏫",
+	"woman_teacher_tone4", "👩🏾\u200d🏫",
+	"woman_teacher_tone5", "👩🏿\u200d🏫",
+	"woman_technologist", "👩\u200d💻",
+	"woman_technologist_dark_skin_tone", "👩🏿\u200d💻",
+	"woman_technologist_light_skin_tone", "👩🏻\u200d💻",
+	"woman_technologist_medium_dark_skin_tone", "👩🏾\u200d💻",
+	"woman_technologist_medium_light_skin_tone", "👩🏼\u200d💻",
+	"woman_technologist_medium_skin_tone", "👩🏽\u200d💻",
+	"woman_technologist_tone1", "👩🏻\u200d💻",
+	"woman_technologist_tone2", "👩🏼\u200d💻",
+	"woman_technologist_tone3", "👩🏽\u200d💻",
+	"woman_technologist_tone4", "👩🏾\u200d💻",
+	"woman_technologist_tone5", "👩🏿\u200d💻",
+	"woman_tipping_hand", "💁\u200d♀️",
+	"woman_tipping_hand_dark_skin_tone", "💁🏿\u200d♀️",
+	"woman_tipping_hand_light_skin_tone", "💁🏻\u200d♀️",
+	"woman_tipping_hand_medium_dark_skin_tone", "💁🏾\u200d♀️",
+	"woman_tipping_hand_medium_light_skin_tone", "💁🏼\u200d♀️",
+	"woman_tipping_hand_medium_skin_tone", "💁🏽\u200d♀️",
+	"woman_tipping_hand_tone1", "💁🏻\u200d♀️",
+	"woman_tipping_hand_tone2", "💁🏼\u200d♀️",
+	"woman_tipping_hand_tone3", "💁🏽\u200d♀️",
+	"woman_tipping_hand_tone4", "💁🏾\u200d♀️",
+	"woman_tipping_hand_tone5", "💁🏿\u200d♀️",
+	"woman_tone1", "👩🏻",
+	"woman_tone1_beard", "🧔🏻\u200d♀️",
+	"woman_tone2", "👩🏼",
+	"woman_tone2_beard", "🧔🏼\u200d♀️",
+	"woman_tone3", "👩🏽",
+	"woman_tone3_beard", "🧔🏽\u200d♀️",
+	"woman_tone4", "👩🏾",
+	"woman_tone4_beard", "🧔🏾\u200d♀️",
+	"woman_tone5", "👩🏿",
+	"woman_tone5_beard", "🧔🏿\u200d♀️",
+	"woman_vampire", "🧛\u200d♀️",
+	"woman_vampire_dark_skin_tone", "🧛🏿\u200d♀️",
+	"woman_vampire_light_skin_tone", "🧛🏻\u200d♀️",
+	"woman_vampire_medium_dark_skin_tone", "🧛🏾\u200d♀️",
+	"woman_vampire_medium_light_skin_tone", "🧛🏼\u200d♀️",
+	"woman_vampire_medium_skin_tone", "🧛🏽\u200d♀️",
+	"woman_vampire_tone1", "🧛🏻\u200d♀️",
+	"woman_vampire_tone2", "🧛🏼\u200d♀️",
+	"woman_vampire_tone3", "🧛🏽\u200d♀️",
+	"woman_vampire_tone4", "🧛🏾\u200d♀️",
+	"woman_vampire_tone5", "🧛🏿\u200d♀️",
+	"woman_walking", "🚶\u200d♀️",
+	"woman_walking_dark_skin_tone", "🚶🏿\u200d♀️",
+	"woman_walking_light_skin_tone", "🚶🏻\u200d♀️",
+	"woman_walking_medium_dark_skin_tone", "🚶🏾\u200d♀️",
+	"woman_walking_medium_light_skin_tone", "🚶🏼\u200d♀️",
+	"woman_walking_medium_skin_tone", "🚶🏽\u200d♀️",
+	"woman_walking_tone1", "🚶🏻\u200d♀️",
+	"woman_walking_tone2", "🚶🏼\u200d♀️",
+	"woman_walking_tone3", "🚶🏽\u200d♀️",
+	"woman_walking_tone4", "🚶🏾\u200d♀️",
+	"woman_walking_tone5", "🚶🏿\u200d♀️",
+	"woman_wearing_turban", "👳\u200d♀️",
+	"woman_wearing_turban_dark_skin_tone", "👳🏿\u200d♀️",
+	"woman_wearing_turban_light_skin_tone", "👳🏻\u200d♀️",
+	"woman_wearing_turban_medium_dark_skin_tone", "👳🏾\u200d♀️",
+	"woman_wearing_turban_medium_light_skin_tone", "👳🏼\u200d♀️",
+	"woman_wearing_turban_medium_skin_tone", "👳🏽\u200d♀️",
+	"woman_wearing_turban_tone1", "👳🏻\u200d♀️",
+	"woman_wearing_turban_tone2", "👳🏼\u200d♀️",
+	"woman_wearing_turban_tone3", "👳🏽\u200d♀️",
+	"woman_wearing_turban_tone4", "👳🏾\u200d♀️",
+	"woman_wearing_turban_tone5", "👳🏿\u200d♀️",
+	"woman_white_haired", "👩\u200d🦳",
+	"woman_white_haired_dark_skin_tone", "👩🏿\u200d🦳",
+	"woman_white_haired_light_skin_tone", "👩🏻\u200d🦳",
+	"woman_white_haired_medium_dark_skin_tone", "👩🏾\u200d🦳",
+	"woman_white_haired_medium_light_skin_tone", "👩🏼\u200d🦳",
+	"woman_white_haired_medium_skin_tone", "👩🏽\u200d🦳",
+	"woman_white_haired_tone1", "👩🏻\u200d🦳",
+	"woman_white_haired_tone2", "👩🏼\u200d🦳",
+	"woman_white_haired_tone3", "👩🏽\u200d🦳",
+	"woman_white_haired_tone4", "👩🏾\u200d🦳",
+	"woman_white_haired_tone5", "👩🏿\u200d🦳",
+	"woman_with_headscarf", "🧕",
+	"woman_with_headscarf_dark_skin_tone", "🧕🏿",
+	"woman_with_headscarf_light_skin_tone", "🧕🏻",
+	"woman_with_headscarf_medium_dark_skin_tone", "🧕🏾",
+	"woman_with_headscarf_medium_light_skin_tone", "🧕🏼",
+	"woman_with_headscarf_medium_skin_tone", "🧕🏽",
+	"woman_with_headscarf_tone1", "🧕🏻",
+	"woman_with_headscarf_tone2", "🧕🏼",
+	"woman_with_headscarf_tone3", "🧕🏽",
+	"woman_with_headscarf_tone4", "🧕🏾",
+	"woman_with_headscarf_tone5", "🧕🏿",
+	"woman_with_probing_cane", "👩\u200d🦯",
+	"woman_with_probing_cane_dark_skin_tone", "👩🏿\u200d🦯",
+	"woman_with_probing_cane_light_skin_tone", "👩🏻\u200d🦯",
+	"woman_with_probing_cane_medium_dark_skin_tone", "👩🏾\u200d🦯",
+	"woman_with_probing_cane_medium_light_skin_tone", "👩🏼\u200d🦯",
+	"woman_with_probing_cane_medium_skin_tone", "👩🏽\u200d🦯",
+	"woman_with_probing_cane_tone1", "👩🏻\u200d🦯",
+	"woman_with_probing_cane_tone2", "👩🏼\u200d🦯",
+	"woman_with_probing_cane_tone3", "👩🏽\u200d🦯",
+	"woman_with_probing_cane_tone4", "👩🏾\u200d🦯",
+	"woman_with_probing_cane_tone5", "👩🏿\u200d🦯",
+	"woman_with_veil", "👰\u200d♀️",
+	"woman_with_veil_dark_skin_tone", "👰🏿\u200d♀️",
+	"woman_with_veil_light_skin_tone", "👰🏻\u200d♀️",
+	"woman_with_veil_medium_dark_skin_tone", "👰🏾\u200d♀️",
+	"woman_with_veil_medium_light_skin_tone", "👰🏼\u200d♀️",
+	"woman_with_veil_medium_skin_tone", "👰🏽\u200d♀️",
+	"woman_with_veil_tone1", "👰🏻\u200d♀️",
+	"woman_with_veil_tone2", "👰🏼\u200d♀️",
+	"woman_with_veil_tone3", "👰🏽\u200d♀️",
+	"woman_with_veil_tone4", "👰🏾\u200d♀️",
+	"woman_with_veil_tone5", "👰🏿\u200d♀️",
+	"woman_zombie", "🧟\u200d♀️",
+	"womans_clothes", "👚",
+	"womans_flat_shoe", "🥿",
+	"womans_hat", "👒",
+	"women_holding_hands_dark_skin_tone", "👭🏿",
+	"women_holding_hands_dark_skin_tone_light_skin_tone", "👩🏿\u200d🤝\u200d👩🏻",
+	"women_holding_hands_dark_skin_tone_medium_dark_skin_tone", "👩🏿\u200d🤝\u200d👩🏾",
+	"women_holding_hands_dark_skin_tone_medium_light_skin_tone", "👩🏿\u200d🤝\u200d👩🏼",
+	"women_holding_hands_dark_skin_tone_medium_skin_tone", "👩🏿\u200d🤝\u200d👩🏽",
+	"women_holding_hands_light_skin_tone", "👭🏻",
+	"women_holding_hands_light_skin_tone_dark_skin_tone", "👩🏻\u200d🤝\u200d👩🏿",
+	"women_holding_hands_light_skin_tone_medium_dark_skin_tone", "👩🏻\u200d🤝\u200d👩🏾",
+	"women_holding_hands_light_skin_tone_medium_light_skin_tone", "👩🏻\u200d🤝\u200d👩🏼",
+	"women_holding_hands_light_skin_tone_medium_skin_tone", "👩🏻\u200d🤝\u200d👩🏽",
+	"women_holding_hands_medium_dark_skin_tone", "👭🏾",
+	"women_holding_hands_medium_dark_skin_tone_dark_skin_tone", "👩🏾\u200d🤝\u200d👩🏿",
+	"women_holding_hands_medium_dark_skin_tone_light_skin_tone", "👩🏾\u200d🤝\u200d👩🏻",
+	"women_holding_hands_medium_dark_skin_tone_medium_light_skin_tone", "👩🏾\u200d🤝\u200d👩🏼",
+	"women_holding_hands_medium_dark_skin_tone_medium_skin_tone", "👩🏾\u200d🤝\u200d👩🏽",
+	"women_holding_hands_medium_light_skin_tone", "👭🏼",
+	"women_holding_hands_medium_light_skin_tone_dark_skin_tone", "👩🏼\u200d🤝\u200d👩🏿",
+	"women_holding_hands_medium_light_skin_tone_light_skin_tone", "👩🏼\u200d🤝\u200d👩🏻",
+	"women_holding_hands_medium_light_skin_tone_medium_dark_skin_tone", "👩🏼\u200d🤝\u200d👩🏾",
+	"women_holding_hands_medium_light_skin_tone_medium_skin_tone", "👩🏼\u200d🤝\u200d👩🏽",
+	"women_holding_hands_medium_skin_tone", "👭🏽",
+	"women_holding_hands_medium_skin_tone_dark_skin_tone", "👩🏽\u200d🤝\u200d👩🏿",
+	"women_holding_hands_medium_skin_tone_light_skin_tone", "👩🏽\u200d🤝\u200d👩🏻",
+	"women_holding_hands_medium_skin_tone_medium_dark_skin_tone", "👩🏽\u200d🤝\u200d👩🏾",
+	"women_holding_hands_medium_skin_tone_medium_light_skin_tone", "👩🏽\u200d🤝\u200d👩🏼",
+	"women_holding_hands_tone1", "👭🏻",
+	"women_holding_hands_tone1_tone2", "👩🏻\u200d🤝\u200d👩🏼",
+	"women_holding_hands_tone1_tone3", "👩🏻\u200d🤝\u200d👩🏽",
+	"women_holding_hands_tone1_tone4", "👩🏻\u200d🤝\u200d👩🏾",
+	"women_holding_hands_tone1_tone5", "👩🏻\u200d🤝\u200d👩🏿",
+	"women_holding_hands_tone2", "👭🏼",
+	"women_holding_hands_tone2_tone1", "👩🏼\u200d🤝\u200d👩🏻",
+	"women_holding_hands_tone2_tone3", "👩🏼\u200d🤝\u200d👩🏽",
+	"women_holding_hands_tone2_tone4", "👩🏼\u200d🤝\u200d👩🏾",
+	"women_holding_hands_tone2_tone5", "👩🏼\u200d🤝\u200d👩🏿",
+	"women_holding_hands_tone3", "👭🏽",
+	"women_holding_hands_tone3_tone1", "👩🏽\u200d🤝\u200d👩🏻",
+	"women_holding_hands_tone3_tone2", "👩🏽\u200d🤝\u200d👩🏼",
+	"women_holding_hands_tone3_tone4", "👩🏽\u200d🤝\u200d👩🏾",
+	"women_holding_hands_tone3_tone5", "👩🏽\u200d🤝\u200d👩🏿",
+	"women_holding_hands_tone4", "👭🏾",
+	"women_holding_hands_tone4_tone1", "👩🏾\u200d🤝\u200d👩🏻",
+	"women_holding_hands_tone4_tone2", "👩🏾\u200d🤝\u200d👩🏼",
+	"women_holding_hands_tone4_tone3", "👩🏾\u200d🤝\u200d👩🏽",
+	"women_holding_hands_tone4_tone5", "👩🏾\u200d🤝\u200d👩🏿",
+	"women_holding_hands_tone5", "👭🏿",
+	"women_holding_hands_tone5_tone1", "👩🏿\u200d🤝\u200d👩🏻",
+	"women_holding_hands_tone5_tone2", "👩🏿\u200d🤝\u200d👩🏼",
+	"women_holding_hands_tone5_tone3", "👩🏿\u200d🤝\u200d👩🏽",
+	"women_holding_hands_tone5_tone4", "👩🏿\u200d🤝\u200d👩🏾",
+	"women_with_bunny_ears_partying", "👯\u200d♀️",
+	"women_wrestling", "🤼\u200d♀️",
+	"womens", "🚺",
+	"wood", "🪵",
+	"woozy_face", "🥴",
+	"world_map", "🗺️",
+	"worm", "🪱",
+	"worried", "😟",
+	"worship_symbol", "🛐",
+	"wrench", "🔧",
+	"wrestlers", "🤼",
+	"wrestling", "🤼",
+	"writing_hand", "✍️",
+	"writing_hand_tone1", "✍🏻",
+	"writing_hand_tone2", "✍🏼",
+	"writing_hand_tone3", "✍🏽",
+	"writing_hand_tone4", "✍🏾",
+	"writing_hand_tone5", "✍🏿",
+	"x", "❌",
+	"x_ray", "🩻",
+	"yarn", "🧶",
+	"yawning_face", "🥱",
+	"yellow_circle", "🟡",
+	"yellow_heart", "💛",
+	"yellow_square", "🟨",
+	"yen", "💴",
+	"yin_yang", "☯️",
+	"yo_yo", "🪀",
+	"yum", "😋",
+	"zany_face", "🤪",
+	"zap", "⚡",
+	"zebra", "🦓",
+	"zero", "0️⃣",
+	"zipper_mouth", "🤐",
+	"zipper_mouth_face", "🤐",
+	"zombie", "🧟",
+	"zzz", "💤",
+	"©️", "copyright",
+	"®️", "registered",
+	"‼️", "bangbang",
+	"⁉️", "interrobang",
+	"™️", "tm",
+	"ℹ️", "information_source",
+	"↔️", "left_right_arrow",
+	"↕️", "arrow_up_down",
+	"↖️", "arrow_upper_left",
+	"↗️", "arrow_upper_right",
+	"↘️", "arrow_lower_right",
+	"↙️", "arrow_lower_left",
+	"↩️", "leftwards_arrow_with_hook",
+	"↪️", "arrow_right_hook",
+	"⌚", "watch",
+	"⌛", "hourglass",
+	"⌨️", "keyboard",
+	"⏏️", "eject_symbol",
+	"⏩", "fast_forward",
+	"⏪", "rewind",
+	"⏫", "arrow_double_up",
+	"⏬", "arrow_double_down",
+	"⏭️", "next_track",
+	"⏮️", "previous_track",
+	"⏯️", "play_pause",
+	"⏰", "alarm_clock",
+	"⏱️", "stopwatch",
+	"⏲️", "timer_clock",
+	"⏳", "hourglass_flowing_sand",
+	"⏸️", "double_vertical_bar",
+	"⏹️", "stop_button",
+	"⏺️", "record_button",
+	"Ⓜ️", "m",
+	"▪️", "black_small_square",
+	"▫️", "white_small_square",
+	"▶️", "arrow_forward",
+	"◀️", "arrow_backward",
+	"◻️", "white_medium_square",
+	"◼️", "black_medium_square",
+	"◽", "white_medium_small_square",
+	"◾", "black_medium_small_square",
+	"☀️", "sunny",
+	"☁️", "cloud",
+	"☂️", "umbrella2",
+	"☃️", "snowman2",
+	"☄️", "comet",
+	"☎️", "telephone",
+	"☑️", "ballot_box_with_check",
+	"☔", "umbrella",
+	"☕", "coffee",
+	"☘️", "shamrock",
+	"☝️", "point_up",
+	"☝🏻", "point_up_tone1",
+	"☝🏼", "point_up_tone2",
+	"☝🏽", "point_up_tone3",
+	"☝🏾", "point_up_tone4",
+	"☝🏿", "point_up_tone5",
+	"☠️", "skull_and_crossbones",
+	"☢️", "radioactive_sign",
+	"☣️", "biohazard_sign",
+	"☦️", "orthodox_cross",
+	"☪️", "star_and_crescent",
+	"☮️", "peace_symbol",
+	"☯️", "yin_yang",
+	"☸️", "wheel_of_dharma",
+	"☹️", "white_frowning_face",
+	"☺️", "relaxed",
+	"♀️", "female_sign",
+	"♂️", "male_sign",
+	"♈", "aries",
+	"♉", "taurus",
+	"♊", "gemini",
+	"♋", "cancer",
+	"♌", "leo",
+	"♍", "virgo",
+	"♎", "libra",
+	"♏", "scorpius",
+	"♐", "sagittarius",
+	"♑", "capricorn",
+	"♒", "aquarius",
+	"♓", "pisces",
+	"♟️", "chess_pawn",
+	"♠️", "spades",
+	"♣️", "clubs",
+	"♥️", "hearts",
+	"♦️", "diamonds",
+	"♨️", "hotsprings",
+	"♻️", "recycle",
+	"♾️", "infinity",
+	"♿", "wheelchair",
+	"⚒️", "hammer_and_pick",
+	"⚓", "anchor",
+	"⚔️", "crossed_swords",
+	"⚕️", "medical_symbol",
+	"⚖️", "scales",
+	"⚗️", "alembic",
+	"⚙️", "gear",
+	"⚛️", "atom_symbol",
+	"⚜️", "fleur_de_lis",
+	"⚠️", "warning",
+	"⚡", "zap",
+	"⚧", "transgender_symbol",
+	"⚪", "white_circle",
+	"⚫", "black_circle",
+	"⚰️", "coffin",
+	"⚱️", "funeral_urn",
+	"⚽", "soccer",
+	"⚾", "baseball",
+	"⛄", "snowman",
+	"⛅", "partly_sunny",
+	"⛈️", "thunder_cloud_and_rain",
+	"⛎", "ophiuchus",
+	"⛏️", "pick",
+	"⛑️", "helmet_with_white_cross",
+	"⛓️", "chains",
+	"⛔", "no_entry",
+	"⛩️", "shinto_shrine",
+	"⛪", "church",
+	"⛰️", "mountain",
+	"⛱️", "umbrella_on_ground",
+	"⛲", "fountain",
+	"⛳", "golf",
+	"⛴️", "ferry",
+	"⛵", "sailboat",
+	"⛷️", "skier",
+	"⛸️", "ice_skate",
+	"⛹️", "person_with_ball",
+	"⛹️\u200d♀️", "woman_bouncing_ball",
+	"⛹️\u200d♂️", "man_bouncing_ball",
+	"⛹🏻", "person_with_ball_tone1",
+	"⛹🏻\u200d♀️", "woman_bouncing_ball_light_skin_tone",
+	"⛹🏻\u200d♂️", "man_bouncing_ball_light_skin_tone",
+	"⛹🏼", "person_with_ball_tone2",
+	"⛹🏼\u200d♀️", "woman_bouncing_ball_medium_light_skin_tone",
+	"⛹🏼\u200d♂️", "man_bouncing_ball_medium_light_skin_tone",
+	"⛹🏽", "person_with_ball_tone3",
+	"⛹🏽\u200d♀️", "woman_bouncing_ball_medium_skin_tone",
+	"⛹🏽\u200d♂️", "man_bouncing_ball_medium_skin_tone",
+	"⛹🏾", "person_with_ball_tone4",
+	"⛹🏾\u200d♀️", "woman_bouncing_ball_medium_dark_skin_tone",
+	"⛹🏾\u200d♂️", "man_bouncing_ball_medium_dark_skin_tone",
+	"⛹🏿", "person_with_ball_tone5",
+	"⛹🏿\u200d♀️", "woman_bouncing_ball_dark_skin_tone",
+	"⛹🏿\u200d♂️", "man_bouncing_ball_dark_skin_tone",
+	"⛺", "tent",
+	"⛽", "fuelpump",
+	"✂️", "scissors",
+	"✅", "white_check_mark",
+	"✈️", "airplane",
+	"✉️", "envelope",
+	"✊", "fist",
+	"✊🏻", "fist_tone1",
+	"✊🏼", "fist_tone2",
+	"✊🏽", "fist_tone3",
+	"✊🏾", "fist_tone4",
+	"✊🏿", "fist_tone5",
+	"✋", "raised_hand",
+	"✋🏻", "raised_hand_tone1",
+	"✋🏼", "raised_hand_tone2",
+	"✋🏽", "raised_hand_tone3",
+	"✋🏾", "raised_hand_tone4",
+	"✋🏿", "raised_hand_tone5",
+	"✌️", "v",
+	"✌🏻", "v_tone1",
+	"✌🏼", "v_tone2",
+	"✌🏽", "v_tone3",
+	"✌🏾", "v_tone4",
+	"✌🏿", "v_tone5",
+	"✍️", "writing_hand",
+	"✍🏻", "writing_hand_tone1",
+	"✍🏼", "writing_hand_tone2",
+	"✍🏽", "writing_hand_tone3",
+	"✍🏾", "writing_hand_tone4",
+	"✍🏿", "writing_hand_tone5",
+	"✏️", "pencil2",
+	"✒️", "black_nib",
+	"✔️", "heavy_check_mark",
+	"✖️", "heavy_multiplication_x",
+	"✝️", "latin_cross",
+	"✡️", "star_of_david",
+	"✨", "sparkles",
+	"✳️", "eight_spoked_asterisk",
+	"✴️", "eight_pointed_black_star",
+	"❄️", "snowflake",
+	"❇️", "sparkle",
+	"❌", "x",
+	"❎", "negative_squared_cross_mark",
+	"❓", "question",
+	"❔", "grey_question",
+	"❕", "grey_exclamation",
+	"❗", "exclamation",
+	"❣️", "heavy_heart_exclamation_mark_ornament",
+	"❤️", "heart",
+	"❤️\u200d🔥", "heart_on_fire",
+	"❤️\u200d🩹", "mending_heart",
+	"➕", "heavy_plus_sign",
+	"➖", "heavy_minus_sign",
+	"➗", "heavy_division_sign",
+	"➡️", "arrow_right",
+	"➰", "curly_loop",
+	"➿", "loop",
+	"⤴️", "arrow_heading_up",
+	"⤵️", "arrow_heading_down",
+	"⬅️", "arrow_left",
+	"⬆️", "arrow_up",
+	"⬇️", "arrow_down",
+	"⬛", "black_large_square",
+	"⬜", "white_large_square",
+	"⭐", "star",
+	"⭕", "o",
+	"〰️", "wavy_dash",
+	"〽️", "part_alternation_mark",
+	"㊗️", "congratulations",
+	"㊙️", "secret",
+	"🀄", "mahjong",
+	"🃏", "black_joker",
+	"🅰️", "a",
+	"🅱️", "b",
+	"🅾️", "o2",
+	"🅿️", "parking",
+	"🆎", "ab",
+	"🆑", "cl",
+	"🆒", "cool",
+	"🆓", "free",
+	"🆔", "id",
+	"🆕", "new",
+	"🆖", "ng",
+	"🆗", "ok",
+	"🆘", "sos",
+	"🆙", "up",
+	"🆚", "vs",
+	"🇦", "regional_indicator_a",
+	"🇦🇨", "flag_ac",
+	"🇦🇩", "flag_ad",
+	"🇦🇪", "flag_ae",
+	"🇦🇫", "flag_af",
+	"🇦🇬", "flag_ag",
+	"🇦🇮", "flag_ai",
+	"🇦🇱", "flag_al",
+	"🇦🇲", "flag_am",
+	"🇦🇴", "flag_ao",
+	"🇦🇶", "flag_aq",
+	"🇦🇷", "flag_ar",
+	"🇦🇸", "flag_as",
+	"🇦🇹", "flag_at",
+	"🇦🇺", "flag_au",
+	"🇦🇼", "flag_aw",
+	"🇦🇽", "flag_ax",
+	"🇦🇿", "flag_az",
+	"🇧", "regional_indicator_b",
+	"🇧🇦", "flag_ba",
+	"🇧🇧", "flag_bb",
+	"🇧🇩", "flag_bd",
+	"🇧🇪", "flag_be",
+	"🇧🇫", "flag_bf",
+	"🇧🇬", "flag_bg",
+	"🇧🇭", "flag_bh",
+	"🇧🇮", "flag_bi",
+	"🇧🇯", "flag_bj",
+	"🇧🇱", "flag_bl",
+	"🇧🇲", "flag_bm",
+	"🇧🇳", "flag_bn",
+	"🇧🇴", "flag_bo",
+	"🇧🇶", "flag_bq",
+	"🇧🇷", "flag_br",
+	"🇧🇸", "flag_bs",
+	"🇧🇹", "flag_bt",
+	"🇧🇻", "flag_bv",
+	"🇧🇼", "flag_bw",
+	"🇧🇾", "flag_by",
+	"🇧🇿", "flag_bz",
+	"🇨", "regional_indicator_c",
+	"🇨🇦", "flag_ca",
+	"🇨🇨", "flag_cc",
+	"🇨🇩", "flag_cd",
+	"🇨🇫", "flag_cf",
+	"🇨🇬", "flag_cg",
+	"🇨🇭", "flag_ch",
+	"🇨🇮", "flag_ci",
+	"🇨🇰", "flag_ck",
+	"🇨🇱", "flag_cl",
+	"🇨🇲", "flag_cm",
+	"🇨🇳", "flag_cn",
+	"🇨🇴", "flag_co",
+	"🇨🇵", "flag_cp",
+	"🇨🇷", "flag_cr",
+	"🇨🇺", "flag_cu",
+	"🇨🇻", "flag_cv",
+	"🇨🇼", "flag_cw",
+	"🇨🇽", "flag_cx",
+	"🇨🇾", "flag_cy",
+	"🇨🇿", "flag_cz",
+	"🇩", "regional_indicator_d",
+	"🇩🇪", "flag_de",
+	"🇩🇬", "flag_dg",
+	"🇩🇯", "flag_dj",
+	"🇩🇰", "flag_dk",
+	"🇩🇲", "flag_dm",
+	"🇩🇴", "flag_do",
+	"🇩🇿", "flag_dz",
+	"🇪", "regional_indicator_e",
+	"🇪🇦", "flag_ea",
+	"🇪🇨", "flag_ec",
+	"🇪🇪", "flag_ee",
+	"🇪🇬", "flag_eg",
+	"🇪🇭", "flag_eh",
+	"🇪🇷", "flag_er",
+	"🇪🇸", "flag_es",
+	"🇪🇹", "flag_et",
+	"🇪🇺", "flag_eu",
+	"🇫", "regional_indicator_f",
+	"🇫🇮", "flag_fi",
+	"🇫🇯", "flag_fj",
+	"🇫🇰", "flag_fk",
+	"🇫🇲", "flag_fm",
+	"🇫🇴", "flag_fo",
+	"🇫🇷", "flag_fr",
+	"🇬", "regional_indicator_g",
+	"🇬🇦", "flag_ga",
+	"🇬🇧", "flag_gb",
+	"🇬🇩", "flag_gd",
+	"🇬🇪", "flag_ge",
+	"🇬🇫", "flag_gf",
+	"🇬🇬", "flag_gg",
+	"🇬🇭", "flag_gh",
+	"🇬🇮", "flag_gi",
+	"🇬🇱", "flag_gl",
+	"🇬🇲", "flag_gm",
+	"🇬🇳", "flag_gn",
+	"🇬🇵", "flag_gp",
+	"🇬🇶", "flag_gq",
+	"🇬🇷", "flag_gr",
+	"🇬🇸", "flag_gs",
+	"🇬🇹", "flag_gt",
+	"🇬🇺", "flag_gu",
+	"🇬🇼", "flag_gw",
+	"🇬🇾", "flag_gy",
+	"🇭", "regional_indicator_h",
+	"🇭🇰", "flag_hk",
+	"🇭🇲", "flag_hm",
+	"🇭🇳", "flag_hn",
+	"🇭🇷", "flag_hr",
+	"🇭🇹", "flag_ht",
+	"🇭🇺", "flag_hu",
+	"🇮", "regional_indicator_i",
+	"🇮🇨", "flag_ic",
+	"🇮🇩", "flag_id",
+	"🇮🇪", "flag_ie",
+	"🇮🇱", "flag_il",
+	"🇮🇲", "flag_im",
+	"🇮🇳", "flag_in",
+	"🇮🇴", "flag_io",
+	"🇮🇶", "flag_iq",
+	"🇮🇷", "flag_ir",
+	"🇮🇸", "flag_is",
+	"🇮🇹", "flag_it",
+	"🇯", "regional_indicator_j",
+	"🇯🇪", "flag_je",
+	"🇯🇲", "flag_jm",
+	"🇯🇴", "flag_jo",
+	"🇯🇵", "flag_jp",
+	"🇰", "regional_indicator_k",
+	"🇰🇪", "flag_ke",
+	"🇰🇬", "flag_kg",
+	"🇰🇭", "flag_kh",
+	"🇰🇮", "flag_ki",
+	"🇰🇲", "flag_km",
+	"🇰🇳", "flag_kn",
+	"🇰🇵", "flag_kp",
+	"🇰🇷", "flag_kr",
+	"🇰🇼", "flag_kw",
+	"🇰🇾", "flag_ky",
+	"🇰🇿", "flag_kz",
+	"🇱", "regional_indicator_l",
+	"🇱🇦", "flag_la",
+	"🇱🇧", "flag_lb",
+	"🇱🇨", "flag_lc",
+	"🇱🇮", "flag_li",
+	"🇱🇰", "flag_lk",
+	"🇱🇷", "flag_lr",
+	"🇱🇸", "flag_ls",
+	"🇱🇹", "flag_lt",
+	"🇱🇺", "flag_lu",
+	"🇱🇻", "flag_lv",
+	"🇱🇾", "flag_ly",
+	"🇲", "regional_indicator_m",
+	"🇲🇦", "flag_ma",
+	"🇲🇨", "flag_mc",
+	"🇲🇩", "flag_md",
+	"🇲🇪", "flag_me",
+	"🇲🇫", "flag_mf",
+	"🇲🇬", "flag_mg",
+	"🇲🇭", "flag_mh",
+	"🇲🇰", "flag_mk",
+	"🇲🇱", "flag_ml",
+	"🇲🇲", "flag_mm",
+	"🇲🇳", "flag_mn",
+	"🇲🇴", "flag_mo",
+	"🇲🇵", "flag_mp",
+	"🇲🇶", "flag_mq",
+	"🇲🇷", "flag_mr",
+	"🇲🇸", "flag_ms",
+	"🇲🇹", "flag_mt",
+	"🇲🇺", "flag_mu",
+	"🇲🇻", "flag_mv",
+	"🇲🇼", "flag_mw",
+	"🇲🇽", "flag_mx",
+	"🇲🇾", "flag_my",
+	"🇲🇿", "flag_mz",
+	"🇳", "regional_indicator_n",
+	"🇳🇦", "flag_na",
+	"🇳🇨", "flag_nc",
+	"🇳🇪", "flag_ne",
+	"🇳🇫", "flag_nf",
+	"🇳🇬", "flag_ng",
+	"🇳🇮", "flag_ni",
+	"🇳🇱", "flag_nl",
+	"🇳🇴", "flag_no",
+	"🇳🇵", "flag_np",
+	"🇳🇷", "flag_nr",
+	"🇳🇺", "flag_nu",
+	"🇳🇿", "flag_nz",
+	"🇴", "regional_indicator_o",
+	"🇴🇲", "flag_om",
+	"🇵", "regional_indicator_p",
+	"🇵🇦", "flag_pa",
+	"🇵🇪", "flag_pe",
+	"🇵🇫", "flag_pf",
+	"🇵🇬", "flag_pg",
+	"🇵🇭", "flag_ph",
+	"🇵🇰", "flag_pk",
+	"🇵🇱", "flag_pl",
+	"🇵🇲", "flag_pm",
+	"🇵🇳", "flag_pn",
+	"🇵🇷", "flag_pr",
+	"🇵🇸", "flag_ps",
+	"🇵🇹", "flag_pt",
+	"🇵🇼", "flag_pw",
+	"🇵🇾", "flag_py",
+	"🇶", "regional_indicator_q",
+	"🇶🇦", "flag_qa",
+	"🇷", "regional_indicator_r",
+	"🇷🇪", "flag_re",
+	"🇷🇴", "flag_ro",
+	"🇷🇸", "flag_rs",
+	"🇷🇺", "flag_ru",
+	"🇷🇼", "flag_rw",
+	"🇸", "regional_indicator_s",
+	"🇸🇦", "flag_sa",
+	"🇸🇧", "flag_sb",
+	"🇸🇨", "flag_sc",
+	"🇸🇩", "flag_sd",
+	"🇸🇪", "flag_se",
+	"🇸🇬", "flag_sg",
+	"🇸🇭", "flag_sh",
+	"🇸🇮", "flag_si",
+	"🇸🇯", "flag_sj",
+	"🇸🇰", "flag_sk",
+	"🇸🇱", "flag_sl",
+	"🇸🇲", "flag_sm",
+	"🇸🇳", "flag_sn",
+	"🇸🇴", "flag_so",
+	"🇸🇷", "flag_sr",
+	"🇸🇸", "flag_ss",
+	"🇸🇹", "flag_st",
+	"🇸🇻", "flag_sv",
+	"🇸🇽", "flag_sx",
+	"🇸🇾", "flag_sy",
+	"🇸🇿", "flag_sz",
+	"🇹", "regional_indicator_t",
+	"🇹🇦", "flag_ta",
+	"🇹🇨", "flag_tc",
+	"🇹🇩", "flag_td",
+	"🇹🇫", "flag_tf",
+	"🇹🇬", "flag_tg",
+	"🇹🇭", "flag_th",
+	"🇹🇯", "flag_tj",
+	"🇹🇰", "flag_tk",
+	"🇹🇱", "flag_tl",
+	"🇹🇲", "flag_tm",
+	"🇹🇳", "flag_tn",
+	"🇹🇴", "flag_to",
+	"🇹🇷", "flag_tr",
+	"🇹🇹", "flag_tt",
+	"🇹🇻", "flag_tv",
+	"🇹🇼", "flag_tw",
+	"🇹🇿", "flag_tz",
+	"🇺", "regional_indicator_u",
+	"🇺🇦", "flag_ua",
+	"🇺🇬", "flag_ug",
+	"🇺🇲", "flag_um",
+	"🇺🇳", "united_nations",
+	"🇺🇸", "flag_us",
+	"🇺🇾", "flag_uy",
+	"🇺🇿", "flag_uz",
+	"🇻", "regional_indicator_v",
+	"🇻🇦", "flag_va",
+	"🇻🇨", "flag_vc",
+	"🇻🇪", "flag_ve",
+	"🇻🇬", "flag_vg",
+	"🇻🇮", "flag_vi",
+	"🇻🇳", "flag_vn",
+	"🇻🇺", "flag_vu",
+	"🇼", "regional_indicator_w",
+	"🇼🇫", "flag_wf",
+	"🇼🇸", "flag_ws",
+	"🇽", "regional_indicator_x",
+	"🇽🇰", "flag_xk",
+	"🇾", "regional_indicator_y",
+	"🇾🇪", "flag_ye",
+	"🇾🇹", "flag_yt",
+	"🇿", "regional_indicator_z",
+	"🇿🇦", "flag_za",
+	"🇿🇲", "flag_zm",
+	"🇿🇼", "flag_zw",
+	"🈁", "koko",
+	"🈂️", "sa",
+	"🈚", "u7121",
+	"🈯", "u6307",
+	"🈲", "u7981",
+	"🈳", "u7a7a",
+	"🈴", "u5408",
+	"🈵", "u6e80",
+	"🈶", "u6709",
+	"🈷️", "u6708",
+	"🈸", "u7533",
+	"🈹", "u5272",
+	"🈺", "u55b6",
+	"🉐", "ideograph_advantage",
+	"🉑", "accept",
+	"🌀", "cyclone",
+	"🌁", "foggy",
+	"🌂", "closed_umbrella",
+	"🌃", "night_with_stars",
+	"🌄", "sunrise_over_mountains",
+	"🌅", "sunrise",
+	"🌆", "city_dusk",
+	"🌇", "city_sunrise",
+	"🌈", "rainbow",
+	"🌉", "bridge_at_night",
+	"🌊", "ocean",
+	"🌋", "volcano",
+	"🌌", "milky_way",
+	"🌍", "earth_africa",
+	"🌎", "earth_americas",
+	"🌏", "earth_asia",
+	"🌐", "globe_with_meridians",
+	"🌑", "new_moon",
+	"🌒", "waxing_crescent_moon",
+	"🌓", "first_quarter_moon",
+	"🌔", "waxing_gibbous_moon",
+	"🌕", "full_moon",
+	"🌖", "waning_gibbous_moon",
+	"🌗", "last_quarter_moon",
+	"🌘", "waning_crescent_moon",
+	"🌙", "crescent_moon",
+	"🌚", "new_moon_with_face",
+	"🌛", "first_quarter_moon_with_face",
+	"🌜", "last_quarter_moon_with_face",
+	"🌝", "full_moon_with_face",
+	"🌞", "sun_with_face",
+	"🌟", "star2",
+	"🌠", "stars",
+	"🌡️", "thermometer",
+	"🌤️", "white_sun_with_small_cloud",
+	"🌥️", "white_sun_behind_cloud",
+	"🌦️", "white_sun_behind_cloud_with_rain",
+	"🌧️", "cloud_with_rain",
+	"🌨️", "cloud_with_snow",
+	"🌩️", "cloud_with_lightning",
+	"🌪️", "cloud_with_tornado",
+	"🌫️", "fog",
+	"🌬️", "wind_blowing_face",
+	"🌭", "hot_dog",
+	"🌮", "taco",
+	"🌯", "burrito",
+	"🌰", "chestnut",
+	"🌱", "seedling",
+	"🌲", "evergreen_tree",
+	"🌳", "deciduous_tree",
+	"🌴", "palm_tree",
+	"🌵", "cactus",
+	"🌶️", "hot_pepper",
+	"🌷", "tulip",
+	"🌸", "cherry_blossom",
+	"🌹", "rose",
+	"🌺", "hibiscus",
+	"🌻", "sunflower",
+	"🌼", "blossom",
+	"🌽", "corn",
+	"🌾", "ear_of_rice",
+	"🌿", "herb",
+	"🍀", "four_leaf_clover",
+	"🍁", "maple_leaf",
+	"🍂", "fallen_leaf",
+	"🍃", "leaves",
+	"🍄", "mushroom",
+	"🍅", "tomato",
+	"🍆", "eggplant",
+	"🍇", "grapes",
+	"🍈", "melon",
+	"🍉", "watermelon",
+	"🍊", "tangerine",
+	"🍋", "lemon",
+	"🍌", "banana",
+	"🍍", "pineapple",
+	"🍎", "apple",
+	"🍏", "green_apple",
+	"🍐", "pear",
+	"🍑", "peach",
+	"🍒", "cherries",
+	"🍓", "strawberry",
+	"🍔", "hamburger",
+	"🍕", "pizza",
+	"🍖", "meat_on_bone",
+	"🍗", "poultry_leg",
+	"🍘", "rice_cracker",
+	"🍙", "rice_ball",
+	"🍚", "rice",
+	"🍛", "curry",
+	"🍜", "ramen",
+	"🍝", "spaghetti",
+	"🍞", "bread",
+	"🍟", "fries",
+	"🍠", "sweet_potato",
+	"🍡", "dango",
+	"🍢", "oden",
+	"🍣", "sushi",
+	"🍤", "fried_shrimp",
+	"🍥", "fish_cake",
+	"🍦", "icecream",
+	"🍧", "shaved_ice",
+	"🍨", "ice_cream",
+	"🍩", "doughnut",
+	"🍪", "cookie",
+	"🍫", "chocolate_bar",
+	"🍬", "candy",
+	"🍭", "lollipop",
+	"🍮", "flan",
+	"🍯", "honey_pot",
+	"🍰", "cake",
+	"🍱", "bento",
+	"🍲", "stew",
+	"🍳", "cooking",
+	"🍴", "fork_and_knife",
+	"🍵", "tea",
+	"🍶", "sake",
+	"🍷", "wine_glass",
+	"🍸", "cocktail",
+	"🍹", "tropical_drink",
+	"🍺", "beer",
+	"🍻", "beers",
+	"🍼", "baby_bottle",
+	"🍽️", "fork_and_knife_with_plate",
+	"🍾", "bottle_with_popping_cork",
+	"🍿", "popcorn",
+	"🎀", "ribbon",
+	"🎁", "gift",
+	"🎂", "birthday",
+	"🎃", "jack_o_lantern",
+	"🎄", "christmas_tree",
+	"🎅", "santa",
+	"🎅🏻", "santa_tone1",
+	"🎅🏼", "santa_tone2",
+	"🎅🏽", "santa_tone3",
+	"🎅🏾", "santa_tone4",
+	"🎅🏿", "santa_tone5",
+	"🎆", "fireworks",
+	"🎇", "sparkler",
+	"🎈", "balloon",
+	"🎉", "tada",
+	"🎊", "confetti_ball",
+	"🎋", "tanabata_tree",
+	"🎌", "crossed_flags",
+	"🎍", "bamboo",
+	"🎎", "dolls",
+	"🎏", "flags",
+	"🎐", "wind_chime",
+	"🎑", "rice_scene",
+	"🎒", "school_satchel",
+	"🎓", "mortar_board",
+	"🎖️", "military_medal",
+	"🎗️", "reminder_ribbon",
+	"🎙️", "studio_microphone",
+	"🎚️", "level_slider",
+	"🎛️", "control_knobs",
+	"🎞️", "film_frames",
+	"🎟️", "admission_tickets",
+	"🎠", "carousel_horse",
+	"🎡", "ferris_wheel",
+	"🎢", "roller_coaster",
+	"🎣", "fishing_pole_and_fish",
+	"🎤", "microphone",
+	"🎥", "movie_camera",
+	"🎦", "cinema",
+	"🎧", "headphones",
+	"🎨", "art",
+	"🎩", "tophat",
+	"🎪", "circus_tent",
+	"🎫", "ticket",
+	"🎬", "clapper",
+	"🎭", "performing_arts",
+	"🎮", "video_game",
+	"🎯", "dart",
+	"🎰", "slot_machine",
+	"🎱", "8ball",
+	"🎲", "game_die",
+	"🎳", "bowling",
+	"🎴", "flower_playing_cards",
+	"🎵", "musical_note",
+	"🎶", "notes",
+	"🎷", "saxophone",
+	"🎸", "guitar",
+	"🎹", "musical_keyboard",
+	"🎺", "trumpet",
+	"🎻", "violin",
+	"🎼", "musical_score",
+	"🎽", "running_shirt_with_sash",
+	"🎾", "tennis",
+	"🎿", "ski",
+	"🏀", "basketball",
+	"🏁", "checkered_flag",
+	"🏂", "snowboarder",
+	"🏂🏻", "snowboarder_light_skin_tone",
+	"🏂🏼", "snowboarder_medium_light_skin_tone",
+	"🏂🏽", "snowboarder_medium_skin_tone",
+	"🏂🏾", "snowboarder_medium_dark_skin_tone",
+	"🏂🏿", "snowboarder_dark_skin_tone",
+	"🏃", "runner",
+	"🏃\u200d♀️", "woman_running",
+	"🏃\u200d♂️", "man_running",
+	"🏃🏻", "runner_tone1",
+	"🏃🏻\u200d♀️", "woman_running_light_skin_tone",
+	"🏃🏻\u200d♂️", "man_running_light_skin_tone",
+	"🏃🏼", "runner_tone2",
+	"🏃🏼\u200d♀️", "woman_running_medium_light_skin_tone",
+	"🏃🏼\u200d♂️", "man_running_medium_light_skin_tone",
+	"🏃🏽", "runner_tone3",
+	"🏃🏽\u200d♀️", "woman_running_medium_skin_tone",
+	"🏃🏽\u200d♂️", "man_running_medium_skin_tone",
+	"🏃🏾", "runner_tone4",
+	"🏃🏾\u200d♀️", "woman_running_medium_dark_skin_tone",
+	"🏃🏾\u200d♂️", "man_running_medium_dark_skin_tone",
+	"🏃🏿", "runner_tone5",
+	"🏃🏿\u200d♀️", "woman_running_dark_skin_tone",
+	"🏃🏿\u200d♂️", "man_running_dark_skin_tone",
+	"🏄", "surfer",
+	"🏄\u200d♀️", "woman_surfing",
+	"🏄\u200d♂️", "man_surfing",
+	"🏄🏻", "surfer_tone1",
+	"🏄🏻\u200d♀️", "woman_surfing_light_skin_tone",
+	"🏄🏻\u200d♂️", "man_surfing_light_skin_tone",
+	"🏄🏼", "surfer_tone2",
+	"🏄🏼\u200d♀️", "woman_surfing_medium_light_skin_tone",
+	"🏄🏼\u200d♂️", "man_surfing_medium_light_skin_tone",
+	"🏄🏽", "surfer_tone3",
+	"🏄🏽\u200d♀️", "woman_surfing_medium_skin_tone",
+	"🏄🏽\u200d♂️", "man_surfing_medium_skin_tone",
+	"🏄🏾", "surfer_tone4",
+	"🏄🏾\u200d♀️", "woman_surfing_medium_dark_skin_tone",
+	"🏄🏾\u200d♂️", "man_surfing_medium_dark_skin_tone",
+	"🏄🏿", "surfer_tone5",
+	"🏄🏿\u200d♀️", "woman_surfing_dark_skin_tone",
+	"🏄🏿\u200d♂️", "man_surfing_dark_skin_tone",
+	"🏅", "sports_medal",
+	"🏆", "trophy",
+	"🏇", "horse_racing",
+	"🏇🏻", "horse_racing_tone1",
+	"🏇🏼", "horse_racing_tone2",
+	"🏇🏽", "horse_racing_tone3",
+	"🏇🏾", "horse_racing_tone4",
+	"🏇🏿", "horse_racing_tone5",
+	"🏈", "football",
+	"🏉", "rugby_football",
+	"🏊", "swimmer",
+	"🏊\u200d♀️", "woman_swimming",
+	"🏊\u200d♂️", "man_swimming",
+	"🏊🏻", "swimmer_tone1",
+	"🏊🏻\u200d♀️", "woman_swimming_light_skin_tone",
+	"🏊🏻\u200d♂️", "man_swimming_light_skin_tone",
+	"🏊🏼", "swimmer_tone2",
+	"🏊🏼\u200d♀️", "woman_swimming_medium_light_skin_tone",
+	"🏊🏼\u200d♂️", "man_swimming_medium_light_skin_tone",
+	"🏊🏽", "swimmer_tone3",
+	"🏊🏽\u200d♀️", "woman_swimming_medium_skin_tone",
+	"🏊🏽\u200d♂️", "man_swimming_medium_skin_tone",
+	"🏊🏾", "swimmer_tone4",
+	"🏊🏾\u200d♀️", "woman_swimming_medium_dark_skin_tone",
+	"🏊🏾\u200d♂️", "man_swimming_medium_dark_skin_tone",
+	"🏊🏿", "swimmer_tone5",
+	"🏊🏿\u200d♀️", "woman_swimming_dark_skin_tone",
+	"🏊🏿\u200d♂️", "man_swimming_dark_skin_tone",
+	"🏋️", "weight_lifter",
+	"🏋️\u200d♀️", "woman_lifting_weights",
+	"🏋️\u200d♂️", "man_lifting_weights",
+	"🏋🏻", "weight_lifter_tone1",
+	"🏋🏻\u200d♀️", "woman_lifting_weights_light_skin_tone",
+	"🏋🏻\u200d♂️", "man_lifting_weights_light_skin_tone",
+	"🏋🏼", "weight_lifter_tone2",
+	"🏋🏼\u200d♀️", "woman_lifting_weights_medium_light_skin_tone",
+	"🏋🏼\u200d♂️", "man_lifting_weights_medium_light_skin_tone",
+	"🏋🏽", "weight_lifter_tone3",
+	"🏋🏽\u200d♀️", "woman_lifting_weights_medium_skin_tone",
+	"🏋🏽\u200d♂️", "man_lifting_weights_medium_skin_tone",
+	"🏋🏾", "weight_lifter_tone4",
+	"🏋🏾\u200d♀️", "woman_lifting_weights_medium_dark_skin_tone",
+	"🏋🏾\u200d♂️", "man_lifting_weights_medium_dark_skin_tone",
+	"🏋🏿", "weight_lifter_tone5",
+	"🏋🏿\u200d♀️", "woman_lifting_weights_dark_skin_tone",
+	"🏋🏿\u200d♂️", "man_lifting_weights_dark_skin_tone",
+	"🏌️", "golfer",
+	"🏌️\u200d♀️", "woman_golfing",
+	"🏌️\u200d♂️", "man_golfing",
+	"🏌🏻", "person_golfing_light_skin_tone",
+	"🏌🏻\u200d♀️", "woman_golfing_light_skin_tone",
+	"🏌🏻\u200d♂️", "man_golfing_light_skin_tone",
+	"🏌🏼", "person_golfing_medium_light_skin_tone",
+	"🏌🏼\u200d♀️", "woman_golfing_medium_light_skin_tone",
+	"🏌🏼\u200d♂️", "man_golfing_medium_light_skin_tone",
+	"🏌🏽", "person_golfing_medium_skin_tone",
+	"🏌🏽\u200d♀️", "woman_golfing_medium_skin_tone",
+	"🏌🏽\u200d♂️", "man_golfing_medium_skin_tone",
+	"🏌🏾", "person_golfing_medium_dark_skin_tone",
+	"🏌🏾\u200d♀️", "woman_golfing_medium_dark_skin_tone",
+	"🏌🏾\u200d♂️", "man_golfing_medium_dark_skin_tone",
+	"🏌🏿", "person_golfing_dark_skin_tone",
+	"🏌🏿\u200d♀️", "woman_golfing_dark_skin_tone",
+	"🏌🏿\u200d♂️", "man_golfing_dark_skin_tone",
+	"🏍️", "racing_motorcycle",
+	"🏎️", "racing_car",
+	"🏏", "cricket_bat_ball",
+	"🏐", "volleyball",
+	"🏑", "field_hockey",
+	"🏒", "hockey",
+	"🏓", "table_tennis",
+	"🏔️", "snow_capped_mountain",
+	"🏕️", "camping",
+	"🏖️", "beach_with_umbrella",
+	"🏗️", "building_construction",
+	"🏘️", "house_buildings",
+	"🏙️", "cityscape",
+	"🏚️", "derelict_house_building",
+	"🏛️", "classical_building",
+	"🏜️", "desert",
+	"🏝️", "desert_island",
+	"🏞️", "national_park",
+	"🏟️", "stadium",
+	"🏠", "house",
+	"🏡", "house_with_garden",
+	"🏢", "office",
+	"🏣", "post_office",
+	"🏤", "european_post_office",
+	"🏥", "hospital",
+	"🏦", "bank",
+	"🏧", "atm",
+	"🏨", "hotel",
+	"🏩", "love_hotel",
+	"🏪", "convenience_store",
+	"🏫", "school",
+	"🏬", "department_store",
+	"🏭", "factory",
+	"🏮", "izakaya_lantern",
+	"🏯", "japanese_castle",
+	"🏰", "european_castle",
+	"🏳️", "flag_white",
+	"🏳️\u200d⚧️", "transgender_flag",
+	"🏳️\u200d🌈", "gay_pride_flag",
+	"🏴", "flag_black",
+	"🏴\u200d☠️", "pirate_flag",
+	"🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f", "england",
+	"🏴\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f", "scotland",
+	"🏴\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f", "wales",
+	"🏵️", "rosette",
+	"🏷️", "label",
+	"🏸", "badminton",
+	"🏹", "archery",
+	"🏺", "amphora",
+	"🐀", "rat",
+	"🐁", "mouse2",
+	"🐂", "ox",
+	"🐃", "water_buffalo",
+	"🐄", "cow2",
+	"🐅", "tiger2",
+	"🐆", "leopard",
+	"🐇", "rabbit2",
+	"🐈", "cat2",
+	"🐈\u200d⬛", "black_cat",
+	"🐉", "dragon",
+	"🐊", "crocodile",
+	"🐋", "whale2",
+	"🐌", "snail",
+	"🐍", "snake",
+	"🐎", "racehorse",
+	"🐏", "ram",
+	"🐐", "goat",
+	"🐑", "sheep",
+	"🐒", "monkey",
+	"🐓", "rooster",
+	"🐔", "chicken",
+	"🐕", "dog2",
+	"🐕\u200d🦺", "service_dog",
+	"🐖", "pig2",
+	"🐗", "boar",
+	"🐘", "elephant",
+	"🐙", "octopus",
+	"🐚", "shell",
+	"🐛", "bug",
+	"🐜", "ant",
+	"🐝", "bee",
+	"🐞", "lady_beetle",
+	"🐟", "fish",
+	"🐠", "tropical_fish",
+	"🐡", "blowfish",
+	"🐢", "turtle",
+	"🐣", "hatching_chick",
+	"🐤", "baby_chick",
+	"🐥", "hatched_chick",
+	"🐦", "bird",
+	"🐧", "penguin",
+	"🐨", "koala",
+	"🐩", "poodle",
+	"🐪", "dromedary_camel",
+	"🐫", "camel",
+	"🐬", "dolphin",
+	"🐭", "mouse",
+	"🐮", "cow",
+	"🐯", "tiger",
+	"🐰", "rabbit",
+	"🐱", "cat",
+	"🐲", "dragon_face",
+	"🐳", "whale",
+	"🐴", "horse",
+	"🐵", "monkey_face",
+	"🐶", "dog",
+	"🐷", "pig",
+	"🐸", "frog",
+	"🐹", "hamster",
+	"🐺", "wolf",
+	"🐻", "bear",
+	"🐻\u200d❄️", "polar_bear",
+	"🐼", "panda_face",
+	"🐽", "pig_nose",
+	"🐾", "paw_prints",
+	"🐿️", "chipmunk",
+	"👀", "eyes",
+	"👁\u200d🗨", "eye_in_speech_bubble",
+	"👁️", "eye",
+	"👂", "ear",
+	"👂🏻", "ear_tone1",
+	"👂🏼", "ear_tone2",
+	"👂🏽", "ear_tone3",
+	"👂🏾", "ear_tone4",
+	"👂🏿", "ear_tone5",
+	"👃", "nose",
+	"👃🏻", "nose_tone1",
+	"👃🏼", "nose_tone2",
+	"👃🏽", "nose_tone3",
+	"👃🏾", "nose_tone4",
+	"👃🏿", "nose_tone5",
+	"👄", "lips",
+	"👅", "tongue",
+	"👆", "point_up_2",
+	"👆🏻", "point_up_2_tone1",
+	"👆🏼", "point_up_2_tone2",
+	"👆🏽", "point_up_2_tone3",
+	"👆🏾", "point_up_2_tone4",
+	"👆🏿", "point_up_2_tone5",
+	"👇", "point_down",
+	"👇🏻", "point_down_tone1",
+	"👇🏼", "point_down_tone2",
+	"👇🏽", "point_down_tone3",
+	"👇🏾", "point_down_tone4",
+	"👇🏿", "point_down_tone5",
+	"👈", "point_left",
+	"👈🏻", "point_left_tone1",
+	"👈🏼", "point_left_tone2",
+	"👈🏽", "point_left_tone3",
+	"👈🏾", "point_left_tone4",
+	"👈🏿", "point_left_tone5",
+	"👉", "point_right",
+	"👉🏻", "point_right_tone1",
+	"👉🏼", "point_right_tone2",
+	"👉🏽", "point_right_tone3",
+	"👉🏾", "point_right_tone4",
+	"👉🏿", "point_right_tone5",
+	"👊", "punch",
+	"👊🏻", "punch_tone1",
+	"👊🏼", "punch_tone2",
+	"👊🏽", "punch_tone3",
+	"👊🏾", "punch_tone4",
+	"👊🏿", "punch_tone5",
+	"👋", "wave",
+	"👋🏻", "wave_tone1",
+	"👋🏼", "wave_tone2",
+	"👋🏽", "wave_tone3",
+	"👋🏾", "wave_tone4",
+	"👋🏿", "wave_tone5",
+	"👌", "ok_hand",
+	"👌🏻", "ok_hand_tone1",
+	"👌🏼", "ok_hand_tone2",
+	"👌🏽", "ok_hand_tone3",
+	"👌🏾", "ok_hand_tone4",
+	"👌🏿", "ok_hand_tone5",
+	"👍", "thumbup",
+	"👍🏻", "thumbup_tone1",
+	"👍🏼", "thumbup_tone2",
+	"👍🏽", "thumbup_tone3",
+	"👍🏾", "thumbup_tone4",
+	"👍🏿", "thumbup_tone5",
+	"👎", "thumbdown",
+	"👎🏻", "thumbdown_tone1",
+	"👎🏼", "thumbdown_tone2",
+	"👎🏽", "thumbdown_tone3",
+	"👎🏾", "thumbdown_tone4",
+	"👎🏿", "thumbdown_tone5",
+	"👏", "clap",
+	"👏🏻", "clap_tone1",
+	"👏🏼", "clap_tone2",
+	"👏🏽", "clap_tone3",
+	"👏🏾", "clap_tone4",
+	"👏🏿", "clap_tone5",
+	"👐", "open_hands",
+	"👐🏻", "open_hands_tone1",
+	"👐🏼", "open_hands_tone2",
+	"👐🏽", "open_hands_tone3",
+	"👐🏾", "open_hands_tone4",
+	"👐🏿", "open_hands_tone5",
+	"👑", "crown",
+	"👒", "womans_hat",
+	"👓", "eyeglasses",
+	"👔", "necktie",
+	"👕", "shirt",
+	"👖", "jeans",
+	"👗", "dress",
+	"👘", "kimono",
+	"👙", "bikini",
+	"👚", "womans_clothes",
+	"👛", "purse",
+	"👜", "handbag",
+	"👝", "pouch",
+	"👞", "mans_shoe",
+	"👟", "athletic_shoe",
+	"👠", "high_heel",
+	"👡", "sandal",
+	"👢", "boot",
+	"👣", "footprints",
+	"👤", "bust_in_silhouette",
+	"👥", "busts_in_silhouette",
+	"👦", "boy",
+	"👦🏻", "boy_tone1",
+	"👦🏼", "boy_tone2",
+	"👦🏽", "boy_tone3",
+	"👦🏾", "boy_tone4",
+	"👦🏿", "boy_tone5",
+	"👧", "girl",
+	"👧🏻", "girl_tone1",
+	"👧🏼", "girl_tone2",
+	"👧🏽", "girl_tone3",
+	"👧🏾", "girl_tone4",
+	"👧🏿", "girl_tone5",
+	"👨", "man",
+	"👨\u200d⚕️", "man_health_worker",
+	"👨\u200d⚖️", "man_judge",
+	"👨\u200d✈️", "man_pilot",
+	"👨\u200d❤️\u200d👨", "couple_with_heart_mm",
+	"👨\u200d❤️\u200d💋\u200d👨", "couplekiss_mm",
+	"👨\u200d🌾", "man_farmer",
+	"👨\u200d🍳", "man_cook",
+	"👨\u200d🍼", "man_feeding_baby",
+	"👨\u200d🎓", "man_student",
+	"👨\u200d🎤", "man_singer",
+	"👨\u200d🎨", "man_artist",
+	"👨\u200d🏫", "man_teacher",
+	"👨\u200d🏭", "man_factory_worker",
+	"👨\u200d👦", "family_man_boy",
+	"👨\u200d👦\u200d👦", "family_man_boy_boy",
+	"👨\u200d👧", "family_man_girl",
+	"👨\u200d👧\u200d👦", "family_man_girl_boy",
+	"👨\u200d👧\u200d👧", "family_man_girl_girl",
+	"👨\u200d👨\u200d👦", "family_mmb",
+	"👨\u200d👨\u200d👦\u200d👦", "family_mmbb",
+	"👨\u200d👨\u200d👧", "family_mmg",
+	"👨\u200d👨\u200d👧\u200d👦", "family_mmgb",
+	"👨\u200d👨\u200d👧\u200d👧", "family_mmgg",
+	"👨\u200d👩\u200d👦", "family_man_woman_boy",
+	"👨\u200d👩\u200d👦\u200d👦", "family_mwbb",
+	"👨\u200d👩\u200d👧", "family_mwg",
+	"👨\u200d👩\u200d👧\u200d👦", "family_mwgb",
+	"👨\u200d👩\u200d👧\u200d👧", "family_mwgg",
+	"👨\u200d💻", "man_technologist",
+	"👨\u200d💼", "man_office_worker",
+	"👨\u200d🔧", "man_mechanic",
+	"👨\u200d🔬", "man_scientist",
+	"👨\u200d🚀", "man_astronaut",
+	"👨\u200d🚒", "man_firefighter",
+	"👨\u200d🦯", "man_with_probing_cane",
+	"👨\u200d🦰", "man_red_haired",
+	"👨\u200d🦱", "man_curly_haired",
+	"👨\u200d🦲", "man_bald",
+	"👨\u200d🦳", "man_white_haired",
+	"👨\u200d🦼", "man_in_motorized_wheelchair",
+	"👨\u200d🦽", "man_in_manual_wheelchair",
+	"👨🏻", "man_tone1",
+	"👨🏻\u200d⚕️", "man_health_worker_light_skin_tone",
+	"👨🏻\u200d⚖️", "man_judge_light_skin_tone",
+	"👨🏻\u200d✈️", "man_pilot_light_skin_tone",
+	"👨🏻\u200d❤️\u200d👨🏻", "couple_with_heart_man_man_light_skin_tone",
+	"👨🏻\u200d❤️\u200d👨🏼", "couple_with_heart_man_man_light_skin_tone_medium_light_skin_tone",
+	"👨🏻\u200d❤️\u200d👨🏽", "couple_with_heart_man_man_light_skin_tone_medium_skin_tone",
+	"👨🏻\u200d❤️\u200d👨🏾", "couple_with_heart_man_man_light_skin_tone_medium_dark_skin_tone",
+	"👨🏻\u200d❤️\u200d👨🏿", "couple_with_heart_man_man_light_skin_tone_dark_skin_tone",
+	"👨🏻\u200d❤️\u200d💋\u200d👨🏻", "kiss_man_man_light_skin_tone",
+	"👨🏻\u200d❤️\u200d💋\u200d👨🏼", "kiss_man_man_light_skin_tone_medium_light_skin_tone",
+	"👨🏻\u200d❤️\u200d💋\u200d👨🏽", "kiss_man_man_light_skin_tone_medium_skin_tone",
+	"👨🏻\u200d❤️\u200d💋\u200d👨🏾", "kiss_man_man_light_skin_tone_medium_dark_skin_tone",
+	"👨🏻\u200d❤️\u200d💋\u200d👨🏿", "kiss_man_man_light_skin_tone_dark_skin_tone",
+	"👨🏻\u200d🌾", "man_farmer_light_skin_tone",
+	"👨🏻\u200d🍳", "man_cook_light_skin_tone",
+	"👨🏻\u200d🍼", "man_feeding_baby_light_skin_tone",
+	"👨🏻\u200d🎓", "man_student_light_skin_tone",
+	"👨🏻\u200d🎤", "man_singer_light_skin_tone",
+	"👨🏻\u200d🎨", "man_artist_light_skin_tone",
+	"👨🏻\u200d🏫", "man_teacher_light_skin_tone",
+	"👨🏻\u200d🏭", "man_factory_worker_light_skin_tone",
+	"👨🏻\u200d💻", "man_technologist_light_skin_tone",
+	"👨🏻\u200d💼", "man_office_worker_light_skin_tone",
+	"👨🏻\u200d🔧", "man_mechanic_light_skin_tone",
+	"👨🏻\u200d🔬", "man_scientist_light_skin_tone",
+	"👨🏻\u200d🚀", "man_astronaut_light_skin_tone",
+	"👨🏻\u200d🚒", "man_firefighter_light_skin_tone",
+	"👨🏻\u200d🤝\u200d👨🏼", "men_holding_hands_light_skin_tone_medium_light_skin_tone",
+	"👨🏻\u200d🤝\u200d👨🏽", "men_holding_hands_light_skin_tone_medium_skin_tone",
+	"👨🏻\u200d🤝\u200d👨🏾", "men_holding_hands_light_skin_tone_medium_dark_skin_tone",
+	"👨🏻\u200d🤝\u200d👨🏿", "men_holding_hands_light_skin_tone_dark_skin_tone",
+	"👨🏻\u200d🦯", "man_with_probing_cane_light_skin_tone",
+	"👨🏻\u200d🦰", "man_red_haired_light_skin_tone",
+	"👨🏻\u200d🦱", "man_curly_haired_light_skin_tone",
+	"👨🏻\u200d🦲", "man_bald_light_skin_tone",
+	"👨🏻\u200d🦳", "man_white_haired_light_skin_tone",
+	"👨🏻\u200d🦼", "man_in_motorized_wheelchair_light_skin_tone",
+	"👨🏻\u200d🦽", "man_in_manual_wheelchair_light_skin_tone",
+	"👨🏼", "man_tone2",
+	"👨🏼\u200d⚕️", "man_health_worker_medium_light_skin_tone",
+	"👨🏼\u200d⚖️", "man_judge_medium_light_skin_tone",
+	"👨🏼\u200d✈️", "man_pilot_medium_light_skin_tone",
+	"👨🏼\u200d❤️\u200d👨🏻", "couple_with_heart_man_man_medium_light_skin_tone_light_skin_tone",
+	"👨🏼\u200d❤️\u200d👨🏼", "couple_with_heart_man_man_medium_light_skin_tone",
+	"👨🏼\u200d❤️\u200d👨🏽", "couple_with_heart_man_man_medium_light_skin_tone_medium_skin_tone",
+	"👨🏼\u200d❤️\u200d👨🏾", "couple_with_heart_man_man_medium_light_skin_tone_medium_dark_skin_tone",
+	"👨🏼\u200d❤️\u200d👨🏿", "couple_with_heart_man_man_medium_light_skin_tone_dark_skin_tone",
+	"👨🏼\u200d❤️\u200d💋\u200d👨🏻", "kiss_man_man_medium_light_skin_tone_light_skin_tone",
+	"👨🏼\u200d❤️\u200d💋\u200d👨🏼", "kiss_man_man_medium_light_skin_tone",
+	"👨🏼\u200d❤️\u200d💋\u200d👨🏽", "kiss_man_man_medium_light_skin_tone_medium_skin_tone",
+	"👨🏼\u200d❤️\u200d💋\u200d👨🏾", "kiss_man_man_medium_light_skin_tone_medium_dark_skin_tone",
+	"👨🏼\u200d❤️\u200d💋\u200d👨🏿", "kiss_man_man_medium_light_skin_tone_dark_skin_tone",
+	"👨🏼\u200d🌾", "man_farmer_medium_light_skin_tone",
+	"👨🏼\u200d🍳", "man_cook_medium_light_skin_tone",
+	"👨🏼\u200d🍼", "man_feeding_baby_medium_light_skin_tone",
+	"👨🏼\u200d🎓", "man_student_medium_light_skin_tone",
+	"👨🏼\u200d🎤", "man_singer_medium_light_skin_tone",
+	"👨🏼\u200d🎨", "man_artist_medium_light_skin_tone",
+	"👨🏼\u200d🏫", "man_teacher_medium_light_skin_tone",
+	"👨🏼\u200d🏭", "man_factory_worker_medium_light_skin_tone",
+	"👨🏼\u200d💻", "man_technologist_medium_light_skin_tone",
+	"👨🏼\u200d💼", "man_office_worker_medium_light_skin_tone",
+	"👨🏼\u200d🔧", "man_mechanic_medium_light_skin_tone",
+	"👨🏼\u200d🔬", "man_scientist_medium_light_skin_tone",
+	"👨🏼\u200d🚀", "man_astronaut_medium_light_skin_tone",
+	"👨🏼\u200d🚒", "man_firefighter_medium_light_skin_tone",
+	"👨🏼\u200d🤝\u200d👨🏻", "men_holding_hands_medium_light_skin_tone_light_skin_tone",
+	"👨🏼\u200d🤝\u200d👨🏽", "men_holding_hands_medium_light_skin_tone_medium_skin_tone",
+	"👨🏼\u200d🤝\u200d👨🏾", "men_holding_hands_medium_light_skin_tone_medium_dark_skin_tone",
+	"👨🏼\u200d🤝\u200d👨🏿", "men_holding_hands_medium_light_skin_tone_dark_skin_tone",
+	"👨🏼\u200d🦯", "man_with_probing_cane_medium_light_skin_tone",
+	"👨🏼\u200d🦰", "man_red_haired_medium_light_skin_tone",
+	"👨🏼\u200d🦱", "man_curly_haired_medium_light_skin_tone",
+	"👨🏼\u200d🦲", "man_bald_medium_light_skin_tone",
+	"👨🏼\u200d🦳", "man_white_haired_medium_light_skin_tone",
+	"👨🏼\u200d🦼", "man_in_motorized_wheelchair_medium_light_skin_tone",
+	"👨🏼\u200d🦽", "man_in_manual_wheelchair_medium_light_skin_tone",
+	"👨🏽", "man_tone3",
+	"👨🏽\u200d⚕️", "man_health_worker_medium_skin_tone",
+	"👨🏽\u200d⚖️", "man_judge_medium_skin_tone",
+	"👨🏽\u200d✈️", "man_pilot_medium_skin_tone",
+	"👨🏽\u200d❤️\u200d👨🏻", "couple_with_heart_man_man_medium_skin_tone_light_skin_tone",
+	"👨🏽\u200d❤️\u200d👨🏼", "couple_with_heart_man_man_medium_skin_tone_medium_light_skin_tone",
+	"👨🏽\u200d❤️\u200d👨🏽", "couple_with_heart_man_man_medium_skin_tone",
+	"👨🏽\u200d❤️\u200d👨🏾", "couple_with_heart_man_man_medium_skin_tone_medium_dark_skin_tone",
+	"👨🏽\u200d❤️\u200d👨🏿", "couple_with_heart_man_man_medium_skin_tone_dark_skin_tone",
+	"👨🏽\u200d❤️\u200d💋\u200d👨🏻", "kiss_man_man_medium_skin_tone_light_skin_tone",
+	"👨🏽\u200d❤️\u200d💋\u200d👨🏼", "kiss_man_man_medium_skin_tone_medium_light_skin_tone",
+	"👨🏽\u200d❤️\u200d💋\u200d👨🏽", "kiss_man_man_medium_skin_tone",
+	"👨🏽\u200d❤️\u200d💋\u200d👨🏾", "kiss_man_man_medium_skin_tone_medium_dark_skin_tone",
+	"👨🏽\u200d❤️\u200d💋\u200d👨🏿", "kiss_man_man_medium_skin_tone_dark_skin_tone",
+	"👨🏽\u200d🌾", "man_farmer_medium_skin_tone",
+	"👨🏽\u200d🍳", "man_cook_medium_skin_tone",
+	"👨🏽\u200d🍼", "man_feeding_baby_medium_skin_tone",
+	"👨🏽\u200d🎓", "man_student_medium_skin_tone",
+	"👨🏽\u200d🎤", "man_singer_medium_skin_tone",
+	"👨🏽\u200d🎨", "man_artist_medium_skin_tone",
+	"👨🏽\u200d🏫", "man_teacher_medium_skin_tone",
+	"👨🏽\u200d🏭", "man_factory_worker_medium_skin_tone",
+	"👨🏽\u200d💻", "man_technologist_medium_skin_tone",
+	"👨🏽\u200d💼", "man_office_worker_medium_skin_tone",
+	"👨🏽\u200d🔧", "man_mechanic_medium_skin_tone",
+	"👨🏽\u200d🔬", "man_scientist_medium_skin_tone",
+	"👨🏽\u200d🚀", "man_astronaut_medium_skin_tone",
+	"👨🏽\u200d🚒", "man_firefighter_medium_skin_tone",
+	"👨🏽\u200d🤝\u200d👨🏻", "men_holding_hands_medium_skin_tone_light_skin_tone",
+	"👨🏽\u200d🤝\u200d👨🏼", "men_holding_hands_medium_skin_tone_medium_light_skin_tone",
+	"👨🏽\u200d🤝\u200d👨🏾", "men_holding_hands_medium_skin_tone_medium_dark_skin_tone",
+	"👨🏽\u200d🤝\u200d👨🏿", "men_holding_hands_medium_skin_tone_dark_skin_tone",
+	"👨🏽\u200d🦯", "man_with_probing_cane_medium_skin_tone",
+	"👨🏽\u200d🦰", "man_red_haired_medium_skin_tone",
+	"👨🏽\u200d🦱", "man_curly_haired_medium_skin_tone",
+	"👨🏽\u200d🦲", "man_bald_medium_skin_tone",
+	"👨🏽\u200d🦳", "man_white_haired_medium_skin_tone",
+	"👨🏽\u200d🦼", "man_in_motorized_wheelchair_medium_skin_tone",
+	"👨🏽\u200d🦽", "man_in_manual_wheelchair_medium_skin_tone",
+	"👨🏾", "man_tone4",
+	"👨🏾\u200d⚕️", "man_health_worker_medium_dark_skin_tone",
+	"👨🏾\u200d⚖️", "man_judge_medium_dark_skin_tone",
+	"👨🏾\u200d✈️", "man_pilot_medium_dark_skin_tone",
+	"👨🏾\u200d❤️\u200d👨🏻", "couple_with_heart_man_man_medium_dark_skin_tone_light_skin_tone",
+	"👨🏾\u200d❤️\u200d👨🏼", "couple_with_heart_man_man_medium_dark_skin_tone_medium_light_skin_tone",
+	"👨🏾\u200d❤️\u200d👨🏽", "couple_with_heart_man_man_medium_dark_skin_tone_medium_skin_tone",
+	"👨🏾\u200d❤️\u200d👨🏾", "couple_with_heart_man_man_medium_dark_skin_tone",
+	"👨🏾\u200d❤️\u200d👨🏿", "couple_with_heart_man_man_medium_dark_skin_tone_dark_skin_tone",
+	"👨🏾\u200d❤️\u200d💋\u200d👨🏻", "kiss_man_man_medium_dark_skin_tone_light_skin_tone",
+	"👨🏾\u200d❤️\u200d💋\u200d👨🏼", "kiss_man_man_medium_dark_skin_tone_medium_light_skin_tone",
+	"👨🏾\u200d❤️\u200d💋\u200d👨🏽", "kiss_man_man_medium_dark_skin_tone_medium_skin_tone",
+	"👨🏾\u200d❤️\u200d💋\u200d👨🏾", "kiss_man_man_medium_dark_skin_tone",
+	"👨🏾\u200d❤️\u200d💋\u200d👨🏿", "kiss_man_man_medium_dark_skin_tone_dark_skin_tone",
+	"👨🏾\u200d🌾", "man_farmer_medium_dark_skin_tone",
+	"👨🏾\u200d🍳", "man_cook_medium_dark_skin_tone",
+	"👨🏾\u200d🍼", "man_feeding_baby_medium_dark_skin_tone",
+	"👨🏾\u200d🎓", "man_student_medium_dark_skin_tone",
+	"👨🏾\u200d🎤", "man_singer_medium_dark_skin_tone",
+	"👨🏾\u200d🎨", "man_artist_medium_dark_skin_tone",
+	"👨🏾\u200d🏫", "man_teacher_medium_dark_skin_tone",
+	"👨🏾\u200d🏭", "man_factory_worker_medium_dark_skin_tone",
+	"👨🏾\u200d💻", "man_technologist_medium_dark_skin_tone",
+	"👨🏾\u200d💼", "man_office_worker_medium_dark_skin_tone",
+	"👨🏾\u200d🔧", "man_mechanic_medium_dark_skin_tone",
+	"👨🏾\u200d🔬", "man_scientist_medium_dark_skin_tone",
+	"👨🏾\u200d🚀", "man_astronaut_medium_dark_skin_tone",
+	"👨🏾\u200d🚒", "man_firefighter_medium_dark_skin_tone",
+	"👨🏾\u200d🤝\u200d👨🏻", "men_holding_hands_medium_dark_skin_tone_light_skin_tone",
+	"👨🏾\u200d🤝\u200d👨🏼", "men_holding_hands_medium_dark_skin_tone_medium_light_skin_tone",
+	"👨🏾\u200d🤝\u200d👨🏽", "men_holding_hands_medium_dark_skin_tone_medium_skin_tone",
+	"👨🏾\u200d🤝\u200d👨🏿", "men_holding_hands_medium_dark_skin_tone_dark_skin_tone",
+	"👨🏾\u200d🦯", "man_with_probing_cane_medium_dark_skin_tone",
+	"👨🏾\u200d🦰", "man_red_haired_medium_dark_skin_tone",
+	"👨🏾\u200d🦱", "man_curly_haired_medium_dark_skin_tone",
+	"👨🏾\u200d🦲", "man_bald_medium_dark_skin_tone",
+	"👨🏾\u200d🦳", "man_white_haired_medium_dark_skin_tone",
+	"👨🏾\u200d🦼", "man_in_motorized_wheelchair_medium_dark_skin_tone",
+	"👨🏾\u200d🦽", "man_in_manual_wheelchair_medium_dark_skin_tone",
+	"👨🏿", "man_tone5",
+	"👨🏿\u200d⚕️", "man_health_worker_dark_skin_tone",
+	"👨🏿\u200d⚖️", "man_judge_dark_skin_tone",
+	"👨🏿\u200d✈️", "man_pilot_dark_skin_tone",
+	"👨🏿\u200d❤️\u200d👨🏻", "couple_with_heart_man_man_dark_skin_tone_light_skin_tone",
+	"👨🏿\u200d❤️\u200d👨🏼", "couple_with_heart_man_man_dark_skin_tone_medium_light_skin_tone",
+	"👨🏿\u200d❤️\u200d👨🏽", "couple_with_heart_man_man_dark_skin_tone_medium_skin_tone",
+	"👨🏿\u200d❤️\u200d👨🏾", "couple_with_heart_man_man_dark_skin_tone_medium_dark_skin_tone",
+	"👨🏿\u200d❤️\u200d👨🏿", "couple_with_heart_man_man_dark_skin_tone",
+	"👨🏿\u200d❤️\u200d💋\u200d👨🏻", "kiss_man_man_dark_skin_tone_light_skin_tone",
+	"👨🏿\u200d❤️\u200d💋\u200d👨🏼", "kiss_man_man_dark_skin_tone_medium_light_skin_tone",
+	"👨🏿\u200d❤️\u200d💋\u200d👨🏽", "kiss_man_man_dark_skin_tone_medium_skin_tone",
+	"👨🏿\u200d❤️\u200d💋\u200d👨🏾", "kiss_man_man_dark_skin_tone_medium_dark_skin_tone",
+	"👨🏿\u200d❤️\u200d💋\u200d👨🏿", "kiss_man_man_dark_skin_tone",
+	"👨🏿\u200d🌾", "man_farmer_dark_skin_tone",
+	"👨🏿\u200d🍳", "man_cook_dark_skin_tone",
+	"👨🏿\u200d🍼", "man_feeding_baby_dark_skin_tone",
+	"👨🏿\u200d🎓", "man_student_dark_skin_tone",
+	"👨🏿\u200d🎤", "man_singer_dark_skin_tone",
+	"👨🏿\u200d🎨", "man_artist_dark_skin_tone",
+	"👨🏿\u200d🏫", "man_teacher_dark_skin_tone",
+	"👨🏿\u200d🏭", "man_factory_worker_dark_skin_tone",
+	"👨🏿\u200d💻", "man_technologist_dark_skin_tone",
+	"👨🏿\u200d💼", "man_office_worker_dark_skin_tone",
+	"👨🏿\u200d🔧", "man_mechanic_dark_skin_tone",
+	"👨🏿\u200d🔬", "man_scientist_dark_skin_tone",
+	"👨🏿\u200d🚀", "man_astronaut_dark_skin_tone",
+	"👨🏿\u200d🚒", "man_firefighter_dark_skin_tone",
+	"👨🏿\u200d🤝\u200d👨🏻", "men_holding_hands_dark_skin_tone_light_skin_tone",
+	"👨🏿\u200d🤝\u200d👨🏼", "men_holding_hands_dark_skin_tone_medium_light_skin_tone",
+	"👨🏿\u200d🤝\u200d👨🏽", "men_holding_hands_dark_skin_tone_medium_skin_tone",
+	"👨🏿\u200d🤝\u200d👨🏾", "men_holding_hands_dark_skin_tone_medium_dark_skin_tone",
+	"👨🏿\u200d🦯", "man_with_probing_cane_dark_skin_tone",
+	"👨🏿\u200d🦰", "man_red_haired_dark_skin_tone",
+	"👨🏿\u200d🦱", "man_curly_haired_dark_skin_tone",
+	"👨🏿\u200d🦲", "man_bald_dark_skin_tone",
+	"👨🏿\u200d🦳", "man_white_haired_dark_skin_tone",
+	"👨🏿\u200d🦼", "man_in_motorized_wheelchair_dark_skin_tone",
+	"👨🏿\u200d🦽", "man_in_manual_wheelchair_dark_skin_tone",
+	"👩", "woman",
+	"👩\u200d⚕️", "woman_health_worker",
+	"👩\u200d⚖️", "woman_judge",
+	"👩\u200d✈️", "woman_pilot",
+	"👩\u200d❤️\u200d👨", "couple_with_heart_woman_man",
+	"👩\u200d❤️\u200d👩", "couple_with_heart_ww",
+	"👩\u200d❤️\u200d💋\u200d👨", "kiss_woman_man",
+	"👩\u200d❤️\u200d💋\u200d👩", "couplekiss_ww",
+	"👩\u200d🌾", "woman_farmer",
+	"👩\u200d🍳", "woman_cook",
+	"👩\u200d🍼", "woman_feeding_baby",
+	"👩\u200d🎓", "woman_student",
+	"👩\u200d🎤", "woman_singer",
+	"👩\u200d🎨", "woman_artist",
+	"👩\u200d🏫", "woman_teacher",
+	"👩\u200d🏭", "woman_factory_worker",
+	"👩\u200d👦", "family_woman_boy",
+	"👩\u200d👦\u200d👦", "family_woman_boy_boy",
+	"👩\u200d👧", "family_woman_girl",
+	"👩\u200d👧\u200d👦", "family_woman_girl_boy",
+	"👩\u200d👧\u200d👧", "family_woman_girl_girl",
+	"👩\u200d👩\u200d👦", "family_wwb",
+	"👩\u200d👩\u200d👦\u200d👦", "family_wwbb",
+	"👩\u200d👩\u200d👧", "family_wwg",
+	"👩\u200d👩\u200d👧\u200d👦", "family_wwgb",
+	"👩\u200d👩\u200d👧\u200d👧", "family_wwgg",
+	"👩\u200d💻", "woman_technologist",
+	"👩\u200d💼", "woman_office_worker",
+	"👩\u200d🔧", "woman_mechanic",
+	"👩\u200d🔬", "woman_scientist",
+	"👩\u200d🚀", "woman_astronaut",
+	"👩\u200d🚒", "woman_firefighter",
+	"👩\u200d🦯", "woman_with_probing_cane",
+	"👩\u200d🦰", "woman_red_haired",
+	"👩\u200d🦱", "woman_curly_haired",
+	"👩\u200d🦲", "woman_bald",
+	"👩\u200d🦳", "woman_white_haired",
+	"👩\u200d🦼", "woman_in_motorized_wheelchair",
+	"👩\u200d🦽", "woman_in_manual_wheelchair",
+	"👩🏻", "woman_tone1",
+	"👩🏻\u200d⚕️", "woman_health_worker_light_skin_tone",
+	"👩🏻\u200d⚖️", "woman_judge_light_skin_tone",
+	"👩🏻\u200d✈️", "woman_pilot_light_skin_tone",
+	"👩🏻\u200d❤️\u200d👨🏻", "couple_with_heart_woman_man_light_skin_tone",
+	"👩🏻\u200d❤️\u200d👨🏼", "couple_with_heart_woman_man_light_skin_tone_medium_light_skin_tone",
+	"👩🏻\u200d❤️\u200d👨🏽", "couple_with_heart_woman_man_light_skin_tone_medium_skin_tone",
+	"👩🏻\u200d❤️\u200d👨🏾", "couple_with_heart_woman_man_light_skin_tone_medium_dark_skin_tone",
+	"👩🏻\u200d❤️\u200d👨🏿", "couple_with_heart_woman_man_light_skin_tone_dark_skin_tone",
+	"👩🏻\u200d❤️\u200d👩🏻", "couple_with_heart_woman_woman_light_skin_tone",
+	"👩🏻\u200d❤️\u200d👩🏼", "couple_with_heart_woman_woman_light_skin_tone_medium_light_skin_tone",
+	"👩🏻\u200d❤️\u200d👩🏽", "couple_with_heart_woman_woman_light_skin_tone_medium_skin_tone",
+	"👩🏻\u200d❤️\u200d👩🏾", "couple_with_heart_woman_woman_light_skin_tone_medium_dark_skin_tone",
+	"👩🏻\u200d❤️\u200d👩🏿", "couple_with_heart_woman_woman_light_skin_tone_dark_skin_tone",
+	"👩🏻\u200d❤️\u200d💋\u200d👨🏻", "kiss_woman_man_light_skin_tone",
+	"👩🏻\u200d❤️\u200d💋\u200d👨🏼", "kiss_woman_man_light_skin_tone_medium_light_skin_tone",
+	"👩🏻\u200d❤️\u200d💋\u200d👨🏽", "kiss_woman_man_light_skin_tone_medium_skin_tone",
+	"👩🏻\u200d❤️\u200d💋\u200d👨🏾", "kiss_woman_man_light_skin_tone_medium_dark_skin_tone",
+	"👩🏻\u200d❤️\u200d💋\u200d👨🏿", "kiss_woman_man_light_skin_tone_dark_skin_tone",
+	"👩🏻\u200d❤️\u200d💋\u200d👩🏻", "kiss_woman_woman_light_skin_tone",
+	"👩🏻\u200d❤️\u200d💋\u200d👩🏼", "kiss_woman_woman_light_skin_tone_medium_light_skin_tone",
+	"👩🏻\u200d❤️\u200d💋\u200d👩🏽", "kiss_woman_woman_light_skin_tone_medium_skin_tone",
+	"👩🏻\u200d❤️\u200d💋\u200d👩🏾", "kiss_woman_woman_light_skin_tone_medium_dark_skin_tone",
+	"👩🏻\u200d❤️\u200d💋\u200d👩🏿", "kiss_woman_woman_light_skin_tone_dark_skin_tone",
+	"👩🏻\u200d🌾", "woman_farmer_light_skin_tone",
+	"👩🏻\u200d🍳", "woman_cook_light_skin_tone",
+	"👩🏻\u200d🍼", "woman_feeding_baby_light_skin_tone",
+	"👩🏻\u200d🎓", "woman_student_light_skin_tone",
+	"👩🏻\u200d🎤", "woman_singer_light_skin_tone",
+	"👩🏻\u200d🎨", "woman_artist_light_skin_tone",
+	"👩🏻\u200d🏫", "woman_teacher_light_skin_tone",
+	"👩🏻\u200d🏭", "woman_factory_worker_light_skin_tone",
+	"👩🏻\u200d💻", "woman_technologist_light_skin_tone",
+	"👩🏻\u200d💼", "woman_office_worker_light_skin_tone",
+	"👩🏻\u200d🔧", "woman_mechanic_light_skin_tone",
+	"👩🏻\u200d🔬", "woman_scientist_light_skin_tone",
+	"👩🏻\u200d🚀", "woman_astronaut_light_skin_tone",
+	"👩🏻\u200d🚒", "woman_firefighter_light_skin_tone",
+	"👩🏻\u200d🤝\u200d👨🏼", "woman_and_man_holding_hands_light_skin_tone_medium_light_skin_tone",
+	"👩🏻\u200d🤝\u200d👨🏽", "woman_and_man_holding_hands_light_skin_tone_medium_skin_tone",
+	"👩🏻\u200d🤝\u200d👨🏾", "woman_and_man_holding_hands_light_skin_tone_medium_dark_skin_tone",
+	"👩🏻\u200d🤝\u200d👨🏿", "woman_and_man_holding_hands_light_skin_tone_dark_skin_tone",
+	"👩🏻\u200d🤝\u200d👩🏼", "women_holding_hands_light_skin_tone_medium_light_skin_tone",
+	"👩🏻\u200d🤝\u200d👩🏽", "women_holding_hands_light_skin_tone_medium_skin_tone",
+	"👩🏻\u200d🤝\u200d👩🏾", "women_holding_hands_light_skin_tone_medium_dark_skin_tone",
+	"👩🏻\u200d🤝\u200d👩🏿", "women_holding_hands_light_skin_tone_dark_skin_tone",
+	"👩🏻\u200d🦯", "woman_with_probing_cane_light_skin_tone",
+	"👩🏻\u200d🦰", "woman_red_haired_light_skin_tone",
+	"👩🏻\u200d🦱", "woman_curly_haired_light_skin_tone",
+	"👩🏻\u200d🦲", "woman_bald_light_skin_tone",
+	"👩🏻\u200d🦳", "woman_white_haired_light_skin_tone",
+	"👩🏻\u200d🦼", "woman_in_motorized_wheelchair_light_skin_tone",
+	"👩🏻\u200d🦽", "woman_in_manual_wheelchair_light_skin_tone",
+	"👩🏼", "woman_tone2",
+	"👩🏼\u200d⚕️", "woman_health_worker_medium_light_skin_tone",
+	"👩🏼\u200d⚖️", "woman_judge_medium_light_skin_tone",
+	"👩🏼\u200d✈️", "woman_pilot_medium_light_skin_tone",
+	"👩🏼\u200d❤️\u200d👨🏻", "couple_with_heart_woman_man_medium_light_skin_tone_light_skin_tone",
+	"👩🏼\u200d❤️\u200d👨🏼", "couple_with_heart_woman_man_medium_light_skin_tone",
+	"👩🏼\u200d❤️\u200d👨🏽", "couple_with_heart_woman_man_medium_light_skin_tone_medium_skin_tone",
+	"👩🏼\u200d❤️\u200d👨🏾", "couple_with_heart_woman_man_medium_light_skin_tone_medium_dark_skin_tone",
+	"👩🏼\u200d❤️\u200d👨🏿", "couple_with_heart_woman_man_medium_light_skin_tone_dark_skin_tone",
+	"👩🏼\u200d❤️\u200d👩🏻", "couple_with_heart_woman_woman_medium_light_skin_tone_light_skin_tone",
+	"👩🏼\u200d❤️\u200d👩🏼", "couple_with_heart_woman_woman_medium_light_skin_tone",
+	"👩🏼\u200d❤️\u200d👩🏽", "couple_with_heart_woman_woman_medium_light_skin_tone_medium_skin_tone",
+	"👩🏼\u200d❤️\u200d👩🏾", "couple_with_heart_woman_woman_medium_light_skin_tone_medium_dark_skin_tone",
+	"👩🏼\u200d❤️\u200d👩🏿", "couple_with_heart_woman_woman_medium_light_skin_tone_dark_skin_tone",
+	"👩🏼\u200d❤️\u200d💋\u200d👨🏻", "kiss_woman_man_medium_light_skin_tone_light_skin_tone",
+	"👩🏼\u200d❤️\u200d💋\u200d👨🏼", "kiss_woman_man_medium_light_skin_tone",
+	"👩🏼\u200d❤️\u200d💋\u200d👨🏽", "kiss_woman_man_medium_light_skin_tone_medium_skin_tone",
+	"👩🏼\u200d❤️\u200d💋\u200d👨🏾", "kiss_woman_man_medium_light_skin_tone_medium_dark_skin_tone",
+	"👩🏼\u200d❤️\u200d💋\u200d👨🏿", "kiss_woman_man_medium_light_skin_tone_dark_skin_tone",
+	"👩🏼\u200d❤️\u200d💋\u200d👩🏻", "kiss_woman_woman_medium_light_skin_tone_light_skin_tone",
+	"👩🏼\u200d❤️\u200d💋\u200d👩🏼", "kiss_woman_woman_medium_light_skin_tone",
+	"👩🏼\u200d❤️\u200d💋\u200d👩🏽", "kiss_woman_woman_medium_light_skin_tone_medium_skin_tone",
+	"👩🏼\u200d❤️\u200d💋\u200d👩🏾", "kiss_woman_woman_medium_light_skin_tone_medium_dark_skin_tone",
+	"👩🏼\u200d❤️\u200d💋\u200d👩🏿", "kiss_woman_woman_medium_light_skin_tone_dark_skin_tone",
+	"👩🏼\u200d🌾", "woman_farmer_medium_light_skin_tone",
+	"👩🏼\u200d🍳", "woman_cook_medium_light_skin_tone",
+	"👩🏼\u200d🍼", "woman_feeding_baby_medium_light_skin_tone",
+	"👩🏼\u200d🎓", "woman_student_medium_light_skin_tone",
+	"👩🏼\u200d🎤", "woman_singer_medium_light_skin_tone",
+	"👩🏼\u200d🎨", "woman_artist_medium_light_skin_tone",
+	"👩🏼\u200d🏫", "woman_teacher_medium_light_skin_tone",
+	"👩🏼\u200d🏭", "woman_factory_worker_medium_light_skin_tone",
+	"👩🏼\u200d💻", "woman_technologist_medium_light_skin_tone",
+	"👩🏼\u200d💼", "woman_office_worker_medium_light_skin_tone",
+	"👩🏼\u200d🔧", "woman_mechanic_medium_light_skin_tone",
+	"👩🏼\u200d🔬", "woman_scientist_medium_light_skin_tone",
+	"👩🏼\u200d🚀", "woman_astronaut_medium_light_skin_tone",
+	"👩🏼\u200d🚒", "woman_firefighter_medium_light_skin_tone",
+	"👩🏼\u200d🤝\u200d👨🏻", "woman_and_man_holding_hands_medium_light_skin_tone_light_skin_tone",
+	"👩🏼\u200d🤝\u200d👨🏽", "woman_and_man_holding_hands_medium_light_skin_tone_medium_skin_tone",
+	"👩🏼\u200d🤝\u200d👨🏾", "woman_and_man_holding_hands_medium_light_skin_tone_medium_dark_skin_tone",
+	"👩🏼\u200d🤝\u200d👨🏿", "woman_and_man_holding_hands_medium_light_skin_tone_dark_skin_tone",
+	"👩🏼\u200d🤝\u200d👩🏻", "women_holding_hands_medium_light_skin_tone_light_skin_tone",
+	"👩🏼\u200d🤝\u200d👩🏽", "women_holding_hands_medium_light_skin_tone_medium_skin_tone",
+	"👩🏼\u200d🤝\u200d👩🏾", "women_holding_hands_medium_light_skin_tone_medium_dark_skin_tone",
+	"👩🏼\u200d🤝\u200d👩🏿", "women_holding_hands_medium_light_skin_tone_dark_skin_tone",
+	"👩🏼\u200d🦯", "woman_with_probing_cane_medium_light_skin_tone",
+	"👩🏼\u200d🦰", "woman_red_haired_medium_light_skin_tone",
+	"👩🏼\u200d🦱", "woman_curly_haired_medium_light_skin_tone",
+	"👩🏼\u200d🦲", "woman_bald_medium_light_skin_tone",
+	"👩🏼\u200d🦳", "woman_white_haired_medium_light_skin_tone",
+	"👩🏼\u200d🦼", "woman_in_motorized_wheelchair_medium_light_skin_tone",
+	"👩🏼\u200d🦽", "woman_in_manual_wheelchair_medium_light_skin_tone",
+	"👩🏽", "woman_tone3",
+	"👩🏽\u200d⚕️", "woman_health_worker_medium_skin_tone",
+	"👩🏽\u200d⚖️", "woman_judge_medium_skin_tone",
+	"👩🏽\u200d✈️", "woman_pilot_medium_skin_tone",
+	"👩🏽\u200d❤️\u200d👨🏻", "couple_with_heart_woman_man_medium_skin_tone_light_skin_tone",
+	"👩🏽\u200d❤️\u200d👨🏼", "couple_with_heart_woman_man_medium_skin_tone_medium_light_skin_tone",
+	"👩🏽\u200d❤️\u200d👨🏽", "couple_with_heart_woman_man_medium_skin_tone",
+	"👩🏽\u200d❤️\u200d👨🏾", "couple_with_heart_woman_man_medium_skin_tone_medium_dark_skin_tone",
+	"👩🏽\u200d❤️\u200d👨🏿", "couple_with_heart_woman_man_medium_skin_tone_dark_skin_tone",
+	"👩🏽\u200d❤️\u200d👩🏻", "couple_with_heart_woman_woman_medium_skin_tone_light_skin_tone",
+	"👩🏽\u200d❤️\u200d👩🏼", "couple_with_heart_woman_woman_medium_skin_tone_medium_light_skin_tone",
+	"👩🏽\u200d❤️\u200d👩🏽", "couple_with_heart_woman_woman_medium_skin_tone",
+	"👩🏽\u200d❤️\u200d👩🏾", "couple_with_heart_woman_woman_medium_skin_tone_medium_dark_skin_tone",
+	"👩🏽\u200d❤️\u200d👩🏿", "couple_with_heart_woman_woman_medium_skin_tone_dark_skin_tone",
+	"👩🏽\u200d❤️\u200d💋\u200d👨🏻", "kiss_woman_man_medium_skin_tone_light_skin_tone",
+	"👩🏽\u200d❤️\u200d💋\u200d👨🏼", "kiss_woman_man_medium_skin_tone_medium_light_skin_tone",
+	"👩🏽\u200d❤️\u200d💋\u200d👨🏽", "kiss_woman_man_medium_skin_tone",
+	"👩🏽\u200d❤️\u200d💋\u200d👨🏾", "kiss_woman_man_medium_skin_tone_medium_dark_skin_tone",
+	"👩🏽\u200d❤️\u200d💋\u200d👨🏿", "kiss_woman_man_medium_skin_tone_dark_skin_tone",
+	"👩🏽\u200d❤️\u200d💋\u200d👩🏻", "kiss_woman_woman_medium_skin_tone_light_skin_tone",
+	"👩🏽\u200d❤️\u200d💋\u200d👩🏼", "kiss_woman_woman_medium_skin_tone_medium_light_skin_tone",
+	"👩🏽\u200d❤️\u200d💋\u200d👩🏽", "kiss_woman_woman_medium_skin_tone",
+	"👩🏽\u200d❤️\u200d💋\u200d👩🏾", "kiss_woman_woman_medium_skin_tone_medium_dark_skin_tone",
+	"👩🏽\u200d❤️\u200d💋\u200d👩🏿", "kiss_woman_woman_medium_skin_tone_dark_skin_tone",
+	"👩🏽\u200d🌾", "woman_farmer_medium_skin_tone",
+	"👩🏽\u200d🍳", "woman_cook_medium_skin_tone",
+	"👩🏽\u200d🍼", "woman_feeding_baby_medium_skin_tone",
+	"👩🏽\u200d🎓", "woman_student_medium_skin_tone",
+	"👩🏽\u200d🎤", "woman_singer_medium_skin_tone",
+	"👩🏽\u200d🎨", "woman_artist_medium_skin_tone",
+	"👩🏽\u200d🏫", "woman_teacher_medium_skin_tone",
+	"👩🏽\u200d🏭", "woman_factory_worker_medium_skin_tone",
+	"👩🏽\u200d💻", "woman_technologist_medium_skin_tone",
+	"👩🏽\u200d💼", "woman_office_worker_medium_skin_tone",
+	"👩🏽\u200d🔧", "woman_mechanic_medium_skin_tone",
+	"👩🏽\u200d🔬", "woman_scientist_medium_skin_tone",
+	"👩🏽\u200d🚀", "woman_astronaut_medium_skin_tone",
+	"👩🏽\u200d🚒", "woman_firefighter_medium_skin_tone",
+	"👩🏽\u200d🤝\u200d👨🏻", "woman_and_man_holding_hands_medium_skin_tone_light_skin_tone",
+	"👩🏽\u200d🤝\u200d👨🏼", "woman_and_man_holding_hands_medium_skin_tone_medium_light_skin_tone",
+	"👩🏽\u200d🤝\u200d👨🏾", "woman_and_man_holding_hands_medium_skin_tone_medium_dark_skin_tone",
+	"👩🏽\u200d🤝\u200d👨🏿", "woman_and_man_holding_hands_medium_skin_tone_dark_skin_tone",
+	"👩🏽\u200d🤝\u200d👩🏻", "women_holding_hands_medium_skin_tone_light_skin_tone",
+	"👩🏽\u200d🤝\u200d👩🏼", "women_holding_hands_medium_skin_tone_medium_light_skin_tone",
+	"👩🏽\u200d🤝\u200d👩🏾", "women_holding_hands_medium_skin_tone_medium_dark_skin_tone",
+	"👩🏽\u200d🤝\u200d👩🏿", "women_holding_hands_medium_skin_tone_dark_skin_tone",
+	"👩🏽\u200d🦯", "woman_with_probing_cane_medium_skin_tone",
+	"👩🏽\u200d🦰", "woman_red_haired_medium_skin_tone",
+	"👩🏽\u200d🦱", "woman_curly_haired_medium_skin_tone",
+	"👩🏽\u200d🦲", "woman_bald_medium_skin_tone",
+	"👩🏽\u200d🦳", "woman_white_haired_medium_skin_tone",
+	"👩🏽\u200d🦼", "woman_in_motorized_wheelchair_medium_skin_tone",
+	"👩🏽\u200d🦽", "woman_in_manual_wheelchair_medium_skin_tone",
+	"👩🏾", "woman_tone4",
+	"👩🏾\u200d⚕️", "woman_health_worker_medium_dark_skin_tone",
+	"👩🏾\u200d⚖️", "woman_judge_medium_dark_skin_tone",
+	"👩🏾\u200d✈️", "woman_pilot_medium_dark_skin_tone",
+	"👩🏾\u200d❤️\u200d👨🏻", "couple_with_heart_woman_man_medium_dark_skin_tone_light_skin_tone",
+	"👩🏾\u200d❤️\u200d👨🏼", "couple_with_heart_woman_man_medium_dark_skin_tone_medium_light_skin_tone",
+	"👩🏾\u200d❤️\u200d👨🏽", "couple_with_heart_woman_man_medium_dark_skin_tone_medium_skin_tone",
+	"👩🏾\u200d❤️\u200d👨🏾", "couple_with_heart_woman_man_medium_dark_skin_tone",
+	"👩🏾\u200d❤️\u200d👨🏿", "couple_with_heart_woman_man_medium_dark_skin_tone_dark_skin_tone",
+	"👩🏾\u200d❤️\u200d👩🏻", "couple_with_heart_woman_woman_medium_dark_skin_tone_light_skin_tone",
+	"👩🏾\u200d❤️\u200d👩🏼", "couple_with_heart_woman_woman_medium_dark_skin_tone_medium_light_skin_tone",
+	"👩🏾\u200d❤️\u200d👩🏽", "couple_with_heart_woman_woman_medium_dark_skin_tone_medium_skin_tone",
+	"👩🏾\u200d❤️\u200d👩🏾", "couple_with_heart_woman_woman_medium_dark_skin_tone",
+	"👩🏾\u200d❤️\u200d👩🏿", "couple_with_heart_woman_woman_medium_dark_skin_tone_dark_skin_tone",
+	"👩🏾\u200d❤️\u200d💋\u200d👨🏻", "kiss_woman_man_medium_dark_skin_tone_light_skin_tone",
+	"👩🏾\u200d❤️\u200d💋\u200d👨🏼", "kiss_woman_man_medium_dark_skin_tone_medium_light_skin_tone",
+	"👩🏾\u200d❤️\u200d💋\u200d👨🏽", "kiss_woman_man_medium_dark_skin_tone_medium_skin_tone",
+	"👩🏾\u200d❤️\u200d💋\u200d👨🏾", "kiss_woman_man_medium_dark_skin_tone",
+	"👩🏾\u200d❤️\u200d💋\u200d👨🏿", "kiss_woman_man_medium_dark_skin_tone_dark_skin_tone",
+	"👩🏾\u200d❤️\u200d💋\u200d👩🏻", "kiss_woman_woman_medium_dark_skin_tone_light_skin_tone",
+	"👩🏾\u200d❤️\u200d💋\u200d👩🏼", "kiss_woman_woman_medium_dark_skin_tone_medium_light_skin_tone",
+	"👩🏾\u200d❤️\u200d💋\u200d👩🏽", "kiss_woman_woman_medium_dark_skin_tone_medium_skin_tone",
+	"👩🏾\u200d❤️\u200d💋\u200d👩🏾", "kiss_woman_woman_medium_dark_skin_tone",
+	"👩🏾\u200d❤️\u200d💋\u200d👩🏿", "kiss_woman_woman_medium_dark_skin_tone_dark_skin_tone",
+	"👩🏾\u200d🌾", "woman_farmer_medium_dark_skin_tone",
+	"👩🏾\u200d🍳", "woman_cook_medium_dark_skin_tone",
+	"👩🏾\u200d🍼", "woman_feeding_baby_medium_dark_skin_tone",
+	"👩🏾\u200d🎓", "woman_student_medium_dark_skin_tone",
+	"👩🏾\u200d🎤", "woman_singer_medium_dark_skin_tone",
+	"👩🏾\u200d🎨", "woman_artist_medium_dark_skin_tone",
+	"👩🏾\u200d🏫", "woman_teacher_medium_dark_skin_tone",
+	"👩🏾\u200d🏭", "woman_factory_worker_medium_dark_skin_tone",
+	"👩🏾\u200d💻", "woman_technologist_medium_dark_skin_tone",
+	"👩🏾\u200d💼", "woman_office_worker_medium_dark_skin_tone",
+	"👩🏾\u200d🔧", "woman_mechanic_medium_dark_skin_tone",
+	"👩🏾\u200d🔬", "woman_scientist_medium_dark_skin_tone",
+	"👩🏾\u200d🚀", "woman_astronaut_medium_dark_skin_tone",
+	"👩🏾\u200d🚒", "woman_firefighter_medium_dark_skin_tone",
+	"👩🏾\u200d🤝\u200d👨🏻", "woman_and_man_holding_hands_medium_dark_skin_tone_light_skin_tone",
+	"👩🏾\u200d🤝\u200d👨🏼", "woman_and_man_holding_hands_medium_dark_skin_tone_medium_light_skin_tone",
+	"👩🏾\u200d🤝\u200d👨🏽", "woman_and_man_holding_hands_medium_dark_skin_tone_medium_skin_tone",
+	"👩🏾\u200d🤝\u200d👨🏿", "woman_and_man_holding_hands_medium_dark_skin_tone_dark_skin_tone",
+	"👩🏾\u200d🤝\u200d👩🏻", "women_holding_hands_medium_dark_skin_tone_light_skin_tone",
+	"👩🏾\u200d🤝\u200d👩🏼", "women_holding_hands_medium_dark_skin_tone_medium_light_skin_tone",
+	"👩🏾\u200d🤝\u200d👩🏽", "women_holding_hands_medium_dark_skin_tone_medium_skin_tone",
+	"👩🏾\u200d🤝\u200d👩🏿", "women_holding_hands_medium_dark_skin_tone_dark_skin_tone",
+	"👩🏾\u200d🦯", "woman_with_probing_cane_medium_dark_skin_tone",
+	"👩🏾\u200d🦰", "woman_red_haired_medium_dark_skin_tone",
+	"👩🏾\u200d🦱", "woman_curly_haired_medium_dark_skin_tone",
+	"👩🏾\u200d🦲", "woman_bald_medium_dark_skin_tone",
+	"👩🏾\u200d🦳", "woman_white_haired_medium_dark_skin_tone",
+	"👩🏾\u200d🦼", "woman_in_motorized_wheelchair_medium_dark_skin_tone",
+	"👩🏾\u200d🦽", "woman_in_manual_wheelchair_medium_dark_skin_tone",
+	"👩🏿", "woman_tone5",
+	"👩🏿\u200d⚕️", "woman_health_worker_dark_skin_tone",
+	"👩🏿\u200d⚖️", "woman_judge_dark_skin_tone",
+	"👩🏿\u200d✈️", "woman_pilot_dark_skin_tone",
+	"👩🏿\u200d❤️\u200d👨🏻", "couple_with_heart_woman_man_dark_skin_tone_light_skin_tone",
+	"👩🏿\u200d❤️\u200d👨🏼", "couple_with_heart_woman_man_dark_skin_tone_medium_light_skin_tone",
+	"👩🏿\u200d❤️\u200d👨🏽", "couple_with_heart_woman_man_dark_skin_tone_medium_skin_tone",
+	"👩🏿\u200d❤️\u200d👨🏾", "couple_with_heart_woman_man_dark_skin_tone_medium_dark_skin_tone",
+	"👩🏿\u200d❤️\u200d👨🏿", "couple_with_heart_woman_man_dark_skin_tone",
+	"👩🏿\u200d❤️\u200d👩🏻", "couple_with_heart_woman_woman_dark_skin_tone_light_skin_tone",
+	"👩🏿\u200d❤️\u200d👩🏼", "couple_with_heart_woman_woman_dark_skin_tone_medium_light_skin_tone",
+	"👩🏿\u200d❤️\u200d👩🏽", "couple_with_heart_woman_woman_dark_skin_tone_medium_skin_tone",
+	"👩🏿\u200d❤️\u200d👩🏾", "couple_with_heart_woman_woman_dark_skin_tone_medium_dark_skin_tone",
+	"👩🏿\u200d❤️\u200d👩🏿", "couple_with_heart_woman_woman_dark_skin_tone",
+	"👩🏿\u200d❤️\u200d💋\u200d👨🏻", "kiss_woman_man_dark_skin_tone_light_skin_tone",
+	"👩🏿\u200d❤️\u200d💋\u200d👨🏼", "kiss_woman_man_dark_skin_tone_medium_light_skin_tone",
+	"👩🏿\u200d❤️\u200d💋\u200d👨🏽", "kiss_woman_man_dark_skin_tone_medium_skin_tone",
+	"👩🏿\u200d❤️\u200d💋\u200d👨🏾", "kiss_woman_man_dark_skin_tone_medium_dark_skin_tone",
+	"👩🏿\u200d❤️\u200d💋\u200d👨🏿", "kiss_woman_man_dark_skin_tone",
+	"👩🏿\u200d❤️\u200d💋\u200d👩🏻", "kiss_woman_woman_dark_skin_tone_light_skin_tone",
+	"👩🏿\u200d❤️\u200d💋\u200d👩🏼", "kiss_woman_woman_dark_skin_tone_medium_light_skin_tone",
+	"👩🏿\u200d❤️\u200d💋\u200d👩🏽", "kiss_woman_woman_dark_skin_tone_medium_skin_tone",
+	"👩🏿\u200d❤️\u200d💋\u200d👩🏾", "kiss_woman_woman_dark_skin_tone_medium_dark_skin_tone",
+	"👩🏿\u200d❤️\u200d💋\u200d👩🏿", "kiss_woman_woman_dark_skin_tone",
+	"👩🏿\u200d🌾", "woman_farmer_dark_skin_tone",
+	"👩🏿\u200d🍳", "woman_cook_dark_skin_tone",
+	"👩🏿\u200d🍼", "woman_feeding_baby_dark_skin_tone",
+	"👩🏿\u200d🎓", "woman_student_dark_skin_tone",
+	"👩🏿\u200d🎤", "woman_singer_dark_skin_tone",
+	"👩🏿\u200d🎨", "woman_artist_dark_skin_tone",
+	"👩🏿\u200d🏫", "woman_teacher_dark_skin_tone",
+	"👩🏿\u200d🏭", "woman_factory_worker_dark_skin_tone",
+	"👩🏿\u200d💻", "woman_technologist_dark_skin_tone",
+	"👩🏿\u200d💼", "woman_office_worker_dark_skin_tone",
+	"👩🏿\u200d🔧", "woman_mechanic_dark_skin_tone",
+	"👩🏿\u200d🔬", "woman_scientist_dark_skin_tone",
+	"👩🏿\u200d🚀", "woman_astronaut_dark_skin_tone",
+	"👩🏿\u200d🚒", "woman_firefighter_dark_skin_tone",
+	"👩🏿\u200d🤝\u200d👨🏻", "woman_and_man_holding_hands_dark_skin_tone_light_skin_tone",
+	"👩🏿\u200d🤝\u200d👨🏼", "woman_and_man_holding_hands_dark_skin_tone_medium_light_skin_tone",
+	"👩🏿\u200d🤝\u200d👨🏽", "woman_and_man_holding_hands_dark_skin_tone_medium_skin_tone",
+	"👩🏿\u200d🤝\u200d👨🏾", "woman_and_man_holding_hands_dark_skin_tone_medium_dark_skin_tone",
+	"👩🏿\u200d🤝\u200d👩🏻", "women_holding_hands_dark_skin_tone_light_skin_tone",
+	"👩🏿\u200d🤝\u200d👩🏼", "women_holding_hands_dark_skin_tone_medium_light_skin_tone",
+	"👩🏿\u200d🤝\u200d👩🏽", "women_holding_hands_dark_skin_tone_medium_skin_tone",
+	"👩🏿\u200d🤝\u200d👩🏾", "women_holding_hands_dark_skin_tone_medium_dark_skin_tone",
+	"👩🏿\u200d🦯", "woman_with_probing_cane_dark_skin_tone",
+	"👩🏿\u200d🦰", "woman_red_haired_dark_skin_tone",
+	"👩🏿\u200d🦱", "woman_curly_haired_dark_skin_tone",
+	"👩🏿\u200d🦲", "woman_bald_dark_skin_tone",
+	"👩🏿\u200d🦳", "woman_white_haired_dark_skin_tone",
+	"👩🏿\u200d🦼", "woman_in_motorized_wheelchair_dark_skin_tone",
+	"👩🏿\u200d🦽", "woman_in_manual_wheelchair_dark_skin_tone",
+	"👪", "family",
+	"👫", "couple",
+	"👫🏻", "woman_and_man_holding_hands_light_skin_tone",
+	"👫🏼", "woman_and_man_holding_hands_medium_light_skin_tone",
+	"👫🏽", "woman_and_man_holding_hands_medium_skin_tone",
+	"👫🏾", "woman_and_man_holding_hands_medium_dark_skin_tone",
+	"👫🏿", "woman_and_man_holding_hands_dark_skin_tone",
+	"👬", "two_men_holding_hands",
+	"👬🏻", "men_holding_hands_light_skin_tone",
+	"👬🏼", "men_holding_hands_medium_light_skin_tone",
+	"👬🏽", "men_holding_hands_medium_skin_tone",
+	"👬🏾", "men_holding_hands_medium_dark_skin_tone",
+	"👬🏿", "men_holding_hands_dark_skin_tone",
+	"👭", "two_women_holding_hands",
+	"👭🏻", "women_holding_hands_light_skin_tone",
+	"👭🏼", "women_holding_hands_medium_light_skin_tone",
+	"👭🏽", "women_holding_hands_medium_skin_tone",
+	"👭🏾", "women_holding_hands_medium_dark_skin_tone",
+	"👭🏿", "women_holding_hands_dark_skin_tone",
+	"👮", "cop",
+	"👮\u200d♀️", "woman_police_officer",
+	"👮\u200d♂️", "man_police_officer",
+	"👮🏻", "cop_tone1",
+	"👮🏻\u200d♀️", "woman_police_officer_light_skin_tone",
+	"👮🏻\u200d♂️", "man_police_officer_light_skin_tone",
+	"👮🏼", "cop_tone2",
+	"👮🏼\u200d♀️", "woman_police_officer_medium_light_skin_tone",
+	"👮🏼\u200d♂️", "man_police_officer_medium_light_skin_tone",
+	"👮🏽", "cop_tone3",
+	"👮🏽\u200d♀️", "woman_police_officer_medium_skin_tone",
+	"👮🏽\u200d♂️", "man_police_officer_medium_skin_tone",
+	"👮🏾", "cop_tone4",
+	"👮🏾\u200d♀️", "woman_police_officer_medium_dark_skin_tone",
+	"👮🏾\u200d♂️", "man_police_officer_medium_dark_skin_tone",
+	"👮🏿", "cop_tone5",
+	"👮🏿\u200d♀️", "woman_police_officer_dark_skin_tone",
+	"👮🏿\u200d♂️", "man_police_officer_dark_skin_tone",
+	"👯", "dancers",
+	"👯\u200d♀️", "women_with_bunny_ears_partying",
+	"👯\u200d♂️", "men_with_bunny_ears_partying",
+	"👰", "person_with_veil",
+	"👰\u200d♀️", "bride_with_veil",
+	"👰\u200d♂️", "man_with_veil",
+	"👰🏻", "person_with_veil_tone1",
+	"👰🏻\u200d♀️", "woman_with_veil_light_skin_tone",
+	"👰🏻\u200d♂️", "man_with_veil_light_skin_tone",
+	"👰🏼", "person_with_veil_tone2",
+	"👰🏼\u200d♀️", "woman_with_veil_medium_light_skin_tone",
+	"👰🏼\u200d♂️", "man_with_veil_medium_light_skin_tone",
+	"👰🏽", "person_with_veil_tone3",
+	"👰🏽\u200d♀️", "woman_with_veil_medium_skin_tone",
+	"👰🏽\u200d♂️", "man_with_veil_medium_skin_tone",
+	"👰🏾", "person_with_veil_tone4",
+	"👰🏾\u200d♀️", "woman_with_veil_medium_dark_skin_tone",
+	"👰🏾\u200d♂️", "man_with_veil_medium_dark_skin_tone",
+	"👰🏿", "person_with_veil_tone5",
+	"👰🏿\u200d♀️", "woman_with_veil_dark_skin_tone",
+	"👰🏿\u200d♂️", "man_with_veil_dark_skin_tone",
+	"👱", "person_with_blond_hair",
+	"👱\u200d♀️", "blond_haired_woman",
+	"👱\u200d♂️", "blond_haired_man",
+	"👱🏻", "person_with_blond_hair_tone1",
+	"👱🏻\u200d♀️", "blond_haired_woman_light_skin_tone",
+	"👱🏻\u200d♂️", "blond_haired_man_light_skin_tone",
+	"👱🏼", "person_with_blond_hair_tone2",
+	"👱🏼\u200d♀️", "blond_haired_woman_medium_light_skin_tone",
+	"👱🏼\u200d♂️", "blond_haired_man_medium_light_skin_tone",
+	"👱🏽", "person_with_blond_hair_tone3",
+	"👱🏽\u200d♀️", "blond_haired_woman_medium_skin_tone",
+	"👱🏽\u200d♂️", "blond_haired_man_medium_skin_tone",
+	"👱🏾", "person_with_blond_hair_tone4",
+	"👱🏾\u200d♀️", "blond_haired_woman_medium_dark_skin_tone",
+	"👱🏾\u200d♂️", "blond_haired_man_medium_dark_skin_tone",
+	"👱🏿", "person_with_blond_hair_tone5",
+	"👱🏿\u200d♀️", "blond_haired_woman_dark_skin_tone",
+	"👱🏿\u200d♂️", "blond_haired_man_dark_skin_tone",
+	"👲", "man_with_gua_pi_mao",
+	"👲🏻", "man_with_gua_pi_mao_tone1",
+	"👲🏼", "man_with_gua_pi_mao_tone2",
+	"👲🏽", "man_with_gua_pi_mao_tone3",
+	"👲🏾", "man_with_gua_pi_mao_tone4",
+	"👲🏿", "man_with_gua_pi_mao_tone5",
+	"👳", "man_with_turban",
+	"👳\u200d♀️", "woman_wearing_turban",
+	"👳\u200d♂️", "man_wearing_turban",
+	"👳🏻", "man_with_turban_tone1",
+	"👳🏻\u200d♀️", "woman_wearing_turban_light_skin_tone",
+	"👳🏻\u200d♂️", "man_wearing_turban_light_skin_tone",
+	"👳🏼", "man_with_turban_tone2",
+	"👳🏼\u200d♀️", "woman_wearing_turban_medium_light_skin_tone",
+	"👳🏼\u200d♂️", "man_wearing_turban_medium_light_skin_tone",
+	"👳🏽", "man_with_turban_tone3",
+	"👳🏽\u200d♀️", "woman_wearing_turban_medium_skin_tone",
+	"👳🏽\u200d♂️", "man_wearing_turban_medium_skin_tone",
+	"👳🏾", "man_with_turban_tone4",
+	"👳🏾\u200d♀️", "woman_wearing_turban_medium_dark_skin_tone",
+	"👳🏾\u200d♂️", "man_wearing_turban_medium_dark_skin_tone",
+	"👳🏿", "man_with_turban_tone5",
+	"👳🏿\u200d♀️", "woman_wearing_turban_dark_skin_tone",
+	"👳🏿\u200d♂️", "man_wearing_turban_dark_skin_tone",
+	"👴", "older_man",
+	"👴🏻", "older_man_tone1",
+	"👴🏼", "older_man_tone2",
+	"👴🏽", "older_man_tone3",
+	"👴🏾", "older_man_tone4",
+	"👴🏿", "older_man_tone5",
+	"👵", "grandma",
+	"👵🏻", "grandma_tone1",
+	"👵🏼", "grandma_tone2",
+	"👵🏽", "grandma_tone3",
+	"👵🏾", "grandma_tone4",
+	"👵🏿", "grandma_tone5",
+	"👶", "baby",
+	"👶🏻", "baby_tone1",
+	"👶🏼", "baby_tone2",
+	"👶🏽", "baby_tone3",
+	"👶🏾", "baby_tone4",
+	"👶🏿", "baby_tone5",
+	"👷", "construction_worker",
+	"👷\u200d♀️", "woman_construction_worker",
+	"👷\u200d♂️", "man_construction_worker",
+	"👷🏻", "construction_worker_tone1",
+	"👷🏻\u200d♀️", "woman_construction_worker_light_skin_tone",
+	"👷🏻\u200d♂️", "man_construction_worker_light_skin_tone",
+	"👷🏼", "construction_worker_tone2",
+	"👷🏼\u200d♀️", "woman_construction_worker_medium_light_skin_tone",
+	"👷🏼\u200d♂️", "man_construction_worker_medium_light_skin_tone",
+	"👷🏽", "construction_worker_tone3",
+	"👷🏽\u200d♀️", "woman_construction_worker_medium_skin_tone",
+	"👷🏽\u200d♂️", "man_construction_worker_medium_skin_tone",
+	"👷🏾", "construction_worker_tone4",
+	"👷🏾\u200d♀️", "woman_construction_worker_medium_dark_skin_tone",
+	"👷🏾\u200d♂️", "man_construction_worker_medium_dark_skin_tone",
+	"👷🏿", "construction_worker_tone5",
+	"👷🏿\u200d♀️", "woman_construction_worker_dark_skin_tone",
+	"👷🏿\u200d♂️", "man_construction_worker_dark_skin_tone",
+	"👸", "princess",
+	"👸🏻", "princess_tone1",
+	"👸🏼", "princess_tone2",
+	"👸🏽", "princess_tone3",
+	"👸🏾", "princess_tone4",
+	"👸🏿", "princess_tone5",
+	"👹", "japanese_ogre",
+	"👺", "japanese_goblin",
+	"👻", "ghost",
+	"👼", "angel",
+	"👼🏻", "angel_tone1",
+	"👼🏼", "angel_tone2",
+	"👼🏽", "angel_tone3",
+	"👼🏾", "angel_tone4",
+	"👼🏿", "angel_tone5",
+	"👽", "alien",
+	"👾", "space_invader",
+	"👿", "imp",
+	"💀", "skeleton",
+	"💁", "information_desk_person",
+	"💁\u200d♀️", "woman_tipping_hand",
+	"💁\u200d♂️", "man_tipping_hand",
+	"💁🏻", "information_desk_person_tone1",
+	"💁🏻\u200d♀️", "woman_tipping_hand_light_skin_tone",
+	"💁🏻\u200d♂️", "man_tipping_hand_light_skin_tone",
+	"💁🏼", "information_desk_person_tone2",
+	"💁🏼\u200d♀️", "woman_tipping_hand_medium_light_skin_tone",
+	"💁🏼\u200d♂️", "man_tipping_hand_medium_light_skin_tone",
+	"💁🏽", "information_desk_person_tone3",
+	"💁🏽\u200d♀️", "woman_tipping_hand_medium_skin_tone",
+	"💁🏽\u200d♂️", "man_tipping_hand_medium_skin_tone",
+	"💁🏾", "information_desk_person_tone4",
+	"💁🏾\u200d♀️", "woman_tipping_hand_medium_dark_skin_tone",
+	"💁🏾\u200d♂️", "man_tipping_hand_medium_dark_skin_tone",
+	"💁🏿", "information_desk_person_tone5",
+	"💁🏿\u200d♀️", "woman_tipping_hand_dark_skin_tone",
+	"💁🏿\u200d♂️", "man_tipping_hand_dark_skin_tone",
+	"💂", "guardsman",
+	"💂\u200d♀️", "woman_guard",
+	"💂\u200d♂️", "man_guard",
+	"💂🏻", "guardsman_tone1",
+	"💂🏻\u200d♀️", "woman_guard_light_skin_tone",
+	"💂🏻\u200d♂️", "man_guard_light_skin_tone",
+	"💂🏼", "guardsman_tone2",
+	"💂🏼\u200d♀️", "woman_guard_medium_light_skin_tone",
+	"💂🏼\u200d♂️", "man_guard_medium_light_skin_tone",
+	"💂🏽", "guardsman_tone3",
+	"💂🏽\u200d♀️", "woman_guard_medium_skin_tone",
+	"💂🏽\u200d♂️", "man_guard_medium_skin_tone",
+	"💂🏾", "guardsman_tone4",
+	"💂🏾\u200d♀️", "woman_guard_medium_dark_skin_tone",
+	"💂🏾\u200d♂️", "man_guard_medium_dark_skin_tone",
+	"💂🏿", "guardsman_tone5",
+	"💂🏿\u200d♀️", "woman_guard_dark_skin_tone",
+	"💂🏿\u200d♂️", "man_guard_dark_skin_tone",
+	"💃", "dancer",
+	"💃🏻", "dancer_tone1",
+	"💃🏼", "dancer_tone2",
+	"💃🏽", "dancer_tone3",
+	"💃🏾", "dancer_tone4",
+	"💃🏿", "dancer_tone5",
+	"💄", "lipstick",
+	"💅", "nail_care",
+	"💅🏻", "nail_care_tone1",
+	"💅🏼", "nail_care_tone2",
+	"💅🏽", "nail_care_tone3",
+	"💅🏾", "nail_care_tone4",
+	"💅🏿", "nail_care_tone5",
+	"💆", "massage",
+	"💆\u200d♀️", "woman_getting_face_massage",
+	"💆\u200d♂️", "man_getting_face_massage",
+	"💆🏻", "massage_tone1",
+	"💆🏻\u200d♀️", "woman_getting_face_massage_light_skin_tone",
+	"💆🏻\u200d♂️", "man_getting_face_massage_light_skin_tone",
+	"💆🏼", "massage_tone2",
+	"💆🏼\u200d♀️", "woman_getting_face_massage_medium_light_skin_tone",
+	"💆🏼\u200d♂️", "man_getting_face_massage_medium_light_skin_tone",
+	"💆🏽", "massage_tone3",
+	"💆🏽\u200d♀️", "woman_getting_face_massage_medium_skin_tone",
+	"💆🏽\u200d♂️", "man_getting_face_massage_medium_skin_tone",
+	"💆🏾", "massage_tone4",
+	"💆🏾\u200d♀️", "woman_getting_face_massage_medium_dark_skin_tone",
+	"💆🏾\u200d♂️", "man_getting_face_massage_medium_dark_skin_tone",
+	"💆🏿", "massage_tone5",
+	"💆🏿\u200d♀️", "woman_getting_face_massage_dark_skin_tone",
+	"💆🏿\u200d♂️", "man_getting_face_massage_dark_skin_tone",
+	"💇", "haircut",
+	"💇\u200d♀️", "woman_getting_haircut",
+	"💇\u200d♂️", "man_getting_haircut",
+	"💇🏻", "haircut_tone1",
+	"💇🏻\u200d♀️", "woman_getting_haircut_light_skin_tone",
+	"💇🏻\u200d♂️", "man_getting_haircut_light_skin_tone",
+	"💇🏼", "haircut_tone2",
+	"💇🏼\u200d♀️", "woman_getting_haircut_medium_light_skin_tone",
+	"💇🏼\u200d♂️", "man_getting_haircut_medium_light_skin_tone",
+	"💇🏽", "haircut_tone3",
+	"💇🏽\u200d♀️", "woman_getting_haircut_medium_skin_tone",
+	"💇🏽\u200d♂️", "man_getting_haircut_medium_skin_tone",
+	"💇🏾", "haircut_tone4",
+	"💇🏾\u200d♀️", "woman_getting_haircut_medium_dark_skin_tone",
+	"💇🏾\u200d♂️", "man_getting_haircut_medium_dark_skin_tone",
+	"💇🏿", "haircut_tone5",
+	"💇🏿\u200d♀️", "woman_getting_haircut_dark_skin_tone",
+	"💇🏿\u200d♂️", "man_getting_haircut_dark_skin_tone",
+	"💈", "barber",
+	"💉", "syringe",
+	"💊", "pill",
+	"💋", "kiss",
+	"💌", "love_letter",
+	"💍", "ring",
+	"💎", "gem",
+	"💏", "couplekiss",
+	"💏🏻", "kiss_light_skin_tone",
+	"💏🏼", "kiss_medium_light_skin_tone",
+	"💏🏽", "kiss_medium_skin_tone",
+	"💏🏾", "kiss_medium_dark_skin_tone",
+	"💏🏿", "kiss_dark_skin_tone",
+	"💐", "bouquet",
+	"💑", "couple_with_heart",
+	"💑🏻", "couple_with_heart_light_skin_tone",
+	"💑🏼", "couple_with_heart_medium_light_skin_tone",
+	"💑🏽", "couple_with_heart_medium_skin_tone",
+	"💑🏾", "couple_with_heart_medium_dark_skin_tone",
+	"💑🏿", "couple_with_heart_dark_skin_tone",
+	"💒", "wedding",
+	"💓", "heartbeat",
+	"💔", "broken_heart",
+	"💕", "two_hearts",
+	"💖", "sparkling_heart",
+	"💗", "heartpulse",
+	"💘", "cupid",
+	"💙", "blue_heart",
+	"💚", "green_heart",
+	"💛", "yellow_heart",
+	"💜", "purple_heart",
+	"💝", "gift_heart",
+	"💞", "revolving_hearts",
+	"💟", "heart_decoration",
+	"💠", "diamond_shape_with_a_dot_inside",
+	"💡", "bulb",
+	"💢", "anger",
+	"💣", "bomb",
+	"💤", "zzz",
+	"💥", "boom",
+	"💦", "sweat_drops",
+	"💧", "droplet",
+	"💨", "dash",
+	"💩", "poo",
+	"💪", "muscle",
+	"💪🏻", "muscle_tone1",
+	"💪🏼", "muscle_tone2",
+	"💪🏽", "muscle_tone3",
+	"💪🏾", "muscle_tone4",
+	"💪🏿", "muscle_tone5",
+	"💫", "dizzy",
+	"💬", "speech_balloon",
+	"💭", "thought_balloon",
+	"💮", "white_flower",
+	"💯", "100",
+	"💰", "moneybag",
+	"💱", "currency_exchange",
+	"💲", "heavy_dollar_sign",
+	"💳", "credit_card",
+	"💴", "yen",
+	"💵", "dollar",
+	"💶", "euro",
+	"💷", "pound",
+	"💸", "money_with_wings",
+	"💹", "chart",
+	"💺", "seat",
+	"💻", "computer",
+	"💼", "briefcase",
+	"💽", "minidisc",
+	"💾", "floppy_disk",
+	"💿", "cd",
+	"📀", "dvd",
+	"📁", "file_folder",
+	"📂", "open_file_folder",
+	"📃", "page_with_curl",
+	"📄", "page_facing_up",
+	"📅", "date",
+	"📆", "calendar",
+	"📇", "card_index",
+	"📈", "chart_with_upwards_trend",
+	"📉", "chart_with_downwards_trend",
+	"📊", "bar_chart",
+	"📋", "clipboard",
+	"📌", "pushpin",
+	"📍", "round_pushpin",
+	"📎", "paperclip",
+	"📏", "straight_ruler",
+	"📐", "triangular_ruler",
+	"📑", "bookmark_tabs",
+	"📒", "ledger",
+	"📓", "notebook",
+	"📔", "notebook_with_decorative_cover",
+	"📕", "closed_book",
+	"📖", "book",
+	"📗", "green_book",
+	"📘", "blue_book",
+	"📙", "orange_book",
+	"📚", "books",
+	"📛", "name_badge",
+	"📜", "scroll",
+	"📝", "memo",
+	"📞", "telephone_receiver",
+	"📟", "pager",
+	"📠", "fax",
+	"📡", "satellite",
+	"📢", "loudspeaker",
+	"📣", "mega",
+	"📤", "outbox_tray",
+	"📥", "inbox_tray",
+	"📦", "package",
+	"📧", "email",
+	"📨", "incoming_envelope",
+	"📩", "envelope_with_arrow",
+	"📪", "mailbox_closed",
+	"📫", "mailbox",
+	"📬", "mailbox_with_mail",
+	"📭", "mailbox_with_no_mail",
+	"📮", "postbox",
+	"📯", "postal_horn",
+	"📰", "newspaper",
+	"📱", "iphone",
+	"📲", "calling",
+	"📳", "vibration_mode",
+	"📴", "mobile_phone_off",
+	"📵", "no_mobile_phones",
+	"📶", "signal_strength",
+	"📷", "camera",
+	"📸", "camera_with_flash",
+	"📹", "video_camera",
+	"📺", "tv",
+	"📻", "radio",
+	"📼", "vhs",
+	"📽️", "film_projector",
+	"📿", "prayer_beads",
+	"🔀", "twisted_rightwards_arrows",
+	"🔁", "repeat",
+	"🔂", "repeat_one",
+	"🔃", "arrows_clockwise",
+	"🔄", "arrows_counterclockwise",
+	"🔅", "low_brightness",
+	"🔆", "high_brightness",
+	"🔇", "mute",
+	"🔈", "speaker",
+	"🔉", "sound",
+	"🔊", "loud_sound",
+	"🔋", "battery",
+	"🔌", "electric_plug",
+	"🔍", "mag",
+	"🔎", "mag_right",
+	"🔏", "lock_with_ink_pen",
+	"🔐", "closed_lock_with_key",
+	"🔑", "key",
+	"🔒", "lock",
+	"🔓", "unlock",
+	"🔔", "bell",
+	"🔕", "no_bell",
+	"🔖", "bookmark",
+	"🔗", "link",
+	"🔘", "radio_button",
+	"🔙", "back",
+	"🔚", "end",
+	"🔛", "on",
+	"🔜", "soon",
+	"🔝", "top",
+	"🔞", "underage",
+	"🔟", "keycap_ten",
+	"🔠", "capital_abcd",
+	"🔡", "abcd",
+	"🔢", "1234",
+	"🔣", "symbols",
+	"🔤", "abc",
+	"🔥", "flame",
+	"🔦", "flashlight",
+	"🔧", "wrench",
+	"🔨", "hammer",
+	"🔩", "nut_and_bolt",
+	"🔪", "knife",
+	"🔫", "gun",
+	"🔬", "microscope",
+	"🔭", "telescope",
+	"🔮", "crystal_ball",
+	"🔯", "six_pointed_star",
+	"🔰", "beginner",
+	"🔱", "trident",
+	"🔲", "black_square_button",
+	"🔳", "white_square_button",
+	"🔴", "red_circle",
+	"🔵", "blue_circle",
+	"🔶", "large_orange_diamond",
+	"🔷", "large_blue_diamond",
+	"🔸", "small_orange_diamond",
+	"🔹", "small_blue_diamond",
+	"🔺", "small_red_triangle",
+	"🔻", "small_red_triangle_down",
+	"🔼", "arrow_up_small",
+	"🔽", "arrow_down_small",
+	"🕉️", "om_symbol",
+	"🕊️", "dove_of_peace",
+	"🕋", "kaaba",
+	"🕌", "mosque",
+	"🕍", "synagogue",
+	"🕎", "menorah",
+	"🕐", "clock1",
+	"🕑", "clock2",
+	"🕒", "clock3",
+	"🕓", "clock4",
+	"🕔", "clock5",
+	"🕕", "clock6",
+	"🕖", "clock7",
+	"🕗", "clock8",
+	"🕘", "clock9",
+	"🕙", "clock10",
+	"🕚", "clock11",
+	"🕛", "clock12",
+	"🕜", "clock130",
+	"🕝", "clock230",
+	"🕞", "clock330",
+	"🕟", "clock430",
+	"🕠", "clock530",
+	"🕡", "clock630",
+	"🕢", "clock730",
+	"🕣", "clock830",
+	"🕤", "clock930",
+	"🕥", "clock1030",
+	"🕦", "clock1130",
+	"🕧", "clock1230",
+	"🕯️", "candle",
+	"🕰️", "mantlepiece_clock",
+	"🕳️", "hole",
+	"🕴️", "man_in_business_suit_levitating",
+	"🕴🏻", "man_in_business_suit_levitating_light_skin_tone",
+	"🕴🏼", "man_in_business_suit_levitating_medium_light_skin_tone",
+	"🕴🏽", "man_in_business_suit_levitating_medium_skin_tone",
+	"🕴🏾", "man_in_business_suit_levitating_medium_dark_skin_tone",
+	"🕴🏿", "man_in_business_suit_levitating_dark_skin_tone",
+	"🕵️", "sleuth_or_spy",
+	"🕵️\u200d♀️", "woman_detective",
+	"🕵️\u200d♂️", "man_detective",
+	"🕵🏻", "sleuth_or_spy_tone1",
+	"🕵🏻\u200d♀️", "woman_detective_light_skin_tone",
+	"🕵🏻\u200d♂️", "man_detective_light_skin_tone",
+	"🕵🏼", "sleuth_or_spy_tone2",
+	"🕵🏼\u200d♀️", "woman_detective_medium_light_skin_tone",
+	"🕵🏼\u200d♂️", "man_detective_medium_light_skin_tone",
+	"🕵🏽", "sleuth_or_spy_tone3",
+	"🕵🏽\u200d♀️", "woman_detective_medium_skin_tone",
+	"🕵🏽\u200d♂️", "man_detective_medium_skin_tone",
+	"🕵🏾", "sleuth_or_spy_tone4",
+	"🕵🏾\u200d♀️", "woman_detective_medium_dark_skin_tone",
+	"🕵🏾\u200d♂️", "man_detective_medium_dark_skin_tone",
+	"🕵🏿", "sleuth_or_spy_tone5",
+	"🕵🏿\u200d♀️", "woman_detective_dark_skin_tone",
+	"🕵🏿\u200d♂️", "man_detective_dark_skin_tone",
+	"🕶️", "dark_sunglasses",
+	"🕷️", "spider",
+	"🕸️", "spider_web",
+	"🕹️", "joystick",
+	"🕺", "male_dancer",
+	"🕺🏻", "male_dancer_tone1",
+	"🕺🏼", "male_dancer_tone2",
+	"🕺🏽", "male_dancer_tone3",
+	"🕺🏾", "male_dancer_tone4",
+	"🕺🏿", "male_dancer_tone5",
+	"🖇️", "linked_paperclips",
+	"🖊️", "lower_left_ballpoint_pen",
+	"🖋️", "lower_left_fountain_pen",
+	"🖌️", "lower_left_paintbrush",
+	"🖍️", "lower_left_crayon",
+	"🖐️", "raised_hand_with_fingers_splayed",
+	"🖐🏻", "raised_hand_with_fingers_splayed_tone1",
+	"🖐🏼", "raised_hand_with_fingers_splayed_tone2",
+	"🖐🏽", "raised_hand_with_fingers_splayed_tone3",
+	"🖐🏾", "raised_hand_with_fingers_splayed_tone4",
+	"🖐🏿", "raised_hand_with_fingers_splayed_tone5",
+	"🖕", "reversed_hand_with_middle_finger_extended",
+	"🖕🏻", "reversed_hand_with_middle_finger_extended_tone1",
+	"🖕🏼", "reversed_hand_with_middle_finger_extended_tone2",
+	"🖕🏽", "reversed_hand_with_middle_finger_extended_tone3",
+	"🖕🏾", "reversed_hand_with_middle_finger_extended_tone4",
+	"🖕🏿", "reversed_hand_with_middle_finger_extended_tone5",
+	"🖖", "raised_hand_with_part_between_middle_and_ring_fingers",
+	"🖖🏻", "raised_hand_with_part_between_middle_and_ring_fingers_tone1",
+	"🖖🏼", "raised_hand_with_part_between_middle_and_ring_fingers_tone2",
+	"🖖🏽", "raised_hand_with_part_between_middle_and_ring_fingers_tone3",
+	"🖖🏾", "raised_hand_with_part_between_middle_and_ring_fingers_tone4",
+	"🖖🏿", "raised_hand_with_part_between_middle_and_ring_fingers_tone5",
+	"🖤", "black_heart",
+	"🖥️", "desktop_computer",
+	"🖨️", "printer",
+	"🖱️", "three_button_mouse",
+	"🖲️", "trackball",
+	"🖼️", "frame_with_picture",
+	"🗂️", "card_index_dividers",
+	"🗃️", "card_file_box",
+	"🗄️", "file_cabinet",
+	"🗑️", "wastebasket",
+	"🗒️", "spiral_note_pad",
+	"🗓️", "spiral_calendar_pad",
+	"🗜️", "compression",
+	"🗝️", "old_key",
+	"🗞️", "rolled_up_newspaper",
+	"🗡️", "dagger_knife",
+	"🗣️", "speaking_head_in_silhouette",
+	"🗨️", "left_speech_bubble",
+	"🗯️", "right_anger_bubble",
+	"🗳️", "ballot_box_with_ballot",
+	"🗺️", "world_map",
+	"🗻", "mount_fuji",
+	"🗼", "tokyo_tower",
+	"🗽", "statue_of_liberty",
+	"🗾", "japan",
+	"🗿", "moyai",
+	"😀", "grinning",
+	"😁", "grin",
+	"😂", "joy",
+	"😃", "smiley",
+	"😄", "smile",
+	"😅", "sweat_smile",
+	"😆", "satisfied",
+	"😇", "innocent",
+	"😈", "smiling_imp",
+	"😉", "wink",
+	"😊", "blush",
+	"😋", "yum",
+	"😌", "relieved",
+	"😍", "heart_eyes",
+	"😎", "sunglasses",
+	"😏", "smirk",
+	"😐", "neutral_face",
+	"😑", "expressionless",
+	"😒", "unamused",
+	"😓", "sweat",
+	"😔", "pensive",
+	"😕", "confused",
+	"😖", "confounded",
+	"😗", "kissing",
+	"😘", "kissing_heart",
+	"😙", "kissing_smiling_eyes",
+	"😚", "kissing_closed_eyes",
+	"😛", "stuck_out_tongue",
+	"😜", "stuck_out_tongue_winking_eye",
+	"😝", "stuck_out_tongue_closed_eyes",
+	"😞", "disappointed",
+	"😟", "worried",
+	"😠", "angry",
+	"😡", "rage",
+	"😢", "cry",
+	"😣", "persevere",
+	"😤", "triumph",
+	"😥", "disappointed_relieved",
+	"😦", "frowning",
+	"😧", "anguished",
+	"😨", "fearful",
+	"😩", "weary",
+	"😪", "sleepy",
+	"😫", "tired_face",
+	"😬", "grimacing",
+	"😭", "sob",
+	"😮", "open_mouth",
+	"😮\u200d💨", "face_exhaling",
+	"😯", "hushed",
+	"😰", "cold_sweat",
+	"😱", "scream",
+	"😲", "astonished",
+	"😳", "flushed",
+	"😴", "sleeping",
+	"😵", "dizzy_face",
+	"😵\u200d💫", "face_with_spiral_eyes",
+	"😶", "no_mouth",
+	"😶\u200d🌫️", "face_in_clouds",
+	"😷", "mask",
+	"😸", "smile_cat",
+	"😹", "joy_cat",
+	"😺", "smiley_cat",
+	"😻", "heart_eyes_cat",
+	"😼", "smirk_cat",
+	"😽", "kissing_cat",
+	"😾", "pouting_cat",
+	"😿", "crying_cat_face",
+	"🙀", "scream_cat",
+	"🙁", "slightly_frowning_face",
+	"🙂", "slightly_smiling_face",
+	"🙃", "upside_down_face",
+	"🙄", "face_with_rolling_eyes",
+	"🙅", "no_good",
+	"🙅\u200d♀️", "woman_gesturing_no",
+	"🙅\u200d♂️", "man_gesturing_no",
+	"🙅🏻", "no_good_tone1",
+	"🙅🏻\u200d♀️", "woman_gesturing_no_light_skin_tone",
+	"🙅🏻\u200d♂️", "man_gesturing_no_light_skin_tone",
+	"🙅🏼", "no_good_tone2",
+	"🙅🏼\u200d♀️", "woman_gesturing_no_medium_light_skin_tone",
+	"🙅🏼\u200d♂️", "man_gesturing_no_medium_light_skin_tone",
+	"🙅🏽", "no_good_tone3",
+	"🙅🏽\u200d♀️", "woman_gesturing_no_medium_skin_tone",
+	"🙅🏽\u200d♂️", "man_gesturing_no_medium_skin_tone",
+	"🙅🏾", "no_good_tone4",
+	"🙅🏾\u200d♀️", "woman_gesturing_no_medium_dark_skin_tone",
+	"🙅🏾\u200d♂️", "man_gesturing_no_medium_dark_skin_tone",
+	"🙅🏿", "no_good_tone5",
+	"🙅🏿\u200d♀️", "woman_gesturing_no_dark_skin_tone",
+	"🙅🏿\u200d♂️", "man_gesturing_no_dark_skin_tone",
+	"🙆", "person_gesturing_ok",
+	"🙆\u200d♀️", "woman_gesturing_ok",
+	"🙆\u200d♂️", "man_gesturing_ok",
+	"🙆🏻", "person_gesturing_ok_tone1",
+	"🙆🏻\u200d♀️", "woman_gesturing_ok_light_skin_tone",
+	"🙆🏻\u200d♂️", "man_gesturing_ok_light_skin_tone",
+	"🙆🏼", "person_gesturing_ok_tone2",
+	"🙆🏼\u200d♀️", "woman_gesturing_ok_medium_light_skin_tone",
+	"🙆🏼\u200d♂️", "man_gesturing_ok_medium_light_skin_tone",
+	"🙆🏽", "person_gesturing_ok_tone3",
+	"🙆🏽\u200d♀️", "woman_gesturing_ok_medium_skin_tone",
+	"🙆🏽\u200d♂️", "man_gesturing_ok_medium_skin_tone",
+	"🙆🏾", "person_gesturing_ok_tone4",
+	"🙆🏾\u200d♀️", "woman_gesturing_ok_medium_dark_skin_tone",
+	"🙆🏾\u200d♂️", "man_gesturing_ok_medium_dark_skin_tone",
+	"🙆🏿", "person_gesturing_ok_tone5",
+	"🙆🏿\u200d♀️", "woman_gesturing_ok_dark_skin_tone",
+	"🙆🏿\u200d♂️", "man_gesturing_ok_dark_skin_tone",
+	"🙇", "bow",
+	"🙇\u200d♀️", "woman_bowing",
+	"🙇\u200d♂️", "man_bowing",
+	"🙇🏻", "bow_tone1",
+	"🙇🏻\u200d♀️", "woman_bowing_light_skin_tone",
+	"🙇🏻\u200d♂️", "man_bowing_light_skin_tone",
+	"🙇🏼", "bow_tone2",
+	"🙇🏼\u200d♀️", "woman_bowing_medium_light_skin_tone",
+	"🙇🏼\u200d♂️", "man_bowing_medium_light_skin_tone",
+	"🙇🏽", "bow_tone3",
+	"🙇🏽\u200d♀️", "woman_bowing_medium_skin_tone",
+	"🙇🏽\u200d♂️", "man_bowing_medium_skin_tone",
+	"🙇🏾", "bow_tone4",
+	"🙇🏾\u200d♀️", "woman_bowing_medium_dark_skin_tone",
+	"🙇🏾\u200d♂️", "man_bowing_medium_dark_skin_tone",
+	"🙇🏿", "bow_tone5",
+	"🙇🏿\u200d♀️", "woman_bowing_dark_skin_tone",
+	"🙇🏿\u200d♂️", "man_bowing_dark_skin_tone",
+	"🙈", "see_no_evil",
+	"🙉", "hear_no_evil",
+	"🙊", "speak_no_evil",
+	"🙋", "raising_hand",
+	"🙋\u200d♀️", "woman_raising_hand",
+	"🙋\u200d♂️", "man_raising_hand",
+	"🙋🏻", "raising_hand_tone1",
+	"🙋🏻\u200d♀️", "woman_raising_hand_light_skin_tone",
+	"🙋🏻\u200d♂️", "man_raising_hand_light_skin_tone",
+	"🙋🏼", "raising_hand_tone2",
+	"🙋🏼\u200d♀️", "woman_raising_hand_medium_light_skin_tone",
+	"🙋🏼\u200d♂️", "man_raising_hand_medium_light_skin_tone",
+	"🙋🏽", "raising_hand_tone3",
+	"🙋🏽\u200d♀️", "woman_raising_hand_medium_skin_tone",
+	"🙋🏽\u200d♂️", "man_raising_hand_medium_skin_tone",
+	"🙋🏾", "raising_hand_tone4",
+	"🙋🏾\u200d♀️", "woman_raising_hand_medium_dark_skin_tone",
+	"🙋🏾\u200d♂️", "man_raising_hand_medium_dark_skin_tone",
+	"🙋🏿", "raising_hand_tone5",
+	"🙋🏿\u200d♀️", "woman_raising_hand_dark_skin_tone",
+	"🙋🏿\u200d♂️", "man_raising_hand_dark_skin_tone",
+	"🙌", "raised_hands",
+	"🙌🏻", "raised_hands_tone1",
+	"🙌🏼", "raised_hands_tone2",
+	"🙌🏽", "raised_hands_tone3",
+	"🙌🏾", "raised_hands_tone4",
+	"🙌🏿", "raised_hands_tone5",
+	"🙍", "person_frowning",
+	"🙍\u200d♀️", "woman_frowning",
+	"🙍\u200d♂️", "man_frowning",
+	"🙍🏻", "person_frowning_tone1",
+	"🙍🏻\u200d♀️", "woman_frowning_light_skin_tone",
+	"🙍🏻\u200d♂️", "man_frowning_light_skin_tone",
+	"🙍🏼", "person_frowning_tone2",
+	"🙍🏼\u200d♀️", "woman_frowning_medium_light_skin_tone",
+	"🙍🏼\u200d♂️", "man_frowning_medium_light_skin_tone",
+	"🙍🏽", "person_frowning_tone3",
+	"🙍🏽\u200d♀️", "woman_frowning_medium_skin_tone",
+	"🙍🏽\u200d♂️", "man_frowning_medium_skin_tone",
+	"🙍🏾", "person_frowning_tone4",
+	"🙍🏾\u200d♀️", "woman_frowning_medium_dark_skin_tone",
+	"🙍🏾\u200d♂️", "man_frowning_medium_dark_skin_tone",
+	"🙍🏿", "person_frowning_tone5",
+	"🙍🏿\u200d♀️", "woman_frowning_dark_skin_tone",
+	"🙍🏿\u200d♂️", "man_frowning_dark_skin_tone",
+	"🙎", "person_with_pouting_face",
+	"🙎\u200d♀️", "woman_pouting",
+	"🙎\u200d♂️", "man_pouting",
+	"🙎🏻", "person_with_pouting_face_tone1",
+	"🙎🏻\u200d♀️", "woman_pouting_light_skin_tone",
+	"🙎🏻\u200d♂️", "man_pouting_light_skin_tone",
+	"🙎🏼", "person_with_pouting_face_tone2",
+	"🙎🏼\u200d♀️", "woman_pouting_medium_light_skin_tone",
+	"🙎🏼\u200d♂️", "man_pouting_medium_light_skin_tone",
+	"🙎🏽", "person_with_pouting_face_tone3",
+	"🙎🏽\u200d♀️", "woman_pouting_medium_skin_tone",
+	"🙎🏽\u200d♂️", "man_pouting_medium_skin_tone",
+	"🙎🏾", "person_with_pouting_face_tone4",
+	"🙎🏾\u200d♀️", "woman_pouting_medium_dark_skin_tone",
+	"🙎🏾\u200d♂️", "man_pouting_medium_dark_skin_tone",
+	"🙎🏿", "person_with_pouting_face_tone5",
+	"🙎🏿\u200d♀️", "woman_pouting_dark_skin_tone",
+	"🙎🏿\u200d♂️", "man_pouting_dark_skin_tone",
+	"🙏", "pray",
+	"🙏🏻", "pray_tone1",
+	"🙏🏼", "pray_tone2",
+	"🙏🏽", "pray_tone3",
+	"🙏🏾", "pray_tone4",
+	"🙏🏿", "pray_tone5",
+	"🚀", "rocket",
+	"🚁", "helicopter",
+	"🚂", "steam_locomotive",
+	"🚃", "railway_car",
+	"🚄", "bullettrain_side",
+	"🚅", "bullettrain_front",
+	"🚆", "train2",
+	"🚇", "metro",
+	"🚈", "light_rail",
+	"🚉", "station",
+	"🚊", "tram",
+	"🚋", "train",
+	"🚌", "bus",
+	"🚍", "oncoming_bus",
+	"🚎", "trolleybus",
+	"🚏", "busstop",
+	"🚐", "minibus",
+	"🚑", "ambulance",
+	"🚒", "fire_engine",
+	"🚓", "police_car",
+	"🚔", "oncoming_police_car",
+	"🚕", "taxi",
+	"🚖", "oncoming_taxi",
+	"🚗", "red_car",
+	"🚘", "oncoming_automobile",
+	"🚙", "blue_car",
+	"🚚", "truck",
+	"🚛", "articulated_lorry",
+	"🚜", "tractor",
+	"🚝", "monorail",
+	"🚞", "mountain_railway",
+	"🚟", "suspension_railway",
+	"🚠", "mountain_cableway",
+	"🚡", "aerial_tramway",
+	"🚢", "ship",
+	"🚣", "rowboat",
+	"🚣\u200d♀️", "woman_rowing_boat",
+	"🚣\u200d♂️", "man_rowing_boat",
+	"🚣🏻", "rowboat_tone1",
+	"🚣🏻\u200d♀️", "woman_rowing_boat_light_skin_tone",
+	"🚣🏻\u200d♂️", "man_rowing_boat_light_skin_tone",
+	"🚣🏼", "rowboat_tone2",
+	"🚣🏼\u200d♀️", "woman_rowing_boat_medium_light_skin_tone",
+	"🚣🏼\u200d♂️", "man_rowing_boat_medium_light_skin_tone",
+	"🚣🏽", "rowboat_tone3",
+	"🚣🏽\u200d♀️", "woman_rowing_boat_medium_skin_tone",
+	"🚣🏽\u200d♂️", "man_rowing_boat_medium_skin_tone",
+	"🚣🏾", "rowboat_tone4",
+	"🚣🏾\u200d♀️", "woman_rowing_boat_medium_dark_skin_tone",
+	"🚣🏾\u200d♂️", "man_rowing_boat_medium_dark_skin_tone",
+	"🚣🏿", "rowboat_tone5",
+	"🚣🏿\u200d♀️", "woman_rowing_boat_dark_skin_tone",
+	"🚣🏿\u200d♂️", "man_rowing_boat_dark_skin_tone",
+	"🚤", "speedboat",
+	"🚥", "traffic_light",
+	"🚦", "vertical_traffic_light",
+	"🚧", "construction",
+	"🚨", "rotating_light",
+	"🚩", "triangular_flag_on_post",
+	"🚪", "door",
+	"🚫", "no_entry_sign",
+	"🚬", "smoking",
+	"🚭", "no_smoking",
+	"🚮", "put_litter_in_its_place",
+	"🚯", "do_not_litter",
+	"🚰", "potable_water",
+	"🚱", "non_potable_water",
+	"🚲", "bike",
+	"🚳", "no_bicycles",
+	"🚴", "bicyclist",
+	"🚴\u200d♀️", "woman_biking",
+	"🚴\u200d♂️", "man_biking",
+	"🚴🏻", "bicyclist_tone1",
+	"🚴🏻\u200d♀️", "woman_biking_light_skin_tone",
+	"🚴🏻\u200d♂️", "man_biking_light_skin_tone",
+	"🚴🏼", "bicyclist_tone2",
+	"🚴🏼\u200d♀️", "woman_biking_medium_light_skin_tone",
+	"🚴🏼\u200d♂️", "man_biking_medium_light_skin_tone",
+	"🚴🏽", "bicyclist_tone3",
+	"🚴🏽\u200d♀️", "woman_biking_medium_skin_tone",
+	"🚴🏽\u200d♂️", "man_biking_medium_skin_tone",
+	"🚴🏾", "bicyclist_tone4",
+	"🚴🏾\u200d♀️", "woman_biking_medium_dark_skin_tone",
+	"🚴🏾\u200d♂️", "man_biking_medium_dark_skin_tone",
+	"🚴🏿", "bicyclist_tone5",
+	"🚴🏿\u200d♀️", "woman_biking_dark_skin_tone",
+	"🚴🏿\u200d♂️", "man_biking_dark_skin_tone",
+	"🚵", "mountain_bicyclist",
+	"🚵\u200d♀️", "woman_mountain_biking",
+	"🚵\u200d♂️", "man_mountain_biking",
+	"🚵🏻", "mountain_bicyclist_tone1",
+	"🚵🏻\u200d♀️", "woman_mountain_biking_light_skin_tone",
+	"🚵🏻\u200d♂️", "man_mountain_biking_light_skin_tone",
+	"🚵🏼", "mountain_bicyclist_tone2",
+	"🚵🏼\u200d♀️", "woman_mountain_biking_medium_light_skin_tone",
+	"🚵🏼\u200d♂️", "man_mountain_biking_medium_light_skin_tone",
+	"🚵🏽", "mountain_bicyclist_tone3",
+	"🚵🏽\u200d♀️", "woman_mountain_biking_medium_skin_tone",
+	"🚵🏽\u200d♂️", "man_mountain_biking_medium_skin_tone",
+	"🚵🏾", "mountain_bicyclist_tone4",
+	"🚵🏾\u200d♀️", "woman_mountain_biking_medium_dark_skin_tone",
+	"🚵🏾\u200d♂️", "man_mountain_biking_medium_dark_skin_tone",
+	"🚵🏿", "mountain_bicyclist_tone5",
+	"🚵🏿\u200d♀️", "woman_mountain_biking_dark_skin_tone",
+	"🚵🏿\u200d♂️", "man_mountain_biking_dark_skin_tone",
+	"🚶", "walking",
+	"🚶\u200d♀️", "woman_walking",
+	"🚶\u200d♂️", "man_walking",
+	"🚶🏻", "walking_tone1",
+	"🚶🏻\u200d♀️", "woman_walking_light_skin_tone",
+	"🚶🏻\u200d♂️", "man_walking_light_skin_tone",
+	"🚶🏼", "walking_tone2",
+	"🚶🏼\u200d♀️", "woman_walking_medium_light_skin_tone",
+	"🚶🏼\u200d♂️", "man_walking_medium_light_skin_tone",
+	"🚶🏽", "walking_tone3",
+	"🚶🏽\u200d♀️", "woman_walking_medium_skin_tone",
+	"🚶🏽\u200d♂️", "man_walking_medium_skin_tone",
+	"🚶🏾", "walking_tone4",
+	"🚶🏾\u200d♀️", "woman_walking_medium_dark_skin_tone",
+	"🚶🏾\u200d♂️", "man_walking_medium_dark_skin_tone",
+	"🚶🏿", "walking_tone5",
+	"🚶🏿\u200d♀️", "woman_walking_dark_skin_tone",
+	"🚶🏿\u200d♂️", "man_walking_dark_skin_tone",
+	"🚷", "no_pedestrians",
+	"🚸", "children_crossing",
+	"🚹", "mens",
+	"🚺", "womens",
+	"🚻", "restroom",
+	"🚼", "baby_symbol",
+	"🚽", "toilet",
+	"🚾", "wc",
+	"🚿", "shower",
+	"🛀", "bath",
+	"🛀🏻", "bath_tone1",
+	"🛀🏼", "bath_tone2",
+	"🛀🏽", "bath_tone3",
+	"🛀🏾", "bath_tone4",
+	"🛀🏿", "bath_tone5",
+	"🛁", "bathtub",
+	"🛂", "passport_control",
+	"🛃", "customs",
+	"🛄", "baggage_claim",
+	"🛅", "left_luggage",
+	"🛋️", "couch_and_lamp",
+	"🛌", "sleeping_accommodation",
+	"🛌🏻", "person_in_bed_light_skin_tone",
+	"🛌🏼", "person_in_bed_medium_light_skin_tone",
+	"🛌🏽", "person_in_bed_medium_skin_tone",
+	"🛌🏾", "person_in_bed_medium_dark_skin_tone",
+	"🛌🏿", "person_in_bed_dark_skin_tone",
+	"🛍️", "shopping_bags",
+	"🛎️", "bellhop_bell",
+	"🛏️", "bed",
+	"🛐", "worship_symbol",
+	"🛑", "stop_sign",
+	"🛒", "shopping_trolley",
+	"🛕", "hindu_temple",
+	"🛖", "hut",
+	"🛗", "elevator",
+	"🛝", "playground_slide",
+	"🛞", "wheel",
+	"🛟", "ring_buoy",
+	"🛠️", "hammer_and_wrench",
+	"🛡️", "shield",
+	"🛢️", "oil_drum",
+	"🛣️", "motorway",
+	"🛤️", "railroad_track",
+	"🛥️", "motorboat",
+	"🛩️", "small_airplane",
+	"🛫", "airplane_departure",
+	"🛬", "airplane_arriving",
+	"🛰️", "satellite_orbital",
+	"🛳️", "passenger_ship",
+	"🛴", "scooter",
+	"🛵", "motorbike",
+	"🛶", "kayak",
+	"🛷", "sled",
+	"🛸", "flying_saucer",
+	"🛹", "skateboard",
+	"🛺", "auto_rickshaw",
+	"🛻", "pickup_truck",
+	"🛼", "roller_skate",
+	"🟠", "orange_circle",
+	"🟡", "yellow_circle",
+	"🟢", "green_circle",
+	"🟣", "purple_circle",
+	"🟤", "brown_circle",
+	"🟥", "red_square",
+	"🟦", "blue_square",
+	"🟧", "orange_square",
+	"🟨", "yellow_square",
+	"🟩", "green_square",
+	"🟪", "purple_square",
+	"🟫", "brown_square",
+	"🟰", "heavy_equals_sign",
+	"🤌", "pinched_fingers",
+	"🤌🏻", "pinched_fingers_light_skin_tone",
+	"🤌🏼", "pinched_fingers_medium_light_skin_tone",
+	"🤌🏽", "pinched_fingers_medium_skin_tone",
+	"🤌🏾", "pinched_fingers_medium_dark_skin_tone",
+	"🤌🏿", "pinched_fingers_dark_skin_tone",
+	"🤍", "white_heart",
+	"🤎", "brown_heart",
+	"🤏", "pinching_hand",
+	"🤏🏻", "pinching_hand_light_skin_tone",
+	"🤏🏼", "pinching_hand_medium_light_skin_tone",
+	"🤏🏽", "pinching_hand_medium_skin_tone",
+	"🤏🏾", "pinching_hand_medium_dark_skin_tone",
+	"🤏🏿", "pinching_hand_dark_skin_tone",
+	"🤐", "zipper_mouth_face",
+	"🤑", "money_mouth_face",
+	"🤒", "face_with_thermometer",
+	"🤓", "nerd_face",
+	"🤔", "thinking_face",
+	"🤕", "face_with_head_bandage",
+	"🤖", "robot_face",
+	"🤗", "hugging_face",
+	"🤘", "sign_of_the_horns",
+	"🤘🏻", "sign_of_the_horns_tone1",
+	"🤘🏼", "sign_of_the_horns_tone2",
+	"🤘🏽", "sign_of_the_horns_tone3",
+	"🤘🏾", "sign_of_the_horns_tone4",
+	"🤘🏿", "sign_of_the_horns_tone5",
+	"🤙", "call_me_hand",
+	"🤙🏻", "call_me_hand_tone1",
+	"🤙🏼", "call_me_hand_tone2",
+	"🤙🏽", "call_me_hand_tone3",
+	"🤙🏾", "call_me_hand_tone4",
+	"🤙🏿", "call_me_hand_tone5",
+	"🤚", "back_of_hand",
+	"🤚🏻", "back_of_hand_tone1",
+	"🤚🏼", "back_of_hand_tone2",
+	"🤚🏽", "back_of_hand_tone3",
+	"🤚🏾", "back_of_hand_tone4",
+	"🤚🏿", "back_of_hand_tone5",
+	"🤛", "left_fist",
+	"🤛🏻", "left_fist_tone1",
+	"🤛🏼", "left_fist_tone2",
+	"🤛🏽", "left_fist_tone3",
+	"🤛🏾", "left_fist_tone4",
+	"🤛🏿", "left_fist_tone5",
+	"🤜", "right_fist",
+	"🤜🏻", "right_fist_tone1",
+	"🤜🏼", "right_fist_tone2",
+	"🤜🏽", "right_fist_tone3",
+	"🤜🏾", "right_fist_tone4",
+	"🤜🏿", "right_fist_tone5",
+	"🤝", "shaking_hands",
+	"🤝🏻", "handshake_light_skin_tone",
+	"🤝🏼", "handshake_medium_light_skin_tone",
+	"🤝🏽", "handshake_medium_skin_tone",
+	"🤝🏾", "handshake_medium_dark_skin_tone",
+	"🤝🏿", "handshake_dark_skin_tone",
+	"🤞", "hand_with_index_and_middle_finger_crossed",
+	"🤞🏻", "hand_with_index_and_middle_fingers_crossed_tone1",
+	"🤞🏼", "hand_with_index_and_middle_fingers_crossed_tone2",
+	"🤞🏽", "hand_with_index_and_middle_fingers_crossed_tone3",
+	"🤞🏾", "hand_with_index_and_middle_fingers_crossed_tone4",
+	"🤞🏿", "hand_with_index_and_middle_fingers_crossed_tone5",
+	"🤟", "love_you_gesture",
+	"🤟🏻", "love_you_gesture_light_skin_tone",
+	"🤟🏼", "love_you_gesture_medium_light_skin_tone",
+	"🤟🏽", "love_you_gesture_medium_skin_tone",
+	"🤟🏾", "love_you_gesture_medium_dark_skin_tone",
+	"🤟🏿", "love_you_gesture_dark_skin_tone",
+	"🤠", "face_with_cowboy_hat",
+	"🤡", "clown_face",
+	"🤢", "sick",
+	"🤣", "rolling_on_the_floor_laughing",
+	"🤤", "drool",
+	"🤥", "liar",
+	"🤦", "facepalm",
+	"🤦\u200d♀️", "woman_facepalming",
+	"🤦\u200d♂️", "man_facepalming",
+	"🤦🏻", "facepalm_tone1",
+	"🤦🏻\u200d♀️", "woman_facepalming_light_skin_tone",
+	"🤦🏻\u200d♂️", "man_facepalming_light_skin_tone",
+	"🤦🏼", "facepalm_tone2",
+	"🤦🏼\u200d♀️", "woman_facepalming_medium_light_skin_tone",
+	"🤦🏼\u200d♂️", "man_facepalming_medium_light_skin_tone",
+	"🤦🏽", "facepalm_tone3",
+	"🤦🏽\u200d♀️", "woman_facepalming_medium_skin_tone",
+	"🤦🏽\u200d♂️", "man_facepalming_medium_skin_tone",
+	"🤦🏾", "facepalm_tone4",
+	"🤦🏾\u200d♀️", "woman_facepalming_medium_dark_skin_tone",
+	"🤦🏾\u200d♂️", "man_facepalming_medium_dark_skin_tone",
+	"🤦🏿", "facepalm_tone5",
+	"🤦🏿\u200d♀️", "woman_facepalming_dark_skin_tone",
+	"🤦🏿\u200d♂️", "man_facepalming_dark_skin_tone",
+	"🤧", "sneeze",
+	"🤨", "face_with_raised_eyebrow",
+	"🤩", "star_struck",
+	"🤪", "zany_face",
+	"🤫", "shushing_face",
+	"🤬", "face_with_symbols_over_mouth",
+	"🤭", "face_with_hand_over_mouth",
+	"🤮", "face_vomiting",
+	"🤯", "exploding_head",
+	"🤰", "expecting_woman",
+	"🤰🏻", "expecting_woman_tone1",
+	"🤰🏼", "expecting_woman_tone2",
+	"🤰🏽", "expecting_woman_tone3",
+	"🤰🏾", "expecting_woman_tone4",
+	"🤰🏿", "expecting_woman_tone5",
+	"🤱", "breast_feeding",
+	"🤱🏻", "breast_feeding_light_skin_tone",
+	"🤱🏼", "breast_feeding_medium_light_skin_tone",
+	"🤱🏽", "breast_feeding_medium_skin_tone",
+	"🤱🏾", "breast_feeding_medium_dark_skin_tone",
+	"🤱🏿", "breast_feeding_dark_skin_tone",
+	"🤲", "palms_up_together",
+	"🤲🏻", "palms_up_together_light_skin_tone",
+	"🤲🏼", "palms_up_together_medium_light_skin_tone",
+	"🤲🏽", "palms_up_together_medium_skin_tone",
+	"🤲🏾", "palms_up_together_medium_dark_skin_tone",
+	"🤲🏿", "palms_up_together_dark_skin_tone",
+	"🤳", "selfie",
+	"🤳🏻", "selfie_tone1",
+	"🤳🏼", "selfie_tone2",
+	"🤳🏽", "selfie_tone3",
+	"🤳🏾", "selfie_tone4",
+	"🤳🏿", "selfie_tone5",
+	"🤴", "prince",
+	"🤴🏻", "prince_tone1",
+	"🤴🏼", "prince_tone2",
+	"🤴🏽", "prince_tone3",
+	"🤴🏾", "prince_tone4",
+	"🤴🏿", "prince_tone5",
+	"🤵", "person_in_tuxedo",
+	"🤵\u200d♀️", "woman_in_tuxedo",
+	"🤵\u200d♂️", "man_in_tuxedo",
+	"🤵🏻", "tuxedo_tone1",
+	"🤵🏻\u200d♀️", "woman_in_tuxedo_light_skin_tone",
+	"🤵🏻\u200d♂️", "man_in_tuxedo_light_skin_tone",
+	"🤵🏼", "tuxedo_tone2",
+	"🤵🏼\u200d♀️", "woman_in_tuxedo_medium_light_skin_tone",
+	"🤵🏼\u200d♂️", "man_in_tuxedo_medium_light_skin_tone",
+	"🤵🏽", "tuxedo_tone3",
+	"🤵🏽\u200d♀️", "woman_in_tuxedo_medium_skin_tone",
+	"🤵🏽\u200d♂️", "man_in_tuxedo_medium_skin_tone",
+	"🤵🏾", "tuxedo_tone4",
+	"🤵🏾\u200d♀️", "woman_in_tuxedo_medium_dark_skin_tone",
+	"🤵🏾\u200d♂️", "man_in_tuxedo_medium_dark_skin_tone",
+	"🤵🏿", "tuxedo_tone5",
+	"🤵🏿\u200d♀️", "woman_in_tuxedo_dark_skin_tone",
+	"🤵🏿\u200d♂️", "man_in_tuxedo_dark_skin_tone",
+	"🤶", "mother_christmas",
+	"🤶🏻", "mother_christmas_tone1",
+	"🤶🏼", "mother_christmas_tone2",
+	"🤶🏽", "mother_christmas_tone3",
+	"🤶🏾", "mother_christmas_tone4",
+	"🤶🏿", "mother_christmas_tone5",
+	"🤷", "shrug",
+	"🤷\u200d♀️", "woman_shrugging",
+	"🤷\u200d♂️", "man_shrugging",
+	"🤷🏻", "shrug_tone1",
+	"🤷🏻\u200d♀️", "woman_shrugging_light_skin_tone",
+	"🤷🏻\u200d♂️", "man_shrugging_light_skin_tone",
+	"🤷🏼", "shrug_tone2",
+	"🤷🏼\u200d♀️", "woman_shrugging_medium_light_skin_tone",
+	"🤷🏼\u200d♂️", "man_shrugging_medium_light_skin_tone",
+	"🤷🏽", "shrug_tone3",
+	"🤷🏽\u200d♀️", "woman_shrugging_medium_skin_tone",
+	"🤷🏽\u200d♂️", "man_shrugging_medium_skin_tone",
+	"🤷🏾", "shrug_tone4",
+	"🤷🏾\u200d♀️", "woman_shrugging_medium_dark_skin_tone",
+	"🤷🏾\u200d♂️", "man_shrugging_medium_dark_skin_tone",
+	"🤷🏿", "shrug_tone5",
+	"🤷🏿\u200d♀️", "woman_shrugging_dark_skin_tone",
+	"🤷🏿\u200d♂️", "man_shrugging_dark_skin_tone",
+	"🤸", "cartwheel",
+	"🤸\u200d♀️", "woman_cartwheeling",
+	"🤸\u200d♂️", "man_cartwheeling",
+	"🤸🏻", "cartwheel_tone1",
+	"🤸🏻\u200d♀️", "woman_cartwheeling_light_skin_tone",
+	"🤸🏻\u200d♂️", "man_cartwheeling_light_skin_tone",
+	"🤸🏼", "cartwheel_tone2",
+	"🤸🏼\u200d♀️", "woman_cartwheeling_medium_light_skin_tone",
+	"🤸🏼\u200d♂️", "man_cartwheeling_medium_light_skin_tone",
+	"🤸🏽", "cartwheel_tone3",
+	"🤸🏽\u200d♀️", "woman_cartwheeling_medium_skin_tone",
+	"🤸🏽\u200d♂️", "man_cartwheeling_medium_skin_tone",
+	"🤸🏾", "cartwheel_tone4",
+	"🤸🏾\u200d♀️", "woman_cartwheeling_medium_dark_skin_tone",
+	"🤸🏾\u200d♂️", "man_cartwheeling_medium_dark_skin_tone",
+	"🤸🏿", "cartwheel_tone5",
+	"🤸🏿\u200d♀️", "woman_cartwheeling_dark_skin_tone",
+	"🤸🏿\u200d♂️", "man_cartwheeling_dark_skin_tone",
+	"🤹", "juggler",
+	"🤹\u200d♀️", "woman_juggling",
+	"🤹\u200d♂️", "man_juggling",
+	"🤹🏻", "juggler_tone1",
+	"🤹🏻\u200d♀️", "woman_juggling_light_skin_tone",
+	"🤹🏻\u200d♂️", "man_juggling_light_skin_tone",
+	"🤹🏼", "juggler_tone2",
+	"🤹🏼\u200d♀️", "woman_juggling_medium_light_skin_tone",
+	"🤹🏼\u200d♂️", "man_juggling_medium_light_skin_tone",
+	"🤹🏽", "juggler_tone3",
+	"🤹🏽\u200d♀️", "woman_juggling_medium_skin_tone",
+	"🤹🏽\u200d♂️", "man_juggling_medium_skin_tone",
+	"🤹🏾", "juggler_tone4",
+	"🤹🏾\u200d♀️", "woman_juggling_medium_dark_skin_tone",
+	"🤹🏾\u200d♂️", "man_juggling_medium_dark_skin_tone",
+	"🤹🏿", "juggler_tone5",
+	"🤹🏿\u200d♀️", "woman_juggling_dark_skin_tone",
+	"🤹🏿\u200d♂️", "man_juggling_dark_skin_tone",
+	"🤺", "fencing",
+	"🤼", "wrestling",
+	"🤼\u200d♀️", "women_wrestling",
+	"🤼\u200d♂️", "men_wrestling",
+	"🤽", "water_polo",
+	"🤽\u200d♀️", "woman_playing_water_polo",
+	"🤽\u200d♂️", "man_playing_water_polo",
+	"🤽🏻", "water_polo_tone1",
+	"🤽🏻\u200d♀️", "woman_playing_water_polo_light_skin_tone",
+	"🤽🏻\u200d♂️", "man_playing_water_polo_light_skin_tone",
+	"🤽🏼", "water_polo_tone2",
+	"🤽🏼\u200d♀️", "woman_playing_water_polo_medium_light_skin_tone",
+	"🤽🏼\u200d♂️", "man_playing_water_polo_medium_light_skin_tone",
+	"🤽🏽", "water_polo_tone3",
+	"🤽🏽\u200d♀️", "woman_playing_water_polo_medium_skin_tone",
+	"🤽🏽\u200d♂️", "man_playing_water_polo_medium_skin_tone",
+	"🤽🏾", "water_polo_tone4",
+	"🤽🏾\u200d♀️", "woman_playing_water_polo_medium_dark_skin_tone",
+	"🤽🏾\u200d♂️", "man_playing_water_polo_medium_dark_skin_tone",
+	"🤽🏿", "water_polo_tone5",
+	"🤽🏿\u200d♀️", "woman_playing_water_polo_dark_skin_tone",
+	"🤽🏿\u200d♂️", "man_playing_water_polo_dark_skin_tone",
+	"🤾", "handball",
+	"🤾\u200d♀️", "woman_playing_handball",
+	"🤾\u200d♂️", "man_playing_handball",
+	"🤾🏻", "handball_tone1",
+	"🤾🏻\u200d♀️", "woman_playing_handball_light_skin_tone",
+	"🤾🏻\u200d♂️", "man_playing_handball_light_skin_tone",
+	"🤾🏼", "handball_tone2",
+	"🤾🏼\u200d♀️", "woman_playing_handball_medium_light_skin_tone",
+	"🤾🏼\u200d♂️", "man_playing_handball_medium_light_skin_tone",
+	"🤾🏽", "handball_tone3",
+	"🤾🏽\u200d♀️", "woman_playing_handball_medium_skin_tone",
+	"🤾🏽\u200d♂️", "man_playing_handball_medium_skin_tone",
+	"🤾🏾", "handball_tone4",
+	"🤾🏾\u200d♀️", "woman_playing_handball_medium_dark_skin_tone",
+	"🤾🏾\u200d♂️", "man_playing_handball_medium_dark_skin_tone",
+	"🤾🏿", "handball_tone5",
+	"🤾🏿\u200d♀️", "woman_playing_handball_dark_skin_tone",
+	"🤾🏿\u200d♂️", "man_playing_handball_dark_skin_tone",
+	"🤿", "diving_mask",
+	"🥀", "wilted_flower",
+	"🥁", "drum_with_drumsticks",
+	"🥂", "clinking_glass",
+	"🥃", "whisky",
+	"🥄", "spoon",
+	"🥅", "goal_net",
+	"🥇", "first_place_medal",
+	"🥈", "second_place_medal",
+	"🥉", "third_place_medal",
+	"🥊", "boxing_gloves",
+	"🥋", "karate_uniform",
+	"🥌", "curling_stone",
+	"🥍", "lacrosse",
+	"🥎", "softball",
+	"🥏", "flying_disc",
+	"🥐", "croissant",
+	"🥑", "avocado",
+	"🥒", "cucumber",
+	"🥓", "bacon",
+	"🥔", "potato",
+	"🥕", "carrot",
+	"🥖", "baguette_bread",
+	"🥗", "green_salad",
+	"🥘", "paella",
+	"🥙", "stuffed_pita",
+	"🥚", "egg",
+	"🥛", "glass_of_milk",
+	"🥜", "shelled_peanut",
+	"🥝", "kiwifruit",
+	"🥞", "pancakes",
+	"🥟", "dumpling",
+	"🥠", "fortune_cookie",
+	"🥡", "takeout_box",
+	"🥢", "chopsticks",
+	"🥣", "bowl_with_spoon",
+	"🥤", "cup_with_straw",
+	"🥥", "coconut",
+	"🥦", "broccoli",
+	"🥧", "pie",
+	"🥨", "pretzel",
+	"🥩", "cut_of_meat",
+	"🥪", "sandwich",
+	"🥫", "canned_food",
+	"🥬", "leafy_green",
+	"🥭", "mango",
+	"🥮", "moon_cake",
+	"🥯", "bagel",
+	"🥰", "smiling_face_with_3_hearts",
+	"🥱", "yawning_face",
+	"🥲", "smiling_face_with_tear",
+	"🥳", "partying_face",
+	"🥴", "woozy_face",
+	"🥵", "hot_face",
+	"🥶", "cold_face",
+	"🥷", "ninja",
+	"🥷🏻", "ninja_light_skin_tone",
+	"🥷🏼", "ninja_medium_light_skin_tone",
+	"🥷🏽", "ninja_medium_skin_tone",
+	"🥷🏾", "ninja_medium_dark_skin_tone",
+	"🥷🏿", "ninja_dark_skin_tone",
+	"🥸", "disguised_face",
+	"🥹", "face_holding_back_tears",
+	"🥺", "pleading_face",
+	"🥻", "sari",
+	"🥼", "lab_coat",
+	"🥽", "goggles",
+	"🥾", "hiking_boot",
+	"🥿", "womans_flat_shoe",
+	"🦀", "crab",
+	"🦁", "lion",
+	"🦂", "scorpion",
+	"🦃", "turkey",
+	"🦄", "unicorn_face",
+	"🦅", "eagle",
+	"🦆", "duck",
+	"🦇", "bat",
+	"🦈", "shark",
+	"🦉", "owl",
+	"🦊", "fox_face",
+	"🦋", "butterfly",
+	"🦌", "deer",
+	"🦍", "gorilla",
+	"🦎", "lizard",
+	"🦏", "rhinoceros",
+	"🦐", "shrimp",
+	"🦑", "squid",
+	"🦒", "giraffe",
+	"🦓", "zebra",
+	"🦔", "hedgehog",
+	"🦕", "sauropod",
+	"🦖", "t_rex",
+	"🦗", "cricket",
+	"🦘", "kangaroo",
+	"🦙", "llama",
+	"🦚", "peacock",
+	"🦛", "hippopotamus",
+	"🦜", "parrot",
+	"🦝", "raccoon",
+	"🦞", "lobster",
+	"🦟", "mosquito",
+	"🦠", "microbe",
+	"🦡", "badger",
+	"🦢", "swan",
+	"🦣", "mammoth",
+	"🦤", "dodo",
+	"🦥", "sloth",
+	"🦦", "otter",
+	"🦧", "orangutan",
+	"🦨", "skunk",
+	"🦩", "flamingo",
+	"🦪", "oyster",
+	"🦫", "beaver",
+	"🦬", "bison",
+	"🦭", "seal",
+	"🦮", "guide_dog",
+	"🦯", "probing_cane",
+	"🦴", "bone",
+	"🦵", "leg",
+	"🦵🏻", "leg_light_skin_tone",
+	"🦵🏼", "leg_medium_light_skin_tone",
+	"🦵🏽", "leg_medium_skin_tone",
+	"🦵🏾", "leg_medium_dark_skin_tone",
+	"🦵🏿", "leg_dark_skin_tone",
+	"🦶", "foot",
+	"🦶🏻", "foot_light_skin_tone",
+	"🦶🏼", "foot_medium_light_skin_tone",
+	"🦶🏽", "foot_medium_skin_tone",
+	"🦶🏾", "foot_medium_dark_skin_tone",
+	"🦶🏿", "foot_dark_skin_tone",
+	"🦷", "tooth",
+	"🦸", "superhero",
+	"🦸\u200d♀️", "woman_superhero",
+	"🦸\u200d♂️", "man_superhero",
+	"🦸🏻", "superhero_light_skin_tone",
+	"🦸🏻\u200d♀️", "woman_superhero_light_skin_tone",
+	"🦸🏻\u200d♂️", "man_superhero_light_skin_tone",
+	"🦸🏼", "superhero_medium_light_skin_tone",
+	"🦸🏼\u200d♀️", "woman_superhero_medium_light_skin_tone",
+	"🦸🏼\u200d♂️", "man_superhero_medium_light_skin_tone",
+	"🦸🏽", "superhero_medium_skin_tone",
+	"🦸🏽\u200d♀️", "woman_superhero_medium_skin_tone",
+	"🦸🏽\u200d♂️", "man_superhero_medium_skin_tone",
+	"🦸🏾", "superhero_medium_dark_skin_tone",
+	"🦸🏾\u200d♀️", "woman_superhero_medium_dark_skin_tone",
+	"🦸🏾\u200d♂️", "man_superhero_medium_dark_skin_tone",
+	"🦸🏿", "superhero_dark_skin_tone",
+	"🦸🏿\u200d♀️", "woman_superhero_dark_skin_tone",
+	"🦸🏿\u200d♂️", "man_superhero_dark_skin_tone",
+	"🦹", "supervillain",
+	"🦹\u200d♀️", "woman_supervillain",
+	"🦹\u200d♂️", "man_supervillain",
+	"🦹🏻", "supervillain_light_skin_tone",
+	"🦹🏻\u200d♀️", "woman_supervillain_light_skin_tone",
+	"🦹🏻\u200d♂️", "man_supervillain_light_skin_tone",
+	"🦹🏼", "supervillain_medium_light_skin_tone",
+	"🦹🏼\u200d♀️", "woman_supervillain_medium_light_skin_tone",
+	"🦹🏼\u200d♂️", "man_supervillain_medium_light_skin_tone",
+	"🦹🏽", "supervillain_medium_skin_tone",
+	"🦹🏽\u200d♀️", "woman_supervillain_medium_skin_tone",
+	"🦹🏽\u200d♂️", "man_supervillain_medium_skin_tone",
+	"🦹🏾", "supervillain_medium_dark_skin_tone",
+	"🦹🏾\u200d♀️", "woman_supervillain_medium_dark_skin_tone",
+	"🦹🏾\u200d♂️", "man_supervillain_medium_dark_skin_tone",
+	"🦹🏿", "supervillain_dark_skin_tone",
+	"🦹🏿\u200d♀️", "woman_supervillain_dark_skin_tone",
+	"🦹🏿\u200d♂️", "man_supervillain_dark_skin_tone",
+	"🦺", "safety_vest",
+	"🦻", "ear_with_hearing_aid",
+	"🦻🏻", "ear_with_hearing_aid_light_skin_tone",
+	"🦻🏼", "ear_with_hearing_aid_medium_light_skin_tone",
+	"🦻🏽", "ear_with_hearing_aid_medium_skin_tone",
+	"🦻🏾", "ear_with_hearing_aid_medium_dark_skin_tone",
+	"🦻🏿", "ear_with_hearing_aid_dark_skin_tone",
+	"🦼", "motorized_wheelchair",
+	"🦽", "manual_wheelchair",
+	"🦾", "mechanical_arm",
+	"🦿", "mechanical_leg",
+	"🧀", "cheese_wedge",
+	"🧁", "cupcake",
+	"🧂", "salt",
+	"🧃", "beverage_box",
+	"🧄", "garlic",
+	"🧅", "onion",
+	"🧆", "falafel",
+	"🧇", "waffle",
+	"🧈", "butter",
+	"🧉", "mate",
+	"🧊", "ice_cube",
+	"🧋", "bubble_tea",
+	"🧌", "troll",
+	"🧍", "person_standing",
+	"🧍\u200d♀️", "woman_standing",
+	"🧍\u200d♂️", "man_standing",
+	"🧍🏻", "person_standing_light_skin_tone",
+	"🧍🏻\u200d♀️", "woman_standing_light_skin_tone",
+	"🧍🏻\u200d♂️", "man_standing_light_skin_tone",
+	"🧍🏼", "person_standing_medium_light_skin_tone",
+	"🧍🏼\u200d♀️", "woman_standing_medium_light_skin_tone",
+	"🧍🏼\u200d♂️", "man_standing_medium_light_skin_tone",
+	"🧍🏽", "person_standing_medium_skin_tone",
+	"🧍🏽\u200d♀️", "woman_standing_medium_skin_tone",
+	"🧍🏽\u200d♂️", "man_standing_medium_skin_tone",
+	"🧍🏾", "person_standing_medium_dark_skin_tone",
+	"🧍🏾\u200d♀️", "woman_standing_medium_dark_skin_tone",
+	"🧍🏾\u200d♂️", "man_standing_medium_dark_skin_tone",
+	"🧍🏿", "person_standing_dark_skin_tone",
+	"🧍🏿\u200d♀️", "woman_standing_dark_skin_tone",
+	"🧍🏿\u200d♂️", "man_standing_dark_skin_tone",
+	"🧎", "person_kneeling",
+	"🧎\u200d♀️", "woman_kneeling",
+	"🧎\u200d♂️", "man_kneeling",
+	"🧎🏻", "person_kneeling_light_skin_tone",
+	"🧎🏻\u200d♀️", "woman_kneeling_light_skin_tone",
+	"🧎🏻\u200d♂️", "man_kneeling_light_skin_tone",
+	"🧎🏼", "person_kneeling_medium_light_skin_tone",
+	"🧎🏼\u200d♀️", "woman_kneeling_medium_light_skin_tone",
+	"🧎🏼\u200d♂️", "man_kneeling_medium_light_skin_tone",
+	"🧎🏽", "person_kneeling_medium_skin_tone",
+	"🧎🏽\u200d♀️", "woman_kneeling_medium_skin_tone",
+	"🧎🏽\u200d♂️", "man_kneeling_medium_skin_tone",
+	"🧎🏾", "person_kneeling_medium_dark_skin_tone",
+	"🧎🏾\u200d♀️", "woman_kneeling_medium_dark_skin_tone",
+	"🧎🏾\u200d♂️", "man_kneeling_medium_dark_skin_tone",
+	"🧎🏿", "person_kneeling_dark_skin_tone",
+	"🧎🏿\u200d♀️", "woman_kneeling_dark_skin_tone",
+	"🧎🏿\u200d♂️", "man_kneeling_dark_skin_tone",
+	"🧏", "deaf_person",
+	"🧏\u200d♀️", "deaf_woman",
+	"🧏\u200d♂️", "deaf_man",
+	"🧏🏻", "deaf_person_light_skin_tone",
+	"🧏🏻\u200d♀️", "deaf_woman_light_skin_tone",
+	"🧏🏻\u200d♂️", "deaf_man_light_skin_tone",
+	"🧏🏼", "deaf_person_medium_light_skin_tone",
+	"🧏🏼\u200d♀️", "deaf_woman_medium_light_skin_tone",
+	"🧏🏼\u200d♂️", "deaf_man_medium_light_skin_tone",
+	"🧏🏽", "deaf_person_medium_skin_tone",
+	"🧏🏽\u200d♀️", "deaf_woman_medium_skin_tone",
+	"🧏🏽\u200d♂️", "deaf_man_medium_skin_tone",
+	"🧏🏾", "deaf_person_medium_dark_skin_tone",
+	"🧏🏾\u200d♀️", "deaf_woman_medium_dark_skin_tone",
+	"🧏🏾\u200d♂️", "deaf_man_medium_dark_skin_tone",
+	"🧏🏿", "deaf_person_dark_skin_tone",
+	"🧏🏿\u200d♀️", "deaf_woman_dark_skin_tone",
+	"🧏🏿\u200d♂️", "deaf_man_dark_skin_tone",
+	"🧐", "face_with_monocle",
+	"🧑", "adult",
+	"🧑\u200d⚕️", "health_worker",
+	"🧑\u200d⚖️", "judge",
+	"🧑\u200d✈️", "pilot",
+	"🧑\u200d🌾", "farmer",
+	"🧑\u200d🍳", "cook",
+	"🧑\u200d🍼", "person_feeding_baby",
+	"🧑\u200d🎄", "mx_claus",
+	"🧑\u200d🎓", "student",
+	"🧑\u200d🎤", "singer",
+	"🧑\u200d🎨", "artist",
+	"🧑\u200d🏫", "teacher",
+	"🧑\u200d🏭", "factory_worker",
+	"🧑\u200d💻", "technologist",
+	"🧑\u200d💼", "office_worker",
+	"🧑\u200d🔧", "mechanic",
+	"🧑\u200d🔬", "scientist",
+	"🧑\u200d🚀", "astronaut",
+	"🧑\u200d🚒", "firefighter",
+	"🧑\u200d🤝\u200d🧑", "people_holding_hands",
+	"🧑\u200d🦯", "person_with_probing_cane",
+	"🧑\u200d🦰", "person_red_hair",
+	"🧑\u200d🦱", "person_curly_hair",
+	"🧑\u200d🦲", "person_bald",
+	"🧑\u200d🦳", "person_white_hair",
+	"🧑\u200d🦼", "person_in_motorized_wheelchair",
+	"🧑\u200d🦽", "person_in_manual_wheelchair",
+	"🧑🏻", "adult_light_skin_tone",
+	"🧑🏻\u200d⚕️", "health_worker_light_skin_tone",
+	"🧑🏻\u200d⚖️", "judge_light_skin_tone",
+	"🧑🏻\u200d✈️", "pilot_light_skin_tone",
+	"🧑🏻\u200d❤️\u200d💋\u200d🧑🏼", "kiss_person_person_light_skin_tone_medium_light_skin_tone",
+	"🧑🏻\u200d❤️\u200d💋\u200d🧑🏽", "kiss_person_person_light_skin_tone_medium_skin_tone",
+	"🧑🏻\u200d❤️\u200d💋\u200d🧑🏾", "kiss_person_person_light_skin_tone_medium_dark_skin_tone",
+	"🧑🏻\u200d❤️\u200d💋\u200d🧑🏿", "kiss_person_person_light_skin_tone_dark_skin_tone",
+	"🧑🏻\u200d❤️\u200d🧑🏼", "couple_with_heart_person_person_light_skin_tone_medium_light_skin_tone",
+	"🧑🏻\u200d❤️\u200d🧑🏽", "couple_with_heart_person_person_light_skin_tone_medium_skin_tone",
+	"🧑🏻\u200d❤️\u200d🧑🏾", "couple_with_heart_person_person_light_skin_tone_medium_dark_skin_tone",
+	"🧑🏻\u200d❤️\u200d🧑🏿", "couple_with_heart_person_person_light_skin_tone_dark_skin_tone",
+	"🧑🏻\u200d🌾", "farmer_light_skin_tone",
+	"🧑🏻\u200d🍳", "cook_light_skin_tone",
+	"🧑🏻\u200d🍼", "person_feeding_baby_light_skin_tone",
+	"🧑🏻\u200d🎄", "mx_claus_light_skin_tone",
+	"🧑🏻\u200d🎓", "student_light_skin_tone",
+	"🧑🏻\u200d🎤", "singer_light_skin_tone",
+	"🧑🏻\u200d🎨", "artist_light_skin_tone",
+	"🧑🏻\u200d🏫", "teacher_light_skin_tone",
+	"🧑🏻\u200d🏭", "factory_worker_light_skin_tone",
+	"🧑🏻\u200d💻", "technologist_light_skin_tone",
+	"🧑🏻\u200d💼", "office_worker_light_skin_tone",
+	"🧑🏻\u200d🔧", "mechanic_light_skin_tone",
+	"🧑🏻\u200d🔬", "scientist_light_skin_tone",
+	"🧑🏻\u200d🚀", "astronaut_light_skin_tone",
+	"🧑🏻\u200d🚒", "firefighter_light_skin_tone",
+	"🧑🏻\u200d🤝\u200d🧑🏻", "people_holding_hands_light_skin_tone",
+	"🧑🏻\u200d🤝\u200d🧑🏼", "people_holding_hands_light_skin_tone_medium_light_skin_tone",
+	"🧑🏻\u200d🤝\u200d🧑🏽", "people_holding_hands_light_skin_tone_medium_skin_tone",
+	"🧑🏻\u200d🤝\u200d🧑🏾", "people_holding_hands_light_skin_tone_medium_dark_skin_tone",
+	"🧑🏻\u200d🤝\u200d🧑🏿", "people_holding_hands_light_skin_tone_dark_skin_tone",
+	"🧑🏻\u200d🦯", "person_with_probing_cane_light_skin_tone",
+	"🧑🏻\u200d🦰", "person_light_skin_tone_red_hair",
+	"🧑🏻\u200d🦱", "person_light_skin_tone_curly_hair",
+	"🧑🏻\u200d🦲", "person_light_skin_tone_bald",
+	"🧑🏻\u200d🦳", "person_light_skin_tone_white_hair",
+	"🧑🏻\u200d🦼", "person_in_motorized_wheelchair_light_skin_tone",
+	"🧑🏻\u200d🦽", "person_in_manual_wheelchair_light_skin_tone",
+	"🧑🏼", "adult_medium_light_skin_tone",
+	"🧑🏼\u200d⚕️", "health_worker_medium_light_skin_tone",
+	"🧑🏼\u200d⚖️", "judge_medium_light_skin_tone",
+	"🧑🏼\u200d✈️", "pilot_medium_light_skin_tone",
+	"🧑🏼\u200d❤️\u200d💋\u200d🧑🏻", "kiss_person_person_medium_light_skin_tone_light_skin_tone",
+	"🧑🏼\u200d❤️\u200d💋\u200d🧑🏽", "kiss_person_person_medium_light_skin_tone_medium_skin_tone",
+	"🧑🏼\u200d❤️\u200d💋\u200d🧑🏾", "kiss_person_person_medium_light_skin_tone_medium_dark_skin_tone",
+	"🧑🏼\u200d❤️\u200d💋\u200d🧑🏿", "kiss_person_person_medium_light_skin_tone_dark_skin_tone",
+	"🧑🏼\u200d❤️\u200d🧑🏻", "couple_with_heart_person_person_medium_light_skin_tone_light_skin_tone",
+	"🧑🏼\u200d❤️\u200d🧑🏽", "couple_with_heart_person_person_medium_light_skin_tone_medium_skin_tone",
+	"🧑🏼\u200d❤️\u200d🧑🏾", "couple_with_heart_person_person_medium_light_skin_tone_medium_dark_skin_tone",
+	"🧑🏼\u200d❤️\u200d🧑🏿", "couple_with_heart_person_person_medium_light_skin_tone_dark_skin_tone",
+	"🧑🏼\u200d🌾", "farmer_medium_light_skin_tone",
+	"🧑🏼\u200d🍳", "cook_medium_light_skin_tone",
+	"🧑🏼\u200d🍼", "person_feeding_baby_medium_light_skin_tone",
+	"🧑🏼\u200d🎄", "mx_claus_medium_light_skin_tone",
+	"🧑🏼\u200d🎓", "student_medium_light_skin_tone",
+	"🧑🏼\u200d🎤", "singer_medium_light_skin_tone",
+	"🧑🏼\u200d🎨", "artist_medium_light_skin_tone",
+	"🧑🏼\u200d🏫", "teacher_medium_light_skin_tone",
+	"🧑🏼\u200d🏭", "factory_worker_medium_light_skin_tone",
+	"🧑🏼\u200d💻", "technologist_medium_light_skin_tone",
+	"🧑🏼\u200d💼", "office_worker_medium_light_skin_tone",
+	"🧑🏼\u200d🔧", "mechanic_medium_light_skin_tone",
+	"🧑🏼\u200d🔬", "scientist_medium_light_skin_tone",
+	"🧑🏼\u200d🚀", "astronaut_medium_light_skin_tone",
+	"🧑🏼\u200d🚒", "firefighter_medium_light_skin_tone",
+	"🧑🏼\u200d🤝\u200d🧑🏻", "people_holding_hands_medium_light_skin_tone_light_skin_tone",
+	"🧑🏼\u200d🤝\u200d🧑🏼", "people_holding_hands_medium_light_skin_tone",
+	"🧑🏼\u200d🤝\u200d🧑🏽", "people_holding_hands_medium_light_skin_tone_medium_skin_tone",
+	"🧑🏼\u200d🤝\u200d🧑🏾", "people_holding_hands_medium_light_skin_tone_medium_dark_skin_tone",
+	"🧑🏼\u200d🤝\u200d🧑🏿", "people_holding_hands_medium_light_skin_tone_dark_skin_tone",
+	"🧑🏼\u200d🦯", "person_with_probing_cane_medium_light_skin_tone",
+	"🧑🏼\u200d🦰", "person_medium_light_skin_tone_red_hair",
+	"🧑🏼\u200d🦱", "person_medium_light_skin_tone_curly_hair",
+	"🧑🏼\u200d🦲", "person_medium_light_skin_tone_bald",
+	"🧑🏼\u200d🦳", "person_medium_light_skin_tone_white_hair",
+	"🧑🏼\u200d🦼", "person_in_motorized_wheelchair_medium_light_skin_tone",
+	"🧑🏼\u200d🦽", "person_in_manual_wheelchair_medium_light_skin_tone",
+	"🧑🏽", "adult_medium_skin_tone",
+	"🧑🏽\u200d⚕️", "health_worker_medium_skin_tone",
+	"🧑🏽\u200d⚖️", "judge_medium_skin_tone",
+	"🧑🏽\u200d✈️", "pilot_medium_skin_tone",
+	"🧑🏽\u200d❤️\u200d💋\u200d🧑🏻", "kiss_person_person_medium_skin_tone_light_skin_tone",
+	"🧑🏽\u200d❤️\u200d💋\u200d🧑🏼", "kiss_person_person_medium_skin_tone_medium_light_skin_tone",
+	"🧑🏽\u200d❤️\u200d💋\u200d🧑🏾", "kiss_person_person_medium_skin_tone_medium_dark_skin_tone",
+	"🧑🏽\u200d❤️\u200d💋\u200d🧑🏿", "kiss_person_person_medium_skin_tone_dark_skin_tone",
+	"🧑🏽\u200d❤️\u200d🧑🏻", "couple_with_heart_person_person_medium_skin_tone_light_skin_tone",
+	"🧑🏽\u200d❤️\u200d🧑🏼", "couple_with_heart_person_person_medium_skin_tone_medium_light_skin_tone",
+	"🧑🏽\u200d❤️\u200d🧑🏾", "couple_with_heart_person_person_medium_skin_tone_medium_dark_skin_tone",
+	"🧑🏽\u200d❤️\u200d🧑🏿", "couple_with_heart_person_person_medium_skin_tone_dark_skin_tone",
+	"🧑🏽\u200d🌾", "farmer_medium_skin_tone",
+	"🧑🏽\u200d🍳", "cook_medium_skin_tone",
+	"🧑🏽\u200d🍼", "person_feeding_baby_medium_skin_tone",
+	"🧑🏽\u200d🎄", "mx_claus_medium_skin_tone",
+	"🧑🏽\u200d🎓", "student_medium_skin_tone",
+	"🧑🏽\u200d🎤", "singer_medium_skin_tone",
+	"🧑🏽\u200d🎨", "artist_medium_skin_tone",
+	"🧑🏽\u200d🏫", "teacher_medium_skin_tone",
+	"🧑🏽\u200d🏭", "factory_worker_medium_skin_tone",
+	"🧑🏽\u200d💻", "technologist_medium_skin_tone",
+	"🧑🏽\u200d💼", "office_worker_medium_skin_tone",
+	"🧑🏽\u200d🔧", "mechanic_medium_skin_tone",
+	"🧑🏽\u200d🔬", "scientist_medium_skin_tone",
+	"🧑🏽\u200d🚀", "astronaut_medium_skin_tone",
+	"🧑🏽\u200d🚒", "firefighter_medium_skin_tone",
+	"🧑🏽\u200d🤝\u200d🧑🏻", "people_holding_hands_medium_skin_tone_light_skin_tone",
+	"🧑🏽\u200d🤝\u200d🧑🏼", "people_holding_hands_medium_skin_tone_medium_light_skin_tone",
+	"🧑🏽\u200d🤝\u200d🧑🏽", "people_holding_hands_medium_skin_tone",
+	"🧑🏽\u200d🤝\u200d🧑🏾", "people_holding_hands_medium_skin_tone_medium_dark_skin_tone",
+	"🧑🏽\u200d🤝\u200d🧑🏿", "people_holding_hands_medium_skin_tone_dark_skin_tone",
+	"🧑🏽\u200d🦯", "person_with_probing_cane_medium_skin_tone",
+	"🧑🏽\u200d🦰", "person_medium_skin_tone_red_hair",
+	"🧑🏽\u200d🦱", "person_medium_skin_tone_curly_hair",
+	"🧑🏽\u200d🦲", "person_medium_skin_tone_bald",
+	"🧑🏽\u200d🦳", "person_medium_skin_tone_white_hair",
+	"🧑🏽\u200d🦼", "person_in_motorized_wheelchair_medium_skin_tone",
+	"🧑🏽\u200d🦽", "person_in_manual_wheelchair_medium_skin_tone",
+	"🧑🏾", "adult_medium_dark_skin_tone",
+	"🧑🏾\u200d⚕️", "health_worker_medium_dark_skin_tone",
+	"🧑🏾\u200d⚖️", "judge_medium_dark_skin_tone",
+	"🧑🏾\u200d✈️", "pilot_medium_dark_skin_tone",
+	"🧑🏾\u200d❤️\u200d💋\u200d🧑🏻", "kiss_person_person_medium_dark_skin_tone_light_skin_tone",
+	"🧑🏾\u200d❤️\u200d💋\u200d🧑🏼", "kiss_person_person_medium_dark_skin_tone_medium_light_skin_tone",
+	"🧑🏾\u200d❤️\u200d💋\u200d🧑🏽", "kiss_person_person_medium_dark_skin_tone_medium_skin_tone",
+	"🧑🏾\u200d❤️\u200d💋\u200d🧑🏿", "kiss_person_person_medium_dark_skin_tone_dark_skin_tone",
+	"🧑🏾\u200d❤️\u200d🧑🏻", "couple_with_heart_person_person_medium_dark_skin_tone_light_skin_tone",
+	"🧑🏾\u200d❤️\u200d🧑🏼", "couple_with_heart_person_person_medium_dark_skin_tone_medium_light_skin_tone",
+	"🧑🏾\u200d❤️\u200d🧑🏽", "couple_with_heart_person_person_medium_dark_skin_tone_medium_skin_tone",
+	"🧑🏾\u200d❤️\u200d🧑🏿", "couple_with_heart_person_person_medium_dark_skin_tone_dark_skin_tone",
+	"🧑🏾\u200d🌾", "farmer_medium_dark_skin_tone",
+	"🧑🏾\u200d🍳", "cook_medium_dark_skin_tone",
+	"🧑🏾\u200d🍼", "person_feeding_baby_medium_dark_skin_tone",
+	"🧑🏾\u200d🎄", "mx_claus_medium_dark_skin_tone",
+	"🧑🏾\u200d🎓", "student_medium_dark_skin_tone",
+	"🧑🏾\u200d🎤", "singer_medium_dark_skin_tone",
+	"🧑🏾\u200d🎨", "artist_medium_dark_skin_tone",
+	"🧑🏾\u200d🏫", "teacher_medium_dark_skin_tone",
+	"🧑🏾\u200d🏭", "factory_worker_medium_dark_skin_tone",
+	"🧑🏾\u200d💻", "technologist_medium_dark_skin_tone",
+	"🧑🏾\u200d💼", "office_worker_medium_dark_skin_tone",
+	"🧑🏾\u200d🔧", "mechanic_medium_dark_skin_tone",
+	"🧑🏾\u200d🔬", "scientist_medium_dark_skin_tone",
+	"🧑🏾\u200d🚀", "astronaut_medium_dark_skin_tone",
+	"🧑🏾\u200d🚒", "firefighter_medium_dark_skin_tone",
+	"🧑🏾\u200d🤝\u200d🧑🏻", "people_holding_hands_medium_dark_skin_tone_light_skin_tone",
+	"🧑🏾\u200d🤝\u200d🧑🏼", "people_holding_hands_medium_dark_skin_tone_medium_light_skin_tone",
+	"🧑🏾\u200d🤝\u200d🧑🏽", "people_holding_hands_medium_dark_skin_tone_medium_skin_tone",
+	"🧑🏾\u200d🤝\u200d🧑🏾", "people_holding_hands_medium_dark_skin_tone",
+	"🧑🏾\u200d🤝\u200d🧑🏿", "people_holding_hands_medium_dark_skin_tone_dark_skin_tone",
+	"🧑🏾\u200d🦯", "person_with_probing_cane_medium_dark_skin_tone",
+	"🧑🏾\u200d🦰", "person_medium_dark_skin_tone_red_hair",
+	"🧑🏾\u200d🦱", "person_medium_dark_skin_tone_curly_hair",
+	"🧑🏾\u200d🦲", "person_medium_dark_skin_tone_bald",
+	"🧑🏾\u200d🦳", "person_medium_dark_skin_tone_white_hair",
+	"🧑🏾\u200d🦼", "person_in_motorized_wheelchair_medium_dark_skin_tone",
+	"🧑🏾\u200d🦽", "person_in_manual_wheelchair_medium_dark_skin_tone",
+	"🧑🏿", "adult_dark_skin_tone",
+	"🧑🏿\u200d⚕️", "health_worker_dark_skin_tone",
+	"🧑🏿\u200d⚖️", "judge_dark_skin_tone",
+	"🧑🏿\u200d✈️", "pilot_dark_skin_tone",
+	"🧑🏿\u200d❤️\u200d💋\u200d🧑🏻", "kiss_person_person_dark_skin_tone_light_skin_tone",
+	"🧑🏿\u200d❤️\u200d💋\u200d🧑🏼", "kiss_person_person_dark_skin_tone_medium_light_skin_tone",
+	"🧑🏿\u200d❤️\u200d💋\u200d🧑🏽", "kiss_person_person_dark_skin_tone_medium_skin_tone",
+	"🧑🏿\u200d❤️\u200d💋\u200d🧑🏾", "kiss_person_person_dark_skin_tone_medium_dark_skin_tone",
+	"🧑🏿\u200d❤️\u200d🧑🏻", "couple_with_heart_person_person_dark_skin_tone_light_skin_tone",
+	"🧑🏿\u200d❤️\u200d🧑🏼", "couple_with_heart_person_person_dark_skin_tone_medium_light_skin_tone",
+	"🧑🏿\u200d❤️\u200d🧑🏽", "couple_with_heart_person_person_dark_skin_tone_medium_skin_tone",
+	"🧑🏿\u200d❤️\u200d🧑🏾", "couple_with_heart_person_person_dark_skin_tone_medium_dark_skin_tone",
+	"🧑🏿\u200d🌾", "farmer_dark_skin_tone",
+	"🧑🏿\u200d🍳", "cook_dark_skin_tone",
+	"🧑🏿\u200d🍼", "person_feeding_baby_dark_skin_tone",
+	"🧑🏿\u200d🎄", "mx_claus_dark_skin_tone",
+	"🧑🏿\u200d🎓", "student_dark_skin_tone",
+	"🧑🏿\u200d🎤", "singer_dark_skin_tone",
+	"🧑🏿\u200d🎨", "artist_dark_skin_tone",
+	"🧑🏿\u200d🏫", "teacher_dark_skin_tone",
+	"🧑🏿\u200d🏭", "factory_worker_dark_skin_tone",
+	"🧑🏿\u200d💻", "technologist_dark_skin_tone",
+	"🧑🏿\u200d💼", "office_worker_dark_skin_tone",
+	"🧑🏿\u200d🔧", "mechanic_dark_skin_tone",
+	"🧑🏿\u200d🔬", "scientist_dark_skin_tone",
+	"🧑🏿\u200d🚀", "astronaut_dark_skin_tone",
+	"🧑🏿\u200d🚒", "firefighter_dark_skin_tone",
+	"🧑🏿\u200d🤝\u200d🧑🏻", "people_holding_hands_dark_skin_tone_light_skin_tone",
+	"🧑🏿\u200d🤝\u200d🧑🏼", "people_holding_hands_dark_skin_tone_medium_light_skin_tone",
+	"🧑🏿\u200d🤝\u200d🧑🏽", "people_holding_hands_dark_skin_tone_medium_skin_tone",
+	"🧑🏿\u200d🤝\u200d🧑🏾", "people_holding_hands_dark_skin_tone_medium_dark_skin_tone",
+	"🧑🏿\u200d🤝\u200d🧑🏿", "people_holding_hands_dark_skin_tone",
+	"🧑🏿\u200d🦯", "person_with_probing_cane_dark_skin_tone",
+	"🧑🏿\u200d🦰", "person_dark_skin_tone_red_hair",
+	"🧑🏿\u200d🦱", "person_dark_skin_tone_curly_hair",
+	"🧑🏿\u200d🦲", "person_dark_skin_tone_bald",
+	"🧑🏿\u200d🦳", "person_dark_skin_tone_white_hair",
+	"🧑🏿\u200d🦼", "person_in_motorized_wheelchair_dark_skin_tone",
+	"🧑🏿\u200d🦽", "person_in_manual_wheelchair_dark_skin_tone",
+	"🧒", "child",
+	"🧒🏻", "child_light_skin_tone",
+	"🧒🏼", "child_medium_light_skin_tone",
+	"🧒🏽", "child_medium_skin_tone",
+	"🧒🏾", "child_medium_dark_skin_tone",
+	"🧒🏿", "child_dark_skin_tone",
+	"🧓", "older_adult",
+	"🧓🏻", "older_adult_light_skin_tone",
+	"🧓🏼", "older_adult_medium_light_skin_tone",
+	"🧓🏽", "older_adult_medium_skin_tone",
+	"🧓🏾", "older_adult_medium_dark_skin_tone",
+	"🧓🏿", "older_adult_dark_skin_tone",
+	"🧔", "bearded_person",
+	"🧔\u200d♀️", "woman_beard",
+	"🧔\u200d♂️", "man_beard",
+	"🧔🏻", "bearded_person_light_skin_tone",
+	"🧔🏻\u200d♀️", "woman_light_skin_tone_beard",
+	"🧔🏻\u200d♂️", "man_light_skin_tone_beard",
+	"🧔🏼", "bearded_person_medium_light_skin_tone",
+	"🧔🏼\u200d♀️", "woman_medium_light_skin_tone_beard",
+	"🧔🏼\u200d♂️", "man_medium_light_skin_tone_beard",
+	"🧔🏽", "bearded_person_medium_skin_tone",
+	"🧔🏽\u200d♀️", "woman_medium_skin_tone_beard",
+	"🧔🏽\u200d♂️", "man_medium_skin_tone_beard",
+	"🧔🏾", "bearded_person_medium_dark_skin_tone",
+	"🧔🏾\u200d♀️", "woman_medium_dark_skin_tone_beard",
+	"🧔🏾\u200d♂️", "man_medium_dark_skin_tone_beard",
+	"🧔🏿", "bearded_person_dark_skin_tone",
+	"🧔🏿\u200d♀️", "woman_dark_skin_tone_beard",
+	"🧔🏿\u200d♂️", "man_dark_skin_tone_beard",
+	"🧕", "woman_with_headscarf",
+	"🧕🏻", "woman_with_headscarf_light_skin_tone",
+	"🧕🏼", "woman_with_headscarf_medium_light_skin_tone",
+	"🧕🏽", "woman_with_headscarf_medium_skin_tone",
+	"🧕🏾", "woman_with_headscarf_medium_dark_skin_tone",
+	"🧕🏿", "woman_with_headscarf_dark_skin_tone",
+	"🧖", "person_in_steamy_room",
+	"🧖\u200d♀️", "woman_in_steamy_room",
+	"🧖\u200d♂️", "man_in_steamy_room",
+	"🧖🏻", "person_in_steamy_room_light_skin_tone",
+	"🧖🏻\u200d♀️", "woman_in_steamy_room_light_skin_tone",
+	"🧖🏻\u200d♂️", "man_in_steamy_room_light_skin_tone",
+	"🧖🏼", "person_in_steamy_room_medium_light_skin_tone",
+	"🧖🏼\u200d♀️", "woman_in_steamy_room_medium_light_skin_tone",
+	"🧖🏼\u200d♂️", "man_in_steamy_room_medium_light_skin_tone",
+	"🧖🏽", "person_in_steamy_room_medium_skin_tone",
+	"🧖🏽\u200d♀️", "woman_in_steamy_room_medium_skin_tone",
+	"🧖🏽\u200d♂️", "man_in_steamy_room_medium_skin_tone",
+	"🧖🏾", "person_in_steamy_room_medium_dark_skin_tone",
+	"🧖🏾\u200d♀️", "woman_in_steamy_room_medium_dark_skin_tone",
+	"🧖🏾\u200d♂️", "man_in_steamy_room_medium_dark_skin_tone",
+	"🧖🏿", "person_in_steamy_room_dark_skin_tone",
+	"🧖🏿\u200d♀️", "woman_in_steamy_room_dark_skin_tone",
+	"🧖🏿\u200d♂️", "man_in_steamy_room_dark_skin_tone",
+	"🧗", "person_climbing",
+	"🧗\u200d♀️", "woman_climbing",
+	"🧗\u200d♂️", "man_climbing",
+	"🧗🏻", "person_climbing_light_skin_tone",
+	"🧗🏻\u200d♀️", "woman_climbing_light_skin_tone",
+	"🧗🏻\u200d♂️", "man_climbing_light_skin_tone",
+	"🧗🏼", "person_climbing_medium_light_skin_tone",
+	"🧗🏼\u200d♀️", "woman_climbing_medium_light_skin_tone",
+	"🧗🏼\u200d♂️", "man_climbing_medium_light_skin_tone",
+	"🧗🏽", "person_climbing_medium_skin_tone",
+	"🧗🏽\u200d♀️", "woman_climbing_medium_skin_tone",
+	"🧗🏽\u200d♂️", "man_climbing_medium_skin_tone",
+	"🧗🏾", "person_climbing_medium_dark_skin_tone",
+	"🧗🏾\u200d♀️", "woman_climbing_medium_dark_skin_tone",
+	"🧗🏾\u200d♂️", "man_climbing_medium_dark_skin_tone",
+	"🧗🏿", "person_climbing_dark_skin_tone",
+	"🧗🏿\u200d♀️", "woman_climbing_dark_skin_tone",
+	"🧗🏿\u200d♂️", "man_climbing_dark_skin_tone",
+	"🧘", "person_in_lotus_position",
+	"🧘\u200d♀️", "woman_in_lotus_position",
+	"🧘\u200d♂️", "man_in_lotus_position",
+	"🧘🏻", "person_in_lotus_position_light_skin_tone",
+	"🧘🏻\u200d♀️", "woman_in_lotus_position_light_skin_tone",
+	"🧘🏻\u200d♂️", "man_in_lotus_position_light_skin_tone",
+	"🧘🏼", "person_in_lotus_position_medium_light_skin_tone",
+	"🧘🏼\u200d♀️", "woman_in_lotus_position_medium_light_skin_tone",
+	"🧘🏼\u200d♂️", "man_in_lotus_position_medium_light_skin_tone",
+	"🧘🏽", "person_in_lotus_position_medium_skin_tone",
+	"🧘🏽\u200d♀️", "woman_in_lotus_position_medium_skin_tone",
+	"🧘🏽\u200d♂️", "man_in_lotus_position_medium_skin_tone",
+	"🧘🏾", "person_in_lotus_position_medium_dark_skin_tone",
+	"🧘🏾\u200d♀️", "woman_in_lotus_position_medium_dark_skin_tone",
+	"🧘🏾\u200d♂️", "man_in_lotus_position_medium_dark_skin_tone",
+	"🧘🏿", "person_in_lotus_position_dark_skin_tone",
+	"🧘🏿\u200d♀️", "woman_in_lotus_position_dark_skin_tone",
+	"🧘🏿\u200d♂️", "man_in_lotus_position_dark_skin_tone",
+	"🧙", "mage",
+	"🧙\u200d♀️", "woman_mage",
+	"🧙\u200d♂️", "man_mage",
+	"🧙🏻", "mage_light_skin_tone",
+	"🧙🏻\u200d♀️", "woman_mage_light_skin_tone",
+	"🧙🏻\u200d♂️", "man_mage_light_skin_tone",
+	"🧙🏼", "mage_medium_light_skin_tone",
+	"🧙🏼\u200d♀️", "woman_mage_medium_light_skin_tone",
+	"🧙🏼\u200d♂️", "man_mage_medium_light_skin_tone",
+	"🧙🏽", "mage_medium_skin_tone",
+	"🧙🏽\u200d♀️", "woman_mage_medium_skin_tone",
+	"🧙🏽\u200d♂️", "man_mage_medium_skin_tone",
+	"🧙🏾", "mage_medium_dark_skin_tone",
+	"🧙🏾\u200d♀️", "woman_mage_medium_dark_skin_tone",
+	"🧙🏾\u200d♂️", "man_mage_medium_dark_skin_tone",
+	"🧙🏿", "mage_dark_skin_tone",
+	"🧙🏿\u200d♀️", "woman_mage_dark_skin_tone",
+	"🧙🏿\u200d♂️", "man_mage_dark_skin_tone",
+	"🧚", "fairy",
+	"🧚\u200d♀️", "woman_fairy",
+	"🧚\u200d♂️", "man_fairy",
+	"🧚🏻", "fairy_light_skin_tone",
+	"🧚🏻\u200d♀️", "woman_fairy_light_skin_tone",
+	"🧚🏻\u200d♂️", "man_fairy_light_skin_tone",
+	"🧚🏼", "fairy_medium_light_skin_tone",
+	"🧚🏼\u200d♀️", "woman_fairy_medium_light_skin_tone",
+	"🧚🏼\u200d♂️", "man_fairy_medium_light_skin_tone",
+	"🧚🏽", "fairy_medium_skin_tone",
+	"🧚🏽\u200d♀️", "woman_fairy_medium_skin_tone",
+	"🧚🏽\u200d♂️", "man_fairy_medium_skin_tone",
+	"🧚🏾", "fairy_medium_dark_skin_tone",
+	"🧚🏾\u200d♀️", "woman_fairy_medium_dark_skin_tone",
+	"🧚🏾\u200d♂️", "man_fairy_medium_dark_skin_tone",
+	"🧚🏿", "fairy_dark_skin_tone",
+	"🧚🏿\u200d♀️", "woman_fairy_dark_skin_tone",
+	"🧚🏿\u200d♂️", "man_fairy_dark_skin_tone",
+	"🧛", "vampire",
+	"🧛\u200d♀️", "woman_vampire",
+	"🧛\u200d♂️", "man_vampire",
+	"🧛🏻", "vampire_light_skin_tone",
+	"🧛🏻\u200d♀️", "woman_vampire_light_skin_tone",
+	"🧛🏻\u200d♂️", "man_vampire_light_skin_tone",
+	"🧛🏼", "vampire_medium_light_skin_tone",
+	"🧛🏼\u200d♀️", "woman_vampire_medium_light_skin_tone",
+	"🧛🏼\u200d♂️", "man_vampire_medium_light_skin_tone",
+	"🧛🏽", "vampire_medium_skin_tone",
+	"🧛🏽\u200d♀️", "woman_vampire_medium_skin_tone",
+	"🧛🏽\u200d♂️", "man_vampire_medium_skin_tone",
+	"🧛🏾", "vampire_medium_dark_skin_tone",
+	"🧛🏾\u200d♀️", "woman_vampire_medium_dark_skin_tone",
+	"🧛🏾\u200d♂️", "man_vampire_medium_dark_skin_tone",
+	"🧛🏿", "vampire_dark_skin_tone",
+	"🧛🏿\u200d♀️", "woman_vampire_dark_skin_tone",
+	"🧛🏿\u200d♂️", "man_vampire_dark_skin_tone",
+	"🧜", "merperson",
+	"🧜\u200d♀️", "mermaid",
+	"🧜\u200d♂️", "merman",
+	"🧜🏻", "merperson_light_skin_tone",
+	"🧜🏻\u200d♀️", "mermaid_light_skin_tone",
+	"🧜🏻\u200d♂️", "merman_light_skin_tone",
+	"🧜🏼", "merperson_medium_light_skin_tone",
+	"🧜🏼\u200d♀️", "mermaid_medium_light_skin_tone",
+	"🧜🏼\u200d♂️", "merman_medium_light_skin_tone",
+	"🧜🏽", "merperson_medium_skin_tone",
+	"🧜🏽\u200d♀️", "mermaid_medium_skin_tone",
+	"🧜🏽\u200d♂️", "merman_medium_skin_tone",
+	"🧜🏾", "merperson_medium_dark_skin_tone",
+	"🧜🏾\u200d♀️", "mermaid_medium_dark_skin_tone",
+	"🧜🏾\u200d♂️", "merman_medium_dark_skin_tone",
+	"🧜🏿", "merperson_dark_skin_tone",
+	"🧜🏿\u200d♀️", "mermaid_dark_skin_tone",
+	"🧜🏿\u200d♂️", "merman_dark_skin_tone",
+	"🧝", "elf",
+	"🧝\u200d♀️", "woman_elf",
+	"🧝\u200d♂️", "man_elf",
+	"🧝🏻", "elf_light_skin_tone",
+	"🧝🏻\u200d♀️", "woman_elf_light_skin_tone",
+	"🧝🏻\u200d♂️", "man_elf_light_skin_tone",
+	"🧝🏼", "elf_medium_light_skin_tone",
+	"🧝🏼\u200d♀️", "woman_elf_medium_light_skin_tone",
+	"🧝🏼\u200d♂️", "man_elf_medium_light_skin_tone",
+	"🧝🏽", "elf_medium_skin_tone",
+	"🧝🏽\u200d♀️", "woman_elf_medium_skin_tone",
+	"🧝🏽\u200d♂️", "man_elf_medium_skin_tone",
+	"🧝🏾", "elf_medium_dark_skin_tone",
+	"🧝🏾\u200d♀️", "woman_elf_medium_dark_skin_tone",
+	"🧝🏾\u200d♂️", "man_elf_medium_dark_skin_tone",
+	"🧝🏿", "elf_dark_skin_tone",
+	"🧝🏿\u200d♀️", "woman_elf_dark_skin_tone",
+	"🧝🏿\u200d♂️", "man_elf_dark_skin_tone",
+	"🧞", "genie",
+	"🧞\u200d♀️", "woman_genie",
+	"🧞\u200d♂️", "man_genie",
+	"🧟", "zombie",
+	"🧟\u200d♀️", "woman_zombie",
+	"🧟\u200d♂️", "man_zombie",
+	"🧠", "brain",
+	"🧡", "orange_heart",
+	"🧢", "billed_cap",
+	"🧣", "scarf",
+	"🧤", "gloves",
+	"🧥", "coat",
+	"🧦", "socks",
+	"🧧", "red_envelope",
+	"🧨", "firecracker",
+	"🧩", "jigsaw",
+	"🧪", "test_tube",
+	"🧫", "petri_dish",
+	"🧬", "dna",
+	"🧭", "compass",
+	"🧮", "abacus",
+	"🧯", "fire_extinguisher",
+	"🧰", "toolbox",
+	"🧱", "bricks",
+	"🧲", "magnet",
+	"🧳", "luggage",
+	"🧴", "squeeze_bottle",
+	"🧵", "thread",
+	"🧶", "yarn",
+	"🧷", "safety_pin",
+	"🧸", "teddy_bear",
+	"🧹", "broom",
+	"🧺", "basket",
+	"🧻", "roll_of_paper",
+	"🧼", "soap",
+	"🧽", "sponge",
+	"🧾", "receipt",
+	"🧿", "nazar_amulet",
+	"🩰", "ballet_shoes",
+	"🩱", "one_piece_swimsuit",
+	"🩲", "briefs",
+	"🩳", "shorts",
+	"🩴", "thong_sandal",
+	"🩸", "drop_of_blood",
+	"🩹", "adhesive_bandage",
+	"🩺", "stethoscope",
+	"🩻", "x_ray",
+	"🩼", "crutch",
+	"🪀", "yo_yo",
+	"🪁", "kite",
+	"🪂", "parachute",
+	"🪃", "boomerang",
+	"🪄", "magic_wand",
+	"🪅", "piñata",
+	"🪆", "nesting_dolls",
+	"🪐", "ringed_planet",
+	"🪑", "chair",
+	"🪒", "razor",
+	"🪓", "axe",
+	"🪔", "diya_lamp",
+	"🪕", "banjo",
+	"🪖", "military_helmet",
+	"🪗", "accordion",
+	"🪘", "long_drum",
+	"🪙", "coin",
+	"🪚", "carpentry_saw",
+	"🪛", "screwdriver",
+	"🪜", "ladder",
+	"🪝", "hook",
+	"🪞", "mirror",
+	"🪟", "window",
+	"🪠", "plunger",
+	"🪡", "sewing_needle",
+	"🪢", "knot",
+	"🪣", "bucket",
+	"🪤", "mouse_trap",
+	"🪥", "toothbrush",
+	"🪦", "headstone",
+	"🪧", "placard",
+	"🪨", "rock",
+	"🪩", "mirror_ball",
+	"🪪", "identification_card",
+	"🪫", "low_battery",
+	"🪬", "hamsa",
+	"🪰", "fly",
+	"🪱", "worm",
+	"🪲", "beetle",
+	"🪳", "cockroach",
+	"🪴", "potted_plant",
+	"🪵", "wood",
+	"🪶", "feather",
+	"🪷", "lotus",
+	"🪸", "coral",
+	"🪹", "empty_nest",
+	"🪺", "nest_with_eggs",
+	"🫀", "anatomical_heart",
+	"🫁", "lungs",
+	"🫂", "people_hugging",
+	"🫃", "pregnant_man",
+	"🫃🏻", "pregnant_man_light_skin_tone",
+	"🫃🏼", "pregnant_man_medium_light_skin_tone",
+	"🫃🏽", "pregnant_man_medium_skin_tone",
+	"🫃🏾", "pregnant_man_medium_dark_skin_tone",
+	"🫃🏿", "pregnant_man_dark_skin_tone",
+	"🫄", "pregnant_person",
+	"🫄🏻", "pregnant_person_light_skin_tone",
+	"🫄🏼", "pregnant_person_medium_light_skin_tone",
+	"🫄🏽", "pregnant_person_medium_skin_tone",
+	"🫄🏾", "pregnant_person_medium_dark_skin_tone",
+	"🫄🏿", "pregnant_person_dark_skin_tone",
+	"🫅", "person_with_crown",
+	"🫅🏻", "person_with_crown_light_skin_tone",
+	"🫅🏼", "person_with_crown_medium_light_skin_tone",
+	"🫅🏽", "person_with_crown_medium_skin_tone",
+	"🫅🏾", "person_with_crown_medium_dark_skin_tone",
+	"🫅🏿", "person_with_crown_dark_skin_tone",
+	"🫐", "blueberries",
+	"🫑", "bell_pepper",
+	"🫒", "olive",
+	"🫓", "flatbread",
+	"🫔", "tamale",
+	"🫕", "fondue",
+	"🫖", "teapot",
+	"🫗", "pouring_liquid",
+	"🫘", "beans",
+	"🫙", "jar",
+	"🫠", "melting_face",
+	"🫡", "saluting_face",
+	"🫢", "face_with_open_eyes_and_hand_over_mouth",
+	"🫣", "face_with_peeking_eye",
+	"🫤", "face_with_diagonal_mouth",
+	"🫥", "dotted_line_face",
+	"🫦", "biting_lip",
+	"🫧", "bubbles",
+	"🫰", "hand_with_index_finger_and_thumb_crossed",
+	"🫰🏻", "hand_with_index_finger_and_thumb_crossed_light_skin_tone",
+	"🫰🏼", "hand_with_index_finger_and_thumb_crossed_medium_light_skin_tone",
+	"🫰🏽", "hand_with_index_finger_and_thumb_crossed_medium_skin_tone",
+	"🫰🏾", "hand_with_index_finger_and_thumb_crossed_medium_dark_skin_tone",
+	"🫰🏿", "hand_with_index_finger_and_thumb_crossed_dark_skin_tone",
+	"🫱", "rightwards_hand",
+	"🫱🏻", "rightwards_hand_light_skin_tone",
+	"🫱🏻\u200d🫲🏼", "handshake_light_skin_tone_medium_light_skin_tone",
+	"🫱🏻\u200d🫲🏽", "handshake_light_skin_tone_medium_skin_tone",
+	"🫱🏻\u200d🫲🏾", "handshake_light_skin_tone_medium_dark_skin_tone",
+	"🫱🏻\u200d🫲🏿", "handshake_light_skin_tone_dark_skin_tone",
+	"🫱🏼", "rightwards_hand_medium_light_skin_tone",
+	"🫱🏼\u200d🫲🏻", "handshake_medium_light_skin_tone_light_skin_tone",
+	"🫱🏼\u200d🫲🏽", "handshake_medium_light_skin_tone_medium_skin_tone",
+	"🫱🏼\u200d🫲🏾", "handshake_medium_light_skin_tone_medium_dark_skin_tone",
+	"🫱🏼\u200d🫲🏿", "handshake_medium_light_skin_tone_dark_skin_tone",
+	"🫱🏽", "rightwards_hand_medium_skin_tone",
+	"🫱🏽\u200d🫲🏻", "handshake_medium_skin_tone_light_skin_tone",
+	"🫱🏽\u200d🫲🏼", "handshake_medium_skin_tone_medium_light_skin_tone",
+	"🫱🏽\u200d🫲🏾", "handshake_medium_skin_tone_medium_dark_skin_tone",
+	"🫱🏽\u200d🫲🏿", "handshake_medium_skin_tone_dark_skin_tone",
+	"🫱🏾", "rightwards_hand_medium_dark_skin_tone",
+	"🫱🏾\u200d🫲🏻", "handshake_medium_dark_skin_tone_light_skin_tone",
+	"🫱🏾\u200d🫲🏼", "handshake_medium_dark_skin_tone_medium_light_skin_tone",
+	"🫱🏾\u200d🫲🏽", "handshake_medium_dark_skin_tone_medium_skin_tone",
+	"🫱🏾\u200d🫲🏿", "handshake_medium_dark_skin_tone_dark_skin_tone",
+	"🫱🏿", "rightwards_hand_dark_skin_tone",
+	"🫱🏿\u200d🫲🏻", "handshake_dark_skin_tone_light_skin_tone",
+	"🫱🏿\u200d🫲🏼", "handshake_dark_skin_tone_medium_light_skin_tone",
+	"🫱🏿\u200d🫲🏽", "handshake_dark_skin_tone_medium_skin_tone",
+	"🫱🏿\u200d🫲🏾", "handshake_dark_skin_tone_medium_dark_skin_tone",
+	"🫲", "leftwards_hand",
+	"🫲🏻", "leftwards_hand_light_skin_tone",
+	"🫲🏼", "leftwards_hand_medium_light_skin_tone",
+	"🫲🏽", "leftwards_hand_medium_skin_tone",
+	"🫲🏾", "leftwards_hand_medium_dark_skin_tone",
+	"🫲🏿", "leftwards_hand_dark_skin_tone",
+	"🫳", "palm_down_hand",
+	"🫳🏻", "palm_down_hand_light_skin_tone",
+	"🫳🏼", "palm_down_hand_medium_light_skin_tone",
+	"🫳🏽", "palm_down_hand_medium_skin_tone",
+	"🫳🏾", "palm_down_hand_medium_dark_skin_tone",
+	"🫳🏿", "palm_down_hand_dark_skin_tone",
+	"🫴", "palm_up_hand",
+	"🫴🏻", "palm_up_hand_light_skin_tone",
+	"🫴🏼", "palm_up_hand_medium_light_skin_tone",
+	"🫴🏽", "palm_up_hand_medium_skin_tone",
+	"🫴🏾", "palm_up_hand_medium_dark_skin_tone",
+	"🫴🏿", "palm_up_hand_dark_skin_tone",
+	"🫵", "index_pointing_at_the_viewer",
+	"🫵🏻", "index_pointing_at_the_viewer_light_skin_tone",
+	"🫵🏼", "index_pointing_at_the_viewer_medium_light_skin_tone",
+	"🫵🏽", "index_pointing_at_the_viewer_medium_skin_tone",
+	"🫵🏾", "index_pointing_at_the_viewer_medium_dark_skin_tone",
+	"🫵🏿", "index_pointing_at_the_viewer_dark_skin_tone",
+	"🫶", "heart_hands",
+	"🫶🏻", "heart_hands_light_skin_tone",
+	"🫶🏼", "heart_hands_medium_light_skin_tone",
+	"🫶🏽", "heart_hands_medium_skin_tone",
+	"🫶🏾", "heart_hands_medium_dark_skin_tone",
+	"🫶🏿", "heart_hands_dark_skin_tone",
+}
+
+var packedUnicodeEmojisSVG = [...]string{
+	"1f004", "1bf32ef6b612a4f8539a083d233c065b",
+	"1f0cf", "080ef5f5f55bdf671af4e31d41b893d3",
+	"1f170", "c7187bc733565edcc179626010040af7",
+	"1f171", "a3332ef2e06680bfa80635bd332a7abc",
+	"1f17e", "bd98f7be046312fa0fe14e6471496949",
+	"1f17f", "ebff6c97250daa98236fb09f024cb6a2",
+	"1f18e", "07af81a5ac6f3aaf50115980ac08f045",
+	"1f191", "b562dfc21bd896ab902e96ce0dbada56",
+	"1f192", "493b294d10a152f36d7513101fcbc1b7",
+	"1f193", "b083a3f93b31a4f6289d8515841ebb67",
+	"1f194", "91b1589451155bddf09b376a16e01a8e",
+	"1f195", "b1b98f44f621fc902b43b6253f27bb7b",
+	"1f196", "833042af2e090050df33e0bc5fc1034d",
+	"1f197", "1bef63657aa7eb2726e9cc735245a88f",
+	"1f198", "7b1bc30c9fdddb9ec771b4e8904178ef",
+	"1f199", "5393e51a22216db7b4e10f8179875e4d",
+	"1f19a", "d6f73a44b9145920c4f29a1a5cac983a",
+	"1f1e6", "bbe8ae762f831966587a35010ed46f67",
+	"1f1e6-1f1e8", "ce540ee7717eb2e93a07313ee897c9eb",
+	"1f1e6-1f1e9", "03edda8fc710082317dc192b7988f368",
+	"1f1e6-1f1ea", "1e0f8ea8b39c7c12501b0a0deda991a6",
+	"1f1e6-1f1eb", "46fecbc0b0e0c44d1171b20a5f2cae44",
+	"1f1e6-1f1ec", "e0f5696c968c0c55a171b7a06d176bed",
+	"1f1e6-1f1ee", "90dc2197923497786683b1450e275250",
+	"1f1e6-1f1f1", "d2504bd52ecf3946c9bfc0ef5e797e3c",
+	"1f1e6-1f1f2", "be7d5f1c6163091f9d2487a065e9dffb",
+	"1f1e6-1f1f4", "3442c32e82d34c1e193ce979ca575b75",
+	"1f1e6-1f1f6", "70454ad4b759ce057e9b5ae8bfc74ddb",
+	"1f1e6-1f1f7", "06b81704d7b89f08dae734409b3f9320",
+	"1f1e6-1f1f8", "2bbe762f2ed3ca3d9daab13cb96efada",
+	"1f1e6-1f1f9", "31ad9f90fa231ed0c0b947deadbfe176",
+	"1f1e6-1f1fa", "089a9442fa6faeffeda0150ae182eccc",
+	"1f1e6-1f1fc", "b6b3224833a3fab47498f6a9d83be368",
+	"1f1e6-1f1fd", "364f7b7682f692230c6871fc30f19876",
+	"1f1e6-1f1ff", "765d88cb946d2435d143da7638c3a009",
+	"1f1e7", "515873f6898e0b26daf51921c65a43f7",
+	"1f1e7-1f1e6", "cba3943cf437ace539cf137343c080d6",
+	"1f1e7-1f1e7", "ccd75c3d36c0427d63f026bd08eec5ae",
+	"1f1e7-1f1e9", "9bed1a653d1ed1f2106c8b0eb13b6c43",
+	"1f1e7-1f1ea", "fbece1691fc06253b81711f3bbc0c807",
+	"1f1e7-1f1eb", "b618d9b3b084c13742cf10508f72cae9",
+	"1f1e7-1f1ec", "3b7879f4b8000316eb26cbef996102bf",
+	"1f1e7-1f1ed", "1203d99f39734ebef456a7fa7df54916",
+	"1f1e7-1f1ee", "11d24452092b69aefaa2bc1cfd8d080c",
+	"1f1e7-1f1ef", "88a8d5ab27e338b68bb20fa20577405c",
+	"1f1e7-1f1f1", "8cde1631998a5b968a69063ab5b969db",
+	"1f1e7-1f1f2", "cef76050138bc289891b492d1703fedb",
+	"1f1e7-1f1f3", "7cc3012c0a3c2e6502fff3f2061283a5",
+	"1f1e7-1f1f4", "c4a172646ffdb980964bf399fd000dba",
+	"1f1e7-1f1f6", "d489c1409382c9d72effb6223236567c",
+	"1f1e7-1f1f7", "407b23225039d193f5e539e2de0900f2",
+	"1f1e7-1f1f8", "388fb4565a0c62ff75b7dc86f92bf1b5",
+	"1f1e7-1f1f9", "62b4f6fce2a4bd6f4ae8963260b4b8f2",
+	"1f1e7-1f1fb", "1d2976b1e227bd271bcbcf3e3da9e6fc",
+	"1f1e7-1f1fc", "690066ce531e722fffabd032c5a8a774",
+	"1f1e7-1f1fe", "86bcc5e9f70c5794cbe5b08c03f7c068",
+	"1f1e7-1f1ff", "661f2b47d604ac019ee43f118b815d60",
+	"1f1e8", "3e0ee2fd29177284491b8fb543bb4bdb",
+	"1f1e8-1f1e6", "6ea8666882589830dd40b86f950081bf",
+	"1f1e8-1f1e8", "8348b09ba2d47bd880723408bc91970a",
+	"1f1e8-1f1e9", "2ed98c24951a8699ad5be11006709adf",
+	"1f1e8-1f1eb", "6cfed08765ddebc69a9fec2583049d15",
+	"1f1e8-1f1ec", "649e2d1e43c47d5dd02b6a2b50886ddd",
+	"1f1e8-1f1ed", "728982808359b77ea519b24e6be7a218",
+	"1f1e8-1f1ee", "4d9908dec47fccd0dee025bd4b1f3ca8",
+	"1f1e8-1f1f0", "d9ae67dcf0c3a6f1cd7dab3f4de6dc91",
+	"1f1e8-1f1f1", "4071a277a721553383b6505c1efe4485",
+	"1f1e8-1f1f2", "962085c2759e97a9a5ae9281c6d20ea1",
+	"1f1e8-1f1f3", "d39728b84718665ada444d92579fadae",
+	"1f1e8-1f1f4", "95752fe012425c0f62e8897a92916613",
+	"1f1e8-1f1f5", "c1a36c711f0ae0ab46c7dce06f63a723",
+	"1f1e8-1f1f7", "5bb8f3219c8f09abe83edb66e11ba844",
+	"1f1e8-1f1fa", "7ad66e7bc98f1ab73026bc867f008fbb",
+	"1f1e8-1f1fb", "53e7f7ae06e8348b6586887e7c607204",
+	"1f1e8-1f1fc", "48f2b28431184c4a0db67917c237179b",
+	"1f1e8-1f1fd", "8ca30f720ae07015c4c8e49bb2d6e42f",
+	"1f1e8-1f1fe", "d1f393cfbb7178a8795d19e287788703",
+	"1f1e8-1f1ff", "92a1cad7710f4afbcc4b9d2e9863c623",
+	"1f1e9", "7b63a90197c59c371914e0d1c91af358",
+	"1f1e9-1f1ea", "939afd91bea7074f84f4a328ca095295",
+	"1f1e9-1f1ec", "25a61f86196b27d9ab335deb79723af4",
+	"1f1e9-1f1ef", "bc0d8fde67fbbe1382f66744dc765d07",
+	"1f1e9-1f1f0", "1eb2893dc9f4ad8ef5f3dc001c922c69",
+	"1f1e9-1f1f2", "59c557a22f8839466e233847b0d86cfe",
+	"1f1e9-1f1f4", "744e3c8dedcebb70840ab009f95b13d3",
+	"1f1e9-1f1ff", "619734e944260b818f266b6e0390bead",
+	"1f1ea", "0df8cc6898cdb812709a4672f137b62d",
+	"1f1ea-1f1e6", "a8224968196d0dd6d84e44c98093c280",
+	"1f1ea-1f1e8", "43cc209f984220ed2ef463c525082c5a",
+	"1f1ea-1f1ea", "72802e4dbb8d31e19e3de610500ab9f4",
+	"1f1ea-1f1ec", "a0634b212047f7fdf09bc113fde994fe",
+	"1f1ea-1f1ed", "e37010ffeb0b56e773c3a2812532bf83",
+	"1f1ea-1f1f7", "cf2083761cb0b2fcf29ffb4efa2414c3",
+	"1f1ea-1f1f8", "a8224968196d0dd6d84e44c98093c280",
+	"1f1ea-1f1f9", "d0af777a290a6f80b63aedfd99ee6c3f",
+	"1f1ea-1f1fa", "4be7421b4e5f8718344dffd8549333e9",
+	"1f1eb", "197cdfb70e6835c81cbb1af86ab7e01e",
+	"1f1eb-1f1ee", "cd2e96a846ada23ae0949bcb18534b1f",
+	"1f1eb-1f1ef", "4b6c6def299739ec0e4ef3016fd7df05",
+	"1f1eb-1f1f0", "1697e3e1209ada4d87a8ce00825f8141",
+	"1f1eb-1f1f2", "622e4fca94d491574c4a1281a1ef7314",
+	"1f1eb-1f1f4", "04b0bd887b326b6d1bb2fd83bafd7f1f",
+	"1f1eb-1f1f7", "c1a36c711f0ae0ab46c7dce06f63a723",
+	"1f1ec", "8971c31a6aaa34e99f197c5c9c3d03ad",
+	"1f1ec-1f1e6", "1adadc61eff78ca7ae57787e6717f564",
+	"1f1ec-1f1e7", "99a9e5571c2f5acd9cb910ce6a3f39a6",
+	"1f1ec-1f1e9", "5c02c6fc239702ef7afbea0f9cbfe17a",
+	"1f1ec-1f1ea", "f7f2de15054ec5ac9be3d9bf285e8320",
+	"1f1ec-1f1eb", "ea3a145d691f58154a44acffd1ab6ff3",
+	"1f1ec-1f1ec", "f1998b531e92ad407b62f515dbe28e1a",
+	"1f1ec-1f1ed", "b6dcc90d03def047251992cca264d755",
+	"1f1ec-1f1ee", "62f506dd2474c7c3d84c2277b48f0019",
+	"1f1ec-1f1f1", "52bafddf281d84b9afbcb5461967990a",
+	"1f1ec-1f1f2", "cc9026e4314eec2edaaf1207d38e6d06",
+	"1f1ec-1f1f3", "5d4c3bb09da9270585624dd8997064e1",
+	"1f1ec-1f1f5", "1c146cdff31786fc64da4158088d7813",
+	"1f1ec-1f1f6", "e80351257922a217be89685598d09340",
+	"1f1ec-1f1f7", "4da46394f31b6dde1ac858f27e371dbd",
+	"1f1ec-1f1f8", "66e27c1bf701dff9f2c3f9428c1e3716",
+	"1f1ec-1f1f9", "da54b9bf623f6bf35e6e5922f9228aa4",
+	"1f1ec-1f1fa", "461f9fb47f2d998cf2f2ae7c95f8cf87",
+	"1f1ec-1f1fc", "04c8b9ed019ce81a510e8866efbd360c",
+	"1f1ec-1f1fe", "da81a396d9646417f4de1b5666a64eef",
+	"1f1ed", "558d3608d282c924baac1499b7ccfe14",
+	"1f1ed-1f1f0", "7968207955275d49b4185fd8ce02895f",
+	"1f1ed-1f1f2", "089a9442fa6faeffeda0150ae182eccc",
+	"1f1ed-1f1f3", "b5c9d55ea7c123c25b6dae4d8878ebec",
+	"1f1ed-1f1f7", "de04008f1e2608c18925882d03e06ed2",
+	"1f1ed-1f1f9", "3ca01981f93df96e92a633986def813b",
+	"1f1ed-1f1fa", "81fc2b556ceda0e7454a32d46c7c54bc",
+	"1f1ee", "4606ee2759d6aae4410c034fb94a8395",
+	"1f1ee-1f1e8", "60ef554f0de960af055e7a208f05b459",
+	"1f1ee-1f1e9", "eff38923a385151be23438cb3c8b11c7",
+	"1f1ee-1f1ea", "6f51ceb455aec2ab2d85e8cadf6aa79f",
+	"1f1ee-1f1f1", "2fdf6f04696efea8e293bcff42a36dc9",
+	"1f1ee-1f1f2", "805f3106b7ca4c1fe8ba76543124b017",
+	"1f1ee-1f1f3", "f0c892dde95804d59b20416b8db9fcbe",
+	"1f1ee-1f1f4", "25a61f86196b27d9ab335deb79723af4",
+	"1f1ee-1f1f6", "d0f82101fb1bb0b068e5f048cf1211d5",
+	"1f1ee-1f1f7", "a003bd0873f93fd1095f9df6a4d18992",
+	"1f1ee-1f1f8", "e4df6ae4dcffd3cd3e3b686bbeefa56a",
+	"1f1ee-1f1f9", "4d4f20f2f1c88447480002bbf675404a",
+	"1f1ef", "72005bede6a07f7681914dc974ed3ff8",
+	"1f1ef-1f1ea", "dce884a7a8675c6ff6f149e8bf21cb97",
+	"1f1ef-1f1f2", "015c49a74a101354b978606d56ae8d4f",
+	"1f1ef-1f1f4", "2548460590c622e1493f9be0f053ea76",
+	"1f1ef-1f1f5", "3a58d8e5d92ed893921b644bebb7cc3e",
+	"1f1f0", "547b9b60d8dfc97568666a168793dc73",
+	"1f1f0-1f1ea", "41e202cbf12d7473cf320447b27b49c1",
+	"1f1f0-1f1ec", "a8e477ad00f8c492cacfb6022c959335",
+	"1f1f0-1f1ed", "cd00b81bb35b2a4ebff51641edad81a1",
+	"1f1f0-1f1ee", "26d3b520ab05841b65fc6e4e0d5fbd02",
+	"1f1f0-1f1f2", "2b5aead432ae7667adc1812288182bdd",
+	"1f1f0-1f1f3", "bf5509422cdbf04356bd742ebe948a7a",
+	"1f1f0-1f1f5", "e0cd271eb2f383d5d4e93fe71c1a3f4f",
+	"1f1f0-1f1f7", "b57d2718c0f2330c0e06166d4b5fb606",
+	"1f1f0-1f1fc", "f9e5ee010086899b5c4d31b5cfbb4004",
+	"1f1f0-1f1fe", "420c78d13bdc8bc0196f9738cdb491f8",
+	"1f1f0-1f1ff", "119ac47606cfa2b965e6ac789aa606b1",
+	"1f1f1", "c07212f2d498c21e56d3b3f581799972",
+	"1f1f1-1f1e6", "ad8e424913be4fd9ec69421bc37d4bc2",
+	"1f1f1-1f1e7", "15ff5c02fc69e8516224bdf39a00e7a6",
+	"1f1f1-1f1e8", "baa3c27e05395ca8012cadcf0b05b774",
+	"1f1f1-1f1ee", "a52fbeb793d03ded5ee8c168b8a6e7d6",
+	"1f1f1-1f1f0", "0de77c5d05390bb1fb630c962a56bb48",
+	"1f1f1-1f1f7", "0ac3af6c036b70334c9f4a51246bff13",
+	"1f1f1-1f1f8", "ca37b723b0024a6bd501ef04eadf4922",
+	"1f1f1-1f1f9", "400cdb5817f3e5815ecbeb879dfdfbec",
+	"1f1f1-1f1fa", "0d7d66cf0a8f30478301c67839c17730",
+	"1f1f1-1f1fb", "8d3134ef31d10935beb0141f9918c41f",
+	"1f1f1-1f1fe", "dc9e0296895e64a0dc96c6954aadd1d4",
+	"1f1f2", "3ae4af803746f6882a684a5a48dc29ff",
+	"1f1f2-1f1e6", "69eef4c9b90da86b24cb69aafd529140",
+	"1f1f2-1f1e8", "33fc478ecbb89220b67fe96b251e23cc",
+	"1f1f2-1f1e9", "239a65b494415a12508c3b77ba0cf0dd",
+	"1f1f2-1f1ea", "33bf70749ffe96311d85d5edb7ffa9a5",
+	"1f1f2-1f1eb", "c1a36c711f0ae0ab46c7dce06f63a723",
+	"1f1f2-1f1ec", "8e88bfa7cfb20e6e7b01f8dedccef158",
+	"1f1f2-1f1ed", "a04412e248d53a4b2581f1ad781beaae",
+	"1f1f2-1f1f0", "06cf3d86babf10c4c99a13812c85c1a5",
+	"1f1f2-1f1f1", "f509dd925ffc8d333efa3bfa37f68484",
+	"1f1f2-1f1f2", "de00df62d77af80d6d3885ee67232928",
+	"1f1f2-1f1f3", "3dc0656020a6fc85bf60b12a009e435f",
+	"1f1f2-1f1f4", "7dcb1d9b3f0495a2ea886eeea6dfbfd8",
+	"1f1f2-1f1f5", "7370737a7111529d65b413d01eef1591",
+	"1f1f2-1f1f6", "e4bc36c061c4f447c010e6be80c9e0bc",
+	"1f1f2-1f1f7", "5fb1abc88ca4ac5e4984ba6184a13389",
+	"1f1f2-1f1f8", "98f1db383b5a6b6f7f88ab12ea1ea617",
+	"1f1f2-1f1f9", "33faa6649f9e67fd4daa303c70198332",
+	"1f1f2-1f1fa", "b71ff9fffd256b95abc918130fce2af7",
+	"1f1f2-1f1fb", "e699bf5a211e116d1b2a2ffdd34de129",
+	"1f1f2-1f1fc", "5bfe6c2c4b0d14b6ac8801972d0f0534",
+	"1f1f2-1f1fd", "3c5b8da90d812275171a486dfd32b54c",
+	"1f1f2-1f1fe", "4d621d576de6c2a43c51d0b69cace34f",
+	"1f1f2-1f1ff", "9c766bfa8ed02217f1ecb22eccd9d27c",
+	"1f1f3", "f654b0f03f641e89a0db09b4c69cc33b",
+	"1f1f3-1f1e6", "79f3f954459f5c14ca49858996d9b6e6",
+	"1f1f3-1f1e8", "572d02aaf3b8ec9697b8a9c649dc2205",
+	"1f1f3-1f1ea", "533380b8faf02c88ab68565a898114bb",
+	"1f1f3-1f1eb", "92fe330f2f22fddea768b7bc429c393a",
+	"1f1f3-1f1ec", "d8b5f4d93ac7396a44eb3352d5e31cee",
+	"1f1f3-1f1ee", "89e84790ce6baaeb39b51a74603b29bc",
+	"1f1f3-1f1f1", "4e731115cad84fd5e7bc024d9b146765",
+	"1f1f3-1f1f4", "ce4def896435e73267187e77a8e32e76",
+	"1f1f3-1f1f5", "47cd7f001f25667541850514d6b528f7",
+	"1f1f3-1f1f7", "c1f7c02fd252677d2f1c823eebcfb12f",
+	"1f1f3-1f1fa", "e4011344d97c939e2ed95d329f24d9be",
+	"1f1f3-1f1ff", "f0dcfef90556797a8c0e2aa8268ce73f",
+	"1f1f4", "89bba1c5173777ba0a352d7ac585a647",
+	"1f1f4-1f1f2", "c1e139ac8fa94073d413a287e14f7c3b",
+	"1f1f5", "7f56a6932ada40d5b3562468de2b0cde",
+	"1f1f5-1f1e6", "d31e2e8df725339060d7c80a7f4ec133",
+	"1f1f5-1f1ea", "1abb9ba2de38e6c8d6c130534b5d2d4a",
+	"1f1f5-1f1eb", "2661c3af3a0a3d6219c18f602735fb69",
+	"1f1f5-1f1ec", "d55ad76981b445a8a3a95a4dc67e1804",
+	"1f1f5-1f1ed", "fddabadcb0b358a763a9afd2857bb5d5",
+	"1f1f5-1f1f0", "4a95a88bc4e490ad256b13173e85b8a5",
+	"1f1f5-1f1f1", "db99e1f10b9b733e13f049300bfaa93b",
+	"1f1f5-1f1f2", "2bf0b0119e3ef5d288d1c7f708acec89",
+	"1f1f5-1f1f3", "a43733a41d048fcf15901db7cf711829",
+	"1f1f5-1f1f7", "f11fea7aa78defc90c68b7cfb029bc28",
+	"1f1f5-1f1f8", "8af934770d4b2612b7da10c4bb8c486d",
+	"1f1f5-1f1f9", "6e9db125513715df3ec213f701b912d8",
+	"1f1f5-1f1fc", "f35b8830aae5d9e0ab53b6918fd2d632",
+	"1f1f5-1f1fe", "47a313745cb9ff00d8fedf333d60fc1f",
+	"1f1f6", "8f10626d49756081044bcb1a2314140f",
+	"1f1f6-1f1e6", "695995d6ddb114b045eac53953a8ded7",
+	"1f1f7", "7102ad5cacc8ba7bd99fa16b4e6468a5",
+	"1f1f7-1f1ea", "c8e10ca93aee8c709c9ad7086ef7db0b",
+	"1f1f7-1f1f4", "9d3c3d0c6d823bdf4f3fb73d27f8826e",
+	"1f1f7-1f1f8", "b044bc510f436a9f7d47629f27bcf358",
+	"1f1f7-1f1fa", "01efcb4dcb5b464a6859688f7e48907f",
+	"1f1f7-1f1fc", "351457feb3d64fb2a0332f088992e808",
+	"1f1f8", "c4cb8aa4b3abef19178d052694e3ebf4",
+	"1f1f8-1f1e6", "e2fd81b98714ede9438e987b8eaaf4d9",
+	"1f1f8-1f1e7", "73133b26661cf0f992d5dde9385605b3",
+	"1f1f8-1f1e8", "7ca358aad934a052df4e943b5b99967e",
+	"1f1f8-1f1e9", "677a2f56794095afd1ef17ba9306da04",
+	"1f1f8-1f1ea", "d185c005a74ae7371c3930da1eb21b89",
+	"1f1f8-1f1ec", "169d41570e242f9f69bda7fb598d0cd7",
+	"1f1f8-1f1ed", "5fb9c7b96aae7603c6e9dda5344ca0a6",
+	"1f1f8-1f1ee", "c50675737a4171106c8327256bb2a07b",
+	"1f1f8-1f1ef", "ce4def896435e73267187e77a8e32e76",
+	"1f1f8-1f1f0", "db83bf812a67bbce39e1e099f641ad14",
+	"1f1f8-1f1f1", "3088186e32c678d6b2b725ae5162bd3f",
+	"1f1f8-1f1f2", "d82e9103d237a0fcc9d41ab4dffbc8ff",
+	"1f1f8-1f1f3", "f1d88cb12c58a4e181758b70a1bcd78d",
+	"1f1f8-1f1f4", "717b5f50d630648af37fe8ead20e786b",
+	"1f1f8-1f1f7", "3806a753a87972a4647463ec5467333a",
+	"1f1f8-1f1f8", "ddf238511a3cb9445ac46bb5d35e89cd",
+	"1f1f8-1f1f9", "026a986362375cc4539d0f124cf2120c",
+	"1f1f8-1f1fb", "bf5a9c3a2c3b15a84694327705136ea0",
+	"1f1f8-1f1fd", "0f6a5e64d6e4fba1ef2c74dd1fc71b04",
+	"1f1f8-1f1fe", "ed30431a60d06a264fca091d5c788b5b",
+	"1f1f8-1f1ff", "9b15eea3d759f65781db7ba90b6aaeb5",
+	"1f1f9", "6c0a0b4df6f599f65e40ad372047d782",
+	"1f1f9-1f1e6", "ad3b29d7b10230c8c8ace42fcbb3c7c1",
+	"1f1f9-1f1e8", "13b9f62cbedf0217e876bcf55d4aef25",
+	"1f1f9-1f1e9", "244c04cb4bdb6dd4784d82df297245c8",
+	"1f1f9-1f1eb", "2b0e93ab364cb49b847d2f843649c1aa",
+	"1f1f9-1f1ec", "b6eec570d5233a65fce6c702939b762a",
+	"1f1f9-1f1ed", "8eb35819c5123690f2d7d8961960c90e",
+	"1f1f9-1f1ef", "9456c1edf27b3c677b8726cae427c2a6",
+	"1f1f9-1f1f0", "fc69f60455ab04068f88cebea3bc235f",
+	"1f1f9-1f1f1", "cd0b602377aa51caadf36db01dcd54db",
+	"1f1f9-1f1f2", "438ea27c2ae7eb9a04e0b0c0afbacf54",
+	"1f1f9-1f1f3", "2dfd2cab9ebadb45706d69e6c339468d",
+	"1f1f9-1f1f4", "32c2033b3811823ecba457d5bcec3013",
+	"1f1f9-1f1f7", "5c1ddcf25e62845a09ddaedb534eb028",
+	"1f1f9-1f1f9", "5c5c329a0b2f551cf941107f889453f3",
+	"1f1f9-1f1fb", "23e46dd5ab86f9c5754f091a105b9104",
+	"1f1f9-1f1fc", "9a866b52de950f63b2a345271a2a54b7",
+	"1f1f9-1f1ff", "1a7681dc245b3106404849f80f5937a0",
+	"1f1fa", "9efe2dc7b0a590b54482c0ef75c752ca",
+	"1f1fa-1f1e6", "ac4bcb496ca349a21b05e400bd8ac5dc",
+	"1f1fa-1f1ec", "ecc1234ed5af7d4d3f7cabed150a9e63",
+	"1f1fa-1f1f2", "d788b9231ed2028dc29245f76cf0a415",
+	"1f1fa-1f1f3", "a3f841eb785e386094300877252f0751",
+	"1f1fa-1f1f8", "d788b9231ed2028dc29245f76cf0a415",
+	"1f1fa-1f1fe", "a46c15a4bf9cbd4c3c2fe3eb76c944f0",
+	"1f1fa-1f1ff", "7b5f068caef383296afcee1c198394b3",
+	"1f1fb", "a70363c477e7d0875631634ef277da9c",
+	"1f1fb-1f1e6", "6db161e1b1d85019bd569e25b6d87f03",
+	"1f1fb-1f1e8", "3c4d4812de2506b893de3eeed446b03a",
+	"1f1fb-1f1ea", "2e1982ae2ce9eeca687d83fa92661e1e",
+	"1f1fb-1f1ec", "898dee1d7461139f2cac3259def418ab",
+	"1f1fb-1f1ee", "31a53c15df6b4ab7eba039e42b3f0314",
+	"1f1fb-1f1f3", "cc2541c8b215b79d5d05065db41a27d5",
+	"1f1fb-1f1fa", "e402061f22397e9d9027c5ffa1fa44fe",
+	"1f1fc", "7e6ce569cc1d4e3c2bb19400e8bbedb6",
+	"1f1fc-1f1eb", "30a3dc6c326bf4c6fa7ccf034d5fc0c0",
+	"1f1fc-1f1f8", "a638c54dfc90ef0457c8afa9b59ed153",
+	"1f1fd", "1c196447e01b16cdb2d0eeea39c3c145",
+	"1f1fd-1f1f0", "ec3a29f6b06bb07a638c92bfcde037c3",
+	"1f1fe", "2d24eb6ab8545bd17e66af014500f1ed",
+	"1f1fe-1f1ea", "9844a069ae43c742f234429e5ac05d5b",
+	"1f1fe-1f1f9", "8ba98dbf55284b1ff94ffcaafd6749b7",
+	"1f1ff", "bb490dbf14a4adf534d757ed3c94ab3b",
+	"1f1ff-1f1e6", "bfc4e3aad2ba71c04041c2e69409e98e",
+	"1f1ff-1f1f2", "1f170dae3d3a29521954072f3d32d51c",
+	"1f1ff-1f1fc", "1507c78581a695b71930b24eb6db55b7",
+	"1f201", "ef0e6ac76ce7fb7ec714cae45b626453",
+	"1f202", "792139a36ce5a965930759ea305ee56e",
+	"1f21a", "01e6499a34df6983dbd9a98896f9ba98",
+	"1f22f", "71fd0955727f308def5322154838afdc",
+	"1f232", "00c4c21f4e44c38fa69600d19e1f4c27",
+	"1f233", "9585d4e025bfda11003734b35a2329e9",
+	"1f234", "386f2cb4eef53c9753be97e5b153c1e7",
+	"1f235", "0990ea5215b51a361f9f5d1cc5f0f715",
+	"1f236", "dfd29c7961ea97c405d31325a34b702f",
+	"1f237", "270f8fffe5193ae95508a7d23dbeb372",
+	"1f238", "7c36d9a18afa75ae6d8ec8603b363113",
+	"1f239", "b5461315d0043e571090037fef595a3d",
+	"1f23a", "62460650701e8429f22dfb75d45a8a8f",
+	"1f250", "dbd6730a7e07f1da7ef9e81fe3ec54f4",
+	"1f251", "850acd4515b313c418cf1d06bda6d552",
+	"1f300", "29b4e3bbe8a0af346aa0cc36a50f0bbb",
+	"1f301", "ef8b3f983e1fd97efb395ceafa78d714",
+	"1f302", "fc11d60ede4cb42f8c1c617bcb7d5fb9",
+	"1f303", "99f0065389c775d7001b181f6aaa6a70",
+	"1f304", "05d001ab4db9cb2d650a33c84d781dd4",
+	"1f305", "c873a3842e3c1c351f71a8e316da3836",
+	"1f306", "3100f4199a7969a6801759d7a8af5781",
+	"1f307", "a4ad10781f285c4cc14bd8c3757f6732",
+	"1f308", "baa392758f065fa770e3a9063f91d33a",
+	"1f309", "053f85d0ecbe3c78ddc7b2edd374b0ba",
+	"1f30a", "2372da6e6674f60fd41b76e0058440a3",
+	"1f30b", "590794b9f632ce8391c966b9e6194c1b",
+	"1f30c", "2e3fbb2338145553a3d26c677b4f83a3",
+	"1f30d", "6d274903d488a6b57e40a883809fb33c",
+	"1f30e", "ed1c937de3aee41b70cea715005de3ef",
+	"1f30f", "0b261d8e3291bf494fcc26872c6f1dfc",
+	"1f310", "516bf0fae97628e22a3a3ec810a8c4ba",
+	"1f311", "6c8c8654d649bee316b88e2342b6c8fa",
+	"1f312", "1033902f7cb4331b67b3c3be3f238ff6",
+	"1f313", "3b9baa700cfeb3b6a8b27434bfca6602",
+	"1f314", "859b37667ef7484514bdf611bb654fe1",
+	"1f315", "fccc6b7583e67ffbe59b5ff17bb220f3",
+	"1f316", "9fafca319eaa0c1e7d01da9a2f013ca7",
+	"1f317", "825059b38b542d878fe89814c61645c2",
+	"1f318", "4abbe17058207a51000e5a3cac06d986",
+	"1f319", "e5908a8644b0403d9ff848f64e2ffcbb",
+	"1f31a", "5828a9abe4bd7a7dfbd221d64133aa43",
+	"1f31b", "39d26a6c2233dfd7996020755b929ce6",
+	"1f31c", "6c900814186d9436432cebb184fab8b7",
+	"1f31d", "c4cccc083da07eb95edf161ad5726d00",
+	"1f31e", "522c8314225487737f7dd4ead8d4a731",
+	"1f31f", "030fc6691abd2ab36c1d90407e02505e",
+	"1f320", "7c34233236b5365782a8b697d05144c3",
+	"1f321", "6305ff54712d29696712e48643b401ba",
+	"1f324", "9c2c6878cb9096e7beddaf21391787bc",
+	"1f325", "04783923cecf02e62f44e48afdebb9e4",
+	"1f326", "7f4def89ba52825f547fe1b031e33802",
+	"1f327", "e63b6822e54d6334126831f99fbda142",
+	"1f328", "dc8757b127abe7b5cbd527d2de74a053",
+	"1f329", "bc55d554d8c7432189439e0edd242bef",
+	"1f32a", "065cf6676dbeebd1264438854f2a76e6",
+	"1f32b", "85aa91d8a19980fb08ede157f919287f",
+	"1f32c", "4dd945c4df62fde81378656b2305f03b",
+	"1f32d", "557b8480fbd03c0405039ef45eb54ffe",
+	"1f32e", "2edf2d23ff03c3e4429ae709d5c3e6de",
+	"1f32f", "630c91022fd762ead4271d5668239025",
+	"1f330", "07e63adc84f2b773c10ee339a8fcbf8c",
+	"1f331", "38002403475def186f4b7ac64cc9d04f",
+	"1f332", "5dca95ac71f782daf8bdff7e8114d656",
+	"1f333", "1179cce930e54f671be9a98ffc9313e3",
+	"1f334", "0b52d41ab11b969765213113b0d197c9",
+	"1f335", "c22a2def17c0dfb9d4ba44dbc54f4062",
+	"1f336", "52d1136b13f76fc2d27ee5a22124dcef",
+	"1f337", "b8d0435c20c936da2daa4ebb412dc45c",
+	"1f338", "764836c1d982d6a999ef5ee6ab11eb87",
+	"1f339", "332a1867f90ba415fe0cd56491e4d16c",
+	"1f33a", "07302a0ce95bfc27d75e6d9748f7c3c4",
+	"1f33b", "b8126c857aacaf1d7226fa5505b5f5cb",
+	"1f33c", "cf977724a615ddf7aa9610c50a577536",
+	"1f33d", "e64de7aced945c2e198bb5731e94ac6d",
+	"1f33e", "e12380ed13d4f1c0633d0166042bbea1",
+	"1f33f", "d23c2dde0892d5c67b9148d3332aff8c",
+	"1f340", "ccb393b137e9218ac3af16b2c4617a2e",
+	"1f341", "1cea8f71b1b8c4f083e93e58c667bd7c",
+	"1f342", "7c8d861a86a9c3852f2cdde40715d490",
+	"1f343", "40115dda7d9f615f9561a451236d9623",
+	"1f344", "2cf786a465ca489dd2e55a37c08e3c59",
+	"1f345", "0217e68f2c1b88c35f227040cc035672",
+	"1f346", "f92a9f8821057c551982b659b268ed8e",
+	"1f347", "40e0130a4411323cdbed71b01ee0268e",
+	"1f348", "3811d4c3223612227a941920960daa30",
+	"1f349", "8b9d5f124020931b4de093c25506f5aa",
+	"1f34a", "b6a8ecf7ee81bc5f198efaae7756c41f",
+	"1f34b", "5aa65ce7d8af354c6840c01006f26ef7",
+	"1f34c", "7afa8b718dc3a9439560566d912b5f2c",
+	"1f34d", "260afedd0836f7e9f87512040ec16f57",
+	"1f34e", "d963d0ebb1bc7743dc37568e3b7c5cfb",
+	"1f34f", "a77e46deee7127d2b85e271f0d5b738a",
+	"1f350", "f5f860bd5863b0e6dc82effa2ef64b6d",
+	"1f351", "1799c138d1fe59c90b621531822b0be2",
+	"1f352", "bcf31add4e99af68aefc1ad8c68bd8f5",
+	"1f353", "ee4d204778150f625d480712d693acf4",
+	"1f354", "3a2a5ebb52c26a470f87b1eb42c0da92",
+	"1f355", "aa0f4c1498e8a6b14b1e3417b601b5aa",
+	"1f356", "f7ab12b60a61adab8654e0a9dc2e63af",
+	"1f357", "cb026612789e2a304448f7d84c6d1f61",
+	"1f358", "600fbe12de9dd0653d9c6d60196a1e42",
+	"1f359", "aa383d4f83ca500164ebe970318158de",
+	"1f35a", "edc4233ac27dd39edc6dcf270c3aa599",
+	"1f35b", "d541826138980a5f4381317c342137f2",
+	"1f35c", "e60479de5773e230338b18f49e225c95",
+	"1f35d", "fea9f79514871312e3d97d243548bb69",
+	"1f35e", "b4145d2678321d6d3376f1c88604fd42",
+	"1f35f", "c38b71cfa643cbb5509817dbf90f7100",
+	"1f360", "23ce7360b90fa15ff7502a4efa6e3bcd",
+	"1f361", "e6bf45e664dc5e37cf7414ecba378138",
+	"1f362", "f39d880f51c2c1fdc68b43fa8ffe86b2",
+	"1f363", "a3fdf36792c57acefbd11c5cf628a617",
+	"1f364", "7c309737c9a3ccda2f7448ef0abe001f",
+	"1f365", "1e5535def77a31917694578e9e32f16e",
+	"1f366", "6a0b77bb41b5c5011ead76848d12ef02",
+	"1f367", "4d10c3bb48aaa22938d622f33368a74c",
+	"1f368", "52bfdf64e0f7355d16780ff7187f9a1a",
+	"1f369", "b598f124b86f91d9daed202d64e29169",
+	"1f36a", "e8f23286ca11816c9ab8c145de57793d",
+	"1f36b", "a7ea7115b408d7dfa2eb701ef0a3b67d",
+	"1f36c", "45c8f940aac4dab66e6e73181663a59e",
+	"1f36d", "9acc7742efa057d93e09f6b68e6afd3a",
+	"1f36e", "15975b815581fb2e18622a682ca2380a",
+	"1f36f", "fc0d70c18dc95a7f572f1b8d4fd99fc5",
+	"1f370", "3105a283924c31ad8edcf99e1406fce4",
+	"1f371", "8f2b2dd111792f17b61b92f26df87d21",
+	"1f372", "852c152018b85fee8747fc9b7fda029d",
+	"1f373", "4c5368da6e23ddb039b77eb75136f27e",
+	"1f374", "753af848c32c8c27590c18f45292a12d",
+	"1f375", "d51a9baef36313665fad17c1e2609023",
+	"1f376", "bbaf8d037b3f9be9c44f45dd8c6c0156",
+	"1f377", "c66576976bc07f835107667c7db2f3db",
+	"1f378", "32b7c567bd420f7e43f13ff4140fcd04",
+	"1f379", "4e4a4ac66710b0aecf931cd72cd65d9e",
+	"1f37a", "b4e610191ce369a8bd475c23d6b4c40b",
+	"1f37b", "5e2ea03aa4963cda5e91d395c2587e6b",
+	"1f37c", "70de7399b20bad71f868ca5161d140d3",
+	"1f37d", "b9cff060443410369c93d78ed3b11408",
+	"1f37e", "ae5e2a35c59da2f4e12c406f5efa86b9",
+	"1f37f", "0973f9db9b3cd198463d3d8bdea44264",
+	"1f380", "309a45f4a75e9936c4c00807354e4c7c",
+	"1f381", "7a7ff35f227d659eb5efd817e2052174",
+	"1f382", "496cd7d4bfc59cdf6cd8a3285b42b576",
+	"1f383", "549e0b9954236583f841032b85ba45f9",
+	"1f384", "2f5331445a4647af2bb317862b38502a",
+	"1f385", "9a77e13be0f62ffa5f508f3cf50912c2",
+	"1f385-1f3fb", "ed2a388ba7ed8f310d600514e2dca126",
+	"1f385-1f3fc", "90016e00fd4d9381ff6bd2d1d2a24a3b",
+	"1f385-1f3fd", "6ea878caca1856a6fa65030950201ea3",
+	"1f385-1f3fe", "92d9856824994ff6623ece935bd56776",
+	"1f385-1f3ff", "105311b6dd7c00d36b514f5e41d0f46c",
+	"1f386", "be2863685453106bcaf150626e1c188a",
+	"1f387", "72f93fd9cd78b6cf221bab5594ca9176",
+	"1f388", "a6298512f50632252a23cc264ec73f29",
+	"1f389", "b052a4bef57c1aa73cd7cff5bc4fb61d",
+	"1f38a", "9d23479dd0484c082194cf5a316adf08",
+	"1f38b", "842e3b859f3efcb7f40ed7fc6867ca50",
+	"1f38c", "560f68782305d3736f6932663213aedf",
+	"1f38d", "3bc9a862612bae045329b90ed60f2ad7",
+	"1f38e", "eb697691fa500edf556867e585c4d4ac",
+	"1f38f", "4b25919b3fb54419d57e436cbdb1a2d4",
+	"1f390", "5ab2679b6db7772e5eb4ed3415d82800",
+	"1f391", "ac4294066a9379874e669fe6eae9dbe5",
+	"1f392", "9354845e25932052065dd6c1e08afb5e",
+	"1f393", "89641ebba191be23dc9a725ae84007ca",
+	"1f396", "6ca609cb5fe0c1a5a74633567c2e743f",
+	"1f397", "d702f2335a85d421e708bc9466571fa8",
+	"1f399", "59bdcc534c0d1adc1fb7575a1a4785a6",
+	"1f39a", "135271eb4178e4ff93c8e318adf7a1bc",
+	"1f39b", "a286d7a1a5c1f92786bdd48244b50add",
+	"1f39e", "024b5c2f8ead9b3fafeffe323d995f12",
+	"1f39f", "7d3e3949b8fafe2ebed318974f1ece8b",
+	"1f3a0", "e054e243a1525b8c307a8d25ad46580e",
+	"1f3a1", "e8c52ade4aba5b3f303de1db23345b7f",
+	"1f3a2", "026a3b9641a2a5695469456570daf22f",
+	"1f3a3", "2d827842d29f3408d9eb56fcdd96e589",
+	"1f3a4", "1a681bfe61f09a5f527efb25cbbd1a37",
+	"1f3a5", "733a0db94b390b2fd5da87408285a079",
+	"1f3a6", "e599756478c7b2bcb016822bbc4c770c",
+	"1f3a7", "b5a892d37d598e0e73dce83212ad8512",
+	"1f3a8", "5cdf72535fd236b2a7e6ab8e4826b3a4",
+	"1f3a9", "31123922fb35c46cb2f8daa1cf4899a5",
+	"1f3aa", "1ca974f0ab1e4880a4aba47c5e96250b",
+	"1f3ab", "5e5ab6737c6e2b6a9aa7a1e7295d1b41",
+	"1f3ac", "78279ce9b1bc7be03b83aec31c42b4ee",
+	"1f3ad", "74753e5398ed3c86145f696cca04ed5b",
+	"1f3ae", "f1426431eb7c60fb8c072f90acb07ceb",
+	"1f3af", "90eb8387713284f854d84ae86a5ff811",
+	"1f3b0", "fd75504bd8a4810f750bdb5a94ade84c",
+	"1f3b1", "0cfd4882c0646d504900c90166d80cf8",
+	"1f3b2", "34039c067d82e86f0f1a07ad38e86685",
+	"1f3b3", "7366fdb7c5d9143057318249297a13bc",
+	"1f3b4", "a5a0052b1eeb1e7765a591dca2439b3a",
+	"1f3b5", "658d047ef378c3147a9d8d3a01fef268",
+	"1f3b6", "d4118b2f1c66753deb666882b7fb4380",
+	"1f3b7", "7a17610e7c39ea79577387820328eff4",
+	"1f3b8", "e048d897c44345a409d66888bf61f72d",
+	"1f3b9", "648413c5ab30c6d4bbd34fd15b4c9e93",
+	"1f3ba", "6e7e4b0e52381281ff1ae9456f61c90a",
+	"1f3bb", "e1d2ae13fd4dd03d7ae81a48ea4ea424",
+	"1f3bc", "0e7fc4b6265bd336e8fe4dca75417ee7",
+	"1f3bd", "b838c3f308050200c31cda4af66039e9",
+	"1f3be", "07aab6d892f35d529932196dbd20101f",
+	"1f3bf", "93c5ae5a41e9d1468fbc1c892ad6e4a6",
+	"1f3c0", "45c074e64c49165be59a19ec38f40ae8",
+	"1f3c1", "8e9a2d7ef44c1052a246e062e4c3f796",
+	"1f3c2", "7c3ec42a517d26f7559a1bd4ce282e55",
+	"1f3c2-1f3fb", "c3263a9facd55f57ec90f2988c8cae2f",
+	"1f3c2-1f3fc", "b8a16cae8e305aef65da38c13613c03d",
+	"1f3c2-1f3fd", "9fe137edb0dbe590fa20cfbd6a9d9a70",
+	"1f3c2-1f3fe", "1a89e4c57ee9dd4eb94bf44df18ba784",
+	"1f3c2-1f3ff", "4a3cb447275cc33c66c8b5173f94b0f4",
+	"1f3c3", "ff99a5cc7fa936b757396e6c1b51f589",
+	"1f3c3-1f3fb", "ed2cad5271c6a22e4c663227a1a88dc4",
+	"1f3c3-1f3fb-200d-2640-fe0f", "6cc14f644e35c26509bf8cd3dcfd9189",
+	"1f3c3-1f3fb-200d-2642-fe0f", "a47d8536794c4d71b52d0a76483ec8a1",
+	"1f3c3-1f3fc", "733d7d1c9ef7dead23d426dda25c1696",
+	"1f3c3-1f3fc-200d-2640-fe0f", "710aaa607844e01a67fd63054e13a8dc",
+	"1f3c3-1f3fc-200d-2642-fe0f", "b918364e4e75614717756786f008dd4b",
+	"1f3c3-1f3fd", "98a7b0c592e65151be05eee3ddf12fa8",
+	"1f3c3-1f3fd-200d-2640-fe0f", "b5b2cc93c232ea97acf112dd3422e3a4",
+	"1f3c3-1f3fd-200d-2642-fe0f", "c03dbe5c2f01b2a1abe6818f48c3ffd9",
+	"1f3c3-1f3fe", "6b5cc40c5791ebe8d2f1983ecd8345e7",
+	"1f3c3-1f3fe-200d-2640-fe0f", "993f278301c13ecaeb97bde300dcfdd1",
+	"1f3c3-1f3fe-200d-2642-fe0f", "c3d98564b2c85d94260bedcd85ae2264",
+	"1f3c3-1f3ff", "720159dbbc5901a62e379f8aca4bdd7f",
+	"1f3c3-1f3ff-200d-2640-fe0f", "8a57f8fbe78ba84eaa85a393a0df9f5a",
+	"1f3c3-1f3ff-200d-2642-fe0f", "58c3c30c10d9d7a957d5de5af4d24d18",
+	"1f3c3-200d-2640-fe0f", "d6b6f90837c3ace171ee7718457877f0",
+	"1f3c3-200d-2642-fe0f", "a897d2fd1408aeb4c548eb4ba434f8c3",
+	"1f3c4", "82080ae19c3f1a6e082013bca37414df",
+	"1f3c4-1f3fb", "7aefaf7f7287ad26b8551af03e64700a",
+	"1f3c4-1f3fb-200d-2640-fe0f", "bf6e82bd0f457145d316671486cd8f72",
+	"1f3c4-1f3fb-200d-2642-fe0f", "d7681b300623922f934f6673574e5567",
+	"1f3c4-1f3fc", "b496edd56d2f2c82887fca4f4859d69e",
+	"1f3c4-1f3fc-200d-2640-fe0f", "c751a1a98de25d0ab3a9fd6b071bc7fa",
+	"1f3c4-1f3fc-200d-2642-fe0f", "a600d186d61c892b6c6e66b071382318",
+	"1f3c4-1f3fd", "1d30d10e461f3a7c99cb1c3dbd244d39",
+	"1f3c4-1f3fd-200d-2640-fe0f", "7234e721d923e6f1eea6b97f1c6112ac",
+	"1f3c4-1f3fd-200d-2642-fe0f", "a360facd845cbccc2c235b4008db81bc",
+	"1f3c4-1f3fe", "ae7b80cbcc619fab7cffd626d89812a3",
+	"1f3c4-1f3fe-200d-2640-fe0f", "a79bee5f12de57120a16cb46b14531da",
+	"1f3c4-1f3fe-200d-2642-fe0f", "b7dbcce9956ce290a8cd40329da7238e",
+	"1f3c4-1f3ff", "70fdfb7b890d82e71b436c5a34838e71",
+	"1f3c4-1f3ff-200d-2640-fe0f", "7a1af78d6f0dced5ce232120251df850",
+	"1f3c4-1f3ff-200d-2642-fe0f", "36d18f44131158a9cbab17475b023749",
+	"1f3c4-200d-2640-fe0f", "14a2ba51038405a0948691e5f4a9a0bb",
+	"1f3c4-200d-2642-fe0f", "435e77efc58439fc277559e76354c3c4",
+	"1f3c5", "c9b563417a1ff01700edc358b5fc309f",
+	"1f3c6", "0a00e865c445d42dfb9f64bedfab8cf8",
+	"1f3c7", "3292eb84cb663e0f2ec7ab7bd53e766f",
+	"1f3c7-1f3fb", "3bbd60003f464dd95f112819b6bf3936",
+	"1f3c7-1f3fc", "d715b5531c0e2321110d31f9aff7e001",
+	"1f3c7-1f3fd", "4724d04f4c9f36a2f71e69c68d6c8b2a",
+	"1f3c7-1f3fe", "0fe65e9200915762274a634fda80595f",
+	"1f3c7-1f3ff", "1cb987a3fe7b0d4c72ae351ff5c2b64b",
+	"1f3c8", "1a25c1bc80c7722bec151bbff3e85dec",
+	"1f3c9", "a71c8639481a2696377378ab07d348fb",
+	"1f3ca", "3d3d2f97da31f797970d23c9991c36c9",
+	"1f3ca-1f3fb", "240fbb983050b091fcd6847b647b5167",
+	"1f3ca-1f3fb-200d-2640-fe0f", "2b15cda399bf3cb2cc7fca1b62478924",
+	"1f3ca-1f3fb-200d-2642-fe0f", "1c2136eecd03dcf815794df0e63be866",
+	"1f3ca-1f3fc", "a78657039bc7a43138128d41a7a3f328",
+	"1f3ca-1f3fc-200d-2640-fe0f", "854aca380c22ddf8a4f69e3f85c5185f",
+	"1f3ca-1f3fc-200d-2642-fe0f", "d218bf31c50d2156b2328ab98530cf1c",
+	"1f3ca-1f3fd", "258353825046fa4191d6ff067bf2f2d6",
+	"1f3ca-1f3fd-200d-2640-fe0f", "4ff40f4fc53c4396cba453d71ac2330a",
+	"1f3ca-1f3fd-200d-2642-fe0f", "bc2d5b6f81203c7a749b09124b4d6921",
+	"1f3ca-1f3fe", "8a97e29b4104a12860d0e75d80269315",
+	"1f3ca-1f3fe-200d-2640-fe0f", "6a99e49cdb8ac02b9e0b6a6bfe530fca",
+	"1f3ca-1f3fe-200d-2642-fe0f", "eb87d44efd10a51a30a3b399891b85df",
+	"1f3ca-1f3ff", "a2c91146f71b1ef6d2cf8d0e76b7016e",
+	"1f3ca-1f3ff-200d-2640-fe0f", "ab9cdd42aae36e677be8cfa4aa1b63e4",
+	"1f3ca-1f3ff-200d-2642-fe0f", "a17ad3a7a5c5a1aeb28a14038a663ac1",
+	"1f3ca-200d-2640-fe0f", "a1f0b617eefb7b7bb00a5ac1d6474e6c",
+	"1f3ca-200d-2642-fe0f", "123a3f509aa65a7253b00b5c8642f1ab",
+	"1f3cb", "6dd7ab864a207d17090be312385e5f68",
+	"1f3cb-1f3fb", "2f04073020feab26f94de654cbb8df50",
+	"1f3cb-1f3fb-200d-2640-fe0f", "f566048cbabe74324a14fe4b6c8c61db",
+	"1f3cb-1f3fb-200d-2642-fe0f", "a7c9926ad513324dbdc25df66befcce1",
+	"1f3cb-1f3fc", "46f17dc924965027337d8a2f0df1d488",
+	"1f3cb-1f3fc-200d-2640-fe0f", "eab73a31d17ce2104a02ca4a89820909",
+	"1f3cb-1f3fc-200d-2642-fe0f", "6e4e3ff455bd1412920dfbbfd0c018fa",
+	"1f3cb-1f3fd", "135d2a82a1f7f9d7b639659e3dd593be",
+	"1f3cb-1f3fd-200d-2640-fe0f", "bbb481984b99a48678f9284de8860b36",
+	"1f3cb-1f3fd-200d-2642-fe0f", "d9cc40855e6acd5ad67bad226d9a9a30",
+	"1f3cb-1f3fe", "464dd865a2cd784392443539f4190d07",
+	"1f3cb-1f3fe-200d-2640-fe0f", "ed34f06cdf88bcbfe9a71c343a04c960",
+	"1f3cb-1f3fe-200d-2642-fe0f", "0ff0ca15d9041a28186969a4a37d8734",
+	"1f3cb-1f3ff", "592e7d1eaa228fd71894d13c9a8a7574",
+	"1f3cb-1f3ff-200d-2640-fe0f", "19bfb1eb4390fcb078f8a833e796b2ac",
+	"1f3cb-1f3ff-200d-2642-fe0f", "79a6155de8e7823dda7477be9394e349",
+	"1f3cb-fe0f-200d-2640-fe0f", "c9a46ce244be49810baf215670b73b90",
+	"1f3cb-fe0f-200d-2642-fe0f", "a017c614e28f794bebc8c4a24e341290",
+	"1f3cc", "da28365ece0a48897bac0f27e1e21f49",
+	"1f3cc-1f3fb", "17f0997bbf8ec8d2f336fa6be1ef492e",
+	"1f3cc-1f3fb-200d-2640-fe0f", "c17a3b48593ca33f55c8bbd9179b0b68",
+	"1f3cc-1f3fb-200d-2642-fe0f", "c0a6e4d7861d903142ce842a7b9d4d8f",
+	"1f3cc-1f3fc", "f28c9dc07e5f42a0e26e763d77460092",
+	"1f3cc-1f3fc-200d-2640-fe0f", "cf94063b8e0d1cdf31cd982c5f2e5f2b",
+	"1f3cc-1f3fc-200d-2642-fe0f", "946771bc592962a56221486eb71a6f4f",
+	"1f3cc-1f3fd", "03b36b80f27dfebfe4fbac8a6f827975",
+	"1f3cc-1f3fd-200d-2640-fe0f", "03368fe0a04c6df38109188ce1e3fcb0",
+	"1f3cc-1f3fd-200d-2642-fe0f", "146cd05bd650d6b399657e2c62c48739",
+	"1f3cc-1f3fe", "d049b7b0b6933595c69e999ceddc2771",
+	"1f3cc-1f3fe-200d-2640-fe0f", "c4e8fe0bcb38e24f5c86ea1217ac3026",
+	"1f3cc-1f3fe-200d-2642-fe0f", "449d43d80485af99d50d7876f1ec8295",
+	"1f3cc-1f3ff", "258ff337f4e451e5dc8818167a2517a2",
+	"1f3cc-1f3ff-200d-2640-fe0f", "e1faddc65fe9b22cf99792241feaf50b",
+	"1f3cc-1f3ff-200d-2642-fe0f", "a272020b6b03a24930833240b011a1cd",
+	"1f3cc-fe0f-200d-2640-fe0f", "fab490f155c34dea60bc085787fd27de",
+	"1f3cc-fe0f-200d-2642-fe0f", "4f48605d44de75f151f4b376c7ff1da4",
+	"1f3cd", "b5577b3454c45a6c0e2d8c277a6c23d5",
+	"1f3ce", "fa67c93ec376cad2e3389ac1a5608b8f",
+	"1f3cf", "a6f72a20e57fc5604130b794eb81ead7",
+	"1f3d0", "408999faaed422016a76103ed3418837",
+	"1f3d1", "cb503564eb2ce37230ec6f56a27b8bbe",
+	"1f3d2", "aeb43e604aa87f6028f2053d26582c3f",
+	"1f3d3", "2859b7c99f56fe22827916c529ccac86",
+	"1f3d4", "d3aa46ca884079ab7f9edf60b4f14275",
+	"1f3d5", "2f87e5640674dc70c9eafec452c12744",
+	"1f3d6", "e6b64cfec7333c838fa8ef994854fcdf",
+	"1f3d7", "f9b9b873bf481dbcd708be1902614079",
+	"1f3d8", "912a52fc3c152af29923ca7e9ae043b0",
+	"1f3d9", "5c94579af4ab4f1e1ca895b431161e2e",
+	"1f3da", "6bcda303bbdcc7446673edae013ce4c4",
+	"1f3db", "dbe34f53028e0d3a38ebb7d3c1af041e",
+	"1f3dc", "68e9a0c39cb131b2255d568dc6eca41f",
+	"1f3dd", "1bd2330e92ac71ac26fe6b5ab5fae7dd",
+	"1f3de", "e17a95f8ce43367d6abb5ca1969a9f25",
+	"1f3df", "67741038c09b81e658f85a81bbce3c6e",
+	"1f3e0", "deea117ff9db07e31d8658a505394d01",
+	"1f3e1", "2c371712123bf19d0d7d6d1f7980a701",
+	"1f3e2", "e06526a1c3ff1d5e509c60eb8a03bcb3",
+	"1f3e3", "98c4f020cfcd05c0c3d0ee025a3154f3",
+	"1f3e4", "d0fa9f2140e3c00f264eff648a668178",
+	"1f3e5", "f7aa9f54302237666daaf6ff028b9d5f",
+	"1f3e6", "3cc9f57b6443eeba3ffb69b93629e3cf",
+	"1f3e7", "11992200304494a5161bf3ff8e262a23",
+	"1f3e8", "b9a0934c854db0d97b4b21424d578452",
+	"1f3e9", "d3a8c94c0473495cbe1b8eb999316475",
+	"1f3ea", "dcd96093a932467ba2155089dae4f789",
+	"1f3eb", "af1ad229ecfb7d74a349cde651e9e49e",
+	"1f3ec", "6837f6f531ad403f557f15f9ba6d22f5",
+	"1f3ed", "c93b005f6352c5c0aee811015a4f8d9c",
+	"1f3ee", "89cfd891eb713f4b055d1b0d03e1501a",
+	"1f3ef", "d842cbb9aa8106059c54eb0cbb5d03ba",
+	"1f3f0", "ec15d5b501416e4b7270cb1dd1624631",
+	"1f3f3", "60607b817ba7412ac83babae796c1d8c",
+	"1f3f3-fe0f-200d-1f308", "a38a70388a9ffb9ede271e41142a8f2e",
+	"1f3f3-fe0f-200d-26a7-fe0f", "b4389a07796a128a9e86e28e7f6df386",
+	"1f3f4", "53d700db9e5fbb461663685b813156df",
+	"1f3f4-200d-2620-fe0f", "b9cd193b23b2d0d0f878f992ef8a6b26",
+	"1f3f4-e0067-e0062-e0065-e006e-e0067-e007f", "9029ac54b7922ae530aa8f0f0e1a2b6a",
+	"1f3f4-e0067-e0062-e0073-e0063-e0074-e007f", "a5414b22592b99113a3b8ee550607816",
+	"1f3f4-e0067-e0062-e0077-e006c-e0073-e007f", "030eea881ddab556dcf9713ad69540cd",
+	"1f3f5", "936f7125fcf405a5d18bd84b0b52f47b",
+	"1f3f7", "0f7341809de05e185655e4d20735d0a2",
+	"1f3f8", "f38d6de174ee2cf1f7c9bde8019e0c18",
+	"1f3f9", "a33ea7f530f4b720a3a4c050594842fa",
+	"1f3fa", "600365a75232f047561f91e816ff1961",
+	"1f3fb", "76e8faef078f9788cc00a499138af98d",
+	"1f3fc", "3781c1246a4fea0dd55e56197ba6ca9e",
+	"1f3fd", "c1f3cc7c40bb7a370a29ebcc0c14def7",
+	"1f3fe", "c86af7b1783402e3e65c22adda7f6aaf",
+	"1f3ff", "49670d374499c7f830a0fca6741db06b",
+	"1f400", "f36b66e2f802dc9f43d8b4fa18c084aa",
+	"1f401", "62e0abd117fa79e4728618e413d86d19",
+	"1f402", "2c675857bded304870f3aedc02de2ffb",
+	"1f403", "06abe2c5f92d0dfc5d66446cb4e1e83b",
+	"1f404", "3c6d0d0cdd8a07c4c6837fd143e1e60c",
+	"1f405", "df8b26ce08bff3cc70d505b48ccdc71c",
+	"1f406", "a4ae9ac0cb5ad12a68f767d39b249811",
+	"1f407", "e076bda560b596a206ea032e02bd411b",
+	"1f408", "56f7f46a090f4935ce29b6718c8d47ce",
+	"1f408-200d-2b1b", "a784784c984905c2a74507d57aa3523e",
+	"1f409", "d73ab953aa1a3b9cca3a5a0bb6c37842",
+	"1f40a", "fface028e87dd156db7f772d5009211e",
+	"1f40b", "217b9691030367991d52c0bcbde34f30",
+	"1f40c", "6dfcd23c3f6302b228666f9ce61dbf61",
+	"1f40d", "dbfbe586e724f4a0cd993d0c1048316b",
+	"1f40e", "591183f3386ef43f266bd7bcf03ef83f",
+	"1f40f", "3b7c890100e7d7260288ce46d67a24cd",
+	"1f410", "55aecf5f15c4f21115d04f8cd63fb3ab",
+	"1f411", "1118afb9d722b17e0cb0595bbe4f6e0a",
+	"1f412", "766e42505fdf9dc7c390ecd08c0bc4a6",
+	"1f413", "ddd66e0ba306c3e6d9483acd8cfc124b",
+	"1f414", "468644ef0a79a3d8163f7778164b756d",
+	"1f415", "8b285f94106efc8fbb076980806d4462",
+	"1f415-200d-1f9ba", "92f89f0c4724cd564fc307a5dd20e18a",
+	"1f416", "d083412544c302d290775006877f6202",
+	"1f417", "1a7689368e6b9d41ce8db98f709ca08a",
+	"1f418", "a2893dfac8eccc398a9b14402df114c2",
+	"1f419", "b881e880846e099613fba17d5de1497b",
+	"1f41a", "3ef216c0db84ff0dbeebd9409104b32f",
+	"1f41b", "370f5af37229902609dec50690ec5f99",
+	"1f41c", "e37bc714ecd911af7c558f5aaa0c4464",
+	"1f41d", "66421caf19743280c076da2fca8932a3",
+	"1f41e", "d5b1663a1a49a2471ab3bce9f98bfc27",
+	"1f41f", "720806c4ff4375a2f36674e8aeeb1906",
+	"1f420", "ea60e8e1bc38ab15f4309ef49f081f22",
+	"1f421", "eb870587a80d227731a888c954ac2eca",
+	"1f422", "0da5614923cc73f71ea9641a5cdb5a3e",
+	"1f423", "3e830e736f5880373f5c58ea37f0a09e",
+	"1f424", "e751b74d24f6d6dd9f57b5c1e257a2fe",
+	"1f425", "3fbf09f29fe27dc2b8a7d06c83465ce8",
+	"1f426", "cf725f98edb284d25530f5dbd7d30ee4",
+	"1f427", "727bc86f98316c40c37e48797f2ab196",
+	"1f428", "6ea80059130bde711bef1b446f78e282",
+	"1f429", "79f5a5b12f5ff0785032b3ec01f37a24",
+	"1f42a", "14baad8ac7905736f0505fef9e6761a8",
+	"1f42b", "aa2e827a8b3c78ded1a842fa19f89326",
+	"1f42c", "1a5d8cce6c59fe1ff80d19f182616d13",
+	"1f42d", "1e8855463ed8a429b8a0da902d4ce910",
+	"1f42e", "7da28de81a5be51f4199da5050e83041",
+	"1f42f", "ed7822d0ae570e74d95ff32a31309005",
+	"1f430", "a81931766f2a88526baea838dde7d225",
+	"1f431", "a582f38ca1d7c72abd5b2565033bc810",
+	"1f432", "6bdc7fbe1d1895ee1ae6f10d8b7ff014",
+	"1f433", "1cb536137c5e70d114922edd3d3faaa0",
+	"1f434", "dd3c29b26d0bc7cdfd537f483c9c5bb3",
+	"1f435", "e867007be4b2af2652e06c6aea5311ae",
+	"1f436", "2eb6ca0fe3f48e16b1fe240735710094",
+	"1f437", "dd77cf000a729a9bf0fc03b97e1e4a5d",
+	"1f438", "68af06bbac9f4830d08fa474f201f92b",
+	"1f439", "fc50a173b1afcfb37d141e4bee11e479",
+	"1f43a", "70c59f59ef85eeddfd7d3962a4840cb8",
+	"1f43b", "589b531ad20f070bbee33f31f233c135",
+	"1f43b-200d-2744-fe0f", "eb3b52294d6cbab490cdb577afe40b11",
+	"1f43c", "ffa1f81e3c3364ef04f9a1a26b55b42b",
+	"1f43d", "6c9bbf3f832dcd57767157214e9c5bb5",
+	"1f43e", "f8cfa52b218e9e8fac7c1b21d09d8ef6",
+	"1f43f", "de0f03380b137cdb950b1a9d2ca04a3b",
+	"1f440", "4c5a77a89716352686f590a6f014770c",
+	"1f441", "938845ae928cc025cc21cc71d38c2418",
+	"1f441-200d-1f5e8", "85ea65fa7910b449da793663ad09a695",
+	"1f442", "c6d79c4d1a768725d74b74b8dde87041",
+	"1f442-1f3fb", "82f12d05b6a1da604853772a022b7733",
+	"1f442-1f3fc", "c3d79dfbe33fe8df10cea51abbd1d763",
+	"1f442-1f3fd", "0998ac3a974b9becd7a6e733b7bfc608",
+	"1f442-1f3fe", "d6e5c362c24a037e6cfdb2841c665882",
+	"1f442-1f3ff", "89a6921cee7acd223407651991aeeb34",
+	"1f443", "9a3e2c6b01cec5094c81ad9db673d430",
+	"1f443-1f3fb", "24595f23d0f31fcf308551a445585407",
+	"1f443-1f3fc", "8458f84a9d4070d2fac4bfa609747d9d",
+	"1f443-1f3fd", "91111ed2130a9ce307a83022eb2b1bf2",
+	"1f443-1f3fe", "3cd3cc8a4bf73ab84643240493ef345e",
+	"1f443-1f3ff", "5acf7aa0de092b9850201f1a98997bcc",
+	"1f444", "c1aac731a5d5bab09fc7d177fadc5eef",
+	"1f445", "0d16121d9eae5745be9da0c730557d30",
+	"1f446", "863d47ae4fdda4d783c57dd9c496b7d6",
+	"1f446-1f3fb", "cbc478ff82823481336a59c9df975d5a",
+	"1f446-1f3fc", "217040d5caf54fd16eb5ed0ca8ac01c0",
+	"1f446-1f3fd", "cc8ea3bd44e057321ae74c97dbe7dc0e",
+	"1f446-1f3fe", "5f6799ef67ea97133d20a1e6c2e4ee5c",
+	"1f446-1f3ff", "c824fe39c2ccf2a5a5ae5c9391a87be8",
+	"1f447", "985bb2d2398be71e1b68bc7e2103c993",
+	"1f447-1f3fb", "36ead449b8b0e52147ab345c7411e919",
+	"1f447-1f3fc", "733b1db280d04104c0f48d2cabc0fe9d",
+	"1f447-1f3fd", "b3875912b2c72107671c851e72c9fb13",
+	"1f447-1f3fe", "98e77dc7b2bb8f0caca2c6413bae4c7b",
+	"1f447-1f3ff", "f041779f08aa1403569ad8ac8dedfa56",
+	"1f448", "1e3b6d27bfb21f04dc9e00c7bc965c55",
+	"1f448-1f3fb", "4f674d2884e5410a290f2902c9ac4767",
+	"1f448-1f3fc", "79e19473aa334b2e12e0e21bb334f31c",
+	"1f448-1f3fd", "524925c691c8a47a0efb7eb71826eaca",
+	"1f448-1f3fe", "fccf52170abe65e36c8ccc9eb2056db0",
+	"1f448-1f3ff", "f9d1f028b115b18db7a663951a53c82c",
+	"1f449", "032d907ff49a63e0757cadd5f36ab216",
+	"1f449-1f3fb", "514a375486cbde6994112a6813338e30",
+	"1f449-1f3fc", "2cf0a9351423d5e826f73926f412579f",
+	"1f449-1f3fd", "24068beaa92dca904f4d5019ec1ec880",
+	"1f449-1f3fe", "f387113fc103b8f60d740605ca094ebf",
+	"1f449-1f3ff", "bd291fec9f86791e1c14d9428da01e5a",
+	"1f44a", "4601b0cf78b83ff531ea80756d6cc9c2",
+	"1f44a-1f3fb", "b4d35a6a482db1951eec43f2a03f167f",
+	"1f44a-1f3fc", "34c4ff66fe9d9b8092682ace398215e4",
+	"1f44a-1f3fd", "afdca1c380ee2b05d0762be4c4a7fe17",
+	"1f44a-1f3fe", "f1e6881034979b46fb100d6d5ccd230d",
+	"1f44a-1f3ff", "aa44525e2c3e814c0376275728b599be",
+	"1f44b", "df7ba0f4020ca70048a0226d1dfa73f6",
+	"1f44b-1f3fb", "13dd4bf16aa9bd2a1ee9a491df0a1614",
+	"1f44b-1f3fc", "1c04f18c838e60d2f9da6edb50bb8acf",
+	"1f44b-1f3fd", "f6cb905941a4e06659b7fda1f220ad11",
+	"1f44b-1f3fe", "1192443acd62d421cf58068cffb22bf9",
+	"1f44b-1f3ff", "c82938c4327ff1aa7e38e30499b8b8d8",
+	"1f44c", "464b4c2745e1dda6a86028d09bb8bbec",
+	"1f44c-1f3fb", "51bf7abe73c308b4fd46b01fdb9bb8bb",
+	"1f44c-1f3fc", "cf8c0022f7611df66d71f4feb60de87f",
+	"1f44c-1f3fd", "065cc3ffc227aa126a8366cb0ea05f7e",
+	"1f44c-1f3fe", "7c65839e8a8895f447c06180693013e5",
+	"1f44c-1f3ff", "462d9312ecec015bb2523a13385a9eed",
+	"1f44d", "08c0a077780263f3df97613e58e71744",
+	"1f44d-1f3fb", "6421ec0021fe0aaac74c995bca648c2d",
+	"1f44d-1f3fc", "91ec3a4c2e4ac1f41195cf2675641262",
+	"1f44d-1f3fd", "2887099457281b44595981d12aee7b27",
+	"1f44d-1f3fe", "98a0f745aa5c3bfa6aacfb46caf2ec3a",
+	"1f44d-1f3ff", "67101ac17ea3b782a17f5abe8a5f1296",
+	"1f44e", "66e3cbf517993ee5261f23687a2bc032",
+	"1f44e-1f3fb", "75f045808f62791af6eb85db3db9f866",
+	"1f44e-1f3fc", "8e80101ffd2eee9330efa01bd812a5c7",
+	"1f44e-1f3fd", "87376e6d945c6dfd5b4bbf6b1351fae9",
+	"1f44e-1f3fe", "a7674d07f7b056cfdeeb762510fe0c75",
+	"1f44e-1f3ff", "f38845d04000b20255488bf8cd7ef992",
+	"1f44f", "9fa091f676e4451ee5946fed948cb8fe",
+	"1f44f-1f3fb", "c6795f855ff264d715b697bd54a6e241",
+	"1f44f-1f3fc", "786ec4e2268cbc5a4a6c6261767127a2",
+	"1f44f-1f3fd", "fcac65b8ddc2d6983660b95104ac5ee8",
+	"1f44f-1f3fe", "cb05d6a6fca6a9b1964d139ec3df1e26",
+	"1f44f-1f3ff", "5d1ed4f41b1e9a9a7f68686e1449bf61",
+	"1f450", "4824d50b7b1d299c694da8a3843ec840",
+	"1f450-1f3fb", "75acff92016b849842a29f93873e2082",
+	"1f450-1f3fc", "c68a78c2ac8d0486efe6e113a314a67d",
+	"1f450-1f3fd", "1481f88f5ea4cbbfc5a41457a8aeab12",
+	"1f450-1f3fe", "8365a82fec73aac36cd5b4e0acd9990d",
+	"1f450-1f3ff", "0c0ce52bde4b3627956f86e9a7176fcf",
+	"1f451", "98fe9cdec2bf8ded782a7bf1e302b664",
+	"1f452", "b4b0c3ade8e13486d81c05fa91c4ce03",
+	"1f453", "39795e861142747cedd93bf1ead76c9d",
+	"1f454", "0f76d5e15506991715cfef61988749f8",
+	"1f455", "63acb187ec615e6e39e1fdd6e4352751",
+	"1f456", "9f5efa2378393854163b617cfe4f3b69",
+	"1f457", "293579110f2137926e045941a942e8d4",
+	"1f458", "986297ec6e17beaccac69da14de35c68",
+	"1f459", "2b7131663ce4ca5046296ba985c69737",
+	"1f45a", "2d16214bcc74dd1546a35d3d6f15abec",
+	"1f45b", "d3092bd86511d5b68e4fb3daaefd2642",
+	"1f45c", "bee42a93836b9ac1083126bd7b048f12",
+	"1f45d", "b9d078e1eb3408ff187e6c473bc88530",
+	"1f45e", "44527ec040d4905b10bf22c0c784183e",
+	"1f45f", "fb2f7817a660282622da1be18fe7799e",
+	"1f460", "b5b74b9da3617bdcfdab8793dde734ad",
+	"1f461", "88c6d18631a6faeff7ad175f18afa209",
+	"1f462", "4d1ed1d5abee26793b4c287b9896cd56",
+	"1f463", "2f9c6cba3a5fc370216faeb3b04927c3",
+	"1f464", "f1719ecb228ce3c17b203ca4c15115c0",
+	"1f465", "7153b47b3433c885f93ef07dbb2e544a",
+	"1f466", "da92b34fa8f50c1295e125b3e5e1c55c",
+	"1f466-1f3fb", "9e057ae833ac2cb0da2512454d050b59",
+	"1f466-1f3fc", "83fc074c3c6c80080672a6adf5a1fc22",
+	"1f466-1f3fd", "d85a08e19ad76674c11c2833010c53ad",
+	"1f466-1f3fe", "3fd01ff7a6032fd44c8b1ee577190d22",
+	"1f466-1f3ff", "0a6795ad0fb0272199f574367553a2a7",
+	"1f467", "af1464f289927a54e50e5b6ad684f540",
+	"1f467-1f3fb", "496b126281f4a1af2d52e3e055b32fc7",
+	"1f467-1f3fc", "1fde6d802203c3fe50946542d7ab9e95",
+	"1f467-1f3fd", "53069313ab2fd0e906179cb8335e3147",
+	"1f467-1f3fe", "cc6c11d5845f38a77e01660e002f2de3",
+	"1f467-1f3ff", "aec96b451fef5f357bb8c6edca9168dd",
+	"1f468", "4a561f2ae55e60f31e4341d02540f70a",
+	"1f468-1f3fb", "296099758a9f1ab0ba40ae2c0d4dfb2e",
+	"1f468-1f3fb-200d-1f33e", "b9587c2e17542aa2c285fc7178107152",
+	"1f468-1f3fb-200d-1f373", "cb3b40981cf6d9b96f8187cb59ef0eb3",
+	"1f468-1f3fb-200d-1f37c", "d6fc608e140374d509b269794fab1787",
+	"1f468-1f3fb-200d-1f384", "ed2a388ba7ed8f310d600514e2dca126",
+	"1f468-1f3fb-200d-1f393", "8b74277e6e2326e7dc7666b630845fca",
+	"1f468-1f3fb-200d-1f3a4", "5937c7c5bd6f17184f4f07ad8616a845",
+	"1f468-1f3fb-200d-1f3a8", "630c5f21b6266be0a37488d618958ff4",
+	"1f468-1f3fb-200d-1f3eb", "3ec7cb70e8fa4a95a71a20100f3888fb",
+	"1f468-1f3fb-200d-1f3ed", "55f492c4a77febe124ed33ebb4ffb689",
+	"1f468-1f3fb-200d-1f4bb", "50d81e63bf47eb00902b6893341e3841",
+	"1f468-1f3fb-200d-1f4bc", "47fc48cdb382562530617816a5814986",
+	"1f468-1f3fb-200d-1f527", "62925251085102a44bda8407577a8ca6",
+	"1f468-1f3fb-200d-1f52c", "0c0a04860e99b51daaae45aa0d0cdf0b",
+	"1f468-1f3fb-200d-1f680", "4accb0495afd42353f93cd11805807f0",
+	"1f468-1f3fb-200d-1f692", "085fa77b34454891ada49a5de7fe2fd9",
+	"1f468-1f3fb-200d-1f91d-200d-1f468-1f3fc", "3497b4b39cfdebb1b3344696d9777dc2",
+	"1f468-1f3fb-200d-1f91d-200d-1f468-1f3fd", "b47031ecb07b92479f94dccddb4f9f70",
+	"1f468-1f3fb-200d-1f91d-200d-1f468-1f3fe", "f29469484fc961c1749f7e69c122f00d",
+	"1f468-1f3fb-200d-1f91d-200d-1f468-1f3ff", "0bcf40c540f302245ae257ccaf5bb8b3",
+	"1f468-1f3fb-200d-1f9af", "bdb235f915693d004bf91332db9f98fc",
+	"1f468-1f3fb-200d-1f9b0", "f20aee6eee82e54f2eccb8b11dc18f82",
+	"1f468-1f3fb-200d-1f9b1", "dc6bb339ead883563765e7fabb68399b",
+	"1f468-1f3fb-200d-1f9b2", "a32139e437161e1303e436268c1e5cf7",
+	"1f468-1f3fb-200d-1f9b3", "5d5f8fa188d5d5449d4556c164ef5ff8",
+	"1f468-1f3fb-200d-1f9bc", "deccb080c23f9ba053f0ac89fc0fda84",
+	"1f468-1f3fb-200d-1f9bd", "1e55d9d48ed78094d56a18c595348b91",
+	"1f468-1f3fb-200d-2695-fe0f", "65e4651a0ad24dbdf6182aa924ccd21d",
+	"1f468-1f3fb-200d-2696-fe0f", "13a0dd7c7af7524a16a5206fae7c1839",
+	"1f468-1f3fb-200d-2708-fe0f", "c334bbde9bd478e38bfb505f441ecb28",
+	"1f468-1f3fb-200d-2764-fe0f-200d-1f468-1f3fb", "256b5b5985a965cad6838644a4bf6a95",
+	"1f468-1f3fb-200d-2764-fe0f-200d-1f468-1f3fc", "880fbd5a6db8ef8115eaec30af232e2f",
+	"1f468-1f3fb-200d-2764-fe0f-200d-1f468-1f3fd", "c225ece669f4d3b24f57929723604fed",
+	"1f468-1f3fb-200d-2764-fe0f-200d-1f468-1f3fe", "02d6ff92f3beec33d130c1f56cbc216e",
+	"1f468-1f3fb-200d-2764-fe0f-200d-1f468-1f3ff", "efdd1d0bdc0c720a7c1c6408f9d31d72",
+	"1f468-1f3fb-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fb", "1594585f764a527fb04f4b742693fe0f",
+	"1f468-1f3fb-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fc", "42bf81318b85fb0145a4200fded953f4",
+	"1f468-1f3fb-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fd", "6c10f50dddd664df7013277c2adff2b9",
+	"1f468-1f3fb-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fe", "93c6d2a2657ae3007459831594f21543",
+	"1f468-1f3fb-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3ff", "314821d074d8a54f4d596d5f1466e799",
+	"1f468-1f3fc", "a173769265ce9220b3993adac139ba7d",
+	"1f468-1f3fc-200d-1f33e", "5fbeee27372ed0fa9e9ec577004ee9d3",
+	"1f468-1f3fc-200d-1f373", "e0cb51b24eabad405f2e51c01c6d395c",
+	"1f468-1f3fc-200d-1f37c", "a6d73ab8b97d05805aa5fa65bbadc077",
+	"1f468-1f3fc-200d-1f384", "90016e00fd4d9381ff6bd2d1d2a24a3b",
+	"1f468-1f3fc-200d-1f393", "1b5fc68368f8abe842df624e12d4eca6",
+	"1f468-1f3fc-200d-1f3a4", "1dcbf531e4761273a8884807319083f7",
+	"1f468-1f3fc-200d-1f3a8", "78ac158a0fc7e8088b851c67825b2b18",
+	"1f468-1f3fc-200d-1f3eb", "5485d3eabca199dd4b5453f21aa134d7",
+	"1f468-1f3fc-200d-1f3ed", "80e2ef12fe61b9f4c47d8ffc362529c2",
+	"1f468-1f3fc-200d-1f4bb", "8ce8e01a755be3bc3bd47e9a04c91168",
+	"1f468-1f3fc-200d-1f4bc", "9b9968da315e005a1141ec0c7a9d731a",
+	"1f468-1f3fc-200d-1f527", "2cc2f7075b0787d2dcf6ccba6a24a6c5",
+	"1f468-1f3fc-200d-1f52c", "74a9429af2ded3eb66b64c133f9fcbae",
+	"1f468-1f3fc-200d-1f680", "43782831a71f6746740cca289a75493a",
+	"1f468-1f3fc-200d-1f692", "6d91b3472e855eaf27c28a0c1cf9ff7c",
+	"1f468-1f3fc-200d-1f91d-200d-1f468-1f3fb", "45a20a12235f31365518c7e0a3558c23",
+	"1f468-1f3fc-200d-1f91d-200d-1f468-1f3fd", "b67909392b10039486bb9809e7562c95",
+	"1f468-1f3fc-200d-1f91d-200d-1f468-1f3fe", "ee5ce6157f4c60967a4b8b1b7f8f7af5",
+	"1f468-1f3fc-200d-1f91d-200d-1f468-1f3ff", "a16b3b8fb41e152e963082288f739a12",
+	"1f468-1f3fc-200d-1f9af", "5e0daa215cfde475c1396a2901991d65",
+	"1f468-1f3fc-200d-1f9b0", "fae946cef8df100ee735e694ab58d19e",
+	"1f468-1f3fc-200d-1f9b1", "4ab793c36ce135759fcc5c93401de0ac",
+	"1f468-1f3fc-200d-1f9b2", "9d3601cc732e2df84b0b28cbcd79d697",
+	"1f468-1f3fc-200d-1f9b3", "14ec3be7800925de9d2d3c14d1e1568c",
+	"1f468-1f3fc-200d-1f9bc", "ddefd3cc23d303281cfcc65f97abd049",
+	"1f468-1f3fc-200d-1f9bd", "efb9005487280c7e16c620caf2dd80bd",
+	"1f468-1f3fc-200d-2695-fe0f", "79e61cb037b2963237170142531f53aa",
+	"1f468-1f3fc-200d-2696-fe0f", "fc00d6ac840e5352bd9cefa8cea215c4",
+	"1f468-1f3fc-200d-2708-fe0f", "63121443f4e4325d3aababdc56efdaa1",
+	"1f468-1f3fc-200d-2764-fe0f-200d-1f468-1f3fb", "7dec34a9deb5ed78911fdee5b06f0df3",
+	"1f468-1f3fc-200d-2764-fe0f-200d-1f468-1f3fc", "691268037bf71fdc4b9edffe7e842ffa",
+	"1f468-1f3fc-200d-2764-fe0f-200d-1f468-1f3fd", "bc5d57b3382453157340fab2940220b9",
+	"1f468-1f3fc-200d-2764-fe0f-200d-1f468-1f3fe", "a323c8cc86633c61e3e1e6210bbeb07a",
+	"1f468-1f3fc-200d-2764-fe0f-200d-1f468-1f3ff", "6e967a6d93321898fa211fb0249c20e2",
+	"1f468-1f3fc-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fb", "63bfd26c9eda91b38bbad0c2e13007dc",
+	"1f468-1f3fc-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fc", "ebdca3b259ce89506e612b289956d1d0",
+	"1f468-1f3fc-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fd", "e5b30015e2486a1f5bad689892d72c6a",
+	"1f468-1f3fc-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fe", "ee7ab3e4108affca02838adc522ae314",
+	"1f468-1f3fc-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3ff", "2ddae246b3dfa9a00e606ff5f75de992",
+	"1f468-1f3fd", "a2ccf9097d31344249c3d8846100171c",
+	"1f468-1f3fd-200d-1f33e", "2171b72fef088f12ee1f9b55053947c9",
+	"1f468-1f3fd-200d-1f373", "ca21215c4a52090c5265eb744b8fe925",
+	"1f468-1f3fd-200d-1f37c", "6cc722cf4e4d852e90e13476bd07fc87",
+	"1f468-1f3fd-200d-1f384", "6ea878caca1856a6fa65030950201ea3",
+	"1f468-1f3fd-200d-1f393", "357f9d52cc5cc5bd9cc187e979720edb",
+	"1f468-1f3fd-200d-1f3a4", "958217345350392fbc8ae4a7907e104b",
+	"1f468-1f3fd-200d-1f3a8", "ab7e41cdf9cbffe289190ffbdef58c99",
+	"1f468-1f3fd-200d-1f3eb", "ecca42f0683e8efb6b853f601114aff0",
+	"1f468-1f3fd-200d-1f3ed", "13dea9589106a858139e099e5d96ca06",
+	"1f468-1f3fd-200d-1f4bb", "a26c19456649ee4e1588063277e186c5",
+	"1f468-1f3fd-200d-1f4bc", "793a6430136c6b509ced854872429a96",
+	"1f468-1f3fd-200d-1f527", "7d5e72c53720a98fdc558c44de7a4acb",
+	"1f468-1f3fd-200d-1f52c", "282952eb109ad53924bfb432e613abf9",
+	"1f468-1f3fd-200d-1f680", "2cf589e4bb28d81e87b748409f7be376",
+	"1f468-1f3fd-200d-1f692", "4f495d40b13d8f2b879e9effcea6d8ef",
+	"1f468-1f3fd-200d-1f91d-200d-1f468-1f3fb", "9595e4b623b035f16fa9916fc9695c8c",
+	"1f468-1f3fd-200d-1f91d-200d-1f468-1f3fc", "c0a07e3d35d8a9170dc1ea7c8823372e",
+	"1f468-1f3fd-200d-1f91d-200d-1f468-1f3fe", "0036def4c134685a6388ff0bc1768a28",
+	"1f468-1f3fd-200d-1f91d-200d-1f468-1f3ff", "1303cc17fcb2c82ffc4aabf64f716701",
+	"1f468-1f3fd-200d-1f9af", "8f3a905f0366b419540a323536fcaddc",
+	"1f468-1f3fd-200d-1f9b0", "908fc08aa976dd4e41eff1e2a2a1dffd",
+	"1f468-1f3fd-200d-1f9b1", "15c607fbbb604de29a492035954d75e2",
+	"1f468-1f3fd-200d-1f9b2", "50bf2e6b33d5c307cedeaa80ea8ccb47",
+	"1f468-1f3fd-200d-1f9b3", "74ec62c43b54772cba943a223ff8c844",
+	"1f468-1f3fd-200d-1f9bc", "736f7ef34035aeb6f20b62979387bba7",
+	"1f468-1f3fd-200d-1f9bd", "870829af98542a889c2c4b7342a0f218",
+	"1f468-1f3fd-200d-2695-fe0f", "c2a38508baf922f3dc859da3bfe9efd8",
+	"1f468-1f3fd-200d-2696-fe0f", "219ad47d297c7f78c1b46123218d089f",
+	"1f468-1f3fd-200d-2708-fe0f", "fbd137df9597e521e9ca2fa75b22076e",
+	"1f468-1f3fd-200d-2764-fe0f-200d-1f468-1f3fb", "e666e49917ad2363cb480b49b5456f29",
+	"1f468-1f3fd-200d-2764-fe0f-200d-1f468-1f3fc", "5f7866139700c621834b15d130263bda",
+	"1f468-1f3fd-200d-2764-fe0f-200d-1f468-1f3fd", "90b1dcaaa9fe7ecfe4b89e4c5b1ad040",
+	"1f468-1f3fd-200d-2764-fe0f-200d-1f468-1f3fe", "292415f0e08f33ca85005b3bf60dfc71",
+	"1f468-1f3fd-200d-2764-fe0f-200d-1f468-1f3ff", "cde841c893415c762b064f2f87a6c17e",
+	"1f468-1f3fd-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fb", "f0a512ff7e891d5f2a09ca89b9da49cb",
+	"1f468-1f3fd-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fc", "84c660e518c595682cd59ac02bab5fbe",
+	"1f468-1f3fd-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fd", "6324168e411cb0955d56df21bea13e60",
+	"1f468-1f3fd-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fe", "cb0073b0c5e5c3136700763423dae449",
+	"1f468-1f3fd-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3ff", "18e9a9ca55b62188e7c1fe0656127f2d",
+	"1f468-1f3fe", "325b0405c0ca2d31db80462e3bfa7dba",
+	"1f468-1f3fe-200d-1f33e", "6beb6e91edab1077f8211c2f258cb538",
+	"1f468-1f3fe-200d-1f373", "e2130e5b2f56680557d2a5dba9c6035a",
+	"1f468-1f3fe-200d-1f37c", "df02f1a0782c56b87ca8ac3badeeceec",
+	"1f468-1f3fe-200d-1f384", "92d9856824994ff6623ece935bd56776",
+	"1f468-1f3fe-200d-1f393", "e8ed5e1e087ff5ac9a654ec828205cc9",
+	"1f468-1f3fe-200d-1f3a4", "3ccacc1e13905df4d8d3f810ed55bc6b",
+	"1f468-1f3fe-200d-1f3a8", "f7416e93ce0014070ef2a79ffee8dc0b",
+	"1f468-1f3fe-200d-1f3eb", "1553e44d2fec6728e8cd58268c48f041",
+	"1f468-1f3fe-200d-1f3ed", "b65e58ed07db74f4c728c2813f4c1a1a",
+	"1f468-1f3fe-200d-1f4bb", "793ae3d7e01d6fe718aac40c7081c2df",
+	"1f468-1f3fe-200d-1f4bc", "4672bbed123f642a41d8bcd9cf59d6fa",
+	"1f468-1f3fe-200d-1f527", "54df1ae47edeb612dade86b2d8a5bcdb",
+	"1f468-1f3fe-200d-1f52c", "2c98229699d46c969ef57e075ec8b69f",
+	"1f468-1f3fe-200d-1f680", "391a9b6eafbbd4956f406c836912941e",
+	"1f468-1f3fe-200d-1f692", "cdd8bbec41a5f865d7db22f1d5e12946",
+	"1f468-1f3fe-200d-1f91d-200d-1f468-1f3fb", "7765a5ee30507c518628a48e7aa7938f",
+	"1f468-1f3fe-200d-1f91d-200d-1f468-1f3fc", "20710e0a39c8185d2d666ac34948c0ae",
+	"1f468-1f3fe-200d-1f91d-200d-1f468-1f3fd", "6b0e531a93795065b69255ee93223b4c",
+	"1f468-1f3fe-200d-1f91d-200d-1f468-1f3ff", "5f03b0939025a0dc7e79e279eb222d66",
+	"1f468-1f3fe-200d-1f9af", "03178a504cd74436660f8d1bb0e8ea47",
+	"1f468-1f3fe-200d-1f9b0", "dbd16e0a92feca0944ed7032a7d4d048",
+	"1f468-1f3fe-200d-1f9b1", "893e8dc1e57bff8d429e63741c364204",
+	"1f468-1f3fe-200d-1f9b2", "029a89a899646963900ad26d75dad79a",
+	"1f468-1f3fe-200d-1f9b3", "47cbdb4b9e3ec091f6908ceebef6c108",
+	"1f468-1f3fe-200d-1f9bc", "dbfe80572737a4b183ac4f10d2908c15",
+	"1f468-1f3fe-200d-1f9bd", "1c5bb4c5f3327c8444a9caa487d4c4e4",
+	"1f468-1f3fe-200d-2695-fe0f", "26129600e82b9df45aab56ce8e5ae8ca",
+	"1f468-1f3fe-200d-2696-fe0f", "2458f2a51a59a58f3f57cbf6f346ed04",
+	"1f468-1f3fe-200d-2708-fe0f", "8de0b1d1cbb5c59b7f66449e6a623171",
+	"1f468-1f3fe-200d-2764-fe0f-200d-1f468-1f3fb", "7ccb21b5a8c070b1e3e99268863e44f7",
+	"1f468-1f3fe-200d-2764-fe0f-200d-1f468-1f3fc", "6237b9e770886dd64b7b05b2bca11278",
+	"1f468-1f3fe-200d-2764-fe0f-200d-1f468-1f3fd", "6e5dad11ea0c40923ea9420c7e1f511a",
+	"1f468-1f3fe-200d-2764-fe0f-200d-1f468-1f3fe", "82e69659b5e2831a3d85662e57e32272",
+	"1f468-1f3fe-200d-2764-fe0f-200d-1f468-1f3ff", "67bd2e04323bc9312079238c0727db13",
+	"1f468-1f3fe-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fb", "1ad396e30782e304613380d42abd171b",
+	"1f468-1f3fe-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fc", "cb36d1e8ca46a33483fd0f0207f66fd0",
+	"1f468-1f3fe-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fd", "9542b4a3a67f6f9075fdb3f61fb94ed9",
+	"1f468-1f3fe-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fe", "a3ee61d0b9d562788b7e98adaa2da3b0",
+	"1f468-1f3fe-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3ff", "d2fdda22522d2877f7636cd901331942",
+	"1f468-1f3ff", "af83ffc7860973be9b3a48124e9d3c25",
+	"1f468-1f3ff-200d-1f33e", "03b66d7b560c89dbaff2b8709eb4054c",
+	"1f468-1f3ff-200d-1f373", "1560d8478e10e92775645092798e3ce0",
+	"1f468-1f3ff-200d-1f37c", "1e6429c9114c50025f5596b2324b5dfa",
+	"1f468-1f3ff-200d-1f384", "105311b6dd7c00d36b514f5e41d0f46c",
+	"1f468-1f3ff-200d-1f393", "39536bbdfc927864a312cf0c4d8c51dd",
+	"1f468-1f3ff-200d-1f3a4", "90198033faff0645e4eb2d094319944c",
+	"1f468-1f3ff-200d-1f3a8", "8e640e5327a2cc4a9c91e3a70f148909",
+	"1f468-1f3ff-200d-1f3eb", "894974af2a716e29eaa10e5a95da6156",
+	"1f468-1f3ff-200d-1f3ed", "c8575296d2f041b9ae1e2b08e8b21187",
+	"1f468-1f3ff-200d-1f4bb", "502f7dc7cead9c69efce5fbe5520b341",
+	"1f468-1f3ff-200d-1f4bc", "f9175aa78d76a906d9eb513d07dfc3b1",
+	"1f468-1f3ff-200d-1f527", "444397e4d9c29bf68a044eb112627990",
+	"1f468-1f3ff-200d-1f52c", "1462b77b7121f62234fa96687000cf0e",
+	"1f468-1f3ff-200d-1f680", "32b4479fda72b0bc9ba84dd9a18c86af",
+	"1f468-1f3ff-200d-1f692", "59af5a1ce4b7a389384bc7c1290fc888",
+	"1f468-1f3ff-200d-1f91d-200d-1f468-1f3fb", "4aa321f8c08cadb26e4d8c4bfe45a1ed",
+	"1f468-1f3ff-200d-1f91d-200d-1f468-1f3fc", "a8a650e4209896ccf071449908297576",
+	"1f468-1f3ff-200d-1f91d-200d-1f468-1f3fd", "4327bf60ae5ca82ecf029ec996f2d3db",
+	"1f468-1f3ff-200d-1f91d-200d-1f468-1f3fe", "5e0c4011b436fce242590bbbd6864c5e",
+	"1f468-1f3ff-200d-1f9af", "6f13f12d3040bac99e3cf16707a03a60",
+	"1f468-1f3ff-200d-1f9b0", "9e4f19705c2623a469473182b89212a7",
+	"1f468-1f3ff-200d-1f9b1", "cb922c040149058b8c06f556e17736e6",
+	"1f468-1f3ff-200d-1f9b2", "eb6796a182ef5b5bb2b0d53526dcf993",
+	"1f468-1f3ff-200d-1f9b3", "8643fdde81d97361abf9f07a83ba9715",
+	"1f468-1f3ff-200d-1f9bc", "cb7f86967e96e92077865c4c4f0ef806",
+	"1f468-1f3ff-200d-1f9bd", "faeb292e7886387dcf692a7185b6679b",
+	"1f468-1f3ff-200d-2695-fe0f", "a191c2c1f994df334267a8cc81e8a1ab",
+	"1f468-1f3ff-200d-2696-fe0f", "3379576d827e8d84ba91535ea199d79f",
+	"1f468-1f3ff-200d-2708-fe0f", "81db924b8c31f2b43beff0ebc559140d",
+	"1f468-1f3ff-200d-2764-fe0f-200d-1f468-1f3fb", "7385d261e70b0cc9566ca77e048c821d",
+	"1f468-1f3ff-200d-2764-fe0f-200d-1f468-1f3fc", "59056eaaeb655a7c5beb85c0095173dd",
+	"1f468-1f3ff-200d-2764-fe0f-200d-1f468-1f3fd", "704977f099302e133536f0ef3c756db6",
+	"1f468-1f3ff-200d-2764-fe0f-200d-1f468-1f3fe", "c4b28fbc6bf564696acf93f73058ee62",
+	"1f468-1f3ff-200d-2764-fe0f-200d-1f468-1f3ff", "05c5bf56bba8bbfa3e8e6e1f9aa34944",
+	"1f468-1f3ff-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fb", "854362b64e8f3cdbbde633f73ed3a5b5",
+	"1f468-1f3ff-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fc", "7b1b3b6a979722eabab479abf0e801c6",
+	"1f468-1f3ff-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fd", "7a62150043fb80e9c7bc6b32ad80ab0e",
+	"1f468-1f3ff-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fe", "5de427e82aed3933a93720a857fa6b88",
+	"1f468-1f3ff-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3ff", "deb56f91411cb6d487c4be492b77db4c",
+	"1f468-200d-1f33e", "294decfba74cc81a67bf80ca383bd4c8",
+	"1f468-200d-1f373", "5083b9b79a0eb93681004cb832d6c3b4",
+	"1f468-200d-1f37c", "a7d6ef4ec8f152fbed2694d3116105e2",
+	"1f468-200d-1f384", "9a77e13be0f62ffa5f508f3cf50912c2",
+	"1f468-200d-1f393", "822f669dcf1896f482b5191ad465d7bf",
+	"1f468-200d-1f3a4", "a80d371c64aa68975c04d7eb628d7e11",
+	"1f468-200d-1f3a8", "7781807ba07d7c3cedfff262af647697",
+	"1f468-200d-1f3eb", "2debd7addbbde54bcb76293957ae88d8",
+	"1f468-200d-1f3ed", "a8edfb553852f212bbf7a8d9f9c30fa7",
+	"1f468-200d-1f466", "39651be2c95bfb01b4c6b64adf9187f9",
+	"1f468-200d-1f466-200d-1f466", "17669dc459ec7b632142ba2368d8d33d",
+	"1f468-200d-1f467", "953b262e6c412066e6da03d6cd73e745",
+	"1f468-200d-1f467-200d-1f466", "b7c7f1b5c741b71681ebc465e4e32a4f",
+	"1f468-200d-1f467-200d-1f467", "475ece5e169b7434c6e845d7e86c278c",
+	"1f468-200d-1f468-200d-1f466", "48657aa9a8b7ef99a232bf8057a5648a",
+	"1f468-200d-1f468-200d-1f466-200d-1f466", "25330f91a421dc15886f47aae528562f",
+	"1f468-200d-1f468-200d-1f467", "ee0e39de06febd5ca94bab0a4eb16ad8",
+	"1f468-200d-1f468-200d-1f467-200d-1f466", "fa92efdbe330a33e06073e688ee29502",
+	"1f468-200d-1f468-200d-1f467-200d-1f467", "10b9955b4f5bdeb8be773380c76d9303",
+	"1f468-200d-1f469-200d-1f466", "a7b649c8c2cf38e3264f915d1c961213",
+	"1f468-200d-1f469-200d-1f466-200d-1f466", "356570767f07ea5101e7dff30343fa6a",
+	"1f468-200d-1f469-200d-1f467", "b0d0e2799eb3c66821396a55c1aeaed4",
+	"1f468-200d-1f469-200d-1f467-200d-1f466", "e863abeafe9067c9b617f1b9d8bc5a51",
+	"1f468-200d-1f469-200d-1f467-200d-1f467", "dd73633ea103e7274a17196828179362",
+	"1f468-200d-1f4bb", "001ee681fa13f39b4475ebf139a7ac64",
+	"1f468-200d-1f4bc", "6da8f926e0b844ea70233c4ea3260b21",
+	"1f468-200d-1f527", "d7ef13a16a7e1c7276214d5181c1f2e5",
+	"1f468-200d-1f52c", "78dcd1cb4f8754439e93ebba4730170a",
+	"1f468-200d-1f680", "499ad1ac9249b7575ec6c0435380d940",
+	"1f468-200d-1f692", "a75b6b2ff509da81b35801073e057ea8",
+	"1f468-200d-1f9af", "4c563924403f14f48eaa81ddb4d541f7",
+	"1f468-200d-1f9b0", "770fed3a8516e0819a2bb949bd300b8f",
+	"1f468-200d-1f9b1", "6083a1adedd7c66bfd5390b89e73ccf3",
+	"1f468-200d-1f9b2", "fdbde87b901e59744ccf58d931820e2b",
+	"1f468-200d-1f9b3", "249da2bffcec354ded3d7b68ca0c75a5",
+	"1f468-200d-1f9bc", "66fd6cb83e2a4f276e28bf7125f5700c",
+	"1f468-200d-1f9bd", "ee85cab72bda4cc497f77f710eb8fa6a",
+	"1f468-200d-2695-fe0f", "a9c58cb30c6283e8ce3fd4d9fb05b171",
+	"1f468-200d-2696-fe0f", "3992481612c30fea0d5752bd4d33d164",
+	"1f468-200d-2708-fe0f", "8b9d38aa44fe033b956b454465a10548",
+	"1f468-200d-2764-fe0f-200d-1f468", "96ba72cd40bb0c9581f5a426d8fdc663",
+	"1f468-200d-2764-fe0f-200d-1f48b-200d-1f468", "282bd8521b6b2d455c767873c676aa9f",
+	"1f469", "55393c8aa1b07ef9bbbba4ec6bd9b562",
+	"1f469-1f3fb", "4a8440d0f005eb46ed688d214a7f8792",
+	"1f469-1f3fb-200d-1f33e", "c05ee60e4b02cbf3250b7194aeb77568",
+	"1f469-1f3fb-200d-1f373", "eb7da966209699f5ef99d30b27a5b5b9",
+	"1f469-1f3fb-200d-1f37c", "7e9bc42d9d71b2e0680b36281655a46d",
+	"1f469-1f3fb-200d-1f384", "e25208b297bf8f9e22044a09811083fd",
+	"1f469-1f3fb-200d-1f393", "6b7066d56f7bb0306bed5d1e4368362a",
+	"1f469-1f3fb-200d-1f3a4", "400b65985fb9c1d6c27eb720aa54c282",
+	"1f469-1f3fb-200d-1f3a8", "5753700d0860e08882b55c541b9a0e12",
+	"1f469-1f3fb-200d-1f3eb", "7d5a8f324d82231cd79f1de6da182af2",
+	"1f469-1f3fb-200d-1f3ed", "6957f0d23f81dd8aa333ec86391ffae3",
+	"1f469-1f3fb-200d-1f4bb", "b5141101a34dc7f2bd83968f6525085b",
+	"1f469-1f3fb-200d-1f4bc", "e5206e051d31c3981958c0f6a09adc76",
+	"1f469-1f3fb-200d-1f527", "4a946474d29e2faa7314d68e9bb3fa1c",
+	"1f469-1f3fb-200d-1f52c", "4dd79c6e2ec753cc8761d6088d030b0a",
+	"1f469-1f3fb-200d-1f680", "b4019d667518ddc15a4f3c9e0b3274aa",
+	"1f469-1f3fb-200d-1f692", "162ea95c835848f50fe7508717ad0f44",
+	"1f469-1f3fb-200d-1f91d-200d-1f468-1f3fc", "da9851cecfe6985008a06e3fa1c1aea6",
+	"1f469-1f3fb-200d-1f91d-200d-1f468-1f3fd", "580cc653ebad003fe6a2242165d7df0e",
+	"1f469-1f3fb-200d-1f91d-200d-1f468-1f3fe", "2c75415bf90d5b8146201abe5154651f",
+	"1f469-1f3fb-200d-1f91d-200d-1f468-1f3ff", "fa968efb651ef4204c0b89c973d5666b",
+	"1f469-1f3fb-200d-1f91d-200d-1f469-1f3fc", "af3100b9207f3462e55fe5148da001f8",
+	"1f469-1f3fb-200d-1f91d-200d-1f469-1f3fd", "e22c4c70963f45d3a300b5614ab939ad",
+	"1f469-1f3fb-200d-1f91d-200d-1f469-1f3fe", "b7e5e2dc520418fc77f99c083d89e879",
+	"1f469-1f3fb-200d-1f91d-200d-1f469-1f3ff", "6668241333c5805aaa2538ddeaf59d47",
+	"1f469-1f3fb-200d-1f9af", "42cc56c9d9adb19541c316c3d723e970",
+	"1f469-1f3fb-200d-1f9b0", "5cb9af3510d009af4dbf47b29612f12c",
+	"1f469-1f3fb-200d-1f9b1", "8d6a47adc6bc6697095e3b584c56fef6",
+	"1f469-1f3fb-200d-1f9b2", "4549c316efc751175db7cef3b56bca2f",
+	"1f469-1f3fb-200d-1f9b3", "93cc1882d9b2744442631151d88dd053",
+	"1f469-1f3fb-200d-1f9bc", "78bcb22888a9bb794b76af73ec7a14b2",
+	"1f469-1f3fb-200d-1f9bd", "80e8ff2c814ac87d52a2460fa1f35e66",
+	"1f469-1f3fb-200d-2695-fe0f", "2bf3289b57c30a2d80d8bb814190f471",
+	"1f469-1f3fb-200d-2696-fe0f", "40d7a6d9708f54dab9ac2c30e9d4c6b3",
+	"1f469-1f3fb-200d-2708-fe0f", "f30e4c1df4e95be305ad216db8852e39",
+	"1f469-1f3fb-200d-2764-fe0f-200d-1f468-1f3fb", "36c308d350c00d853499019526b3d9d1",
+	"1f469-1f3fb-200d-2764-fe0f-200d-1f468-1f3fc", "acaa918820bf4923209a614045fb94b6",
+	"1f469-1f3fb-200d-2764-fe0f-200d-1f468-1f3fd", "c4c1f3c65502ce730de793606976d918",
+	"1f469-1f3fb-200d-2764-fe0f-200d-1f468-1f3fe", "37ac0988a41e2582211f6fdb58017648",
+	"1f469-1f3fb-200d-2764-fe0f-200d-1f468-1f3ff", "760d438dd8818306433c66390c1ada6e",
+	"1f469-1f3fb-200d-2764-fe0f-200d-1f469-1f3fb", "8e65f23a8bd5d220ee44191dc0c8efe9",
+	"1f469-1f3fb-200d-2764-fe0f-200d-1f469-1f3fc", "e05a5488326423c95c23fa04f0989028",
+	"1f469-1f3fb-200d-2764-fe0f-200d-1f469-1f3fd", "d3a5175d5967b49303aaa007c2323503",
+	"1f469-1f3fb-200d-2764-fe0f-200d-1f469-1f3fe", "95993a38ab1f852a3e2e0acbefb8caf4",
+	"1f469-1f3fb-200d-2764-fe0f-200d-1f469-1f3ff", "047094777be0cf85fc16c2c86e4c688f",
+	"1f469-1f3fb-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fb", "b7fbe619e9e01d7dacdf3a80ea2283c9",
+	"1f469-1f3fb-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fc", "c60d98a1b568138df0f4e3315241c67c",
+	"1f469-1f3fb-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fd", "0f00b719d0f51d29bfc1bb70a86ebbca",
+	"1f469-1f3fb-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fe", "06166817c43bf33237f4db6280182c54",
+	"1f469-1f3fb-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3ff", "db1c2f64c493bb2282b2b48aaf517fd5",
+	"1f469-1f3fb-200d-2764-fe0f-200d-1f48b-200d-1f469-1f3fb", "716165f1effafd6289ee7f74d5f8407b",
+	"1f469-1f3fb-200d-2764-fe0f-200d-1f48b-200d-1f469-1f3fc", "2db20d18a3880dd64da1d8e9c7cfa3bb",
+	"1f469-1f3fb-200d-2764-fe0f-200d-1f48b-200d-1f469-1f3fd", "fe44c728ce714a7ef417c0d49b15ad02",
+	"1f469-1f3fb-200d-2764-fe0f-200d-1f48b-200d-1f469-1f3fe", "a6304ffbc3d5568f144daa08a9081414",
+	"1f469-1f3fb-200d-2764-fe0f-200d-1f48b-200d-1f469-1f3ff", "c8d00e4308cecc7841479e759002feca",
+	"1f469-1f3fc", "4e5c39b2653bdcbb7021443b051094b4",
+	"1f469-1f3fc-200d-1f33e", "31c6feca10ba91c88283d90f48b9e596",
+	"1f469-1f3fc-200d-1f373", "0c244dd4926eac645058f5e5154f6d87",
+	"1f469-1f3fc-200d-1f37c", "a20a876eb57197c2b3dabf50ea0d2557",
+	"1f469-1f3fc-200d-1f384", "4b083ab47a352c20827b7e2457c4ea20",
+	"1f469-1f3fc-200d-1f393", "cb85df61e21dc34e23c6667133695956",
+	"1f469-1f3fc-200d-1f3a4", "31db695924215fc7e288ca0388d16c1e",
+	"1f469-1f3fc-200d-1f3a8", "f485ba770550749690b2d3419c59efbf",
+	"1f469-1f3fc-200d-1f3eb", "f1a5c411d8332356f7d013863b3bb77b",
+	"1f469-1f3fc-200d-1f3ed", "d149aa3152b1d0d06cb4581a1da24c07",
+	"1f469-1f3fc-200d-1f4bb", "5b082b13bb34c501ff8be602eb47e760",
+	"1f469-1f3fc-200d-1f4bc", "8314ff2c4ec7f0f1493a60aa9c6ca0d6",
+	"1f469-1f3fc-200d-1f527", "81b415149fbcfe7dfc9b78859b033808",
+	"1f469-1f3fc-200d-1f52c", "f7310bbaf7e63acb1de3495752a03154",
+	"1f469-1f3fc-200d-1f680", "c03d7dac24a5d5d2a9255f0e5acffd14",
+	"1f469-1f3fc-200d-1f692", "48f16c7d6b5c4731e50c4a2f4abdd32d",
+	"1f469-1f3fc-200d-1f91d-200d-1f468-1f3fb", "8ad29bc57b755eb0e679a3f8fb96439b",
+	"1f469-1f3fc-200d-1f91d-200d-1f468-1f3fd", "6c5d95b4e54283eee288c7b6adea1380",
+	"1f469-1f3fc-200d-1f91d-200d-1f468-1f3fe", "e650bde0c76bde895b715d37ca084887",
+	"1f469-1f3fc-200d-1f91d-200d-1f468-1f3ff", "c3ff32aafb1f1a0a758bcc6109e0e5a4",
+	"1f469-1f3fc-200d-1f91d-200d-1f469-1f3fb", "13391d4dbabd1baf111829281c4bd742",
+	"1f469-1f3fc-200d-1f91d-200d-1f469-1f3fd", "2ba3d61cfb67ad247aa1a4c31820cc46",
+	"1f469-1f3fc-200d-1f91d-200d-1f469-1f3fe", "687bcea1a44d07c81ff7c8c53d705041",
+	"1f469-1f3fc-200d-1f91d-200d-1f469-1f3ff", "9390d01b73a35988eeadd26e987bc8eb",
+	"1f469-1f3fc-200d-1f9af", "73fa3a01d6f07e9a3f7ed3c75ce3b9e9",
+	"1f469-1f3fc-200d-1f9b0", "f57d9d8cba0c2c3efe420f67d5c3cc8d",
+	"1f469-1f3fc-200d-1f9b1", "a24da1d246e0908a99c67dc48768ba86",
+	"1f469-1f3fc-200d-1f9b2", "79ba4dfc4afabf837ba4894cd8c1d37d",
+	"1f469-1f3fc-200d-1f9b3", "1eb9b79722b18e5e3be552f37122ed84",
+	"1f469-1f3fc-200d-1f9bc", "287b7cc1bf7bd576b2c4a6188b54684a",
+	"1f469-1f3fc-200d-1f9bd", "0ec768b28ed3582ec436cd4652bf561c",
+	"1f469-1f3fc-200d-2695-fe0f", "c88b21573403cedac7f18d55af57e0ae",
+	"1f469-1f3fc-200d-2696-fe0f", "af5f05769e4755f7f5265269a54757e3",
+	"1f469-1f3fc-200d-2708-fe0f", "23ca3e0d5bab9a5ebe68f9eba6671431",
+	"1f469-1f3fc-200d-2764-fe0f-200d-1f468-1f3fb", "25dc13edf0aeaea4911011ba7f3eb2ec",
+	"1f469-1f3fc-200d-2764-fe0f-200d-1f468-1f3fc", "c561e8bf1732fa5edf431590591b4b8a",
+	"1f469-1f3fc-200d-2764-fe0f-200d-1f468-1f3fd", "0a7e55e4b5db670525407b7fd7e7f6aa",
+	"1f469-1f3fc-200d-2764-fe0f-200d-1f468-1f3fe", "bcdee8d6f823e7242546ececd3099881",
+	"1f469-1f3fc-200d-2764-fe0f-200d-1f468-1f3ff", "5d37d071f87b53a4260f815aa924689a",
+	"1f469-1f3fc-200d-2764-fe0f-200d-1f469-1f3fb", "aca632c602f12a99f5a1a8d588f858b7",
+	"1f469-1f3fc-200d-2764-fe0f-200d-1f469-1f3fc", "74a3fdcd8ec14a0383f84bbbfa34190a",
+	"1f469-1f3fc-200d-2764-fe0f-200d-1f469-1f3fd", "f5b5c6a759f2147353dfa4f6c356e15f",
+	"1f469-1f3fc-200d-2764-fe0f-200d-1f469-1f3fe", "281fb7cb02d1651612f1d6bfb6b9757a",
+	"1f469-1f3fc-200d-2764-fe0f-200d-1f469-1f3ff", "6faa734c6c31ce344e71008142ce97ba",
+	"1f469-1f3fc-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fb", "1e6c3c89cbed8f853cc9b5eb0a86215d",
+	"1f469-1f3fc-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fc", "0c78d39195e86dd3a18ec422a7c5de2a",
+	"1f469-1f3fc-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fd", "f789d89354a8f5248593108c8df0df82",
+	"1f469-1f3fc-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fe", "9be6b87ad91eeef32708affd8cd9d7ed",
+	"1f469-1f3fc-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3ff", "a64d2a49a27c3c431136fbf0b308c5c3",
+	"1f469-1f3fc-200d-2764-fe0f-200d-1f48b-200d-1f469-1f3fb", "23e1c8b52911dd854313f02ce2680d23",
+	"1f469-1f3fc-200d-2764-fe0f-200d-1f48b-200d-1f469-1f3fc", "b783eba12b2d117cd97c0cf41da787fe",
+	"1f469-1f3fc-200d-2764-fe0f-200d-1f48b-200d-1f469-1f3fd", "40c554ac80c3afed17fa83d7ac701faf",
+	"1f469-1f3fc-200d-2764-fe0f-200d-1f48b-200d-1f469-1f3fe", "da9fb6e7a6e49b405a256bd942b69ff7",
+	"1f469-1f3fc-200d-2764-fe0f-200d-1f48b-200d-1f469-1f3ff", "9405d68a4d72305380b89b3d615ade08",
+	"1f469-1f3fd", "d6b7df4fe1250ec05349f57d8a36e72b",
+	"1f469-1f3fd-200d-1f33e", "6d2c5244c2c60def7772acf366a1f0f8",
+	"1f469-1f3fd-200d-1f373", "29105405916cc959c1e39a7d24f54921",
+	"1f469-1f3fd-200d-1f37c", "544e21f44f747aa00bff6f0b42dd0671",
+	"1f469-1f3fd-200d-1f384", "8b8b3c75cc1cc90e05de45cbfb4cbf7f",
+	"1f469-1f3fd-200d-1f393", "1edf244c3891dcbe0dfdfedd441832d7",
+	"1f469-1f3fd-200d-1f3a4", "27f75de4cec0943119819371e5e589e2",
+	"1f469-1f3fd-200d-1f3a8", "58a73cf10928c8484c829bc3e075a305",
+	"1f469-1f3fd-200d-1f3eb", "5ddb5da3e15c2aa0e1daa67588f96895",
+	"1f469-1f3fd-200d-1f3ed", "b440f45c00c5fb69a04d500e29db11b3",
+	"1f469-1f3fd-200d-1f4bb", "0ad7247adcc5b1cae4285780787843ed",
+	"1f469-1f3fd-200d-1f4bc", "52aab809f3799796ab308b384ae831fd",
+	"1f469-1f3fd-200d-1f527", "be79522916395976316afb14365e3cc5",
+	"1f469-1f3fd-200d-1f52c", "3e351a53ec2fd7a5e77daeb7f7619c84",
+	"1f469-1f3fd-200d-1f680", "1d34207841604ead8f228efc6de5694b",
+	"1f469-1f3fd-200d-1f692", "c8cae5e2d1f6c877a1a8ecbc6cf186ed",
+	"1f469-1f3fd-200d-1f91d-200d-1f468-1f3fb", "3c0db26cea27baa4ed43381768002c13",
+	"1f469-1f3fd-200d-1f91d-200d-1f468-1f3fc", "b2779ac68c1a10fe0ae533943fbea263",
+	"1f469-1f3fd-200d-1f91d-200d-1f468-1f3fe", "ac16a3298f6aa3d9b730708c2968fa99",
+	"1f469-1f3fd-200d-1f91d-200d-1f468-1f3ff", "02063ac87d66ee807bca80994752b13c",
+	"1f469-1f3fd-200d-1f91d-200d-1f469-1f3fb", "ea1c7ee2460a4f6a9b898c3843950349",
+	"1f469-1f3fd-200d-1f91d-200d-1f469-1f3fc", "618ab972b401228b4d7334e2d00874e7",
+	"1f469-1f3fd-200d-1f91d-200d-1f469-1f3fe", "fecb77322d79365c0c4d94961794fa7f",
+	"1f469-1f3fd-200d-1f91d-200d-1f469-1f3ff", "cb4166709b0e470024bb7888024177ff",
+	"1f469-1f3fd-200d-1f9af", "efa3a0f4b6ed4c013d987411afb5ca26",
+	"1f469-1f3fd-200d-1f9b0", "5f0b561cd83f1a1a98f3d9b89aacd511",
+	"1f469-1f3fd-200d-1f9b1", "04441cae81215450ae396fcd41b5ed46",
+	"1f469-1f3fd-200d-1f9b2", "15b0f8e9ab83200c095149863a8b4cdf",
+	"1f469-1f3fd-200d-1f9b3", "41e4aaae2899d212613e2a32a7df000f",
+	"1f469-1f3fd-200d-1f9bc", "ec72051e4ff2b9f7facaeeb8c6ff5e21",
+	"1f469-1f3fd-200d-1f9bd", "c30f9c21b7338892e5d778d48a5a0134",
+	"1f469-1f3fd-200d-2695-fe0f", "fa78e04dbe3582c0190e248f5a916028",
+	"1f469-1f3fd-200d-2696-fe0f", "3c51068c920b9197c5f2e50bf7909464",
+	"1f469-1f3fd-200d-2708-fe0f", "efd4ceb1752fb6dfe72a86356a72cdae",
+	"1f469-1f3fd-200d-2764-fe0f-200d-1f468-1f3fb", "acbc7f64fabcb45f7f82b212f525e8b0",
+	"1f469-1f3fd-200d-2764-fe0f-200d-1f468-1f3fc", "0f3b414a9fc1ed7e1915b8819163e404",
+	"1f469-1f3fd-200d-2764-fe0f-200d-1f468-1f3fd", "27872fe06a8ec01e102c5eb254a373ee",
+	"1f469-1f3fd-200d-2764-fe0f-200d-1f468-1f3fe", "576142ba81a59e5fad77d2529316c56e",
+	"1f469-1f3fd-200d-2764-fe0f-200d-1f468-1f3ff", "d271649403bc0323db07981f2508f2f7",
+	"1f469-1f3fd-200d-2764-fe0f-200d-1f469-1f3fb", "9df18aea301352a280bd02cc3b89236e",
+	"1f469-1f3fd-200d-2764-fe0f-200d-1f469-1f3fc", "99acbe619c3a6ad99830a3836b9f8a31",
+	"1f469-1f3fd-200d-2764-fe0f-200d-1f469-1f3fd", "e4266f85e2124a9e50b5ce3539965bc2",
+	"1f469-1f3fd-200d-2764-fe0f-200d-1f469-1f3fe", "3e62b3c5ce965e1e3a46e3e0623748ba",
+	"1f469-1f3fd-200d-2764-fe0f-200d-1f469-1f3ff", "6ed7914877fa185b441eabacbbc6f380",
+	"1f469-1f3fd-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fb", "6907b5f26485bee4a536e66dedb6e6e3",
+	"1f469-1f3fd-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fc", "89ada0142f618da282e828d07bcccfad",
+	"1f469-1f3fd-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fd", "f239257616c0ce72100b90fecc040744",
+	"1f469-1f3fd-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fe", "a9238b7c298f0719a48d9d1ba52c1d25",
+	"1f469-1f3fd-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3ff", "d21f8df460c7ae40a61e7fe5e0426512",
+	"1f469-1f3fd-200d-2764-fe0f-200d-1f48b-200d-1f469-1f3fb", "debb276cf038fbdf4a17cc5ca814cdbb",
+	"1f469-1f3fd-200d-2764-fe0f-200d-1f48b-200d-1f469-1f3fc", "7bfcd2fd2d6cf2ce1d445b4be73b79a3",
+	"1f469-1f3fd-200d-2764-fe0f-200d-1f48b-200d-1f469-1f3fd", "d2ac957826b698f2a8efb153a8089b31",
+	"1f469-1f3fd-200d-2764-fe0f-200d-1f48b-200d-1f469-1f3fe", "f66dc33d90f39ff73bbaff64beb54be9",
+	"1f469-1f3fd-200d-2764-fe0f-200d-1f48b-200d-1f469-1f3ff", "e5c425a31f6de39f5028c7e8f3255bf4",
+	"1f469-1f3fe", "c54fc3caa4686b1c8b5e435efb4e248d",
+	"1f469-1f3fe-200d-1f33e", "8b308f6501b07bf49003ee79e90d3080",
+	"1f469-1f3fe-200d-1f373", "6db671fe52184eef889799f2a456045d",
+	"1f469-1f3fe-200d-1f37c", "aaaa38f72f28f35fdc537d36130c2d81",
+	"1f469-1f3fe-200d-1f384", "35c164880897dd708ed51d2941301c10",
+	"1f469-1f3fe-200d-1f393", "6f76cbcfbebab34e011f6366967e74c0",
+	"1f469-1f3fe-200d-1f3a4", "3a73c0a66923019fddf2456ed4bca515",
+	"1f469-1f3fe-200d-1f3a8", "8a643a1f62e2818331396c0cdd021f06",
+	"1f469-1f3fe-200d-1f3eb", "42f417a237bb3827006c175f4becce8b",
+	"1f469-1f3fe-200d-1f3ed", "ab8ce74e33f64ac1a578849a6f2e9885",
+	"1f469-1f3fe-200d-1f4bb", "e3c324bfc5e4da193eb4791fb118a074",
+	"1f469-1f3fe-200d-1f4bc", "9edb44f79375d0f8532f4aaab78a4ef2",
+	"1f469-1f3fe-200d-1f527", "dff246e02fa4e39502d1b6736b4096f8",
+	"1f469-1f3fe-200d-1f52c", "0eeb7ea386e175e12c13a89c2e551ce8",
+	"1f469-1f3fe-200d-1f680", "e9df0c69884582c8c47808250ec4cac2",
+	"1f469-1f3fe-200d-1f692", "22169f12a5fdb65d4767122fca047722",
+	"1f469-1f3fe-200d-1f91d-200d-1f468-1f3fb", "af11740467b1006950640f741a491c7e",
+	"1f469-1f3fe-200d-1f91d-200d-1f468-1f3fc", "2208e758eb9340d80189c75ad21563b0",
+	"1f469-1f3fe-200d-1f91d-200d-1f468-1f3fd", "2236cc2cec63a9eaf7763b1c196012cd",
+	"1f469-1f3fe-200d-1f91d-200d-1f468-1f3ff", "ef68804f725bd3d3d0b69a6e5ab93a38",
+	"1f469-1f3fe-200d-1f91d-200d-1f469-1f3fb", "427196f5352393a0d396880926d9fdd2",
+	"1f469-1f3fe-200d-1f91d-200d-1f469-1f3fc", "84a4b0f9fe39b3a7a9b11bbaf532d8cf",
+	"1f469-1f3fe-200d-1f91d-200d-1f469-1f3fd", "6c2b86fc28d0cef0d6e0581a30e3f496",
+	"1f469-1f3fe-200d-1f91d-200d-1f469-1f3ff", "ef6ed19889aacfd33c5203de1f819e23",
+	"1f469-1f3fe-200d-1f9af", "74340a9085c26aa7b62a0e9de3bd1396",
+	"1f469-1f3fe-200d-1f9b0", "e8e3f547ed020eba2154100b354c2e8b",
+	"1f469-1f3fe-200d-1f9b1", "429b3b0971ada8f52a9dbc93105eaaf9",
+	"1f469-1f3fe-200d-1f9b2", "ed05cd29c2fc8dd39ca34c040d11647d",
+	"1f469-1f3fe-200d-1f9b3", "99b38cd273a08d9e992b74073ff69d93",
+	"1f469-1f3fe-200d-1f9bc", "9eb0ddad15773a21f676f2a2fd0a3c63",
+	"1f469-1f3fe-200d-1f9bd", "0827d63a9ca5bf7b360d03f146c814b3",
+	"1f469-1f3fe-200d-2695-fe0f", "e73cf632a96329576540cc68f9ef25b9",
+	"1f469-1f3fe-200d-2696-fe0f", "9355b588d9b275fbcbcb58c8c13fb7f7",
+	"1f469-1f3fe-200d-2708-fe0f", "3ef538c9e8f36542de50ac17fa5fe8d7",
+	"1f469-1f3fe-200d-2764-fe0f-200d-1f468-1f3fb", "08094f34475aa9be9fdceaed29fd8053",
+	"1f469-1f3fe-200d-2764-fe0f-200d-1f468-1f3fc", "4c12215c9313a8514ab3e431751cb482",
+	"1f469-1f3fe-200d-2764-fe0f-200d-1f468-1f3fd", "4436816a6d68ae53a7e3455dbe0e58d9",
+	"1f469-1f3fe-200d-2764-fe0f-200d-1f468-1f3fe", "2e55f66b1a84d562576c075d5d2378f5",
+	"1f469-1f3fe-200d-2764-fe0f-200d-1f468-1f3ff", "f8eaeafef7b9fd6a27652881a54db757",
+	"1f469-1f3fe-200d-2764-fe0f-200d-1f469-1f3fb", "a355bbcce726fbba96d6a28741649669",
+	"1f469-1f3fe-200d-2764-fe0f-200d-1f469-1f3fc", "d32459b82148d5cbf1e03b003230d7b6",
+	"1f469-1f3fe-200d-2764-fe0f-200d-1f469-1f3fd", "fed4ef164f83d848f13ae6cfcab556ef",
+	"1f469-1f3fe-200d-2764-fe0f-200d-1f469-1f3fe", "6d0dbe4d65bc75752eedf3ebbbce4c86",
+	"1f469-1f3fe-200d-2764-fe0f-200d-1f469-1f3ff", "ece6cfddda169ad39b735f11b93bc3ca",
+	"1f469-1f3fe-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fb", "bfa406c50c94005b0364c2a264860b2c",
+	"1f469-1f3fe-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fc", "1845f628183af48ee75dc421100f500b",
+	"1f469-1f3fe-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fd", "bbc59c553442f16743f7d9a96137e111",
+	"1f469-1f3fe-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fe", "a57edd62a2d5684509783e2a6f43d7ba",
+	"1f469-1f3fe-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3ff", "b1cd81a028bbd9b6fb78e4c78ca5f075",
+	"1f469-1f3fe-200d-2764-fe0f-200d-1f48b-200d-1f469-1f3fb", "c56ae4c8c41e0c13b9f2f5dcf3827cb0",
+	"1f469-1f3fe-200d-2764-fe0f-200d-1f48b-200d-1f469-1f3fc", "312797132c9c8ac9edd50a41f24fe278",
+	"1f469-1f3fe-200d-2764-fe0f-200d-1f48b-200d-1f469-1f3fd", "a0d0e4d477abe32bb1637bc1d756d136",
+	"1f469-1f3fe-200d-2764-fe0f-200d-1f48b-200d-1f469-1f3fe", "2e08933c9384cde89547e053a7655846",
+	"1f469-1f3fe-200d-2764-fe0f-200d-1f48b-200d-1f469-1f3ff", "9f8808f3b0e9f04ab829d815ac6fd473",
+	"1f469-1f3ff", "fba1d9956db585299f85d53dd8474256",
+	"1f469-1f3ff-200d-1f33e", "0009618e499a0416857ba72269849fc3",
+	"1f469-1f3ff-200d-1f373", "a45f7b5b54ff1c098e9b02593e6adc10",
+	"1f469-1f3ff-200d-1f37c", "469eb344c3bb4597e979c212a4a5603d",
+	"1f469-1f3ff-200d-1f384", "21d8977920e9aa27089f971704695fc0",
+	"1f469-1f3ff-200d-1f393", "752fdf60e9b39c1021d345b7a89c5177",
+	"1f469-1f3ff-200d-1f3a4", "9c1de015477307258713626405331986",
+	"1f469-1f3ff-200d-1f3a8", "bd8a8189440491ad5eca9b920a7295c5",
+	"1f469-1f3ff-200d-1f3eb", "c7c25b7c8f5ba0422921e078d9338ea2",
+	"1f469-1f3ff-200d-1f3ed", "ba30af14ec3eff7e16b7ce0bfdacd84c",
+	"1f469-1f3ff-200d-1f4bb", "acd662e82616cd7f40435ecba3ae1bda",
+	"1f469-1f3ff-200d-1f4bc", "a41da6a6a4ad9c6b64d98c3e84c9617c",
+	"1f469-1f3ff-200d-1f527", "088f8554a4524c59500868ccd1d4f37b",
+	"1f469-1f3ff-200d-1f52c", "90e42e5162bcfbc3961a138d85731cf1",
+	"1f469-1f3ff-200d-1f680", "3a040154c7667e309a163c33d0089773",
+	"1f469-1f3ff-200d-1f692", "2be8aedb51ad2f4f3d76d6d2e04b5470",
+	"1f469-1f3ff-200d-1f91d-200d-1f468-1f3fb", "e37a09cf46ab10550fccd90e32e761b9",
+	"1f469-1f3ff-200d-1f91d-200d-1f468-1f3fc", "e1ba0026a5cc602924834d31b6239cfd",
+	"1f469-1f3ff-200d-1f91d-200d-1f468-1f3fd", "c98258da30e0453fe5f6797e77c5ad45",
+	"1f469-1f3ff-200d-1f91d-200d-1f468-1f3fe", "1df3e5f974d353c417ed701aee476a11",
+	"1f469-1f3ff-200d-1f91d-200d-1f469-1f3fb", "d908c8ec832aeaa7b43ca11fa13d4483",
+	"1f469-1f3ff-200d-1f91d-200d-1f469-1f3fc", "976862e9872e868bf7bc362c3a954d58",
+	"1f469-1f3ff-200d-1f91d-200d-1f469-1f3fd", "37a7790b54dcc2e3a000c248582792c6",
+	"1f469-1f3ff-200d-1f91d-200d-1f469-1f3fe", "622d84936c03564f3bd1a8efe94649f3",
+	"1f469-1f3ff-200d-1f9af", "3c05e392fc4011f7ca74dd64cfd25cb6",
+	"1f469-1f3ff-200d-1f9b0", "a55a2bd2ad31ac07648b0b149902abf7",
+	"1f469-1f3ff-200d-1f9b1", "dfd2bee0bf3301ac8e5ade903a24b8c9",
+	"1f469-1f3ff-200d-1f9b2", "dbba7a4e9cc79334b255d1c322f66b2b",
+	"1f469-1f3ff-200d-1f9b3", "81b1bb1f94c59be53b72a4b24cfc5579",
+	"1f469-1f3ff-200d-1f9bc", "e04323da7a26eef6876f30be12197ec7",
+	"1f469-1f3ff-200d-1f9bd", "12822020bf870690a34f3972f604613d",
+	"1f469-1f3ff-200d-2695-fe0f", "6eae6e4173ab349cca3e0d387122cfc8",
+	"1f469-1f3ff-200d-2696-fe0f", "1025d4b9d4660b5e4e243bf0faa5620c",
+	"1f469-1f3ff-200d-2708-fe0f", "26520efea2f61082869db732d0bbda54",
+	"1f469-1f3ff-200d-2764-fe0f-200d-1f468-1f3fb", "fb3693a994480b97c1d7bed8a8bbdede",
+	"1f469-1f3ff-200d-2764-fe0f-200d-1f468-1f3fc", "a2aa4f15998877774e033cdf3b2c6b52",
+	"1f469-1f3ff-200d-2764-fe0f-200d-1f468-1f3fd", "f414975e17cab7f07639bb011352a55e",
+	"1f469-1f3ff-200d-2764-fe0f-200d-1f468-1f3fe", "1011b0e8da7c61bc9c5d5a60a4473412",
+	"1f469-1f3ff-200d-2764-fe0f-200d-1f468-1f3ff", "0f788081c54bdc4c1052dbf9c8b2dabd",
+	"1f469-1f3ff-200d-2764-fe0f-200d-1f469-1f3fb", "db923883bbeaddb34b2187707fda47ac",
+	"1f469-1f3ff-200d-2764-fe0f-200d-1f469-1f3fc", "512842ac7f55c150517a9d789c012a37",
+	"1f469-1f3ff-200d-2764-fe0f-200d-1f469-1f3fd", "7a269ab5fcabaceeb647bfc13ac19512",
+	"1f469-1f3ff-200d-2764-fe0f-200d-1f469-1f3fe", "2e805b0262e744aff373281b82830bde",
+	"1f469-1f3ff-200d-2764-fe0f-200d-1f469-1f3ff", "0b6f5befab406880a9b63f407639559f",
+	"1f469-1f3ff-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fb", "c77891519d90d53406804f33db67b608",
+	"1f469-1f3ff-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fc", "4c7308bb73721199c0b23c3f230931fc",
+	"1f469-1f3ff-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fd", "4828af7bf71bd85136af78a0f6492953",
+	"1f469-1f3ff-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3fe", "21201c828b469033ef588786bdf0b3df",
+	"1f469-1f3ff-200d-2764-fe0f-200d-1f48b-200d-1f468-1f3ff", "035a624f002da882721e964509098b03",
+	"1f469-1f3ff-200d-2764-fe0f-200d-1f48b-200d-1f469-1f3fb", "1d7fc1761497a2641cae5c17b80bee8b",
+	"1f469-1f3ff-200d-2764-fe0f-200d-1f48b-200d-1f469-1f3fc", "5b26bd4103fbb765c7329d41a3df7075",
+	"1f469-1f3ff-200d-2764-fe0f-200d-1f48b-200d-1f469-1f3fd", "6aa67b54c18150f38c34d1a88c6eccab",
+	"1f469-1f3ff-200d-2764-fe0f-200d-1f48b-200d-1f469-1f3fe", "600d211ad08036dd3a0727e5d0f894bd",
+	"1f469-1f3ff-200d-2764-fe0f-200d-1f48b-200d-1f469-1f3ff", "34ddb0e2d936e73ac615f9f9252465e2",
+	"1f469-200d-1f33e", "c4c1fa7998175ce6787fe4330588fa04",
+	"1f469-200d-1f373", "fdad8d976f2a6333ab99776940f1fe42",
+	"1f469-200d-1f37c", "ff8e88ccbd96141a488b991b13d14e5e",
+	"1f469-200d-1f384", "fa94ca14b2a88caa3529aa407645e6be",
+	"1f469-200d-1f393", "9046ad65adaa1e8a152e62e4790550ab",
+	"1f469-200d-1f3a4", "f8eaaf4c5d92f1cadf3242eecddac324",
+	"1f469-200d-1f3a8", "daec28d82ed385d47f6e4a157ddbde18",
+	"1f469-200d-1f3eb", "cca6a3d335f4a41b4e0d7538499f6892",
+	"1f469-200d-1f3ed", "ce636b3e6a427ec27cc7cdcc03ff31de",
+	"1f469-200d-1f466", "66a66146666c485c6d6e6849b3e8ff2e",
+	"1f469-200d-1f466-200d-1f466", "95bab3dc5269d36d0f68321ea9bf8e87",
+	"1f469-200d-1f467", "e488d2a3032cf78b5dd160efe12e4651",
+	"1f469-200d-1f467-200d-1f466", "5c2d1d1055865bd40432ab3e19269408",
+	"1f469-200d-1f467-200d-1f467", "161492e934c530288d50aef3aae6b71d",
+	"1f469-200d-1f469-200d-1f466", "def866ac0055751ee3182efe64287a98",
+	"1f469-200d-1f469-200d-1f466-200d-1f466", "45ed7a86efbdf780e6f907f1dcc0d0b0",
+	"1f469-200d-1f469-200d-1f467", "0ae3c62f0c077a82ef6d3a0c8d219dc5",
+	"1f469-200d-1f469-200d-1f467-200d-1f466", "f3bad5a9a0f03e8386b09e4bf441f32b",
+	"1f469-200d-1f469-200d-1f467-200d-1f467", "6581d869a42d89dec19222a1811a4291",
+	"1f469-200d-1f4bb", "6cc26b2a99c011c2efea7dbf638d2c2c",
+	"1f469-200d-1f4bc", "e335c404cdbcb2f063114b4fc1b30e23",
+	"1f469-200d-1f527", "feccf61dcc4b79e8646f1834e0103f8b",
+	"1f469-200d-1f52c", "c86f520b770dedfa733ff2bdbfab0f66",
+	"1f469-200d-1f680", "7fa023e319f8176927b67a1f8ac65289",
+	"1f469-200d-1f692", "69e77d9640edc775c499a68332222a62",
+	"1f469-200d-1f9af", "e61492cdd9592f4cfa74b4eb3920015d",
+	"1f469-200d-1f9b0", "cac36b9d62313708153c66cb99cb8cb3",
+	"1f469-200d-1f9b1", "2a4c16144dafcc7c73ad23f370ed350c",
+	"1f469-200d-1f9b2", "55f506ab0a26fefd1a66cfc913bc9a7e",
+	"1f469-200d-1f9b3", "3db60866983a923775f35e692d73d313",
+	"1f469-200d-1f9bc", "5d1624b5fabf365a639f3c1426d0b9da",
+	"1f469-200d-1f9bd", "c203a3c2194f5bc11fda6cfc926eac89",
+	"1f469-200d-2695-fe0f", "708852a93cb3f02b8fa384e177705baf",
+	"1f469-200d-2696-fe0f", "57c12e6d051f42c27babaf0b431395ab",
+	"1f469-200d-2708-fe0f", "0e4e3a77daf120d0da57757f45cd788e",
+	"1f469-200d-2764-fe0f-200d-1f468", "3d08f3213d29cb977d8c74ea5cf16c82",
+	"1f469-200d-2764-fe0f-200d-1f469", "97c54609186e3aedba6824bc0dabedbd",
+	"1f469-200d-2764-fe0f-200d-1f48b-200d-1f468", "fa5ad9d30f2e9fa928e7cb36a6cbda35",
+	"1f469-200d-2764-fe0f-200d-1f48b-200d-1f469", "3e6fe9139009de4b87b2690aac5bb940",
+	"1f46a", "af9a70ada0ec287096f84d3cd545bab1",
+	"1f46b", "3c717b0d164f2435d4c46ec2fbdd7363",
+	"1f46b-1f3fb", "9a2909bc4bc112b465c50fe0f041a3b6",
+	"1f46b-1f3fc", "003e85963114ac1efebc782dcde4bc57",
+	"1f46b-1f3fd", "1a2f71d7e6eabca6b8c1cb62f3acbad4",
+	"1f46b-1f3fe", "635a3240d74c7805b8e6d360447eea0c",
+	"1f46b-1f3ff", "879a8ce063ddef1f6ca005a12ad3efc5",
+	"1f46c", "408021169d5ec234ea9940ed80c1f355",
+	"1f46c-1f3fb", "684da0090f5777d0aee4921744a744f8",
+	"1f46c-1f3fc", "cb69486b443b6876eba10f4da690c597",
+	"1f46c-1f3fd", "5876aa6d4a43b2df0c3e868dc256b7a3",
+	"1f46c-1f3fe", "8d8ccdc88a34d7344c66558f99d89d6d",
+	"1f46c-1f3ff", "558a9cc85559acc338700ce63da6b02b",
+	"1f46d", "6f62bae14a3663fedb03553ee393e5f8",
+	"1f46d-1f3fb", "b7e8a90cbd3d56f10b570ef1a8273dd6",
+	"1f46d-1f3fc", "bd3f7205b9a41293c96e7f98eb086cb9",
+	"1f46d-1f3fd", "dbd250e2255de8d3f2376c0ad4403390",
+	"1f46d-1f3fe", "c9c5cda971c3c283cea0a65b03ddc212",
+	"1f46d-1f3ff", "718e6b0bffca0d32b0a444bca088e7d6",
+	"1f46e", "770955b283a8a3d1cfd221f70dc0e6ee",
+	"1f46e-1f3fb", "6279b79f07fa4bacbe118b891347031d",
+	"1f46e-1f3fb-200d-2640-fe0f", "5c79219a6ea469a0182ec5df2439ce2a",
+	"1f46e-1f3fb-200d-2642-fe0f", "0cc1c9e5863fa49b891f84def52afd2b",
+	"1f46e-1f3fc", "2578d978aba121c6333a09a503ba7fe6",
+	"1f46e-1f3fc-200d-2640-fe0f", "45a360d4f7809e577aab398551823308",
+	"1f46e-1f3fc-200d-2642-fe0f", "e857ca9ac3b4d7bf0d7ff3821fd82a0d",
+	"1f46e-1f3fd", "9775d6e097636b8fb926e1a55458b46f",
+	"1f46e-1f3fd-200d-2640-fe0f", "1c0d13640603c371a611587d06353b69",
+	"1f46e-1f3fd-200d-2642-fe0f", "130cad87f3ef6550482c39ad4c67d729",
+	"1f46e-1f3fe", "aa91b3ae7533f6f79c695b279a02c3e5",
+	"1f46e-1f3fe-200d-2640-fe0f", "0914ca93cc2f18917577723f5cc69b2a",
+	"1f46e-1f3fe-200d-2642-fe0f", "cbce7890f64e0bbb9bdd4664dfbfcbff",
+	"1f46e-1f3ff", "066ac551f8c87cd1b7e44a1f8e65af49",
+	"1f46e-1f3ff-200d-2640-fe0f", "75fa5ca236bd9a4c8b46472b0889974c",
+	"1f46e-1f3ff-200d-2642-fe0f", "3b3e939ba7ea5b17641973605fb3c367",
+	"1f46e-200d-2640-fe0f", "bf2638093845f1a4051c0331b2f02ea2",
+	"1f46e-200d-2642-fe0f", "ccb2d2a7a4b084c98d57ab384c0267c6",
+	"1f46f", "c74f1755041b1db171f32a6340a2f1ad",
+	"1f46f-200d-2640-fe0f", "ca99e78cbd0b60e254f50543063803b4",
+	"1f46f-200d-2642-fe0f", "73d82af5f3813a256237682879256f39",
+	"1f470", "178f1ae593a3fe88a135d55a8f041f13",
+	"1f470-1f3fb", "15ce5ebd7a86fb94775121f7ea9a509b",
+	"1f470-1f3fb-200d-2640-fe0f", "5dcadb864074245f46e7f89241838ca3",
+	"1f470-1f3fb-200d-2642-fe0f", "acd4b1d13abe3afc215d6a8d52835711",
+	"1f470-1f3fc", "444805b7ce220170da1870bd3c8938d8",
+	"1f470-1f3fc-200d-2640-fe0f", "baebecae6a8d6242bf4ef3eb5089a600",
+	"1f470-1f3fc-200d-2642-fe0f", "6780beb7cfe20de23129773da786d186",
+	"1f470-1f3fd", "22d9c2a4199fa1e1305b403d6f7a1b8c",
+	"1f470-1f3fd-200d-2640-fe0f", "361a6cab72e8e840ccbfdecf779ae8c7",
+	"1f470-1f3fd-200d-2642-fe0f", "0e8e873defbdbcbf43fe52e0359c3f68",
+	"1f470-1f3fe", "b80f5adb40a8f874be4fa5fef5cbd6e7",
+	"1f470-1f3fe-200d-2640-fe0f", "687800af2986793efd88f5f124b3592d",
+	"1f470-1f3fe-200d-2642-fe0f", "5f45ee211c1cc1cc646364bdea252699",
+	"1f470-1f3ff", "21bc8accea0c4f7737b4e621778d4482",
+	"1f470-1f3ff-200d-2640-fe0f", "b8b2df1bc4d274b5c1dfb4cee08d65c7",
+	"1f470-1f3ff-200d-2642-fe0f", "aeb61deb81d24fa34437287f89c64942",
+	"1f470-200d-2640-fe0f", "61518f7f99778e7b1d2912f177218e2a",
+	"1f470-200d-2642-fe0f", "1eec9b269d1f4bbec357215a982d273d",
+	"1f471", "97522f4ddbac0a424e63064f7b7baea9",
+	"1f471-1f3fb", "2b4137bf709fea8cec83f7fb08a229e8",
+	"1f471-1f3fb-200d-2640-fe0f", "143017f50097887c1b7c8e414c543702",
+	"1f471-1f3fb-200d-2642-fe0f", "d2c882ea277d3491314a1093b60c87ab",
+	"1f471-1f3fc", "128f73e6db6d11d1577afa389b6a94dd",
+	"1f471-1f3fc-200d-2640-fe0f", "4e5c39b2653bdcbb7021443b051094b4",
+	"1f471-1f3fc-200d-2642-fe0f", "b015f8a948b8a70785f91ebfeb9da8b9",
+	"1f471-1f3fd", "b07a9be3582d9c433f1cba43b334daf4",
+	"1f471-1f3fd-200d-2640-fe0f", "1a79354704635a634dbd5b962b903c59",
+	"1f471-1f3fd-200d-2642-fe0f", "67256f26af63c15883fbb392f8390b8d",
+	"1f471-1f3fe", "cce4a2cf7e9699d0d467a85c0304431c",
+	"1f471-1f3fe-200d-2640-fe0f", "16c3030a75cdc92940e5b56976b667d0",
+	"1f471-1f3fe-200d-2642-fe0f", "7d5d7d38af60577fd7fe4dcaeba3bbd9",
+	"1f471-1f3ff", "39ec79f4c6a6eefc1535f001700c4cc9",
+	"1f471-1f3ff-200d-2640-fe0f", "a60fef3c60fd7285b1c9077eadea4ffa",
+	"1f471-1f3ff-200d-2642-fe0f", "83daf63e7edc5e685ca3311fb9edb7af",
+	"1f471-200d-2640-fe0f", "06054e507640fe08208a805a880bd57b",
+	"1f471-200d-2642-fe0f", "5e9806db4409c0d2c5b0995288cf60b1",
+	"1f472", "cb992a6634d275a8499979728964b6ce",
+	"1f472-1f3fb", "417cadc37b5d9694e036529ad8f6a002",
+	"1f472-1f3fc", "79ee69dcd8d4e523cb9188c7da91019c",
+	"1f472-1f3fd", "3746591402b881bb8ba4b47e45880a12",
+	"1f472-1f3fe", "2c25b19b4feaf5cc5cfd92a7b02fc211",
+	"1f472-1f3ff", "59fb4cc978d2e9304a7a4c731ff5cfcc",
+	"1f473", "04f98a870f131aa41bda167e0a565b85",
+	"1f473-1f3fb", "cac990aef8ef6d42608dbbeba2450269",
+	"1f473-1f3fb-200d-2640-fe0f", "6ede211f0b68f93636f9a9bd6e60dda4",
+	"1f473-1f3fb-200d-2642-fe0f", "af5c01282edbb6f3ddd92e4388606872",
+	"1f473-1f3fc", "c560fb10078ca40fc5ae8c2299eeb61c",
+	"1f473-1f3fc-200d-2640-fe0f", "2513d07a3bd72f65a17c199053c4ee06",
+	"1f473-1f3fc-200d-2642-fe0f", "39df3b31634c5c36c1807312d0fc3266",
+	"1f473-1f3fd", "2c1ad9d9e777b5e25e1cff57eee1807e",
+	"1f473-1f3fd-200d-2640-fe0f", "d2feee290476a59d570f10701612ce04",
+	"1f473-1f3fd-200d-2642-fe0f", "a2ae38665a94625f20f5a78e9434c475",
+	"1f473-1f3fe", "54042ce7a79c4dd2e95e477951d11585",
+	"1f473-1f3fe-200d-2640-fe0f", "847c3b975d0addd21c0ecbbd24a28401",
+	"1f473-1f3fe-200d-2642-fe0f", "7ebdef857bd504e5e48d7f3af6c1d5b5",
+	"1f473-1f3ff", "1180ff15939e25b59ab504a30f79ce87",
+	"1f473-1f3ff-200d-2640-fe0f", "4b07c6204c49035ee0f9df20a77dacee",
+	"1f473-1f3ff-200d-2642-fe0f", "8787013c0b1d00a29f6660fe3eaa1e51",
+	"1f473-200d-2640-fe0f", "2db1e039a934e3791067807fb07ccd3c",
+	"1f473-200d-2642-fe0f", "c8501363fcc73cc42e0941af0109ee41",
+	"1f474", "e9e625fd08df5c4b229deae8485b85de",
+	"1f474-1f3fb", "c5b54825a8043da98554a187e0d5a31c",
+	"1f474-1f3fc", "6074259f0c76688b3899d1a4c5ca7e4e",
+	"1f474-1f3fd", "63956ccca3dfb96980ff8270d09f9075",
+	"1f474-1f3fe", "38a6b6726f3e320ea86ada3a4cc85b15",
+	"1f474-1f3ff", "edd95b4964a6d45246ee83897dd26f9b",
+	"1f475", "15ebfdae28b2b852cce176b5cd7b8c72",
+	"1f475-1f3fb", "aa7bf6e0fcfe11448dbc18ac74cb77a5",
+	"1f475-1f3fc", "1e12e16f853ba36453a70434d09a4244",
+	"1f475-1f3fd", "78cdafcb7c7b771045575eae6b9877c4",
+	"1f475-1f3fe", "d7e881e9c28cd1bb65f722c0fcfb2b2f",
+	"1f475-1f3ff", "30b585e56c563bf1b0fcca4d516546c3",
+	"1f476", "7d4ab5c9735709184c38ef242f689647",
+	"1f476-1f3fb", "180367177854e0c407b5eb99c0234b30",
+	"1f476-1f3fc", "29653af412330e5b0fcecfd5df4f4649",
+	"1f476-1f3fd", "e9ef777676878cd5e1182369dfe3936d",
+	"1f476-1f3fe", "449fd96e28f8d1f3d3a3b06c4b4595c9",
+	"1f476-1f3ff", "03c2dc40bc908159316b2b61096c1291",
+	"1f477", "87fc4587f2899ed9f38af84d8572deb3",
+	"1f477-1f3fb", "9455c6259d47d65fe8800cc4980ce6d9",
+	"1f477-1f3fb-200d-2640-fe0f", "435ec311a03dae3d97fdf1b8b8c280c2",
+	"1f477-1f3fb-200d-2642-fe0f", "b7a8d53abfbe350bd308884fe7ea368f",
+	"1f477-1f3fc", "cbdd8ae31bdd383f9ac37a3b3f8bcd2a",
+	"1f477-1f3fc-200d-2640-fe0f", "e1d34365eabd95131142141e6792056f",
+	"1f477-1f3fc-200d-2642-fe0f", "dafa563c40b44de2ef1a7d797e4cc037",
+	"1f477-1f3fd", "f4f8d09c66fbd71ae00822e0daf502ab",
+	"1f477-1f3fd-200d-2640-fe0f", "07d5dbab3b2118aace504894791a8b57",
+	"1f477-1f3fd-200d-2642-fe0f", "63df7f88d8adfc1afb3432dcf5e0f363",
+	"1f477-1f3fe", "d99b70a25576c75ef1d735e3a11bbc7f",
+	"1f477-1f3fe-200d-2640-fe0f", "950b4d766dd85965b80ae64e112810a4",
+	"1f477-1f3fe-200d-2642-fe0f", "deead738e00289bde10197f7f5223ced",
+	"1f477-1f3ff", "6f53b12829f09cd4fad177ec4c6e85ee",
+	"1f477-1f3ff-200d-2640-fe0f", "b8d67a157c8d797d570540594027e136",
+	"1f477-1f3ff-200d-2642-fe0f", "3626b41e97391eeb2e9473267dc11b02",
+	"1f477-200d-2640-fe0f", "36b31160501e167746f7edc3cd33b495",
+	"1f477-200d-2642-fe0f", "8cf911be88357d07e30fb9330daaa391",
+	"1f478", "53fd80576c28adc6cc43ead4637d60b7",
+	"1f478-1f3fb", "c472791462f428675161086895cffec8",
+	"1f478-1f3fc", "5d8482cb5d30d412e26ba2d9709240b6",
+	"1f478-1f3fd", "6871d8626c462c35a7d4f430a7eedfbd",
+	"1f478-1f3fe", "4cba08d51c9b544526914a81674d4670",
+	"1f478-1f3ff", "b0974f0910325b60ba73fab29901bf3e",
+	"1f479", "f7b28657f23a942ed82d45ed23094d63",
+	"1f47a", "97af278eacce256fb63c30689f80ee86",
+	"1f47b", "d92e0fd8dd2558af60d2a77eaae3100f",
+	"1f47c", "ca86c2cbb56cae3c88c269aa0d9b5bd0",
+	"1f47c-1f3fb", "d9cec66b0fb892f43d64f620d70c5d21",
+	"1f47c-1f3fc", "2abaf346540d4f73a7786c9702952630",
+	"1f47c-1f3fd", "1e38d4131bdeac25303a3602c25a9f2a",
+	"1f47c-1f3fe", "b3a2a764b8ed47c78a7f3639351045da",
+	"1f47c-1f3ff", "e4e87c9fa804c97e5b82ddc375d0747f",
+	"1f47d", "9cf1e02c84cdd759ebbd16d28e431d42",
+	"1f47e", "f061fbca366aace47d711c09ee19d26b",
+	"1f47f", "7fd35efd211326f76a364c4c10d77ef1",
+	"1f480", "f64f47a895e537305b3463f9d30bc177",
+	"1f481", "e3151f4a7f87ba845f0b961f67cec5ca",
+	"1f481-1f3fb", "88bf77da230ce21c5026635dbbd9611c",
+	"1f481-1f3fb-200d-2640-fe0f", "073457993178e889608efa5713b80a78",
+	"1f481-1f3fb-200d-2642-fe0f", "1d05ac7f16a9e2e8f35e21fe33f12abe",
+	"1f481-1f3fc", "1c6d99fe1cf444c63e08c2956cfc78cd",
+	"1f481-1f3fc-200d-2640-fe0f", "d5639c90fdbf9e87894f536da7d986cf",
+	"1f481-1f3fc-200d-2642-fe0f", "f35938865ef20adb89f8351eaee128f6",
+	"1f481-1f3fd", "8c5799448fe6fcf7dc00bb7703b08dcf",
+	"1f481-1f3fd-200d-2640-fe0f", "5fa2d2753fe260ce07608f99e41d44e1",
+	"1f481-1f3fd-200d-2642-fe0f", "c712f8d59534fb0e98de8feb8669d32d",
+	"1f481-1f3fe", "742e2b48f04a103d7183f92fd1b71b59",
+	"1f481-1f3fe-200d-2640-fe0f", "21cfbfcb04a73a9514b12b22f6c5f6c5",
+	"1f481-1f3fe-200d-2642-fe0f", "582f312c837aaee37694a4a7c48551a9",
+	"1f481-1f3ff", "0a68a9d6deff6861cb61bef664556d2e",
+	"1f481-1f3ff-200d-2640-fe0f", "29a9dbe2ab17d65dad0fb9a37e3445a6",
+	"1f481-1f3ff-200d-2642-fe0f", "4105188359a054b7b176a48aa6d50b40",
+	"1f481-200d-2640-fe0f", "2cbe54370276de226f5088f07223e422",
+	"1f481-200d-2642-fe0f", "f517c96b24aa9603cb500054c3e9fa97",
+	"1f482", "09e7fe5dff0fb3c974363e259d09dca3",
+	"1f482-1f3fb", "0a0df34b233b78dcf99ff4d89c614437",
+	"1f482-1f3fb-200d-2640-fe0f", "d73b1912006f47782cc73fe36d5ec3d9",
+	"1f482-1f3fb-200d-2642-fe0f", "36c3849db2471224f01544456982e323",
+	"1f482-1f3fc", "242aac582d753bf70a107a986bb6d21a",
+	"1f482-1f3fc-200d-2640-fe0f", "c6cddeeaa2bf33c69a89d184e3282100",
+	"1f482-1f3fc-200d-2642-fe0f", "8ef0fda6e7e05fd7c16f3688c46c85d8",
+	"1f482-1f3fd", "1a701c365c0911d0f706a8b06714b744",
+	"1f482-1f3fd-200d-2640-fe0f", "f473a55d0ce25ba81edbf748c046c35a",
+	"1f482-1f3fd-200d-2642-fe0f", "bc11668deab89928e4c40016df057006",
+	"1f482-1f3fe", "d8fe5e48a818732ec37f8bb91dbac801",
+	"1f482-1f3fe-200d-2640-fe0f", "7015599f90a5f526d0ba11b8e21ac726",
+	"1f482-1f3fe-200d-2642-fe0f", "ef7670e022583926d7133e2a42cc2f09",
+	"1f482-1f3ff", "873dc5c60327b566d716005a4937e808",
+	"1f482-1f3ff-200d-2640-fe0f", "c295768cb769c87a33214cd5248eff8d",
+	"1f482-1f3ff-200d-2642-fe0f", "1de39527aa0c6c29a84a2ffc7a525562",
+	"1f482-200d-2640-fe0f", "d05572fcb9e9ada684f4ef5446e0092f",
+	"1f482-200d-2642-fe0f", "f7b0146ff19142d68fbed5fef22dcd7e",
+	"1f483", "762f76a5eef7c38bde88c1888726e75d",
+	"1f483-1f3fb", "acac0429f723f2ac52d03b7b00de7a50",
+	"1f483-1f3fc", "a92068066172a9f2043fa934329884e6",
+	"1f483-1f3fd", "646d497a80f142c0f37bb20ac73fea41",
+	"1f483-1f3fe", "64f5c5a996ff4f1dc675809f9bf48c56",
+	"1f483-1f3ff", "c739545e834bbc313e57a48e062cb432",
+	"1f484", "0c518947e88b8259ca154299eede123d",
+	"1f485", "70f1d2b535b42b22b01302efe2b4bebc",
+	"1f485-1f3fb", "bce56fa7d23510b3952271bcdb72da37",
+	"1f485-1f3fc", "e32265c67d20b47d115dfa53a0f32271",
+	"1f485-1f3fd", "4f5549515880634d646214f4a9c266ec",
+	"1f485-1f3fe", "476cdc0d299c7acad78c59a6ff477a49",
+	"1f485-1f3ff", "b874ab14ffd1cf741291076e1fee788f",
+	"1f486", "a51fc65b9f1bce4d1ed2a0cdcab6f48d",
+	"1f486-1f3fb", "b05e9437c06e3e5a4634dad8af9004e6",
+	"1f486-1f3fb-200d-2640-fe0f", "b8fd7672d420984b0526022e6d763194",
+	"1f486-1f3fb-200d-2642-fe0f", "770dc69b36de2e979ecf136e172eaf72",
+	"1f486-1f3fc", "2ea0babe788aba543cab1d47ca72e5c4",
+	"1f486-1f3fc-200d-2640-fe0f", "9db5cc8c5c430ac14d86e21b3688629f",
+	"1f486-1f3fc-200d-2642-fe0f", "90f88e3af89c4339f1c19cfef0880df8",
+	"1f486-1f3fd", "fbd0cdbd475a5e3568e448339b7f22bc",
+	"1f486-1f3fd-200d-2640-fe0f", "47ccc4c4c99cf7a808fa12e8a66aa9e5",
+	"1f486-1f3fd-200d-2642-fe0f", "df8cc331901cdea17f04a9a16db33b2b",
+	"1f486-1f3fe", "04baef0dc14b5187f9b0c33fa8707502",
+	"1f486-1f3fe-200d-2640-fe0f", "1ace7c42ae608a89088bcd9319d9d277",
+	"1f486-1f3fe-200d-2642-fe0f", "d2f3262c23663c44a70c2d57669d4eb3",
+	"1f486-1f3ff", "9d3c637a4159e08698b630721d54f827",
+	"1f486-1f3ff-200d-2640-fe0f", "96ecbefe46bb434c13f5a89802b5ec94",
+	"1f486-1f3ff-200d-2642-fe0f", "7c21c02ee987439b528736adf84d23f4",
+	"1f486-200d-2640-fe0f", "52a394382e50afb3a700906ae66a537c",
+	"1f486-200d-2642-fe0f", "35f3f921817c0d8ad7665df77f4d2d4a",
+	"1f487", "49c4729bd700e4ca9c119451ecb54b6c",
+	"1f487-1f3fb", "1e2030c985b158e74ae1b216125cac8a",
+	"1f487-1f3fb-200d-2640-fe0f", "6cb53a6a9cd7b0cc393948cdccdfd6a2",
+	"1f487-1f3fb-200d-2642-fe0f", "abf761f17d6cf8cde528b4c0428a6ea4",
+	"1f487-1f3fc", "20ee521d55f89361c1b8e399c2029895",
+	"1f487-1f3fc-200d-2640-fe0f", "d1989701a8b5f0f119dcf067b20db641",
+	"1f487-1f3fc-200d-2642-fe0f", "8f9367c5ebdcac057e4b98b424a7d331",
+	"1f487-1f3fd", "3820fb52eaf77c8ed7abb8a101d661f8",
+	"1f487-1f3fd-200d-2640-fe0f", "312d79de138177e77805b7254f58ce48",
+	"1f487-1f3fd-200d-2642-fe0f", "8a2673837207eda5fd74c7c665d9b687",
+	"1f487-1f3fe", "63fd1d4a9fec43db09dfd92ebb8881e4",
+	"1f487-1f3fe-200d-2640-fe0f", "67e0153a3bd5238018e065d4a14f6ff7",
+	"1f487-1f3fe-200d-2642-fe0f", "30cb958e509bb0965a3e591fcd40bc49",
+	"1f487-1f3ff", "b34ad089ab768af46f156b3a078198a4",
+	"1f487-1f3ff-200d-2640-fe0f", "4aceb8c2735981cee8b75373c0f3391d",
+	"1f487-1f3ff-200d-2642-fe0f", "5eed46562404041e4a2ab9f59a679d40",
+	"1f487-200d-2640-fe0f", "3033000da40ef9fee5bfefe8919022b5",
+	"1f487-200d-2642-fe0f", "adcb02d39d476ca5f502767c7311e53c",
+	"1f488", "de9e8041ce007578d6cb70a50b643a39",
+	"1f489", "fa063beda8b31cc0fbf3475da4eadbd1",
+	"1f48a", "d84e76e52edde463aa106a30db7d4908",
+	"1f48b", "1f47248f358622a7398c81207142239b",
+	"1f48c", "cf61cc6cbb5934d4d0e9c426f5b17d8a",
+	"1f48d", "8c1770ed85c17cfc28bdcba042e4388d",
+	"1f48e", "5a8d9af8b5b3922097b2cccfce844630",
+	"1f48f", "4c06e8737b6e48224a0e5976c4537fae",
+	"1f48f-1f3fb", "f5f7e5c44c71309fb8c8b6158ebb2446",
+	"1f48f-1f3fc", "37a9c1cea4adac64fc9c07b78996530f",
+	"1f48f-1f3fd", "44e4fa2c7cf07fedc715e0ebe6c9f4fa",
+	"1f48f-1f3fe", "2ad0fa81e6eb0db77fdb06f832732865",
+	"1f48f-1f3ff", "a8de0d11b5a6ee9d8a28a6ab38884160",
+	"1f490", "3113df582817db67e43e88415a345c6e",
+	"1f491", "8b29c19c33ef185cc5fe9fba05389257",
+	"1f491-1f3fb", "2e1cfd9ae7083518726e773f561bc8bf",
+	"1f491-1f3fc", "3358a883165c286cb0928edb9d4a9cb1",
+	"1f491-1f3fd", "d2173fead798eb0046381b40c9259eaa",
+	"1f491-1f3fe", "b2e69b00f5769bd9312e462f164392fe",
+	"1f491-1f3ff", "0f6a353494551426a03fc68522230686",
+	"1f492", "cce04e58eccd72f3844059633baa5057",
+	"1f493", "058dee5288ffcb904a26594ea31fcfb3",
+	"1f494", "fda7477c4eea759cf5407472387453bf",
+	"1f495", "70b35c3dc2e909287823bd8626150089",
+	"1f496", "8544ea5ce32fd9e17df806eb1cfeab47",
+	"1f497", "4bfb4661aa2746510f9e9ddcc990ccae",
+	"1f498", "4c1490be77de62e7cf3e5bb5e68dfff8",
+	"1f499", "e37c985edda06b7d5f4559bc838c1bde",
+	"1f49a", "35221463e22cf68c28b23b6479a43613",
+	"1f49b", "46dc70e2608d986da6de64c6ba5a59da",
+	"1f49c", "ec10708f00b5b6767490ffe50dfe2663",
+	"1f49d", "99ee25afc79bd853311f019eddc53ab4",
+	"1f49e", "430e873bc74e81778ccf63f5d440f0ca",
+	"1f49f", "70e2e710d9ea845df9bba730bcdb0e02",
+	"1f4a0", "56c6c1f230e42a3780cfd5373b49c418",
+	"1f4a1", "d95a24865c58c14548e439defc097222",
+	"1f4a2", "baf44b1c1e3c284e9b263d08b426bdc5",
+	"1f4a3", "31ef50db484eb3d4b2fbebb4e91a0764",
+	"1f4a4", "f341538d6092b98ba32c58ad45537267",
+	"1f4a5", "d198f92b9911c7fdbb12baa694a819a2",
+	"1f4a6", "9d7ddcf78b0fdff6ab938077da38401f",
+	"1f4a7", "0631de3cded7d03f57c5ea29aae77168",
+	"1f4a8", "3140c8f687ba06dc8628b3c90686f77e",
+	"1f4a9", "8359db312f7319a4b0b23415e5fd1392",
+	"1f4aa", "1837c5719a977362642ffaf4990b6bac",
+	"1f4aa-1f3fb", "265f7b903ab5ce027b91304a9b41a4bb",
+	"1f4aa-1f3fc", "91c005d9108b18392832578533e6f884",
+	"1f4aa-1f3fd", "64aa9648a4ff86e608127ad7b2cf9ac5",
+	"1f4aa-1f3fe", "b8e50e05f78dd95d203c764955b9727c",
+	"1f4aa-1f3ff", "155543fac269ac1e1a3bb1f96a57b5d7",
+	"1f4ab", "1b3817ca3b1dc991baefdb3079ed0624",
+	"1f4ac", "df8b5c1e4abb97e748071aeb28f1ba38",
+	"1f4ad", "0933c4860868252befc05509165a1db4",
+	"1f4ae", "83a26e87d6e49f8f0e692fd9b090bef2",
+	"1f4af", "db009c8fa13d0f303df266e9d42c8e30",
+	"1f4b0", "6c42ea9f3e233d9110e04dcd87db55da",
+	"1f4b1", "da56d5c7e4409c0c1640ff6ba21ec1ad",
+	"1f4b2", "1679bb713b6a99e6ddf111d45400be3a",
+	"1f4b3", "ae85f3ba482693c99192d68b402b691c",
+	"1f4b4", "24f893169104e65cf4e3dfb1d817d078",
+	"1f4b5", "7bfe8fa5c4b8e024b491a7902eeca1fe",
+	"1f4b6", "194b9697b35e4ff8541e40d73d8b6c36",
+	"1f4b7", "e6025571aaedfcbedc52c5e38e5a1017",
+	"1f4b8", "aff6d39f4947f22f042e67ac21a9dc7a",
+	"1f4b9", "302be58fece29baf9e7e18b32f626b89",
+	"1f4ba", "8f081debefb68b05c983e7171ad4549b",
+	"1f4bb", "509dd485f6269e2521955120f3e8f0ef",
+	"1f4bc", "7d4bf2e92b2587e47b09997dbc654823",
+	"1f4bd", "54f34f46e4d0a360c76dd65eb9af4eec",
+	"1f4be", "435f9f150f67f68ca9e254d80a38f33c",
+	"1f4bf", "280d22966f7da9bd8d4b422b84d44c37",
+	"1f4c0", "157c1bae93cc97462a1aab7d3e3af20f",
+	"1f4c1", "ecf869302151b7838aff2f2125920206",
+	"1f4c2", "63155fb563c7f3ac84ec1e239d7688a3",
+	"1f4c3", "731d48be4af017de9a5299e90ff28b12",
+	"1f4c4", "eedd4bd948a0da6d75bf5304bff4e17f",
+	"1f4c5", "c5f722a37a7e3800b78a61c3ebd2dd74",
+	"1f4c6", "8528553f74b666f80b1784f07a205cdf",
+	"1f4c7", "5e8675734d4bf5232a60a4f6751dcd03",
+	"1f4c8", "3bfe17056153e8a390c99fe243e0a649",
+	"1f4c9", "dd7e45533e2f2008d8ba36ebed18c712",
+	"1f4ca", "7b4003ce2786fcf382c6b1ba5ac08f24",
+	"1f4cb", "1bfac5a4300cf5668073bd17d178e89e",
+	"1f4cc", "09204f6a96455580e749454b7449aa82",
+	"1f4cd", "304f17a447dd859533f5b41c0f2464f0",
+	"1f4ce", "be2be087dbf2f58ac10f91f16b7e6b0a",
+	"1f4cf", "7ffb6424744afba54797827604583e33",
+	"1f4d0", "bf6bdfc4ee2222efcd652a344cb22ef4",
+	"1f4d1", "f2d2183f4b21d53a9e0885fc78d9c766",
+	"1f4d2", "2399e762ce8c9fe5d6f24f5c09dcb379",
+	"1f4d3", "ba3e449f17cf9e91e2f52a0dcb65b4cc",
+	"1f4d4", "673d9936cd86abc0f48e36d86950cc81",
+	"1f4d5", "73793d476111f6ee0422b20b236b34c4",
+	"1f4d6", "794df59352a0aab153763ae7c1a4b67c",
+	"1f4d7", "2f62701a0bd9896f10ba600e9bb3ee6d",
+	"1f4d8", "331da3cdba2b7b84a5acd7a1f304a3bd",
+	"1f4d9", "7bb4df46a1dad3c2092e712cdda18369",
+	"1f4da", "9515c498b6c2e3d2e3e3b5ad73b6c22f",
+	"1f4db", "117476c82e097a60f93a9447b67d0dbf",
+	"1f4dc", "5f8aee4f266854e41de9778beaf7abca",
+	"1f4dd", "e1ec53c5d89c0291001989a36716aa9a",
+	"1f4de", "f34c63197816c3e60bea4a9537c5fffa",
+	"1f4df", "cbf0c56a2f3c861877b0de6f7fe8c24f",
+	"1f4e0", "eb5164cb069835f50006747e21fab87d",
+	"1f4e1", "7e9c28f98192ec5811981181f28ea7ac",
+	"1f4e2", "0634b5f01a88a0121bed072779e81bd6",
+	"1f4e3", "75c28d370b0dacca5589ec28c423f572",
+	"1f4e4", "13a4210298dfc7e57883c733259bda0a",
+	"1f4e5", "d2847ce64775bce206d39ae4114db659",
+	"1f4e6", "8e06d99a02d277377d03fa2975237f14",
+	"1f4e7", "701414a67cb18fe339ec2bbe21a1d448",
+	"1f4e8", "95ff2ac1c2edcb54e96d85f4976711d2",
+	"1f4e9", "63228fcdf74039fa92cb1188586fa030",
+	"1f4ea", "a26369e03c9084aeb19ab69e0396a396",
+	"1f4eb", "f6a60dce93537e22dae89fb5c7003f1b",
+	"1f4ec", "459285aabe618b3d3e215676c97b689c",
+	"1f4ed", "088935948a58168d5e34e8bf4afd7137",
+	"1f4ee", "4d41113640745c63755c85463f6b3001",
+	"1f4ef", "80b2f3b471f6d01821b1b3eda515e0a4",
+	"1f4f0", "51cd8436fb99a6f12257db34780fb7a7",
+	"1f4f1", "3547c9b25b1b9a1da8c06100fc4da900",
+	"1f4f2", "14b8317fd0cf1f8d1e8a89de6c841c76",
+	"1f4f3", "c039d0f4fb5cee040c7aa7c80da104d8",
+	"1f4f4", "97ec29084cf5be2bdf9be1d4ec61036c",
+	"1f4f5", "889b9885ccdacc969f18d3c15788f2a4",
+	"1f4f6", "0fb64655fbfea2d2fcd384c5dee3e2cc",
+	"1f4f7", "6c895a6898d73cc30959ac78c67c2ddf",
+	"1f4f8", "0c57febf44dbbb019c6fd75b05837495",
+	"1f4f9", "05a1abc6388a96e2a536650433c12fca",
+	"1f4fa", "3a36caf1fd1fce61581d2250b8459314",
+	"1f4fb", "a9e13a28157a6568d203df8fcf754230",
+	"1f4fc", "d5818f5fa376321c8bef87324c160ed8",
+	"1f4fd", "654212b1a4cdf391b4a8bb37faf7d18c",
+	"1f4ff", "9f6ac7129f1751c174534f1ac2f119ae",
+	"1f500", "5ca9943d29a6dc5cd0808b011327a81c",
+	"1f501", "f3fdb69431ef187e87cb909b0206cc93",
+	"1f502", "503a6a1d6710b6ea50a29507115549de",
+	"1f503", "50b152d1ee2fcb5c356592ab0a5fc3bb",
+	"1f504", "2ffcb91dee0015f46b03482b3c73fcad",
+	"1f505", "d22dc22448b350e43b9e3cefcf3196d1",
+	"1f506", "d3d76c82a46964a19a8c96e222bd414a",
+	"1f507", "a85469a7ea891a12e2513f4281d51f78",
+	"1f508", "3c2ef0062bb4f45d77a5853e75128d80",
+	"1f509", "7654aba0da598aad6cee02d8c69c0330",
+	"1f50a", "766c86e6244395ea36c530a7a4f27242",
+	"1f50b", "b3d90df3b07bcb3d0ac0b68a8b893272",
+	"1f50c", "16520d89e684777eedd536346015e71f",
+	"1f50d", "ddeb2a90dd32867b77e22b1eccf5fa55",
+	"1f50e", "0477c6a43026315dd623bc6367e18acb",
+	"1f50f", "3cac7cd551488d2fd245b113170329ad",
+	"1f510", "edc16486593aba6cd30ee597d12abd8c",
+	"1f511", "f8bae833e841f69bd5d5bd23568f9a09",
+	"1f512", "85e68275b969dee2025a71dcd34677ab",
+	"1f513", "6bf346b6ca375514599aab9fa65afb9f",
+	"1f514", "6201ff6add4821014e02cfc1bc82fc95",
+	"1f515", "e2b2f4d4a21891f6f01b43a3c4e341a1",
+	"1f516", "2424297076c0d1c8499820fc4f9d9f57",
+	"1f517", "7c13aa0def6ccb6932f47dedd33f59c1",
+	"1f518", "8ae21900410d5310d5697a36de1aa5ea",
+	"1f519", "6c928e43c553124e23e45c31c6ddbece",
+	"1f51a", "6a86f8cca40da89386aa7e9eca926da7",
+	"1f51b", "b8b439115436db0c0bbff081749a1ddb",
+	"1f51c", "b3f45308df051ef73d8cf63ffe0976c3",
+	"1f51d", "fa259978fede6539d40db4b59a2b0f66",
+	"1f51e", "ece853d6c1c1cd81f762db6c26fade40",
+	"1f51f", "5e1630b749695f21d61dcf55fc09c2b6",
+	"1f520", "2404733a7fc9302ab9471a4842dcf68e",
+	"1f521", "c1837b024900b2dd81e3200b2f35875f",
+	"1f522", "8db9972dd015f679c16544ac3e29e6b1",
+	"1f523", "1c15ce3170d607ac9960d225739a881d",
+	"1f524", "bf963581d0de4e574187f07ff71a0656",
+	"1f525", "67069a13e006345ce28ecc581f2ed162",
+	"1f526", "020aad8e903ef738aab703419e0139fa",
+	"1f527", "eed642a423f5147c48ad395310a3d797",
+	"1f528", "767059c01f5e1967080e803b9ac9ff42",
+	"1f529", "2c9735249ccb0e821822eb4a0fec20f2",
+	"1f52a", "9f89170e2913a534d3dc182297c44c87",
+	"1f52b", "fd0dd759c1ed1e0a044fa6882e95fd02",
+	"1f52c", "5e8bf9a87b34b1e80b8ccfeb55c61df1",
+	"1f52d", "ebbab3f48f8d122987d7617bed56d17c",
+	"1f52e", "1e183a34aa97fc91f7e6992bdd24f981",
+	"1f52f", "ba9ded4c8399e915303b1f97974a1573",
+	"1f530", "bd766f88fab518680708df9935b50e85",
+	"1f531", "7d7c9561cc5ab5259ff8023b8ef86c99",
+	"1f532", "41e47bdc538a48f4ecdde53dc73e4e9b",
+	"1f533", "f90f568565bb6721ca07c30f1c9f54b7",
+	"1f534", "a0a7f6cf67b863940eceaa40397e2030",
+	"1f535", "35fce59c5d17a56d69c3de3d8864ed22",
+	"1f536", "db6a04513188ab406f172cf98141dd58",
+	"1f537", "f46a1943d6b9b360f14ca7355da59b7a",
+	"1f538", "893165570015b53aad1a6999d13ea7d8",
+	"1f539", "85e5fb9f60873c46d98c8ab4efdf4066",
+	"1f53a", "79e14ae0b5c616e7ceb92e5c01a42cbc",
+	"1f53b", "b8630468b0aed7fa65976ef23ceaa8c3",
+	"1f53c", "3e208107951235969e572910cdaf60f6",
+	"1f53d", "b27c96355efbd49d331fe799ebb0f167",
+	"1f549", "251041f7277f771b3bbf869097a6f10a",
+	"1f54a", "49533def46858e98682242755ef6912b",
+	"1f54b", "a2bcfe4eee2242c2f9724d2cfdf6c45b",
+	"1f54c", "7b8001eccac970278fc175bc0f74489c",
+	"1f54d", "5336e6cc987e13a886e8d2e8d483633b",
+	"1f54e", "1c5a602f27f37e42e8955eaff293a971",
+	"1f550", "11beb379b710396803eae9672cda0662",
+	"1f551", "b1ee2010d5c89c554af9cac9684c31ce",
+	"1f552", "1b49a181ff7cdab94bd390b3d3224f63",
+	"1f553", "f483d0c3ce87afe27007a9b8fcad4547",
+	"1f554", "e54cb38aa9327d2e17410178236e0320",
+	"1f555", "faec4b08de7d17e4ea1ecf5bf4a6c0a3",
+	"1f556", "969f2c73dcc20bfd35f093a1a02a4e9d",
+	"1f557", "d6784afcd2745a3941b08bb98acc5322",
+	"1f558", "f2022d3850e36ba427caea9a51cd9ae0",
+	"1f559", "38bfa82363472a2ff8e43b6559b9a9a6",
+	"1f55a", "20c5f193bec54b7bf249eef78d17fa08",
+	"1f55b", "e98b98c644f3189af469660a51721954",
+	"1f55c", "505b59fb67bd9d6604bd4f15bd22d1e1",
+	"1f55d", "6a695716479912d8a088c52419c610b7",
+	"1f55e", "f3de70a77769446bb2d6353b04105308",
+	"1f55f", "2799e319aa2a2526f31629e37b3af464",
+	"1f560", "0b637337b40e8513c11b022e187cb3d8",
+	"1f561", "9c26776906ae5adcf76dad9b39692133",
+	"1f562", "5a7474418f87e73ee9bb6d02eafa2305",
+	"1f563", "150bfceb8f2536c49dbe0a6ea5167489",
+	"1f564", "0000f34484a7bf568d45b7591916b603",
+	"1f565", "5da159fab87f2c8e59c459d04d127dc7",
+	"1f566", "5be8c5ebff4285f213dd497a2969bed8",
+	"1f567", "628b5d25b12422cb143cdc0d7be18f7a",
+	"1f56f", "74e9975a0b831d350b1a92e2793cf483",
+	"1f570", "e75125914de0c65e43aab08786e23b06",
+	"1f573", "0542c8e0343df05c3d32b8694aec066a",
+	"1f574", "ab6a1028c6b247bcc472a4e9d0041228",
+	"1f574-1f3fb", "d1a272b765ce7b4fb2a94e0613cbc370",
+	"1f574-1f3fb-200d-2640-fe0f", "e78fe49009591173017056181d33fa72",
+	"1f574-1f3fb-200d-2642-fe0f", "3cff3df41a0104aff7a8bb0e19800b30",
+	"1f574-1f3fc", "86868c1b2423baa5790a34f485dcd042",
+	"1f574-1f3fc-200d-2640-fe0f", "f4149fa9e499c27cb681cd8151158510",
+	"1f574-1f3fc-200d-2642-fe0f", "b4a898bf092d57e67c2120b6b3ce0de0",
+	"1f574-1f3fd", "b2a02b8a4ccb81c6e3af70b1168ba5a2",
+	"1f574-1f3fd-200d-2640-fe0f", "5466febf998bb2f4ef7cf8b509304bf0",
+	"1f574-1f3fd-200d-2642-fe0f", "746637ba728b663cc853bd56c9111d9c",
+	"1f574-1f3fe", "7c7ddee26a1c09876bebcc2c25f5b746",
+	"1f574-1f3fe-200d-2640-fe0f", "00105e2e3311d55fad448a3f82e92e44",
+	"1f574-1f3fe-200d-2642-fe0f", "8011eef7d68aa40a7b1b151b0c962913",
+	"1f574-1f3ff", "16e64c8e7dcb219b42845cfea1175cb1",
+	"1f574-1f3ff-200d-2640-fe0f", "b488f18fd70a2360c8092fd6dd810519",
+	"1f574-1f3ff-200d-2642-fe0f", "9a4e23451ab85b216a6d73464ac56067",
+	"1f574-fe0f-200d-2640-fe0f", "bf13c55b5b950756a35205c264465164",
+	"1f574-fe0f-200d-2642-fe0f", "81e4c2e69ccafce51a5b1bbfacbfc5e6",
+	"1f575", "0d9e341a5ff1e9d55e691cc7d86f05bd",
+	"1f575-1f3fb", "b627cfc2f8b906495959bb772d2fa59c",
+	"1f575-1f3fb-200d-2640-fe0f", "17864ff030901a8af3211a41d28834e7",
+	"1f575-1f3fb-200d-2642-fe0f", "1d32c80bbeb06320fc9ad21b61be37b4",
+	"1f575-1f3fc", "2f57dbf12c69920d4c9cb912065be4d3",
+	"1f575-1f3fc-200d-2640-fe0f", "8c4a38c29b7a0342d8996ac7aad6f509",
+	"1f575-1f3fc-200d-2642-fe0f", "225d7a78ca6c8f5b3eb412e488ea18cf",
+	"1f575-1f3fd", "274035ca8eff944cb317533b3093f173",
+	"1f575-1f3fd-200d-2640-fe0f", "3e055288b38d72c85f51135cb1f594ed",
+	"1f575-1f3fd-200d-2642-fe0f", "a9c6ebdb934525e428efe6d36e9552a9",
+	"1f575-1f3fe", "e4fe6f3a25bdcfbfccaeb67f5bbc1884",
+	"1f575-1f3fe-200d-2640-fe0f", "71bf9b31162691d5bf97577a77f8e02b",
+	"1f575-1f3fe-200d-2642-fe0f", "0a6590e811c0fd46ef81a7a96845ea03",
+	"1f575-1f3ff", "be3674dfe83de7b94ae4ed2bab3da8a6",
+	"1f575-1f3ff-200d-2640-fe0f", "eef4a7950b00b5b8f916ac4c3a73106c",
+	"1f575-1f3ff-200d-2642-fe0f", "576491c1b9ad7a79b5dc9649dea66805",
+	"1f575-fe0f-200d-2640-fe0f", "e634993a52b48cdf32505de99dc71192",
+	"1f575-fe0f-200d-2642-fe0f", "ea887e7960cb2d5c876c910bdf55d068",
+	"1f576", "7d15a79b1f1d1a18e9fc874a084c6d6c",
+	"1f577", "d6e12f410c99374f88879450daaaced9",
+	"1f578", "4f7f755e1f3af2ecc5eea3540cd16527",
+	"1f579", "d5b6040243084dc53e2baa2acf9af348",
+	"1f57a", "b839169ecf4b7b147129f96e369e055a",
+	"1f57a-1f3fb", "c37ade23949ebed54ca304e548c55b72",
+	"1f57a-1f3fc", "9b31686eaf7d89d4ed2449a2fb0b3808",
+	"1f57a-1f3fd", "8772fb03a8ca53be1b06a7d7a0020dbf",
+	"1f57a-1f3fe", "69ec6070d431cbf7843575f3d3bff7bd",
+	"1f57a-1f3ff", "1609b10f7c77f3f19d48b12e227004ad",
+	"1f587", "99176ba5499c1a3fa03ab6030f9a3ccb",
+	"1f58a", "ef166777e2f79997d529055dc66f0ab7",
+	"1f58b", "5d831ad22096d754a2d4961374aa9f7a",
+	"1f58c", "1f90b12fb5df52074f2f863802f09382",
+	"1f58d", "8ad04dbb11c573c22adf06a81995dbcd",
+	"1f590", "03a7095ff4fb6450153094ecb26b236d",
+	"1f590-1f3fb", "a08de47989183721f1f2b5ef9a55f4dd",
+	"1f590-1f3fc", "25046a389b90a8a726140e60bd2230fe",
+	"1f590-1f3fd", "36de8dcb664c9f7a53e63c529109f597",
+	"1f590-1f3fe", "842b662764dbaea4bb6f2f2302b0a8c6",
+	"1f590-1f3ff", "53ea320d6c861f822762b4a2735dd9a8",
+	"1f595", "66ab7f72e745ac8b2ccd0cd920b1d4e8",
+	"1f595-1f3fb", "1ad5f8565cbd0a102c74bcf9f6b1cb27",
+	"1f595-1f3fc", "69ecac0ac98e32818713df874abc5ea6",
+	"1f595-1f3fd", "a5428e27d8a94903955aa47b9588a4f1",
+	"1f595-1f3fe", "413d1ebdefa088a085c4326ceb0052dc",
+	"1f595-1f3ff", "6f5bda185520a8233a7ee99e57c2c0d9",
+	"1f596", "c9fa5a58142d229cf34ed71b0c213384",
+	"1f596-1f3fb", "398e9e01dc5a7cca25fdb0a72270a0e1",
+	"1f596-1f3fc", "e89210e40f25ad35aeadbd2c204025e5",
+	"1f596-1f3fd", "a66bc91624cea86566605ac1e2e44f3b",
+	"1f596-1f3fe", "cd8155b9922404ca5fab57ea1cd2c4c0",
+	"1f596-1f3ff", "583c37730c928c6d3b061af53ad749f4",
+	"1f5a4", "d91cb297561a6bc50f8c6918d428bc61",
+	"1f5a5", "54b027c5a0fdb9c92545820e12544ab4",
+	"1f5a8", "2ca36a6407640df119f1cac98a4fde48",
+	"1f5b1", "e97b992acec4ceea9cf5412d65c38332",
+	"1f5b2", "88f07ff3608e596846433af12af1449c",
+	"1f5bc", "c4424d3d5068cba14a10467cfafd6580",
+	"1f5c2", "b9248f302c72a7bf6000495bc443229c",
+	"1f5c3", "b542a360ed4cdceeac3bca73b455ebc6",
+	"1f5c4", "2faf0368f5faf385b807cb7b8b36a7b8",
+	"1f5d1", "d0b3037b528406cab8072a6c30cb3267",
+	"1f5d2", "3391ce4715f3c814d6067911438e5bf7",
+	"1f5d3", "7be2358758e81e1ab253eb071a9527c7",
+	"1f5dc", "09c5f5a8214d180fd33997a0f6f332e2",
+	"1f5dd", "e14bea9653868307f4d1e70fa17e2535",
+	"1f5de", "a5054ee2699eac86708c28d32dcc087d",
+	"1f5e1", "47f10f1fb3beec3810f0f37cf4cccd95",
+	"1f5e3", "124c49c6b3c5a9664053fe7ca23a20b6",
+	"1f5e8", "972f4783c40bf1217f007fcfd944ac30",
+	"1f5ef", "3cde66c399ecfb908ead029f0f1f7e5e",
+	"1f5f3", "ff85a1aae50ad48506e3275656768e89",
+	"1f5fa", "88de0a41aeb09fc3b3d1258d1f94228d",
+	"1f5fb", "5edf36be746bc578274b74ca2b360db1",
+	"1f5fc", "c8a34a6a05436624f8c1579871333c72",
+	"1f5fd", "3f743d1073c0662bcc72eb6b55541f5e",
+	"1f5fe", "168846893df62b3f7279874c122ff488",
+	"1f5ff", "295860beaa5c80f427ca012b50e46634",
+	"1f600", "7c010dc6da25c012643ea22c1f002bb4",
+	"1f601", "ab226fe832acc948e9f974decd04a4f0",
+	"1f602", "6201503f3aa918470a2190b36d1e196f",
+	"1f603", "66f6c781fe86c346fbaf3390618668fc",
+	"1f604", "626aaed496ac12bbdb68a86b46871a1f",
+	"1f605", "b45af785b0e648fe2fb7e318a6b8010c",
+	"1f606", "cb91d362742dcc886e4a4c3f981f3365",
+	"1f607", "e461bb3847973d47822fdc79d66f494d",
+	"1f608", "54b9902837fed72b7551edb77b30b96e",
+	"1f609", "2e41bfdeba797283ee9da9bb439c3ece",
+	"1f60a", "6f75b58eb746d08c2c18451573876292",
+	"1f60b", "913e66b19410c0ca3e71fe837a29e093",
+	"1f60c", "817f965bd1fd796777908e6c8052d665",
+	"1f60d", "e55f552bd0b1cf368b466422408c8401",
+	"1f60e", "5f80f04e6ee97feebdd00feff92ced82",
+	"1f60f", "480eb6b0f6c22cbc1d98d6ff93e1edc1",
+	"1f610", "fa9b378aed10bba1aea54ca34e76fe4b",
+	"1f611", "4f57f2120dbe61deda4eb15ea2fd3559",
+	"1f612", "19581aca5d78feac3a180971f24d399b",
+	"1f613", "6d8c8a8219b45458260ab4843f383b76",
+	"1f614", "2ea1f6bba4448fa4e8d9504fe976095d",
+	"1f615", "fc5c8aa09047ef94d2c380891feff0f4",
+	"1f616", "e6fe72eb79ef6258554b1385259df5d1",
+	"1f617", "2651df01b16f9037f954d7bfe6a535f4",
+	"1f618", "81a553e7c02c5dd144e40888c4b2faad",
+	"1f619", "d5d060921efbed7794c0d8087ba4cf58",
+	"1f61a", "ac30cfcc3533da0c03cc80bbc3e63db6",
+	"1f61b", "10f25947b6687b5b103e131ca82826ab",
+	"1f61c", "c9030d84bb2453fbba42c7c1896dbde8",
+	"1f61d", "8220f7a8492a41bd571c315954aeb9b9",
+	"1f61e", "08b9e24092ee146b9e071e89049c1055",
+	"1f61f", "a114d57f38f4921991a16893f9525421",
+	"1f620", "4a1e59abafc2145af813eda8bf82a49d",
+	"1f621", "3f4e3f10b0a177426ca14b381445ca3b",
+	"1f622", "f6d30507f4baee759bc9d7e5c0d3ba4f",
+	"1f623", "00faf33e229084cb56d8a51c9130761a",
+	"1f624", "1344a726dc134bff094a84ff60ea934d",
+	"1f625", "7e1efa56e2112cb69d5d6c205d2c454a",
+	"1f626", "c57f781369d6761064207b216ab66d1e",
+	"1f627", "92a2ca0b8a4ec9474a0b1e1a953262a9",
+	"1f628", "f467d08bfa67807c2b3dc6e0d1495fe1",
+	"1f629", "7d9b0ce939d6486f22cf0111f0f815d6",
+	"1f62a", "387d45cfc608c20850306f85bc143f09",
+	"1f62b", "7f36e54469b941cf193a1a19baf2b806",
+	"1f62c", "c4a0dcc8ff99eb77285d772b6b577389",
+	"1f62d", "f7b3f6b926cb31a17d4928d076febab4",
+	"1f62e", "2312e90b9fc75749149a200817b952f2",
+	"1f62e-200d-1f4a8", "7669a083750f34a23def85b9afe351ab",
+	"1f62f", "f2d2c885c71d7c98b53fc15216e34e87",
+	"1f630", "b6e59899d18889c76321b4cb546b30b9",
+	"1f631", "81195a9847a459acbb2b680a70357728",
+	"1f632", "b4a967b8b0d6fea5994d4efdbe07f354",
+	"1f633", "fd077d826b040d6c8b895de3b7585c25",
+	"1f634", "711ac22a92d00f844023ded91f820e8c",
+	"1f635", "08cf46d4be10cd96a7f69a8b372d1425",
+	"1f635-200d-1f4ab", "8a44746659bab9f133830029b166edff",
+	"1f636", "63164bb66f2c9c65db1b1f7cf554a968",
+	"1f636-200d-1f32b-fe0f", "cffbe7d3b392eec446456db6adea7704",
+	"1f637", "2993ada413223a149555f5f68198043f",
+	"1f638", "e96ed3e46f59af0b9b7b49e3cb4e59f7",
+	"1f639", "4557741be111e94ca7083abf39422a30",
+	"1f63a", "ef2af7fab48463e72a3a7f0f8fb4fb4e",
+	"1f63b", "d4d91a5f31668dd4609a3d7522f722c5",
+	"1f63c", "e25128510c26b0aad9d71bc6cf49df67",
+	"1f63d", "ddd2c1bf4d9e5afa87394a52f1832fe4",
+	"1f63e", "551f9d76028c39299e0bc9bc20cd0e0d",
+	"1f63f", "509c4f6bb9b71c5d9551357942e11f12",
+	"1f640", "3068417ae7f1a7c5c2ba60ab1aa1fb62",
+	"1f641", "9dc0c15c9e35f7ba86150ae12e895d29",
+	"1f642", "da3651e59d6006dfa5fa07ec3102d1f3",
+	"1f643", "559c3311dcdb3f23b7fb745559207db9",
+	"1f644", "8210e7f5bf5e03f5dee15f6ce4232feb",
+	"1f645", "836f55532a453bb6c39638ce5c21ba38",
+	"1f645-1f3fb", "990d05052d07ceb7794619c1abf32da0",
+	"1f645-1f3fb-200d-2640-fe0f", "e4bafeefcfd535fb36d72b4102ca89af",
+	"1f645-1f3fb-200d-2642-fe0f", "aa87472d8cff75ffa04a48454d46d7c8",
+	"1f645-1f3fc", "c845d2d835955e12d212d26c0593a49c",
+	"1f645-1f3fc-200d-2640-fe0f", "9618b50a9153e13446d5239cfcb5501f",
+	"1f645-1f3fc-200d-2642-fe0f", "7e2e8796afd5c215461046b0cf9b868b",
+	"1f645-1f3fd", "a59f14e5601d196f409a79cfde146ab3",
+	"1f645-1f3fd-200d-2640-fe0f", "522a8d8ef52265787b785482fbf88735",
+	"1f645-1f3fd-200d-2642-fe0f", "a46c7ac83683d024183cc74925c07428",
+	"1f645-1f3fe", "920a27f462f5094e93b2764ee9c86e16",
+	"1f645-1f3fe-200d-2640-fe0f", "538bac4f357c812fa136ed3198fd1e5e",
+	"1f645-1f3fe-200d-2642-fe0f", "65379e75f4c1fff032f58936364a2d1c",
+	"1f645-1f3ff", "2899cc3275777a6d9a2986dd1440c880",
+	"1f645-1f3ff-200d-2640-fe0f", "8c4cedc551e2f84cdffa591b3d573f97",
+	"1f645-1f3ff-200d-2642-fe0f", "96edea1b25cd421b90b1c8df2db6c2b5",
+	"1f645-200d-2640-fe0f", "0963f6c910f6b6b90847631be9cbdcb9",
+	"1f645-200d-2642-fe0f", "275159f063e49c0443b28cfce651a666",
+	"1f646", "2a621dab73905adc65c79bd717101420",
+	"1f646-1f3fb", "5bd68fb9086ce1f7402f36d5d10c39fb",
+	"1f646-1f3fb-200d-2640-fe0f", "c7af2697784341b59be0ec006555f2fb",
+	"1f646-1f3fb-200d-2642-fe0f", "4c1ea62bf8fde61bc7dea77e1b14fe80",
+	"1f646-1f3fc", "bc176e6c3413dbe53bdabe275142643c",
+	"1f646-1f3fc-200d-2640-fe0f", "37af92e28f861bdd02103ebdc7d59e1a",
+	"1f646-1f3fc-200d-2642-fe0f", "3250d2cc557ac2dcd9c4f2987bd7a1db",
+	"1f646-1f3fd", "0133d2f2f9b15044012435afb8ba2b13",
+	"1f646-1f3fd-200d-2640-fe0f", "623b8a4f322030c5c0844d9e55b54a3b",
+	"1f646-1f3fd-200d-2642-fe0f", "550a3008fa852f848c9cf5d7071d027f",
+	"1f646-1f3fe", "65abdc01764266f7df480af26b24a545",
+	"1f646-1f3fe-200d-2640-fe0f", "f1403ee5b0610a493e453e9c8c66b145",
+	"1f646-1f3fe-200d-2642-fe0f", "08d25cfc2a331a9f4c717e40a87be188",
+	"1f646-1f3ff", "bd3d22b6f76087bcb9630dba7ab5451e",
+	"1f646-1f3ff-200d-2640-fe0f", "9c2951e9e5472ba14a2d6a76c661deb1",
+	"1f646-1f3ff-200d-2642-fe0f", "e3d86737aa88eecd1663a5682b80e5be",
+	"1f646-200d-2640-fe0f", "1b1af1650a397c8a1870338ddcaf5532",
+	"1f646-200d-2642-fe0f", "c173f908105bc50c398824cd79718c1a",
+	"1f647", "18386212a23d1d9eb2014f2a96c3a8c6",
+	"1f647-1f3fb", "4d1b3ca79ffa4e217cd17f65c1493693",
+	"1f647-1f3fb-200d-2640-fe0f", "b71b0f575c9195596d081002a6ea3dcb",
+	"1f647-1f3fb-200d-2642-fe0f", "929ff0beced5fae703eddd219e00bc71",
+	"1f647-1f3fc", "e6ca6bfa7d78e651f6ec49034168aff5",
+	"1f647-1f3fc-200d-2640-fe0f", "758741537217e54a2a3aa867f967254b",
+	"1f647-1f3fc-200d-2642-fe0f", "9b8bb015731f4df944fe8fe1d8cf1a57",
+	"1f647-1f3fd", "457e90908912e9aa3c8241a1b399b9f8",
+	"1f647-1f3fd-200d-2640-fe0f", "1d33c9a8fc7131c4d64796f046ec5a31",
+	"1f647-1f3fd-200d-2642-fe0f", "cf35bc218d53bace117eaf8d0a65d40a",
+	"1f647-1f3fe", "ce81c1d5260e044f06729efff609778b",
+	"1f647-1f3fe-200d-2640-fe0f", "e146bf30eff14755c88bdf6dc175cecd",
+	"1f647-1f3fe-200d-2642-fe0f", "4b0580098b30b58eee577bb13f14c6bf",
+	"1f647-1f3ff", "cc15ee7d19541b9ee217768b7921d000",
+	"1f647-1f3ff-200d-2640-fe0f", "07ce262a33b8abf5f638f1de539fbd9c",
+	"1f647-1f3ff-200d-2642-fe0f", "e9b9aa1d3bf7e4d383ab44dda43618f8",
+	"1f647-200d-2640-fe0f", "675d17a3ebb1029ee1405a8d60ab8031",
+	"1f647-200d-2642-fe0f", "4175bacb70ded99827b450575737ad1a",
+	"1f648", "a5aefa53dd12db3e911bf24b5ab4e478",
+	"1f649", "ff686eb311c897278950bd29a9113990",
+	"1f64a", "3096aae4bb3afd6cb72252000ee4e4f2",
+	"1f64b", "f6029c315568bbf96b2b6a6887989ffb",
+	"1f64b-1f3fb", "781225a875b56a87c6fcb1c7d02e9f08",
+	"1f64b-1f3fb-200d-2640-fe0f", "32e41552cda9f1af61ef37a583017d18",
+	"1f64b-1f3fb-200d-2642-fe0f", "8802422d1f3808b2004625833b6f1225",
+	"1f64b-1f3fc", "7f04d1ac64b62eaadc9c0a9b5c8cf14d",
+	"1f64b-1f3fc-200d-2640-fe0f", "bcab3e4095023cb7338642e9a895568e",
+	"1f64b-1f3fc-200d-2642-fe0f", "c6c31d836d81bd52d50284756fa87c01",
+	"1f64b-1f3fd", "cd187e1a441654b319d11b7ea6d5f133",
+	"1f64b-1f3fd-200d-2640-fe0f", "79c448a90825fc870334d2e6ed9f26d1",
+	"1f64b-1f3fd-200d-2642-fe0f", "c38d79c8b75507df69ea7682c5d829de",
+	"1f64b-1f3fe", "96f5786c086214c286f78d82d19be355",
+	"1f64b-1f3fe-200d-2640-fe0f", "27538637717bd93fd6d9fae4bdbf1d11",
+	"1f64b-1f3fe-200d-2642-fe0f", "16ec0f5f36a740e54c01933dc9626a52",
+	"1f64b-1f3ff", "3eb25b48e33eac40ae23aaaefa6b0ff9",
+	"1f64b-1f3ff-200d-2640-fe0f", "0fa69ee0fa238cfbcf010550e5ed0b9f",
+	"1f64b-1f3ff-200d-2642-fe0f", "4df8624eb02ecc90630e70cb22d5364e",
+	"1f64b-200d-2640-fe0f", "bd62dd372c32648f02e2fe8b529d94f0",
+	"1f64b-200d-2642-fe0f", "73eb28d646c1d52066a6fd8b9d9da955",
+	"1f64c", "ba431d6ffa85449aca277d36b401519e",
+	"1f64c-1f3fb", "fdf726a9235852e13210425da64e9315",
+	"1f64c-1f3fc", "5ec067af98b2b306970b1530b5d84f29",
+	"1f64c-1f3fd", "7409af062a65b243c9f59780d17596e0",
+	"1f64c-1f3fe", "34a01dd719f96040bf3b79d8f4545db0",
+	"1f64c-1f3ff", "62fb6e97b95a33cc8880823a0757e7df",
+	"1f64d", "5f85cdd26ef114949f19dafbc70b40ae",
+	"1f64d-1f3fb", "907e557883c61add1e37382c4bb432ff",
+	"1f64d-1f3fb-200d-2640-fe0f", "0989e7fdee4729b41c6848209cc7e1ba",
+	"1f64d-1f3fb-200d-2642-fe0f", "f96c2a36447a02edf8c730e7defbe96f",
+	"1f64d-1f3fc", "4f6f1ceea1cf9007087d1fe227b3d5db",
+	"1f64d-1f3fc-200d-2640-fe0f", "94fa86a314386a8a4fd32ca002c89c62",
+	"1f64d-1f3fc-200d-2642-fe0f", "5940802b4a7207362f1dfc66cfd8cf50",
+	"1f64d-1f3fd", "c5bc135f86ac4174f921584d8fd32381",
+	"1f64d-1f3fd-200d-2640-fe0f", "5aa57853bd756632c263b188bf5bd5b1",
+	"1f64d-1f3fd-200d-2642-fe0f", "107a3f1dccb93795ab6ff4c9ed5a2858",
+	"1f64d-1f3fe", "430da7ab6af2c365166e8908c660da7b",
+	"1f64d-1f3fe-200d-2640-fe0f", "dd19c7c967123e49d4cc73f3d99e3670",
+	"1f64d-1f3fe-200d-2642-fe0f", "394756eef766f51c91722da9430e1d8f",
+	"1f64d-1f3ff", "5f772312b7c3018a1ee8eec5cf8f1d6b",
+	"1f64d-1f3ff-200d-2640-fe0f", "62cd24f5ea190fc76358adb770f49588",
+	"1f64d-1f3ff-200d-2642-fe0f", "05fa874208781b1ae35297503bbd53f8",
+	"1f64d-200d-2640-fe0f", "ed4573f772198e869e8f98a110b0315a",
+	"1f64d-200d-2642-fe0f", "bd50f4e7d27719b697c8c09782e56059",
+	"1f64e", "d44a54c7600107ade937c9f262cf70aa",
+	"1f64e-1f3fb", "d055024360a093d60f9e9c9765ff7406",
+	"1f64e-1f3fb-200d-2640-fe0f", "8f32e6b636a8d98fb9f12a18dfe5cb1f",
+	"1f64e-1f3fb-200d-2642-fe0f", "2267c3f0fe7bb99da85539696c3fec6e",
+	"1f64e-1f3fc", "619b8047a38b082a5d5f808f07216fe1",
+	"1f64e-1f3fc-200d-2640-fe0f", "29747cff972f544b8bfb778f845377da",
+	"1f64e-1f3fc-200d-2642-fe0f", "c9ea268aa7b6429ad0bab9f948e5d813",
+	"1f64e-1f3fd", "ca0402732a19790446fa2c6d43f5efd9",
+	"1f64e-1f3fd-200d-2640-fe0f", "85e6263e12bb986b19291107d7ba11c7",
+	"1f64e-1f3fd-200d-2642-fe0f", "1b9243f446a480d34998956cfb3613e4",
+	"1f64e-1f3fe", "a498bd05e70b5854049360918223e7be",
+	"1f64e-1f3fe-200d-2640-fe0f", "f07cb7c57e9b1a2ac75d59c2f1a65798",
+	"1f64e-1f3fe-200d-2642-fe0f", "467cacfda14482ad4de55e92be42f368",
+	"1f64e-1f3ff", "0a82630356cb742a8f5a95b92ed0e67e",
+	"1f64e-1f3ff-200d-2640-fe0f", "bce655b1b6034dd8a7f37b35b626ee1b",
+	"1f64e-1f3ff-200d-2642-fe0f", "4b6666f4d919e0b68965ec0b18ed0a68",
+	"1f64e-200d-2640-fe0f", "f23fcdc37adb1113ec6a629fcf5b7d33",
+	"1f64e-200d-2642-fe0f", "8e9c54b22ffb8507caba8dea86edb291",
+	"1f64f", "1904291ab1aa5d14b2adaaff23a578dd",
+	"1f64f-1f3fb", "bcab4c6e6a98f0a5413eb9b111d9e4f6",
+	"1f64f-1f3fc", "7ebfe4e1b2c2e6172bade1960838fe2d",
+	"1f64f-1f3fd", "fcf78553740b6d379d28e1092fc293c9",
+	"1f64f-1f3fe", "f7569feeac6da4a8ac86e681151cc47d",
+	"1f64f-1f3ff", "3f0179c8a166b821969a153781d8d0f2",
+	"1f680", "748ff0e7b2f1f22adecad8463de25945",
+	"1f681", "01075418f9647440ed04acabf3e8f7aa",
+	"1f682", "f390f4d0cb6aaa013ddf18ce6615d821",
+	"1f683", "fea5832461c20821b109170d73898118",
+	"1f684", "9b24377f1cf5effcecb2853bc759d3fc",
+	"1f685", "4a9111e181ea614235add9dfb096205c",
+	"1f686", "654296028cc95c58b0f583ab5a61327b",
+	"1f687", "2fc6570871bbfd545aff4faa71f58ec1",
+	"1f688", "82c62425b59d06a0cb0cd18ef573466f",
+	"1f689", "61cec822f6697dee4e983546ebdd8b63",
+	"1f68a", "3f418c6b8a8a1a13162f69716d83c9c0",
+	"1f68b", "0b777b823ec85f31d5936553733b68fa",
+	"1f68c", "9db1206a2ccb12d67bea2d47cc3e0d07",
+	"1f68d", "5539a6c427da1960a7db94febf02a01b",
+	"1f68e", "e7c8c0c4054cfa920b3501521955c158",
+	"1f68f", "93f6904835699e9b29676d18cae9abc5",
+	"1f690", "664fdc23e521ee9c1c619a2b7c3c7b18",
+	"1f691", "734395ce30cc061a86c5b24a322ec4a2",
+	"1f692", "c2aef75dcc13733edda4b2bbd35509a6",
+	"1f693", "c8d3385524b2681cbb83d5f6dad308a2",
+	"1f694", "1d1374021eaf78943dab417ef3bca421",
+	"1f695", "b4cf68e705b8fe43fdcab0c37254ab5e",
+	"1f696", "214a3c5c9606d28683ca427a72ecbbb0",
+	"1f697", "53b7f069eafe8fd4fd29b1242eeb82e3",
+	"1f698", "6722611d81e4e8f1349a2476b48f5a40",
+	"1f699", "8f2709228308ba976978f5dfdf2543ff",
+	"1f69a", "56ddd9000c95d59159bd32c05a6463ba",
+	"1f69b", "f9652629575f1a1f55dc4e539e4027d3",
+	"1f69c", "c3c997035449530ae2bf3c4fccaf5cef",
+	"1f69d", "92f443d730355c96a209fa0d6d01d1ba",
+	"1f69e", "c023080478d4567e641e8c9dea4ff520",
+	"1f69f", "18862b80cffca5a1564e4c0609fa9a0a",
+	"1f6a0", "efac1bfad4c725d65b24d04a0df85d4c",
+	"1f6a1", "058fc206f7623f0071ea386e16e82e63",
+	"1f6a2", "4f0733f2f2b923058a9c28b22daef33f",
+	"1f6a3", "d8fa05dba9403dc09d02f74edb374713",
+	"1f6a3-1f3fb", "6a3a372ebf68406a74c0a94a274b386e",
+	"1f6a3-1f3fb-200d-2640-fe0f", "fc027cbabbb6d8f0b960a29786579d8a",
+	"1f6a3-1f3fb-200d-2642-fe0f", "13d3572a85cd6365f53a543c380f1dcf",
+	"1f6a3-1f3fc", "bbdd9507db13b6b19c40c1bc37705cc6",
+	"1f6a3-1f3fc-200d-2640-fe0f", "8bf28e206919c45c61a78221968418d4",
+	"1f6a3-1f3fc-200d-2642-fe0f", "e636dbb25b6cabc9d0b5ba7df4d46e30",
+	"1f6a3-1f3fd", "b8937d3a8e7ee9b7991d368acd6b10ee",
+	"1f6a3-1f3fd-200d-2640-fe0f", "feafb7871b0d42641936211a695259d4",
+	"1f6a3-1f3fd-200d-2642-fe0f", "4d1301ea90bf99fb9318a9b8438ff628",
+	"1f6a3-1f3fe", "29f253f2116a4ba58987ec4768634dbc",
+	"1f6a3-1f3fe-200d-2640-fe0f", "5ef8214974b898fe125358e0fad210d1",
+	"1f6a3-1f3fe-200d-2642-fe0f", "3d7df8a4a4dcfa89f8755d3200e2dbe2",
+	"1f6a3-1f3ff", "85acdad3a3c754da14d31d3561051b42",
+	"1f6a3-1f3ff-200d-2640-fe0f", "257937c92246bac4e78cd57e84e3987d",
+	"1f6a3-1f3ff-200d-2642-fe0f", "f13471dbe9cb24fd6685d0ceb54ccff4",
+	"1f6a3-200d-2640-fe0f", "bbc33a2765c9e570540377d41cb6362a",
+	"1f6a3-200d-2642-fe0f", "1b7d9fdf3c8f30b96cc4540d0397d4ae",
+	"1f6a4", "96ac826bfd73104e2f9dc72aaa7e5b6b",
+	"1f6a5", "11463169f9dfe8fc57d8ca9eff7ab8f0",
+	"1f6a6", "9a495f33d1114e0f9f91a3eb5090af81",
+	"1f6a7", "cc41695a05541293cdedb6fff1be8563",
+	"1f6a8", "b56e4108808ff9e886c75a135ccdb784",
+	"1f6a9", "f5f43be48e7804a8265947d8dd64e430",
+	"1f6aa", "77cc21ee15c109963eeb17581bbe4104",
+	"1f6ab", "415fa55dc7f6a0a58dbdda2d33b29bf3",
+	"1f6ac", "29362d6f43281e0f69076b520fb262b4",
+	"1f6ad", "57501a31a2dc8e9f127b01c5981c7d71",
+	"1f6ae", "f2d7eb6ae9c9fc4a4112d1ca4a5d9cec",
+	"1f6af", "944c0d3b832d55d9cd788260f8b26a0a",
+	"1f6b0", "42cd19c00c2f5f199da38ec554635cbc",
+	"1f6b1", "a5c2d247ff4ddb2b1e966320d46dbcab",
+	"1f6b2", "442dd1525062b91820d91cf99dd645e5",
+	"1f6b3", "acc1f217a15aeb408a4e6f8b09a1661b",
+	"1f6b4", "312da0d93f342c7ecdd0ebee5bddce74",
+	"1f6b4-1f3fb", "7810abdb23cb3bc7a2ffb53a13dc8f83",
+	"1f6b4-1f3fb-200d-2640-fe0f", "baa9307b417571db11381b8c648692f8",
+	"1f6b4-1f3fb-200d-2642-fe0f", "b086f6ad3cba1e9b72e4fdda99995e74",
+	"1f6b4-1f3fc", "e0bb10124eba68a2f07886b86403b6fe",
+	"1f6b4-1f3fc-200d-2640-fe0f", "a53c5c63b050e1ee14b9b1b4684df369",
+	"1f6b4-1f3fc-200d-2642-fe0f", "1dc8db91e1bbb857885b3768ac71ab1f",
+	"1f6b4-1f3fd", "c2b9ba438212a0dc876ed87636630c57",
+	"1f6b4-1f3fd-200d-2640-fe0f", "98277e0e3350fe35e102e67e83e9f827",
+	"1f6b4-1f3fd-200d-2642-fe0f", "95491ec991ab7813b1cf9a08fc27591b",
+	"1f6b4-1f3fe", "66b5af677441a51c2ff958ab664d1286",
+	"1f6b4-1f3fe-200d-2640-fe0f", "261c3534a746570279f47a60a3f70120",
+	"1f6b4-1f3fe-200d-2642-fe0f", "505d18f36bc693b3ccc510b7be041330",
+	"1f6b4-1f3ff", "a0645eccb2a0a1de9966ecd7956acc43",
+	"1f6b4-1f3ff-200d-2640-fe0f", "d031cc3e478e22659ccc57653a41aea8",
+	"1f6b4-1f3ff-200d-2642-fe0f", "ef6232bb2eb6296ef4d01f0e0f42633c",
+	"1f6b4-200d-2640-fe0f", "c1b55e70bfea5c4824e4a55177568422",
+	"1f6b4-200d-2642-fe0f", "119e86efa4f6a047134c9ccd3cc3c5a4",
+	"1f6b5", "a205db3062f442f0761d56529c485742",
+	"1f6b5-1f3fb", "1af8e7a525dc0678fe2ff70ec26684c0",
+	"1f6b5-1f3fb-200d-2640-fe0f", "3d54353a4ea5e11663aa858d198289c5",
+	"1f6b5-1f3fb-200d-2642-fe0f", "4329f2e6bdba199d441b689cbcfb9c4c",
+	"1f6b5-1f3fc", "21c6e35dd488d9a937cf5541660a4a60",
+	"1f6b5-1f3fc-200d-2640-fe0f", "37140af52a9bee083ce5e5e7547d5d9b",
+	"1f6b5-1f3fc-200d-2642-fe0f", "daf1ea6baf5c0dd535d8f6cd49a17cc6",
+	"1f6b5-1f3fd", "210338168b5d00372a88f3e0e0b9ee5c",
+	"1f6b5-1f3fd-200d-2640-fe0f", "961a76c1772969b3aaeb7274430c6e95",
+	"1f6b5-1f3fd-200d-2642-fe0f", "1960f1124863b6696b18aba56267168e",
+	"1f6b5-1f3fe", "ca9900e982f51633708c017d8e5cef8c",
+	"1f6b5-1f3fe-200d-2640-fe0f", "e14debeca54a3456e447c9e61994732c",
+	"1f6b5-1f3fe-200d-2642-fe0f", "5a9fe9bdafe18e2769997f60316cf2e3",
+	"1f6b5-1f3ff", "17f877586ced178c1f46772b1b3fd5e7",
+	"1f6b5-1f3ff-200d-2640-fe0f", "7201d20947d7389d6914ec50d75bd697",
+	"1f6b5-1f3ff-200d-2642-fe0f", "e472dd0918b31d84864e95cfad851a16",
+	"1f6b5-200d-2640-fe0f", "d56992b2df5ce1d436c31088bb064b14",
+	"1f6b5-200d-2642-fe0f", "8c647eb5ba92ce2e93618da667f8c876",
+	"1f6b6", "660f8acc8164369d9d5f2ba84c490670",
+	"1f6b6-1f3fb", "f67a6bdc932ce2c87487b65bd243e8a0",
+	"1f6b6-1f3fb-200d-2640-fe0f", "0c72eea57ac1e9ee4908da4ac7f84976",
+	"1f6b6-1f3fb-200d-2642-fe0f", "6a356420b3c9387c896a74262e78edd1",
+	"1f6b6-1f3fc", "63a56f89f6b72b9ab70b82134e228363",
+	"1f6b6-1f3fc-200d-2640-fe0f", "0098709d735bd4ae1228c2665d0eda21",
+	"1f6b6-1f3fc-200d-2642-fe0f", "f028bdc22cf452b1312a7a4cd10a84c7",
+	"1f6b6-1f3fd", "404ba3a074a886afe7301dbbf0e485dd",
+	"1f6b6-1f3fd-200d-2640-fe0f", "1e14b077a569ddd1a0f6e1b54361b754",
+	"1f6b6-1f3fd-200d-2642-fe0f", "5c8a1bc5ac083808f6f0ea519a6d1c8e",
+	"1f6b6-1f3fe", "a5fd8f21da24056419a2afdb2eb177d0",
+	"1f6b6-1f3fe-200d-2640-fe0f", "a3de8eef5989f4fa979c0e6ffc33a34d",
+	"1f6b6-1f3fe-200d-2642-fe0f", "3a16830dcbf02942fc8a2ff3fbee68fa",
+	"1f6b6-1f3ff", "44e8c5b6ffb57875dc213f0dc2d60dd2",
+	"1f6b6-1f3ff-200d-2640-fe0f", "68d4ca73e49096ce6a724083e632e4d8",
+	"1f6b6-1f3ff-200d-2642-fe0f", "040cc17ef3a1c7daa80b2aacdcd5752d",
+	"1f6b6-200d-2640-fe0f", "9680424e7286bd6f615865f35ad53e6f",
+	"1f6b6-200d-2642-fe0f", "7f3423f0d46703e87fd05ea77e45118a",
+	"1f6b7", "0cb784cd70c2e57dfaeaf67e8673256e",
+	"1f6b8", "8f6a229efe387d8c6748e74007ee4fed",
+	"1f6b9", "95ab7ffb8d5e241e108c25f902509b2a",
+	"1f6ba", "aed9b39359d1332acf621d489c6dc79a",
+	"1f6bb", "49b93e5d61becf80a5dd32884b867e3a",
+	"1f6bc", "b27ddde1cf4f8ae0f48aad5f4d9e6b72",
+	"1f6bd", "911620b8cbc68d6f317a054be58977bd",
+	"1f6be", "07d9a4d6f4154a1b8ac8a75838948fe5",
+	"1f6bf", "bed220030481eb31314f5ffce48ab558",
+	"1f6c0", "979eda077154e01c720eba386173ca36",
+	"1f6c0-1f3fb", "2c25b28c7b9e33dadb6daf1f80c5d424",
+	"1f6c0-1f3fc", "7f2ca4e46b1a59f8c08c4672f4a81515",
+	"1f6c0-1f3fd", "9bbcbdbf898e8b03cbbc2c49273e247e",
+	"1f6c0-1f3fe", "09703f994cc29ba95390adafc109a5ba",
+	"1f6c0-1f3ff", "8806b9939c35714baf9f5bb5b0e1f5fa",
+	"1f6c1", "258f504cdda0f12abe03f9360724bfec",
+	"1f6c2", "cd1bdce1611a7c1ff88f761a949413a3",
+	"1f6c3", "8a374fe55f29aaa29114c8b49d301c0a",
+	"1f6c4", "cbd219ee673dba70b2c9e39bfbf8f7d1",
+	"1f6c5", "a01f52021777e58829cad9323b17371a",
+	"1f6cb", "052c2cb602f1fe2bafb5900b67cf236a",
+	"1f6cc", "6c19371cf04137dfdd0de46a32c230fd",
+	"1f6cc-1f3fb", "5baead4e3ce7a0ded1977fcfeceb4176",
+	"1f6cc-1f3fc", "ceea7dbdb52a88a800a3f538c27ab4f2",
+	"1f6cc-1f3fd", "85062b7ca20c887bcbe93977b070b456",
+	"1f6cc-1f3fe", "831b74f22f01cb59cc4ba8f64ec0c327",
+	"1f6cc-1f3ff", "298f6b746370fda33a91d6748fd0c830",
+	"1f6cd", "a2ba6861bf663df7158a5699cae07c1c",
+	"1f6ce", "791e391602ab4e6b10c64f43095342b9",
+	"1f6cf", "08148db134d007c67675a73a5c89bc19",
+	"1f6d0", "182f6ee66279e4564729eedd02f113ef",
+	"1f6d1", "2ff27b392104b9f10c0faa72f68e3fbd",
+	"1f6d2", "3f0519f01b411bfdaf3fe311ea34b74c",
+	"1f6d5", "5b294fcb8f365e9a9d9d45e7caa72856",
+	"1f6d6", "553b3d5b39313e22a9f5864e7ea071f7",
+	"1f6d7", "d77b8dbc29974f4cd6db18e4d8329be0",
+	"1f6dd", "cff6060797f2c4bd00f86b2e1e9860e3",
+	"1f6de", "48fa2b2a410d7be930d2c4708afaabcf",
+	"1f6df", "15d18d67d91396deba83eae167ebc1f2",
+	"1f6e0", "28a6206f93399999d1a908d5c45232ad",
+	"1f6e1", "ad2e4d6e7b90ca6005a5038e22b099cc",
+	"1f6e2", "ab7865e51cabbc0f7497b2ef0a28f503",
+	"1f6e3", "2181fc530db1148d9539399397354547",
+	"1f6e4", "039d43e53c13e4adfc1ea88b7b563b9d",
+	"1f6e5", "68a9d96b5ca631e01277d2fba7811eb4",
+	"1f6e9", "971eb19375e225dcace1026e67ca51f1",
+	"1f6eb", "b325d2c8b0d4f7c4e71372f3b973c5e8",
+	"1f6ec", "5870a5192561ceb1db7bd245d9d5c7b4",
+	"1f6f0", "a069b0f6a6d79b5056dfc8a1f2cca4ad",
+	"1f6f3", "59cda6e5f44b956f5dbd46c0dd1dc63e",
+	"1f6f4", "12055ae4da82b5e45b83bbd1919d7836",
+	"1f6f5", "054ec4de28473a5bcfdf1e7dc20a6711",
+	"1f6f6", "3d1325998ccf6e8874185a82aa2a7d64",
+	"1f6f7", "f8db26d08ff2e4af0f967ae1827bf8b6",
+	"1f6f8", "d2b42f278672425e187ce745be2e2bcd",
+	"1f6f9", "78ee9dac01fd40a3d1a22b5ee20c0710",
+	"1f6fa", "17368d92d73b6f8b824d8925c474c91e",
+	"1f6fb", "23a4feddccd956ca8bb5bc8049d07c01",
+	"1f6fc", "ec28d8e0cea7737cc97081d10828c5b0",
+	"1f7e0", "89266863a0183fbdd7b4524634f5ec8b",
+	"1f7e1", "b633d391ab0f467ac71a7b459fefa4be",
+	"1f7e2", "dbd26c4768e6ce541f5b857b4973226e",
+	"1f7e3", "d5705ac7e416d39813ddf63c8d396102",
+	"1f7e4", "4e697e73dee47d6a399f3d33d15a83b5",
+	"1f7e5", "395a4608ed021406b8f0ad4506081996",
+	"1f7e6", "f3dd95bf2c4f3a87f4e562760ab8082a",
+	"1f7e7", "2b417f558f9e5063c0c5c9d348d4347e",
+	"1f7e8", "0ca363e545e2f490cf4b852f5c8e0404",
+	"1f7e9", "bb7aa875fe442f8c2c79e5b8b1e8c7c9",
+	"1f7ea", "5c5c067826e5243e8228edd1dd6a69cc",
+	"1f7eb", "d417205608f0ca8bbca73c1274ee5d5b",
+	"1f7f0", "d12affc4ae89d028b7749fe6360b6cdf",
+	"1f90c", "24494e7542e5cb86e1821f11bcdad52e",
+	"1f90c-1f3fb", "d791da7b1a23ecf6e79092f1e8d1f2ee",
+	"1f90c-1f3fc", "27f2e1682b9690924c87956971a3b3d2",
+	"1f90c-1f3fd", "bd5b969cd8a8621634726ea4d03505c4",
+	"1f90c-1f3fe", "befd9cacd69790122bb22306372ebc3d",
+	"1f90c-1f3ff", "c829443df39b9b4adfbc86ca6854dda8",
+	"1f90d", "75ef348f4fd073a0ee237584fa350ea6",
+	"1f90e", "0dbfbf14977e0fa1f094d00281332013",
+	"1f90f", "2f99d51172fe3346316961fe4f67fe30",
+	"1f90f-1f3fb", "03c1065498cc4370b8e0deb867d14b80",
+	"1f90f-1f3fc", "3fa9750a187819d0bb9a2ce4fa4e61a5",
+	"1f90f-1f3fd", "08ad6577195036baf6de481b81c0c453",
+	"1f90f-1f3fe", "599a69e42323cd729713d99769324a8b",
+	"1f90f-1f3ff", "664094c83e37a7056f6584bd9f3f2773",
+	"1f910", "c9687b0b5a491edea9fbe438d316a341",
+	"1f911", "dd35e632b9aac425f17ad59c85ecf9eb",
+	"1f912", "0d8bbcf262e835b89d4f75a0cda241fe",
+	"1f913", "c61131c53f9986ac8808d592c3019a7a",
+	"1f914", "263a7f4eeb6f69e46d969fa479188592",
+	"1f915", "267b527f1be941e367bce73444c96f3b",
+	"1f916", "b67e65c2ab0428da786c1f53ebfd4243",
+	"1f917", "aeb86343db64360cf19cd66eae4eb792",
+	"1f918", "4cc63989873551be9218f8219326eb62",
+	"1f918-1f3fb", "8c470a3905bbc669c74de491c812b8fc",
+	"1f918-1f3fc", "1539517cf9dffcecd98a5adfb8e32900",
+	"1f918-1f3fd", "440c3c0546c4d779dfead9edd45db3e1",
+	"1f918-1f3fe", "59452bf1b005e6f315338aad11c2243d",
+	"1f918-1f3ff", "350f25c2297fecb66d92c0fc9cf01937",
+	"1f919", "29e1f3826b6e67ef9c25140c83a5d802",
+	"1f919-1f3fb", "f71ed20d4938704495e41731dfd0b1df",
+	"1f919-1f3fc", "4b09495ffbea06f18998d9bd3c3a7abe",
+	"1f919-1f3fd", "ab6568f8bff3d353cbc373b00a7ff37d",
+	"1f919-1f3fe", "7a4b02348b453b83d9d5eddff21c6b4b",
+	"1f919-1f3ff", "793b82ac800030928994e43e91d2cc90",
+	"1f91a", "1d2621e5852646cb449ff778ff014969",
+	"1f91a-1f3fb", "03f72fd05c4c4465f374e341d77f089f",
+	"1f91a-1f3fc", "d35c1ded71f65290c24615ef06ad19ea",
+	"1f91a-1f3fd", "3a37de3c540d8f2ba4e9047ad631af1c",
+	"1f91a-1f3fe", "bc42d978a15e36408ac95d222395335f",
+	"1f91a-1f3ff", "805da37fec8479154e9d681ebf7fcbcd",
+	"1f91b", "94801ab47a5a573b00876e2d35fb9f44",
+	"1f91b-1f3fb", "ac124a11bad5b752efef1812af0c5065",
+	"1f91b-1f3fc", "691e1346eda4d84c351044dd7e6f8221",
+	"1f91b-1f3fd", "999adeb9199b2523de72f28c3aed1aac",
+	"1f91b-1f3fe", "36dded763cc3aa4b4e798a8c5d32ad5c",
+	"1f91b-1f3ff", "b38e512f3b6a5d190650f956d0a43e1a",
+	"1f91c", "bfe98075124bdb8b6ac3c7f040774efb",
+	"1f91c-1f3fb", "57bfd99d6b61e4fd9be0ecec7f661cf7",
+	"1f91c-1f3fc", "8e483e5c659fd05e3194936f63ea2ba2",
+	"1f91c-1f3fd", "e67297f9ccdf5ee457fff229ec2deb8b",
+	"1f91c-1f3fe", "741b7103a8f04432313c963e462886fb",
+	"1f91c-1f3ff", "cef6139ee2105a15d87277eda9ae0c72",
+	"1f91d", "721f98d2ad64bc9a005819bddc2eb322",
+	"1f91d-1f3fb", "7345dee38d302ca7f871d3829479894d",
+	"1f91d-1f3fc", "30f45e331f0deaef65181332391e16a0",
+	"1f91d-1f3fd", "b78530431b0b285e856d540ea2302abc",
+	"1f91d-1f3fe", "d513a0299008d22ea75c208be21af20b",
+	"1f91d-1f3ff", "85d14d87cfdb1591424944f4bd3fbdd7",
+	"1f91e", "093949d259169bc4d540b065462277de",
+	"1f91e-1f3fb", "812cb33188d430312d85f4de3e4bb5c6",
+	"1f91e-1f3fc", "ff1a0335ce80419b159d6ce93ace23b4",
+	"1f91e-1f3fd", "48acd48e3f3aadcb841b8115c0324743",
+	"1f91e-1f3fe", "3608579e9183dc13a7eadad0fba7b2a4",
+	"1f91e-1f3ff", "774493fe2b2046e74fbb2f1a54b7ac16",
+	"1f91f", "3dc67b93bfac1fa91815569ffd22a874",
+	"1f91f-1f3fb", "35d41bc20e433b649f9ca702e4449eba",
+	"1f91f-1f3fc", "def622214d5ad39278ec2600c197000a",
+	"1f91f-1f3fd", "2cebe8708185decab3d03f674a193a8a",
+	"1f91f-1f3fe", "7d3e26500f8a61a424f819958e1556b7",
+	"1f91f-1f3ff", "5d76b9f37a6c2d952106c0d8c71a7ec8",
+	"1f920", "c18f8f63a3232a0d246868b4d9f20f7b",
+	"1f921", "9b323e96266a2cbf1fa0b07a547418be",
+	"1f922", "8212db2111debc4acc49ceb20cbfbdf0",
+	"1f923", "567230faa19ec889fad5613630597049",
+	"1f924", "bcee365bd88b9ad34961293f870fbc65",
+	"1f925", "7db949cd37e5e7c2623249272d9b3ea7",
+	"1f926", "2b7369da4c95a00663061c4acb5567ff",
+	"1f926-1f3fb", "94abfeb751d16e1d050ce1fca7817b27",
+	"1f926-1f3fb-200d-2640-fe0f", "53afc1d327bfc0e93db70f19031dda3d",
+	"1f926-1f3fb-200d-2642-fe0f", "50c86571a05706b95d90d948e50c07f1",
+	"1f926-1f3fc", "fc55a0b669a11aee693dd249af519abb",
+	"1f926-1f3fc-200d-2640-fe0f", "4b606ec1f384ab6a812d0eb7c6dfc288",
+	"1f926-1f3fc-200d-2642-fe0f", "a8a61a3b61c2ffdeb9e3ece65c818b32",
+	"1f926-1f3fd", "ef218a0b037cc59bbc287778dc19d739",
+	"1f926-1f3fd-200d-2640-fe0f", "8541b3067327c36a0343dca642c6a62a",
+	"1f926-1f3fd-200d-2642-fe0f", "e6d8ab86226242c9b3505d6430bb5270",
+	"1f926-1f3fe", "3f0e2af86f4f1843855994a4353c38ca",
+	"1f926-1f3fe-200d-2640-fe0f", "dea442643ec94fc96dbe8b1a9d5ec172",
+	"1f926-1f3fe-200d-2642-fe0f", "bde1e7ae8d15868e613a8f0e4263cb31",
+	"1f926-1f3ff", "190e9262baadf08c2c87e776017ed65e",
+	"1f926-1f3ff-200d-2640-fe0f", "5a4d827bd7b61eb1ed3a3c17b885eb19",
+	"1f926-1f3ff-200d-2642-fe0f", "8afb6cf24e748da3745958ed50f55dd9",
+	"1f926-200d-2640-fe0f", "6e014f8c0b0641b4aa08366c1ee33e7f",
+	"1f926-200d-2642-fe0f", "2b5dd64f0e98417ee815db4e47e9e499",
+	"1f927", "a8a943a00a0430e5d06bb57ffacf2839",
+	"1f928", "879468637365983eba26983ddf6d38ad",
+	"1f929", "ffa92fc2c8f0a781d5ae9ffbecefa054",
+	"1f92a", "aee3a8d989cabcd262db85fe0ce7cd0d",
+	"1f92b", "acb7b1a237378bbddd34927b4001b8f4",
+	"1f92c", "d3b48623c6a5915294f7278351b002da",
+	"1f92d", "6ba3855f5ea79b9b014e66b28c54429c",
+	"1f92e", "633e893d2577bb3de002991aa00bc3b0",
+	"1f92f", "ddea4500a252f80cbf6d6c92db15178e",
+	"1f930", "b7e3dbb19c3827f816d31d41f40b9cab",
+	"1f930-1f3fb", "806ce1822cd982fb5cca464fc728d7cc",
+	"1f930-1f3fc", "610fb7ae7015b8bdce3bf3cad6937099",
+	"1f930-1f3fd", "198a5c244f3497124743bcaae8b3bece",
+	"1f930-1f3fe", "8894be9692fe30ec4ea1082e24d69aea",
+	"1f930-1f3ff", "03f79b4541d307be99e9b1ff0660a62b",
+	"1f931", "25fa21b12082690d8c45e1c21e366635",
+	"1f931-1f3fb", "7850ca1e5f40881b6ec3fd70442f88d1",
+	"1f931-1f3fc", "f8e0fddf1beffd3a4c15c309e57143f6",
+	"1f931-1f3fd", "45079b32dd7747137b5e04ce90cf661f",
+	"1f931-1f3fe", "507d3947092b81aa47b5fd573b5515bc",
+	"1f931-1f3ff", "0977d24075aea9ae9e84d5d4e102d2c6",
+	"1f932", "dd4f622d0cffd2089f4afb9076ff1797",
+	"1f932-1f3fb", "a7eccc48437e30e9e4652ef4fcbf1ee0",
+	"1f932-1f3fc", "cb118ee43ced1cdd4e3e17c9af5e10b8",
+	"1f932-1f3fd", "6eb1026d4a7cc846d2ad8c4d2e247595",
+	"1f932-1f3fe", "b602f0770e3f6aa7265dbe2881259a84",
+	"1f932-1f3ff", "4ed6c4300d27c0683cb4dac3364c7e95",
+	"1f933", "38df7a213fb773ddc158558a3075d10d",
+	"1f933-1f3fb", "eebea3080bbbfe540a724b231ff01a08",
+	"1f933-1f3fc", "932f050b224642212a1034af0ff18e6a",
+	"1f933-1f3fd", "c991627955c3f8924c5066256e3c7e47",
+	"1f933-1f3fe", "6ced3847afbf38a97e576d369744ccd0",
+	"1f933-1f3ff", "ddd86f7a79e89b8400999b6354accc5f",
+	"1f934", "6d7e0f3b4b9279dda7875df4d5ae8b40",
+	"1f934-1f3fb", "a371c029978be85615dad4038d42e8e6",
+	"1f934-1f3fc", "d14d615cdff4c0fdb8044254ea328de8",
+	"1f934-1f3fd", "081d3cc7d468abf5c779fdfeb72662b9",
+	"1f934-1f3fe", "aed8c42690943f0c06392099346d575f",
+	"1f934-1f3ff", "c0756a1988f7c3a0c0c732eaa0d12bef",
+	"1f935", "3af67fd06e7c5cf136c22654eaa51962",
+	"1f935-1f3fb", "06a19966574248f47ac8570b6f79c992",
+	"1f935-1f3fb-200d-2640-fe0f", "700cb091f05d9ba7f6805447ca4adfe0",
+	"1f935-1f3fb-200d-2642-fe0f", "4b4cacefad809a36361b8ca5a069c830",
+	"1f935-1f3fc", "1c617c8800e557975555c28407e6a7db",
+	"1f935-1f3fc-200d-2640-fe0f", "f54a3d5d5c70cf0d13fd778bcaf1f297",
+	"1f935-1f3fc-200d-2642-fe0f", "85403c696cf8d809cdb672525b84c33b",
+	"1f935-1f3fd", "af5f9ae8899de5c3be14afdb6728b4c9",
+	"1f935-1f3fd-200d-2640-fe0f", "567dfecfecbe8a4bfdfc1bf4edef0868",
+	"1f935-1f3fd-200d-2642-fe0f", "f5446257d5625441734281c5dce41dac",
+	"1f935-1f3fe", "ec86ce30dfcdd92a871c8c9064084589",
+	"1f935-1f3fe-200d-2640-fe0f", "0d0dd554019e0e46379a8efc745abe1f",
+	"1f935-1f3fe-200d-2642-fe0f", "bcb3fc13f8108da5a563c9fb86ed34c9",
+	"1f935-1f3ff", "145f7bb4cd7db626c25a6c02456462b8",
+	"1f935-1f3ff-200d-2640-fe0f", "55d326adbbd0de37b4e7354608965772",
+	"1f935-1f3ff-200d-2642-fe0f", "6bbe6bcf94288d2e673851061bb49dd2",
+	"1f935-200d-2640-fe0f", "336e26cd512ff4ed2b2238ff8087eec5",
+	"1f935-200d-2642-fe0f", "da7554bf196b958f159e5dbb3b7084ef",
+	"1f936", "fa94ca14b2a88caa3529aa407645e6be",
+	"1f936-1f3fb", "e25208b297bf8f9e22044a09811083fd",
+	"1f936-1f3fc", "4b083ab47a352c20827b7e2457c4ea20",
+	"1f936-1f3fd", "8b8b3c75cc1cc90e05de45cbfb4cbf7f",
+	"1f936-1f3fe", "35c164880897dd708ed51d2941301c10",
+	"1f936-1f3ff", "21d8977920e9aa27089f971704695fc0",
+	"1f937", "a6f0125bda828e86ccba6c5b64c74100",
+	"1f937-1f3fb", "f37ba287409050dbac4660708cd65a1d",
+	"1f937-1f3fb-200d-2640-fe0f", "b7e3e925224e1dd9ab6a9ccc02a57195",
+	"1f937-1f3fb-200d-2642-fe0f", "2a6e5919e58df2a439fe123be230624c",
+	"1f937-1f3fc", "21d94abcaf7e2f614bb2264aa14f01e4",
+	"1f937-1f3fc-200d-2640-fe0f", "2db729e5755e2164efb94d8d995cde3d",
+	"1f937-1f3fc-200d-2642-fe0f", "b8f4484ceffb751aa13f674a8be20e1c",
+	"1f937-1f3fd", "be6abfeef9d6b03de92f28d5b0499426",
+	"1f937-1f3fd-200d-2640-fe0f", "abf13f9789e1e33f28243c648a8170a6",
+	"1f937-1f3fd-200d-2642-fe0f", "af98f33a8a273c26d52cb3fc764ae849",
+	"1f937-1f3fe", "bb9e730957dcc60ef85e65455beacb46",
+	"1f937-1f3fe-200d-2640-fe0f", "430fc6217379e8c2b8d6f4d40e4119a3",
+	"1f937-1f3fe-200d-2642-fe0f", "6424a39f1cef67ec334a955598693288",
+	"1f937-1f3ff", "6fc69fcaafce22bce90bf12943f1ee7f",
+	"1f937-1f3ff-200d-2640-fe0f", "f2628bfc6c7972d73044beee09a9b74d",
+	"1f937-1f3ff-200d-2642-fe0f", "8a924e5d45c0a3ca992f3b5427067b04",
+	"1f937-200d-2640-fe0f", "9fa4e8b751cc33b92608079dcb56293f",
+	"1f937-200d-2642-fe0f", "8bb32bd487f28959e88250de4794153a",
+	"1f938", "1948eca4d13209a088ac39d88cdc60ff",
+	"1f938-1f3fb", "caf3189e034fb74a360315a16e2fc9c2",
+	"1f938-1f3fb-200d-2640-fe0f", "e951ef5244ac8b90f61f9684d4251c80",
+	"1f938-1f3fb-200d-2642-fe0f", "08511f65d12257de42cb0e38741ed97f",
+	"1f938-1f3fc", "cfbea48dcbb2d1eafac9709491df63bc",
+	"1f938-1f3fc-200d-2640-fe0f", "7f3d53a4529fce18fd17031e5ebcc1ba",
+	"1f938-1f3fc-200d-2642-fe0f", "ec2a00b9d3c6bdb83f2a8d0c38c96430",
+	"1f938-1f3fd", "6a2a9bf7660d682483bdabf01789835d",
+	"1f938-1f3fd-200d-2640-fe0f", "db3a757906949d483454f800b4510bc0",
+	"1f938-1f3fd-200d-2642-fe0f", "ef7f8d7ef34ec96814285b447600e862",
+	"1f938-1f3fe", "74ef644223026d44cb8989db3127d3cf",
+	"1f938-1f3fe-200d-2640-fe0f", "3ae492224e929a8cbb1e6f81c437850d",
+	"1f938-1f3fe-200d-2642-fe0f", "e360c888afa12dd2b7380d0de89d4ff2",
+	"1f938-1f3ff", "f990788ea761c9163a0a1b4dc91929ab",
+	"1f938-1f3ff-200d-2640-fe0f", "d9abdf7902f0576e514dad47fd19abb3",
+	"1f938-1f3ff-200d-2642-fe0f", "b1742ac237af2171d08929bf6e93a7b2",
+	"1f938-200d-2640-fe0f", "181e14284d04a9115e1b9235f0e788e7",
+	"1f938-200d-2642-fe0f", "d4ca5ce1c1ad7c9ec8b801c6de566409",
+	"1f939", "ce1cb62b6a67196f8de5df9876f038d6",
+	"1f939-1f3fb", "e656f183e5a22a38f337e7de7e316e58",
+	"1f939-1f3fb-200d-2640-fe0f", "19700558aa106149d34d7056932d0e29",
+	"1f939-1f3fb-200d-2642-fe0f", "1c7e382f2f9f36c36e710fc4deaa272e",
+	"1f939-1f3fc", "003519a109cd19de38c8845aecf1bb98",
+	"1f939-1f3fc-200d-2640-fe0f", "b3696fcbb3f46f108e297aa6c24cefac",
+	"1f939-1f3fc-200d-2642-fe0f", "f9c31743fb4821cae75ef1f34366a5af",
+	"1f939-1f3fd", "8673f8b7a808b5b6340988d2fd4307e6",
+	"1f939-1f3fd-200d-2640-fe0f", "b4e7366de69790632e2d11c337c09067",
+	"1f939-1f3fd-200d-2642-fe0f", "c98a5dbe79e5c4f87dd8e788a31ab0f2",
+	"1f939-1f3fe", "21422b05608bbc656f968455442b688c",
+	"1f939-1f3fe-200d-2640-fe0f", "a2c5fdf59473655ee575cc2d71bb5d72",
+	"1f939-1f3fe-200d-2642-fe0f", "33cd3c15452bd39808675b70804c1f3e",
+	"1f939-1f3ff", "f0c6210b88b3120894abfe2716c6ad27",
+	"1f939-1f3ff-200d-2640-fe0f", "8a702eae2f09090d7bcbb55141dce6d8",
+	"1f939-1f3ff-200d-2642-fe0f", "df9cec539cf80c1d6ef6d917de5faa22",
+	"1f939-200d-2640-fe0f", "eba5d35eeea19659f59b278fe4630f87",
+	"1f939-200d-2642-fe0f", "54dbb83805cd44eef9ae0cc3429bc7a6",
+	"1f93a", "aac0ffc4672c9707c76118dbac907a07",
+	"1f93c", "c85523a381bb24faffc9d02222bf76fd",
+	"1f93c-200d-2640-fe0f", "8ea607afa7bcedeb2fe414d15200ac13",
+	"1f93c-200d-2642-fe0f", "2a79c268a98676d675c028cafb3e1252",
+	"1f93d", "6f9af1b4a7c298cbd4a15b6b90333871",
+	"1f93d-1f3fb", "90355331d422ce8a686dee722ed2f90c",
+	"1f93d-1f3fb-200d-2640-fe0f", "59082dfd1924a3a1a170d1d6342e0ed8",
+	"1f93d-1f3fb-200d-2642-fe0f", "861a896e64ee03826f846e330b2cf3d2",
+	"1f93d-1f3fc", "627f445f0150f20c7657302620453f40",
+	"1f93d-1f3fc-200d-2640-fe0f", "4ae5a2db74c037605703cc837078683e",
+	"1f93d-1f3fc-200d-2642-fe0f", "99074ec66afe20c487f5e3c6e6e17ade",
+	"1f93d-1f3fd", "7933987438efe3bda9a5290a62bc4e1e",
+	"1f93d-1f3fd-200d-2640-fe0f", "227fe006a3ba251d3602698f8bed2dd5",
+	"1f93d-1f3fd-200d-2642-fe0f", "4fd9b11483bf5fca7964cef4735b4494",
+	"1f93d-1f3fe", "4e9a17a2650eac60f8b3fe3825cd004e",
+	"1f93d-1f3fe-200d-2640-fe0f", "6e423a40b237268262554fe74334ed17",
+	"1f93d-1f3fe-200d-2642-fe0f", "116fee1744819351f7a0176d1e1359d6",
+	"1f93d-1f3ff", "718b7f45da79b29eae44661179ae4e13",
+	"1f93d-1f3ff-200d-2640-fe0f", "b08e537533ee9fd667aaa9932f7aeddb",
+	"1f93d-1f3ff-200d-2642-fe0f", "200edd5228be534d8f0a2be06a394699",
+	"1f93d-200d-2640-fe0f", "ffa686880d5e800441246b2dcc9590a7",
+	"1f93d-200d-2642-fe0f", "c69f90edb8b9a349e2cb816b33af0516",
+	"1f93e", "f98b0a9fb9f497ba61f15d98ace1d65e",
+	"1f93e-1f3fb", "9571a75fea7c7c8a06a472d3dc6a7403",
+	"1f93e-1f3fb-200d-2640-fe0f", "55c49fe0812ae3b17a2f6f2b86651d92",
+	"1f93e-1f3fb-200d-2642-fe0f", "23e62edb3356527fbd8ed3af98f4f994",
+	"1f93e-1f3fc", "8ac12b59272083e4d9d591866282961f",
+	"1f93e-1f3fc-200d-2640-fe0f", "8bd1f8d7a9a0e5267855146aea68ee81",
+	"1f93e-1f3fc-200d-2642-fe0f", "c7a87ee9a48ea74f83053442c7ac7f42",
+	"1f93e-1f3fd", "d09ec23e7d3861ced5425b8526ec316c",
+	"1f93e-1f3fd-200d-2640-fe0f", "6adb3c817113d3f46b27c64a9e1af28e",
+	"1f93e-1f3fd-200d-2642-fe0f", "11ddff238eddecadd85bd5ed19621237",
+	"1f93e-1f3fe", "826b0c974ba4cda72e4da887972b9c6b",
+	"1f93e-1f3fe-200d-2640-fe0f", "18729439d2a6761a1275c6578189c3ae",
+	"1f93e-1f3fe-200d-2642-fe0f", "e3ac8efbcee5e39759424b9a71205082",
+	"1f93e-1f3ff", "92a7693f118f444f02d60d1548feb113",
+	"1f93e-1f3ff-200d-2640-fe0f", "156a252526c3745ca7f98a1bf0b57fb7",
+	"1f93e-1f3ff-200d-2642-fe0f", "9823dcf3d148d1e44334db781e5a7927",
+	"1f93e-200d-2640-fe0f", "5b994d589b1dcb2d803595cde9f344b1",
+	"1f93e-200d-2642-fe0f", "751bed3be20ea3ab9d046553d016fb7a",
+	"1f93f", "348da7884f4dec0c4005b82f1f3e410a",
+	"1f940", "e328cbaba5658ae0f002fadbddbf04d0",
+	"1f941", "24495a3225f3b181f06ebc589b3267be",
+	"1f942", "1046d6db48d3c45ffa745e73f85e230e",
+	"1f943", "05ed8fab4d9da4b1130ff7123988bad8",
+	"1f944", "b8088efbebfa288bed766d40ac14e118",
+	"1f945", "ff63bac8b064e04d31a32eeae8ccd7dc",
+	"1f947", "e2f8f101328a4b4ae7875945716345b3",
+	"1f948", "c65da98dd1cd29756d4d5901ed549661",
+	"1f949", "9ecf90770f4de9be7b44cb601d49722c",
+	"1f94a", "0bba59679c1a2c8325542fa484725c1a",
+	"1f94b", "9447893dd2eff1070480ee5a93ef3180",
+	"1f94c", "4712a73f565aa5a47245ed69d584f403",
+	"1f94d", "75dfe02f845433ec1da6f104c5b8ccba",
+	"1f94e", "9efa279f76d8fcf03309fa1b771c43de",
+	"1f94f", "688d7afb399b4582b65a4db9016c28fc",
+	"1f950", "90fd02b490e78c1d109f8a6c2258e603",
+	"1f951", "e4b6d2720cd87cfdffe875ecc469f710",
+	"1f952", "6fdc6338e892d53430b283f7136c5cb3",
+	"1f953", "9a3fba507c934679b333d8d3cfee04a9",
+	"1f954", "18ab2177ee2cf01e2855d916c24b568d",
+	"1f955", "505b14bf36ec47cdbe22258a9419471e",
+	"1f956", "6728f7445b5530e8a9a2223c48bdb922",
+	"1f957", "9cf68b29157f98c83144580400b5d3ee",
+	"1f958", "7f1f04d2c3986363c03750206bf7d9d7",
+	"1f959", "ad56cc64bdb8fa62623c559900829a3a",
+	"1f95a", "5ca0c0b0ad60ee4b580e7ed918426cdb",
+	"1f95b", "c7b9a045336a335d4b87fae6b75b73ce",
+	"1f95c", "ff9619d028f1535f85a4c759a64de267",
+	"1f95d", "17ef302e76de9a5905f978a06c52ddf4",
+	"1f95e", "ddef78a94382b29b2d562a947f332211",
+	"1f95f", "41c805926fd5c0b120e7b58536473314",
+	"1f960", "98c3f380071921c6c56509b1ee924df9",
+	"1f961", "2613f4b9c6f9ae2f05e64c1ba3ad6c30",
+	"1f962", "3f6e1787ce79cf5aca97e45fc7680564",
+	"1f963", "232c25279b78c07d9f9dcf3b55dee3d6",
+	"1f964", "e93446908591e6087dcff8877dce3eb5",
+	"1f965", "fb44324f4075e90f296ea8a798af1703",
+	"1f966", "1de9216486dce86e581aa64cbe4b7f4e",
+	"1f967", "98956724fc3f86e605dcf272a05b4a1e",
+	"1f968", "c08455e24d167e611d3ed56380212922",
+	"1f969", "d35629f6c4293ff75d35f0c96e287c92",
+	"1f96a", "38fadb5ec7c550dd3c3a513f2135dadc",
+	"1f96b", "2396da35337ea36298e29e868ea4407b",
+	"1f96c", "816f010a6094fe98836610d2f080a5fb",
+	"1f96d", "79957f681e99624173f17b2ba0e81627",
+	"1f96e", "54c838326679dc943c43a70c3291814f",
+	"1f96f", "8e51177d22634fcb045f1cc5bd941a4c",
+	"1f970", "01c80723fb8cbdcb9e22747cd88e07e4",
+	"1f971", "e899188d7f6b611acdca8b91abc2f104",
+	"1f972", "4fc5b1ef776d4ae648d813da6eb6704f",
+	"1f973", "f3e307960b1c81de130c429034802618",
+	"1f974", "154693d902e0f75f3e475bd487be7969",
+	"1f975", "372518b135d414f92edcd06b89e1468b",
+	"1f976", "10ba107674fdf2d100be5592e7c85c74",
+	"1f977", "b5f4153866352d28e665cff0c44f36c0",
+	"1f977-1f3fb", "b803a4b9e5eeab66e4a4aaca84105935",
+	"1f977-1f3fc", "c7f258a5332b1fa52a5dfc5ae05ad76c",
+	"1f977-1f3fd", "dd642bb5444f274317579b62d2d353f3",
+	"1f977-1f3fe", "5f71ab6be9935e2075bc6c4cbde05161",
+	"1f977-1f3ff", "046606412fd783386b53e492e4f63eb3",
+	"1f978", "5963476960a5b3a9cf36e7bf8b435928",
+	"1f979", "571a9318fd6af1875977d03256f23214",
+	"1f97a", "e63c47fd56c7ed1e4552974baaef4b4e",
+	"1f97b", "6c290f08486ef0478c750e3a5f50f637",
+	"1f97c", "ec6ba34f48e83598abb7108a5687c8fa",
+	"1f97d", "0d10438407905c132a21ad9cf2ba576c",
+	"1f97e", "48eee58d8732624417222c0ae7e360b3",
+	"1f97f", "3a604b4ffe92262b9303fbb545f9bd43",
+	"1f980", "fca95001ef5ed7b75f1d0b3baf0aaf4d",
+	"1f981", "e23557c7f844b57a30a431444b64af5f",
+	"1f982", "738160272d6024585562a55a39e1cb25",
+	"1f983", "618ca8489ae1ab5f94fde2e3a7fb2c0c",
+	"1f984", "b99b0dd045c527a855a8ee484140cdfe",
+	"1f985", "eefee5ca29d3804705c4c61dc104efb1",
+	"1f986", "5e623785155f790f48901eeb4e5022c9",
+	"1f987", "af70a9ae5dd90f1078e363eab1c855cc",
+	"1f988", "7141e059d1cd75465ac7cdfa2101da72",
+	"1f989", "59e611bd4994d2978d695df90db540c4",
+	"1f98a", "ba34ab28ffd9aeddbce8253362a33dd1",
+	"1f98b", "64342de07ecabe5158112886bccfef45",
+	"1f98c", "a8a497879f296fa124562710db149d98",
+	"1f98d", "e8b3b5a31c0a3c541960bd3ddccc538f",
+	"1f98e", "1703f9a3721beece0d66f10c0ef9d3d0",
+	"1f98f", "1d5443faf52d355d2abd7900449eb5ae",
+	"1f990", "1247f4e6cce47d98b0a08f956fccb4f9",
+	"1f991", "b70c28fda81441dfa331c656b365c61f",
+	"1f992", "d0f34dde89da79643396c7bdc41493bd",
+	"1f993", "536b3e1a4ba312bd793ca96a4d0487f2",
+	"1f994", "222cb7486c4fec7d2602bf456b259f5b",
+	"1f995", "9703ffde881fc89b02b21a419e3f1f2f",
+	"1f996", "367e391010f99cb7ee114f0568ddd80f",
+	"1f997", "6b66d2ae72c692e11209c7b0cf2bb9ef",
+	"1f998", "e6afe8d972ad58432c61bd59e9df132f",
+	"1f999", "fa08c3c8afc72844296f9c00465be77a",
+	"1f99a", "4a5fb8d468c3d4c13216440813feb418",
+	"1f99b", "77c65f388b96be7a8bd2d8334fb546bc",
+	"1f99c", "ef02cd8645bcdff874d23500b2b516fd",
+	"1f99d", "09b38a6de1509c805be67eed340e2f8f",
+	"1f99e", "506e7d0b2e3c6ab21f2e57dd5072cfc3",
+	"1f99f", "4b68db498853957de56d6e5d7cb89ccf",
+	"1f9a0", "c166700764a11c7d30026d4d825b235a",
+	"1f9a1", "82cb4a510436e4755388615426d2de73",
+	"1f9a2", "05d31fd63d5a6a3cef375d3d326a8a0c",
+	"1f9a3", "af5f26288872cf6f0f22ef421fc394cd",
+	"1f9a4", "76174ac71d3cebcc5ec2949e33a2ed25",
+	"1f9a5", "cdc3facd345983768d3bd16aa6021f90",
+	"1f9a6", "a8ef7bbc89c69e7de6d38b6fc1f92dab",
+	"1f9a7", "70e2c1bb3d82f6e009d3aaa6d72b8fc2",
+	"1f9a8", "7631a1b3137202d7b80e48d99987484f",
+	"1f9a9", "430705f097a53695b1bf71c6de22b866",
+	"1f9aa", "2fcf8d7e3940696bb875970845316433",
+	"1f9ab", "0ee00f8f2f780bb43828baa8684991e2",
+	"1f9ac", "4074c31ae3588a394a7a78c5e80c7d98",
+	"1f9ad", "a6e116c71ab2e177001c5ca9977e68d1",
+	"1f9ae", "cbce1272869a42215ef4a4afad1cd85e",
+	"1f9af", "ae2bc7c99cefa62a5ec6c5bebd181de7",
+	"1f9b0", "34d058f310514aa8b38e46d0c9916e01",
+	"1f9b1", "9a89e54569523cfff827e1cb10a4f38a",
+	"1f9b2", "57fba982f0886bb9fa799e2cb199c098",
+	"1f9b3", "7b4a9192e7a487a0803072d7e29b0208",
+	"1f9b4", "dc147b62a179d59b5098c926628ebab1",
+	"1f9b5", "344019c5657ef505cd2458f05f1c89fe",
+	"1f9b5-1f3fb", "d60b98636d4af01fda7cd95d10dad45e",
+	"1f9b5-1f3fc", "b8a3d0b887458fa6193716883a77ea8e",
+	"1f9b5-1f3fd", "1dae4b144aede36560abe2c2d63686a9",
+	"1f9b5-1f3fe", "d7228ec3d0ad6a9f4d2894733fa3c395",
+	"1f9b5-1f3ff", "9f0cf23705daa3432a2615f7b01ff7e1",
+	"1f9b6", "44b6cd0df0c9590310f18b21279b0b31",
+	"1f9b6-1f3fb", "81a61af00a8f7b928e45df627b2e416b",
+	"1f9b6-1f3fc", "8a5903aab23c22ac0f0fd2974a9eb7b8",
+	"1f9b6-1f3fd", "e37bbe76d9b3326ab8bf3e164a8f6b6a",
+	"1f9b6-1f3fe", "90f961e5700e8cc9c7aac41a7708be18",
+	"1f9b6-1f3ff", "2c24a849d3f4b9da6046b923ae7ad024",
+	"1f9b7", "0dc3b6c0f9b69ae7471adc414f6fc0ce",
+	"1f9b8", "05f8af73b85832f48989ab6cecbc1a94",
+	"1f9b8-1f3fb", "0de6c951e72141af9aa66db4fa66ebb5",
+	"1f9b8-1f3fb-200d-2640-fe0f", "921f9127e2adaf5896f665292a532a1c",
+	"1f9b8-1f3fb-200d-2642-fe0f", "a6beb4165bce6281e9e81eff4de526b2",
+	"1f9b8-1f3fc", "b2399885e46e90181cd57527bbc5b758",
+	"1f9b8-1f3fc-200d-2640-fe0f", "6048cd1fcd6f39a58f7c4f279b56bf2b",
+	"1f9b8-1f3fc-200d-2642-fe0f", "e1dd57af31b3be285d1421b5a4d3b6a5",
+	"1f9b8-1f3fd", "5e90e9dd7ba9ea1eb41dd05e3cd6cdce",
+	"1f9b8-1f3fd-200d-2640-fe0f", "463a8a1675322d8b1f0fec6c0c7319af",
+	"1f9b8-1f3fd-200d-2642-fe0f", "f21c159ba9b0ea3952c61b6b7922a812",
+	"1f9b8-1f3fe", "c1b018e1543766df8850eb345a267619",
+	"1f9b8-1f3fe-200d-2640-fe0f", "1bd08eea3a7362eac906ac102683790c",
+	"1f9b8-1f3fe-200d-2642-fe0f", "738caee09b1d8e0795ca4cdbd137bf71",
+	"1f9b8-1f3ff", "217c2cb1a0bf68c94519486846fce117",
+	"1f9b8-1f3ff-200d-2640-fe0f", "abe65f059bc50c17bbfb59c9be2bfc04",
+	"1f9b8-1f3ff-200d-2642-fe0f", "f4a5fd892af6e4cc9ad9f609fe8b2f5b",
+	"1f9b8-200d-2640-fe0f", "566ebbbd1875331374b4bbdf8b872190",
+	"1f9b8-200d-2642-fe0f", "631f7bad4f29ec429d643bfdd15fce4f",
+	"1f9b9", "3dc774bd30a9a61ea4696691c981f7b1",
+	"1f9b9-1f3fb", "8c40928823dc9277cfd02ca2b8cc2887",
+	"1f9b9-1f3fb-200d-2640-fe0f", "1bb70e8bfc45b0a420a122ff0b16eb9a",
+	"1f9b9-1f3fb-200d-2642-fe0f", "31dc8076f1a4563738aaa75e7da8ad07",
+	"1f9b9-1f3fc", "8d7406ed006385766970b934ce4fe8db",
+	"1f9b9-1f3fc-200d-2640-fe0f", "e49d3ba857ecacb1995f5466793dc738",
+	"1f9b9-1f3fc-200d-2642-fe0f", "52228f7fb4c77e37381b40b593dcd41a",
+	"1f9b9-1f3fd", "558931e7dc4f6fab0e67f999e5dfdb11",
+	"1f9b9-1f3fd-200d-2640-fe0f", "93e3beacb013e67287b92d576e74af92",
+	"1f9b9-1f3fd-200d-2642-fe0f", "71ae042caadaddd8562d3e98573b2ed6",
+	"1f9b9-1f3fe", "725dba6e4ec1c6dee66ca6d1ef0e5a47",
+	"1f9b9-1f3fe-200d-2640-fe0f", "104b702022072d5ed76a9b01d2103902",
+	"1f9b9-1f3fe-200d-2642-fe0f", "3a791c5624e8aaa04d781f2f162be633",
+	"1f9b9-1f3ff", "946471265b328f30c4140e1770aae3a8",
+	"1f9b9-1f3ff-200d-2640-fe0f", "7f08432ef93f820b2a6cc9c8e95a86cf",
+	"1f9b9-1f3ff-200d-2642-fe0f", "aa87e8a35dbd298f50f6b2e1c01c5ad2",
+	"1f9b9-200d-2640-fe0f", "fbbb89018ad3b4119e23f2660fd0a363",
+	"1f9b9-200d-2642-fe0f", "e01709322004d85e169d1a720c058c1d",
+	"1f9ba", "df8615a72085735c2d93bdc504c40d8a",
+	"1f9bb", "ff0d16a79f1080221ca5523ac87d9472",
+	"1f9bb-1f3fb", "869b54c2d8a2e806139de55dd60c3723",
+	"1f9bb-1f3fc", "a49752c1a526af4b2612e6207bb188e8",
+	"1f9bb-1f3fd", "7e7c2f74d9a6035f4c301d65335ea16d",
+	"1f9bb-1f3fe", "9ad758fe18b89eefe522295e39de5b5a",
+	"1f9bb-1f3ff", "e53b759073d7c416ab6414f17560bfaa",
+	"1f9bc", "582f0380b96c11bac7b4d3f9159b9535",
+	"1f9bd", "5f99f7506e0a777509a134a0692b1396",
+	"1f9be", "12b7251a9a096c53443ac4efbd01d0a3",
+	"1f9bf", "a8fae04612d4a5ef9c801b49121e69b1",
+	"1f9c0", "90e5e990b28206c9884cb6e73ac0adb0",
+	"1f9c1", "c352b227ece1465d8ae9a67076426898",
+	"1f9c2", "fd72e31198f8ff94f70c826290622af0",
+	"1f9c3", "6bd52eef967d3df855102935a93c9a70",
+	"1f9c4", "e04954175e9c3f3b895c0366ca4363e8",
+	"1f9c5", "dbb5e0a5ac7fcd8f801730e2e3498977",
+	"1f9c6", "a94024f83beca602c5971b8ae6009f75",
+	"1f9c7", "e3585a83e0de8f33dc85d02bba5da810",
+	"1f9c8", "ba1058c9c4297e7358162853663b1cfd",
+	"1f9c9", "c307c164eaccc7381860988b70b38882",
+	"1f9ca", "fd6114328c436e5a111d326d05af3b9a",
+	"1f9cb", "e1fba47252509b8a076f39a3272809f9",
+	"1f9cc", "20e874870eab5f5c6f8ad7c51146d868",
+	"1f9cd", "73abeedf09a85642fc74aecd1e5e4e7c",
+	"1f9cd-1f3fb", "73e98f86f248a793d31f8adea4f54f3f",
+	"1f9cd-1f3fb-200d-2640-fe0f", "63202f68e1ac0ba1447271cab9706014",
+	"1f9cd-1f3fb-200d-2642-fe0f", "e12889fc24ecaf74a65a1d1eae8f4f67",
+	"1f9cd-1f3fc", "7eac84671992bfa137340ab9a44d576d",
+	"1f9cd-1f3fc-200d-2640-fe0f", "e0a7c02a2d2c94f08b71c8c5658bb2a7",
+	"1f9cd-1f3fc-200d-2642-fe0f", "19cbe89e1fb782cc5aa2ade21669984b",
+	"1f9cd-1f3fd", "321d9b915c41d0350130b1a8997528b2",
+	"1f9cd-1f3fd-200d-2640-fe0f", "ca3cbb5662839534e2d34f32b2699c0b",
+	"1f9cd-1f3fd-200d-2642-fe0f", "486cf8d7f6718fa1fc54f85deb3bc407",
+	"1f9cd-1f3fe", "71abae064715bd20f8d6cf8e96a95fe0",
+	"1f9cd-1f3fe-200d-2640-fe0f", "2668287aa85f84e297057d0f74a73506",
+	"1f9cd-1f3fe-200d-2642-fe0f", "7649727186dad39a3f3cce9400204b0d",
+	"1f9cd-1f3ff", "748e649a4da988fb0f0482c9fb817a5f",
+	"1f9cd-1f3ff-200d-2640-fe0f", "75ebaab95b16217831b226a00149f37d",
+	"1f9cd-1f3ff-200d-2642-fe0f", "559149bf1f85eb2e90a0d42e28f84fe4",
+	"1f9cd-200d-2640-fe0f", "c02083bf36e880aa24bdb7b59e14ef35",
+	"1f9cd-200d-2642-fe0f", "410585ccbd3ebe997c3554df996f366e",
+	"1f9ce", "77e04697733e6488d0a50d3e4253fd6f",
+	"1f9ce-1f3fb", "f0e8801de8c9931e8fafb1072898beed",
+	"1f9ce-1f3fb-200d-2640-fe0f", "c499b91d738c7985c96b948da20fba56",
+	"1f9ce-1f3fb-200d-2642-fe0f", "119339667bd1214c447326f20f3b52aa",
+	"1f9ce-1f3fc", "addc625aa551180ad75e7a6c93b71b77",
+	"1f9ce-1f3fc-200d-2640-fe0f", "5056e757024fbc919e0c8faa96116cf5",
+	"1f9ce-1f3fc-200d-2642-fe0f", "0c22a18d2167ef2595a6d4189245fd86",
+	"1f9ce-1f3fd", "cba2759ed08d6e491026957dc9355fd5",
+	"1f9ce-1f3fd-200d-2640-fe0f", "b1ab593c4ba61694c1862522180b0246",
+	"1f9ce-1f3fd-200d-2642-fe0f", "9edc195fff89615456efae4bf13831be",
+	"1f9ce-1f3fe", "5630457097574c48e0969eabdaa2d6b3",
+	"1f9ce-1f3fe-200d-2640-fe0f", "4c94d0176318bc3a0c037a6e3bdb5807",
+	"1f9ce-1f3fe-200d-2642-fe0f", "e176873ec347a8a9d2f5d96bdda0ce1b",
+	"1f9ce-1f3ff", "39d7228c270903d9d7a4c32e6780c6d7",
+	"1f9ce-1f3ff-200d-2640-fe0f", "ffa9dc366506fcfe529cc5ce3859776c",
+	"1f9ce-1f3ff-200d-2642-fe0f", "ed3f8e48b79f552e76da2dfdf5199802",
+	"1f9ce-200d-2640-fe0f", "86867e53eb5517ae45a043a6a1e37011",
+	"1f9ce-200d-2642-fe0f", "5b4bc5143440f3f223f5f6b63c8157cb",
+	"1f9cf", "66721fdc43f4a0c307d365419bb51427",
+	"1f9cf-1f3fb", "acfc26c8e8e514e8279915ca433c09f7",
+	"1f9cf-1f3fb-200d-2640-fe0f", "3082440dee2a905555745a331adc8dd0",
+	"1f9cf-1f3fb-200d-2642-fe0f", "82abeb872e7ac1754264b537d0b553a2",
+	"1f9cf-1f3fc", "fd9cf68056afa31bfb30b286e800fda8",
+	"1f9cf-1f3fc-200d-2640-fe0f", "2d1f96f2bb72af799af9833af1877b8f",
+	"1f9cf-1f3fc-200d-2642-fe0f", "6c6c29554124e34f12f57ed8658cb17c",
+	"1f9cf-1f3fd", "87519b349e326690e670b2e7d75126fb",
+	"1f9cf-1f3fd-200d-2640-fe0f", "abd547d16691bb4cf2b672a38346bf74",
+	"1f9cf-1f3fd-200d-2642-fe0f", "e95ce26dfe8807ab3bb23a72df74b55d",
+	"1f9cf-1f3fe", "25a5193f696bf65278fbc9f67409f4bf",
+	"1f9cf-1f3fe-200d-2640-fe0f", "7b85f8b9b4ba98e4e99b7f7165aae56d",
+	"1f9cf-1f3fe-200d-2642-fe0f", "42cb4469dba26c8b42643a9edd35f6bf",
+	"1f9cf-1f3ff", "19b82de2252962b76a3635fa4d78bcf3",
+	"1f9cf-1f3ff-200d-2640-fe0f", "c39b13d719be43dafe171819b663bb83",
+	"1f9cf-1f3ff-200d-2642-fe0f", "e3a6c1190deae2b43d7f630fd3f70cc9",
+	"1f9cf-200d-2640-fe0f", "e1ee238d98c423480edc11549686ac23",
+	"1f9cf-200d-2642-fe0f", "4bf7d5f6e5474fc9b572926d7695834e",
+	"1f9d0", "ece6283544a703f158b497507aae3b62",
+	"1f9d1", "5c0074506e0e63ae5ac8646fa17910c0",
+	"1f9d1-1f3fb", "a73d24769c0b06c6d9860daf5a15aeb3",
+	"1f9d1-1f3fb-200d-1f33e", "4356c77eb1af446256d222ebae04ad7f",
+	"1f9d1-1f3fb-200d-1f373", "eef772525d54c119e1553e60c8a84243",
+	"1f9d1-1f3fb-200d-1f37c", "29ad8629df2cc75f59f77a79ee05aad6",
+	"1f9d1-1f3fb-200d-1f384", "d17400e4c6792c33206589776f978c48",
+	"1f9d1-1f3fb-200d-1f393", "7c9c3e401c06e91c678f67e970ea42b9",
+	"1f9d1-1f3fb-200d-1f3a4", "531adcd0cd883e35973652e748bd68c4",
+	"1f9d1-1f3fb-200d-1f3a8", "9b3d0c9513525e7fb3298ad7a4157b8c",
+	"1f9d1-1f3fb-200d-1f3eb", "841a6fdb897dfaa822965530fd035956",
+	"1f9d1-1f3fb-200d-1f3ed", "ec51ae340dc3e445302637ee8b15874e",
+	"1f9d1-1f3fb-200d-1f4bb", "bf6dc913a5e692db30b9d7f0a1dcaa22",
+	"1f9d1-1f3fb-200d-1f4bc", "edd44743176dd0763381fa7dc847ffb0",
+	"1f9d1-1f3fb-200d-1f527", "93d777513c3dd0a15a5451bfc893e0ba",
+	"1f9d1-1f3fb-200d-1f52c", "b2d694d90e16423128a9586fafa52260",
+	"1f9d1-1f3fb-200d-1f680", "0677926f6dc0c52f48f1e8a36cd38af2",
+	"1f9d1-1f3fb-200d-1f692", "4119762945206f4236886e5c4a5c107c",
+	"1f9d1-1f3fb-200d-1f91d-200d-1f9d1-1f3fb", "8543ce5f23ba63dac6bddcc1211676eb",
+	"1f9d1-1f3fb-200d-1f91d-200d-1f9d1-1f3fc", "fbab8540f076ee98c588c7b0bef67dbb",
+	"1f9d1-1f3fb-200d-1f91d-200d-1f9d1-1f3fd", "188c11d69d27bbbb30184fdb00952cfa",
+	"1f9d1-1f3fb-200d-1f91d-200d-1f9d1-1f3fe", "ff51bed1207a8a0bf952deb79765f4e0",
+	"1f9d1-1f3fb-200d-1f91d-200d-1f9d1-1f3ff", "cc10fda83519443ee3a9244d4fa93771",
+	"1f9d1-1f3fb-200d-1f9af", "a81460f39407665a3c48497c6759ed33",
+	"1f9d1-1f3fb-200d-1f9b0", "2adf3bb7b175d74a32066a3ca9746e82",
+	"1f9d1-1f3fb-200d-1f9b1", "b6062290347938ce3eb39357abc959e7",
+	"1f9d1-1f3fb-200d-1f9b2", "783c1a074ccf74705bd13ff3c19b0eb6",
+	"1f9d1-1f3fb-200d-1f9b3", "888bf94a4d5db30a4c7f1f92e9ac469d",
+	"1f9d1-1f3fb-200d-1f9bc", "6d231e4fbc4281e15867601c1571106d",
+	"1f9d1-1f3fb-200d-1f9bd", "99de91eeaac103c0d49c2815ec398497",
+	"1f9d1-1f3fb-200d-2695-fe0f", "d6a04cee9857c066da10e87dc8f20c1c",
+	"1f9d1-1f3fb-200d-2696-fe0f", "58d0bca9d878af0d7738dc122a25cc6b",
+	"1f9d1-1f3fb-200d-2708-fe0f", "1d9ae008740995a8cd1fcb98f992c3cd",
+	"1f9d1-1f3fb-200d-2764-fe0f-200d-1f48b-200d-1f9d1-1f3fc", "273ca4795f28a152dff2a7b03194e5e7",
+	"1f9d1-1f3fb-200d-2764-fe0f-200d-1f48b-200d-1f9d1-1f3fd", "b3d19aa8a5d139cd0bc7856488bdf962",
+	"1f9d1-1f3fb-200d-2764-fe0f-200d-1f48b-200d-1f9d1-1f3fe", "1490386fa66943527ddfad572a54d142",
+	"1f9d1-1f3fb-200d-2764-fe0f-200d-1f48b-200d-1f9d1-1f3ff", "13a77ba4c447e225d4a8cfd1ad2a1e4e",
+	"1f9d1-1f3fb-200d-2764-fe0f-200d-1f9d1-1f3fc", "465e60222d0d83fd1f6ed99ba2942dc1",
+	"1f9d1-1f3fb-200d-2764-fe0f-200d-1f9d1-1f3fd", "2d9099197cb69de9afb3e9066dbc617e",
+	"1f9d1-1f3fb-200d-2764-fe0f-200d-1f9d1-1f3fe", "630ddb0c3c8115160d8243edc0440cf0",
+	"1f9d1-1f3fb-200d-2764-fe0f-200d-1f9d1-1f3ff", "b663e0f06d37cbe1a0a2292a94a53091",
+	"1f9d1-1f3fc", "d0b8a0832b9923f8d6503f17726f8f0a",
+	"1f9d1-1f3fc-200d-1f33e", "c8b1650899d86b013f8901a03bad0adb",
+	"1f9d1-1f3fc-200d-1f373", "5ec317cd32c4a0b2a3a906e88d702831",
+	"1f9d1-1f3fc-200d-1f37c", "d494ec5a45c271ec3d6578282b8e3e55",
+	"1f9d1-1f3fc-200d-1f384", "7c0bf82e902c79582358fe5fab49717e",
+	"1f9d1-1f3fc-200d-1f393", "ed1bda94dd4968e840212c5f851ab18d",
+	"1f9d1-1f3fc-200d-1f3a4", "0cd96fdfb7508de5110a75c63e013ed3",
+	"1f9d1-1f3fc-200d-1f3a8", "e8d1da792b6eeeb0a2c36c38e9e807b4",
+	"1f9d1-1f3fc-200d-1f3eb", "b5412e659b7b29a4aa35a041382b067e",
+	"1f9d1-1f3fc-200d-1f3ed", "bc99aacd77d22e205c77c9b0f651e2a0",
+	"1f9d1-1f3fc-200d-1f4bb", "f92a4c1da8927a4939ca2b5ab17ac0c8",
+	"1f9d1-1f3fc-200d-1f4bc", "19dcd7845842cb6510c9703c04d3fde8",
+	"1f9d1-1f3fc-200d-1f527", "824f59ab90343591fcf42e4d72beb253",
+	"1f9d1-1f3fc-200d-1f52c", "b2f8f407e3ebcdaef918bd214395c0d0",
+	"1f9d1-1f3fc-200d-1f680", "7ec73675290b77a81258bd65597eb0af",
+	"1f9d1-1f3fc-200d-1f692", "69a90c6a79b354f0b737787792f676e9",
+	"1f9d1-1f3fc-200d-1f91d-200d-1f9d1-1f3fb", "748a6202cc32eabe376fe9a964444746",
+	"1f9d1-1f3fc-200d-1f91d-200d-1f9d1-1f3fc", "05c923c314f34767d8fe3407539fafd7",
+	"1f9d1-1f3fc-200d-1f91d-200d-1f9d1-1f3fd", "3e5edc2b947fd0b7d72559aecba29c78",
+	"1f9d1-1f3fc-200d-1f91d-200d-1f9d1-1f3fe", "3c28b7352aa42712ae9d5f94d3af098b",
+	"1f9d1-1f3fc-200d-1f91d-200d-1f9d1-1f3ff", "997f376b9ea3db8110d87d0f517cbb79",
+	"1f9d1-1f3fc-200d-1f9af", "3d5ad6981f2f6e46c520bceb22b149f6",
+	"1f9d1-1f3fc-200d-1f9b0", "3cc7d653b4640955b02090d38d28fe0d",
+	"1f9d1-1f3fc-200d-1f9b1", "2286598e236171ae19a24c76917d2a58",
+	"1f9d1-1f3fc-200d-1f9b2", "c58aaa5810f9ccb88087243990600c9c",
+	"1f9d1-1f3fc-200d-1f9b3", "258667558371a25412e0b99fa84d9a57",
+	"1f9d1-1f3fc-200d-1f9bc", "820126bce06d70b9f4dc6df60092324b",
+	"1f9d1-1f3fc-200d-1f9bd", "a49d667d740c88f4a6470d7705724bc3",
+	"1f9d1-1f3fc-200d-2695-fe0f", "0e0bf498e347a1a79d43a085c565b3a0",
+	"1f9d1-1f3fc-200d-2696-fe0f", "54ae65452fe0bf838b68659d80844af8",
+	"1f9d1-1f3fc-200d-2708-fe0f", "958a8f70896025ec9d3521cc7e05284a",
+	"1f9d1-1f3fc-200d-2764-fe0f-200d-1f48b-200d-1f9d1-1f3fb", "06b7e2d6931a2cc2f67e0de9e145f9ec",
+	"1f9d1-1f3fc-200d-2764-fe0f-200d-1f48b-200d-1f9d1-1f3fd", "089866c94c221d84038f81a8ae29879d",
+	"1f9d1-1f3fc-200d-2764-fe0f-200d-1f48b-200d-1f9d1-1f3fe", "5f7952d702a76f4a5cea36f3a5240ef6",
+	"1f9d1-1f3fc-200d-2764-fe0f-200d-1f48b-200d-1f9d1-1f3ff", "aa1ea48ff20d312626d1ebaf681d4b98",
+	"1f9d1-1f3fc-200d-2764-fe0f-200d-1f9d1-1f3fb", "315034a75dbe8aa74721aeb4dfdcfb5e",
+	"1f9d1-1f3fc-200d-2764-fe0f-200d-1f9d1-1f3fd", "fe5f1e259640668521fb25632e4fa1d7",
+	"1f9d1-1f3fc-200d-2764-fe0f-200d-1f9d1-1f3fe", "d5d77f1d63ac1db259edeab7ab70ffb1",
+	"1f9d1-1f3fc-200d-2764-fe0f-200d-1f9d1-1f3ff", "d09a48de7b5ac236450541c3e67dcbd9",
+	"1f9d1-1f3fd", "0f9872f8ec8d1a50ffb3629f0beaace0",
+	"1f9d1-1f3fd-200d-1f33e", "084026309fc35e622f05808338a0409b",
+	"1f9d1-1f3fd-200d-1f373", "311d8b2b1f3455b92853cba6f4759aec",
+	"1f9d1-1f3fd-200d-1f37c", "13e0e57c8dad4724196fbae5b3c910f6",
+	"1f9d1-1f3fd-200d-1f384", "020efad3516a39bc3c1c2895d409bae0",
+	"1f9d1-1f3fd-200d-1f393", "d8cf2d9d9dbc2721f72f82f9aec28915",
+	"1f9d1-1f3fd-200d-1f3a4", "5a694b91cdc6d788471e4374656a3c34",
+	"1f9d1-1f3fd-200d-1f3a8", "ab686ee048676cb10ef6bed31028bbbb",
+	"1f9d1-1f3fd-200d-1f3eb", "05ac7df4433679c033ae294dbd52ea77",
+	"1f9d1-1f3fd-200d-1f3ed", "8341b12f960ef71ab88c2205dc20e3b5",
+	"1f9d1-1f3fd-200d-1f4bb", "9fe7f7a0f889d824d0edd80dbc7817ef",
+	"1f9d1-1f3fd-200d-1f4bc", "21c832e86d9f919dd2dcb24f554f669f",
+	"1f9d1-1f3fd-200d-1f527", "ac3b136b3b6bbfb0133c041e4a462666",
+	"1f9d1-1f3fd-200d-1f52c", "3dc6f59212cc300c47922c924d2cd6e7",
+	"1f9d1-1f3fd-200d-1f680", "9978f314798de31db888d3eeb03cd601",
+	"1f9d1-1f3fd-200d-1f692", "205ab18fba44f75adec7ade713714448",
+	"1f9d1-1f3fd-200d-1f91d-200d-1f9d1-1f3fb", "ec0ab2ce7d754011d689a252f7dd414c",
+	"1f9d1-1f3fd-200d-1f91d-200d-1f9d1-1f3fc", "e07d98c4f004957c7490a273a655a218",
+	"1f9d1-1f3fd-200d-1f91d-200d-1f9d1-1f3fd", "473240e807378a8c6d8b44217fb27cf0",
+	"1f9d1-1f3fd-200d-1f91d-200d-1f9d1-1f3fe", "461898562cf02f76b548df5db5572c28",
+	"1f9d1-1f3fd-200d-1f91d-200d-1f9d1-1f3ff", "0d5d3041235b53244489495c33c4b004",
+	"1f9d1-1f3fd-200d-1f9af", "dd8e95845e37e85641c2e97e3b9b05bd",
+	"1f9d1-1f3fd-200d-1f9b0", "169c33f365bca0627616b7893a872d45",
+	"1f9d1-1f3fd-200d-1f9b1", "854a94f915b537b447db3681d31c9979",
+	"1f9d1-1f3fd-200d-1f9b2", "df3fe20a2d6350e0bc40e28c06eacc26",
+	"1f9d1-1f3fd-200d-1f9b3", "aa784ccd749e63a51b77561335401862",
+	"1f9d1-1f3fd-200d-1f9bc", "240c67c8b34eb0b1da173167cf4e3ff4",
+	"1f9d1-1f3fd-200d-1f9bd", "2b650edb4b64e1e13b3796ab3272e146",
+	"1f9d1-1f3fd-200d-2695-fe0f", "fa1b46fba925da954a515f98d45df879",
+	"1f9d1-1f3fd-200d-2696-fe0f", "14a3e7e534dd164c2f58e4e398013558",
+	"1f9d1-1f3fd-200d-2708-fe0f", "0decafe5dc69d5efd8e9d85a3e8dd4b8",
+	"1f9d1-1f3fd-200d-2764-fe0f-200d-1f48b-200d-1f9d1-1f3fb", "97547ca6617ddc8ef3a45d5c8e2de96d",
+	"1f9d1-1f3fd-200d-2764-fe0f-200d-1f48b-200d-1f9d1-1f3fc", "336770e0b07d60707d7de49d0eaa4e62",
+	"1f9d1-1f3fd-200d-2764-fe0f-200d-1f48b-200d-1f9d1-1f3fe", "2490b0be4d6dce751b698f42df1ed467",
+	"1f9d1-1f3fd-200d-2764-fe0f-200d-1f48b-200d-1f9d1-1f3ff", "8bd0260d29b2d69fe5598de2735ffa1d",
+	"1f9d1-1f3fd-200d-2764-fe0f-200d-1f9d1-1f3fb", "df185ec77a8f18d29a072e6339ebe631",
+	"1f9d1-1f3fd-200d-2764-fe0f-200d-1f9d1-1f3fc", "14ad2ccd91f78bee3fabb67e5f181bd4",
+	"1f9d1-1f3fd-200d-2764-fe0f-200d-1f9d1-1f3fe", "dab0923cee94ebcdb78cddaecb85c60c",
+	"1f9d1-1f3fd-200d-2764-fe0f-200d-1f9d1-1f3ff", "3980b3bb3869cad54445356af9f5eedd",
+	"1f9d1-1f3fe", "a786b886d349738b8e08aa4067ad5432",
+	"1f9d1-1f3fe-200d-1f33e", "525a706a0f873ef83938c39e4936433c",
+	"1f9d1-1f3fe-200d-1f373", "dca5ff1fc04255389eff0028e921aed3",
+	"1f9d1-1f3fe-200d-1f37c", "4f45c7167095f74ff59327de4d3e0115",
+	"1f9d1-1f3fe-200d-1f384", "8423bce212a5ec2a01e93b0e1a9bfdac",
+	"1f9d1-1f3fe-200d-1f393", "a741876d6de3ace454279e7bc1014f99",
+	"1f9d1-1f3fe-200d-1f3a4", "ae5c58a0732f801b7f26584475ee63df",
+	"1f9d1-1f3fe-200d-1f3a8", "25cc6d4537d878e2b680a4913d81de58",
+	"1f9d1-1f3fe-200d-1f3eb", "77b1f303ad1b889cd99bc45828279779",
+	"1f9d1-1f3fe-200d-1f3ed", "8438e67e2762f22eda9197b6936ded0c",
+	"1f9d1-1f3fe-200d-1f4bb", "bcc6d32784c3b7f77b11e1ec97eda415",
+	"1f9d1-1f3fe-200d-1f4bc", "ca339caee3a5018d531f70039a8e47ab",
+	"1f9d1-1f3fe-200d-1f527", "6fadcebd5005f543f26beb15258f3055",
+	"1f9d1-1f3fe-200d-1f52c", "7a2e70981a3be9ccc980f103f5fd8a8e",
+	"1f9d1-1f3fe-200d-1f680", "5429e945618edca98786285bdc86bdc2",
+	"1f9d1-1f3fe-200d-1f692", "592c78280bc68c0ea17a35685149eedb",
+	"1f9d1-1f3fe-200d-1f91d-200d-1f9d1-1f3fb", "78d0a8e2a4c121724b086a1a30383e91",
+	"1f9d1-1f3fe-200d-1f91d-200d-1f9d1-1f3fc", "fbd8c954691668ceada3c475755ca35f",
+	"1f9d1-1f3fe-200d-1f91d-200d-1f9d1-1f3fd", "46c22458ecaa66eda7d211985c1cc38c",
+	"1f9d1-1f3fe-200d-1f91d-200d-1f9d1-1f3fe", "2938c2e15bd71bbd53b1744f66b65298",
+	"1f9d1-1f3fe-200d-1f91d-200d-1f9d1-1f3ff", "800b259acb8babe76fad9242ded9686c",
+	"1f9d1-1f3fe-200d-1f9af", "1d12317c94b1516fcf6195892ffb79a1",
+	"1f9d1-1f3fe-200d-1f9b0", "06bf204263f1a2a8ed2e9dd9cd0611ad",
+	"1f9d1-1f3fe-200d-1f9b1", "5f6a6088b5dea1dff1f2212699c49588",
+	"1f9d1-1f3fe-200d-1f9b2", "c47155ce36a3818b1296b7a380654160",
+	"1f9d1-1f3fe-200d-1f9b3", "2207de84a1c8ae9b6448d467ed79b3ac",
+	"1f9d1-1f3fe-200d-1f9bc", "33e7959a132a39691251575eb14fba5f",
+	"1f9d1-1f3fe-200d-1f9bd", "e6cad252ff67fc8d8cc75df503881945",
+	"1f9d1-1f3fe-200d-2695-fe0f", "58c3a6da03c303d5ae6647c79d30644a",
+	"1f9d1-1f3fe-200d-2696-fe0f", "d01333cb5f4163574473c2772dcce114",
+	"1f9d1-1f3fe-200d-2708-fe0f", "f7e36fb56623a083b6740eb3d048e980",
+	"1f9d1-1f3fe-200d-2764-fe0f-200d-1f48b-200d-1f9d1-1f3fb", "3a6894097226116ae7ce2035ef6f0f2b",
+	"1f9d1-1f3fe-200d-2764-fe0f-200d-1f48b-200d-1f9d1-1f3fc", "b27aba22564a48c9d85f0073265cf3f9",
+	"1f9d1-1f3fe-200d-2764-fe0f-200d-1f48b-200d-1f9d1-1f3fd", "9610d74cb56fab27bbd870b00dba930d",
+	"1f9d1-1f3fe-200d-2764-fe0f-200d-1f48b-200d-1f9d1-1f3ff", "422076b3e766399025beddbf34dde7e4",
+	"1f9d1-1f3fe-200d-2764-fe0f-200d-1f9d1-1f3fb", "d9f2531c2cfc039ca8a7786be16b9610",
+	"1f9d1-1f3fe-200d-2764-fe0f-200d-1f9d1-1f3fc", "1f92cd9b15ee350b93de37c9e3840532",
+	"1f9d1-1f3fe-200d-2764-fe0f-200d-1f9d1-1f3fd", "8d28eb881c117ccb2a48035547ba398b",
+	"1f9d1-1f3fe-200d-2764-fe0f-200d-1f9d1-1f3ff", "53e66c5bbeb959299107e8b3d7547653",
+	"1f9d1-1f3ff", "ded81139c0261716ede8918707ad348b",
+	"1f9d1-1f3ff-200d-1f33e", "d9b5c45899c71032c16bb042ea1de7ec",
+	"1f9d1-1f3ff-200d-1f373", "062a37466828befbdc22dd6c70d2f26e",
+	"1f9d1-1f3ff-200d-1f37c", "38872839eea424e113f10cf5453f5678",
+	"1f9d1-1f3ff-200d-1f384", "d13dc77dc8c3436ed7f1043b46f8cca4",
+	"1f9d1-1f3ff-200d-1f393", "878332fac1464007550a6a4c274006d7",
+	"1f9d1-1f3ff-200d-1f3a4", "7f6c9f9a3e7917478ac9112c1e8422df",
+	"1f9d1-1f3ff-200d-1f3a8", "4217b412e24e781846d918e103c3bb72",
+	"1f9d1-1f3ff-200d-1f3eb", "6cec76c494bfa3ae46035e0edcb45c12",
+	"1f9d1-1f3ff-200d-1f3ed", "70fc307ed438ce746e63b71c33d969c4",
+	"1f9d1-1f3ff-200d-1f4bb", "1a8a376f7e86e3d77251955392d9ef98",
+	"1f9d1-1f3ff-200d-1f4bc", "366bb47156c87549462a61b06721fc9b",
+	"1f9d1-1f3ff-200d-1f527", "b4f9d962e098ce611bb3349342aba005",
+	"1f9d1-1f3ff-200d-1f52c", "584bfae16a8ff73d414eead25fa370c5",
+	"1f9d1-1f3ff-200d-1f680", "4631087c5fb54661ba4d01ae71cace60",
+	"1f9d1-1f3ff-200d-1f692", "34716f2b22e852b8e641dc91cdbcba63",
+	"1f9d1-1f3ff-200d-1f91d-200d-1f9d1-1f3fb", "5212f2403f865e103f3cd808bbcce400",
+	"1f9d1-1f3ff-200d-1f91d-200d-1f9d1-1f3fc", "2d241fa4cac39d7222f03734abb3ece5",
+	"1f9d1-1f3ff-200d-1f91d-200d-1f9d1-1f3fd", "1f70a48bf27298da2a1caca7ea85f6e9",
+	"1f9d1-1f3ff-200d-1f91d-200d-1f9d1-1f3fe", "2af61c8a3e58bd22f440e3a7dd012110",
+	"1f9d1-1f3ff-200d-1f91d-200d-1f9d1-1f3ff", "c3a730e5d5606554d6567037503bbc43",
+	"1f9d1-1f3ff-200d-1f9af", "fc2fb2fd0060b46fae5d58160496989a",
+	"1f9d1-1f3ff-200d-1f9b0", "9328f9d82a1136eccac429840d5935a9",
+	"1f9d1-1f3ff-200d-1f9b1", "7e4d508daee769febfe542ad0e016215",
+	"1f9d1-1f3ff-200d-1f9b2", "c72c77a0d6cc0c48e92fd0edb239acd0",
+	"1f9d1-1f3ff-200d-1f9b3", "e6e9e7a405bb146e88e0f8a3aec6bb02",
+	"1f9d1-1f3ff-200d-1f9bc", "46436dabe2908b75088f509c3f91b03c",
+	"1f9d1-1f3ff-200d-1f9bd", "815627ecfc88d10dfac326e354f8f316",
+	"1f9d1-1f3ff-200d-2695-fe0f", "53a70520c771d871cf02c51b70f73b13",
+	"1f9d1-1f3ff-200d-2696-fe0f", "d24b5e815b39b61c4bf747b0315f7af3",
+	"1f9d1-1f3ff-200d-2708-fe0f", "9629329d15f85294668d2f310829e4a6",
+	"1f9d1-1f3ff-200d-2764-fe0f-200d-1f48b-200d-1f9d1-1f3fb", "fd0a3e77df584b4e6f6c54fbbda9587e",
+	"1f9d1-1f3ff-200d-2764-fe0f-200d-1f48b-200d-1f9d1-1f3fc", "23c5f5338914dafeee68394a64245728",
+	"1f9d1-1f3ff-200d-2764-fe0f-200d-1f48b-200d-1f9d1-1f3fd", "ee93f48b12afe35a6ad16d6192494538",
+	"1f9d1-1f3ff-200d-2764-fe0f-200d-1f48b-200d-1f9d1-1f3fe", "b1d8d9e6cfa2923ea83ef9c7abd4dc80",
+	"1f9d1-1f3ff-200d-2764-fe0f-200d-1f9d1-1f3fb", "05d8a9d4718ec0d5788f99343f33cbc4",
+	"1f9d1-1f3ff-200d-2764-fe0f-200d-1f9d1-1f3fc", "d90c4b051025851e8bd62168c6921aa1",
+	"1f9d1-1f3ff-200d-2764-fe0f-200d-1f9d1-1f3fd", "a1f68ff56f790c80061ff73bb3bdb470",
+	"1f9d1-1f3ff-200d-2764-fe0f-200d-1f9d1-1f3fe", "e72c8568f7e87d55dfb2fd6db9e5337f",
+	"1f9d1-200d-1f33e", "82a5610e8450aa63d835b60cd13e1e45",
+	"1f9d1-200d-1f373", "43a762ae5038e4e28d7fdfb4231132fb",
+	"1f9d1-200d-1f37c", "bf79ec13ba711cee8f696f69dad70906",
+	"1f9d1-200d-1f384", "07df9e164392126108fbd370a3af1421",
+	"1f9d1-200d-1f393", "f332ef3d172d77d4c641eb65a49bc4a8",
+	"1f9d1-200d-1f3a4", "4ab5e1a69cab55e1ec0fda9306acd479",
+	"1f9d1-200d-1f3a8", "c2d98046d6f9b642f56a494bcbc76321",
+	"1f9d1-200d-1f3eb", "202dcb7b0453a308167e21fc7a3a06d3",
+	"1f9d1-200d-1f3ed", "99bd938af7bd3d769c0d8d514013e58a",
+	"1f9d1-200d-1f4bb", "c210245001be9e8dcc7e334e9e94043d",
+	"1f9d1-200d-1f4bc", "2bc856b4eb32bacb668f4844d8e7233f",
+	"1f9d1-200d-1f527", "e4d803c3bb0f8a5c8e2d37b6bfa7a1c1",
+	"1f9d1-200d-1f52c", "da5e3a22d3d7e5bb5caec6a2de5dd035",
+	"1f9d1-200d-1f680", "b624d405f4e23cc021e045efae40ece4",
+	"1f9d1-200d-1f692", "da2e32efbdd18f01572f8904bd33a131",
+	"1f9d1-200d-1f91d-200d-1f9d1", "3d11a69902e23f2dc1c01176e22135d3",
+	"1f9d1-200d-1f9af", "39c113ca451fa1a8f6f8626b2a246c8b",
+	"1f9d1-200d-1f9b0", "8814ba388970278862c579833a43516a",
+	"1f9d1-200d-1f9b1", "0ac11febca8bfde20516f5139d93e46b",
+	"1f9d1-200d-1f9b2", "bb0ed620695b35f1450b623617ffc0b0",
+	"1f9d1-200d-1f9b3", "f74bfb2a28c708ed3c3832c720ee5e0e",
+	"1f9d1-200d-1f9bc", "9f216159df2c12370101e55dc5dc6862",
+	"1f9d1-200d-1f9bd", "e3eb745d92a5175aab529edb2e7d3248",
+	"1f9d1-200d-2695-fe0f", "e185e1a81329abffe479ea0a59650f11",
+	"1f9d1-200d-2696-fe0f", "581744e66dae5ebd54b563d527478f93",
+	"1f9d1-200d-2708-fe0f", "01aa92966a7d5cde4f0c9370dd84ba9c",
+	"1f9d2", "e361b8723c20d3049457fd449a2cf0ce",
+	"1f9d2-1f3fb", "7222f385d5ec496cc4bb0308e1153879",
+	"1f9d2-1f3fc", "81286b8e5b76e65d7f03c3ffaaef5ec0",
+	"1f9d2-1f3fd", "0be0403c674552b9cc5dfbf4f9d42c15",
+	"1f9d2-1f3fe", "f157cbac452bf9ae53d7aa1073cb78f6",
+	"1f9d2-1f3ff", "cece3d1439975e3678bb956fd99a6b16",
+	"1f9d3", "2861f278b896dc3b7734b2b2e07b3fa1",
+	"1f9d3-1f3fb", "3da7a9607b726ffb4947c7a07c822800",
+	"1f9d3-1f3fc", "eaf0846edd962b9c41baf6675f8e8756",
+	"1f9d3-1f3fd", "26d9aeef49a0e48315a6668435d388fa",
+	"1f9d3-1f3fe", "47df200fb8534471e8ca1290bd600a98",
+	"1f9d3-1f3ff", "973da4215ff91a11314b977449b93400",
+	"1f9d4", "9d1b5445bc3ad1deb1c1c262e8a9dfd4",
+	"1f9d4-1f3fb", "2d60ccc91929e8ea8fa313a0728f367c",
+	"1f9d4-1f3fb-200d-2640-fe0f", "f9403047f472b9b8ca3b53230af3b5f4",
+	"1f9d4-1f3fb-200d-2642-fe0f", "653a8e1d8e8736fbe8a01d1d0599a7b4",
+	"1f9d4-1f3fc", "e44b7408dce2a801347a5648fbaa13aa",
+	"1f9d4-1f3fc-200d-2640-fe0f", "0e3649e174bc3392b5b9bc46545ebb33",
+	"1f9d4-1f3fc-200d-2642-fe0f", "b5768a9acaa6944a8d61754c2333132f",
+	"1f9d4-1f3fd", "42b83fa035b51cc78967d6397b30f112",
+	"1f9d4-1f3fd-200d-2640-fe0f", "05280ec44dc3b1589a0de2f4f9f3c6e5",
+	"1f9d4-1f3fd-200d-2642-fe0f", "e67b8fcfcc7e2dac5f450a1774a16dca",
+	"1f9d4-1f3fe", "683f13c51040cf0f943448e5206135f8",
+	"1f9d4-1f3fe-200d-2640-fe0f", "427d2c94f1e6c1b749612cc12f11df7f",
+	"1f9d4-1f3fe-200d-2642-fe0f", "fb85748cbf6237665a63d0be4a86e87f",
+	"1f9d4-1f3ff", "aa7ee66a0b4a196350679d2a33ea1f37",
+	"1f9d4-1f3ff-200d-2640-fe0f", "ddd646b579a0e1211ac528f322a81d7c",
+	"1f9d4-1f3ff-200d-2642-fe0f", "4e2cfb47767914d79f828f0d034a314e",
+	"1f9d4-200d-2640-fe0f", "c0c269468c9c961894e2271efad76cdf",
+	"1f9d4-200d-2642-fe0f", "43910a2315d80e54680078da1d9bee20",
+	"1f9d5", "2f200f77ba6ea3a96b4db91d29005749",
+	"1f9d5-1f3fb", "2f36b16efce2273fd8af875aa24aca43",
+	"1f9d5-1f3fc", "c047791b5f0a0e09206289fbdbc19c9a",
+	"1f9d5-1f3fd", "94224b47cd686845e22a8c15586fe445",
+	"1f9d5-1f3fe", "851e1dcf23d9d8efc250d49370f2a754",
+	"1f9d5-1f3ff", "216b91a03b445bdb3751e631a5a608ff",
+	"1f9d6", "c9780594998f0ee4f3d6f224b03c9ee5",
+	"1f9d6-1f3fb", "ca3dcef2e67de1e096ee19cd24eb6702",
+	"1f9d6-1f3fb-200d-2640-fe0f", "7c61a4efbcb172a107757cd9d29ceeb2",
+	"1f9d6-1f3fb-200d-2642-fe0f", "58d2d618b428d10337d11bcf53ac9abd",
+	"1f9d6-1f3fc", "91e7b21f2e0e5bd00cb9398219bafff5",
+	"1f9d6-1f3fc-200d-2640-fe0f", "4f486e912b84eb81b42219bb01b8f118",
+	"1f9d6-1f3fc-200d-2642-fe0f", "e0383021231d746c9a0596aba8c0b840",
+	"1f9d6-1f3fd", "433eab58ecd0deccffc57c8860040b02",
+	"1f9d6-1f3fd-200d-2640-fe0f", "2e61c60deca6f1a1b9889224218c0374",
+	"1f9d6-1f3fd-200d-2642-fe0f", "d644ae3886379900a2aad80d97732117",
+	"1f9d6-1f3fe", "3bc8dd485118ee6ca56f5a9e30893968",
+	"1f9d6-1f3fe-200d-2640-fe0f", "4addb81fa0b6e0275190c85c6569784d",
+	"1f9d6-1f3fe-200d-2642-fe0f", "7be6df4cb1ea8762760d66931f6819d2",
+	"1f9d6-1f3ff", "e94ec80b0fab970d1343354e48d57496",
+	"1f9d6-1f3ff-200d-2640-fe0f", "4170cde17128fe667d5efb755880b04a",
+	"1f9d6-1f3ff-200d-2642-fe0f", "5291f48639cb101a73063a600c6e8f0c",
+	"1f9d6-200d-2640-fe0f", "9a77b4a345faab00f882f3827e9c9267",
+	"1f9d6-200d-2642-fe0f", "0b5bcef820dd9f3d67744097c286ce3e",
+	"1f9d7", "a8a074cc55eb537493539db348aab9a6",
+	"1f9d7-1f3fb", "3dc636a2d49a04415f0f9c96bcca8e8a",
+	"1f9d7-1f3fb-200d-2640-fe0f", "3db812dc6d0cbd195764611e35c6af03",
+	"1f9d7-1f3fb-200d-2642-fe0f", "02207416446aa886a5381f88f3182059",
+	"1f9d7-1f3fc", "5f84d46b37e0b88c473a024ff0347300",
+	"1f9d7-1f3fc-200d-2640-fe0f", "23d7a64c784e502b9ecd92d0f9c08d71",
+	"1f9d7-1f3fc-200d-2642-fe0f", "a328aaa6f4f382926fa495bf3ba92afd",
+	"1f9d7-1f3fd", "ebe85522fa1e73d7200f78470a231068",
+	"1f9d7-1f3fd-200d-2640-fe0f", "d829687b546ae8a31adb8c45241ecb64",
+	"1f9d7-1f3fd-200d-2642-fe0f", "e844240dc2411be5439c1326fe46e7c4",
+	"1f9d7-1f3fe", "01b8ee039e18e0901cfc499bc71c6990",
+	"1f9d7-1f3fe-200d-2640-fe0f", "84e3577814a6e335c45f1d766d54a4b0",
+	"1f9d7-1f3fe-200d-2642-fe0f", "ad1074c0702656fec1fce44211e26eaa",
+	"1f9d7-1f3ff", "8f7170461d8ea91ec4127aa5b3c6723a",
+	"1f9d7-1f3ff-200d-2640-fe0f", "94403bf6bcea5b39c46141ed25824cbb",
+	"1f9d7-1f3ff-200d-2642-fe0f", "c1da8fe78533c5509fb5b97da9ee9b6f",
+	"1f9d7-200d-2640-fe0f", "631009c880011f9252745316f7a6619a",
+	"1f9d7-200d-2642-fe0f", "30e719fd200a5bca3f760d4af8309d12",
+	"1f9d8", "343b2bf6e4bffc0f2511e683fca7feca",
+	"1f9d8-1f3fb", "32fcb37d4fdd650d73f28dfab8f99323",
+	"1f9d8-1f3fb-200d-2640-fe0f", "1dcf839d3946816c3d28247c6b3795e8",
+	"1f9d8-1f3fb-200d-2642-fe0f", "d54feddfcc86b76e84407839c8fee397",
+	"1f9d8-1f3fc", "574a41bea03444d6556bb2a07f6c20ec",
+	"1f9d8-1f3fc-200d-2640-fe0f", "8380a773d8651f24fd810b6553779f00",
+	"1f9d8-1f3fc-200d-2642-fe0f", "79e0a69229f5b700bb5b3cbcd4b7ccea",
+	"1f9d8-1f3fd", "ec40d5da381fb31686f5a20e0af5d75a",
+	"1f9d8-1f3fd-200d-2640-fe0f", "a53dc336c12f2823e70919ec4ae38e12",
+	"1f9d8-1f3fd-200d-2642-fe0f", "c5bcb9198662897f657193a54d7c88f0",
+	"1f9d8-1f3fe", "554093718cd40b1222f4290f45a2fd6f",
+	"1f9d8-1f3fe-200d-2640-fe0f", "b8783388b27601db4e4f7cc755d0b654",
+	"1f9d8-1f3fe-200d-2642-fe0f", "c998fb5c898e8502567805f582d210b8",
+	"1f9d8-1f3ff", "09612863c6c52ec54862dedbcbfd00db",
+	"1f9d8-1f3ff-200d-2640-fe0f", "2d0065f12461931ea56d9d737599f8b7",
+	"1f9d8-1f3ff-200d-2642-fe0f", "6b5f51de9d2394203fab745b8a7bfa1f",
+	"1f9d8-200d-2640-fe0f", "3a77e32a4186aea365929e965e030298",
+	"1f9d8-200d-2642-fe0f", "8412469510e8a64554aa7a66b2a6e504",
+	"1f9d9", "48861cd332b06ab0fee8865188f6ed42",
+	"1f9d9-1f3fb", "00d8541506c4f9ad5097206bf041c21a",
+	"1f9d9-1f3fb-200d-2640-fe0f", "b438c16b261e093696e62a1a885cdf30",
+	"1f9d9-1f3fb-200d-2642-fe0f", "e46d5cf36d4f8730cd9a0e87e27b39ee",
+	"1f9d9-1f3fc", "bba45ad5e43a6dfc3808fc2c89e60be7",
+	"1f9d9-1f3fc-200d-2640-fe0f", "6de4803c453e401fd9a496dd04824304",
+	"1f9d9-1f3fc-200d-2642-fe0f", "eaa3bd7d4aedbe3370f13d001b1df7d9",
+	"1f9d9-1f3fd", "618236af9063475d6aea94ad88cf496a",
+	"1f9d9-1f3fd-200d-2640-fe0f", "71252bf410cb016ec3c3f387142f1288",
+	"1f9d9-1f3fd-200d-2642-fe0f", "7edc30faec6d8df693ae54089f3ef39b",
+	"1f9d9-1f3fe", "cf4ba1b9ee56e41df83f7b4077090847",
+	"1f9d9-1f3fe-200d-2640-fe0f", "987a4b09b19d009af74ecd5aa2ce5208",
+	"1f9d9-1f3fe-200d-2642-fe0f", "73c1f9d72ce637eeb0912bd7d4a86fbf",
+	"1f9d9-1f3ff", "1db5f5621f5ae8f73aa4812e9e343f42",
+	"1f9d9-1f3ff-200d-2640-fe0f", "e0139854aba487f4126d494cf888380f",
+	"1f9d9-1f3ff-200d-2642-fe0f", "4e331a5aeb50d352f087435d23596889",
+	"1f9d9-200d-2640-fe0f", "1dafc1b378a16a192aa60fd044152648",
+	"1f9d9-200d-2642-fe0f", "2f02679c4162c9c9d27a28a800c5e69a",
+	"1f9da", "ee740e436f1798ba6ad7fbba112b2410",
+	"1f9da-1f3fb", "0bb07db0d1e5f158b7700e04ac57f109",
+	"1f9da-1f3fb-200d-2640-fe0f", "b037a9b4e1223ddde108a1503ba1e562",
+	"1f9da-1f3fb-200d-2642-fe0f", "4d1ba3b403a2cc69b9be6404d95cc510",
+	"1f9da-1f3fc", "66edd694a75fb426a55644c11691f0a1",
+	"1f9da-1f3fc-200d-2640-fe0f", "b6a66e6815691246055f4a4a4c9e2fbf",
+	"1f9da-1f3fc-200d-2642-fe0f", "41f72c28e915d210437e582512a75999",
+	"1f9da-1f3fd", "d49c32e5980a172d35ba0fc46fe946ea",
+	"1f9da-1f3fd-200d-2640-fe0f", "9dd3bc6a3c2ab5a1a30d034418e1e449",
+	"1f9da-1f3fd-200d-2642-fe0f", "7730d9a67220e06c4b937f43db576b24",
+	"1f9da-1f3fe", "868bea312c95c94948d24fefc9a621db",
+	"1f9da-1f3fe-200d-2640-fe0f", "f358d991df3e0d3c1151935535277a65",
+	"1f9da-1f3fe-200d-2642-fe0f", "88a50ca0498a10843fbd81e510eb78ed",
+	"1f9da-1f3ff", "d35a5f82a7bdad1a3862d6607acc6b8a",
+	"1f9da-1f3ff-200d-2640-fe0f", "27e333bf05f6ffdf08b0c13bd3d31217",
+	"1f9da-1f3ff-200d-2642-fe0f", "d873f9b00ca32263b4b71932e8da732f",
+	"1f9da-200d-2640-fe0f", "66d6eff4403350b072813d10925205ed",
+	"1f9da-200d-2642-fe0f", "aa159edfdd00793bdbc8c261478c22ec",
+	"1f9db", "7ff3d0c31182a3e0ddc4fb2cbba80607",
+	"1f9db-1f3fb", "672635b9a4339b5dbe1d8ec82448d2e0",
+	"1f9db-1f3fb-200d-2640-fe0f", "b1553649a4132583b858187541d637e7",
+	"1f9db-1f3fb-200d-2642-fe0f", "0350934f92cde2d67a77b84d18367fa1",
+	"1f9db-1f3fc", "1d9b7b00f5a4289cb8ffd6d207df04f0",
+	"1f9db-1f3fc-200d-2640-fe0f", "4b2b8ba80373b67fc338674e0ff935a8",
+	"1f9db-1f3fc-200d-2642-fe0f", "4a0f3e3ab990b2813b67b010fb612f6d",
+	"1f9db-1f3fd", "b229142af51d4af94879e9926f48a392",
+	"1f9db-1f3fd-200d-2640-fe0f", "92c9e16f95b0546219f846a2913d6feb",
+	"1f9db-1f3fd-200d-2642-fe0f", "d8c48acf24e3ee003848047367e752f4",
+	"1f9db-1f3fe", "362aab919f3c8773a1fb59100314cfef",
+	"1f9db-1f3fe-200d-2640-fe0f", "02e78f3f076d900363c55e045dff330b",
+	"1f9db-1f3fe-200d-2642-fe0f", "5fbb997f1f5311969a881f2c7f4c515f",
+	"1f9db-1f3ff", "03ccb743a9c42c8c83910f8a8949fa36",
+	"1f9db-1f3ff-200d-2640-fe0f", "04488c3c5879bcd80e920faa8e3fcd1f",
+	"1f9db-1f3ff-200d-2642-fe0f", "debf413899ddcf055ac0cffc7641626c",
+	"1f9db-200d-2640-fe0f", "c7686e989f90435dfcb794abcde3bcd2",
+	"1f9db-200d-2642-fe0f", "fef51a627159aaa4759c812c2945ce76",
+	"1f9dc", "6ce9524619b852925762f7cda549e274",
+	"1f9dc-1f3fb", "2a5d382b0be4059e9f8ac97d4bb8aed4",
+	"1f9dc-1f3fb-200d-2640-fe0f", "694fad2bec3833bac257ebd8e4a9504f",
+	"1f9dc-1f3fb-200d-2642-fe0f", "aad5af67ca4be288ed1fd00f6c154f57",
+	"1f9dc-1f3fc", "e7b36c7b78fc8acbf03dc366fafd43ce",
+	"1f9dc-1f3fc-200d-2640-fe0f", "2203027ddecdc045aec94357450b4530",
+	"1f9dc-1f3fc-200d-2642-fe0f", "7a18fdad29559333046e1df4d34f86b9",
+	"1f9dc-1f3fd", "74657057d786751d7016ec1727733697",
+	"1f9dc-1f3fd-200d-2640-fe0f", "ba581ef02424c465a38145e65f8f1d58",
+	"1f9dc-1f3fd-200d-2642-fe0f", "68ae4eb7c72054f6e238b9c69cc90d9f",
+	"1f9dc-1f3fe", "00e6c22a3ed9c78cdd8382993448f1d8",
+	"1f9dc-1f3fe-200d-2640-fe0f", "643e1beb80590f15f378b262036e8001",
+	"1f9dc-1f3fe-200d-2642-fe0f", "64d017a8572a93e8d0dac2460d725fb8",
+	"1f9dc-1f3ff", "da1aa93913927015100be2b0e95a44aa",
+	"1f9dc-1f3ff-200d-2640-fe0f", "3e2cd92b66060c29cff7bef6ea2e0456",
+	"1f9dc-1f3ff-200d-2642-fe0f", "1d6e9c622b6ee497c18756317a4fd29c",
+	"1f9dc-200d-2640-fe0f", "bac5ea1aa04ed502cb375def90af9a2e",
+	"1f9dc-200d-2642-fe0f", "89cd7eb2c075f997621c23c5f651fe25",
+	"1f9dd", "8738333495fab2ea53accfb1e6fadb47",
+	"1f9dd-1f3fb", "e0512629d76cc356a19d3ef99747ca15",
+	"1f9dd-1f3fb-200d-2640-fe0f", "c7eb742cb16e158f2895edb372c200f3",
+	"1f9dd-1f3fb-200d-2642-fe0f", "bfd9b9dfa58a53fca7de8242b2e4d3d7",
+	"1f9dd-1f3fc", "87bf9e29d869aff1adb917fd0fb7c193",
+	"1f9dd-1f3fc-200d-2640-fe0f", "41a874a7fbed9bc6d6a21e6634f00b56",
+	"1f9dd-1f3fc-200d-2642-fe0f", "be618df1a2f4832da12795e58124376c",
+	"1f9dd-1f3fd", "00b1287a89ec490d9bbb09011465a1c5",
+	"1f9dd-1f3fd-200d-2640-fe0f", "bfb7670addd486a17063e9e39af86462",
+	"1f9dd-1f3fd-200d-2642-fe0f", "e79e337d29d9c88efe0d746f835d884f",
+	"1f9dd-1f3fe", "accb7af48c00777f25e3aab6d6da735a",
+	"1f9dd-1f3fe-200d-2640-fe0f", "444af7af46ac656dffb23a33040cc638",
+	"1f9dd-1f3fe-200d-2642-fe0f", "80c507466757fdf397d5b2f17c09b81d",
+	"1f9dd-1f3ff", "8a295569f9f205841c33085202539ab2",
+	"1f9dd-1f3ff-200d-2640-fe0f", "8a9482e7e12f8c8f3783844125791bef",
+	"1f9dd-1f3ff-200d-2642-fe0f", "834e1a2a61ce6e8533336f1f7e1d244d",
+	"1f9dd-200d-2640-fe0f", "a58910ba2a48b7c7ac2436cc47b15d08",
+	"1f9dd-200d-2642-fe0f", "c70b690debd7e0fe9c1685a673b8c7a1",
+	"1f9de", "c232d1fc0940c72d2de13293e1845f7f",
+	"1f9de-200d-2640-fe0f", "5deffcb0dbea4f9362e684bd10eb9f45",
+	"1f9de-200d-2642-fe0f", "a08f3a02e3225d9c9dcb8bab91960ce0",
+	"1f9df", "5ac85f0d02866dbce56973ac87224a7d",
+	"1f9df-200d-2640-fe0f", "3a7998c9dd06201ec681eed98ce86526",
+	"1f9df-200d-2642-fe0f", "f6e85f7022a1fdf4868fb8667cfe12cc",
+	"1f9e0", "0bf5972bff8b8b4c26621bd5cd25d839",
+	"1f9e1", "ec0bba62895ee2423aed0aabf5fb3c83",
+	"1f9e2", "b1b58b6b04a9f27b37c712060c06c145",
+	"1f9e3", "559218d868ea9947d1bf8f398f04d214",
+	"1f9e4", "0e61b1d970d94a2786e48830b2ba1da9",
+	"1f9e5", "b24d488311f7b61c7652675a38895105",
+	"1f9e6", "2ca95301db0ebcf082d6be3824d44da0",
+	"1f9e7", "a38e4c37e7586cf0d2ed5ef9b7a1256b",
+	"1f9e8", "a66029551e6622ee220b35677db15d8b",
+	"1f9e9", "6397a81d13d9b1cac66c1afefb072f3d",
+	"1f9ea", "fc330e3e65219b14fbc0f73695678212",
+	"1f9eb", "267472d9c0d95782291d5b05f6008409",
+	"1f9ec", "9982c55956394231e8a23ccac7cfe202",
+	"1f9ed", "232c63163a0f5b41306a860843920938",
+	"1f9ee", "77e4efbf6ce6a79d8e7fe15172be07e5",
+	"1f9ef", "77ade546786b522c1f56c57e74d4b1b0",
+	"1f9f0", "e673c35319c2c6b3d4318f16d4181f77",
+	"1f9f1", "d81f686b7808eef83563b335fa444a5d",
+	"1f9f2", "6fa55b72d1a0a1db7fb2be7c248b06b1",
+	"1f9f3", "b5fe64c81ae3bfcd40655a32b297f948",
+	"1f9f4", "1e7634006385f432ddb02ac2ec30a230",
+	"1f9f5", "04ae66e9535ee04c36ebc2489bbd0351",
+	"1f9f6", "d57f2dafc1768e2fa3fbdae18d04a17f",
+	"1f9f7", "83ef7fa5e050f0e8133709ad5d87fc90",
+	"1f9f8", "f248b9a976f998c9cc7faadd0040a5fb",
+	"1f9f9", "ba5be176ff3630e7bd154623ebac6522",
+	"1f9fa", "4810636ec1a368979c0daefe076741e2",
+	"1f9fb", "e04c90b83c277fb080c70d73a71e7c79",
+	"1f9fc", "e33aeec40a8c81635528cb5f8bf50200",
+	"1f9fd", "41d1ec3b4319acce95451c0b5d8784b0",
+	"1f9fe", "e3f518dd9cfea9db83921d7c037d61d1",
+	"1f9ff", "c84e3f83b281b92495872d0d3b139cea",
+	"1fa70", "38939362014f3205d178580c43cbd13a",
+	"1fa71", "84d41fa0d4c5e3b668edb1142d6d4105",
+	"1fa72", "57b616f0b6a6e590342ac593b59a1a8c",
+	"1fa73", "e82f2a5518037286d72a5411e710d4d7",
+	"1fa74", "6985843ccf92dd8c22735b3c832a1acd",
+	"1fa78", "2af597d88e655d0cce7ff65a50298d70",
+	"1fa79", "c36f404ef43affe7525784b28dce16ff",
+	"1fa7a", "d4b1476ff922b0ef6ac41ea825cf2705",
+	"1fa7b", "05ae035616febbc76aa972f1cafda156",
+	"1fa7c", "88a3e1f7e33e6740e980498f8b05aac0",
+	"1fa80", "e8fb6cf9479a7397963129798f97b1d5",
+	"1fa81", "c82b382a4e972f005a98e29131b773d9",
+	"1fa82", "8286cb29b97eb60edbd70a9172afabbb",
+	"1fa83", "914776cd7f1e6684424542e0793cf54b",
+	"1fa84", "e6bbd7e1a762e2e20cee7ae28d29a6f3",
+	"1fa85", "e329f1d8eba62eb4d02e9106bbe2c6a5",
+	"1fa86", "1c1e2b8196062cea1c70edeed44fc479",
+	"1fa90", "f7e01db7b5747da3c85cfd6bc6207c66",
+	"1fa91", "9efd3edbbbbe01484706490270ca2059",
+	"1fa92", "089aef33603e32cfb0408c97244972bb",
+	"1fa93", "76e6d179559520cd50c0f603ca15c517",
+	"1fa94", "4a272ae77e82237cdaca3c0895b8a313",
+	"1fa95", "aedb2953ab450c89c31253dd395297c1",
+	"1fa96", "d9c6360fe725a4054969f2b59506dc0d",
+	"1fa97", "45868a261eb6ede08e6ce627d8d15993",
+	"1fa98", "cd7e7ce7864a13e519ceac8e3d573dd1",
+	"1fa99", "11b9d8164d204c7fd48a88a515745c1d",
+	"1fa9a", "dadac20f322abc00a1730821b9a2c8c6",
+	"1fa9b", "bb70fe9751f89158c5df2c920846eb6a",
+	"1fa9c", "c9ab5c7dade3ae221f8b865b880d3f02",
+	"1fa9d", "0b7d8392d45f50e895f03eb2c1e2fb6c",
+	"1fa9e", "f2bce58c85b3a413f40a65ad2c0b2ebf",
+	"1fa9f", "d9a6bac016e1c405fe737691c8cc084b",
+	"1faa0", "0a46bbddbcd72afd279f95ec25ed901f",
+	"1faa1", "e615f9bfc59c57cc6ad2a1bd5df68264",
+	"1faa2", "d8cd8872f217c51e8cab323d5681a6e9",
+	"1faa3", "1ba62b494748b1fff7ac325265ea543f",
+	"1faa4", "d3a23a6f305b9e9b242bd252296602c1",
+	"1faa5", "5c554f6ebf3cfddf82ea3d1d715d2dda",
+	"1faa6", "aabdb1da52257ba05480f31bbe066aa3",
+	"1faa7", "c21367782d767a023bd5d090b735bad9",
+	"1faa8", "3f668b2c0d30e081d55f33b810f5cf96",
+	"1faa9", "e840b05553afb9124104f5aa811ee3bc",
+	"1faaa", "4baa581530ad91e37a9ff45dbf893f85",
+	"1faab", "f8902502abf350aad7b2e7c06e5a989e",
+	"1faac", "74ce6ebfcb1ee2eab6d8c0ad093e12e5",
+	"1fab0", "ba4d132b61d61e957465db5d29e61e7d",
+	"1fab1", "711d821cf988b45ec85fd6a31ba985d8",
+	"1fab2", "fdfbde645f16761b69a21d334ee17611",
+	"1fab3", "787ebc472336cac2e745b913176af113",
+	"1fab4", "ffe6ed65594ad7e59441d29b235a5e3b",
+	"1fab5", "1ed507569d97d69aa36ebd7dc1011177",
+	"1fab6", "ccf3a8ec8488ab282e36c8f0217d73cd",
+	"1fab7", "32d25744a68866fc82e2ff6c61319067",
+	"1fab8", "36eb692f0215a5b914d06212849c076b",
+	"1fab9", "b403598f63fca2fd72ac586bc2933c69",
+	"1faba", "5bea3ba62bee6066f51b25e7cdb31baa",
+	"1fac0", "4cd3151267b3c4033da1226fb48105f9",
+	"1fac1", "8e9f1b9bfcef34a52182bd1d06fbcb88",
+	"1fac2", "16e50cf15d1cfdc28964072544f55043",
+	"1fac3", "7ac652461bfca723e872c9ea15be4dc5",
+	"1fac3-1f3fb", "86e509d4a505efa4ce79fc6fd0cb12ce",
+	"1fac3-1f3fc", "fc049048fa5f8d8a28da7917a2e3c7ae",
+	"1fac3-1f3fd", "e5110b63d491f9e097e914b473dd6695",
+	"1fac3-1f3fe", "be6bebc64aef9026524cef83540817c7",
+	"1fac3-1f3ff", "d6e1d5da26a18884f876e8e7e5363fe6",
+	"1fac4", "f99915805ced3b2ca6b498c665912f1d",
+	"1fac4-1f3fb", "c0f1e18b02a71b9e82dc7b7e081e9c50",
+	"1fac4-1f3fc", "59c21f539e35335edf636a56344cefd7",
+	"1fac4-1f3fd", "80c05f330755348088b6b9f4e70c6d97",
+	"1fac4-1f3fe", "bf67abeb3b7d0c6302ddcd1d1e89dd01",
+	"1fac4-1f3ff", "e1ee349d6ef799de42641f2a07d1d6cc",
+	"1fac5", "cf6191b180dc3f27610f7f972e9e7862",
+	"1fac5-1f3fb", "82bbfa789a340c03d7b52926bac05c6e",
+	"1fac5-1f3fc", "15a676b1d432287c85388c2983669a65",
+	"1fac5-1f3fd", "e0a611e21ad1baefbae97ae5772b3d04",
+	"1fac5-1f3fe", "29fa9a78651a0cc9de45bb2129c555e5",
+	"1fac5-1f3ff", "0c6f08d5da979270ed8c598b45dd7de2",
+	"1fad0", "0f17a7cce9e5c8fd393abc909a2616ab",
+	"1fad1", "233755b9437ebf9f05d34ea440d7a16b",
+	"1fad2", "bfbfe07667b675fdcbbb30ba63f0464c",
+	"1fad3", "284a5960c3fb6d80d0e02bc8ed2f2a2d",
+	"1fad4", "198bca7e9175adece98c33d497569962",
+	"1fad5", "9aed4befbf07fca5047eda5e2d461292",
+	"1fad6", "3bdb393d75d08ec821a943f2f2659a95",
+	"1fad7", "50bac7c3b7f21ea25538c6d2ff6371cb",
+	"1fad8", "a7ce025edb4144559b99c87c29d55312",
+	"1fad9", "7fa8e7fb17a9f47875f023c01362cb31",
+	"1fae0", "2b2a009b3adc6ee893908925a47d0194",
+	"1fae1", "be438a3c54ed89b52bc3351ec3e631fc",
+	"1fae2", "1dd7a811b3c7640a8a8775921e56446a",
+	"1fae3", "d5c8d0449482e5cf153ebd79c4ff4a36",
+	"1fae4", "981be7a645b49c309a2424165e31518d",
+	"1fae5", "adc7e2a980c644dd79582158ec23ea79",
+	"1fae6", "aea1000bde11cedc2b8bc8c9d6b7d5c9",
+	"1fae7", "5663ca34bbb761c8b326f7af4aa4d654",
+	"1faf0", "58905ea0d9ba9c84bf76176a5345561c",
+	"1faf0-1f3fb", "4673d95d6feb7b30d98a0309afabb695",
+	"1faf0-1f3fc", "aef56f60a2d125a9ae442dd81764c619",
+	"1faf0-1f3fd", "789eaaccb7c477978124edc41a469d50",
+	"1faf0-1f3fe", "7ada806ac825ac721284842df0af35a9",
+	"1faf0-1f3ff", "1de92c01c845b249bfef3d554ac3be5b",
+	"1faf1", "5553a433cc196c795417c8c5841922b3",
+	"1faf1-1f3fb", "7002ee25a3889672b70705bbc3d0437e",
+	"1faf1-1f3fb-200d-1faf2-1f3fc", "6e464a959dad9d4257c222d3f42ff0b4",
+	"1faf1-1f3fb-200d-1faf2-1f3fd", "d8abd3f6f5cecd3cb2d4d5c5dcd37ee0",
+	"1faf1-1f3fb-200d-1faf2-1f3fe", "03beb9dac4ed05d98f6463b2c32c575a",
+	"1faf1-1f3fb-200d-1faf2-1f3ff", "b677fad687da962c6242e44d987c7082",
+	"1faf1-1f3fc", "8ea1d84a3c07c9f03cb48acc241148f7",
+	"1faf1-1f3fc-200d-1faf2-1f3fb", "5a5b149001a8964efd7904c3a210e10e",
+	"1faf1-1f3fc-200d-1faf2-1f3fd", "95f1b9d9a7635bc5a1991edb60e3ec63",
+	"1faf1-1f3fc-200d-1faf2-1f3fe", "b384d800b30858897bb8b79951828517",
+	"1faf1-1f3fc-200d-1faf2-1f3ff", "94ae9f39c3c53f9819dbd046101a6986",
+	"1faf1-1f3fd", "8e00e6b1b056e6c25e0b9ef77c7b8137",
+	"1faf1-1f3fd-200d-1faf2-1f3fb", "59e97fd108e6cd1da64a10303b1eafcf",
+	"1faf1-1f3fd-200d-1faf2-1f3fc", "41d35e84c49e2dfb4b3c2b28ce375226",
+	"1faf1-1f3fd-200d-1faf2-1f3fe", "24b6944efef2bf2b7e7fc592a009953c",
+	"1faf1-1f3fd-200d-1faf2-1f3ff", "bfc97645917d8a70a82cdac0b5f86fda",
+	"1faf1-1f3fe", "188c33b329a2bb936fba70962e410153",
+	"1faf1-1f3fe-200d-1faf2-1f3fb", "50e616240b0d75f7f226af633c35525a",
+	"1faf1-1f3fe-200d-1faf2-1f3fc", "8797e5ed302dfa808aed819ae84fff52",
+	"1faf1-1f3fe-200d-1faf2-1f3fd", "1681da114d17a77a6dae40f058a3f456",
+	"1faf1-1f3fe-200d-1faf2-1f3ff", "1f037dbe74311d59082e8e3bd0931c4f",
+	"1faf1-1f3ff", "5eb8ea63cfbd13da23eff1304b9b57f3",
+	"1faf1-1f3ff-200d-1faf2-1f3fb", "7f7336c6696273054bc9a83ada52b853",
+	"1faf1-1f3ff-200d-1faf2-1f3fc", "91a1a4ae190116a6228fe54f0394e379",
+	"1faf1-1f3ff-200d-1faf2-1f3fd", "bac0adec7b8ea9bf37e8f6cab8f0d26b",
+	"1faf1-1f3ff-200d-1faf2-1f3fe", "422f24b0165662ae85abb2ae5f05c46b",
+	"1faf2", "469260c0d80dfb5780e1c54795da61ae",
+	"1faf2-1f3fb", "f14809c19ad9b1729aa796081ab1b095",
+	"1faf2-1f3fc", "d9e0c517e07c0f0093e1b3b11011758f",
+	"1faf2-1f3fd", "2f860666aed873402ad9d29967c1085a",
+	"1faf2-1f3fe", "f3266fd73f2384e8454b31fef78f9035",
+	"1faf2-1f3ff", "b5bd5013a4947fa4a1838670701987df",
+	"1faf3", "3ff45773638d864b5789eac95c8e073f",
+	"1faf3-1f3fb", "efd0cac700afa3c6444216f9257c2bdd",
+	"1faf3-1f3fc", "1346befa301c6233cf48a5ec2a689077",
+	"1faf3-1f3fd", "a54906ca994f15d7682e506be5ae4622",
+	"1faf3-1f3fe", "e221a0a0886d0a9db9aa16358211a4a3",
+	"1faf3-1f3ff", "77129122c33cfc3950d3e9e1191b212c",
+	"1faf4", "c7365791eca611a8d95ab7274cc00128",
+	"1faf4-1f3fb", "540ea2dcf5fac472a56416c0afafef0c",
+	"1faf4-1f3fc", "744b0a4c2efacccaf7f6907708a7433b",
+	"1faf4-1f3fd", "8232f715b1d81e491c457164dc13b0ea",
+	"1faf4-1f3fe", "7f1da86d04e6967ff66ccbe361431ef8",
+	"1faf4-1f3ff", "7f8dcfcb27b72925d0c36830d7423daa",
+	"1faf5", "443df4ef69cc07c7b0b10f1093836a79",
+	"1faf5-1f3fb", "b9529558511565953820b7b192d7de67",
+	"1faf5-1f3fc", "bfabc709d2ec58cc9a5dd7f000b75062",
+	"1faf5-1f3fd", "e4a6c3410af90a8520af0959ddcb647f",
+	"1faf5-1f3fe", "84e0692a97a184c6aae2e2b68221cea6",
+	"1faf5-1f3ff", "8554587eddf7a8e29330a27a320984a6",
+	"1faf6", "eb47d4c118ffc01ac50f2af9c7391a44",
+	"1faf6-1f3fb", "9c99205dc782580acfd8acfc599b4ecc",
+	"1faf6-1f3fc", "85d4341dbb13c0c355189a2211205c75",
+	"1faf6-1f3fd", "c6e077102229782b29e69ad1b502a8dd",
+	"1faf6-1f3fe", "7188a9c6a8c67e64bcc010cdca18df99",
+	"1faf6-1f3ff", "f00e084a260ed128d223422783654288",
+	"203c", "9a13b8821ec2c880981df4bf3ce07177",
+	"2049", "2cc6266229c7e2ccfde10e81782e8b5c",
+	"2122", "1cc8befbb8d3c3dcb5b4f942d396395f",
+	"2139", "70fef31c956805f7f5d867ebb5ba9b70",
+	"2194", "43e7ad0f9a97bb8c7655bf3ec472ded6",
+	"2195", "d9584dea94b394bd56d70f941b8afdf3",
+	"2196", "be097e7d915489fe6a4d3c90d6931f60",
+	"2197", "94d72d11e74aa2583b2092275093efea",
+	"2198", "f220b5e735fd1d9f1e1d374d7021f7f6",
+	"2199", "f33f04b3c6c8190ec9afa622d0168706",
+	"21a9", "610162a08147d9e3c3198c32c9deada9",
+	"21aa", "397cc2a7ee743bfc004701afc818b84a",
+	"23-20e3", "05ace4106e5e1af6afbea0fa339e557c",
+	"231a", "ff8c49a85b7fbda0110c66353a9db71e",
+	"231b", "fcff6ac673eb2d32ef5a49818d9572da",
+	"2328", "094aec29c55873f2b7a24df523b428c6",
+	"23cf", "d303e410b0ca930c1415c3b420675997",
+	"23e9", "d6e013cec3f76e3fe8b0aecea25dfd16",
+	"23ea", "cce448a3308b974d14c3a58bc86cabdc",
+	"23eb", "bcce2b4d6cf7e4c2a0f83d6488c338ea",
+	"23ec", "97ebb7b72c4369b203aba61059674db1",
+	"23ed", "5c453c22c9759622b0cd8a103b368801",
+	"23ee", "f6f5399e57ef77e62130ed0b16104a4f",
+	"23ef", "dad59c6b5d0282bdb6476eb7b437785c",
+	"23f0", "630f5938948131784285d97d57a3e8a0",
+	"23f1", "11d800c7b4c405d96e8e412163727a89",
+	"23f2", "c3b6bfe6efe3d61d1e9198fe347ca06c",
+	"23f3", "617c1718e07ad475bacecb7e5e401dd3",
+	"23f8", "c76aff0be5af2818d6a54a7041f42a11",
+	"23f9", "bfd68f26a38f7184b5bcdd40153c4196",
+	"23fa", "86be05bb96db845b7681ef10462f0a51",
+	"24c2", "dac778e8126fd5f96b6511d7ad8ffec1",
+	"25aa", "60c5854fe77ca7a4946b7c801c559f4a",
+	"25ab", "6c50e93fd7985026a8bc945a412edbdd",
+	"25b6", "9fa9d42fbc4405fdca021e2fe9e5c4e2",
+	"25c0", "b080d1398cef7723bd25208b26b3f89a",
+	"25fb", "d55ae3fc2d6af0ffc3d175e9ef6b423a",
+	"25fc", "b6a3073e1281d1ef79d48ef130c183b2",
+	"25fd", "da4ea68f0040eb0cdcf1aadc43bcbb7c",
+	"25fe", "7d4f02f7354aee0b30e7e5a35579fff4",
+	"2600", "1038cee47a0a8313dc2d4006145fcee6",
+	"2601", "6b40a9e9f0035e358ec8ecd215429e01",
+	"2602", "4ae61830562f5264431aae7c546bd2bf",
+	"2603", "f5ff21535567c18b5e0af90c9152699b",
+	"2604", "b1287f622ee40d8bfc5c008b176f0ea7",
+	"260e", "e39ec5942bc69d0a6392f507ff3321ed",
+	"2611", "86c16c39d96283551fd4ca7392e22681",
+	"2614", "f6de0d98b8ce397124e3cb7cc069da16",
+	"2615", "2e081ee00be98dbfc615fa5d5dc249b1",
+	"2618", "ac577511ab9afa8bb830b0d70272d887",
+	"261d", "a08390af9d69488ad04ca6332b33c2d5",
+	"261d-1f3fb", "e243141d9cecd61ee4dc3251baffe5fa",
+	"261d-1f3fc", "4e88c34f04c3cad89f5f0949d8ea96d3",
+	"261d-1f3fd", "e03a98069a150f630847129fb427b8dd",
+	"261d-1f3fe", "ca0867a54e1e6303914873bea2479c79",
+	"261d-1f3ff", "5892291f9a03ff4dc8b36beece5ac70c",
+	"2620", "39cb7a326a67437dfed8216b0523d055",
+	"2622", "9445b59878fd9d95fad861939d5a25a7",
+	"2623", "672f8aca9a57812526e1e5875916efbe",
+	"2626", "698459178d35dc0796ec25fbae70c6c5",
+	"262a", "d23f3adc94869d5324e319ce84aaf451",
+	"262e", "d7bbe0154d7fe493cad8d0254bf151cb",
+	"262f", "fcafaf615ea28a865390cd1075f7c808",
+	"2638", "df0b84caccd7c506a224e12879bd169a",
+	"2639", "88201cfdba8c923f39b94e0db7f42f08",
+	"263a", "b426f77f42bb709bcb2faa23602d2dc4",
+	"2640", "b8aa4aa17118e3f6fa4a281eebaa8657",
+	"2642", "f2d869d6b7b636d5ca7e1fa73e09b9c6",
+	"2648", "97ba10c090fb15245ff6cbcc14fd291a",
+	"2649", "611c257f23f38c50b66e1454df7bda7a",
+	"264a", "31e97cadbf129a612acc8498fd9b4e8e",
+	"264b", "a92d65baeb967f98d06fb9667f43d077",
+	"264c", "8e920530067946e0a960de6de58b29c8",
+	"264d", "85170bae8fa48e79647abf33e831c2de",
+	"264e", "bf336110b8d3f2fc054016e661dde72e",
+	"264f", "5ff81764b353f54e96aa1ad538181a0c",
+	"2650", "3c3978d607f6d32816a9fa03ac34b640",
+	"2651", "c795dea52fd2f189386004b416e7e42f",
+	"2652", "7c5374d50f0fab4ddad07d721eef2a03",
+	"2653", "bfaee833fbad8301dbd9a4862efe5671",
+	"265f", "22bbea32f8826e963038329aee825bc1",
+	"2660", "3fe9fcacdbee990bd0d873135689bd5b",
+	"2663", "b67a375a6b8f5caeacff463835c44011",
+	"2665", "1afd3c799f3e158662a70498e83e2a87",
+	"2666", "61e5eb6df757130920f69f1bc3ec6cdf",
+	"2668", "2f845c13e5a7ba55b9dd834910f20b74",
+	"267b", "1abe7bcd908fce61e7eadc5aaab23cbd",
+	"267e", "87c3702386b15ec3c4474fae0bf31bbe",
+	"267f", "5610f2e382d6b6394dcf82fbac03e32c",
+	"2692", "3c660250c99f7f232505c273d29e6a7e",
+	"2693", "3935fe40246bcfa69647ca92a58761a5",
+	"2694", "e7159ba0fcc85f39f95227dd85f44aeb",
+	"2695", "e3ffc4211c11ed9af585031111c236f9",
+	"2696", "04e853004af5d0aad387cef5be7513a0",
+	"2697", "bbe33fe3305ad5d3212157014584317f",
+	"2699", "a6d05968d7706183143518d96c9f066e",
+	"269b", "f70b9bba067a9d6a02d73385aff6449c",
+	"269c", "87632fc55f217f6774af0b077e01ddca",
+	"26a0", "289673858e06dfa2e0e3a7ee610c3a30",
+	"26a1", "bcca43b1c7aa91d47f62962ce2422ae1",
+	"26a7", "5a34a257309d5c07242e1deabe5b5b02",
+	"26aa", "2e2d492de1f35e3234bdfaddb2b6894a",
+	"26ab", "6278365424fa5701d3b185e2d9fe8225",
+	"26b0", "1d3e7779b411a7cfeea6dd430557f803",
+	"26b1", "69a6d1386a64620065532c7e72882d8a",
+	"26bd", "376bdd3ac92afd53169e8faecf624110",
+	"26be", "53d958a3faa6250deaadde739100ab66",
+	"26c4", "198dea7bcb58c81b9e93b26bcfac0ba5",
+	"26c5", "0ea19f09eaae8075f4cfa0771796c819",
+	"26c8", "d987d05d2d1595268dee2e7ada00f1fd",
+	"26ce", "55766f4390e34af67f31e11e31e4af83",
+	"26cf", "6ed16a164f91fc0843c06088529e3a27",
+	"26d1", "782c5bd88fb4750d99cf6655fba03474",
+	"26d3", "054f3a3f26cef91a51405d70a83f9102",
+	"26d4", "22841a994e9477aaf121a8844c07ebf0",
+	"26e9", "c69e993b1de469f191315a52bfa4f1b1",
+	"26ea", "d0657b7d9a16d462de04fa4369f92690",
+	"26f0", "9cf5ee12b49ade54b7a75c123dd28544",
+	"26f1", "11f1b62baa1bed36665089ce5da05045",
+	"26f2", "a4e74e3428dae61b1f70506f40ac63cd",
+	"26f3", "795431f7abdbe4dcaa45e1355146ecec",
+	"26f4", "2d3379f5f922b815bf195d381436e573",
+	"26f5", "764420c21fa117adca53fda6490c9ec2",
+	"26f7", "bffa69e95906ccb382393c3d09e3baa1",
+	"26f7-1f3fb", "bfa4963f6b2ad0a46513afad9d9eb611",
+	"26f7-1f3fc", "53457d4efec9be1d3bd20ff6c0997db9",
+	"26f7-1f3fd", "7f86d843bfd0603e57fcf51fb3817f6a",
+	"26f7-1f3fe", "eb37ccfb516378b010c16c5cb482fa86",
+	"26f7-1f3ff", "d8f5b4a43e7367a5b2f1e21146a76153",
+	"26f8", "8f1d923af2f428792561333404cc0808",
+	"26f9", "a5a0432e8f6009b91678356b39e9ad83",
+	"26f9-1f3fb", "fb3822271aed96ad08bb956b811ae542",
+	"26f9-1f3fb-200d-2640-fe0f", "5b0885b72f6e076a808e0214be2d7b61",
+	"26f9-1f3fb-200d-2642-fe0f", "29b478871bf19827e49d2358115f730a",
+	"26f9-1f3fc", "c70730bdb7cc6ad5c4213bfe133d9ac3",
+	"26f9-1f3fc-200d-2640-fe0f", "fc1004c016bd1acf87ccf134cfa46fa0",
+	"26f9-1f3fc-200d-2642-fe0f", "2a83e55014bb281d69a1565e2dfc105e",
+	"26f9-1f3fd", "cf9415dea1dc3cd4679929c823bfdf0e",
+	"26f9-1f3fd-200d-2640-fe0f", "4f989b3a40c260a1b32483ac6849f43f",
+	"26f9-1f3fd-200d-2642-fe0f", "dc36a28262a87ae36f796ecf6e2bc200",
+	"26f9-1f3fe", "3f6196c88eba2615a3645ccdd583ca22",
+	"26f9-1f3fe-200d-2640-fe0f", "5c0c879149927a18f3e6aba436da51e6",
+	"26f9-1f3fe-200d-2642-fe0f", "356626d9a23473a35df7516479686ab2",
+	"26f9-1f3ff", "e569230c0feadf93c474a1355785a8d1",
+	"26f9-1f3ff-200d-2640-fe0f", "6b37796cd505197da44d13902366ccb5",
+	"26f9-1f3ff-200d-2642-fe0f", "01dbac2721b78f26d2db3602f6c8bc21",
+	"26f9-fe0f-200d-2640-fe0f", "048f569111aa7bb39f95c4c62a1a58d1",
+	"26f9-fe0f-200d-2642-fe0f", "a4aefde30a114312b44782f724a6842b",
+	"26fa", "55fb67da14f0411c1c363213f5f6afa3",
+	"26fd", "aceae280075be9b5026cf651f580a699",
+	"2702", "3dcc54fffb253571d6eab25020e424f5",
+	"2705", "212e30e47232be03033a87dc58edaa95",
+	"2708", "f77079e6e8625b28a365f021e983cd4d",
+	"2709", "e62b930d873735bbede7ae1785d13233",
+	"270a", "a6b80a0effe992a7dab3a0da18335d39",
+	"270a-1f3fb", "b87b2a7761bb9bb287a719e5a9b4d8c9",
+	"270a-1f3fc", "7b6f0023e00e59a7eadd77fcb930550a",
+	"270a-1f3fd", "1a4314a11992006afa74ee76f037a2f4",
+	"270a-1f3fe", "655402be8912d444fb4606122279a98d",
+	"270a-1f3ff", "f181cfd31e1cffe993982e60125e4d93",
+	"270b", "23862f9f0fbd366c49b898c278c879cb",
+	"270b-1f3fb", "b35861d502058e77b66f9424d0399697",
+	"270b-1f3fc", "4b196d9f6f6cb3912da001c923a3628d",
+	"270b-1f3fd", "6fbdbe1b24eea15c44f2f0ce89d2de3c",
+	"270b-1f3fe", "af69eefb59b1dae2180b3641ba77121f",
+	"270b-1f3ff", "31feca763e90c1b622f1144bebb1648c",
+	"270c", "d24e4bd5f355ec0bdf176bd36d33678a",
+	"270c-1f3fb", "449824ac589b6e63c7a671e9fabec7db",
+	"270c-1f3fc", "3798ccb3763dc5b2fe4eb60d9998f1da",
+	"270c-1f3fd", "b49bfa1ba6f51c984c5e46c22cc0d828",
+	"270c-1f3fe", "2fc6d6efcb988a46fdd916f3d8d208c8",
+	"270c-1f3ff", "f50b6dbf0f0ba5ccf4f628c5611ae5a7",
+	"270d", "8cd77280fc37422a25059d2d6dd59797",
+	"270d-1f3fb", "79c6e4286819646876bf9e4f9fb120ce",
+	"270d-1f3fc", "6ba62bb726543c1c8f912b251d8baaa7",
+	"270d-1f3fd", "194f512f5c57469cd5c7fd3f5a199769",
+	"270d-1f3fe", "73956eb46d7065e6b5c5840edaea5026",
+	"270d-1f3ff", "09a099221f7e00db7469e85e3ced1f4e",
+	"270f", "b37d783fa2330771124219f7f13f2f31",
+	"2712", "3fe596b04c5915b21f6cc16a1f50ceab",
+	"2714", "7b3ff12d16d96ca77345da9450986dc7",
+	"2716", "133b77ff9fc321682fed3cde12d6355c",
+	"271d", "30ee6669f72802f601cd8569d3237fee",
+	"2721", "6beea364b9ff84ef3050b82901915c77",
+	"2728", "e820a306c732b90515989dada9995a97",
+	"2733", "c403bad03948b5fefc0e67d1b6b5cec9",
+	"2734", "1d2dc7d83ed3182e3348d4f216c15c87",
+	"2744", "18154d25de86594ea07571e133b92e05",
+	"2747", "99665c9a8eca6fc9c10045b3172aa6fe",
+	"274c", "8becd37ab9d13cdfe37c08c496a9def3",
+	"274e", "d17d69c79096244f30760ec441f76933",
+	"2753", "3e531d8e171629e9433db0bb431b2e12",
+	"2754", "aef26397c9a6a3afee9c857c5e6f3317",
+	"2755", "4d5be7b6873ad2b627eef85496c92fcd",
+	"2757", "4467f392af6cb1ad2acd20ae416a69ab",
+	"2763", "fcc6e0e5fd313e770f13408321d2d50b",
+	"2764", "0483f2b648dcc986d01385062052ae1c",
+	"2764-fe0f-200d-1f525", "93bef14e30e0a38bbc07f733e524f652",
+	"2764-fe0f-200d-1fa79", "9967ec117c4f96b99c71e706ed1ec74a",
+	"2795", "9a43e631d506ae4818ecefc825dc02ad",
+	"2796", "13c8662cff8e029799a35878b56e23eb",
+	"2797", "3d663bbe9d97bc976c24ec4d125fe2b3",
+	"27a1", "464b4ad3ec906581bdc288c42780b3c9",
+	"27b0", "48c9c24b660f8c392a56f8d28c86f1c3",
+	"27bf", "9927e29e935ba83635ac960b29111116",
+	"2934", "a840470f230f4af61a7f19c289030d51",
+	"2935", "0a925256fa01e07f5a7986c1e4fee0c9",
+	"2a-20e3", "63f52f1c8a74fd85d7a4c1b36957e7bd",
+	"2b05", "de73a9116babdbce65076a42ac2ac2ba",
+	"2b06", "b51c7cfe515fd884537ca5c2ac8ce7a2",
+	"2b07", "31abf4145cf7c27ea0e1a2e4328283fd",
+	"2b1b", "d0e23cdf2386056cc64e775a6248ed17",
+	"2b1c", "b08312e344d5ada1c4da75f4e02f293c",
+	"2b50", "141d49436743034a59dec6bd5618675d",
+	"2b55", "0cfc29f75efcc78c7c38580fffe314ba",
+	"30-20e3", "d10eead5823437c08b5287cf93bd5223",
+	"3030", "502afa9807a8da920a357e25c14da10a",
+	"303d", "8abc9c947c43ded3e8f531cd7b42252d",
+	"31-20e3", "68546f5fc3b2166f42cf90b7e23c5ae9",
+	"32-20e3", "eb29ce5fcf54bc3b23ff77039a4ecf3c",
+	"3297", "98cb1e133ea86796b3027a1282e3a3b8",
+	"3299", "01105a704e5c229cd10d7c2223b7bf35",
+	"33-20e3", "67f896405747f26f63f09e0cb048d358",
+	"34-20e3", "09fe8a2882cac4cdb4712ab9622d3fe1",
+	"35-20e3", "5575865e2cb3d50ea051b09d7e1d2550",
+	"36-20e3", "f8b3e0e54d99d2b2962a2e474b2110e4",
+	"37-20e3", "c5ef2ff553f9cecd81add57e79aaf81d",
+	"38-20e3", "71de2e3efd19455f1c63b9bd00329ec5",
+	"39-20e3", "488cb48b4a6952b728df8bbe99fdbb20",
+	"a9", "c04b978067d1d0e67497618a9ae6cced",
+	"ae", "d572ca58de003e569b80bdd330ce503f",
+	"e50a", "fcd3e15d569ca24ecbb591c449c9fa8d",
+}
@@ -0,0 +1,152 @@
+package emojiparser_test
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func BenchmarkParseUnicodeASCII(b *testing.B) {
+	content := strings.Repeat("the quick brown fox jumps over the lazy dog 0123456789. ", 200)[:10<<10] + "😄"
+	b.SetBytes(int64(len(content)))
+	for b.Loop() {
+		emojiparser.ParseUnicode(content, nil)
+	}
+}
+
+// BenchmarkNewDiscordEmojiParser measures startup; compare runs with and
+// without -tags emojigen.
+func BenchmarkNewDiscordEmojiParser(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := emojiparser.NewDiscordEmojiParser(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkParserRetainedMemory reports the heap a parser keeps alive after
+// construction, as retained-B/op.
+func BenchmarkParserRetainedMemory(b *testing.B) {
+	var before, after runtime.MemStats
+	var retained int64
+	for b.Loop() {
+		runtime.GC()
+		runtime.ReadMemStats(&before)
+		parser, err := emojiparser.NewDiscordEmojiParser()
+		if err != nil {
+			b.Fatal(err)
+		}
+		runtime.GC()
+		runtime.ReadMemStats(&after)
+		retained += int64(after.HeapAlloc) - int64(before.HeapAlloc)
+		runtime.KeepAlive(parser)
+	}
+	b.ReportMetric(float64(retained)/float64(b.N), "retained-B/op")
+}
+
+func BenchmarkParseEmojiFree(b *testing.B) {
+	content := strings.Repeat("meeting moved to 3pm: bring notes, and the agenda. ", 40)
+	b.SetBytes(int64(len(content)))
+	b.ReportAllocs()
+	for b.Loop() {
+		emojiparser.Parse(content)
+	}
+}
+
+func BenchmarkParseSparse(b *testing.B) {
+	content := strings.Repeat("the build is green again, nice work everyone. ", 20) + "🎉 :tada: " +
+		strings.Repeat("ping me if the deploy looks off. ", 20) + "<:pepe:12345678901234567>"
+	b.SetBytes(int64(len(content)))
+	b.ReportAllocs()
+	for b.Loop() {
+		emojiparser.Parse(content)
+	}
+}
+
+// BenchmarkParseChatLog parses a few kilobytes of chat with emojis, accented
+// and CJK text, shortcodes, and custom emojis.
+func BenchmarkParseChatLog(b *testing.B) {
+	lines := []string{
+		"alice: gm everyone ☀️ who's up for raid tonight?",
+		"bob: me!! 🙋‍♂️ but I'll be late, dinner first 🍝",
+		"carol: café closes at 9, see you après 🥐☕",
+		"dave: 今日は雨ですね ☔ 気をつけて",
+		"erin: lmao 😂😂😂 :joy: <:pepe:12345678901234567>",
+		"frank: gg 👍🏽 nice clutch 🇺🇸🇧🇬 <a:wave:12345678901234567>",
+		"grace: reminder — standup moved to 10:30, bring notes 📝",
+		"heidi: ❤️‍🔥 this update is 🔥 :tada: :sparkles:",
+	}
+	content := strings.Repeat(strings.Join(lines, "\n")+"\n", 8)
+	b.SetBytes(int64(len(content)))
+	b.ReportAllocs()
+	for b.Loop() {
+		emojiparser.Parse(content)
+	}
+}
+
+// BenchmarkParseUnicodeManySkipRanges skips 500 custom emojis interleaved
+// with unicode emojis, as in emote spam.
+func BenchmarkParseUnicodeManySkipRanges(b *testing.B) {
+	content := strings.Repeat("<:pepe:12345678901234567> 😄 <a:wave:12345678901234567>🎉", 250)
+	custom := emojiparser.ParseDiscordCustom(content)
+	b.SetBytes(int64(len(content)))
+	b.ReportAllocs()
+	for b.Loop() {
+		emojiparser.ParseUnicode(content, custom)
+	}
+}
+
+// BenchmarkCountEmoteWall counts an emote wall without building results;
+// compare allocations with BenchmarkParseEmoteWall.
+func BenchmarkCountEmoteWall(b *testing.B) {
+	content := strings.Repeat("<:pepe:12345678901234567> 😄 :tada: 🎉", 100)
+	b.SetBytes(int64(len(content)))
+	b.ReportAllocs()
+	for b.Loop() {
+		emojiparser.Count(content)
+	}
+}
+
+func BenchmarkParseEmoteWall(b *testing.B) {
+	content := strings.Repeat("<:pepe:12345678901234567> 😄 :tada: 🎉", 100)
+	b.SetBytes(int64(len(content)))
+	b.ReportAllocs()
+	for b.Loop() {
+		emojiparser.Parse(content)
+	}
+}
+
+// BenchmarkContainsEmojiFree scans a long message with no emojis; compare
+// with BenchmarkContainsEmojiFirstWord.
+func BenchmarkContainsEmojiFree(b *testing.B) {
+	content := strings.Repeat("no emojis in this message at all, only words. ", 44)
+	b.SetBytes(int64(len(content)))
+	b.ReportAllocs()
+	for b.Loop() {
+		emojiparser.ContainsEmoji(content)
+	}
+}
+
+func BenchmarkContainsEmojiFirstWord(b *testing.B) {
+	content := "🎉 " + strings.Repeat("the rest of the message is just words. ", 50) + "😄"
+	b.SetBytes(int64(len(content)))
+	b.ReportAllocs()
+	for b.Loop() {
+		emojiparser.ContainsEmoji(content)
+	}
+}
+
+// BenchmarkAppendParse reuses one result buffer; compare allocations with
+// BenchmarkParseChatLog. Only the results' links are allocated.
+func BenchmarkAppendParse(b *testing.B) {
+	content := strings.Repeat("lmao 😂😂 gg :joy: <:pepe:12345678901234567>\n", 20)
+	buf := emojiparser.AppendParse(nil, content)
+	b.SetBytes(int64(len(content)))
+	b.ReportAllocs()
+	for b.Loop() {
+		buf = emojiparser.AppendParse(buf[:0], content)
+	}
+}
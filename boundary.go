@@ -0,0 +1,189 @@
+package emojiparser
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// StartsWithEmoji reports whether content begins with an emoji using the default parser.
+func StartsWithEmoji(content string) (ParsedEmoji, bool) {
+	return defaultParser.StartsWithEmoji(content)
+}
+
+// EndsWithEmoji reports whether content ends with an emoji using the default parser.
+func EndsWithEmoji(content string) (ParsedEmoji, bool) {
+	return defaultParser.EndsWithEmoji(content)
+}
+
+// StartsWithEmoji reports whether content begins with an emoji and returns
+// it: the first result of Parse, if it starts at 0. Only a window at the
+// start of content is scanned, twice as long as the longest unicode key,
+// shortcode, or custom emoji markup with a name Discord allows, and it only
+// grows while an emoji may run past it. Leading whitespace means content does
+// not start with an emoji. With markup skipping on, all of content is
+// scanned, since a code span may run through it.
+func (p *DiscordEmojiParser) StartsWithEmoji(content string) (ParsedEmoji, bool) {
+	state := p.state.Load()
+	span := p.boundarySpan(state)
+	n := 2 * span
+	if p.opts.markupKinds() != 0 {
+		n = len(content)
+	}
+	to, whole := p.windowEnd(state, content, n)
+	if !p.beginParse(content[:to]) {
+		return ParsedEmoji{}, false
+	}
+
+	var scanned string
+	var m *offsetMap
+	var first token
+	found := false
+	for {
+		scanned, m = p.rewriteContent(content[:to])
+		found = false
+		p.scan(state, scanned, scanAll, nil, func(t token) bool {
+			first, found = t, true
+			return false
+		})
+		// An emoji at 0 well inside the window cannot go on past it.
+		if whole || !found || first.from != 0 || first.to+span <= len(scanned) {
+			break
+		}
+		n *= 2
+		to, whole = p.windowEnd(state, content, n)
+	}
+	if !found || first.from != 0 {
+		return ParsedEmoji{}, false
+	}
+
+	var counts tokenCounts
+	counts.add(first.kind)
+	p.countTokens(counts)
+	emoji := p.emojiFor(state, scanned, first)
+	if m != nil {
+		emoji.Position = m.position(emoji.Position)
+	}
+	return emoji, true
+}
+
+// EndsWithEmoji reports whether content ends with an emoji and returns it,
+// with its position relative to content: the last result of Parse, if it
+// ends at len(content). Only a window at the end of content is scanned, as
+// in StartsWithEmoji. The window also takes in any run of regional
+// indicators or escaping backslashes it starts in, since how they pair up
+// depends on where the run begins. Trailing whitespace means content does
+// not end with an emoji.
+func (p *DiscordEmojiParser) EndsWithEmoji(content string) (ParsedEmoji, bool) {
+	state := p.state.Load()
+	span := p.boundarySpan(state)
+	n := 2 * span
+	if p.opts.markupKinds() != 0 {
+		n = len(content)
+	}
+	start, whole := p.windowStart(state, content, n)
+	if !p.beginParse(content[start:]) {
+		return ParsedEmoji{}, false
+	}
+
+	// Every matcher starts afresh after ASCII whitespace, and a window
+	// reaching a span before its last emoji scans the same tokens there as
+	// a scan over all of content. An emoji starting closer to the window's
+	// start may have started before it, like a long ZWJ sequence.
+	var scanned string
+	var m *offsetMap
+	var last token
+	found := false
+	for {
+		scanned, m = p.rewriteContent(content[start:])
+		found = false
+		p.scan(state, scanned, scanAll, nil, func(t token) bool {
+			last, found = t, true
+			return true
+		})
+		if whole || !found || last.to != len(scanned) || last.from >= span {
+			break
+		}
+		n *= 2
+		start, whole = p.windowStart(state, content, n)
+	}
+	if !found {
+		return ParsedEmoji{}, false
+	}
+
+	emoji := p.emojiFor(state, scanned, last)
+	if m != nil {
+		emoji.Position = m.position(emoji.Position)
+	}
+	emoji.Position.From += start
+	emoji.Position.To += start
+	if emoji.Position.To != len(content) {
+		return ParsedEmoji{}, false
+	}
+	var counts tokenCounts
+	counts.add(last.kind)
+	p.countTokens(counts)
+	return emoji, true
+}
+
+// boundarySpan returns the most bytes a single emoji can take before what
+// follows it may still change it: the longest unicode key with a skin tone
+// and a joiner after it, the longest shortcode with colons of either width
+// and a skin tone, or custom emoji markup with the longest name Discord
+// allows. Bytes IgnoreZeroWidth skips are not counted.
+func (p *DiscordEmojiParser) boundarySpan(state *parserState) int {
+	unicode := state.maxKeyLen + len(Tone1.Modifier()) + len(zeroWidthJoinerString)
+	name := max(state.maxNameLen, len(indicatorShortcodePrefix)+1) + len("_tone1")
+	shortcode := 2*len(fullwidthColon) + name + len(toneShortcodePrefix) + len("1:")
+	_, maxDigits := p.opts.snowflakeDigits()
+	custom := len("<a:") + maxCustomNameLen + len(":") + maxDigits + len(">")
+	return max(unicode, shortcode, custom)
+}
+
+// windowEnd returns the end of the first n bytes of content, rounded up to a
+// rune boundary, and whether that is all there is to scan: all of content,
+// or its first word when no key contains whitespace. Characters
+// IgnoreZeroWidth skips are not counted.
+func (p *DiscordEmojiParser) windowEnd(state *parserState, content string, n int) (int, bool) {
+	i := 0
+	for i < len(content) && n > 0 {
+		r, size := utf8.DecodeRuneInString(content[i:])
+		if !p.opts.IgnoreZeroWidth || !isIgnoredZeroWidth(r) {
+			n -= size
+		}
+		i += size
+	}
+	if !state.keyHasSpace && p.opts.markupKinds() == 0 {
+		if space := strings.IndexAny(content[:i], asciiSpace); space >= 0 {
+			return space, true
+		}
+	}
+	return i, i == len(content)
+}
+
+// windowStart returns the start of the last n bytes of content, counted as in
+// windowEnd, and whether that is all there is to scan. The start is moved back
+// to the beginning of a run of regional indicators or escaping backslashes it
+// falls in.
+func (p *DiscordEmojiParser) windowStart(state *parserState, content string, n int) (int, bool) {
+	i := len(content)
+	for i > 0 && n > 0 {
+		r, size := utf8.DecodeLastRuneInString(content[:i])
+		if !p.opts.IgnoreZeroWidth || !isIgnoredZeroWidth(r) {
+			n -= size
+		}
+		i -= size
+	}
+	for i > 0 {
+		r, size := utf8.DecodeLastRuneInString(content[:i])
+		if _, ok := regionalIndicatorLetter(r); !ok && (r != '\\' || !p.opts.Escapes) {
+			break
+		}
+		i -= size
+	}
+	if !state.keyHasSpace && p.opts.markupKinds() == 0 {
+		if space := strings.LastIndexAny(content[i:], asciiSpace); space >= 0 {
+			return i + space + 1, true
+		}
+	}
+	return i, i == 0
+}
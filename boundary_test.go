@@ -0,0 +1,188 @@
+package emojiparser_test
+
+import (
+	"strings"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestStartsWithEmoji(t *testing.T) {
+	cases := map[string]string{
+		"😄 hello":                       "smile",
+		":tada: party":                  "tada",
+		"<a:wave:12345678901234567> hi": "wave",
+		"👨‍👩‍👧‍👦 family":                "family_mwgb",
+		" 😄":                            "",
+		"hello 😄":                       "",
+		":not_a_name: 😄":                "",
+		"<:broken:12> 😄":                "",
+		"":                              "",
+	}
+	for input, want := range cases {
+		result, ok := emojiparser.StartsWithEmoji(input)
+		if ok != (want != "") {
+			t.Fatalf("StartsWithEmoji(%q): expected ok=%v", input, want != "")
+		}
+		if ok && (result.Name != want || result.Position.From != 0) {
+			t.Fatalf("StartsWithEmoji(%q): expected %s at 0, got %s at %d", input, want, result.Name, result.Position.From)
+		}
+	}
+}
+
+func TestEndsWithEmoji(t *testing.T) {
+	cases := map[string]string{
+		"hello 😄":                       "smile",
+		"party :tada:":                  "tada",
+		"hi <a:wave:12345678901234567>": "wave",
+		"family 👨‍👩‍👧‍👦":                "family_mwgb",
+		"a:b:smile:":                    "smile",
+		"😄 ":                            "",
+		"😄 hello":                       "",
+		"":                              "",
+	}
+	for input, want := range cases {
+		result, ok := emojiparser.EndsWithEmoji(input)
+		if ok != (want != "") {
+			t.Fatalf("EndsWithEmoji(%q): expected ok=%v", input, want != "")
+		}
+		if !ok {
+			continue
+		}
+		if result.Name != want || result.Position.To != len(input) {
+			t.Fatalf("EndsWithEmoji(%q): expected %s ending at %d, got %s ending at %d", input, want, len(input), result.Name, result.Position.To)
+		}
+		parsed := emojiparser.Parse(input)
+		if last := parsed[len(parsed)-1]; last.Position != result.Position {
+			t.Fatalf("EndsWithEmoji(%q): position %v disagrees with Parse %v", input, result.Position, last.Position)
+		}
+	}
+}
+
+func TestBoundaryToneShortcodes(t *testing.T) {
+	const toned = ":sob::skin-tone-3:"
+	for _, input := range []string{toned, toned + " tail", "head " + toned} {
+		want := emojiparser.Parse(input)
+		if first, ok := emojiparser.StartsWithEmoji(input); ok != (want[0].Position.From == 0) || ok && first.Position != want[0].Position {
+			t.Fatalf("StartsWithEmoji(%q) = %v, %v; Parse starts with %v", input, first.Position, ok, want[0].Position)
+		}
+		last := want[len(want)-1]
+		if result, ok := emojiparser.EndsWithEmoji(input); ok != (last.Position.To == len(input)) || ok && result.Position != last.Position {
+			t.Fatalf("EndsWithEmoji(%q) = %v, %v; Parse ends with %v", input, result.Position, ok, last.Position)
+		}
+	}
+	if result, ok := emojiparser.StartsWithEmoji(":wave::skin-tone-3: hi"); !ok || result.Position.To != 19 || result.Tone != emojiparser.Tone3 {
+		t.Fatalf("StartsWithEmoji = %+v, %v; want a toned wave ending at 19", result, ok)
+	}
+	if got := emojiparser.TrimLeftEmoji(toned + " hi"); got != " hi" {
+		t.Fatalf("TrimLeftEmoji = %q, want %q", got, " hi")
+	}
+	if got := emojiparser.TrimRightEmoji("hi " + toned); got != "hi " {
+		t.Fatalf("TrimRightEmoji = %q, want %q", got, "hi ")
+	}
+}
+
+func TestBoundaryEscapes(t *testing.T) {
+	parser := newTestParser(t, emojiparser.WithEscapes(true))
+	for _, input := range []string{`hi \:smile:`, `hi \<:pepe:12345678901234567>`, `\:smile:`} {
+		if result, ok := parser.EndsWithEmoji(input); ok {
+			t.Fatalf("EndsWithEmoji(%q) = %v, want none for escaped markup", input, result)
+		}
+		if got := parser.TrimRightEmoji(input); got != input {
+			t.Fatalf("TrimRightEmoji(%q) = %q, want it unchanged", input, got)
+		}
+	}
+	if result, ok := parser.EndsWithEmoji(`hi \\:smile:`); !ok || result.Position.From != 5 {
+		t.Fatalf("EndsWithEmoji after an escaped backslash = %v, %v; want smile at 5", result.Position, ok)
+	}
+}
+
+func TestEndsWithEmojiIndicatorRuns(t *testing.T) {
+	for n := 1; n <= 40; n++ {
+		for _, prefix := range []string{"", "x", "😄", "a "} {
+			input := prefix + strings.Repeat("\U0001F1FA", n)
+			parsed := emojiparser.Parse(input)
+			last := parsed[len(parsed)-1]
+			result, ok := emojiparser.EndsWithEmoji(input)
+			if !ok || result.Position != last.Position || result.Name != last.Name {
+				t.Fatalf("EndsWithEmoji(%d indicators after %q) = %s %v, %v; Parse ends with %s %v", n, prefix, result.Name, result.Position, ok, last.Name, last.Position)
+			}
+		}
+	}
+}
+
+func TestBoundaryFullwidthColons(t *testing.T) {
+	parser := newTestParser(t, emojiparser.WithAcceptFullwidthColons(true))
+	const smile = "：smile："
+	if result, ok := parser.StartsWithEmoji(smile + " hi"); !ok || result.Name != "smile" || result.Position.To != len(smile) {
+		t.Fatalf("StartsWithEmoji = %v, %v; want smile ending at %d", result, ok, len(smile))
+	}
+	if result, ok := parser.EndsWithEmoji("hi " + smile); !ok || result.Name != "smile" || result.Position.From != 3 {
+		t.Fatalf("EndsWithEmoji = %v, %v; want smile from 3", result, ok)
+	}
+	if got := parser.TrimEmoji(smile + "hi" + smile); got != "hi" {
+		t.Fatalf("TrimEmoji = %q, want %q", got, "hi")
+	}
+}
+
+func TestBoundaryIgnoreZeroWidth(t *testing.T) {
+	parser := newTestParser(t, emojiparser.WithIgnoreZeroWidth(true))
+	const split = ":smi\u200ble:"
+	parsed := parser.Parse(split)
+	if len(parsed) != 1 {
+		t.Fatalf("Parse(%q) found %d emojis, want 1", split, len(parsed))
+	}
+	for _, input := range []string{split, split + " tail", "head " + split} {
+		if result, ok := parser.StartsWithEmoji(input); ok != (input[0] == ':') || ok && result.Position != parsed[0].Position {
+			t.Fatalf("StartsWithEmoji(%q) = %v, %v; want %v", input, result.Position, ok, parsed[0].Position)
+		}
+		want := parsed[0].Position
+		if strings.HasPrefix(input, "head ") {
+			want.From += 5
+			want.To += 5
+		}
+		if result, ok := parser.EndsWithEmoji(input); ok != (input[len(input)-1] == ':') || ok && result.Position != want {
+			t.Fatalf("EndsWithEmoji(%q) = %v, %v; want %v", input, result.Position, ok, want)
+		}
+	}
+}
+
+func TestBoundaryLongTokens(t *testing.T) {
+	family := "\U0001F468‍\U0001F469‍\U0001F467"
+	chain := family + strings.Repeat("‍\U0001F466", 100)
+	inputs := []string{
+		chain,
+		"x" + chain + "y",
+		strings.Repeat("\U0001F1FA", 201),
+		strings.Repeat("\U0001F1FA", 200),
+		"😄" + strings.Repeat("é", 1000),
+		strings.Repeat("é", 1000) + "😄",
+		strings.Repeat("a", 1000) + ":smile:",
+		":smile:" + strings.Repeat("a", 1000),
+		strings.Repeat("😄", 500),
+		strings.Repeat(`\`, 301) + ":smile:",
+		strings.Repeat(`\`, 300) + ":smile:",
+	}
+	for _, opts := range [][]emojiparser.Option{nil, {emojiparser.WithEscapes(true)}} {
+		parser := newTestParser(t, opts...)
+		for _, input := range inputs {
+			parsed := parser.Parse(input)
+			first, ok := parser.StartsWithEmoji(input)
+			if want := len(parsed) > 0 && parsed[0].Position.From == 0; ok != want || ok && first.Position != parsed[0].Position {
+				t.Fatalf("StartsWithEmoji(%.20q…) = %v, %v; Parse = %v", input, first.Position, ok, parsed)
+			}
+			last, ok := parser.EndsWithEmoji(input)
+			if want := len(parsed) > 0 && parsed[len(parsed)-1].Position.To == len(input); ok != want || ok && last.Position != parsed[len(parsed)-1].Position {
+				t.Fatalf("EndsWithEmoji(…%.20q) = %v, %v; Parse ends with %v", input[max(0, len(input)-20):], last.Position, ok, parsed[len(parsed)-1:])
+			}
+		}
+	}
+}
+
+func BenchmarkBoundaryLongWord(b *testing.B) {
+	content := "😄" + strings.Repeat("é", 1<<20) + "😄"
+	for b.Loop() {
+		emojiparser.StartsWithEmoji(content)
+		emojiparser.EndsWithEmoji(content)
+	}
+}
@@ -0,0 +1,112 @@
+package emojiparser
+
+import (
+	"strings"
+	"unsafe"
+)
+
+// ParseBytes parses all emoji types from content using the default parser.
+func ParseBytes(content []byte) []ParsedEmoji {
+	return defaultParser.ParseBytes(content)
+}
+
+// ParseUnicodeBytes parses unicode emojis from content using the default
+// parser.
+func ParseUnicodeBytes(content []byte, skipRanges []ParsedEmoji) []ParsedEmoji {
+	return defaultParser.ParseUnicodeBytes(content, skipRanges)
+}
+
+// ParseTextRepresentationBytes parses text emojis from content using the
+// default parser.
+func ParseTextRepresentationBytes(content []byte, skipRanges []ParsedEmoji) []ParsedEmoji {
+	return defaultParser.ParseTextRepresentationBytes(content, skipRanges)
+}
+
+// ParseDiscordCustomBytes parses custom emojis from content using the default
+// parser.
+func ParseDiscordCustomBytes(content []byte) []ParsedEmoji {
+	return defaultParser.ParseDiscordCustomBytes(content)
+}
+
+// CountBytes returns the number of emojis in content using the default
+// parser.
+func CountBytes(content []byte) int {
+	return defaultParser.CountBytes(content)
+}
+
+// ContainsEmojiBytes reports whether content contains any emoji using the
+// default parser.
+func ContainsEmojiBytes(content []byte) bool {
+	return defaultParser.ContainsEmojiBytes(content)
+}
+
+// ParseBytes is Parse for content held in a byte slice, without copying it
+// to a string first. Positions are byte offsets into content. The results
+// never refer to content's memory, so the caller may reuse the slice as soon
+// as ParseBytes returns; content must not be modified during the call.
+func (p *DiscordEmojiParser) ParseBytes(content []byte) []ParsedEmoji {
+	return detachResults(p.Parse(bytesView(content)), content)
+}
+
+// ParseUnicodeBytes is ParseUnicode for content held in a byte slice, with the
+// guarantees of ParseBytes.
+func (p *DiscordEmojiParser) ParseUnicodeBytes(content []byte, skipRanges []ParsedEmoji) []ParsedEmoji {
+	return detachResults(p.ParseUnicode(bytesView(content), skipRanges), content)
+}
+
+// ParseTextRepresentationBytes is ParseTextRepresentation for content held in
+// a byte slice, with the guarantees of ParseBytes.
+func (p *DiscordEmojiParser) ParseTextRepresentationBytes(content []byte, skipRanges []ParsedEmoji) []ParsedEmoji {
+	return detachResults(p.ParseTextRepresentation(bytesView(content), skipRanges), content)
+}
+
+// ParseDiscordCustomBytes is ParseDiscordCustom for content held in a byte
+// slice, with the guarantees of ParseBytes.
+func (p *DiscordEmojiParser) ParseDiscordCustomBytes(content []byte) []ParsedEmoji {
+	return detachResults(p.ParseDiscordCustom(bytesView(content)), content)
+}
+
+// CountBytes is Count for content held in a byte slice.
+func (p *DiscordEmojiParser) CountBytes(content []byte) int {
+	return p.Count(bytesView(content))
+}
+
+// ContainsEmojiBytes is ContainsEmoji for content held in a byte slice.
+func (p *DiscordEmojiParser) ContainsEmojiBytes(content []byte) bool {
+	return p.ContainsEmoji(bytesView(content))
+}
+
+// bytesView returns a string sharing b's memory. It must not outlive the
+// call it is made for, and b must not change while it is in use.
+func bytesView(b []byte) string {
+	return unsafe.String(unsafe.SliceData(b), len(b))
+}
+
+// detachResults copies every string of results that points into content, so
+// that none of them keeps a reference to it.
+func detachResults(results []ParsedEmoji, content []byte) []ParsedEmoji {
+	for i := range results {
+		emoji := &results[i]
+		emoji.Name = detachString(emoji.Name, content)
+		emoji.Unicode = detachString(emoji.Unicode, content)
+		if emoji.ID != nil {
+			id := detachString(*emoji.ID, content)
+			emoji.ID = &id
+		}
+	}
+	return results
+}
+
+// detachString returns a copy of s if it points into content, and s itself
+// otherwise.
+func detachString(s string, content []byte) string {
+	if len(s) == 0 || len(content) == 0 {
+		return s
+	}
+	start := uintptr(unsafe.Pointer(unsafe.SliceData(content)))
+	at := uintptr(unsafe.Pointer(unsafe.StringData(s)))
+	if at < start || at >= start+uintptr(len(content)) {
+		return s
+	}
+	return strings.Clone(s)
+}
@@ -0,0 +1,54 @@
+package emojiparser_test
+
+import (
+	"reflect"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestParseBytes(t *testing.T) {
+	for _, content := range countInputs {
+		if got, want := emojiparser.ParseBytes([]byte(content)), emojiparser.Parse(content); !reflect.DeepEqual(got, want) {
+			t.Fatalf("ParseBytes(%q) = %v, want %v", content, got, want)
+		}
+		if got, want := emojiparser.CountBytes([]byte(content)), emojiparser.Count(content); got != want {
+			t.Fatalf("CountBytes(%q) = %d, want %d", content, got, want)
+		}
+		if got, want := emojiparser.ContainsEmojiBytes([]byte(content)), emojiparser.ContainsEmoji(content); got != want {
+			t.Fatalf("ContainsEmojiBytes(%q) = %v, want %v", content, got, want)
+		}
+	}
+}
+
+func TestParseBytesTypes(t *testing.T) {
+	content := "😄 <:smile:12345678901234567> :tada: 🔥"
+	custom := emojiparser.ParseDiscordCustom(content)
+	cases := []struct {
+		name string
+		got  []emojiparser.ParsedEmoji
+		want []emojiparser.ParsedEmoji
+	}{
+		{"unicode", emojiparser.ParseUnicodeBytes([]byte(content), custom), emojiparser.ParseUnicode(content, custom)},
+		{"text", emojiparser.ParseTextRepresentationBytes([]byte(content), custom), emojiparser.ParseTextRepresentation(content, custom)},
+		{"custom", emojiparser.ParseDiscordCustomBytes([]byte(content)), custom},
+	}
+	for _, c := range cases {
+		if !reflect.DeepEqual(c.got, c.want) {
+			t.Fatalf("%s: got %v, want %v", c.name, c.got, c.want)
+		}
+	}
+}
+
+func TestParseBytesDoesNotRetainContent(t *testing.T) {
+	content := "hi 😄 <a:wave:12345678901234567> :tada:"
+	buf := []byte(content)
+	got := emojiparser.ParseBytes(buf)
+	want := emojiparser.Parse(content)
+	for i := range buf {
+		buf[i] = 'x'
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("results changed with the input slice: %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,81 @@
+package emojiparser_test
+
+import (
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestCloneIsolatesRegistrations(t *testing.T) {
+	original, err := emojiparser.NewDiscordEmojiParser()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	clone, err := original.Clone()
+	if err != nil {
+		t.Fatalf("clone: %v", err)
+	}
+
+	if _, err := clone.RegisterShortcode("octo", "🐙"); err != nil {
+		t.Fatalf("register shortcode: %v", err)
+	}
+	if err := clone.RegisterCustomEmoji("wave", "12345678901234567"); err != nil {
+		t.Fatalf("register custom emoji: %v", err)
+	}
+
+	if got := clone.ParseTextRepresentation(":octo:", nil); len(got) != 1 {
+		t.Fatalf("clone parsed %d results for :octo:, want 1", len(got))
+	}
+	if got := original.ParseTextRepresentation(":octo:", nil); len(got) != 0 {
+		t.Fatalf("original sees the clone's shortcode: %+v", got)
+	}
+	if original.ContainsEmojiNamed("<:other:12345678901234567>", "wave") {
+		t.Fatal("original sees the clone's custom emoji")
+	}
+	if !clone.ContainsEmojiNamed("<:other:12345678901234567>", "wave") {
+		t.Fatal("clone lost its custom emoji")
+	}
+}
+
+func TestCloneKeepsRegistrationsAndOverridesOptions(t *testing.T) {
+	original, err := emojiparser.NewDiscordEmojiParser(emojiparser.WithCustomLinkTemplate("https://a.example/{id}"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := original.RegisterShortcode("octo", "🐙"); err != nil {
+		t.Fatalf("register shortcode: %v", err)
+	}
+
+	clone, err := original.Clone(emojiparser.WithCustomLinkTemplate("https://b.example/{id}"))
+	if err != nil {
+		t.Fatalf("clone: %v", err)
+	}
+	if got := clone.ParseTextRepresentation(":octo:", nil); len(got) != 1 {
+		t.Fatalf("clone parsed %d results for :octo:, want the original's registration", len(got))
+	}
+
+	content := "<:wave:12345678901234567>"
+	if got := *original.ParseDiscordCustom(content)[0].Link; got != "https://a.example/12345678901234567" {
+		t.Fatalf("original link = %q", got)
+	}
+	if got := *clone.ParseDiscordCustom(content)[0].Link; got != "https://b.example/12345678901234567" {
+		t.Fatalf("clone link = %q", got)
+	}
+
+	if _, err := original.Clone(emojiparser.WithUnicodeLinkTemplate("{nope}")); err == nil {
+		t.Fatal("expected an error for an invalid template")
+	}
+}
+
+func BenchmarkClone(b *testing.B) {
+	parser, err := emojiparser.NewDiscordEmojiParser()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := parser.Clone(emojiparser.WithMetrics(true)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package emojiparser
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrInvalidCodePoint is returned by FromCodePoints for input that is not a
+// list of hex code points. It is wrapped with the offending part, so compare
+// with errors.Is.
+var ErrInvalidCodePoint = errors.New("invalid code point")
+
+// codePointOptions holds the settings of a CodePoints call.
+type codePointOptions struct {
+	separator string
+	upper     bool
+	uPlus     bool
+	twemoji   bool
+}
+
+// CodePointOption configures a single CodePoints call.
+type CodePointOption func(*codePointOptions)
+
+// WithCodePointSeparator sets the text CodePoints puts between code points.
+// The default is "-", as in asset file names.
+func WithCodePointSeparator(separator string) CodePointOption {
+	return func(o *codePointOptions) {
+		o.separator = separator
+	}
+}
+
+// WithUppercaseCodePoints makes CodePoints write hex digits in uppercase.
+func WithUppercaseCodePoints(upper bool) CodePointOption {
+	return func(o *codePointOptions) {
+		o.upper = upper
+	}
+}
+
+// WithUPlusPrefix makes CodePoints write every code point as U+ and at least
+// four hex digits, as in "U+0023".
+func WithUPlusPrefix(uPlus bool) CodePointOption {
+	return func(o *codePointOptions) {
+		o.uPlus = uPlus
+	}
+}
+
+// WithTwemojiStyle makes CodePoints leave out U+FE0F and U+200D, the way
+// Twemoji names its image files.
+func WithTwemojiStyle(twemoji bool) CodePointOption {
+	return func(o *codePointOptions) {
+		o.twemoji = twemoji
+	}
+}
+
+// CodePoints returns the code points of emoji in hex. By default they are
+// lowercase and separated by dashes, the form Discord's asset links use, so
+// the keycap for # becomes "23-fe0f-20e3".
+func CodePoints(emoji string, opts ...CodePointOption) string {
+	o := codePointOptions{separator: "-"}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	format := "%x"
+	switch {
+	case o.uPlus && o.upper:
+		format = "U+%04X"
+	case o.uPlus:
+		format = "U+%04x"
+	case o.upper:
+		format = "%X"
+	}
+	var b []byte
+	for _, r := range emoji {
+		if o.twemoji && (r == '\uFE0F' || r == zeroWidthJoiner) {
+			continue
+		}
+		if len(b) > 0 {
+			b = append(b, o.separator...)
+		}
+		b = fmt.Appendf(b, format, r)
+	}
+	return string(b)
+}
+
+// CodePoints returns the code points of the emoji the result stands for,
+// formatted the way CodePoints formats them by default. Custom emojis have
+// none and return "".
+func (e ParsedEmoji) CodePoints() string {
+	if e.Type == EmojiTypeCustom {
+		return ""
+	}
+	return CodePoints(e.Unicode)
+}
+
+// FromCodePoints returns the string whose code points s lists in hex, the
+// reverse of CodePoints, so "1f468-200d-1f4bb" becomes the man technologist
+// emoji. The points may be separated by dashes or spaces, in either case, and
+// each may have a U+ prefix, as in "U+1F468 U+200D U+1F4BB". It fails for
+// empty input, malformed hex, and values that are not valid runes, such as
+// surrogates. The result need not be an emoji; see IsKnown.
+func FromCodePoints(s string) (string, error) {
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return r == '-' || r == ' '
+	})
+	if len(fields) == 0 {
+		return "", fmt.Errorf("%w: no code points in %q", ErrInvalidCodePoint, s)
+	}
+	b := make([]byte, 0, len(fields)*4)
+	for _, field := range fields {
+		digits := field
+		if len(digits) > 2 && (digits[0] == 'U' || digits[0] == 'u') && digits[1] == '+' {
+			digits = digits[2:]
+		}
+		n, err := strconv.ParseUint(digits, 16, 32)
+		if err != nil || !utf8.ValidRune(rune(n)) {
+			return "", fmt.Errorf("%w: %q", ErrInvalidCodePoint, field)
+		}
+		b = utf8.AppendRune(b, rune(n))
+	}
+	return string(b), nil
+}
+
+// IsKnown reports whether emoji is in the default parser's tables.
+func IsKnown(emoji string) bool {
+	return defaultParser.IsKnown(emoji)
+}
+
+// IsKnown reports whether emoji is one of the parser's emojis, ignoring
+// U+FE0F so that the output of FromCodePoints for Twemoji file names is
+// known too. Flags the parser generates shortcodes for count, shortcode
+// names and custom emojis do not.
+func (p *DiscordEmojiParser) IsKnown(emoji string) bool {
+	state := p.state.Load()
+	if _, ok := state.qualifiedForm(emoji); ok {
+		return true
+	}
+	if emoji == "" || flagSequence(emoji) != len(emoji) {
+		return false
+	}
+	_, ok := state.svg[toCodePoint(emoji, "-")]
+	return ok
+}
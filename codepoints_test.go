@@ -0,0 +1,88 @@
+package emojiparser_test
+
+import (
+	"errors"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestCodePoints(t *testing.T) {
+	const (
+		couple = "\U0001F469\u200d❤\ufe0f\u200d\U0001F468"
+		flag   = "\U0001F1E7\U0001F1EC"
+		keycap = "#\ufe0f\u20e3"
+	)
+	cases := []struct {
+		emoji string
+		opts  []emojiparser.CodePointOption
+		want  string
+	}{
+		{couple, nil, "1f469-200d-2764-fe0f-200d-1f468"},
+		{couple, []emojiparser.CodePointOption{emojiparser.WithTwemojiStyle(true)}, "1f469-2764-1f468"},
+		{flag, nil, "1f1e7-1f1ec"},
+		{flag, []emojiparser.CodePointOption{emojiparser.WithUppercaseCodePoints(true), emojiparser.WithCodePointSeparator("_")}, "1F1E7_1F1EC"},
+		{keycap, nil, "23-fe0f-20e3"},
+		{keycap, []emojiparser.CodePointOption{emojiparser.WithUPlusPrefix(true), emojiparser.WithUppercaseCodePoints(true), emojiparser.WithCodePointSeparator(" ")}, "U+0023 U+FE0F U+20E3"},
+		{keycap, []emojiparser.CodePointOption{emojiparser.WithUPlusPrefix(true), emojiparser.WithTwemojiStyle(true)}, "U+0023-U+20e3"},
+		{"", nil, ""},
+	}
+	for _, c := range cases {
+		if got := emojiparser.CodePoints(c.emoji, c.opts...); got != c.want {
+			t.Fatalf("CodePoints(%q) = %q, want %q", c.emoji, got, c.want)
+		}
+	}
+}
+
+func TestParsedEmojiCodePoints(t *testing.T) {
+	results := emojiparser.Parse("\U0001F1E7\U0001F1EC :smile: <:pepe:12345678901234567>")
+	if len(results) != 3 {
+		t.Fatalf("Parse found %d emojis, want 3", len(results))
+	}
+	for i, want := range []string{"1f1e7-1f1ec", "1f604", ""} {
+		if got := results[i].CodePoints(); got != want {
+			t.Fatalf("results[%d].CodePoints() = %q, want %q", i, got, want)
+		}
+	}
+}
+
+func TestFromCodePoints(t *testing.T) {
+	const technologist = "\U0001F468\u200d\U0001F4BB"
+	for _, input := range []string{"1f468-200d-1f4bb", "1F468 200D 1F4BB", "U+1F468 U+200D U+1F4BB", "u+1f468-u+200d-u+1f4bb"} {
+		got, err := emojiparser.FromCodePoints(input)
+		if err != nil {
+			t.Fatalf("FromCodePoints(%q) failed: %v", input, err)
+		}
+		if got != technologist {
+			t.Fatalf("FromCodePoints(%q) = %q, want %q", input, got, technologist)
+		}
+	}
+	for _, input := range []string{"", " - ", "1f4g8", "U+", "d800", "110000", "ffffffffff", "+1f468", "0x1f468"} {
+		if _, err := emojiparser.FromCodePoints(input); !errors.Is(err, emojiparser.ErrInvalidCodePoint) {
+			t.Fatalf("FromCodePoints(%q) error = %v, want ErrInvalidCodePoint", input, err)
+		}
+	}
+}
+
+func TestIsKnown(t *testing.T) {
+	cases := map[string]bool{
+		"\U0001F468\u200d\U0001F4BB": true,
+		"❤\ufe0f":                    true,
+		"❤":                          true,
+		"\U0001F1E7\U0001F1EC":       true,
+		"\U0001F468\U0001F4BB":       false,
+		"\U0001F1FF\U0001F1FF":       false,
+		"smile":                      false,
+		"<:pepe:12345678901234567>":  false,
+		"":                           false,
+	}
+	for emoji, want := range cases {
+		if got := emojiparser.IsKnown(emoji); got != want {
+			t.Fatalf("IsKnown(%q) = %v, want %v", emoji, got, want)
+		}
+	}
+	twemoji, err := emojiparser.FromCodePoints("2764")
+	if err != nil || !emojiparser.IsKnown(twemoji) {
+		t.Fatalf("FromCodePoints(%q) = %q, %v; want a known emoji", "2764", twemoji, err)
+	}
+}
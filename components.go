@@ -0,0 +1,166 @@
+package emojiparser
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// PartialEmoji is Discord's partial emoji object, as found on buttons, select
+// options, poll answers, and reactions. Custom emojis have an ID; for unicode
+// emojis Name holds the emoji itself.
+type PartialEmoji struct {
+	ID       *string `json:"id"`
+	Name     *string `json:"name"`
+	Animated bool    `json:"animated,omitempty"`
+}
+
+// ComponentEmoji is an emoji found by ExtractComponentEmojis, together with
+// the JSON path of the object it was read from, such as
+// "components[0].components[1].emoji".
+type ComponentEmoji struct {
+	ParsedEmoji
+	Path string
+}
+
+// ParsePartialEmoji converts a partial emoji object using the default parser.
+func ParsePartialEmoji(emoji PartialEmoji) (ParsedEmoji, error) {
+	return defaultParser.ParsePartialEmoji(emoji)
+}
+
+// ExtractComponentEmojis extracts component and poll emojis using the default parser.
+func ExtractComponentEmojis(raw []byte) ([]ComponentEmoji, error) {
+	return defaultParser.ExtractComponentEmojis(raw)
+}
+
+// ParsePartialEmoji converts a partial emoji object to a parse result. An
+// object with an ID becomes a custom emoji whose Unicode holds the equivalent
+// markup; Discord omits the name of emojis it no longer has data for, in
+// which case Name and Unicode are empty. An object without an ID must name a
+// single known unicode emoji. The result has no position.
+func (p *DiscordEmojiParser) ParsePartialEmoji(emoji PartialEmoji) (ParsedEmoji, error) {
+	name := ""
+	if emoji.Name != nil {
+		name = *emoji.Name
+	}
+
+	if emoji.ID != nil {
+		id := *emoji.ID
+		if !p.validSnowflake(id) {
+			return ParsedEmoji{}, fmt.Errorf("partial emoji %q: invalid id %q", name, id)
+		}
+		if name != "" && !isShortcodeName(name) {
+			return ParsedEmoji{}, fmt.Errorf("partial emoji %s: invalid name %q", id, name)
+		}
+		result := p.newCustomEmoji(name, id, emoji.Animated)
+		if name != "" {
+			prefix := "<:"
+			if emoji.Animated {
+				prefix = "<a:"
+			}
+			result.Unicode = prefix + name + ":" + id + ">"
+		}
+		return result, nil
+	}
+
+	if name == "" {
+		return ParsedEmoji{}, fmt.Errorf("partial emoji: %w", ErrEmptyEmoji)
+	}
+	result, ok := p.singleUnicode(p.state.Load(), name)
+	if !ok {
+		return ParsedEmoji{}, fmt.Errorf("partial emoji %q: %w", name, ErrNotEmoji)
+	}
+	result.Position = EmojiPosition{}
+	return result, nil
+}
+
+// componentPayload is the part of a message or interaction payload that
+// ExtractComponentEmojis walks. Interactions carry the message they were
+// triggered on under "message".
+type componentPayload struct {
+	Components []payloadComponent `json:"components"`
+	Poll       *struct {
+		Answers []struct {
+			PollMedia struct {
+				Emoji json.RawMessage `json:"emoji"`
+			} `json:"poll_media"`
+		} `json:"answers"`
+	} `json:"poll"`
+	Message *componentPayload `json:"message"`
+}
+
+// payloadComponent is a message component. Action rows and layout components
+// nest further components; buttons carry an emoji and select menus carry
+// options with emojis.
+type payloadComponent struct {
+	Emoji      json.RawMessage    `json:"emoji"`
+	Components []payloadComponent `json:"components"`
+	Options    []struct {
+		Emoji json.RawMessage `json:"emoji"`
+	} `json:"options"`
+}
+
+// ExtractComponentEmojis returns the emojis of the components and poll
+// answers in raw, a message object or an interaction payload with the
+// message under "message". It reads components[].emoji and
+// components[].options[].emoji at any nesting depth and
+// poll.answers[].poll_media.emoji, in document order, and converts each
+// object with ParsePartialEmoji. Missing sections are skipped.
+//
+// If raw is not JSON of that shape, ExtractComponentEmojis returns only an
+// error. Emoji objects that cannot be converted are left out of the results
+// and reported together, with their paths, in the joined error, so both
+// results and an error may be returned.
+func (p *DiscordEmojiParser) ExtractComponentEmojis(raw []byte) ([]ComponentEmoji, error) {
+	var payload componentPayload
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil, fmt.Errorf("extract component emojis: %w", err)
+	}
+
+	var (
+		results []ComponentEmoji
+		errs    []error
+	)
+	add := func(path string, raw json.RawMessage) {
+		if len(raw) == 0 || string(raw) == "null" {
+			return
+		}
+		var partial PartialEmoji
+		if err := json.Unmarshal(raw, &partial); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", path, err))
+			return
+		}
+		emoji, err := p.ParsePartialEmoji(partial)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", path, err))
+			return
+		}
+		results = append(results, ComponentEmoji{ParsedEmoji: emoji, Path: path})
+	}
+
+	var walkComponents func(prefix string, components []payloadComponent)
+	walkComponents = func(prefix string, components []payloadComponent) {
+		for i, component := range components {
+			path := fmt.Sprintf("%scomponents[%d]", prefix, i)
+			add(path+".emoji", component.Emoji)
+			for j, option := range component.Options {
+				add(fmt.Sprintf("%s.options[%d].emoji", path, j), option.Emoji)
+			}
+			walkComponents(path+".", component.Components)
+		}
+	}
+	walkPayload := func(prefix string, payload *componentPayload) {
+		walkComponents(prefix, payload.Components)
+		if payload.Poll != nil {
+			for i, answer := range payload.Poll.Answers {
+				add(fmt.Sprintf("%spoll.answers[%d].poll_media.emoji", prefix, i), answer.PollMedia.Emoji)
+			}
+		}
+	}
+
+	walkPayload("", &payload)
+	if payload.Message != nil {
+		walkPayload("message.", payload.Message)
+	}
+	return results, errors.Join(errs...)
+}
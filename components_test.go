@@ -0,0 +1,111 @@
+package emojiparser_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+const buttonsMessage = `{
+	"content": "pick one",
+	"components": [
+		{"type": 1, "components": [
+			{"type": 2, "label": "Yes", "emoji": {"id": null, "name": "👍"}},
+			{"type": 2, "label": "Wave", "emoji": {"id": "12345678901234567", "name": "wave", "animated": true}},
+			{"type": 2, "label": "Plain"}
+		]},
+		{"type": 1, "components": [
+			{"type": 3, "options": [
+				{"label": "Party", "value": "p", "emoji": {"name": "🎉"}},
+				{"label": "None", "value": "n"}
+			]}
+		]}
+	]
+}`
+
+const pollInteraction = `{
+	"type": 3,
+	"message": {
+		"poll": {"answers": [
+			{"answer_id": 1, "poll_media": {"text": "Cats", "emoji": {"id": null, "name": "🐱"}}},
+			{"answer_id": 2, "poll_media": {"text": "Dogs", "emoji": {"id": "22345678901234567", "name": null}}},
+			{"answer_id": 3, "poll_media": {"text": "Neither"}}
+		]}
+	}
+}`
+
+func TestExtractComponentEmojisButtons(t *testing.T) {
+	results, err := emojiparser.ExtractComponentEmojis([]byte(buttonsMessage))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []struct {
+		path  string
+		typ   emojiparser.EmojiType
+		name  string
+		value string
+	}{
+		{"components[0].components[0].emoji", emojiparser.EmojiTypeUnicode, "thumbup", "👍"},
+		{"components[0].components[1].emoji", emojiparser.EmojiTypeCustom, "wave", "<a:wave:12345678901234567>"},
+		{"components[1].components[0].options[0].emoji", emojiparser.EmojiTypeUnicode, "tada", "🎉"},
+	}
+	if len(results) != len(want) {
+		t.Fatalf("got %d results, want %d: %v", len(results), len(want), results)
+	}
+	for i, w := range want {
+		got := results[i]
+		if got.Path != w.path || got.Type != w.typ || got.Name != w.name || got.Unicode != w.value {
+			t.Fatalf("result %d = %s %v, want %s %s %q %s", i, got.Path, got.ParsedEmoji, w.path, w.typ, w.name, w.value)
+		}
+	}
+	if !results[1].Animated || *results[1].Link != "https://cdn.discordapp.com/emojis/12345678901234567.gif" {
+		t.Fatalf("custom emoji = %+v", results[1].ParsedEmoji)
+	}
+}
+
+func TestExtractComponentEmojisPoll(t *testing.T) {
+	results, err := emojiparser.ExtractComponentEmojis([]byte(pollInteraction))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2: %v", len(results), results)
+	}
+	if results[0].Path != "message.poll.answers[0].poll_media.emoji" || results[0].Unicode != "🐱" {
+		t.Fatalf("first result = %s %v", results[0].Path, results[0].ParsedEmoji)
+	}
+	if results[1].Path != "message.poll.answers[1].poll_media.emoji" || *results[1].ID != "22345678901234567" || results[1].Name != "" {
+		t.Fatalf("second result = %s %v", results[1].Path, results[1].ParsedEmoji)
+	}
+}
+
+func TestExtractComponentEmojisCollectsErrors(t *testing.T) {
+	raw := `{"components": [{"type": 1, "components": [
+		{"type": 2, "emoji": {"name": "not an emoji"}},
+		{"type": 2, "emoji": {"id": "12", "name": "short"}},
+		{"type": 2, "emoji": "🎉"},
+		{"type": 2, "emoji": {"name": "🎉"}}
+	]}]}`
+	results, err := emojiparser.ExtractComponentEmojis([]byte(raw))
+	if len(results) != 1 || results[0].Path != "components[0].components[3].emoji" {
+		t.Fatalf("results = %v", results)
+	}
+	if !errors.Is(err, emojiparser.ErrNotEmoji) {
+		t.Fatalf("error = %v, want ErrNotEmoji among the joined errors", err)
+	}
+	for _, path := range []string{"components[0].components[0].emoji", "components[0].components[1].emoji", "components[0].components[2].emoji"} {
+		if !strings.Contains(err.Error(), path) {
+			t.Fatalf("error %q does not mention %s", err, path)
+		}
+	}
+
+	if _, err := emojiparser.ExtractComponentEmojis([]byte(`{"components": {}}`)); err == nil {
+		t.Fatal("expected an error for a malformed payload")
+	}
+	if results, err := emojiparser.ExtractComponentEmojis([]byte(`{"content": "hi"}`)); err != nil || len(results) != 0 {
+		t.Fatalf("payload without components = %v, %v", results, err)
+	}
+}
@@ -0,0 +1,149 @@
+package emojiparser
+
+import "strings"
+
+// ContainsEmoji reports whether content contains any emoji using the default
+// parser.
+func ContainsEmoji(content string) bool {
+	return defaultParser.ContainsEmoji(content)
+}
+
+// ContainsCustomEmoji reports whether content contains a custom emoji using
+// the default parser.
+func ContainsCustomEmoji(content string) bool {
+	return defaultParser.ContainsCustomEmoji(content)
+}
+
+// ContainsUnicodeEmoji reports whether content contains a unicode emoji using
+// the default parser.
+func ContainsUnicodeEmoji(content string) bool {
+	return defaultParser.ContainsUnicodeEmoji(content)
+}
+
+// ContainsTextEmoji reports whether content contains a text emoji using the
+// default parser.
+func ContainsTextEmoji(content string) bool {
+	return defaultParser.ContainsTextEmoji(content)
+}
+
+// ContainsEmoji reports whether len(p.Parse(content)) > 0. It stops scanning
+// at the first emoji.
+func (p *DiscordEmojiParser) ContainsEmoji(content string) bool {
+	return p.contains(content, "")
+}
+
+// ContainsCustomEmoji reports whether Parse would report a custom emoji for
+// content. It stops scanning at the first one.
+func (p *DiscordEmojiParser) ContainsCustomEmoji(content string) bool {
+	return p.contains(content, EmojiTypeCustom)
+}
+
+// ContainsUnicodeEmoji reports whether Parse would report a unicode emoji for
+// content. It stops scanning at the first one, and a unicode emoji inside
+// custom emoji markup does not count.
+func (p *DiscordEmojiParser) ContainsUnicodeEmoji(content string) bool {
+	return p.contains(content, EmojiTypeUnicode)
+}
+
+// ContainsTextEmoji reports whether Parse would report a text emoji for
+// content. It stops scanning at the first one, and a shortcode inside custom
+// emoji markup does not count.
+func (p *DiscordEmojiParser) ContainsTextEmoji(content string) bool {
+	return p.contains(content, EmojiTypeText)
+}
+
+// contains reports whether content has an emoji of the given kind, or of any
+// kind if kind is empty. All kinds are scanned so that skipping matches as
+// Parse does.
+func (p *DiscordEmojiParser) contains(content string, kind EmojiType) bool {
+	return p.containsToken(content, func(_ *parserState, t token) bool {
+		return kind == "" || t.kind == kind
+	})
+}
+
+// ContainsName reports whether content contains an emoji called name using
+// the default parser.
+func ContainsName(content, name string) bool {
+	return defaultParser.ContainsName(content, name)
+}
+
+// ContainsAnyName reports whether content contains an emoji called any of
+// names using the default parser.
+func ContainsAnyName(content string, names ...string) bool {
+	return defaultParser.ContainsAnyName(content, names...)
+}
+
+// ContainsCustomID reports whether content contains the custom emoji with
+// the given ID using the default parser.
+func ContainsCustomID(content, id string) bool {
+	return defaultParser.ContainsCustomID(content, id)
+}
+
+// ContainsName reports whether Parse would report an emoji called name, with
+// or without colons, for content: a custom emoji or shortcode written with
+// that name, or a unicode emoji with name among its shortcodes. It stops
+// scanning at the first one. With WithCaseInsensitiveNames, case is ignored.
+//
+// Unlike ContainsEmojiNamed, a unicode emoji does not match the name of a
+// custom emoji, and a custom emoji matches by the name it is written with.
+func (p *DiscordEmojiParser) ContainsName(content, name string) bool {
+	return p.ContainsAnyName(content, name)
+}
+
+// ContainsAnyName is ContainsName for several names at once, as for an
+// allowlist.
+func (p *DiscordEmojiParser) ContainsAnyName(content string, names ...string) bool {
+	wanted := make(map[string]bool, len(names))
+	for _, name := range names {
+		wanted[p.foldName(strings.Trim(name, ":"))] = true
+	}
+	return p.containsToken(content, func(state *parserState, t token) bool {
+		if t.kind != EmojiTypeUnicode {
+			return wanted[p.foldName(t.name)]
+		}
+		if state.unicodeToName[t.key] == "" {
+			return wanted[p.foldName(generatedName(t.key))]
+		}
+		for _, name := range state.namesFor(t.key) {
+			if wanted[p.foldName(name)] {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// ContainsCustomID reports whether Parse would report a custom emoji with the
+// given ID for content. It stops scanning at the first one.
+func (p *DiscordEmojiParser) ContainsCustomID(content, id string) bool {
+	return p.containsToken(content, func(_ *parserState, t token) bool {
+		return t.kind == EmojiTypeCustom && t.id == id
+	})
+}
+
+// foldName returns name as ContainsName compares it.
+func (p *DiscordEmojiParser) foldName(name string) string {
+	if p.opts.CaseInsensitiveNames {
+		return strings.ToLower(name)
+	}
+	return name
+}
+
+// containsToken reports whether content has an emoji for which match returns
+// true, stopping at the first.
+func (p *DiscordEmojiParser) containsToken(content string, match func(*parserState, token) bool) bool {
+	if !p.beginParse(content) {
+		return false
+	}
+
+	state := p.state.Load()
+	if stripped, m := p.rewriteContent(content); m != nil {
+		content = stripped
+	}
+	found := false
+	p.scan(state, content, scanAll, nil, func(t token) bool {
+		found = match(state, t)
+		return !found
+	})
+	return found
+}
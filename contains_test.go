@@ -0,0 +1,70 @@
+package emojiparser_test
+
+import (
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestContainsEmoji(t *testing.T) {
+	for _, content := range countInputs {
+		counts := emojiparser.CountByType(content)
+		if got, want := emojiparser.ContainsEmoji(content), emojiparser.Count(content) > 0; got != want {
+			t.Fatalf("ContainsEmoji(%q) = %v, want %v", content, got, want)
+		}
+		if got, want := emojiparser.ContainsCustomEmoji(content), counts[emojiparser.EmojiTypeCustom] > 0; got != want {
+			t.Fatalf("ContainsCustomEmoji(%q) = %v, want %v", content, got, want)
+		}
+		if got, want := emojiparser.ContainsUnicodeEmoji(content), counts[emojiparser.EmojiTypeUnicode] > 0; got != want {
+			t.Fatalf("ContainsUnicodeEmoji(%q) = %v, want %v", content, got, want)
+		}
+		if got, want := emojiparser.ContainsTextEmoji(content), counts[emojiparser.EmojiTypeText] > 0; got != want {
+			t.Fatalf("ContainsTextEmoji(%q) = %v, want %v", content, got, want)
+		}
+	}
+}
+
+func TestContainsEmojiInsideCustom(t *testing.T) {
+	content := "<:smile:12345678901234567>"
+	if emojiparser.ContainsTextEmoji(content) {
+		t.Fatalf("ContainsTextEmoji(%q) = true, want false", content)
+	}
+	if !emojiparser.ContainsCustomEmoji(content) {
+		t.Fatalf("ContainsCustomEmoji(%q) = false, want true", content)
+	}
+}
+
+func TestContainsName(t *testing.T) {
+	content := "gg <:galaxy_brain:12345678901234567> :tada: 😄 🇺🇸"
+	for _, name := range []string{"galaxy_brain", ":galaxy_brain:", "tada", "smile", "flag_us"} {
+		if !emojiparser.ContainsName(content, name) {
+			t.Fatalf("ContainsName(%q) = false, want true", name)
+		}
+	}
+	for _, name := range []string{"Galaxy_Brain", "pepe", "12345678901234567", ""} {
+		if emojiparser.ContainsName(content, name) {
+			t.Fatalf("ContainsName(%q) = true, want false", name)
+		}
+	}
+	if emojiparser.ContainsName("<:smile:12345678901234567>", "tada") {
+		t.Fatalf("ContainsName matched a shortcode inside custom emoji markup")
+	}
+	if !emojiparser.ContainsAnyName(content, "pepe", "tada") || emojiparser.ContainsAnyName(content, "pepe", "frog") {
+		t.Fatalf("ContainsAnyName did not check every name")
+	}
+
+	parser := newTestParser(t, emojiparser.WithCaseInsensitiveNames(true))
+	if !parser.ContainsName(content, "Galaxy_Brain") || !parser.ContainsName(content, "SMILE") {
+		t.Fatalf("ContainsName ignored WithCaseInsensitiveNames")
+	}
+}
+
+func TestContainsCustomID(t *testing.T) {
+	content := "<:pepe:12345678901234567> <a:wave:76543210987654321>"
+	if !emojiparser.ContainsCustomID(content, "76543210987654321") {
+		t.Fatalf("ContainsCustomID = false, want true")
+	}
+	if emojiparser.ContainsCustomID(content, "1234567890123456") {
+		t.Fatalf("ContainsCustomID matched a prefix of an ID")
+	}
+}
@@ -0,0 +1,93 @@
+package emojiparser
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+// ErrTruncated is returned by ParseContext when it stops at the
+// MaxInputBytes or MaxResults limit. It is wrapped with the limit reached, so
+// compare with errors.Is.
+var ErrTruncated = errors.New("parse truncated")
+
+// contextCheckInterval is the number of results ParseContext finds between
+// checks of its context, and contextCheckBytes the number of bytes it scans
+// between them when it finds none.
+const (
+	contextCheckInterval = 256
+	contextCheckBytes    = 64 << 10
+)
+
+// ParseContext parses content using the default parser.
+func ParseContext(ctx context.Context, content string) ([]ParsedEmoji, error) {
+	return defaultParser.ParseContext(ctx, content)
+}
+
+// ParseContext is Parse for untrusted input. It checks ctx as it scans, every
+// few hundred results and every 64 KiB of content without them, and stops at
+// the MaxInputBytes and MaxResults limits of the parser's options.
+// When ctx is done it returns the results found so far with ctx.Err(), and
+// when a limit is reached, the results within it with an error wrapping
+// ErrTruncated. An emoji running past MaxInputBytes, or that might, is left
+// out.
+func (p *DiscordEmojiParser) ParseContext(ctx context.Context, content string) ([]ParsedEmoji, error) {
+	if err := ctx.Err(); err != nil {
+		return []ParsedEmoji{}, err
+	}
+
+	var truncated error
+	scanned := content
+	if limit := p.opts.MaxInputBytes; limit > 0 && len(content) > limit {
+		for limit > 0 && !utf8.RuneStart(content[limit]) {
+			limit--
+		}
+		scanned = content[:limit]
+		truncated = fmt.Errorf("%w: content is longer than %d bytes", ErrTruncated, p.opts.MaxInputBytes)
+	}
+	if !p.beginParse(scanned) {
+		return []ParsedEmoji{}, truncated
+	}
+
+	state := p.state.Load()
+	rewritten, m := p.rewriteContent(scanned)
+	results := make([]ParsedEmoji, 0)
+	var counts tokenCounts
+	var err error
+	nextCheck := contextCheckBytes
+	progress := func(pos int) bool {
+		if pos < nextCheck {
+			return true
+		}
+		nextCheck = pos + contextCheckBytes
+		err = ctx.Err()
+		return err == nil
+	}
+	p.scanProgress(state, rewritten, scanAll, nil, progress, func(t token) bool {
+		if len(results)%contextCheckInterval == contextCheckInterval-1 {
+			if err = ctx.Err(); err != nil {
+				return false
+			}
+		}
+		if truncated != nil && t.to == len(rewritten) {
+			// The emoji may go on past the limit.
+			return false
+		}
+		if p.opts.MaxResults > 0 && len(results) == p.opts.MaxResults {
+			truncated = fmt.Errorf("%w: content has more than %d emojis", ErrTruncated, p.opts.MaxResults)
+			return false
+		}
+		counts.add(t.kind)
+		results = append(results, p.emojiFor(state, rewritten, t))
+		return true
+	})
+	p.countTokens(counts)
+	if m != nil {
+		m.restore(results)
+	}
+	if err != nil {
+		return results, err
+	}
+	return results, truncated
+}
@@ -0,0 +1,116 @@
+package emojiparser_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+// countdownContext is done after its Err method has been called n times.
+type countdownContext struct {
+	context.Context
+	n int
+}
+
+func (c *countdownContext) Err() error {
+	if c.n--; c.n < 0 {
+		return context.DeadlineExceeded
+	}
+	return nil
+}
+
+func TestParseContext(t *testing.T) {
+	content := strings.Repeat("😄 :tada: <:pepe:12345678901234567> ", 400)
+	results, err := emojiparser.ParseContext(context.Background(), content)
+	if err != nil || len(results) != 1200 {
+		t.Fatalf("ParseContext = %d results, %v; want 1200 and no error", len(results), err)
+	}
+	if want := emojiparser.Parse(content); !reflect.DeepEqual(results, want) {
+		t.Fatalf("ParseContext results differ from Parse")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if results, err := emojiparser.ParseContext(ctx, content); !errors.Is(err, context.Canceled) || len(results) != 0 {
+		t.Fatalf("ParseContext with a canceled context = %d results, %v", len(results), err)
+	}
+
+	// Done at the first check during the scan, after the one on entry.
+	results, err = emojiparser.ParseContext(&countdownContext{Context: context.Background(), n: 1}, content)
+	if !errors.Is(err, context.DeadlineExceeded) || len(results) == 0 || len(results) >= 1200 {
+		t.Fatalf("ParseContext past its deadline = %d results, %v; want some partial results", len(results), err)
+	}
+	if !reflect.DeepEqual(results, emojiparser.Parse(content)[:len(results)]) {
+		t.Fatalf("partial results differ from Parse")
+	}
+}
+
+func TestParseContextWithoutEmojis(t *testing.T) {
+	// Several megabytes of non-ASCII text the scan has to look at closely,
+	// but with no emoji to report.
+	content := strings.Repeat("Grüße aus Köln: «déjà vu» ", 1<<17)
+	if results := emojiparser.Parse(content); len(results) != 0 {
+		t.Fatalf("Parse found %d emojis in text without any", len(results))
+	}
+
+	// Done at the first check during the scan, after the one on entry.
+	countdown := &countdownContext{Context: context.Background(), n: 1}
+	results, err := emojiparser.ParseContext(countdown, content)
+	if !errors.Is(err, context.DeadlineExceeded) || len(results) != 0 {
+		t.Fatalf("ParseContext past its deadline = %d results, %v; want none and the deadline error", len(results), err)
+	}
+	if countdown.n != -1 {
+		t.Fatalf("ParseContext scanned on after its deadline")
+	}
+}
+
+func TestParseContextLimits(t *testing.T) {
+	content := "😄 :tada: 👨\u200D👩\u200D👧\u200D👦 <:pepe:12345678901234567>"
+	tests := []struct {
+		opts      []emojiparser.Option
+		want      []string
+		truncated bool
+	}{
+		{nil, []string{"smile", "tada", "family_mwgb", "pepe"}, false},
+		{[]emojiparser.Option{emojiparser.WithMaxResults(4)}, []string{"smile", "tada", "family_mwgb", "pepe"}, false},
+		{[]emojiparser.Option{emojiparser.WithMaxResults(2)}, []string{"smile", "tada"}, true},
+		{[]emojiparser.Option{emojiparser.WithMaxInputBytes(len(content))}, []string{"smile", "tada", "family_mwgb", "pepe"}, false},
+		// The limit falls inside the family, after man and woman.
+		{[]emojiparser.Option{emojiparser.WithMaxInputBytes(len("😄 :tada: 👨\u200D👩"))}, []string{"smile", "tada"}, true},
+		{[]emojiparser.Option{emojiparser.WithMaxInputBytes(len("😄 :tada: 👨") + 1)}, []string{"smile", "tada"}, true},
+		// A shortcode could go on with a :skin-tone-N: suffix.
+		{[]emojiparser.Option{emojiparser.WithMaxInputBytes(len("😄 :tada:"))}, []string{"smile"}, true},
+		{[]emojiparser.Option{emojiparser.WithMaxInputBytes(len("😄 :tada: "))}, []string{"smile", "tada"}, true},
+		{[]emojiparser.Option{emojiparser.WithMaxInputBytes(2)}, nil, true},
+	}
+	for _, tc := range tests {
+		parser := newTestParser(t, tc.opts...)
+		results, err := parser.ParseContext(context.Background(), content)
+		if errors.Is(err, emojiparser.ErrTruncated) != tc.truncated || err != nil && !tc.truncated {
+			t.Fatalf("ParseContext with %d options: error %v, want truncated=%v", len(tc.opts), err, tc.truncated)
+		}
+		if got := emojiNames(results); strings.Join(got, " ") != strings.Join(tc.want, " ") {
+			t.Fatalf("ParseContext with %d options = %v, want %v", len(tc.opts), got, tc.want)
+		}
+		// Parse ignores the limits.
+		if got := parser.Parse(content); len(got) != 4 {
+			t.Fatalf("Parse with limits = %v, want all 4 emojis", got)
+		}
+	}
+
+	if _, err := emojiparser.NewDiscordEmojiParser(emojiparser.WithMaxResults(-1)); err == nil {
+		t.Fatalf("NewDiscordEmojiParser accepted a negative limit")
+	}
+}
+
+func emojiNames(results []emojiparser.ParsedEmoji) []string {
+	var names []string
+	for _, result := range results {
+		names = append(names, result.Name)
+	}
+	return names
+}
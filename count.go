@@ -0,0 +1,54 @@
+package emojiparser
+
+// Count returns the number of emojis in content using the default parser.
+func Count(content string) int {
+	return defaultParser.Count(content)
+}
+
+// CountByType returns the number of emojis of each type in content using the
+// default parser.
+func CountByType(content string) map[EmojiType]int {
+	return defaultParser.CountByType(content)
+}
+
+// Count returns len(p.Parse(content)) without building the results, so no
+// names, links, or result slices are allocated.
+func (p *DiscordEmojiParser) Count(content string) int {
+	counts := p.count(content)
+	return counts.custom + counts.unicode + counts.text
+}
+
+// CountByType returns the number of emojis of each type Parse would report
+// for content. Types with no emojis are left out of the map.
+func (p *DiscordEmojiParser) CountByType(content string) map[EmojiType]int {
+	counts := p.count(content)
+	byType := make(map[EmojiType]int, 3)
+	if counts.custom > 0 {
+		byType[EmojiTypeCustom] = counts.custom
+	}
+	if counts.unicode > 0 {
+		byType[EmojiTypeUnicode] = counts.unicode
+	}
+	if counts.text > 0 {
+		byType[EmojiTypeText] = counts.text
+	}
+	return byType
+}
+
+func (p *DiscordEmojiParser) count(content string) tokenCounts {
+	var counts tokenCounts
+	if !p.beginParse(content) {
+		return counts
+	}
+
+	state := p.state.Load()
+	if stripped, m := p.rewriteContent(content); m != nil {
+		content = stripped
+	}
+	p.scan(state, content, scanAll, nil, func(t token) bool {
+		counts.add(t.kind)
+		return true
+	})
+	p.countTokens(counts)
+	return counts
+}
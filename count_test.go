@@ -0,0 +1,45 @@
+package emojiparser_test
+
+import (
+	"maps"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+var countInputs = []string{
+	"",
+	"plain text",
+	"hi 😄 <:pepe:12345678901234567> :tada:!",
+	"<:smile:12345678901234567> :smile: 😄",
+	"<a:wave:12345678901234567><:tada:12345678901234567>:tada:",
+	":sob::👌 :smile::skin-tone-3: 👍🏽",
+	"🇺🇸🇧🇬 1️⃣ a:b:smile:",
+}
+
+func TestCount(t *testing.T) {
+	for _, content := range countInputs {
+		results := emojiparser.Parse(content)
+		if got := emojiparser.Count(content); got != len(results) {
+			t.Fatalf("Count(%q) = %d, want %d", content, got, len(results))
+		}
+		want := make(map[emojiparser.EmojiType]int)
+		for _, emoji := range results {
+			want[emoji.Type]++
+		}
+		if got := emojiparser.CountByType(content); !maps.Equal(got, want) {
+			t.Fatalf("CountByType(%q) = %v, want %v", content, got, want)
+		}
+	}
+}
+
+func TestCountZeroWidth(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser(emojiparser.WithIgnoreZeroWidth(true))
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+	content := ":sm\u200bile: 😄\u200b🔥"
+	if got, want := parser.Count(content), len(parser.Parse(content)); got != want || got != 3 {
+		t.Fatalf("Count(%q) = %d, want %d", content, got, want)
+	}
+}
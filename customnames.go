@@ -0,0 +1,29 @@
+package emojiparser
+
+// ReplaceCustomWithNames rewrites custom emoji tags to :name: using the
+// default parser.
+func ReplaceCustomWithNames(content string) string {
+	return defaultParser.ReplaceCustomWithNames(content)
+}
+
+// ReplaceCustomWithNames rewrites every custom emoji tag Parse reports to its
+// name between colons, so "<a:partyblob:1234567890123456>" becomes
+// ":partyblob:", for relaying messages where the tags mean nothing. Unicode
+// and text emojis and all other text are kept. WithAnimatedNameSuffix marks
+// the names of animated emojis, and WithSeparateCustomNames puts a space
+// between the names of adjacent tags, which would otherwise read as one run
+// of colons.
+func (p *DiscordEmojiParser) ReplaceCustomWithNames(content string) string {
+	end := -1
+	return p.ReplaceTypes(content, func(emoji ParsedEmoji) string {
+		name := ":" + emoji.Name + ":"
+		if emoji.Animated {
+			name += p.opts.AnimatedNameSuffix
+		}
+		if p.opts.SeparateCustomNames && emoji.Position.From == end {
+			name = " " + name
+		}
+		end = emoji.Position.To
+		return name
+	}, EmojiTypeCustom)
+}
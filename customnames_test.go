@@ -0,0 +1,32 @@
+package emojiparser_test
+
+import (
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestReplaceCustomWithNames(t *testing.T) {
+	cases := map[string]string{
+		"gg <a:partyblob:12345678901234567>!":                  "gg :partyblob:!",
+		"<:pepe:12345678901234567><:frog:12345678901234567> 😄": ":pepe::frog: 😄",
+		":tada: <:smile:12345678901234567>":                    ":tada: :smile:",
+		"no tags":                                              "no tags",
+	}
+	for input, want := range cases {
+		if got := emojiparser.ReplaceCustomWithNames(input); got != want {
+			t.Fatalf("ReplaceCustomWithNames(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestReplaceCustomWithNamesOptions(t *testing.T) {
+	parser := newTestParser(t,
+		emojiparser.WithAnimatedNameSuffix("(gif)"),
+		emojiparser.WithSeparateCustomNames(true),
+	)
+	content := "<a:wave:12345678901234567><:pepe:12345678901234567> <:frog:12345678901234567>"
+	if got, want := parser.ReplaceCustomWithNames(content), ":wave:(gif) :pepe: :frog:"; got != want {
+		t.Fatalf("ReplaceCustomWithNames = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,171 @@
+package emojiparser
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/gif"
+	_ "image/jpeg" // register JPEG for image.Decode
+	_ "image/png"  // register PNG for image.Decode
+)
+
+// Limits applied by ValidateEmojiImage. Discord rejects emoji uploads larger
+// than MaxEmojiImageSize and scales the rest down to at most 128x128, so
+// images larger than MaxEmojiImageDimension on a side only waste bandwidth.
+const (
+	MaxEmojiImageSize      = 256 << 10
+	MaxEmojiImageDimension = 4096
+)
+
+// Errors returned by ValidateEmojiImage. They are wrapped with details about
+// the image, so compare with errors.Is.
+var (
+	ErrEmojiImageEmpty      = errors.New("emoji image is empty")
+	ErrEmojiImageTooLarge   = errors.New("emoji image is too large")
+	ErrEmojiImageFormat     = errors.New("unsupported emoji image format")
+	ErrEmojiImageCorrupt    = errors.New("corrupt emoji image")
+	ErrEmojiImageDimensions = errors.New("emoji image dimensions out of range")
+)
+
+// EmojiImageInfo describes an image accepted by ValidateEmojiImage.
+type EmojiImageInfo struct {
+	Format   string // "png", "jpeg", "gif", or "webp"
+	Width    int
+	Height   int
+	Frames   int  // 1 for still images
+	Animated bool // more than one frame; upload it as an animated emoji
+	Size     int  // in bytes
+}
+
+// ValidateEmojiImage checks data the way Discord checks guild emoji uploads:
+// at most MaxEmojiImageSize bytes of PNG, JPEG, GIF, or WebP with sides of 1
+// to MaxEmojiImageDimension pixels. PNG, JPEG, and GIF images are fully
+// decoded once their headers declare sides within the limit, so truncated or
+// damaged files are reported as corrupt; WebP files are checked from their
+// container and frame headers only.
+func ValidateEmojiImage(data []byte) (EmojiImageInfo, error) {
+	info := EmojiImageInfo{Size: len(data), Frames: 1}
+	switch {
+	case len(data) == 0:
+		return info, ErrEmojiImageEmpty
+	case len(data) > MaxEmojiImageSize:
+		return info, fmt.Errorf("%w: %d bytes, limit %d", ErrEmojiImageTooLarge, len(data), MaxEmojiImageSize)
+	}
+
+	if isWebP(data) {
+		info.Format = "webp"
+		if err := sniffWebP(data, &info); err != nil {
+			return info, fmt.Errorf("%w: webp: %v", ErrEmojiImageCorrupt, err)
+		}
+		info.Animated = info.Frames > 1
+		return info, checkEmojiImageDimensions(info)
+	}
+
+	// The header is checked first, so that a small file claiming a huge
+	// size is rejected before any pixels are allocated for it.
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	info.Format = format
+	if errors.Is(err, image.ErrFormat) {
+		return info, ErrEmojiImageFormat
+	}
+	if err != nil {
+		return info, fmt.Errorf("%w: %s: %v", ErrEmojiImageCorrupt, format, err)
+	}
+	info.Width, info.Height = config.Width, config.Height
+	if err := checkEmojiImageDimensions(info); err != nil {
+		return info, err
+	}
+
+	if format == "gif" {
+		var anim *gif.GIF
+		if anim, err = gif.DecodeAll(bytes.NewReader(data)); err == nil {
+			info.Frames = len(anim.Image)
+		}
+	} else {
+		_, _, err = image.Decode(bytes.NewReader(data))
+	}
+	if err != nil {
+		return info, fmt.Errorf("%w: %s: %v", ErrEmojiImageCorrupt, format, err)
+	}
+	info.Animated = info.Frames > 1
+	return info, nil
+}
+
+// checkEmojiImageDimensions reports whether the sides of info are within the
+// limits of ValidateEmojiImage.
+func checkEmojiImageDimensions(info EmojiImageInfo) error {
+	if info.Width < 1 || info.Height < 1 || info.Width > MaxEmojiImageDimension || info.Height > MaxEmojiImageDimension {
+		return fmt.Errorf("%w: %dx%d, limit %dx%d", ErrEmojiImageDimensions, info.Width, info.Height, MaxEmojiImageDimension, MaxEmojiImageDimension)
+	}
+	return nil
+}
+
+func isWebP(data []byte) bool {
+	return len(data) >= 12 && string(data[:4]) == "RIFF" && string(data[8:12]) == "WEBP"
+}
+
+// sniffWebP reads the dimensions and frame count of a WebP file from its
+// chunk headers. The image data itself is not decoded.
+func sniffWebP(data []byte, info *EmojiImageInfo) error {
+	if size := binary.LittleEndian.Uint32(data[4:8]); int64(size)+8 > int64(len(data)) {
+		return errors.New("truncated RIFF container")
+	}
+
+	frames := 0
+	for offset := 12; offset < len(data); {
+		if len(data)-offset < 8 {
+			return errors.New("truncated chunk header")
+		}
+		fourCC := string(data[offset : offset+4])
+		size := int(binary.LittleEndian.Uint32(data[offset+4 : offset+8]))
+		start := offset + 8
+		if size > len(data)-start {
+			return fmt.Errorf("chunk %q overruns the file", fourCC)
+		}
+		chunk := data[start : start+size]
+
+		switch fourCC {
+		case "VP8X":
+			if len(chunk) < 10 {
+				return errors.New("short VP8X chunk")
+			}
+			info.Width = int(uint24(chunk[4:])) + 1
+			info.Height = int(uint24(chunk[7:])) + 1
+		case "ANMF":
+			frames++
+		case "VP8 ":
+			if len(chunk) < 10 || chunk[3] != 0x9d || chunk[4] != 0x01 || chunk[5] != 0x2a {
+				return errors.New("bad VP8 frame header")
+			}
+			if info.Width == 0 {
+				info.Width = int(binary.LittleEndian.Uint16(chunk[6:]) & 0x3fff)
+				info.Height = int(binary.LittleEndian.Uint16(chunk[8:]) & 0x3fff)
+			}
+		case "VP8L":
+			if len(chunk) < 5 || chunk[0] != 0x2f {
+				return errors.New("bad VP8L header")
+			}
+			if info.Width == 0 {
+				bits := binary.LittleEndian.Uint32(chunk[1:])
+				info.Width = int(bits&0x3fff) + 1
+				info.Height = int(bits>>14&0x3fff) + 1
+			}
+		}
+		// Chunks are padded to an even size.
+		offset = start + size + size&1
+	}
+
+	if frames > 0 {
+		info.Frames = frames
+	}
+	if info.Width == 0 {
+		return errors.New("no image chunk")
+	}
+	return nil
+}
+
+func uint24(b []byte) uint32 {
+	return uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16
+}
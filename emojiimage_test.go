@@ -0,0 +1,139 @@
+package emojiparser_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"runtime"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func encodePNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height))); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func encodeGIF(t *testing.T, frames int) []byte {
+	t.Helper()
+	palette := color.Palette{color.Black, color.White}
+	anim := &gif.GIF{}
+	for range frames {
+		anim.Image = append(anim.Image, image.NewPaletted(image.Rect(0, 0, 48, 48), palette))
+		anim.Delay = append(anim.Delay, 10)
+	}
+	var buf bytes.Buffer
+	if err := gif.EncodeAll(&buf, anim); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// webpFile wraps chunks, each a four-character code and payload, in a RIFF
+// WebP container.
+func webpFile(chunks ...[]byte) []byte {
+	var body []byte
+	for _, chunk := range chunks {
+		body = append(body, chunk[:4]...)
+		body = binary.LittleEndian.AppendUint32(body, uint32(len(chunk)-4))
+		body = append(body, chunk[4:]...)
+		if len(chunk)%2 == 1 {
+			body = append(body, 0)
+		}
+	}
+	file := []byte("RIFF")
+	file = binary.LittleEndian.AppendUint32(file, uint32(len(body)+4))
+	file = append(file, "WEBP"...)
+	return append(file, body...)
+}
+
+func TestValidateEmojiImage(t *testing.T) {
+	var jpg bytes.Buffer
+	if err := jpeg.Encode(&jpg, image.NewGray(image.Rect(0, 0, 64, 32)), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// A lossless still image: signature, then 14-bit width-1 and height-1.
+	lossless := append([]byte("VP8L\x2f"), binary.LittleEndian.AppendUint32(nil, 31|31<<14)...)
+	// An animated image: VP8X with the animation flag and a 96x64 canvas.
+	extended := []byte("VP8X\x02\x00\x00\x00\x5f\x00\x00\x3f\x00\x00")
+	frame := []byte("ANMFframe")
+
+	tests := []struct {
+		name string
+		data []byte
+		want emojiparser.EmojiImageInfo
+	}{
+		{"png", encodePNG(t, 128, 128), emojiparser.EmojiImageInfo{Format: "png", Width: 128, Height: 128, Frames: 1}},
+		{"jpeg", jpg.Bytes(), emojiparser.EmojiImageInfo{Format: "jpeg", Width: 64, Height: 32, Frames: 1}},
+		{"gif still", encodeGIF(t, 1), emojiparser.EmojiImageInfo{Format: "gif", Width: 48, Height: 48, Frames: 1}},
+		{"gif animated", encodeGIF(t, 3), emojiparser.EmojiImageInfo{Format: "gif", Width: 48, Height: 48, Frames: 3, Animated: true}},
+		{"webp still", webpFile(lossless), emojiparser.EmojiImageInfo{Format: "webp", Width: 32, Height: 32, Frames: 1}},
+		{"webp animated", webpFile(extended, frame, frame), emojiparser.EmojiImageInfo{Format: "webp", Width: 96, Height: 64, Frames: 2, Animated: true}},
+	}
+	for _, tt := range tests {
+		tt.want.Size = len(tt.data)
+		got, err := emojiparser.ValidateEmojiImage(tt.data)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		if got != tt.want {
+			t.Fatalf("%s: info = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestValidateEmojiImageErrors(t *testing.T) {
+	valid := encodePNG(t, 16, 16)
+	oversized := append(encodePNG(t, 16, 16), make([]byte, emojiparser.MaxEmojiImageSize)...)
+
+	tests := []struct {
+		name string
+		data []byte
+		want error
+	}{
+		{"empty", nil, emojiparser.ErrEmojiImageEmpty},
+		{"oversized", oversized, emojiparser.ErrEmojiImageTooLarge},
+		{"bmp", []byte("BM\x00\x00\x00\x00\x00\x00\x00\x00"), emojiparser.ErrEmojiImageFormat},
+		{"corrupt png", valid[:len(valid)/2], emojiparser.ErrEmojiImageCorrupt},
+		{"corrupt gif", encodeGIF(t, 2)[:40], emojiparser.ErrEmojiImageCorrupt},
+		{"truncated webp", webpFile([]byte("VP8L\x2f\x1f\x00\x00\x00"))[:18], emojiparser.ErrEmojiImageCorrupt},
+		{"webp without image", webpFile([]byte("EXIFdata")), emojiparser.ErrEmojiImageCorrupt},
+		{"too wide", encodePNG(t, emojiparser.MaxEmojiImageDimension+1, 1), emojiparser.ErrEmojiImageDimensions},
+	}
+	for _, tt := range tests {
+		if _, err := emojiparser.ValidateEmojiImage(tt.data); !errors.Is(err, tt.want) {
+			t.Fatalf("%s: error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestValidateEmojiImageHugeHeader(t *testing.T) {
+	// A 1x1 PNG whose header claims 20000x20000 pixels.
+	data := encodePNG(t, 1, 1)
+	binary.BigEndian.PutUint32(data[16:], 20000)
+	binary.BigEndian.PutUint32(data[20:], 20000)
+	binary.BigEndian.PutUint32(data[29:], crc32.ChecksumIEEE(data[12:29]))
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	_, err := emojiparser.ValidateEmojiImage(data)
+	runtime.ReadMemStats(&after)
+	if !errors.Is(err, emojiparser.ErrEmojiImageDimensions) {
+		t.Fatalf("error = %v, want %v", err, emojiparser.ErrEmojiImageDimensions)
+	}
+	if allocated := after.TotalAlloc - before.TotalAlloc; allocated > 1<<20 {
+		t.Fatalf("validating a %d-byte file allocated %d bytes", len(data), allocated)
+	}
+}
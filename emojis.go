@@ -0,0 +1,52 @@
+package emojiparser
+
+// EmojiInfo describes a known unicode emoji.
+type EmojiInfo struct {
+	Unicode    string
+	Name       string
+	Aliases    []string // every shortcode name, sorted, Name among them
+	CodePoints string   // dash-separated lowercase hex, as in asset file names
+	Link       *string  // SVG asset link, nil if there is none
+	HasSVG     bool     // whether the tables have an SVG asset, whatever Link is
+}
+
+// NumEmojis returns the number of known emojis using the default parser.
+func NumEmojis() int {
+	return defaultParser.NumEmojis()
+}
+
+// NumEmojis returns the number of emojis AllEmojis yields, for checking a
+// dataset after an update without building every EmojiInfo.
+func (p *DiscordEmojiParser) NumEmojis() int {
+	return len(p.state.Load().emojis())
+}
+
+// AllEmojisSlice returns every known emoji using the default parser.
+func AllEmojisSlice() []EmojiInfo {
+	return defaultParser.AllEmojisSlice()
+}
+
+// AllEmojisSlice returns the emojis AllEmojis yields, in the same order, for
+// callers that want a slice.
+func (p *DiscordEmojiParser) AllEmojisSlice() []EmojiInfo {
+	state := p.state.Load()
+	emojis := state.emojis()
+	infos := make([]EmojiInfo, 0, len(emojis))
+	for _, emoji := range emojis {
+		infos = append(infos, p.emojiInfo(state, emoji))
+	}
+	return infos
+}
+
+func (p *DiscordEmojiParser) emojiInfo(state *parserState, emoji string) EmojiInfo {
+	name := state.unicodeToName[emoji]
+	_, hasSVG := state.svgHash(emoji, emoji)
+	return EmojiInfo{
+		Unicode:    emoji,
+		Name:       name,
+		Aliases:    state.shortcodeNames(emoji),
+		CodePoints: toCodePoint(emoji, "-"),
+		Link:       p.svgLink(state, emoji, emoji, name),
+		HasSVG:     hasSVG,
+	}
+}
@@ -0,0 +1,25 @@
+//go:build go1.23
+
+package emojiparser
+
+import "iter"
+
+// AllEmojis iterates over every known emoji using the default parser.
+func AllEmojis() iter.Seq[EmojiInfo] {
+	return defaultParser.AllEmojis()
+}
+
+// AllEmojis iterates over every known unicode emoji in code point order. The
+// iteration reads the tables current when it starts, so it is unaffected by
+// concurrent reloads and merges, and each EmojiInfo is built as it is
+// yielded.
+func (p *DiscordEmojiParser) AllEmojis() iter.Seq[EmojiInfo] {
+	return func(yield func(EmojiInfo) bool) {
+		state := p.state.Load()
+		for _, emoji := range state.emojis() {
+			if !yield(p.emojiInfo(state, emoji)) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,75 @@
+//go:build go1.23
+
+package emojiparser_test
+
+import (
+	"sync"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestAllEmojis(t *testing.T) {
+	count := 0
+	previous := ""
+	found := map[string]emojiparser.EmojiInfo{}
+	for info := range emojiparser.AllEmojis() {
+		if info.Unicode <= previous {
+			t.Fatalf("%+q yielded after %+q, want code point order", info.Unicode, previous)
+		}
+		previous = info.Unicode
+		count++
+		switch info.Unicode {
+		case "😄", "🇩🇪", "1️⃣", "piñata":
+			found[info.Unicode] = info
+		}
+	}
+	if count < 3600 {
+		t.Fatalf("yielded %d emojis, want the whole table", count)
+	}
+	if len(emojiparser.AllEmojisSlice()) != count {
+		t.Fatalf("AllEmojisSlice has %d entries, AllEmojis yielded %d", len(emojiparser.AllEmojisSlice()), count)
+	}
+
+	smile := found["😄"]
+	if smile.Name != "smile" || smile.CodePoints != "1f604" || smile.Link == nil {
+		t.Fatalf("😄 = %+v", smile)
+	}
+	if found["🇩🇪"].Name != "flag_de" || found["1️⃣"].CodePoints != "31-fe0f-20e3" {
+		t.Fatalf("spot checks = %+v", found)
+	}
+	if _, ok := found["piñata"]; ok {
+		t.Fatal("a shortcode name was yielded as an emoji")
+	}
+
+	for range emojiparser.AllEmojis() {
+		break
+	}
+}
+
+func TestAllEmojisConcurrentParse(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for range 20 {
+			parser.Parse("hi 😄 :tada:")
+			if _, err := parser.RegisterShortcode("octo", "🐙"); err != nil {
+				t.Error(err)
+			}
+		}
+	}()
+	n := 0
+	for range parser.AllEmojis() {
+		n++
+	}
+	wg.Wait()
+	if n == 0 {
+		t.Fatal("no emojis yielded")
+	}
+}
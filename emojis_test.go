@@ -0,0 +1,83 @@
+package emojiparser_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestEmojiInfoAliases(t *testing.T) {
+	infos := emojiparser.AllEmojisSlice()
+	if got := emojiparser.NumEmojis(); got != len(infos) {
+		t.Fatalf("NumEmojis = %d, AllEmojisSlice has %d entries", got, len(infos))
+	}
+	withSVG := 0
+	for _, info := range infos {
+		if info.Name != "" && !slices.Contains(info.Aliases, info.Name) {
+			t.Fatalf("%+q: aliases %q do not include the name %q", info.Unicode, info.Aliases, info.Name)
+		}
+		if info.HasSVG != (info.Link != nil) {
+			t.Fatalf("%+q: HasSVG = %v but Link = %v", info.Unicode, info.HasSVG, info.Link)
+		}
+		if info.HasSVG {
+			withSVG++
+		}
+		if info.Unicode == "\U0001F44D" && !slices.Equal(info.Aliases, []string{"+1", "thumbsup", "thumbup"}) {
+			t.Fatalf("thumbs up aliases = %q", info.Aliases)
+		}
+	}
+	if withSVG < len(infos)/2 {
+		t.Fatalf("only %d of %d emojis have an SVG asset", withSVG, len(infos))
+	}
+}
+
+func TestEmojiInfoHasSVGWithTemplate(t *testing.T) {
+	parser := newTestParser(t, emojiparser.WithUnicodeLinkTemplate("https://cdn.example/{codepoints}.{ext}"))
+	for _, info := range parser.AllEmojisSlice() {
+		if info.Link == nil {
+			t.Fatalf("%+q has no templated link", info.Unicode)
+		}
+		if _, ok := parser.SVGHash(info.Unicode); ok != info.HasSVG {
+			t.Fatalf("%+q: HasSVG = %v, SVGHash found an asset: %v", info.Unicode, info.HasSVG, ok)
+		}
+	}
+}
+
+func TestLetterlikeEmojiKeys(t *testing.T) {
+	// U+2139 is a lowercase letter, but ℹ️ is an emoji, not a name.
+	info := "ℹ️"
+	if err := emojiparser.ValidateUnicodeEmojiField(info); err != nil {
+		t.Fatalf("ValidateUnicodeEmojiField(%+q): %v", info, err)
+	}
+	if !emojiparser.IsKnown(info) || !emojiparser.IsKnown("ℹ") {
+		t.Fatalf("IsKnown does not know %+q", info)
+	}
+	if !emojiparser.IsRGI(info) {
+		t.Fatalf("IsRGI(%+q) = false", info)
+	}
+	if name, ok := emojiparser.PreferredShortcode(info); !ok || name != "information_source" {
+		t.Fatalf("PreferredShortcode(%+q) = %q, %v", info, name, ok)
+	}
+
+	// piñata is a name with a non-ASCII letter, not an emoji.
+	pinata := "piñata"
+	if emojiparser.IsKnown(pinata) || emojiparser.IsRGI(pinata) {
+		t.Fatalf("%q is taken for an emoji", pinata)
+	}
+	if _, ok := emojiparser.PreferredShortcode(pinata); ok {
+		t.Fatalf("PreferredShortcode(%q) found a shortcode", pinata)
+	}
+	if err := emojiparser.ValidateUnicodeEmojiField(pinata); !errors.Is(err, emojiparser.ErrNotUnicodeEmoji) {
+		t.Fatalf("ValidateUnicodeEmojiField(%q): %v", pinata, err)
+	}
+
+	infos := emojiparser.AllEmojisSlice()
+	if !slices.ContainsFunc(infos, func(e emojiparser.EmojiInfo) bool { return e.Unicode == info }) {
+		t.Fatalf("AllEmojisSlice leaves out %+q", info)
+	}
+	if slices.ContainsFunc(infos, func(e emojiparser.EmojiInfo) bool { return e.Unicode == pinata }) {
+		t.Fatalf("AllEmojisSlice lists %q", pinata)
+	}
+}
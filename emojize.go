@@ -0,0 +1,57 @@
+package emojiparser
+
+// Emojize replaces shortcodes with unicode emojis using the default parser.
+func Emojize(content string) string {
+	return defaultParser.Emojize(content)
+}
+
+// Emojize replaces every shortcode Parse reports as a text emoji with its
+// unicode emoji, so "gg :tada:" becomes "gg 🎉". Unknown shortcodes, custom
+// emoji tags and shortcodes inside them, and all other text are kept, and a
+// shared-colon chain such as ":joy:sob:" becomes "😂😭". A :skin-tone-N:
+// suffix is applied as Parse applies it, so ":thumbsup::skin-tone-3:" becomes
+// 👍🏽. Content without shortcodes is returned as is.
+func (p *DiscordEmojiParser) Emojize(content string) string {
+	return p.ReplaceTypes(content, func(emoji ParsedEmoji) string {
+		return emoji.Unicode
+	}, EmojiTypeText)
+}
+
+// Demojize replaces unicode emojis with shortcodes using the default parser.
+func Demojize(content string) string {
+	return defaultParser.Demojize(content)
+}
+
+// Demojize is the inverse of Emojize: it replaces every unicode emoji Parse
+// reports with its preferred shortcode, so "gg 🎉" becomes "gg :tada:".
+// Sequences are replaced whole. A skin toned emoji whose toned form has no
+// shortcode of its own is written as its base's shortcode followed by
+// :skin-tone-N:, which Emojize reads back. An emoji with no shortcode at all
+// is kept, or with WithDemojizeCodePoints written as its code points, as in
+// :u1f9d1-200d-1f9b0:. Text and custom emojis are kept as they are.
+func (p *DiscordEmojiParser) Demojize(content string) string {
+	return p.ReplaceTypes(content, func(emoji ParsedEmoji) string {
+		if name, ok := p.demojizedName(emoji.Unicode); ok {
+			return ":" + name + ":"
+		}
+		if tone := toneOf(emoji.Unicode); tone != ToneNone {
+			base := withoutSkinTones(emoji.Unicode)
+			if name, ok := p.demojizedName(base); ok && withSkinTone(base, tone) == emoji.Unicode {
+				return ":" + name + ":" + toneShortcodePrefix + string(rune('0'+tone)) + ":"
+			}
+		}
+		if p.opts.DemojizeCodePoints {
+			return ":u" + toCodePoint(emoji.Unicode, "-") + ":"
+		}
+		return emoji.Unicode
+	}, EmojiTypeUnicode)
+}
+
+// demojizedName returns the shortcode to write emoji as, trying the fully
+// qualified form when emoji lacks a U+FE0F that the dataset key has.
+func (p *DiscordEmojiParser) demojizedName(emoji string) (string, bool) {
+	if name, ok := p.PreferredShortcode(emoji); ok {
+		return name, true
+	}
+	return p.PreferredShortcode(addVS16(emoji))
+}
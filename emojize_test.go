@@ -0,0 +1,76 @@
+package emojiparser_test
+
+import (
+	"testing"
+	"unsafe"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestEmojize(t *testing.T) {
+	cases := map[string]string{
+		"gg :tada:":                          "gg 🎉",
+		":joy:sob:":                          "😂😭",
+		":thumbsup::skin-tone-3: ok":         "👍🏽 ok",
+		":nope: :smile:":                     ":nope: 😄",
+		"<:smile:12345678901234567> :smile:": "<:smile:12345678901234567> 😄",
+		"😄 already":                          "😄 already",
+	}
+	for input, want := range cases {
+		if got := emojiparser.Emojize(input); got != want {
+			t.Fatalf("Emojize(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestEmojizeUnchanged(t *testing.T) {
+	content := "nothing to see 😄 <:pepe:12345678901234567>"
+	if got := emojiparser.Emojize(content); unsafe.StringData(got) != unsafe.StringData(content) {
+		t.Fatalf("Emojize copied content without shortcodes")
+	}
+}
+
+func TestDemojize(t *testing.T) {
+	cases := map[string]string{
+		"gg 🎉":                        "gg :tada:",
+		"😂😭":                          ":joy::sob:",
+		"👍🏽":                          ":thumbup_tone3:",
+		"<:pepe:12345678901234567> 😄": "<:pepe:12345678901234567> :smile:",
+		":tada: stays":                ":tada: stays",
+		"plain":                       "plain",
+	}
+	for input, want := range cases {
+		if got := emojiparser.Demojize(input); got != want {
+			t.Fatalf("Demojize(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestDemojizeRoundTrip(t *testing.T) {
+	for _, content := range []string{"gg 🎉 👨\u200d👩\u200d👧 ❤\ufe0f 👍🏿", "🇺🇸 1\ufe0f\u20e3 🏳\ufe0f\u200d🌈"} {
+		if got := emojiparser.Emojize(emojiparser.Demojize(content)); got != content {
+			t.Fatalf("Emojize(Demojize(%q)) = %q", content, got)
+		}
+	}
+}
+
+func TestDemojizeSkinToneSuffix(t *testing.T) {
+	got := emojiparser.Demojize("🤼🏽")
+	name, ok := emojiparser.PreferredShortcode("🤼")
+	if want := ":" + name + "::skin-tone-3:"; !ok || got != want {
+		t.Fatalf("Demojize = %q, want %q", got, want)
+	}
+	if back := emojiparser.Emojize(got); back != "🤼🏽" {
+		t.Fatalf("Emojize(%q) = %q", got, back)
+	}
+}
+
+func TestDemojizeCodePoints(t *testing.T) {
+	if got := emojiparser.Demojize("a🪅"); got != "a🪅" {
+		t.Fatalf("Demojize = %q, want the emoji kept", got)
+	}
+	parser := newTestParser(t, emojiparser.WithDemojizeCodePoints(true))
+	if got := parser.Demojize("a🪅"); got != "a:u1fa85:" {
+		t.Fatalf("Demojize = %q, want %q", got, "a:u1fa85:")
+	}
+}
@@ -0,0 +1,86 @@
+package emojiparser
+
+import "sync"
+
+// Field is a named piece of emoji-bearing text, such as a username, channel
+// topic, or embed field.
+type Field struct {
+	Name  string
+	Value string
+}
+
+// FieldEmojis holds the emojis parsed from one Field.
+type FieldEmojis struct {
+	Name   string
+	Emojis []ParsedEmoji
+}
+
+// ParseFields parses each field value independently using the default parser.
+func ParseFields(fields map[string]string) map[string][]ParsedEmoji {
+	return defaultParser.ParseFields(fields)
+}
+
+// ParseFieldList parses each field value independently using the default parser.
+func ParseFieldList(fields []Field) []FieldEmojis {
+	return defaultParser.ParseFieldList(fields)
+}
+
+// ParseFields parses each value in fields independently and returns the
+// results under the same keys. Empty values map to empty slices. Fields are
+// parsed concurrently when their combined size reaches the parser's
+// ParallelFieldsThreshold.
+func (p *DiscordEmojiParser) ParseFields(fields map[string]string) map[string][]ParsedEmoji {
+	list := make([]Field, 0, len(fields))
+	for name, value := range fields {
+		list = append(list, Field{Name: name, Value: value})
+	}
+
+	results := make(map[string][]ParsedEmoji, len(fields))
+	for _, field := range p.ParseFieldList(list) {
+		results[field.Name] = field.Emojis
+	}
+	return results
+}
+
+// ParseFieldList is like ParseFields but keeps the order of fields, so
+// repeated names are allowed and results line up with the input by index.
+func (p *DiscordEmojiParser) ParseFieldList(fields []Field) []FieldEmojis {
+	results := make([]FieldEmojis, len(fields))
+	for i, field := range fields {
+		results[i].Name = field.Name
+	}
+
+	if !p.parseFieldsConcurrently(fields) {
+		for i, field := range fields {
+			results[i].Emojis = p.Parse(field.Value)
+		}
+		return results
+	}
+
+	var wg sync.WaitGroup
+	for i, field := range fields {
+		if !mayContainEmoji(field.Value) {
+			results[i].Emojis = []ParsedEmoji{}
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i].Emojis = p.Parse(field.Value)
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+func (p *DiscordEmojiParser) parseFieldsConcurrently(fields []Field) bool {
+	threshold := p.opts.ParallelFieldsThreshold
+	if threshold <= 0 || len(fields) < 2 {
+		return false
+	}
+	total := 0
+	for _, field := range fields {
+		total += len(field.Value)
+	}
+	return total >= threshold
+}
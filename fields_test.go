@@ -0,0 +1,64 @@
+package emojiparser_test
+
+import (
+	"strings"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestParseFields(t *testing.T) {
+	fields := map[string]string{
+		"username": "🔥general🔥",
+		"topic":    "talk about :smile: things",
+		"empty":    "",
+		"tag":      "<:pepe:12345678901234567>",
+		"plain":    "no emojis here",
+	}
+
+	results := emojiparser.ParseFields(fields)
+	if len(results) != len(fields) {
+		t.Fatalf("expected %d fields, got %d", len(fields), len(results))
+	}
+	want := map[string]int{"username": 2, "topic": 1, "empty": 0, "tag": 1, "plain": 0}
+	for name, count := range want {
+		got, ok := results[name]
+		if !ok {
+			t.Fatalf("missing field %q", name)
+		}
+		if got == nil {
+			t.Fatalf("field %q: expected empty slice, got nil", name)
+		}
+		if len(got) != count {
+			t.Fatalf("field %q: expected %d emojis, got %d", name, count, len(got))
+		}
+	}
+	if results["tag"][0].Type != emojiparser.EmojiTypeCustom {
+		t.Fatalf("expected custom emoji in emoji-only field")
+	}
+}
+
+func TestParseFieldListConcurrent(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser(emojiparser.WithParallelFields(1))
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+
+	fields := []emojiparser.Field{
+		{Name: "a", Value: strings.Repeat("😄 ", 50)},
+		{Name: "b", Value: ""},
+		{Name: "a", Value: ":tada:"},
+	}
+	results := parser.ParseFieldList(fields)
+	if len(results) != len(fields) {
+		t.Fatalf("expected %d results, got %d", len(fields), len(results))
+	}
+	for i, field := range fields {
+		if results[i].Name != field.Name {
+			t.Fatalf("result %d: expected name %q, got %q", i, field.Name, results[i].Name)
+		}
+		if want := parser.Parse(field.Value); len(results[i].Emojis) != len(want) {
+			t.Fatalf("result %d: expected %d emojis, got %d", i, len(want), len(results[i].Emojis))
+		}
+	}
+}
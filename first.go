@@ -0,0 +1,59 @@
+package emojiparser
+
+// First returns the first emoji in content using the default parser.
+func First(content string) (ParsedEmoji, bool) {
+	return defaultParser.First(content)
+}
+
+// FirstOfType returns the first emoji of the given type in content using the
+// default parser.
+func FirstOfType(content string, kind EmojiType) (ParsedEmoji, bool) {
+	return defaultParser.FirstOfType(content, kind)
+}
+
+// First returns the emoji with the smallest Position.From in content, which is
+// p.Parse(content)[0], and false if there is none. Scanning stops at that
+// emoji. Where several types match at the same offset, the same one wins as
+// in Parse: custom markup, then a shortcode, then a unicode emoji.
+func (p *DiscordEmojiParser) First(content string) (ParsedEmoji, bool) {
+	return p.first(content, "")
+}
+
+// FirstOfType is First restricted to emojis of the given type. It returns the
+// first result of that type in Parse, so a shortcode inside custom emoji
+// markup is never the first text emoji.
+func (p *DiscordEmojiParser) FirstOfType(content string, kind EmojiType) (ParsedEmoji, bool) {
+	return p.first(content, kind)
+}
+
+// first returns the first emoji of the given kind, or of any kind if kind is
+// empty.
+func (p *DiscordEmojiParser) first(content string, kind EmojiType) (ParsedEmoji, bool) {
+	if !p.beginParse(content) {
+		return ParsedEmoji{}, false
+	}
+
+	state := p.state.Load()
+	scanned, m := p.rewriteContent(content)
+	var first token
+	found := false
+	p.scan(state, scanned, scanAll, nil, func(t token) bool {
+		if kind != "" && t.kind != kind {
+			return true
+		}
+		first, found = t, true
+		return false
+	})
+	if !found {
+		return ParsedEmoji{}, false
+	}
+
+	var counts tokenCounts
+	counts.add(first.kind)
+	p.countTokens(counts)
+	emoji := p.emojiFor(state, scanned, first)
+	if m != nil {
+		emoji.Position = m.position(emoji.Position)
+	}
+	return emoji, true
+}
@@ -0,0 +1,51 @@
+package emojiparser_test
+
+import (
+	"reflect"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestFirst(t *testing.T) {
+	for _, content := range countInputs {
+		results := emojiparser.Parse(content)
+		got, ok := emojiparser.First(content)
+		if len(results) == 0 {
+			if ok {
+				t.Fatalf("First(%q) = %v, want none", content, got)
+			}
+			continue
+		}
+		if !ok || !reflect.DeepEqual(got, results[0]) {
+			t.Fatalf("First(%q) = %v, %v, want %v", content, got, ok, results[0])
+		}
+	}
+}
+
+func TestFirstOfType(t *testing.T) {
+	content := "😄 <:smile:12345678901234567> :tada: 🔥"
+	got, ok := emojiparser.FirstOfType(content, emojiparser.EmojiTypeText)
+	if !ok || got.Name != "tada" {
+		t.Fatalf("FirstOfType(text) = %v, %v, want tada", got, ok)
+	}
+	got, ok = emojiparser.FirstOfType(content, emojiparser.EmojiTypeCustom)
+	if !ok || got.Name != "smile" || got.Position.From != 5 {
+		t.Fatalf("FirstOfType(custom) = %v, %v, want smile at 5", got, ok)
+	}
+	if _, ok := emojiparser.FirstOfType("😄 :tada:", emojiparser.EmojiTypeCustom); ok {
+		t.Fatalf("FirstOfType(custom) found an emoji in content without one")
+	}
+}
+
+func TestFirstZeroWidth(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser(emojiparser.WithIgnoreZeroWidth(true))
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+	content := "a\u200b:sm\u200bile: 😄"
+	got, ok := parser.First(content)
+	if want := parser.Parse(content)[0]; !ok || !reflect.DeepEqual(got, want) {
+		t.Fatalf("First(%q) = %v, %v, want %v", content, got, ok, want)
+	}
+}
@@ -0,0 +1,153 @@
+package emojiparser
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Shortcode families that Discord's client accepts for every regional
+// indicator letter and every flag it has an image for. They resolve without
+// entries in the name table.
+const (
+	flagShortcodePrefix      = "flag_"
+	indicatorShortcodePrefix = "regional_indicator_"
+
+	regionalIndicatorA = '\U0001F1E6'
+	regionalIndicatorZ = '\U0001F1FF'
+)
+
+// A subdivision flag is a black flag followed by tag characters spelling an
+// ISO 3166-2 code, such as "gbeng", and CANCEL TAG.
+const (
+	blackFlag = '\U0001F3F4'
+	cancelTag = '\U000E007F'
+)
+
+// lookupShortcode returns the emoji for a shortcode name: the table entry if
+// there is one, else the emoji of a generated :regional_indicator_x: or
+// :flag_xx: shortcode.
+func (s *parserState) lookupShortcode(name string) (string, bool) {
+	if emoji, ok := s.nameToUnicode[name]; ok {
+		return emoji, true
+	}
+	return s.generatedShortcode(name)
+}
+
+// generatedShortcode resolves :regional_indicator_x: for the letters a to z
+// and :flag_xx: for the letter pairs whose regional indicator sequence has an
+// SVG asset or a table entry, so unassigned pairs like :flag_zz: stay text.
+func (s *parserState) generatedShortcode(name string) (string, bool) {
+	if letter, ok := strings.CutPrefix(name, indicatorShortcodePrefix); ok && isIndicatorCode(letter, 1) {
+		return regionalIndicator(letter[0]), true
+	}
+	if code, ok := strings.CutPrefix(name, flagShortcodePrefix); ok && isIndicatorCode(code, 2) {
+		flag := regionalIndicator(code[0]) + regionalIndicator(code[1])
+		if _, ok := s.svg[toCodePoint(flag, "-")]; ok {
+			return flag, true
+		}
+		if _, ok := s.unicodeToName[flag]; ok {
+			return flag, true
+		}
+	}
+	return "", false
+}
+
+// isIndicatorCode reports whether code is n lowercase ASCII letters.
+func isIndicatorCode(code string, n int) bool {
+	if len(code) != n {
+		return false
+	}
+	for i := 0; i < len(code); i++ {
+		if code[i] < 'a' || code[i] > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+// regionalIndicator returns the regional indicator symbol for a lowercase
+// ASCII letter.
+func regionalIndicator(letter byte) string {
+	return string(regionalIndicatorA + rune(letter-'a'))
+}
+
+// regionalIndicatorLetter returns the lowercase letter a regional indicator
+// symbol stands for.
+func regionalIndicatorLetter(r rune) (byte, bool) {
+	if r < regionalIndicatorA || r > regionalIndicatorZ {
+		return 0, false
+	}
+	return byte('a' + r - regionalIndicatorA), true
+}
+
+// regionalIndicatorPair returns the length of the two regional indicators
+// content starts with, or 0. A pair is the flag of the country with that
+// ISO 3166 code.
+func regionalIndicatorPair(content string) int {
+	first, size := utf8.DecodeRuneInString(content)
+	if _, ok := regionalIndicatorLetter(first); !ok {
+		return 0
+	}
+	second, next := utf8.DecodeRuneInString(content[size:])
+	if _, ok := regionalIndicatorLetter(second); !ok {
+		return 0
+	}
+	return size + next
+}
+
+// tagLetter returns the lowercase letter or digit the tag character r stands
+// for. Subdivision codes only use those.
+func tagLetter(r rune) (byte, bool) {
+	switch {
+	case r >= '\U000E0061' && r <= '\U000E007A', r >= '\U000E0030' && r <= '\U000E0039':
+		return byte(r - 0xE0000), true
+	}
+	return 0, false
+}
+
+// tagSequence returns the length of the subdivision flag content starts
+// with, or 0. A sequence without its CANCEL TAG is not a flag.
+func tagSequence(content string) int {
+	first, n := utf8.DecodeRuneInString(content)
+	if first != blackFlag {
+		return 0
+	}
+	for tags := 0; ; tags++ {
+		r, size := utf8.DecodeRuneInString(content[n:])
+		if r == cancelTag && tags > 0 {
+			return n + size
+		}
+		if _, ok := tagLetter(r); !ok {
+			return 0
+		}
+		n += size
+	}
+}
+
+// flagSequence returns the length of the country or subdivision flag content
+// starts with, or 0.
+func flagSequence(content string) int {
+	if n := regionalIndicatorPair(content); n > 0 {
+		return n
+	}
+	return tagSequence(content)
+}
+
+// generatedName names an emoji the tables do not: a flag is named after the
+// letters of its regional indicators or tags, like :flag_us: or "flag_gbeng".
+// Other emojis get "".
+func generatedName(emoji string) string {
+	if flagSequence(emoji) != len(emoji) {
+		return ""
+	}
+	var name strings.Builder
+	name.WriteString(flagShortcodePrefix)
+	for _, r := range emoji {
+		if letter, ok := regionalIndicatorLetter(r); ok {
+			name.WriteByte(letter)
+		} else if letter, ok := tagLetter(r); ok {
+			name.WriteByte(letter)
+		}
+	}
+	return name.String()
+}
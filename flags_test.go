@@ -0,0 +1,121 @@
+package emojiparser_test
+
+import (
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestGeneratedFlagShortcodes(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Tables without any flag or indicator names; the German flag has an SVG.
+	err = parser.ReloadAssets(assetsFS(`{"grin": "😄", "😄": "grin"}`, `{"1f1e9-1f1ea": "feed", "1f1e7": "beef"}`))
+	if err != nil {
+		t.Fatalf("reload: %v", err)
+	}
+
+	results := parser.ParseTextRepresentation("go :flag_de: :regional_indicator_b: :flag_zz: :flag_DE:", nil)
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2: %v", len(results), results)
+	}
+
+	flag := results[0]
+	if flag.Name != "flag_de" || flag.Unicode != "🇩🇪" || flag.Link == nil || *flag.Link != "https://discord.com/assets/feed.svg" {
+		t.Fatalf("flag result = %+v", flag)
+	}
+	letter := results[1]
+	if letter.Name != "regional_indicator_b" || letter.Unicode != "🇧" || letter.Link == nil || *letter.Link != "https://discord.com/assets/beef.svg" {
+		t.Fatalf("indicator result = %+v", letter)
+	}
+
+	if !parser.ContainsEmojiNamed("hi :flag_de:", "flag_de") {
+		t.Fatal("ContainsEmojiNamed does not resolve a generated flag shortcode")
+	}
+	if got := parser.Assets().UnicodeEmojis["flag_de"]; got != "" {
+		t.Fatalf("generated shortcode leaked into Assets(): %q", got)
+	}
+}
+
+func TestFlagShortcodesDefaultTables(t *testing.T) {
+	results := emojiparser.ParseTextRepresentation(":flag_us: :regional_indicator_z: :flag_zz:", nil)
+	if len(results) != 2 || results[0].Unicode != "🇺🇸" || results[1].Unicode != "🇿" {
+		t.Fatalf("results = %v", results)
+	}
+}
+
+func TestRegionalIndicatorFlags(t *testing.T) {
+	checkSingleEmoji(t, []wantEmoji{
+		{"🇺🇸", "flag_us", "🇺🇸", 0, 8},
+		{"in 🇧🇬!", "flag_bg", "🇧🇬", 3, 11},
+		// Pairs the tables do not know are named after their letters.
+		{"🇦🇦", "flag_aa", "🇦🇦", 0, 8},
+		{"🇺🇺", "flag_uu", "🇺🇺", 0, 8},
+		// A lone indicator is still an emoji.
+		{"🇺 x", "regional_indicator_u", "🇺", 0, 4},
+	})
+}
+
+func TestRegionalIndicatorPairing(t *testing.T) {
+	results := emojiparser.Parse("🇺🇸🇧🇬🇫")
+	want := []string{"flag_us", "flag_bg", "regional_indicator_f"}
+	if len(results) != len(want) {
+		t.Fatalf("Parse = %v, want %v", results, want)
+	}
+	for i, result := range results {
+		if result.Name != want[i] {
+			t.Fatalf("result %d = %v, want %s", i, result, want[i])
+		}
+	}
+}
+
+func TestRegionalIndicatorFlagLink(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser(emojiparser.WithUnicodeLinkTemplate("{codepoints}"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	results := parser.Parse("🇦🇦")
+	if len(results) != 1 || results[0].Link == nil || *results[0].Link != "1f1e6-1f1e6" {
+		t.Fatalf("Parse(🇦🇦) = %v, want the link 1f1e6-1f1e6", results)
+	}
+	if flag := emojiparser.Parse("🇺🇸")[0]; flag.Link == nil {
+		t.Fatalf("Parse(🇺🇸) = %v, want the flag's asset", flag)
+	}
+}
+
+// subdivisionFlag spells the tag sequence flag of an ISO 3166-2 code.
+func subdivisionFlag(code string, cancel bool) string {
+	flag := "\U0001F3F4"
+	for _, c := range code {
+		flag += string(c + 0xE0000)
+	}
+	if cancel {
+		flag += "\U000E007F"
+	}
+	return flag
+}
+
+func TestSubdivisionFlags(t *testing.T) {
+	england, scotland, wales := subdivisionFlag("gbeng", true), subdivisionFlag("gbsct", true), subdivisionFlag("gbwls", true)
+	texas := subdivisionFlag("ustx", true)
+	checkSingleEmoji(t, []wantEmoji{
+		{england, "england", england, 0, len(england)},
+		{"go " + scotland + "!", "scotland", scotland, 3, 3 + len(scotland)},
+		{wales, "wales", wales, 0, len(wales)},
+		// Flags the tables do not know are named after their tags.
+		{texas, "flag_ustx", texas, 0, len(texas)},
+	})
+}
+
+func TestTruncatedSubdivisionFlag(t *testing.T) {
+	black := emojiparser.Parse("\U0001F3F4")[0]
+	for _, content := range []string{subdivisionFlag("gbeng", false), subdivisionFlag("", true)} {
+		results := emojiparser.Parse(content + ":smile:")
+		if len(results) != 2 || results[0].Name != black.Name || results[0].Position.To != 4 ||
+			results[1].Position.From != len(content) {
+			t.Fatalf("Parse(%+q) = %v, want the black flag and :smile:", content, results)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package emojiparser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// String returns a compact description of the emoji for logs, such as
+// `custom "wave" id=1234 animated [7:33)` or `unicode "smile" U+1F604 [3:7)`.
+// Missing values are shown as "-".
+func (e ParsedEmoji) String() string {
+	var b strings.Builder
+	e.writeTo(&b, false)
+	return b.String()
+}
+
+// Format implements fmt.Formatter. The verbs %v and %s print String, %q
+// prints it quoted, and %+v adds the link. %#v prints the Go syntax of the
+// struct.
+func (e ParsedEmoji) Format(f fmt.State, verb rune) {
+	type plain ParsedEmoji // drops the methods to avoid recursion
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		goSyntax := fmt.Sprintf("%#v", plain(e))
+		fmt.Fprint(f, strings.Replace(goSyntax, "plain", "ParsedEmoji", 1))
+	case verb == 'v' || verb == 's':
+		var b strings.Builder
+		e.writeTo(&b, verb == 'v' && f.Flag('+'))
+		fmt.Fprint(f, b.String())
+	case verb == 'q':
+		fmt.Fprint(f, strconv.Quote(e.String()))
+	default:
+		fmt.Fprintf(f, "%%!%c(ParsedEmoji=%s)", verb, e.String())
+	}
+}
+
+func (e ParsedEmoji) writeTo(b *strings.Builder, verbose bool) {
+	b.WriteString(string(e.Type))
+	b.WriteByte(' ')
+	b.WriteString(strconv.Quote(e.Name))
+	b.WriteByte(' ')
+
+	if e.Type == EmojiTypeCustom {
+		b.WriteString("id=")
+		b.WriteString(orDash(e.ID))
+		if e.Animated {
+			b.WriteString(" animated")
+		}
+	} else if e.Unicode == "" {
+		b.WriteByte('-')
+	} else {
+		for i, r := range e.Unicode {
+			if i > 0 {
+				b.WriteByte(' ')
+			}
+			fmt.Fprintf(b, "U+%04X", r)
+		}
+	}
+
+	fmt.Fprintf(b, " [%d:%d)", e.Position.From, e.Position.To)
+	if verbose {
+		b.WriteString(" link=")
+		b.WriteString(orDash(e.Link))
+	}
+}
+
+func orDash(value *string) string {
+	if value == nil {
+		return "-"
+	}
+	return *value
+}
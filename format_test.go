@@ -0,0 +1,64 @@
+package emojiparser_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestParsedEmojiFormat(t *testing.T) {
+	content := "hi 😄 <a:wave:12345678901234567> :tada: <:pepe:22345678901234567> 👍🏻"
+	results := emojiparser.Parse(content)
+	if len(results) != 5 {
+		t.Fatalf("got %d results, want 5", len(results))
+	}
+
+	want := []string{
+		`unicode "smile" U+1F604 [3:7)`,
+		`custom "wave" id=12345678901234567 animated [8:34)`,
+		`text "tada" U+1F389 [35:41)`,
+		`custom "pepe" id=22345678901234567 [42:67)`,
+		`unicode "thumbup_tone1" U+1F44D U+1F3FB [68:76)`,
+	}
+	for i, result := range results {
+		if got := result.String(); got != want[i] {
+			t.Fatalf("String() = %s, want %s", got, want[i])
+		}
+		if got := fmt.Sprintf("%v", result); got != want[i] {
+			t.Fatalf("%%v = %s, want %s", got, want[i])
+		}
+		verbose := fmt.Sprintf("%+v", result)
+		if wantLink := " link=" + *result.Link; !strings.HasSuffix(verbose, wantLink) || !strings.HasPrefix(verbose, want[i]) {
+			t.Fatalf("%%+v = %s, want %s%s", verbose, want[i], wantLink)
+		}
+	}
+
+	if got := fmt.Sprintf("%+v", results[1]); got != `custom "wave" id=12345678901234567 animated [8:34) link=https://cdn.discordapp.com/emojis/12345678901234567.gif` {
+		t.Fatalf("%%+v = %s", got)
+	}
+}
+
+func TestParsedEmojiFormatMissingFields(t *testing.T) {
+	tests := []struct {
+		emoji  emojiparser.ParsedEmoji
+		format string
+		want   string
+	}{
+		{emojiparser.ParsedEmoji{Type: emojiparser.EmojiTypeCustom, Name: "wave"}, "%v", `custom "wave" id=- [0:0)`},
+		{emojiparser.ParsedEmoji{Type: emojiparser.EmojiTypeCustom, Name: "wave"}, "%+v", `custom "wave" id=- [0:0) link=-`},
+		{emojiparser.ParsedEmoji{Type: emojiparser.EmojiTypeUnicode, Name: "smile"}, "%+v", `unicode "smile" - [0:0) link=-`},
+		{emojiparser.ParsedEmoji{Type: emojiparser.EmojiTypeText, Name: "smile", Unicode: "😄"}, "%q", `"text \"smile\" U+1F604 [0:0)"`},
+		{emojiparser.ParsedEmoji{Type: emojiparser.EmojiTypeText, Name: "smile"}, "%d", `%!d(ParsedEmoji=text "smile" - [0:0))`},
+	}
+	for _, tt := range tests {
+		if got := fmt.Sprintf(tt.format, tt.emoji); got != tt.want {
+			t.Fatalf("Sprintf(%q) = %s, want %s", tt.format, got, tt.want)
+		}
+	}
+
+	if got := fmt.Sprintf("%#v", emojiparser.ParsedEmoji{Name: "x"}); !strings.HasPrefix(got, "emojiparser.ParsedEmoji{") || !strings.Contains(got, `Name:"x"`) {
+		t.Fatalf("%%#v = %s, want Go syntax", got)
+	}
+}
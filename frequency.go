@@ -0,0 +1,89 @@
+package emojiparser
+
+// EmojiKey identifies an emoji regardless of where and how it was written.
+// Custom emojis are identified by ID alone, and unicode and text emojis by
+// their unicode sequence alone, so :smile: and 😄 share a key while 👍 and 👍🏽
+// do not.
+type EmojiKey struct {
+	ID      string // custom emoji ID, empty for other types
+	Unicode string // unicode sequence, empty for custom emojis
+}
+
+// Key returns the key identifying e.
+func (e ParsedEmoji) Key() EmojiKey {
+	if e.Type == EmojiTypeCustom {
+		if e.ID == nil {
+			return EmojiKey{}
+		}
+		return EmojiKey{ID: *e.ID}
+	}
+	return EmojiKey{Unicode: e.Unicode}
+}
+
+// keyOf returns the key of the emoji t.
+func keyOf(t token) EmojiKey {
+	if t.kind == EmojiTypeCustom {
+		return EmojiKey{ID: t.id}
+	}
+	return EmojiKey{Unicode: t.unicode}
+}
+
+// String returns the custom emoji ID or the unicode sequence.
+func (k EmojiKey) String() string {
+	if k.ID != "" {
+		return k.ID
+	}
+	return k.Unicode
+}
+
+// Frequencies counts the occurrences of each emoji in content using the
+// default parser.
+func Frequencies(content string) map[string]int {
+	return defaultParser.Frequencies(content)
+}
+
+// FrequenciesByKey counts the occurrences of each emoji in content using the
+// default parser.
+func FrequenciesByKey(content string) map[EmojiKey]int {
+	return defaultParser.FrequenciesByKey(content)
+}
+
+// Frequencies returns how many times each emoji occurs in content, keyed by
+// EmojiKey.String: the ID of a custom emoji, the unicode sequence otherwise.
+// IDs are digits and no unicode sequence is, so the two never collide.
+func (p *DiscordEmojiParser) Frequencies(content string) map[string]int {
+	frequencies := make(map[string]int)
+	p.countKeys(content, func(key EmojiKey) {
+		frequencies[key.String()]++
+	})
+	return frequencies
+}
+
+// FrequenciesByKey is Frequencies keyed by EmojiKey. Like Count, it scans
+// content without building results.
+func (p *DiscordEmojiParser) FrequenciesByKey(content string) map[EmojiKey]int {
+	frequencies := make(map[EmojiKey]int)
+	p.countKeys(content, func(key EmojiKey) {
+		frequencies[key]++
+	})
+	return frequencies
+}
+
+// countKeys calls add with the key of each emoji in content.
+func (p *DiscordEmojiParser) countKeys(content string, add func(EmojiKey)) {
+	if !p.beginParse(content) {
+		return
+	}
+
+	state := p.state.Load()
+	if stripped, m := p.rewriteContent(content); m != nil {
+		content = stripped
+	}
+	var counts tokenCounts
+	p.scan(state, content, scanAll, nil, func(t token) bool {
+		counts.add(t.kind)
+		add(keyOf(t))
+		return true
+	})
+	p.countTokens(counts)
+}
@@ -0,0 +1,43 @@
+package emojiparser_test
+
+import (
+	"maps"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestFrequencies(t *testing.T) {
+	content := "😂😂 👍 👍🏽 :joy: <:pepe:12345678901234567> <:pepe:76543210987654321> <:frog:12345678901234567>"
+	want := map[string]int{
+		"😂":                 3,
+		"👍":                 1,
+		"👍🏽":                1,
+		"12345678901234567": 2,
+		"76543210987654321": 1,
+	}
+	if got := emojiparser.Frequencies(content); !maps.Equal(got, want) {
+		t.Fatalf("Frequencies = %v, want %v", got, want)
+	}
+
+	byKey := emojiparser.FrequenciesByKey(content)
+	for _, emoji := range emojiparser.Parse(content) {
+		if byKey[emoji.Key()] != want[emoji.Key().String()] {
+			t.Fatalf("FrequenciesByKey[%v] = %d, want %d", emoji.Key(), byKey[emoji.Key()], want[emoji.Key().String()])
+		}
+	}
+	if len(byKey) != len(want) {
+		t.Fatalf("FrequenciesByKey has %d keys, want %d", len(byKey), len(want))
+	}
+}
+
+func TestEmojiKey(t *testing.T) {
+	custom := emojiparser.Parse("<:pepe:12345678901234567>")[0].Key()
+	if custom != (emojiparser.EmojiKey{ID: "12345678901234567"}) {
+		t.Fatalf("custom key = %v", custom)
+	}
+	text := emojiparser.Parse(":smile:")[0].Key()
+	if unicode := emojiparser.Parse("😄")[0].Key(); text != unicode || unicode.Unicode != "😄" {
+		t.Fatalf("text key %v and unicode key %v differ", text, unicode)
+	}
+}
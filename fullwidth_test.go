@@ -0,0 +1,51 @@
+package emojiparser_test
+
+import (
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestAcceptFullwidthColons(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser(emojiparser.WithAcceptFullwidthColons(true))
+	if err != nil {
+		t.Fatalf("NewDiscordEmojiParser: %v", err)
+	}
+
+	tests := []struct {
+		content string
+		want    []emojiparser.EmojiPosition
+	}{
+		{"ＦＦ：smile：", []emojiparser.EmojiPosition{{From: 6, To: 17}}},
+		{"：smile：", []emojiparser.EmojiPosition{{From: 0, To: 11}}},
+		{"：tada: :tada：", []emojiparser.EmojiPosition{{From: 0, To: 8}, {From: 9, To: 17}}},
+		{":smile:", []emojiparser.EmojiPosition{{From: 0, To: 7}}},
+		{"時間：午後３時", nil},
+		{"比率は 3：2 です", nil},
+		{"注意：smile について", nil},
+		{"：not_an_emoji：", nil},
+	}
+	for _, tc := range tests {
+		results := parser.Parse(tc.content)
+		if len(results) != len(tc.want) {
+			t.Fatalf("Parse(%q) = %+v, want positions %v", tc.content, results, tc.want)
+		}
+		for i, result := range results {
+			if result.Type != emojiparser.EmojiTypeText || result.Position != tc.want[i] {
+				t.Fatalf("Parse(%q)[%d] = %+v, want a text emoji at %v", tc.content, i, result, tc.want[i])
+			}
+		}
+	}
+
+	if got := parser.Parse("：smile："); got[0].Name != "smile" || got[0].Unicode != "😄" {
+		t.Fatalf("Parse = %+v, want smile", got[0])
+	}
+}
+
+func TestFullwidthColonsOffByDefault(t *testing.T) {
+	for _, content := range []string{"：smile：", "：smile:", ":smile："} {
+		if got := emojiparser.Parse(content); len(got) != 0 {
+			t.Fatalf("Parse(%q) = %+v, want no results", content, got)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package emojiparser
+
+// GroupedEmojis holds parse results split by type, each in position order.
+type GroupedEmojis struct {
+	Custom  []ParsedEmoji
+	Unicode []ParsedEmoji
+	Text    []ParsedEmoji
+}
+
+// ParseGrouped parses content and groups the results by type using the
+// default parser.
+func ParseGrouped(content string) GroupedEmojis {
+	return defaultParser.ParseGrouped(content)
+}
+
+// ParseGrouped is Partition(p.Parse(content)). The groups come from a single
+// parse, so together they are exactly Parse's results: no emoji is in two
+// groups and no two results overlap.
+func (p *DiscordEmojiParser) ParseGrouped(content string) GroupedEmojis {
+	return Partition(p.Parse(content))
+}
+
+// Partition splits results by type, keeping their order within each type.
+// Types without results have nil slices.
+func Partition(results []ParsedEmoji) GroupedEmojis {
+	var groups GroupedEmojis
+	for _, result := range results {
+		switch result.Type {
+		case EmojiTypeCustom:
+			groups.Custom = append(groups.Custom, result)
+		case EmojiTypeUnicode:
+			groups.Unicode = append(groups.Unicode, result)
+		case EmojiTypeText:
+			groups.Text = append(groups.Text, result)
+		}
+	}
+	return groups
+}
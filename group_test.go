@@ -0,0 +1,36 @@
+package emojiparser_test
+
+import (
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestParseGrouped(t *testing.T) {
+	content := "😄 <:smile:12345678901234567> :tada: 🔥 <a:wave:12345678901234567> :smile:"
+	groups := emojiparser.ParseGrouped(content)
+	check := func(name string, got []emojiparser.ParsedEmoji, kind emojiparser.EmojiType, want ...string) {
+		t.Helper()
+		if len(got) != len(want) {
+			t.Fatalf("%s: got %d emojis, want %d", name, len(got), len(want))
+		}
+		for i, emoji := range got {
+			if emoji.Type != kind || emoji.Name != want[i] {
+				t.Fatalf("%s[%d] = %s %s, want %s %s", name, i, emoji.Type, emoji.Name, kind, want[i])
+			}
+			if i > 0 && got[i-1].Position.To > emoji.Position.From {
+				t.Fatalf("%s: results out of order: %v", name, got)
+			}
+		}
+	}
+	check("Custom", groups.Custom, emojiparser.EmojiTypeCustom, "smile", "wave")
+	check("Unicode", groups.Unicode, emojiparser.EmojiTypeUnicode, "smile", "flame")
+	check("Text", groups.Text, emojiparser.EmojiTypeText, "tada", "smile")
+}
+
+func TestPartitionEmpty(t *testing.T) {
+	groups := emojiparser.Partition(nil)
+	if groups.Custom != nil || groups.Unicode != nil || groups.Text != nil {
+		t.Fatalf("Partition(nil) = %v", groups)
+	}
+}
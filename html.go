@@ -0,0 +1,231 @@
+package emojiparser
+
+import (
+	"html"
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	htmlTagRegex    = regexp.MustCompile(`(?s)<[A-Za-z!/][^>]*>`)
+	htmlImgRegex    = regexp.MustCompile(`(?i)^<img\b`)
+	htmlAttrRegex   = regexp.MustCompile(`(?s)([A-Za-z-]+)\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s"'>]+))`)
+	htmlEntityRegex = regexp.MustCompile(`&(?:#[0-9]+|#[xX][0-9A-Fa-f]+|[A-Za-z][A-Za-z0-9]*);`)
+
+	// Twemoji-style file names list lowercase hex code points separated by
+	// dashes, e.g. 1f468-200d-1f4bb.svg.
+	codePointFileRegex = regexp.MustCompile(`^([0-9a-f]{2,6}(?:-[0-9a-f]{2,6})*)\.(?:svg|png)$`)
+	customURLRegex     = regexp.MustCompile(`^https?://(?:cdn|media)\.discordapp\.(?:com|net)/emojis/(\d+)\.(png|gif|webp)`)
+	discordAssetRegex  = regexp.MustCompile(`^https?://discord\.com/assets/([0-9a-f]+)(?:\.svg)?$`)
+)
+
+// ParseHTML parses emojis from rendered HTML using the default parser.
+func ParseHTML(content string) []ParsedEmoji {
+	return defaultParser.ParseHTML(content)
+}
+
+// ParseHTML parses emojis from HTML produced by web renderers. Character
+// references such as &#x1F604; and &#128516; are decoded before parsing the
+// text between tags, and <img> tags are recognized as emojis when their src
+// is a Twemoji-style code point file, a Discord emoji asset, or a Discord
+// custom emoji URL, or otherwise when their alt text is a single emoji. A
+// custom emoji is named by its alt text, the name parameter of its URL, or
+// the name it is registered under with RegisterCustomEmoji; images with none
+// of these are ignored, since the file name is only the ID.
+// Other entities and images are ignored. Positions refer to content, so an
+// emoji written as references spans all of them and an image spans its tag.
+func (p *DiscordEmojiParser) ParseHTML(content string) []ParsedEmoji {
+	state := p.state.Load()
+	var results []ParsedEmoji
+	text := newDecodedText(len(content))
+
+	last := 0
+	for _, tag := range htmlTagRegex.FindAllStringIndex(content, -1) {
+		text.appendText(content, last, tag[0])
+		// Tags separate the surrounding text so a sequence cannot be joined
+		// across them.
+		text.appendSeparator(tag[0], tag[1])
+		last = tag[1]
+
+		raw := content[tag[0]:tag[1]]
+		if !htmlImgRegex.MatchString(raw) {
+			continue
+		}
+		if emoji, ok := p.parseHTMLImage(state, raw); ok {
+			emoji.Position = EmojiPosition{From: tag[0], To: tag[1]}
+			results = append(results, emoji)
+		}
+	}
+	text.appendText(content, last, len(content))
+
+	for _, emoji := range p.Parse(text.builder.String()) {
+		emoji.Position = text.originalPosition(emoji.Position)
+		results = append(results, emoji)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Position.From < results[j].Position.From
+	})
+	return results
+}
+
+// parseHTMLImage resolves an <img> tag to an emoji.
+func (p *DiscordEmojiParser) parseHTMLImage(state *parserState, tag string) (ParsedEmoji, bool) {
+	attrs := make(map[string]string)
+	for _, match := range htmlAttrRegex.FindAllStringSubmatch(tag, -1) {
+		attrs[strings.ToLower(match[1])] = html.UnescapeString(match[2] + match[3] + match[4])
+	}
+	src, alt := attrs["src"], strings.TrimSpace(attrs["alt"])
+
+	if match := customURLRegex.FindStringSubmatch(src); match != nil {
+		animated := match[2] == "gif"
+		name := strings.Trim(alt, ":")
+		if u, err := url.Parse(src); err == nil {
+			animated = animated || u.Query().Get("animated") == "true"
+			if name == "" {
+				name = u.Query().Get("name")
+			}
+		}
+		if name == "" {
+			name, _ = state.customName(match[1])
+		}
+		if name == "" {
+			return ParsedEmoji{}, false
+		}
+		emoji := p.newCustomEmoji(name, match[1], animated)
+		emoji.Unicode = tag
+		return emoji, true
+	}
+
+	if match := discordAssetRegex.FindStringSubmatch(src); match != nil {
+		if codePoint, ok := state.codePointForHash(match[1]); ok {
+			return p.singleUnicode(state, fromCodePoint(codePoint))
+		}
+	}
+
+	if u, err := url.Parse(src); err == nil {
+		if match := codePointFileRegex.FindStringSubmatch(path.Base(u.Path)); match != nil {
+			sequence := fromCodePoint(match[1])
+			if emoji, ok := p.singleUnicode(state, sequence); ok {
+				return emoji, true
+			}
+			// Twemoji drops U+FE0F from file names.
+			if emoji, ok := p.singleUnicode(state, sequence+variationSelector16); ok {
+				return emoji, true
+			}
+		}
+	}
+
+	if alt == "" {
+		return ParsedEmoji{}, false
+	}
+	if emoji, ok := p.singleUnicode(state, alt); ok {
+		return emoji, true
+	}
+	results := p.parseTextRepresentation(state, alt, nil)
+	if len(results) == 1 && results[0].Position.From == 0 && results[0].Position.To == len(alt) {
+		return results[0], true
+	}
+	return ParsedEmoji{}, false
+}
+
+// singleUnicode returns the unicode emoji that s consists of entirely.
+func (p *DiscordEmojiParser) singleUnicode(state *parserState, s string) (ParsedEmoji, bool) {
+	results := p.parseUnicode(state, s, nil)
+	if len(results) != 1 || results[0].Position.From != 0 || results[0].Position.To != len(s) {
+		return ParsedEmoji{}, false
+	}
+	return results[0], true
+}
+
+// fromCodePoint reverses toCodePoint with a "-" separator. Invalid parts are
+// skipped; callers only pass strings that look like code point lists.
+func fromCodePoint(codePoint string) string {
+	var builder strings.Builder
+	for part := range strings.SplitSeq(codePoint, "-") {
+		value, err := strconv.ParseUint(part, 16, 32)
+		if err != nil {
+			continue
+		}
+		builder.WriteRune(rune(value))
+	}
+	return builder.String()
+}
+
+// decodedText accumulates the text of an HTML document with character
+// references decoded, remembering which original bytes produced each piece.
+type decodedText struct {
+	builder strings.Builder
+	spans   []decodedSpan
+}
+
+// decodedSpan maps decoded bytes [from, to) to original bytes [origFrom, origTo).
+// A span is either a verbatim copy or a single decoded reference or tag.
+type decodedSpan struct {
+	from, to         int
+	origFrom, origTo int
+	verbatim         bool
+}
+
+func newDecodedText(size int) *decodedText {
+	text := &decodedText{}
+	text.builder.Grow(size)
+	return text
+}
+
+func (t *decodedText) appendText(content string, from, to int) {
+	segment := content[from:to]
+	last := 0
+	for _, ref := range htmlEntityRegex.FindAllStringIndex(segment, -1) {
+		t.appendSpan(segment[last:ref[0]], from+last, from+ref[0], true)
+		t.appendSpan(html.UnescapeString(segment[ref[0]:ref[1]]), from+ref[0], from+ref[1], false)
+		last = ref[1]
+	}
+	t.appendSpan(segment[last:], from+last, to, true)
+}
+
+func (t *decodedText) appendSeparator(origFrom, origTo int) {
+	t.appendSpan("\x00", origFrom, origTo, false)
+}
+
+func (t *decodedText) appendSpan(decoded string, origFrom, origTo int, verbatim bool) {
+	if decoded == "" {
+		return
+	}
+	from := t.builder.Len()
+	t.builder.WriteString(decoded)
+	t.spans = append(t.spans, decodedSpan{
+		from:     from,
+		to:       t.builder.Len(),
+		origFrom: origFrom,
+		origTo:   origTo,
+		verbatim: verbatim,
+	})
+}
+
+// originalPosition maps a range of decoded bytes back to the original
+// content, widening it to whole references at either end.
+func (t *decodedText) originalPosition(pos EmojiPosition) EmojiPosition {
+	return EmojiPosition{From: t.originalOffset(pos.From, false), To: t.originalOffset(pos.To-1, true)}
+}
+
+func (t *decodedText) originalOffset(offset int, end bool) int {
+	i := sort.Search(len(t.spans), func(i int) bool {
+		return t.spans[i].to > offset
+	})
+	span := t.spans[i]
+	switch {
+	case span.verbatim && end:
+		return span.origFrom + offset - span.from + 1
+	case span.verbatim:
+		return span.origFrom + offset - span.from
+	case end:
+		return span.origTo
+	default:
+		return span.origFrom
+	}
+}
@@ -0,0 +1,92 @@
+package emojiparser_test
+
+import (
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestParseHTMLEntities(t *testing.T) {
+	content := `<p>hex &#x1F604; dec &#128516; zwj &#x1F468;&#x200D;&#x1F4BB; ` +
+		`literal 🎉 &amp; &#65; &lt;:pepe:12345678901234567&gt;</p>`
+	results := emojiparser.ParseHTML(content)
+
+	want := []struct {
+		raw  string
+		name string
+	}{
+		{"&#x1F604;", "smile"},
+		{"&#128516;", "smile"},
+		{"&#x1F468;&#x200D;&#x1F4BB;", "man_technologist"},
+		{"🎉", "tada"},
+		{"&lt;:pepe:12345678901234567&gt;", "pepe"},
+	}
+	if len(results) != len(want) {
+		t.Fatalf("expected %d emojis, got %d: %+v", len(want), len(results), results)
+	}
+	for i, w := range want {
+		got := content[results[i].Position.From:results[i].Position.To]
+		if got != w.raw || results[i].Name != w.name {
+			t.Fatalf("result %d: expected %s at %q, got %s at %q", i, w.name, w.raw, results[i].Name, got)
+		}
+	}
+}
+
+func TestParseHTMLImages(t *testing.T) {
+	twemoji := `<img class="emoji" alt="x" src="https://cdn.jsdelivr.net/gh/twitter/twemoji@14.0.2/assets/svg/1f604.svg">`
+	twemojiNoFE0F := `<img src="https://twemoji.maxcdn.com/v/latest/72x72/2764.png">`
+	custom := `<img alt=":wave:" src="https://cdn.discordapp.com/emojis/12345678901234567.gif?size=48">`
+	altOnly := `<IMG ALT="🎉" SRC="/static/party.png"/>`
+	other := `<img alt="logo" src="https://example.com/logo.png"> <img src="/img/1234.png">`
+
+	smile := emojiparser.ParseUnicode("😄", nil)[0]
+	if smile.Link == nil {
+		t.Fatalf("expected smile to have a discord asset link")
+	}
+	asset := `<img src="` + *smile.Link + `">`
+
+	content := twemoji + " " + twemojiNoFE0F + custom + asset + altOnly + other
+	results := emojiparser.ParseHTML(content)
+
+	want := []struct {
+		tag  string
+		name string
+		kind emojiparser.EmojiType
+	}{
+		{twemoji, "smile", emojiparser.EmojiTypeUnicode},
+		{twemojiNoFE0F, "heart", emojiparser.EmojiTypeUnicode},
+		{custom, "wave", emojiparser.EmojiTypeCustom},
+		{asset, "smile", emojiparser.EmojiTypeUnicode},
+		{altOnly, "tada", emojiparser.EmojiTypeUnicode},
+	}
+	if len(results) != len(want) {
+		t.Fatalf("expected %d emojis, got %d: %+v", len(want), len(results), results)
+	}
+	for i, w := range want {
+		got := content[results[i].Position.From:results[i].Position.To]
+		if got != w.tag || results[i].Name != w.name || results[i].Type != w.kind {
+			t.Fatalf("result %d: expected %s %s at %q, got %s %s at %q", i, w.kind, w.name, w.tag, results[i].Type, results[i].Name, got)
+		}
+	}
+	if id := results[2].ID; id == nil || *id != "12345678901234567" || !results[2].Animated {
+		t.Fatalf("expected animated custom emoji with id, got %+v", results[2])
+	}
+}
+
+func TestParseHTMLCustomWithoutAlt(t *testing.T) {
+	parser := newTestParser(t)
+	if err := parser.RegisterCustomEmoji("pepe", "22345678901234567"); err != nil {
+		t.Fatalf("RegisterCustomEmoji: %v", err)
+	}
+	named := `<img src="https://cdn.discordapp.com/emojis/12345678901234567.webp?size=48&name=wave">`
+	registered := `<img src="https://cdn.discordapp.com/emojis/22345678901234567.png">`
+	unnamed := `<img src="https://cdn.discordapp.com/emojis/32345678901234567.png">`
+
+	results := parser.ParseHTML(named + registered + unnamed)
+	if len(results) != 2 || results[0].Name != "wave" || results[1].Name != "pepe" {
+		t.Fatalf("ParseHTML = %+v, want wave and pepe and no unnamed image", results)
+	}
+	if results[1].Position.To != len(named)+len(registered) {
+		t.Fatalf("pepe spans %v, want its tag", results[1].Position)
+	}
+}
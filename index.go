@@ -0,0 +1,120 @@
+package emojiparser
+
+import "strings"
+
+// IndexEmoji returns the offset of the first emoji in content using the
+// default parser.
+func IndexEmoji(content string) int {
+	return defaultParser.IndexEmoji(content)
+}
+
+// LastIndexEmoji returns the offset of the last emoji in content using the
+// default parser.
+func LastIndexEmoji(content string) int {
+	return defaultParser.LastIndexEmoji(content)
+}
+
+// IndexEmojiOf returns the offset of the first occurrence of emoji in content
+// using the default parser.
+func IndexEmojiOf(content, emoji string) int {
+	return defaultParser.IndexEmojiOf(content, emoji)
+}
+
+// LastIndexEmojiOf returns the offset of the last occurrence of emoji in
+// content using the default parser.
+func LastIndexEmojiOf(content, emoji string) int {
+	return defaultParser.LastIndexEmojiOf(content, emoji)
+}
+
+// IndexEmoji returns the byte offset of the first emoji in content, the
+// Position.From of Parse's first result, or -1 if there is none. Scanning
+// stops at that emoji.
+func (p *DiscordEmojiParser) IndexEmoji(content string) int {
+	return p.index(content, false, func(token) bool { return true })
+}
+
+// LastIndexEmoji returns the byte offset of the last emoji in content, the
+// Position.From of Parse's last result, or -1 if there is none. Content is
+// scanned backwards a whitespace-separated run at a time, since no match
+// crosses ASCII whitespace; with markup skipping options, or keys containing
+// whitespace, all of content is scanned instead.
+func (p *DiscordEmojiParser) LastIndexEmoji(content string) int {
+	return p.index(content, true, func(token) bool { return true })
+}
+
+// IndexEmojiOf returns the byte offset of the first occurrence of emoji in
+// content, or -1 if there is none. emoji is a unicode sequence or a custom
+// emoji ID, and occurrences are the results of Parse with that EmojiKey, so
+// IndexEmojiOf(content, "😄") also finds :smile:.
+func (p *DiscordEmojiParser) IndexEmojiOf(content, emoji string) int {
+	key := keyFor(emoji)
+	return p.index(content, false, func(t token) bool { return keyOf(t) == key })
+}
+
+// LastIndexEmojiOf is IndexEmojiOf for the last occurrence, scanned as in
+// LastIndexEmoji.
+func (p *DiscordEmojiParser) LastIndexEmojiOf(content, emoji string) int {
+	key := keyFor(emoji)
+	return p.index(content, true, func(t token) bool { return keyOf(t) == key })
+}
+
+// keyFor returns the key of emoji given as a custom emoji ID or a unicode
+// sequence.
+func keyFor(emoji string) EmojiKey {
+	if isDigits(emoji) {
+		return EmojiKey{ID: emoji}
+	}
+	return EmojiKey{Unicode: emoji}
+}
+
+// isDigits reports whether s is a non-empty run of ASCII digits.
+func isDigits(s string) bool {
+	for i := range len(s) {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// index returns the start of the first, or with last the last, emoji in
+// content for which match returns true, or -1.
+func (p *DiscordEmojiParser) index(content string, last bool, match func(token) bool) int {
+	if !p.beginParse(content) {
+		return -1
+	}
+	if !last || p.opts.markupKinds() != 0 || p.state.Load().keyHasSpace {
+		return p.indexIn(content, last, match)
+	}
+	for end := len(content); ; {
+		start := strings.LastIndexAny(content[:end], asciiSpace) + 1
+		if i := p.indexIn(content[start:end], true, match); i >= 0 {
+			return start + i
+		}
+		if start == 0 {
+			return -1
+		}
+		end = start - 1
+	}
+}
+
+// indexIn scans all of content for index.
+func (p *DiscordEmojiParser) indexIn(content string, last bool, match func(token) bool) int {
+	state := p.state.Load()
+	scanned, m := p.rewriteContent(content)
+	index := -1
+	var counts tokenCounts
+	p.scan(state, scanned, scanAll, nil, func(t token) bool {
+		counts.add(t.kind)
+		if !match(t) {
+			return true
+		}
+		index = t.from
+		if m != nil {
+			index = m.position(EmojiPosition{From: t.from, To: t.to}).From
+		}
+		return last
+	})
+	p.countTokens(counts)
+	return index
+}
@@ -0,0 +1,69 @@
+package emojiparser_test
+
+import (
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestIndexEmoji(t *testing.T) {
+	for _, content := range append(countInputs, "a :sob::👌 b", "x <:pepe:12345678901234567>😄 y z  ") {
+		results := emojiparser.Parse(content)
+		first, last := -1, -1
+		if len(results) > 0 {
+			first, last = results[0].Position.From, results[len(results)-1].Position.From
+		}
+		if got := emojiparser.IndexEmoji(content); got != first {
+			t.Fatalf("IndexEmoji(%q) = %d, want %d", content, got, first)
+		}
+		if got := emojiparser.LastIndexEmoji(content); got != last {
+			t.Fatalf("LastIndexEmoji(%q) = %d, want %d", content, got, last)
+		}
+	}
+}
+
+func TestIndexEmojiOf(t *testing.T) {
+	content := "😄 <:pepe:12345678901234567> :smile: 👍🏽 👍 <:pepe:12345678901234567> end"
+	cases := []struct {
+		emoji       string
+		first, last int
+	}{
+		{"😄", 0, 31},
+		{"12345678901234567", 5, 53},
+		{"👍", 48, 48},
+		{"👍🏽", 39, 39},
+		{"🔥", -1, -1},
+	}
+	for _, c := range cases {
+		if got := emojiparser.IndexEmojiOf(content, c.emoji); got != c.first {
+			t.Fatalf("IndexEmojiOf(%q) = %d, want %d", c.emoji, got, c.first)
+		}
+		if got := emojiparser.LastIndexEmojiOf(content, c.emoji); got != c.last {
+			t.Fatalf("LastIndexEmojiOf(%q) = %d, want %d", c.emoji, got, c.last)
+		}
+	}
+}
+
+func TestLastIndexEmojiSkipMarkup(t *testing.T) {
+	parser := newTestParser(t, emojiparser.WithSkipCodeBlocks(true))
+	content := "😄 ```\ncode 🔥\n```"
+	if got := parser.LastIndexEmoji(content); got != 0 {
+		t.Fatalf("LastIndexEmoji(%q) = %d, want 0", content, got)
+	}
+}
+
+func FuzzLastIndexEmoji(f *testing.F) {
+	for _, content := range countInputs {
+		f.Add(content)
+	}
+	f.Fuzz(func(t *testing.T, content string) {
+		results := emojiparser.Parse(content)
+		want := -1
+		if len(results) > 0 {
+			want = results[len(results)-1].Position.From
+		}
+		if got := emojiparser.LastIndexEmoji(content); got != want {
+			t.Fatalf("LastIndexEmoji(%q) = %d, want %d", content, got, want)
+		}
+	})
+}
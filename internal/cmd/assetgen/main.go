@@ -0,0 +1,121 @@
+// Command assetgen regenerates derived files for the embedded emoji assets.
+//
+// It records the entry count of each table in meta.json so the parser can
+// size its maps before decoding:
+//
+//	go run ./internal/cmd/assetgen -dir assets
+//
+// With -go it also writes the tables as Go composite literals, which the
+// package compiles in place of the embedded JSON under the emojigen build tag:
+//
+//	go run ./internal/cmd/assetgen -dir assets -go assets_tables_gen.go
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+)
+
+// tables lists the asset files whose entry counts are recorded, keyed by the
+// field name used in meta.json.
+var tables = []string{"UnicodeEmojis", "UnicodeEmojisSVG"}
+
+func main() {
+	dir := flag.String("dir", "assets", "directory containing the emoji asset files")
+	goFile := flag.String("go", "", "also write the tables as Go source to this file")
+	flag.Parse()
+
+	if err := run(*dir, *goFile); err != nil {
+		fmt.Fprintln(os.Stderr, "assetgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(dir, goFile string) error {
+	if err := writeMeta(dir); err != nil {
+		return err
+	}
+	if goFile == "" {
+		return nil
+	}
+
+	content, err := generateGo(dir)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(goFile, content, 0o644)
+}
+
+func writeMeta(dir string) error {
+	meta := make(map[string]int, len(tables))
+	for _, name := range tables {
+		table, err := readTable(filepath.Join(dir, name+".json"))
+		if err != nil {
+			return err
+		}
+		meta[name] = len(table)
+	}
+
+	content, err := json.MarshalIndent(meta, "", "    ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, "meta.json"), append(content, '\n'), 0o644)
+}
+
+func readTable(path string) (map[string]string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var table map[string]string
+	if err := json.Unmarshal(content, &table); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return table, nil
+}
+
+// generateGo renders the tables as a gofmt-ed Go source file. Each table is
+// a flat array of alternating keys and values, one entry per line. Keys are
+// written in sorted order so the output only changes when the tables do.
+func generateGo(dir string) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by assetgen; DO NOT EDIT.\n\n")
+	buf.WriteString("//go:build emojigen\n\n")
+	buf.WriteString("package emojiparser\n\n")
+	buf.WriteString("// generatedAssets returns the emoji tables compiled into the binary.\n")
+	buf.WriteString("func generatedAssets() *Assets {\n\treturn &Assets{\n")
+	for _, name := range tables {
+		fmt.Fprintf(&buf, "%s: unpackTable(packed%s[:]),\n", name, name)
+	}
+	buf.WriteString("}\n}\n")
+
+	for _, name := range tables {
+		table, err := readTable(filepath.Join(dir, name+".json"))
+		if err != nil {
+			return nil, err
+		}
+
+		keys := make([]string, 0, len(table))
+		for key := range table {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+
+		fmt.Fprintf(&buf, "\nvar packed%s = [...]string{\n", name)
+		for _, key := range keys {
+			fmt.Fprintf(&buf, "%s, %s,\n", strconv.Quote(key), strconv.Quote(table[key]))
+		}
+		buf.WriteString("}\n")
+	}
+
+	return format.Source(buf.Bytes())
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+const assetsDir = "../../../assets"
+
+func TestGenerateGoDeterministic(t *testing.T) {
+	first, err := generateGo(assetsDir)
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	for range 3 {
+		next, err := generateGo(assetsDir)
+		if err != nil {
+			t.Fatalf("generate: %v", err)
+		}
+		if !bytes.Equal(first, next) {
+			t.Fatal("generateGo output differs between runs")
+		}
+	}
+}
+
+func TestGeneratedFileUpToDate(t *testing.T) {
+	want, err := generateGo(assetsDir)
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	got, err := os.ReadFile("../../../assets_tables_gen.go")
+	if err != nil {
+		t.Fatalf("read generated file: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatal("assets_tables_gen.go is stale; run go generate")
+	}
+}
@@ -0,0 +1,174 @@
+// Command compositiongen generates the table of canonical compositions, the
+// pairs of characters NFC composes into one, from Unicode's UnicodeData.txt
+// and CompositionExclusions.txt:
+//
+//	curl -O https://www.unicode.org/Public/15.0.0/ucd/UnicodeData.txt
+//	curl -O https://www.unicode.org/Public/15.0.0/ucd/CompositionExclusions.txt
+//	go run ./internal/cmd/compositiongen -out composition_table.go
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// decomposition is a canonical decomposition of a character into two.
+type decomposition struct {
+	composite, first, second rune
+}
+
+func main() {
+	data := flag.String("data", "UnicodeData.txt", "path of Unicode's UnicodeData.txt")
+	exclusions := flag.String("exclusions", "CompositionExclusions.txt", "path of Unicode's CompositionExclusions.txt")
+	out := flag.String("out", "composition_table.go", "file to write")
+	version := flag.String("version", "15.0", "Unicode version of the data, recorded in the output")
+	flag.Parse()
+
+	if err := run(*data, *exclusions, *out, *version); err != nil {
+		fmt.Fprintln(os.Stderr, "compositiongen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(data, exclusions, out, version string) error {
+	f, err := os.Open(exclusions)
+	if err != nil {
+		return err
+	}
+	excluded, err := readExclusions(f)
+	f.Close()
+	if err != nil {
+		return fmt.Errorf("read %s: %w", exclusions, err)
+	}
+
+	f, err = os.Open(data)
+	if err != nil {
+		return err
+	}
+	pairs, err := readCompositions(f, excluded)
+	f.Close()
+	if err != nil {
+		return fmt.Errorf("read %s: %w", data, err)
+	}
+	if len(pairs) == 0 {
+		return fmt.Errorf("read %s: no canonical compositions", data)
+	}
+
+	content, err := generate(pairs, version)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(out, content, 0o644)
+}
+
+// readExclusions returns the characters listed in CompositionExclusions.txt,
+// one code point or range per line.
+func readExclusions(r io.Reader) (map[rune]bool, error) {
+	excluded := make(map[rune]bool)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		loText, hiText, isRange := strings.Cut(line, "..")
+		if !isRange {
+			hiText = loText
+		}
+		lo, err := strconv.ParseUint(loText, 16, 32)
+		if err != nil {
+			return nil, err
+		}
+		hi, err := strconv.ParseUint(hiText, 16, 32)
+		if err != nil {
+			return nil, err
+		}
+		for c := lo; c <= hi; c++ {
+			excluded[rune(c)] = true
+		}
+	}
+	return excluded, scanner.Err()
+}
+
+// readCompositions returns the primary composites of UnicodeData.txt: the
+// characters with a canonical decomposition into a starter and one other
+// character that are not excluded from composition. Lines have the form
+// "00E9;LATIN SMALL LETTER E WITH ACUTE;Ll;0;L;0065 0301;;;;N;...", with the
+// canonical combining class in the fourth field and the decomposition in the
+// sixth.
+func readCompositions(r io.Reader, excluded map[rune]bool) ([]decomposition, error) {
+	classes := make(map[rune]int)
+	var candidates []decomposition
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), ";")
+		if len(fields) < 6 {
+			continue
+		}
+		c, err := strconv.ParseUint(fields[0], 16, 32)
+		if err != nil {
+			return nil, err
+		}
+		if class, err := strconv.Atoi(fields[3]); err == nil && class != 0 {
+			classes[rune(c)] = class
+		}
+
+		// Compatibility decompositions start with a <tag>, and singletons
+		// never compose.
+		parts := strings.Fields(fields[5])
+		if len(parts) != 2 || strings.HasPrefix(parts[0], "<") {
+			continue
+		}
+		first, err := strconv.ParseUint(parts[0], 16, 32)
+		if err != nil {
+			return nil, err
+		}
+		second, err := strconv.ParseUint(parts[1], 16, 32)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, decomposition{rune(c), rune(first), rune(second)})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	var pairs []decomposition
+	for _, d := range candidates {
+		// Non-starter decompositions are excluded like the listed ones.
+		if excluded[d.composite] || classes[d.composite] != 0 || classes[d.first] != 0 {
+			continue
+		}
+		pairs = append(pairs, d)
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].first != pairs[j].first {
+			return pairs[i].first < pairs[j].first
+		}
+		return pairs[i].second < pairs[j].second
+	})
+	return pairs, nil
+}
+
+func generate(pairs []decomposition, version string) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by compositiongen; DO NOT EDIT.\n\n")
+	buf.WriteString("package emojiparser\n\n")
+	fmt.Fprintf(&buf, "// canonicalCompositions maps the pairs of characters NFC composes in\n")
+	fmt.Fprintf(&buf, "// Unicode %s to their composite, Hangul syllables aside.\n", version)
+	buf.WriteString("var canonicalCompositions = map[[2]rune]rune{\n")
+	for _, d := range pairs {
+		fmt.Fprintf(&buf, "{%#04x, %#04x}: %#04x,\n", d.first, d.second, d.composite)
+	}
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
@@ -0,0 +1,152 @@
+// Command presentationgen generates the tables of code points with the
+// Emoji_Presentation and Emoji_Modifier_Base properties from Unicode's
+// emoji-data.txt:
+//
+//	curl -O https://www.unicode.org/Public/15.0.0/ucd/emoji/emoji-data.txt
+//	go run ./internal/cmd/presentationgen -data emoji-data.txt -out presentation_table.go
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// rangeEntry is an inclusive code point range.
+type rangeEntry struct {
+	lo, hi uint32
+}
+
+func main() {
+	data := flag.String("data", "emoji-data.txt", "path of Unicode's emoji-data.txt")
+	out := flag.String("out", "presentation_table.go", "file to write")
+	version := flag.String("version", "15.0", "Unicode version of the data, recorded in the output")
+	flag.Parse()
+
+	if err := run(*data, *out, *version); err != nil {
+		fmt.Fprintln(os.Stderr, "presentationgen:", err)
+		os.Exit(1)
+	}
+}
+
+// table is a generated range table.
+type table struct {
+	name     string
+	property string
+	doc      string // %s is replaced by the Unicode version
+}
+
+var tables = []table{
+	{
+		name:     "emojiPresentation",
+		property: "Emoji_Presentation",
+		doc:      "emojiPresentation holds the code points with the Emoji_Presentation\n// property in Unicode %s, which are shown as emojis without U+FE0F.",
+	},
+	{
+		name:     "emojiModifierBase",
+		property: "Emoji_Modifier_Base",
+		doc:      "emojiModifierBase holds the code points with the Emoji_Modifier_Base\n// property in Unicode %s, which take a skin tone modifier.",
+	},
+}
+
+func run(data, out, version string) error {
+	raw, err := os.ReadFile(data)
+	if err != nil {
+		return err
+	}
+
+	ranges := make([][]rangeEntry, len(tables))
+	for i, t := range tables {
+		if ranges[i], err = readRanges(bytes.NewReader(raw), t.property); err != nil {
+			return fmt.Errorf("read %s: %w", data, err)
+		}
+		if len(ranges[i]) == 0 {
+			return fmt.Errorf("read %s: no %s entries", data, t.property)
+		}
+	}
+	content, err := generate(ranges, version)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(out, content, 0o644)
+}
+
+// readRanges returns the ranges listed for property, merging adjacent ones.
+// Lines have the form "231A..231B ; Emoji_Presentation # comment".
+func readRanges(r io.Reader, property string) ([]rangeEntry, error) {
+	var ranges []rangeEntry
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		codePoints, prop, ok := strings.Cut(line, ";")
+		if !ok || strings.TrimSpace(prop) != property {
+			continue
+		}
+
+		loText, hiText, isRange := strings.Cut(strings.TrimSpace(codePoints), "..")
+		if !isRange {
+			hiText = loText
+		}
+		lo, err := strconv.ParseUint(loText, 16, 32)
+		if err != nil {
+			return nil, err
+		}
+		hi, err := strconv.ParseUint(hiText, 16, 32)
+		if err != nil {
+			return nil, err
+		}
+
+		if n := len(ranges); n > 0 && ranges[n-1].hi+1 == uint32(lo) {
+			ranges[n-1].hi = uint32(hi)
+			continue
+		}
+		ranges = append(ranges, rangeEntry{uint32(lo), uint32(hi)})
+	}
+	return ranges, scanner.Err()
+}
+
+func generate(ranges [][]rangeEntry, version string) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by presentationgen; DO NOT EDIT.\n\n")
+	buf.WriteString("package emojiparser\n\n")
+	buf.WriteString("import \"unicode\"\n")
+	for i, t := range tables {
+		buf.WriteString("\n// ")
+		fmt.Fprintf(&buf, t.doc, version)
+		fmt.Fprintf(&buf, "\nvar %s = &unicode.RangeTable{\n", t.name)
+		writeRanges(&buf, ranges[i])
+		buf.WriteString("}\n")
+	}
+	return format.Source(buf.Bytes())
+}
+
+func writeRanges(buf *bytes.Buffer, ranges []rangeEntry) {
+	var r16, r32 []rangeEntry
+	for _, r := range ranges {
+		if r.hi <= 0xFFFF {
+			r16 = append(r16, r)
+		} else {
+			r32 = append(r32, r)
+		}
+	}
+	if len(r16) > 0 {
+		buf.WriteString("R16: []unicode.Range16{\n")
+		for _, r := range r16 {
+			fmt.Fprintf(buf, "{Lo: %#04x, Hi: %#04x, Stride: 1},\n", r.lo, r.hi)
+		}
+		buf.WriteString("},\n")
+	}
+	if len(r32) > 0 {
+		buf.WriteString("R32: []unicode.Range32{\n")
+		for _, r := range r32 {
+			fmt.Fprintf(buf, "{Lo: %#04x, Hi: %#04x, Stride: 1},\n", r.lo, r.hi)
+		}
+		buf.WriteString("},\n")
+	}
+}
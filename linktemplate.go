@@ -0,0 +1,116 @@
+package emojiparser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Placeholders accepted by link templates. {animated} expands to "true" or
+// "false", and {animated?A:B} expands to A for animated emojis and B
+// otherwise. {ext} is "svg" for unicode emojis and "gif" or "png" for custom
+// emojis.
+var (
+	unicodeLinkFields = map[string]bool{"codepoints": true, "name": true, "ext": true}
+	customLinkFields  = map[string]bool{"id": true, "name": true, "ext": true, "animated": true}
+)
+
+// linkTemplate is a parsed link template.
+type linkTemplate struct {
+	parts []templatePart
+}
+
+// templatePart is either literal text or a placeholder. A conditional
+// placeholder expands to ifTrue or ifFalse.
+type templatePart struct {
+	literal     string
+	field       string
+	conditional bool
+	ifTrue      string
+	ifFalse     string
+}
+
+// linkValues are the values placeholders expand to.
+type linkValues struct {
+	codePoints string
+	id         string
+	name       string
+	ext        string
+	animated   bool
+}
+
+// parseLinkTemplate parses tmpl, accepting only the placeholders in fields.
+func parseLinkTemplate(tmpl string, fields map[string]bool) (*linkTemplate, error) {
+	t := &linkTemplate{}
+	rest := tmpl
+	for rest != "" {
+		open := strings.IndexAny(rest, "{}")
+		if open < 0 {
+			t.parts = append(t.parts, templatePart{literal: rest})
+			break
+		}
+		if rest[open] == '}' {
+			return nil, fmt.Errorf("unexpected '}' at offset %d", len(tmpl)-len(rest)+open)
+		}
+		if open > 0 {
+			t.parts = append(t.parts, templatePart{literal: rest[:open]})
+		}
+		end := strings.IndexByte(rest[open:], '}')
+		if end < 0 {
+			return nil, fmt.Errorf("unclosed '{' at offset %d", len(tmpl)-len(rest)+open)
+		}
+		part, err := parsePlaceholder(rest[open+1:open+end], fields)
+		if err != nil {
+			return nil, err
+		}
+		t.parts = append(t.parts, part)
+		rest = rest[open+end+1:]
+	}
+	return t, nil
+}
+
+func parsePlaceholder(body string, fields map[string]bool) (templatePart, error) {
+	field, branches, conditional := strings.Cut(body, "?")
+	if !fields[field] {
+		return templatePart{}, fmt.Errorf("unknown placeholder {%s}", body)
+	}
+	if !conditional {
+		return templatePart{field: field}, nil
+	}
+	if field != "animated" {
+		return templatePart{}, fmt.Errorf("placeholder {%s}: only {animated} can be conditional", body)
+	}
+	ifTrue, ifFalse, ok := strings.Cut(branches, ":")
+	if !ok {
+		return templatePart{}, fmt.Errorf("placeholder {%s}: want {animated?A:B}", body)
+	}
+	return templatePart{field: field, conditional: true, ifTrue: ifTrue, ifFalse: ifFalse}, nil
+}
+
+func (t *linkTemplate) expand(values linkValues) string {
+	var builder strings.Builder
+	for _, part := range t.parts {
+		switch {
+		case part.field == "":
+			builder.WriteString(part.literal)
+		case part.conditional && values.animated:
+			builder.WriteString(part.ifTrue)
+		case part.conditional:
+			builder.WriteString(part.ifFalse)
+		case part.field == "codepoints":
+			builder.WriteString(values.codePoints)
+		case part.field == "id":
+			builder.WriteString(values.id)
+		case part.field == "name":
+			builder.WriteString(values.name)
+		case part.field == "ext":
+			builder.WriteString(values.ext)
+		case part.field == "animated":
+			if values.animated {
+				builder.WriteString("true")
+			} else {
+				builder.WriteString("false")
+			}
+		}
+	}
+	return builder.String()
+}
@@ -0,0 +1,54 @@
+package emojiparser_test
+
+import (
+	"strings"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestLinkTemplates(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser(
+		emojiparser.WithUnicodeLinkTemplate("https://img.example.com/e/{codepoints}.webp?v=2&n={name}"),
+		emojiparser.WithCustomLinkTemplate("https://img.example.com/c/{id}{animated?.gif:.png}?name={name}&a={animated}&ext={ext}"),
+	)
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+
+	results := parser.Parse("😄 :man_technologist: <a:wave:12345678901234567> <:pepe:67890123456789012>")
+	if len(results) != 4 {
+		t.Fatalf("expected 4 emojis, got %d", len(results))
+	}
+	want := []string{
+		"https://img.example.com/e/1f604.webp?v=2&n=smile",
+		"https://img.example.com/e/1f468-200d-1f4bb.webp?v=2&n=man_technologist",
+		"https://img.example.com/c/12345678901234567.gif?name=wave&a=true&ext=gif",
+		"https://img.example.com/c/67890123456789012.png?name=pepe&a=false&ext=png",
+	}
+	for i, link := range want {
+		if results[i].Link == nil || *results[i].Link != link {
+			t.Fatalf("result %d: expected link %q, got %v", i, link, results[i].Link)
+		}
+	}
+}
+
+func TestLinkTemplateErrors(t *testing.T) {
+	cases := []struct {
+		opt  emojiparser.Option
+		want string
+	}{
+		{emojiparser.WithUnicodeLinkTemplate("https://x/{id}.png"), "{id}"},
+		{emojiparser.WithUnicodeLinkTemplate("https://x/{codepoints.png"), "unclosed"},
+		{emojiparser.WithCustomLinkTemplate("https://x/{codepoints}"), "{codepoints}"},
+		{emojiparser.WithCustomLinkTemplate("https://x/{name?a:b}"), "conditional"},
+		{emojiparser.WithCustomLinkTemplate("https://x/{animated?gif}"), "{animated?A:B}"},
+		{emojiparser.WithCustomLinkTemplate("https://x/}"), "unexpected"},
+	}
+	for _, tc := range cases {
+		_, err := emojiparser.NewDiscordEmojiParser(tc.opt)
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Fatalf("expected error mentioning %q, got %v", tc.want, err)
+		}
+	}
+}
@@ -1,19 +1,13 @@
 package emojiparser
 
 import (
-	"embed"
-	"encoding/json"
 	"fmt"
-	"io"
-	"regexp"
-	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"unicode/utf8"
 )
 
-//go:embed assets/*.json
-var assetsFS embed.FS
-
 // Assets holds the parsed emoji lookup tables.
 type Assets struct {
 	UnicodeEmojis    map[string]string
@@ -44,16 +38,34 @@ type ParsedEmoji struct {
 	Position EmojiPosition
 	Link     *string
 	Animated bool
+
+	// Presentation reports the variation selector of unicode emojis. It is
+	// PresentationDefault for text and custom emojis.
+	Presentation Presentation
+
+	// Tone is the skin tone modifier of a unicode emoji, or of the emoji a
+	// shortcode stands for, the first one for sequences of several people,
+	// or ToneNone.
+	Tone SkinTone
+
+	// Qualification reports whether a unicode emoji, or the emoji a shortcode
+	// stands for, has the U+FE0F its characters need. It is
+	// QualificationNone for custom emojis.
+	Qualification Qualification
 }
 
 // DiscordEmojiParser parses unicode, text, and custom emojis from a string.
+// It is safe for concurrent use, including concurrently with ReloadAssets and
+// MergeAssets.
 type DiscordEmojiParser struct {
-	assets        *Assets
-	nameToUnicode map[string]string
-	unicodeToName map[string]string
-	unicodeKeys   []string
-	customRegex   *regexp.Regexp
-	textRegex     *regexp.Regexp
+	state atomic.Pointer[parserState]
+	mu    sync.Mutex // serializes state updates
+	opts  Options
+
+	metrics parserMetrics
+
+	unicodeLink *linkTemplate // nil means the Discord asset link
+	customLink  *linkTemplate // nil means the Discord CDN link
 }
 
 var defaultParser *DiscordEmojiParser
@@ -86,203 +98,177 @@ func ParseDiscordCustom(content string) []ParsedEmoji {
 	return defaultParser.ParseDiscordCustom(content)
 }
 
-// parseAssets loads and parses all JSON files under assets/.
-// It returns the parsed emoji maps or an error.
-func parseAssets() (*Assets, error) {
-	unicodeEmojis, err := parseJSONMap("assets/UnicodeEmojis.json")
+// NewDiscordEmojiParser creates a new parser instance with embedded assets.
+func NewDiscordEmojiParser(opts ...Option) (*DiscordEmojiParser, error) {
+	assets, err := defaultAssets()
 	if err != nil {
-		return nil, fmt.Errorf("parse assets/UnicodeEmojis.json: %w", err)
+		return nil, err
 	}
 
-	unicodeEmojisSVG, err := parseJSONMap("assets/UnicodeEmojisSVG.json")
+	state, err := newParserState(assets)
 	if err != nil {
-		return nil, fmt.Errorf("parse assets/UnicodeEmojisSVG.json: %w", err)
+		return nil, err
 	}
+	return newParser(state, opts)
+}
 
-	return &Assets{
-		UnicodeEmojis:    unicodeEmojis,
-		UnicodeEmojisSVG: unicodeEmojisSVG,
-	}, nil
+// newParser creates a parser with the given state and options.
+func newParser(state *parserState, opts []Option) (*DiscordEmojiParser, error) {
+	parser := &DiscordEmojiParser{}
+	if err := parser.configure(Options{}, opts); err != nil {
+		return nil, err
+	}
+	parser.state.Store(state)
+	return parser, nil
 }
 
-// NewDiscordEmojiParser creates a new parser instance with embedded assets.
-func NewDiscordEmojiParser() (*DiscordEmojiParser, error) {
-	assets, err := parseAssets()
-	if err != nil {
+// Clone returns a parser that starts with p's current tables and
+// registrations and with p's options overridden by opts. The tables are
+// shared rather than copied, which makes Clone much cheaper than
+// NewDiscordEmojiParser; later reloads, merges, and registrations on either
+// parser do not affect the other. Metrics start from zero.
+func (p *DiscordEmojiParser) Clone(opts ...Option) (*DiscordEmojiParser, error) {
+	clone := &DiscordEmojiParser{}
+	if err := clone.configure(p.opts, opts); err != nil {
 		return nil, err
 	}
+	clone.state.Store(p.state.Load())
+	return clone, nil
+}
 
-	nameToUnicode := make(map[string]string)
-	unicodeToName := make(map[string]string)
-	for key, value := range assets.UnicodeEmojis {
-		if containsNonASCII(key) {
-			unicodeToName[key] = value
-		}
-		if containsNonASCII(value) {
-			nameToUnicode[key] = value
-		}
+// configure applies opts on top of base and compiles the link templates.
+func (p *DiscordEmojiParser) configure(base Options, opts []Option) error {
+	options := base
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	minDigits, maxDigits := options.snowflakeDigits()
+	if minDigits < 1 || minDigits > maxDigits || maxDigits > maxSnowflakeDigits {
+		return fmt.Errorf("snowflake digits: want 1 <= min <= max <= %d, got %d and %d", maxSnowflakeDigits, minDigits, maxDigits)
+	}
+	if options.MaxInputBytes < 0 || options.MaxResults < 0 {
+		return fmt.Errorf("parse limits: want non-negative limits, got %d bytes and %d results", options.MaxInputBytes, options.MaxResults)
 	}
 
-	unicodeKeys := make([]string, 0, len(unicodeToName))
-	for key := range unicodeToName {
-		unicodeKeys = append(unicodeKeys, key)
+	var err error
+	if options.UnicodeLinkTemplate != "" {
+		if p.unicodeLink, err = parseLinkTemplate(options.UnicodeLinkTemplate, unicodeLinkFields); err != nil {
+			return fmt.Errorf("unicode link template: %w", err)
+		}
+	}
+	if options.CustomLinkTemplate != "" {
+		if p.customLink, err = parseLinkTemplate(options.CustomLinkTemplate, customLinkFields); err != nil {
+			return fmt.Errorf("custom link template: %w", err)
+		}
 	}
-	sort.Slice(unicodeKeys, func(i, j int) bool {
-		return len(unicodeKeys[i]) > len(unicodeKeys[j])
-	})
-
-	return &DiscordEmojiParser{
-		assets:        assets,
-		nameToUnicode: nameToUnicode,
-		unicodeToName: unicodeToName,
-		unicodeKeys:   unicodeKeys,
-		customRegex:   regexp.MustCompile(`<(a?):(\w+):(\d{16,})>`),
-		textRegex:     regexp.MustCompile(`:([A-Za-z0-9_]+):`),
-	}, nil
+	p.opts = options
+	return nil
 }
 
-// Parse parses all emoji types from the provided content.
+// Parse parses all emoji types from the provided content. The results are
+// sorted by position, and their positions are pairwise disjoint: where
+// matches of different types could overlap, the one starting first wins, and
+// custom markup beats a shortcode beats a unicode emoji at the same offset.
 func (p *DiscordEmojiParser) Parse(content string) []ParsedEmoji {
-	customEmojis := p.ParseDiscordCustom(content)
-	unicodeEmojis := p.ParseUnicode(content, customEmojis)
-	textEmojis := p.ParseTextRepresentation(content, customEmojis)
-
-	all := append(append(unicodeEmojis, textEmojis...), customEmojis...)
-	sort.Slice(all, func(i, j int) bool {
-		return all[i].Position.From < all[j].Position.From
-	})
-	return all
+	return p.ParseWithOptions(content, ParseOptions{})
 }
 
-// ParseUnicode parses unicode emojis from the content.
-func (p *DiscordEmojiParser) ParseUnicode(content string, skipRanges []ParsedEmoji) []ParsedEmoji {
-	results := make([]ParsedEmoji, 0)
-	for i := 0; i < len(content); {
-		if p.isInsideRange(i, skipRanges) {
-			_, size := utf8.DecodeRuneInString(content[i:])
-			i += size
-			continue
-		}
-
-		match := ""
-		for _, key := range p.unicodeKeys {
-			if strings.HasPrefix(content[i:], key) {
-				match = key
-				break
-			}
-		}
-
-		if match == "" {
-			_, size := utf8.DecodeRuneInString(content[i:])
-			i += size
-			continue
-		}
+// parseAll finds the emojis of all types in content in a single pass and
+// returns them sorted by position.
+func (p *DiscordEmojiParser) parseAll(content string) []ParsedEmoji {
+	if !p.beginParse(content) {
+		return []ParsedEmoji{}
+	}
 
-		from := i
-		to := i + len(match)
-		if p.isInsideRange(from, skipRanges) {
-			i = to
-			continue
-		}
+	return p.appendKinds(make([]ParsedEmoji, 0), content, scanAll, nil)
+}
 
-		name := p.unicodeToName[match]
-		codePoint := toCodePoint(match, "-")
-		var link *string
-		if hash, ok := p.assets.UnicodeEmojisSVG[codePoint]; ok {
-			url := "https://discord.com/assets/" + hash
-			link = &url
+// beginParse counts a parse call and reports whether content may contain
+// emojis at all.
+func (p *DiscordEmojiParser) beginParse(content string) bool {
+	if p.opts.Metrics {
+		p.metrics.parseCalls.Add(1)
+	}
+	if !mayContainEmoji(content) {
+		if p.opts.Metrics {
+			p.metrics.fastPathSkips.Add(1)
 		}
-
-		results = append(results, ParsedEmoji{
-			ID:       nil,
-			Name:     name,
-			Type:     EmojiTypeUnicode,
-			Unicode:  match,
-			Position: EmojiPosition{From: from, To: to},
-			Link:     link,
-			Animated: false,
-		})
-		i = to
+		return false
 	}
+	return true
+}
 
-	return results
+// ParseUnicode parses unicode emojis from the content.
+func (p *DiscordEmojiParser) ParseUnicode(content string, skipRanges []ParsedEmoji) []ParsedEmoji {
+	return p.AppendParseUnicode(make([]ParsedEmoji, 0), content, skipRanges)
+}
+
+func (p *DiscordEmojiParser) parseUnicode(state *parserState, content string, skipRanges []ParsedEmoji) []ParsedEmoji {
+	return p.collect(state, content, scanUnicode, skipRanges)
 }
 
 // ParseTextRepresentation parses text emoji representations like :smile: from content.
+// Shortcodes may share a colon, so ":joy:sob:" yields both; the second one's
+// position starts after the shared colon, keeping positions disjoint.
 func (p *DiscordEmojiParser) ParseTextRepresentation(content string, skipRanges []ParsedEmoji) []ParsedEmoji {
-	results := make([]ParsedEmoji, 0)
-	matches := p.textRegex.FindAllStringSubmatchIndex(content, -1)
-	for _, match := range matches {
-		if len(match) < 4 {
-			continue
-		}
-		from := match[0]
-		to := match[1]
-		name := content[match[2]:match[3]]
+	return p.AppendParseTextRepresentation(make([]ParsedEmoji, 0), content, skipRanges)
+}
 
-		if p.isInsideRange(from, skipRanges) {
-			continue
-		}
-		unicode, ok := p.nameToUnicode[name]
-		if !ok {
-			continue
-		}
+func (p *DiscordEmojiParser) parseTextRepresentation(state *parserState, content string, skipRanges []ParsedEmoji) []ParsedEmoji {
+	return p.collect(state, content, scanText, skipRanges)
+}
 
-		codePoint := toCodePoint(unicode, "-")
-		var link *string
-		if hash, ok := p.assets.UnicodeEmojisSVG[codePoint]; ok {
-			url := "https://discord.com/assets/" + hash + ".svg"
-			link = &url
-		}
+// ParseDiscordCustom parses custom Discord emojis like <:name:id> or <a:name:id>.
+func (p *DiscordEmojiParser) ParseDiscordCustom(content string) []ParsedEmoji {
+	return p.AppendParseDiscordCustom(make([]ParsedEmoji, 0), content)
+}
 
-		results = append(results, ParsedEmoji{
-			ID:       nil,
-			Name:     name,
-			Type:     EmojiTypeText,
-			Unicode:  unicode,
-			Position: EmojiPosition{From: from, To: to},
-			Link:     link,
-			Animated: false,
-		})
+// svgLink returns the SVG asset link of a unicode emoji matched as the table
+// key, or nil if there is none. Unicode and text emojis both get theirs here.
+func (p *DiscordEmojiParser) svgLink(state *parserState, emoji, key, name string) *string {
+	if p.unicodeLink != nil {
+		url := p.unicodeLink.expand(linkValues{codePoints: toCodePoint(emoji, "-"), name: name, ext: "svg"})
+		return &url
+	}
+	if hash, ok := state.svgHash(emoji, key); ok {
+		url := svgAssetLink(hash)
+		return &url
 	}
+	return nil
+}
 
-	return results
+// svgAssetLink returns the Discord asset link of the SVG with hash.
+func svgAssetLink(hash string) string {
+	return "https://discord.com/assets/" + hash + ".svg"
 }
 
-// ParseDiscordCustom parses custom Discord emojis like <:name:id> or <a:name:id>.
-func (p *DiscordEmojiParser) ParseDiscordCustom(content string) []ParsedEmoji {
-	results := make([]ParsedEmoji, 0)
-	matches := p.customRegex.FindAllStringSubmatchIndex(content, -1)
-	for _, match := range matches {
-		if len(match) < 8 {
-			continue
-		}
-		from := match[0]
-		to := match[1]
-		animatedFlag := content[match[2]:match[3]]
-		name := content[match[4]:match[5]]
-		id := content[match[6]:match[7]]
-
-		animated := animatedFlag == "a"
-		ext := "png"
-		if animated {
-			ext = "gif"
-		}
-		url := "https://cdn.discordapp.com/emojis/" + id + "." + ext
-
-		idCopy := id
-		results = append(results, ParsedEmoji{
-			ID:       &idCopy,
-			Name:     name,
-			Type:     EmojiTypeCustom,
-			Unicode:  content[from:to],
-			Position: EmojiPosition{From: from, To: to},
-			Link:     &url,
-			Animated: animated,
-		})
+// newCustomEmoji builds a custom emoji result with its link. The caller fills
+// in Unicode and Position.
+func (p *DiscordEmojiParser) newCustomEmoji(name, id string, animated bool) ParsedEmoji {
+	ext := "png"
+	if animated {
+		ext = "gif"
+	}
+	url := "https://cdn.discordapp.com/emojis/" + id + "." + ext
+	if p.customLink != nil {
+		url = p.customLink.expand(linkValues{id: id, name: name, ext: ext, animated: animated})
 	}
 
-	return results
+	return ParsedEmoji{
+		ID:       &id,
+		Name:     name,
+		Type:     EmojiTypeCustom,
+		Link:     &url,
+		Animated: animated,
+	}
+}
+
+// swapState installs next as the parser's state, carrying over registrations
+// from the current state. The caller must hold p.mu.
+func (p *DiscordEmojiParser) swapState(next *parserState) {
+	next.customEmojis = p.state.Load().customEmojis
+	p.state.Store(next)
 }
 
 func toCodePoint(str, sep string) string {
@@ -293,9 +279,12 @@ func toCodePoint(str, sep string) string {
 	return strings.Join(points, sep)
 }
 
-func (p *DiscordEmojiParser) isInsideRange(index int, ranges []ParsedEmoji) bool {
-	for _, item := range ranges {
-		if index >= item.Position.From && index < item.Position.To {
+// mayContainEmoji is a cheap pre-check for Parse. Text and custom emojis need
+// a colon and every unicode key contains a non-ASCII byte, so content that is
+// plain ASCII without colons cannot contain any emoji.
+func mayContainEmoji(content string) bool {
+	for i := 0; i < len(content); i++ {
+		if content[i] == ':' || content[i] >= utf8.RuneSelf {
 			return true
 		}
 	}
@@ -310,23 +299,3 @@ func containsNonASCII(value string) bool {
 	}
 	return false
 }
-
-func parseJSONMap(path string) (map[string]string, error) {
-	file, err := assetsFS.Open(path)
-	if err != nil {
-		return nil, err
-	}
-	defer file.Close()
-
-	content, err := io.ReadAll(file)
-	if err != nil {
-		return nil, err
-	}
-
-	var result map[string]string
-	if err := json.Unmarshal(content, &result); err != nil {
-		return nil, err
-	}
-
-	return result, nil
-}
@@ -1,6 +1,9 @@
 package emojiparser_test
 
 import (
+	"math/rand"
+	"reflect"
+	"slices"
 	"strings"
 	"testing"
 
@@ -61,7 +64,7 @@ func TestParseTextRepresentation(t *testing.T) {
 }
 
 func TestParseDiscordCustom(t *testing.T) {
-	content := "hello <a:wave:1234567890123456> and <:smile:6789012345678901>"
+	content := "hello <a:wave:12345678901234567> and <:smile:67890123456789012>"
 	results := emojiparser.ParseDiscordCustom(content)
 	if len(results) != 2 {
 		t.Fatalf("expected 2 custom emojis, got %d", len(results))
@@ -69,8 +72,8 @@ func TestParseDiscordCustom(t *testing.T) {
 	if results[0].Name != "wave" || !results[0].Animated {
 		t.Fatalf("expected first custom emoji to be animated wave")
 	}
-	if results[0].ID == nil || *results[0].ID != "1234567890123456" {
-		t.Fatalf("expected first custom emoji id 1234567890123456")
+	if results[0].ID == nil || *results[0].ID != "12345678901234567" {
+		t.Fatalf("expected first custom emoji id 12345678901234567")
 	}
 	if results[0].Link == nil || !strings.HasSuffix(*results[0].Link, ".gif") {
 		t.Fatalf("expected gif link for animated emoji")
@@ -78,8 +81,8 @@ func TestParseDiscordCustom(t *testing.T) {
 	if results[1].Name != "smile" || results[1].Animated {
 		t.Fatalf("expected second custom emoji to be static smile")
 	}
-	if results[1].ID == nil || *results[1].ID != "6789012345678901" {
-		t.Fatalf("expected second custom emoji id 6789012345678901")
+	if results[1].ID == nil || *results[1].ID != "67890123456789012" {
+		t.Fatalf("expected second custom emoji id 67890123456789012")
 	}
 	if results[1].Link == nil || !strings.HasSuffix(*results[1].Link, ".png") {
 		t.Fatalf("expected png link for static emoji")
@@ -87,7 +90,7 @@ func TestParseDiscordCustom(t *testing.T) {
 }
 
 func TestParseAllSorted(t *testing.T) {
-	content := "A :smile: B 😄 C <a:wave:1234567890123456>"
+	content := "A :smile: B 😄 C <a:wave:12345678901234567>"
 	results := emojiparser.Parse(content)
 	if len(results) != 3 {
 		t.Fatalf("expected 3 emojis, got %d", len(results))
@@ -98,3 +101,270 @@ func TestParseAllSorted(t *testing.T) {
 		}
 	}
 }
+
+func TestSkipRangesRejectOverlaps(t *testing.T) {
+	skip := func(from, to int) []emojiparser.ParsedEmoji {
+		return []emojiparser.ParsedEmoji{{Position: emojiparser.EmojiPosition{From: from, To: to}}}
+	}
+
+	// "🧑‍💻" is [0:11); the range covers its trailing 💻 only.
+	if results := emojiparser.ParseUnicode("🧑‍💻 😄", skip(7, 11)); len(results) != 1 || results[0].Name != "smile" {
+		t.Fatalf("ParseUnicode with a range inside a sequence = %v, want only smile", results)
+	}
+	// Adjacent ranges do not overlap.
+	if results := emojiparser.ParseUnicode("😄😄", skip(4, 8)); len(results) != 1 || results[0].Position.To != 4 {
+		t.Fatalf("ParseUnicode with an adjacent range = %v, want the first smile", results)
+	}
+
+	content := "x :smile: :tada:"
+	cases := []struct {
+		from, to int
+		want     int
+	}{
+		{0, 2, 2},   // before :smile:
+		{0, 3, 1},   // over the opening colon
+		{8, 9, 1},   // over the closing colon
+		{5, 6, 1},   // inside the name
+		{9, 10, 2},  // between the two
+		{0, 100, 0}, // everything
+	}
+	for _, tc := range cases {
+		if got := len(emojiparser.ParseTextRepresentation(content, skip(tc.from, tc.to))); got != tc.want {
+			t.Fatalf("ParseTextRepresentation skipping [%d:%d) = %d results, want %d", tc.from, tc.to, got, tc.want)
+		}
+	}
+}
+
+func TestParseResultsNeverIntersect(t *testing.T) {
+	contents := []string{
+		":a<:a:12345678901234567>",
+		":smile<:smile:12345678901234567>:smile:",
+		"<:a:12345678901234567>:smile:<a:b:12345678901234567>",
+		"::smile::tada::😄:",
+		":smile:😄‍:tada:<:x:12345678901234567>👍🏽",
+	}
+	for _, content := range contents {
+		results := emojiparser.Parse(content)
+		for i := 1; i < len(results); i++ {
+			if results[i].Position.From < results[i-1].Position.To {
+				t.Fatalf("Parse(%q): %v intersects %v", content, results[i-1], results[i])
+			}
+		}
+	}
+}
+
+func TestParseResultsDisjoint(t *testing.T) {
+	merged := newTestParser(t)
+	// Keys made of shortcode characters could claim bytes of a shortcode
+	// or of custom markup.
+	if _, err := merged.MergeAssets(&emojiparser.Assets{UnicodeEmojis: map[string]string{
+		"smile_e":  "é:smile:",
+		"colon_ok": ":👌",
+		"tag":      "😄<",
+	}}); err != nil {
+		t.Fatalf("MergeAssets: %v", err)
+	}
+	parsers := map[string]*emojiparser.DiscordEmojiParser{
+		"default": newTestParser(t),
+		"merged":  merged,
+		"options": newTestParser(t,
+			emojiparser.WithIgnoreZeroWidth(true),
+			emojiparser.WithAcceptFullwidthColons(true),
+			emojiparser.WithCaseInsensitiveShortcodes(true),
+			emojiparser.WithEscapes(true),
+			emojiparser.WithMarkdown(true)),
+	}
+	fragments := []string{
+		":", "::", ":smile:", ":SMILE:", ":tada", "tada:", ":joy:sob:", ":skin-tone-2:", ":thumbsup_tone3:",
+		"é", "😄", "👌", "👨‍👩‍👧", "🇺🇸", "🇺", "1️⃣", "\\", "`", "||", "> ", "<", ">", "\u200b", "\uFF1A",
+		"<:pepe:12345678901234567>", "<a:smile:12345678901234567>", " ", "x", "https://x.com/",
+	}
+
+	rng := rand.New(rand.NewSource(9))
+	for range 2000 {
+		var b strings.Builder
+		for range rng.Intn(16) {
+			b.WriteString(fragments[rng.Intn(len(fragments))])
+		}
+		content := b.String()
+		for name, parser := range parsers {
+			results := parser.Parse(content)
+			for i, result := range results {
+				if result.Position.From >= result.Position.To || result.Position.To > len(content) ||
+					i > 0 && result.Position.From < results[i-1].Position.To {
+					t.Fatalf("%s Parse(%q): %+v is empty, out of bounds, or intersects the result before it", name, content, result)
+				}
+			}
+		}
+	}
+}
+
+func TestSkipRangesInAnyOrder(t *testing.T) {
+	content := strings.Repeat("<:pepe:12345678901234567> 😄 :tada: <a:wave:12345678901234567>🎉👍🏽", 20)
+	custom := emojiparser.ParseDiscordCustom(content)
+
+	var wantUnicode, wantText []emojiparser.ParsedEmoji
+	for _, result := range emojiparser.Parse(content) {
+		switch result.Type {
+		case emojiparser.EmojiTypeUnicode:
+			wantUnicode = append(wantUnicode, result)
+		case emojiparser.EmojiTypeText:
+			wantText = append(wantText, result)
+		}
+	}
+
+	reversed := slices.Clone(custom)
+	slices.Reverse(reversed)
+	shuffled := slices.Clone(custom)
+	rand.New(rand.NewSource(1)).Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+	// Duplicated and nested ranges merge into the same skipped bytes.
+	nested := append(slices.Clone(shuffled), custom[3], emojiparser.ParsedEmoji{Position: emojiparser.EmojiPosition{
+		From: custom[5].Position.From + 2, To: custom[5].Position.To - 2,
+	}})
+
+	for name, skip := range map[string][]emojiparser.ParsedEmoji{"sorted": custom, "reversed": reversed, "shuffled": shuffled, "nested": nested} {
+		if got := emojiparser.ParseUnicode(content, skip); !reflect.DeepEqual(got, wantUnicode) {
+			t.Fatalf("%s: ParseUnicode = %v, want %v", name, got, wantUnicode)
+		}
+		if got := emojiparser.ParseTextRepresentation(content, skip); !reflect.DeepEqual(got, wantText) {
+			t.Fatalf("%s: ParseTextRepresentation = %v, want %v", name, got, wantText)
+		}
+	}
+}
+
+func TestStrictCustomNames(t *testing.T) {
+	strict, err := emojiparser.NewDiscordEmojiParser(emojiparser.WithStrictCustomNames(true))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tests := []struct {
+		name  string
+		valid bool
+	}{
+		{"a", false},
+		{"ab", true},
+		{strings.Repeat("x", 32), true},
+		{strings.Repeat("x", 33), false},
+	}
+	for _, tc := range tests {
+		content := "<:" + tc.name + ":12345678901234567>"
+		if got := len(strict.ParseDiscordCustom(content)) == 1; got != tc.valid {
+			t.Fatalf("strict ParseDiscordCustom(%q) matched %v, want %v", content, got, tc.valid)
+		}
+		if got := emojiparser.ParseDiscordCustom(content); len(got) != 1 {
+			t.Fatalf("ParseDiscordCustom(%q) = %v, want a match without the option", content, got)
+		}
+	}
+
+	// The name of rejected markup is still a shortcode.
+	results := strict.Parse("<:b:12345678901234567>")
+	if len(results) != 1 || results[0].Type != emojiparser.EmojiTypeText || results[0].Name != "b" {
+		t.Fatalf("strict Parse = %v, want the :b: shortcode", results)
+	}
+}
+
+func TestEscapes(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser(emojiparser.WithEscapes(true))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tests := []struct {
+		content string
+		want    []string
+	}{
+		{`\:smile:`, nil},
+		{`\\:smile:`, []string{"smile"}},
+		{`\\\:smile:`, nil},
+		{`\<:pepe:12345678901234567>`, nil},
+		{`\<:smile:12345678901234567> :tada:`, []string{"tada"}},
+		{`\\<:pepe:12345678901234567>`, []string{"pepe"}},
+		{`a\b :smile: \😄`, []string{"smile", "smile"}},
+	}
+	for _, tc := range tests {
+		var got []string
+		for _, result := range parser.Parse(tc.content) {
+			got = append(got, result.Name)
+		}
+		if !slices.Equal(got, tc.want) {
+			t.Fatalf("Parse(%q) = %v, want %v", tc.content, got, tc.want)
+		}
+	}
+
+	// Escaped markup is not mistaken for a shortcode by a text-only parse.
+	if results := parser.ParseTextRepresentation(`\<:smile:12345678901234567>`, nil); len(results) != 0 {
+		t.Fatalf("ParseTextRepresentation = %v, want none", results)
+	}
+
+	content := `\:tada: :tada: \\<:pepe:12345678901234567>`
+	results := parser.Parse(content)
+	if len(results) != 2 || results[0].Position.From != strings.Index(content, " :tada:")+1 ||
+		results[1].Position.From != strings.Index(content, "<") {
+		t.Fatalf("Parse(%q) = %v, want positions in the original string", content, results)
+	}
+	if results := emojiparser.Parse(`\:smile:`); len(results) != 1 {
+		t.Fatalf("Parse without the option = %v, want the shortcode", results)
+	}
+}
+
+func TestSharedColonShortcodes(t *testing.T) {
+	type want struct {
+		name     string
+		from, to int
+	}
+	cases := map[string][]want{
+		":joy::sob:":       {{"joy", 0, 5}, {"sob", 5, 10}},
+		":joy:sob:":        {{"joy", 0, 5}, {"sob", 5, 9}},
+		"a:b:smile:":       {{"b", 1, 4}, {"smile", 4, 10}},
+		":joy:sob:tada:":   {{"joy", 0, 5}, {"sob", 5, 9}, {"tada", 9, 14}},
+		":joy::sob:tada:":  {{"joy", 0, 5}, {"sob", 5, 10}, {"tada", 10, 15}},
+		":nope:joy:sob:":   {{"joy", 5, 10}, {"sob", 10, 14}},
+		":joy:nope:sob:":   {{"joy", 0, 5}, {"sob", 9, 14}},
+		":not_real:smile:": {{"smile", 9, 16}},
+	}
+	for content, wants := range cases {
+		results := emojiparser.ParseTextRepresentation(content, nil)
+		if len(results) != len(wants) {
+			t.Fatalf("ParseTextRepresentation(%q) = %v, want %v", content, results, wants)
+		}
+		for i, w := range wants {
+			if got := results[i]; got.Name != w.name || got.Position.From != w.from || got.Position.To != w.to {
+				t.Fatalf("ParseTextRepresentation(%q)[%d] = %s at [%d:%d), want %s at [%d:%d)",
+					content, i, got.Name, got.Position.From, got.Position.To, w.name, w.from, w.to)
+			}
+		}
+	}
+}
+
+func TestCaseInsensitiveShortcodes(t *testing.T) {
+	if results := emojiparser.ParseTextRepresentation(":SMILE: :Thinking:", nil); len(results) != 0 {
+		t.Fatalf("ParseTextRepresentation matched mixed case by default: %v", results)
+	}
+
+	parser, err := emojiparser.NewDiscordEmojiParser(emojiparser.WithCaseInsensitiveShortcodes(true))
+	if err != nil {
+		t.Fatalf("NewDiscordEmojiParser: %v", err)
+	}
+	content := "x :SMILE: :Thinking: :Flag_US: :NoPe:"
+	results := parser.ParseTextRepresentation(content, nil)
+	want := []struct {
+		name, unicode string
+		from, to      int
+	}{
+		{"SMILE", "😄", 2, 9},
+		{"Thinking", "🤔", 10, 20},
+		{"Flag_US", "🇺🇸", 21, 30},
+	}
+	if len(results) != len(want) {
+		t.Fatalf("ParseTextRepresentation(%q) = %v, want %d results", content, results, len(want))
+	}
+	for i, w := range want {
+		got := results[i]
+		if got.Name != w.name || got.Unicode != w.unicode || got.Position.From != w.from || got.Position.To != w.to {
+			t.Fatalf("ParseTextRepresentation(%q)[%d] = %+v, want %s at [%d:%d)", content, i, got, w.name, w.from, w.to)
+		}
+	}
+	// The fold is ASCII only: the Kelvin sign does not fold to k.
+	if results := parser.ParseTextRepresentation(":\u212Aiss:", nil); len(results) != 0 {
+		t.Fatalf("ParseTextRepresentation folded a non-ASCII letter: %v", results)
+	}
+}
@@ -0,0 +1,162 @@
+package emojiparser
+
+import (
+	"regexp"
+	"strings"
+)
+
+// markdownDelimiters are the Discord inline formatting markers removed by
+// StripMarkdown, longest first so "**" is preferred over "*".
+var markdownDelimiters = []string{"**", "__", "~~", "||", "*", "_"}
+
+// customTagRegex matches custom emoji markup so its underscores and colons are
+// never mistaken for formatting.
+var customTagRegex = regexp.MustCompile(`<a?:\w+:\d+>`)
+
+// StripMarkdown removes Discord's inline formatting markers (bold, italics,
+// underline, strikethrough, and spoilers) from content and returns the
+// stripped text together with an OffsetMap relating it to content. Markers are
+// only removed in matching pairs; inline code spans and custom emoji markup
+// are copied verbatim. Underscores only delimit at word boundaries, so
+// shortcodes like :sweat_smile: are left intact.
+//
+// The map can be passed to TranslatePositions to move parse results from
+// content into the stripped text.
+func StripMarkdown(content string) (string, OffsetMap) {
+	protected := protectedMarkdownSpans(content)
+	removed := make([]bool, len(content))
+
+	for i := 0; i < len(content); {
+		if end, ok := protectedEnd(protected, i); ok {
+			i = end
+			continue
+		}
+		if removed[i] {
+			i++
+			continue
+		}
+
+		matched := false
+		for _, delim := range markdownDelimiters {
+			if !strings.HasPrefix(content[i:], delim) || !canOpenDelimiter(content, i, delim) {
+				continue
+			}
+			closing := findClosingDelimiter(content, i+len(delim), delim, protected, removed)
+			if closing < 0 {
+				continue
+			}
+			markRemoved(removed, i, len(delim))
+			markRemoved(removed, closing, len(delim))
+			i += len(delim)
+			matched = true
+			break
+		}
+		if !matched {
+			i++
+		}
+	}
+
+	var builder strings.Builder
+	builder.Grow(len(content))
+	var offsets OffsetMap
+	for i := 0; i < len(content); {
+		if removed[i] {
+			i++
+			continue
+		}
+		start := i
+		for i < len(content) && !removed[i] {
+			i++
+		}
+		offsets = append(offsets, OffsetSpan{Original: start, Stripped: builder.Len(), Len: i - start})
+		builder.WriteString(content[start:i])
+	}
+	return builder.String(), offsets
+}
+
+// protectedMarkdownSpans returns the sorted, non-overlapping spans in which
+// formatting markers are not interpreted: inline code and custom emoji tags.
+func protectedMarkdownSpans(content string) []EmojiPosition {
+	var spans []EmojiPosition
+	tags := customTagRegex.FindAllStringIndex(content, -1)
+	for i := 0; i < len(content); i++ {
+		if len(tags) > 0 && i == tags[0][0] {
+			spans = append(spans, EmojiPosition{From: tags[0][0], To: tags[0][1]})
+			i = tags[0][1] - 1
+			tags = tags[1:]
+			continue
+		}
+		if content[i] != '`' {
+			continue
+		}
+		end := strings.IndexByte(content[i+1:], '`')
+		if end < 0 {
+			break
+		}
+		spans = append(spans, EmojiPosition{From: i, To: i + end + 2})
+		i += end + 1
+		for len(tags) > 0 && tags[0][0] <= i {
+			tags = tags[1:]
+		}
+	}
+	return spans
+}
+
+func protectedEnd(spans []EmojiPosition, index int) (int, bool) {
+	for _, span := range spans {
+		if index >= span.From && index < span.To {
+			return span.To, true
+		}
+	}
+	return 0, false
+}
+
+func findClosingDelimiter(content string, from int, delim string, protected []EmojiPosition, removed []bool) int {
+	for i := from; i+len(delim) <= len(content); {
+		if end, ok := protectedEnd(protected, i); ok {
+			i = end
+			continue
+		}
+		if !removed[i] && i > from && strings.HasPrefix(content[i:], delim) && canCloseDelimiter(content, i, delim) {
+			return i
+		}
+		i++
+	}
+	return -1
+}
+
+func canOpenDelimiter(content string, index int, delim string) bool {
+	next := index + len(delim)
+	if next >= len(content) || isMarkdownSpace(content[next]) {
+		return false
+	}
+	if delim[0] == '_' && index > 0 && isWordByte(content[index-1]) {
+		return false
+	}
+	return true
+}
+
+func canCloseDelimiter(content string, index int, delim string) bool {
+	if isMarkdownSpace(content[index-1]) {
+		return false
+	}
+	next := index + len(delim)
+	if delim[0] == '_' && next < len(content) && isWordByte(content[next]) {
+		return false
+	}
+	return true
+}
+
+func markRemoved(removed []bool, from, length int) {
+	for i := from; i < from+length; i++ {
+		removed[i] = true
+	}
+}
+
+func isMarkdownSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func isWordByte(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_'
+}
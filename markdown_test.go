@@ -0,0 +1,65 @@
+package emojiparser_test
+
+import (
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestStripMarkdown(t *testing.T) {
+	cases := map[string]string{
+		"**bold** and *it* and ~~gone~~": "bold and it and gone",
+		"__under__ ||spoiler||":          "under spoiler",
+		"***both***":                     "both",
+		"keep :sweat_smile: intact":      "keep :sweat_smile: intact",
+		"unpaired ** stays":              "unpaired ** stays",
+		"`**code**` and **x**":           "`**code**` and x",
+		"<:_x_:12345678901234567> _y_":   "<:_x_:12345678901234567> y",
+	}
+	for input, want := range cases {
+		got, _ := emojiparser.StripMarkdown(input)
+		if got != want {
+			t.Fatalf("StripMarkdown(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestTranslatePositionsThroughStripMarkdown(t *testing.T) {
+	content := "**hi 😄** :smile: ~~<:wave:12345678901234567>~~ 🎉"
+	stripped, offsets := emojiparser.StripMarkdown(content)
+	if stripped != "hi 😄 :smile: <:wave:12345678901234567> 🎉" {
+		t.Fatalf("unexpected stripped text %q", stripped)
+	}
+
+	results := emojiparser.TranslatePositions(emojiparser.Parse(content), offsets.Translate)
+	if len(results) != 4 {
+		t.Fatalf("expected 4 emojis, got %d", len(results))
+	}
+	for _, result := range results {
+		got := stripped[result.Position.From:result.Position.To]
+		if got != result.Unicode && got != ":"+result.Name+":" {
+			t.Fatalf("translated %s %q points at %q", result.Type, result.Name, got)
+		}
+	}
+}
+
+func TestTranslatePositionsDropsRemoved(t *testing.T) {
+	content := "a 😄 b 🎉"
+	results := emojiparser.Parse(content)
+	// Drop everything up to and including the first emoji.
+	cut := results[0].Position.To
+	translate := func(offset int) (int, bool) {
+		if offset < cut {
+			return 0, false
+		}
+		return offset - cut, true
+	}
+
+	translated := emojiparser.TranslatePositions(results, translate)
+	if len(translated) != 1 || translated[0].Name != "tada" {
+		t.Fatalf("expected only the second emoji to survive, got %+v", translated)
+	}
+	if translated[0].Position.From != results[1].Position.From-cut {
+		t.Fatalf("expected shifted position, got %v", translated[0].Position)
+	}
+}
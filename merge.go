@@ -0,0 +1,203 @@
+package emojiparser
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// ErrCollision is returned by MergeAssets and RegisterShortcode in strict mode
+// when an entry would be redefined to a different value.
+var ErrCollision = errors.New("emoji entry collision")
+
+// CollisionKind classifies a Collision.
+type CollisionKind int
+
+const (
+	// CollisionRedefined means an existing key was set to a different value.
+	CollisionRedefined CollisionKind = iota
+	// CollisionAlias means a new name maps to the same emoji as an existing
+	// name. It is only reported when alias reporting is enabled.
+	CollisionAlias
+)
+
+// Collision describes an entry that clashed with the parser's current tables.
+//
+// For CollisionRedefined, Name is the key, Old and New are its previous and
+// requested values, and Winner is the value in effect afterwards. For
+// CollisionAlias, Name is the new name, Old is the existing name that maps to
+// the same emoji, and New and Winner are that emoji.
+type Collision struct {
+	Kind   CollisionKind
+	Name   string
+	Old    string
+	New    string
+	Winner string
+}
+
+// MergeAssets overlays the entries of extra onto the parser's current tables
+// and swaps in the rebuilt indexes atomically. Entries from extra replace
+// existing entries with the same key, and every replaced entry is reported as
+// a collision. A name entry whose emoji is not yet known also gains the
+// reverse emoji-to-name entry, so the new sequence is matched by ParseUnicode
+// without having to list it twice.
+//
+// With strict collisions enabled, any redefinition fails with ErrCollision
+// and the collisions are still returned. On any error the parser is left
+// unchanged.
+func (p *DiscordEmojiParser) MergeAssets(extra *Assets) ([]Collision, error) {
+	if extra == nil {
+		return nil, errors.New("merge assets: nil assets")
+	}
+	if err := validateUnicodeEmojis(extra.UnicodeEmojis); err != nil {
+		return nil, fmt.Errorf("merge assets: %w", err)
+	}
+	if err := validateUnicodeEmojisSVG(extra.UnicodeEmojisSVG); err != nil {
+		return nil, fmt.Errorf("merge assets: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	current := p.state.Load()
+	collisions := p.findCollisions(current, extra.UnicodeEmojis)
+	if p.opts.StrictCollisions {
+		var redefined []string
+		for i, collision := range collisions {
+			if collision.Kind == CollisionRedefined {
+				collisions[i].Winner = collision.Old
+				redefined = append(redefined, collision.Name)
+			}
+		}
+		if len(redefined) > 0 {
+			return collisions, fmt.Errorf("merge assets: %w: %s", ErrCollision, strings.Join(redefined, ", "))
+		}
+	}
+
+	merged := current.assets()
+	maps.Copy(merged.UnicodeEmojis, extra.UnicodeEmojis)
+	maps.Copy(merged.UnicodeEmojisSVG, extra.UnicodeEmojisSVG)
+	for key, value := range extra.UnicodeEmojis {
+		if containsNonASCII(value) && !containsNonASCII(key) {
+			if _, ok := merged.UnicodeEmojis[value]; !ok {
+				merged.UnicodeEmojis[value] = key
+			}
+		}
+	}
+
+	state, err := newParserState(merged)
+	if err != nil {
+		return nil, fmt.Errorf("merge assets: %w", err)
+	}
+	p.swapState(state)
+	return collisions, nil
+}
+
+// RegisterShortcode maps the shortcode name to emoji, so that :name: is
+// parsed as a text emoji. If emoji is not yet known it is also added to the
+// unicode index under name. Collisions are reported as for MergeAssets.
+func (p *DiscordEmojiParser) RegisterShortcode(name, emoji string) ([]Collision, error) {
+	if !isShortcodeName(name) {
+		return nil, fmt.Errorf("register shortcode: invalid name %q: want letters, digits, and underscores", name)
+	}
+	if !containsNonASCII(emoji) {
+		return nil, fmt.Errorf("register shortcode %q: %q is not a unicode emoji", name, emoji)
+	}
+	return p.MergeAssets(&Assets{UnicodeEmojis: map[string]string{name: emoji}})
+}
+
+// RegisterCustomEmoji records a custom emoji of the server the parser is used
+// for, so that searches by name such as FindEmojiNamed also find custom
+// emojis with that ID, whatever name their markup uses. Registering the same
+// ID again under the same name has no effect.
+func (p *DiscordEmojiParser) RegisterCustomEmoji(name, id string) error {
+	if !isShortcodeName(name) {
+		return fmt.Errorf("register custom emoji: invalid name %q: want letters, digits, and underscores", name)
+	}
+	if !p.validSnowflake(id) {
+		return fmt.Errorf("register custom emoji %q: invalid id %q", name, id)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	current := p.state.Load()
+	if slices.Contains(current.customEmojis[name], id) {
+		return nil
+	}
+	customEmojis := make(map[string][]string, len(current.customEmojis)+1)
+	for key, ids := range current.customEmojis {
+		customEmojis[key] = ids
+	}
+	customEmojis[name] = append(slices.Clip(customEmojis[name]), id)
+
+	next := *current
+	next.customEmojis = customEmojis
+	p.state.Store(&next)
+	return nil
+}
+
+// findCollisions compares entries against the current tables. The result is
+// sorted by kind and name so reports are stable across runs.
+func (p *DiscordEmojiParser) findCollisions(current *parserState, entries map[string]string) []Collision {
+	var collisions []Collision
+	for key, value := range entries {
+		if old, ok := current.entry(key); ok && old != value {
+			collisions = append(collisions, Collision{
+				Kind:   CollisionRedefined,
+				Name:   key,
+				Old:    old,
+				New:    value,
+				Winner: value,
+			})
+		}
+	}
+
+	if p.opts.ReportAliases {
+		for name, emoji := range entries {
+			if !containsNonASCII(emoji) {
+				continue
+			}
+			for existing, target := range current.nameToUnicode {
+				if existing != name && target == emoji {
+					collisions = append(collisions, Collision{
+						Kind:   CollisionAlias,
+						Name:   name,
+						Old:    existing,
+						New:    emoji,
+						Winner: emoji,
+					})
+				}
+			}
+		}
+	}
+
+	sort.Slice(collisions, func(i, j int) bool {
+		if collisions[i].Kind != collisions[j].Kind {
+			return collisions[i].Kind < collisions[j].Kind
+		}
+		if collisions[i].Name != collisions[j].Name {
+			return collisions[i].Name < collisions[j].Name
+		}
+		return collisions[i].Old < collisions[j].Old
+	})
+	return collisions
+}
+
+// isShortcodeName reports whether name can appear between the colons of a
+// text emoji.
+func isShortcodeName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_') {
+			return false
+		}
+	}
+	return true
+}
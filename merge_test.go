@@ -0,0 +1,204 @@
+package emojiparser_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestMergeAssetsNewSequence(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser()
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+
+	sequence := "🐙‍🌈"
+	if results := parser.ParseUnicode(sequence, nil); len(results) != 1 || results[0].Name != "octopus" {
+		t.Fatalf("test sequence is already part of the dataset: %+v", results)
+	}
+
+	_, err = parser.MergeAssets(&emojiparser.Assets{
+		UnicodeEmojis:    map[string]string{"rainbow_octopus": sequence},
+		UnicodeEmojisSVG: map[string]string{"1f419-200d-1f308": "0123abcd"},
+	})
+	if err != nil {
+		t.Fatalf("merge: %v", err)
+	}
+
+	content := "hi " + sequence + " :rainbow_octopus:"
+	results := parser.Parse(content)
+	if len(results) != 2 {
+		t.Fatalf("expected 2 emojis, got %d", len(results))
+	}
+	unicode := results[0]
+	if unicode.Name != "rainbow_octopus" || unicode.Unicode != sequence {
+		t.Fatalf("expected merged sequence as one emoji, got %q (%q)", unicode.Name, unicode.Unicode)
+	}
+	if unicode.Position.To-unicode.Position.From != len(sequence) {
+		t.Fatalf("expected match to span the whole sequence, got %v", unicode.Position)
+	}
+	if unicode.Link == nil || !strings.Contains(*unicode.Link, "0123abcd") {
+		t.Fatalf("expected merged svg hash in link, got %v", unicode.Link)
+	}
+	if results[1].Unicode != sequence {
+		t.Fatalf("expected shortcode to resolve to merged sequence, got %q", results[1].Unicode)
+	}
+
+	// The embedded entries are still there.
+	if results := parser.Parse(":smile: 😄"); len(results) != 2 {
+		t.Fatalf("expected embedded emojis to survive the merge, got %d", len(results))
+	}
+}
+
+func TestMergeAssetsExtraWins(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser()
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+
+	_, err = parser.MergeAssets(&emojiparser.Assets{
+		UnicodeEmojis: map[string]string{"smile": "😁", "😄": "happy"},
+	})
+	if err != nil {
+		t.Fatalf("merge: %v", err)
+	}
+
+	results := parser.Parse(":smile: 😄")
+	if len(results) != 2 {
+		t.Fatalf("expected 2 emojis, got %d", len(results))
+	}
+	if results[0].Unicode != "😁" {
+		t.Fatalf("expected overridden shortcode target, got %q", results[0].Unicode)
+	}
+	if results[1].Name != "happy" {
+		t.Fatalf("expected overridden unicode name, got %q", results[1].Name)
+	}
+}
+
+func TestMergeAssetsValidation(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser()
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+
+	cases := []*emojiparser.Assets{
+		nil,
+		{UnicodeEmojis: map[string]string{"": "😄"}},
+		{UnicodeEmojis: map[string]string{"alias": "smile"}},
+		{UnicodeEmojis: map[string]string{"bad": "\xff"}},
+		{UnicodeEmojisSVG: map[string]string{"1F604": "feed"}},
+	}
+	for i, extra := range cases {
+		if _, err := parser.MergeAssets(extra); err == nil {
+			t.Fatalf("case %d: expected validation error", i)
+		}
+	}
+	if results := parser.Parse(":smile:"); len(results) != 1 || results[0].Unicode != "😄" {
+		t.Fatalf("expected parser unchanged after failed merges")
+	}
+}
+
+func TestRegisterShortcodeNoCollision(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser(emojiparser.WithStrictCollisions(true))
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+
+	collisions, err := parser.RegisterShortcode("happy_face", "🐙‍🌈")
+	if err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	if len(collisions) != 0 {
+		t.Fatalf("expected no collisions, got %+v", collisions)
+	}
+	if results := parser.ParseTextRepresentation(":happy_face:", nil); len(results) != 1 {
+		t.Fatalf("expected registered shortcode to parse")
+	}
+}
+
+func TestRegisterShortcodeSilentWin(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser()
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+
+	collisions, err := parser.RegisterShortcode("smile", "😁")
+	if err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	want := emojiparser.Collision{
+		Kind:   emojiparser.CollisionRedefined,
+		Name:   "smile",
+		Old:    "😄",
+		New:    "😁",
+		Winner: "😁",
+	}
+	if len(collisions) != 1 || collisions[0] != want {
+		t.Fatalf("expected %+v, got %+v", want, collisions)
+	}
+	if results := parser.ParseTextRepresentation(":smile:", nil); len(results) != 1 || results[0].Unicode != "😁" {
+		t.Fatalf("expected new target to win")
+	}
+}
+
+func TestRegisterShortcodeStrictError(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser(emojiparser.WithStrictCollisions(true))
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+
+	collisions, err := parser.RegisterShortcode("smile", "😁")
+	if !errors.Is(err, emojiparser.ErrCollision) {
+		t.Fatalf("expected ErrCollision, got %v", err)
+	}
+	if len(collisions) != 1 || collisions[0].Winner != "😄" {
+		t.Fatalf("expected old target to be kept, got %+v", collisions)
+	}
+	if results := parser.ParseTextRepresentation(":smile:", nil); len(results) != 1 || results[0].Unicode != "😄" {
+		t.Fatalf("expected parser unchanged after strict collision")
+	}
+}
+
+func TestRegisterShortcodeAliasReporting(t *testing.T) {
+	quiet, err := emojiparser.NewDiscordEmojiParser(emojiparser.WithStrictCollisions(true))
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+	collisions, err := quiet.RegisterShortcode("grinning_smile", "😄")
+	if err != nil || len(collisions) != 0 {
+		t.Fatalf("expected silent aliasing, got %+v, %v", collisions, err)
+	}
+
+	reporting, err := emojiparser.NewDiscordEmojiParser(
+		emojiparser.WithStrictCollisions(true),
+		emojiparser.WithAliasReporting(true),
+	)
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+	collisions, err = reporting.RegisterShortcode("grinning_smile", "😄")
+	if err != nil {
+		t.Fatalf("aliasing must not be an error in strict mode: %v", err)
+	}
+	if len(collisions) != 1 {
+		t.Fatalf("expected 1 alias collision, got %+v", collisions)
+	}
+	if collisions[0].Kind != emojiparser.CollisionAlias || collisions[0].Old != "smile" {
+		t.Fatalf("expected alias of smile, got %+v", collisions[0])
+	}
+}
+
+func TestRegisterShortcodeValidation(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser()
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+	if _, err := parser.RegisterShortcode("not valid", "😄"); err == nil {
+		t.Fatalf("expected error for invalid name")
+	}
+	if _, err := parser.RegisterShortcode("smile2", "smile"); err == nil {
+		t.Fatalf("expected error for non-emoji target")
+	}
+}
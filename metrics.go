@@ -0,0 +1,74 @@
+package emojiparser
+
+import (
+	"expvar"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// ParserMetrics is a snapshot of a parser's operation counters. Counters are
+// only updated when the parser was created with WithMetrics(true).
+type ParserMetrics struct {
+	ParseCalls     uint64 `json:"parse_calls"`
+	FastPathSkips  uint64 `json:"fast_path_skips"`
+	UnicodeMatches uint64 `json:"unicode_matches"`
+	TextMatches    uint64 `json:"text_matches"`
+	CustomMatches  uint64 `json:"custom_matches"`
+}
+
+// parserMetrics holds the live counters behind ParserMetrics.
+type parserMetrics struct {
+	parseCalls     atomic.Uint64
+	fastPathSkips  atomic.Uint64
+	unicodeMatches atomic.Uint64
+	textMatches    atomic.Uint64
+	customMatches  atomic.Uint64
+}
+
+// Metrics returns a snapshot of the parser's counters. Each counter is read
+// atomically, but the snapshot as a whole is not taken at a single instant.
+func (p *DiscordEmojiParser) Metrics() ParserMetrics {
+	return ParserMetrics{
+		ParseCalls:     p.metrics.parseCalls.Load(),
+		FastPathSkips:  p.metrics.fastPathSkips.Load(),
+		UnicodeMatches: p.metrics.unicodeMatches.Load(),
+		TextMatches:    p.metrics.textMatches.Load(),
+		CustomMatches:  p.metrics.customMatches.Load(),
+	}
+}
+
+// expvarMu makes the check and the publish in PublishExpvar one step, since
+// expvar.Publish panics on a name that is already taken.
+var expvarMu sync.Mutex
+
+// PublishExpvar publishes the parser's metrics as an expvar variable named
+// prefix, rendered as a JSON object of counters. It fails if a variable with
+// that name is already published, and is safe to call concurrently, from
+// any number of parsers.
+func (p *DiscordEmojiParser) PublishExpvar(prefix string) error {
+	expvarMu.Lock()
+	defer expvarMu.Unlock()
+	if expvar.Get(prefix) != nil {
+		return fmt.Errorf("publish expvar: %q is already published", prefix)
+	}
+	expvar.Publish(prefix, expvar.Func(func() any {
+		return p.Metrics()
+	}))
+	return nil
+}
+
+// countMatches adds n matches of type kind to the metrics if they are enabled.
+func (p *DiscordEmojiParser) countMatches(kind EmojiType, n int) {
+	if !p.opts.Metrics || n == 0 {
+		return
+	}
+	switch kind {
+	case EmojiTypeUnicode:
+		p.metrics.unicodeMatches.Add(uint64(n))
+	case EmojiTypeText:
+		p.metrics.textMatches.Add(uint64(n))
+	case EmojiTypeCustom:
+		p.metrics.customMatches.Add(uint64(n))
+	}
+}
@@ -0,0 +1,109 @@
+package emojiparser_test
+
+import (
+	"encoding/json"
+	"expvar"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestMetrics(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser(emojiparser.WithMetrics(true))
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+
+	parser.Parse("A :smile: B 😄 C <a:wave:12345678901234567> 🎉")
+	parser.Parse("plain ascii")
+	parser.ParseUnicode("😄", nil)
+
+	want := emojiparser.ParserMetrics{
+		ParseCalls:     2,
+		FastPathSkips:  1,
+		UnicodeMatches: 3,
+		TextMatches:    1,
+		CustomMatches:  1,
+	}
+	if got := parser.Metrics(); got != want {
+		t.Fatalf("expected %+v, got %+v", want, got)
+	}
+}
+
+func TestMetricsDisabledByDefault(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser()
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+	parser.Parse(":smile: 😄")
+	if got := parser.Metrics(); got != (emojiparser.ParserMetrics{}) {
+		t.Fatalf("expected zero metrics, got %+v", got)
+	}
+}
+
+func TestPublishExpvar(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser(emojiparser.WithMetrics(true))
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+	if err := parser.PublishExpvar("emojiparser_test"); err != nil {
+		t.Fatalf("publish: %v", err)
+	}
+	if err := parser.PublishExpvar("emojiparser_test"); err == nil {
+		t.Fatalf("expected error publishing the same name twice")
+	}
+
+	parser.Parse(":smile:")
+	var published emojiparser.ParserMetrics
+	if err := json.Unmarshal([]byte(expvar.Get("emojiparser_test").String()), &published); err != nil {
+		t.Fatalf("decode expvar: %v", err)
+	}
+	if published.ParseCalls != 1 || published.TextMatches != 1 {
+		t.Fatalf("unexpected published metrics %+v", published)
+	}
+}
+
+func TestPublishExpvarConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	var published atomic.Int32
+	for range 16 {
+		parser, err := emojiparser.NewDiscordEmojiParser(emojiparser.WithMetrics(true))
+		if err != nil {
+			t.Fatalf("new parser: %v", err)
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if parser.PublishExpvar("emojiparser_test_concurrent") == nil {
+				published.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := published.Load(); n != 1 {
+		t.Fatalf("%d parsers published the same name, want 1", n)
+	}
+}
+
+func BenchmarkParseMetrics(b *testing.B) {
+	content := strings.Repeat("hello :smile: world 😄 <:pepe:12345678901234567> ", 20)
+	for _, enabled := range []bool{false, true} {
+		name := "disabled"
+		if enabled {
+			name = "enabled"
+		}
+		b.Run(name, func(b *testing.B) {
+			parser, err := emojiparser.NewDiscordEmojiParser(emojiparser.WithMetrics(enabled))
+			if err != nil {
+				b.Fatal(err)
+			}
+			b.ReportAllocs()
+			for b.Loop() {
+				parser.Parse(content)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package emojiparser
+
+import (
+	"slices"
+	"sort"
+)
+
+// Names returns the shortcode names of the default parser.
+func Names() []string {
+	return defaultParser.Names()
+}
+
+// NamesFor returns the shortcode names of emoji using the default parser.
+func NamesFor(emoji string) []string {
+	return defaultParser.NamesFor(emoji)
+}
+
+// Names returns every shortcode name in the parser's tables, without colons
+// and in sorted order, for autocompletion and the like. The list is sorted
+// once per table load, so calls only pay for the copy the caller gets to
+// keep. Generated flag shortcodes such as "flag_bg" are left out unless the
+// tables list them, and so are custom emoji registrations.
+func (p *DiscordEmojiParser) Names() []string {
+	return slices.Clone(p.state.Load().names())
+}
+
+// NamesFor returns every shortcode name that maps to emoji, sorted, or nil
+// if there is none. U+FE0F in emoji is ignored, and flags include their
+// generated shortcode. The slice is the caller's to modify.
+func (p *DiscordEmojiParser) NamesFor(emoji string) []string {
+	state := p.state.Load()
+	return state.shortcodeNames(state.tableKey(emoji))
+}
+
+// shortcodeNames returns a sorted copy of the names of the emoji key,
+// including its generated shortcode if it resolves.
+func (s *parserState) shortcodeNames(key string) []string {
+	names := slices.Clone(s.namesFor(key))
+	if name := generatedName(key); name != "" && !slices.Contains(names, name) {
+		if _, ok := s.generatedShortcode(name); ok {
+			names = append(names, name)
+			sort.Strings(names)
+		}
+	}
+	return names
+}
@@ -0,0 +1,46 @@
+package emojiparser_test
+
+import (
+	"slices"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestNames(t *testing.T) {
+	names := emojiparser.Names()
+	if !slices.IsSorted(names) {
+		t.Fatal("Names is not sorted")
+	}
+	for _, name := range []string{"smile", "thumbsup", "heart"} {
+		if _, ok := slices.BinarySearch(names, name); !ok {
+			t.Fatalf("Names is missing %q", name)
+		}
+	}
+	names[0] = "mutated"
+	if again := emojiparser.Names(); again[0] == "mutated" {
+		t.Fatal("Names returned the parser's own slice")
+	}
+}
+
+func TestNamesFor(t *testing.T) {
+	cases := map[string][]string{
+		"\U0001F44D":           {"+1", "thumbsup", "thumbup"},
+		"❤\ufe0f":              {"heart"},
+		"❤":                    {"heart"},
+		"\U0001F1E7\U0001F1EC": {"flag_bg"},
+		"x":                    nil,
+		"":                     nil,
+	}
+	for emoji, want := range cases {
+		if got := emojiparser.NamesFor(emoji); !slices.Equal(got, want) {
+			t.Fatalf("NamesFor(%q) = %q, want %q", emoji, got, want)
+		}
+	}
+
+	names := emojiparser.NamesFor("\U0001F44D")
+	names[0] = "mutated"
+	if again := emojiparser.NamesFor("\U0001F44D"); again[0] != "+1" {
+		t.Fatalf("NamesFor returned the parser's own slice: %q", again)
+	}
+}
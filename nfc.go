@@ -0,0 +1,120 @@
+package emojiparser
+
+import "unicode/utf8"
+
+//go:generate go run ./internal/cmd/compositiongen -out composition_table.go
+
+// Hangul syllables compose algorithmically from their leading consonant,
+// vowel, and optional trailing consonant jamo.
+const (
+	hangulSBase  = 0xAC00
+	hangulLBase  = 0x1100
+	hangulVBase  = 0x1161
+	hangulTBase  = 0x11A7
+	hangulLCount = 19
+	hangulVCount = 21
+	hangulTCount = 28
+	hangulNCount = hangulVCount * hangulTCount
+	hangulSCount = hangulLCount * hangulNCount
+)
+
+// composesWithPrevious holds the characters that compose with the one before
+// them, outside of Hangul.
+var composesWithPrevious = func() map[rune]bool {
+	seconds := make(map[rune]bool)
+	for pair := range canonicalCompositions {
+		seconds[pair[1]] = true
+	}
+	return seconds
+}()
+
+// composePair returns the canonical composite of a and b, if any.
+func composePair(a, b rune) (rune, bool) {
+	if l, v := a-hangulLBase, b-hangulVBase; l >= 0 && l < hangulLCount && v >= 0 && v < hangulVCount {
+		return hangulSBase + (l*hangulVCount+v)*hangulTCount, true
+	}
+	if s, t := a-hangulSBase, b-hangulTBase; s >= 0 && s < hangulSCount && s%hangulTCount == 0 && t > 0 && t < hangulTCount {
+		return a + t, true
+	}
+	c, ok := canonicalCompositions[[2]rune{a, b}]
+	return c, ok
+}
+
+// composeAfter returns the composite of prev and r if compose is set. prev is
+// -1 when nothing may compose with r.
+func composeAfter(prev, r rune, compose bool) (rune, bool) {
+	if !compose || prev < 0 {
+		return 0, false
+	}
+	return composePair(prev, r)
+}
+
+// composesWithAny reports whether r may compose with the character before
+// it.
+func composesWithAny(r rune) bool {
+	return composesWithPrevious[r] ||
+		r >= hangulVBase && r < hangulVBase+hangulVCount ||
+		r > hangulTBase && r < hangulTBase+hangulTCount
+}
+
+// rewrite returns content without the characters remove reports, which may be
+// nil, and with compose, with each character followed by one it composes with
+// replaced by their composite, as NFC does. That makes decomposed text, such
+// as NFD produces, NFC. Text NFC would change otherwise, by reordering
+// combining marks or replacing singletons, is left as is, and so is a pair
+// with a removed character between them. The map is nil if content is
+// unchanged.
+func rewrite(content string, remove func(rune) bool, compose bool) (string, *offsetMap) {
+	if !needsRewrite(content, remove, compose) {
+		return content, nil
+	}
+
+	m := &offsetMap{shift: []int{0}}
+	buf := make([]byte, 0, len(content))
+	// prev is the last character written, or -1 if nothing can compose
+	// with it. It starts at prevOut in buf and prevOrig in content, and
+	// composed is the index of the removal recording its composition, or -1.
+	prev, prevOut, prevOrig, composed := rune(-1), 0, 0, -1
+	for i := 0; i < len(content); {
+		r, size := utf8.DecodeRuneInString(content[i:])
+		if remove != nil && r >= utf8.RuneSelf && remove(r) {
+			m.add(len(buf), i, size)
+			prev = -1
+			i += size
+			continue
+		}
+		if c, ok := composeAfter(prev, r, compose); ok {
+			buf = utf8.AppendRune(buf[:prevOut], c)
+			saved := i + size - prevOrig - (len(buf) - prevOut)
+			if composed >= 0 {
+				m.at[composed] = len(buf) - 1
+				m.origAt[composed] = i + size - 1
+				m.shift[composed+1] = m.shift[composed] + saved
+			} else if saved > 0 {
+				m.add(len(buf)-1, i+size-1, saved)
+				composed = len(m.at) - 1
+			}
+			prev = c
+			i += size
+			continue
+		}
+		prev, prevOut, prevOrig, composed = r, len(buf), i, -1
+		if r == utf8.RuneError && size == 1 {
+			prev = -1
+		}
+		buf = append(buf, content[i:i+size]...)
+		i += size
+	}
+	return string(buf), m
+}
+
+// needsRewrite reports whether content has a character to remove or, with
+// compose, one that may compose with the character before it.
+func needsRewrite(content string, remove func(rune) bool, compose bool) bool {
+	for _, r := range content {
+		if r >= utf8.RuneSelf && (remove != nil && remove(r) || compose && composesWithAny(r)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+package emojiparser_test
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func newNFCParser(t *testing.T, opts ...emojiparser.Option) *emojiparser.DiscordEmojiParser {
+	t.Helper()
+	parser := newTestParser(t, append(opts, emojiparser.WithNormalizeNFC(true))...)
+	if _, err := parser.MergeAssets(&emojiparser.Assets{UnicodeEmojis: map[string]string{
+		"cafe_coffee":  "caf\u00E9\u2615",
+		"ganada_party": "\uAC00\U0001F389",
+		"ae_party":     "\u01E3\U0001F389",
+	}}); err != nil {
+		t.Fatalf("MergeAssets: %v", err)
+	}
+	return parser
+}
+
+func TestNormalizeNFC(t *testing.T) {
+	tests := []struct {
+		content  string
+		name     string
+		from, to int
+	}{
+		{"cafe\u0301\u2615", "cafe_coffee", 0, 9},
+		{"caf\u00E9\u2615", "cafe_coffee", 0, 8},
+		{"x e\u0301 cafe\u0301\u2615!", "cafe_coffee", 6, 15},
+		// Hangul jamo compose algorithmically.
+		{"\u1100\u1161\U0001F389", "ganada_party", 0, 10},
+		// U+01E3 is U+00E6 and a macron, and U+00E6 has no decomposition.
+		{"\u00E6\u0304\U0001F389", "ae_party", 0, 8},
+		{"\u01E3\U0001F389", "ae_party", 0, 6},
+		// Characters IgnoreZeroWidth removes keep a pair apart.
+		{"\u00E6\u200B\u0304\U0001F389", "tada", 7, 11},
+	}
+	parser := newNFCParser(t, emojiparser.WithIgnoreZeroWidth(true))
+	for _, tc := range tests {
+		results := parser.Parse(tc.content)
+		if len(results) != 1 || results[0].Name != tc.name || results[0].Position.From != tc.from || results[0].Position.To != tc.to {
+			t.Fatalf("Parse(%q) = %+v, want %s at [%d:%d)", tc.content, results, tc.name, tc.from, tc.to)
+		}
+	}
+	if results := parser.Parse("cafe\u0301\u2615"); results[0].Unicode != "caf\u00E9\u2615" {
+		t.Fatalf("Parse = %+v, want the composed key as Unicode", results)
+	}
+
+	plain := newTestParser(t)
+	if _, err := plain.MergeAssets(&emojiparser.Assets{UnicodeEmojis: map[string]string{"cafe_coffee": "caf\u00E9\u2615"}}); err != nil {
+		t.Fatalf("MergeAssets: %v", err)
+	}
+	if results := plain.Parse("cafe\u0301\u2615"); len(results) != 1 || results[0].Name != "coffee" {
+		t.Fatalf("Parse without NormalizeNFC = %+v, want only coffee", results)
+	}
+}
+
+func TestNormalizeNFCPositions(t *testing.T) {
+	fragments := []string{
+		"e", "\u0301", "\u0302", "\u0323", "cafe", "\u2615", "\U0001F389", "\U0001F604", ":smile:",
+		"\u1100", "\u1161", "\u11A8", "\u00E6", "\u0304", "<", "\u0338", "<:pepe:12345678901234567>",
+		"\u200B", " ", "\u00E9", "\xcc", "\U0001F44D", "\U0001F3FD",
+	}
+	parsers := map[string]*emojiparser.DiscordEmojiParser{
+		"nfc":            newNFCParser(t),
+		"nfc zero width": newNFCParser(t, emojiparser.WithIgnoreZeroWidth(true)),
+	}
+	rng := rand.New(rand.NewSource(3))
+	for range 2000 {
+		var b strings.Builder
+		for range rng.Intn(12) {
+			b.WriteString(fragments[rng.Intn(len(fragments))])
+		}
+		content := b.String()
+		for name, parser := range parsers {
+			results := parser.Parse(content)
+			for i, result := range results {
+				from, to := result.Position.From, result.Position.To
+				if from < 0 || from >= to || to > len(content) || i > 0 && from < results[i-1].Position.To {
+					t.Fatalf("%s Parse(%q): bad position %+v", name, content, result)
+				}
+				// The match is the whole of what was composed into it.
+				again := parser.Parse(content[from:to])
+				if len(again) != 1 || again[0].Unicode != result.Unicode || again[0].Position.To != to-from {
+					t.Fatalf("%s Parse(%q): %q at [%d:%d) parses alone as %+v", name, content, result.Unicode, from, to, again)
+				}
+			}
+		}
+	}
+}
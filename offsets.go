@@ -0,0 +1,58 @@
+package emojiparser
+
+import "sort"
+
+// OffsetSpan records that Len bytes starting at Original in a source string
+// were copied to Stripped in a derived string.
+type OffsetSpan struct {
+	Original int
+	Stripped int
+	Len      int
+}
+
+// OffsetMap describes how a derived string was produced from its source by
+// listing the kept spans in increasing order. Bytes not covered by any span
+// were removed.
+type OffsetMap []OffsetSpan
+
+// Translate maps a byte offset in the source string to the derived string.
+// ok is false when the byte at offset was removed.
+func (m OffsetMap) Translate(offset int) (int, bool) {
+	i := sort.Search(len(m), func(i int) bool {
+		return m[i].Original+m[i].Len > offset
+	})
+	if i == len(m) || offset < m[i].Original {
+		return 0, false
+	}
+	return m[i].Stripped + offset - m[i].Original, true
+}
+
+// TranslatePositions returns copies of results with positions rewritten into
+// the coordinates of a derived string. translate maps a byte offset in the
+// original content to the derived string and reports false for removed bytes;
+// OffsetMap.Translate has this signature. A result keeps the range between
+// its first and last surviving bytes, and results whose bytes were all
+// removed are dropped.
+func TranslatePositions(results []ParsedEmoji, translate func(offset int) (int, bool)) []ParsedEmoji {
+	translated := make([]ParsedEmoji, 0, len(results))
+	for _, result := range results {
+		from, to, kept := 0, 0, false
+		for offset := result.Position.From; offset < result.Position.To; offset++ {
+			mapped, ok := translate(offset)
+			if !ok {
+				continue
+			}
+			if !kept {
+				from = mapped
+				kept = true
+			}
+			to = mapped + 1
+		}
+		if !kept {
+			continue
+		}
+		result.Position = EmojiPosition{From: from, To: to}
+		translated = append(translated, result)
+	}
+	return translated
+}
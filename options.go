@@ -0,0 +1,421 @@
+package emojiparser
+
+// Options configures a DiscordEmojiParser. The zero value matches the
+// behavior of NewDiscordEmojiParser without options.
+type Options struct {
+	// StrictCollisions makes MergeAssets and RegisterShortcode fail with
+	// ErrCollision instead of overwriting an entry with a different value.
+	StrictCollisions bool
+
+	// ReportAliases adds a CollisionAlias entry to collision reports when a
+	// new name maps to an emoji that another name already maps to. Aliasing
+	// is legal and never an error, even with StrictCollisions.
+	ReportAliases bool
+
+	// ParallelFieldsThreshold is the combined size in bytes of the values
+	// passed to ParseFields or ParseFieldList at which fields are parsed
+	// concurrently. Zero or negative disables concurrent parsing.
+	ParallelFieldsThreshold int
+
+	// StrictSingleEmoji makes ValidateSingleEmoji reject surrounding
+	// whitespace instead of ignoring it.
+	StrictSingleEmoji bool
+
+	// Metrics enables the operation counters reported by Metrics and
+	// PublishExpvar.
+	Metrics bool
+
+	// TrimEmojiSpace makes TrimEmoji and its variants also remove whitespace
+	// adjacent to the emojis they trim.
+	TrimEmojiSpace bool
+
+	// StripSpace makes Strip collapse whitespace left doubled by a removed
+	// emoji, and drop whitespace it leaves at either end of the string.
+	StripSpace bool
+
+	// TruncateEllipsis is appended by Truncate and TruncateRunes to content
+	// they shorten, such as "…". It counts against their limit.
+	TruncateEllipsis string
+
+	// DemojizeCodePoints makes Demojize write emojis without a shortcode as
+	// their code points, as in :u1f9d1-200d-1f9b0:, instead of keeping them.
+	DemojizeCodePoints bool
+
+	// AnimatedNameSuffix is appended by ReplaceCustomWithNames to the names
+	// of animated custom emojis, such as " (animated)".
+	AnimatedNameSuffix string
+
+	// SeparateCustomNames makes ReplaceCustomWithNames put a space between
+	// the names of adjacent custom emoji tags.
+	SeparateCustomNames bool
+
+	// EscapeMarkdownText makes ToMarkdown escape Markdown syntax in the text
+	// around emojis.
+	EscapeMarkdownText bool
+
+	// AltTextPhrases replaces the English phrases AltText and DescribeAll
+	// build descriptions from, for localized alt text.
+	AltTextPhrases AltTextPhrases
+
+	// UnicodeLinkTemplate, when set, replaces the Discord asset link of
+	// unicode and text emojis. It may use the placeholders {codepoints}
+	// (dash-separated lowercase hex), {name}, and {ext} ("svg").
+	UnicodeLinkTemplate string
+
+	// CustomLinkTemplate, when set, replaces the Discord CDN link of custom
+	// emojis. It may use the placeholders {id}, {name}, {ext} ("gif" or
+	// "png"), {animated} ("true" or "false"), and {animated?A:B}, which
+	// expands to A for animated emojis and B otherwise.
+	CustomLinkTemplate string
+
+	// SkipTextPresentation leaves out unicode emojis followed by U+FE0E
+	// (VS15), with which the author asked for the character to be shown as
+	// text rather than as an emoji.
+	SkipTextPresentation bool
+
+	// ExcludeTextSymbols leaves out single characters that are shown as text
+	// by default, such as ⚧ or a bare © added with MergeAssets, unless they
+	// are followed by U+FE0F. The embedded tables already key ©, ®, ™, and
+	// most other such symbols with U+FE0F, so they never match bare unless
+	// MatchBareTextSymbols is set.
+	ExcludeTextSymbols bool
+
+	// MatchBareTextSymbols lets a single text-default character such as ✈,
+	// ❤, or © match the key that spells it with U+FE0F when the selector is
+	// missing. Keys otherwise match with or without their U+FE0F, but these
+	// symbols are common in prose, so by default they are only emojis with
+	// it. ExcludeTextSymbols takes precedence.
+	MatchBareTextSymbols bool
+
+	// IgnoreZeroWidth makes the parse methods skip over U+200B and U+FEFF,
+	// so that "🔥\u200b🔥" parses as two emojis and ":fi\u200bre:" as :fire:.
+	// Positions cover the characters skipped inside a match; names and
+	// Unicode values are those of the content without them. U+200D is not
+	// skipped, as it joins legitimate ZWJ sequences.
+	IgnoreZeroWidth bool
+
+	// AcceptFullwidthColons makes the text matcher accept U+FF1A, the colon
+	// CJK input methods emit, as either delimiter of a shortcode, so that
+	// "：smile：" and ":smile：" parse as :smile:. Positions cover the
+	// fullwidth colons. Discord itself does not accept them.
+	AcceptFullwidthColons bool
+
+	// MinSnowflakeDigits and MaxSnowflakeDigits bound the number of digits
+	// of custom emoji ids. Zero means the default of 17 and 20, the lengths
+	// of Discord snowflakes today. Markup with an id outside the bounds, or
+	// one that does not fit in 64 bits, is not a custom emoji.
+	MinSnowflakeDigits int
+	MaxSnowflakeDigits int
+
+	// StrictCustomNames rejects custom emoji markup whose name is not 2 to
+	// 32 characters long, as Discord requires. The rejected markup is left
+	// to the other matchers, so its :name: may still be a shortcode.
+	StrictCustomNames bool
+
+	// Escapes makes a backslash before a shortcode or custom emoji markup
+	// escape it, as in the Discord client: \:smile: and
+	// \<:pepe:12345678901234567> are not reported, nor is anything inside
+	// them. A backslash that is itself escaped, as in \\:smile:, does not.
+	Escapes bool
+
+	// SkipCodeSpans leaves out emojis inside inline code, `like this` or
+	// ``like this``, which Discord shows literally. A backtick without a
+	// matching one is literal text.
+	SkipCodeSpans bool
+
+	// SkipCodeBlocks leaves out emojis inside fenced code blocks, from a
+	// ``` anywhere in a line, language hint included, to the next ``` or,
+	// without one, to the end of the content.
+	SkipCodeBlocks bool
+
+	// SkipURLs leaves out emojis inside http and https URLs, such as the
+	// :id: of "https://example.com/:id:/edit" or the 🍕 of
+	// "https://example.com/🍕", and inside <https://...> links. A URL ends at
+	// whitespace or '<', less trailing punctuation and markdown delimiters.
+	SkipURLs bool
+
+	// SkipSpoilers leaves out emojis inside spoilers, ||like this 😈||,
+	// custom emojis included. Bars without a matching pair, or inside inline
+	// code, are literal text.
+	SkipSpoilers bool
+
+	// Markdown only parses the text Discord renders: it leaves out
+	// everything the Skip options above do, together with block quote
+	// markers and masked link destinations, [text](https://...). See
+	// MarkdownSkipRanges.
+	Markdown bool
+
+	// CaseInsensitiveShortcodes matches shortcodes regardless of ASCII case,
+	// so that :SMILE: and :Thinking: parse like :smile: and :thinking:.
+	// Results keep the name as written.
+	CaseInsensitiveShortcodes bool
+
+	// NormalizeNFC composes decomposed characters before matching, as NFC
+	// does, so that text from clients sending NFD matches keys written
+	// composed, such as "é" in assets merged with MergeAssets. Positions are
+	// those in the original content and cover every character composed into
+	// a match; Unicode values are composed. Text NFC would change otherwise,
+	// such as combining marks out of canonical order, is matched as is.
+	NormalizeNFC bool
+
+	// MaxInputBytes and MaxResults bound the work ParseContext does for
+	// untrusted input: it stops scanning after MaxInputBytes bytes of
+	// content, or once it has found MaxResults emojis, and reports the
+	// results so far with ErrTruncated. Zero means no limit. Other Parse
+	// methods ignore the limits.
+	MaxInputBytes int
+	MaxResults    int
+
+	// CaseInsensitiveNames makes ContainsName and ContainsAnyName compare
+	// names regardless of case.
+	CaseInsensitiveNames bool
+}
+
+// Option configures a parser created by NewDiscordEmojiParser.
+type Option func(*Options)
+
+// WithStrictCollisions makes entry collisions errors instead of silent
+// overwrites.
+func WithStrictCollisions(strict bool) Option {
+	return func(o *Options) {
+		o.StrictCollisions = strict
+	}
+}
+
+// WithAliasReporting includes aliasing names in collision reports.
+func WithAliasReporting(report bool) Option {
+	return func(o *Options) {
+		o.ReportAliases = report
+	}
+}
+
+// WithParallelFields parses fields concurrently once their combined size
+// reaches threshold bytes.
+func WithParallelFields(threshold int) Option {
+	return func(o *Options) {
+		o.ParallelFieldsThreshold = threshold
+	}
+}
+
+// WithStrictSingleEmoji makes ValidateSingleEmoji reject surrounding
+// whitespace.
+func WithStrictSingleEmoji(strict bool) Option {
+	return func(o *Options) {
+		o.StrictSingleEmoji = strict
+	}
+}
+
+// WithMetrics enables the parser's operation counters.
+func WithMetrics(enabled bool) Option {
+	return func(o *Options) {
+		o.Metrics = enabled
+	}
+}
+
+// WithTrimEmojiSpace makes TrimEmoji also remove whitespace next to the
+// trimmed emojis.
+func WithTrimEmojiSpace(trim bool) Option {
+	return func(o *Options) {
+		o.TrimEmojiSpace = trim
+	}
+}
+
+// WithUnicodeLinkTemplate sets the link template for unicode and text emojis,
+// for example "https://img.example.com/e/{codepoints}.webp?v=2".
+func WithUnicodeLinkTemplate(template string) Option {
+	return func(o *Options) {
+		o.UnicodeLinkTemplate = template
+	}
+}
+
+// WithCustomLinkTemplate sets the link template for custom emojis, for
+// example "https://img.example.com/c/{id}{animated?.gif:.png}".
+func WithCustomLinkTemplate(template string) Option {
+	return func(o *Options) {
+		o.CustomLinkTemplate = template
+	}
+}
+
+// WithSkipTextPresentation skips unicode emojis followed by U+FE0E.
+func WithSkipTextPresentation(skip bool) Option {
+	return func(o *Options) {
+		o.SkipTextPresentation = skip
+	}
+}
+
+// WithExcludeTextSymbols skips text-default symbols without U+FE0F.
+func WithExcludeTextSymbols(exclude bool) Option {
+	return func(o *Options) {
+		o.ExcludeTextSymbols = exclude
+	}
+}
+
+// WithMatchBareTextSymbols matches text-default symbols keyed with U+FE0F
+// without it.
+func WithMatchBareTextSymbols(match bool) Option {
+	return func(o *Options) {
+		o.MatchBareTextSymbols = match
+	}
+}
+
+// WithIgnoreZeroWidth skips U+200B and U+FEFF while matching.
+func WithIgnoreZeroWidth(ignore bool) Option {
+	return func(o *Options) {
+		o.IgnoreZeroWidth = ignore
+	}
+}
+
+// WithAcceptFullwidthColons accepts U+FF1A as a shortcode delimiter.
+func WithAcceptFullwidthColons(accept bool) Option {
+	return func(o *Options) {
+		o.AcceptFullwidthColons = accept
+	}
+}
+
+// WithSnowflakeDigits sets the number of digits custom emoji ids may have.
+func WithSnowflakeDigits(minDigits, maxDigits int) Option {
+	return func(o *Options) {
+		o.MinSnowflakeDigits = minDigits
+		o.MaxSnowflakeDigits = maxDigits
+	}
+}
+
+// WithStrictCustomNames only accepts custom emoji names of 2 to 32
+// characters.
+func WithStrictCustomNames(strict bool) Option {
+	return func(o *Options) {
+		o.StrictCustomNames = strict
+	}
+}
+
+// WithEscapes leaves out shortcodes and custom emojis escaped with a
+// backslash.
+func WithEscapes(escapes bool) Option {
+	return func(o *Options) {
+		o.Escapes = escapes
+	}
+}
+
+// WithSkipCodeSpans leaves out emojis inside inline code spans.
+func WithSkipCodeSpans(skip bool) Option {
+	return func(o *Options) {
+		o.SkipCodeSpans = skip
+	}
+}
+
+// WithSkipCodeBlocks leaves out emojis inside fenced code blocks.
+func WithSkipCodeBlocks(skip bool) Option {
+	return func(o *Options) {
+		o.SkipCodeBlocks = skip
+	}
+}
+
+// WithSkipURLs leaves out emojis inside URLs.
+func WithSkipURLs(skip bool) Option {
+	return func(o *Options) {
+		o.SkipURLs = skip
+	}
+}
+
+// WithSkipSpoilers leaves out emojis inside spoilers.
+func WithSkipSpoilers(skip bool) Option {
+	return func(o *Options) {
+		o.SkipSpoilers = skip
+	}
+}
+
+// WithMarkdown only parses the text Discord renders as markdown.
+func WithMarkdown(markdown bool) Option {
+	return func(o *Options) {
+		o.Markdown = markdown
+	}
+}
+
+// WithCaseInsensitiveShortcodes matches shortcodes regardless of ASCII case.
+func WithCaseInsensitiveShortcodes(insensitive bool) Option {
+	return func(o *Options) {
+		o.CaseInsensitiveShortcodes = insensitive
+	}
+}
+
+// WithNormalizeNFC composes decomposed characters before matching.
+func WithNormalizeNFC(normalize bool) Option {
+	return func(o *Options) {
+		o.NormalizeNFC = normalize
+	}
+}
+
+// WithMaxInputBytes bounds the bytes of content ParseContext scans.
+func WithMaxInputBytes(max int) Option {
+	return func(o *Options) {
+		o.MaxInputBytes = max
+	}
+}
+
+// WithMaxResults bounds the number of emojis ParseContext returns.
+func WithMaxResults(max int) Option {
+	return func(o *Options) {
+		o.MaxResults = max
+	}
+}
+
+// WithStripSpace makes Strip collapse the whitespace left around removed
+// emojis.
+func WithStripSpace(collapse bool) Option {
+	return func(o *Options) {
+		o.StripSpace = collapse
+	}
+}
+
+// WithCaseInsensitiveNames makes ContainsName ignore case.
+func WithCaseInsensitiveNames(insensitive bool) Option {
+	return func(o *Options) {
+		o.CaseInsensitiveNames = insensitive
+	}
+}
+
+// WithTruncateEllipsis makes Truncate mark shortened content with ellipsis.
+func WithTruncateEllipsis(ellipsis string) Option {
+	return func(o *Options) {
+		o.TruncateEllipsis = ellipsis
+	}
+}
+
+// WithDemojizeCodePoints makes Demojize write emojis without a shortcode as
+// code points.
+func WithDemojizeCodePoints(codePoints bool) Option {
+	return func(o *Options) {
+		o.DemojizeCodePoints = codePoints
+	}
+}
+
+// WithAnimatedNameSuffix makes ReplaceCustomWithNames mark animated emojis
+// with suffix.
+func WithAnimatedNameSuffix(suffix string) Option {
+	return func(o *Options) {
+		o.AnimatedNameSuffix = suffix
+	}
+}
+
+// WithSeparateCustomNames makes ReplaceCustomWithNames separate the names of
+// adjacent tags with a space.
+func WithSeparateCustomNames(separate bool) Option {
+	return func(o *Options) {
+		o.SeparateCustomNames = separate
+	}
+}
+
+// WithEscapeMarkdownText makes ToMarkdown escape the text around emojis.
+func WithEscapeMarkdownText(escape bool) Option {
+	return func(o *Options) {
+		o.EscapeMarkdownText = escape
+	}
+}
+
+// WithAltTextPhrases makes AltText and DescribeAll describe emojis with
+// phrases instead of the English defaults.
+func WithAltTextPhrases(phrases AltTextPhrases) Option {
+	return func(o *Options) {
+		o.AltTextPhrases = phrases
+	}
+}
@@ -0,0 +1,64 @@
+package emojiparser
+
+// ResultOrder selects how ParseWithOptions orders its results.
+type ResultOrder int
+
+const (
+	// OrderPosition sorts results by their position in the content. It is
+	// the order Parse uses. Results never overlap, so no two start at the
+	// same offset.
+	OrderPosition ResultOrder = iota
+	// OrderTypeThenPosition groups results by type in the fixed order
+	// custom, text, unicode, and sorts each group by position.
+	OrderTypeThenPosition
+	// OrderScan groups results in the order the separate per-type passes
+	// of earlier versions reported them: all custom emojis, then unicode,
+	// then text, each sorted by position.
+	OrderScan
+)
+
+// ParseOptions controls a single ParseWithOptions call. The zero value
+// behaves like Parse.
+type ParseOptions struct {
+	OrderBy ResultOrder
+	// Limit caps the number of results after ordering. Zero or negative
+	// means no limit.
+	Limit int
+}
+
+// ParseWithOptions parses content using the default parser.
+func ParseWithOptions(content string, opts ParseOptions) []ParsedEmoji {
+	return defaultParser.ParseWithOptions(content, opts)
+}
+
+// ParseWithOptions parses all emoji types from content, orders the results
+// as opts.OrderBy selects, and then applies opts.Limit, so a limited result
+// is always a prefix of the unlimited one.
+func (p *DiscordEmojiParser) ParseWithOptions(content string, opts ParseOptions) []ParsedEmoji {
+	all := p.parseAll(content)
+	switch opts.OrderBy {
+	case OrderScan:
+		all = groupByType(all, EmojiTypeCustom, EmojiTypeUnicode, EmojiTypeText)
+	case OrderTypeThenPosition:
+		all = groupByType(all, EmojiTypeCustom, EmojiTypeText, EmojiTypeUnicode)
+	}
+
+	if opts.Limit > 0 && len(all) > opts.Limit {
+		all = all[:opts.Limit]
+	}
+	return all
+}
+
+// groupByType returns the results of each type in turn, keeping the order
+// of results within a type.
+func groupByType(results []ParsedEmoji, order ...EmojiType) []ParsedEmoji {
+	grouped := make([]ParsedEmoji, 0, len(results))
+	for _, kind := range order {
+		for _, result := range results {
+			if result.Type == kind {
+				grouped = append(grouped, result)
+			}
+		}
+	}
+	return grouped
+}
@@ -0,0 +1,100 @@
+package emojiparser_test
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+// orderContent mixes the three types so that each ordering differs.
+const orderContent = ":tada: 😄 <:pepe:12345678901234567> :smile: 🎉 <a:wave:12345678901234567>"
+
+func resultNames(results []emojiparser.ParsedEmoji) []string {
+	out := make([]string, len(results))
+	for i, result := range results {
+		out[i] = string(result.Type[0]) + ":" + result.Name
+	}
+	return out
+}
+
+func equalNames(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestParseWithOptionsOrdering(t *testing.T) {
+	cases := map[emojiparser.ResultOrder][]string{
+		emojiparser.OrderPosition: {
+			"t:tada", "u:smile", "c:pepe", "t:smile", "u:tada", "c:wave",
+		},
+		emojiparser.OrderTypeThenPosition: {
+			"c:pepe", "c:wave", "t:tada", "t:smile", "u:smile", "u:tada",
+		},
+		emojiparser.OrderScan: {
+			"c:pepe", "c:wave", "u:smile", "u:tada", "t:tada", "t:smile",
+		},
+	}
+	for order, want := range cases {
+		got := resultNames(emojiparser.ParseWithOptions(orderContent, emojiparser.ParseOptions{OrderBy: order}))
+		if !equalNames(got, want) {
+			t.Fatalf("order %d: expected %v, got %v", order, want, got)
+		}
+	}
+
+	if got, want := resultNames(emojiparser.Parse(orderContent)), cases[emojiparser.OrderPosition]; !equalNames(got, want) {
+		t.Fatalf("Parse: expected position order %v, got %v", want, got)
+	}
+}
+
+func TestParseWithOptionsLimitAfterOrdering(t *testing.T) {
+	results := emojiparser.ParseWithOptions(orderContent, emojiparser.ParseOptions{
+		OrderBy: emojiparser.OrderTypeThenPosition,
+		Limit:   3,
+	})
+	if got, want := resultNames(results), []string{"c:pepe", "c:wave", "t:tada"}; !equalNames(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	if got := emojiparser.ParseWithOptions(orderContent, emojiparser.ParseOptions{Limit: 100}); len(got) != 6 {
+		t.Fatalf("expected a large limit to return everything, got %d", len(got))
+	}
+}
+
+// sortedParse is the implementation Parse used before merging pre-sorted
+// passes: concatenate all passes and sort by start offset.
+func sortedParse(content string) []emojiparser.ParsedEmoji {
+	custom := emojiparser.ParseDiscordCustom(content)
+	all := append(append(emojiparser.ParseUnicode(content, custom), emojiparser.ParseTextRepresentation(content, custom)...), custom...)
+	sort.SliceStable(all, func(i, j int) bool {
+		return all[i].Position.From < all[j].Position.From
+	})
+	return all
+}
+
+func TestParseOrderMatchesSort(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for i := range 50 {
+		content := randomMessage(rng, 300)
+		if got, want := emojiparser.Parse(content), sortedParse(content); !reflect.DeepEqual(got, want) {
+			t.Fatalf("input %d %q: merged order differs from sorted order", i, content)
+		}
+	}
+}
+
+func BenchmarkParseDense(b *testing.B) {
+	content := strings.Repeat("😄:tada:<:pepe:12345678901234567>🎉 ", 125)
+	b.ReportAllocs()
+	for b.Loop() {
+		emojiparser.Parse(content)
+	}
+}
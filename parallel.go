@@ -0,0 +1,82 @@
+package emojiparser
+
+import (
+	"strings"
+	"sync"
+)
+
+// asciiSpace lists the bytes ParseParallel may split content at.
+const asciiSpace = " \t\n\r\f\v"
+
+// ParseParallel parses content in chunks concurrently using the default parser.
+func ParseParallel(content string, chunks int) []ParsedEmoji {
+	return defaultParser.ParseParallel(content, chunks)
+}
+
+// ParseParallel splits content into up to chunks pieces, parses them
+// concurrently, and merges the results in position order. The output is
+// identical to Parse(content).
+//
+// Chunks are only split at ASCII whitespace: no emoji contains it, and every
+// matcher starts afresh after it, so no match can straddle a split. Content
+// without whitespace near the split points yields fewer, larger chunks. Code
+// spans, code blocks, and spoilers do contain whitespace, so with any option
+// that skips markup, and with tables whose keys contain whitespace, content
+// is parsed in one piece.
+func (p *DiscordEmojiParser) ParseParallel(content string, chunks int) []ParsedEmoji {
+	if chunks <= 1 || p.state.Load().keyHasSpace || p.opts.markupKinds() != 0 {
+		return p.Parse(content)
+	}
+
+	bounds := splitAtSpace(content, chunks)
+	if len(bounds) == 2 {
+		return p.Parse(content)
+	}
+
+	parts := make([][]ParsedEmoji, len(bounds)-1)
+	var wg sync.WaitGroup
+	for i := range parts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			from, to := bounds[i], bounds[i+1]
+			results := p.Parse(content[from:to])
+			for j := range results {
+				results[j].Position.From += from
+				results[j].Position.To += from
+			}
+			parts[i] = results
+		}()
+	}
+	wg.Wait()
+
+	total := 0
+	for _, part := range parts {
+		total += len(part)
+	}
+	merged := make([]ParsedEmoji, 0, total)
+	for _, part := range parts {
+		merged = append(merged, part...)
+	}
+	return merged
+}
+
+// splitAtSpace returns increasing chunk boundaries, starting at 0 and ending
+// at len(content), with each inner boundary placed on an ASCII whitespace
+// byte at or after an even split point.
+func splitAtSpace(content string, chunks int) []int {
+	bounds := []int{0}
+	size := len(content) / chunks
+	for i := 1; i < chunks; i++ {
+		target := max(i*size, bounds[len(bounds)-1]+1)
+		if target >= len(content) {
+			break
+		}
+		space := strings.IndexAny(content[target:], asciiSpace)
+		if space < 0 {
+			break
+		}
+		bounds = append(bounds, target+space)
+	}
+	return append(bounds, len(content))
+}
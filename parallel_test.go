@@ -0,0 +1,77 @@
+package emojiparser_test
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+// randomMessage builds content of roughly size bytes from fragments that
+// exercise all three emoji types, separators, and near-misses.
+func randomMessage(rng *rand.Rand, size int) string {
+	fragments := []string{
+		"hello", "world", " ", " ", "\n", ":", "::", ":smile:", ":tada:", ":not_real:",
+		"😄", "🎉", "👨‍👩‍👧‍👦", "🇺🇸", "1️⃣", "❤️", "<:pepe:12345678901234567>",
+		"<a:wave:12345678901234567>", "<:broken:12>", "<", ">", "a:b:smile:", "piñata",
+	}
+	var builder strings.Builder
+	for builder.Len() < size {
+		builder.WriteString(fragments[rng.Intn(len(fragments))])
+	}
+	return builder.String()
+}
+
+func TestParseParallelMatchesParse(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := range 5 {
+		content := randomMessage(rng, 4000)
+		want := emojiparser.Parse(content)
+		for _, chunks := range []int{0, 1, 2, 3, 8} {
+			got := emojiparser.ParseParallel(content, chunks)
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("input %d, %d chunks: results differ from Parse", i, chunks)
+			}
+		}
+	}
+}
+
+func TestParseParallelWithoutWhitespace(t *testing.T) {
+	content := strings.Repeat("😄:smile:", 50)
+	if got, want := emojiparser.ParseParallel(content, 4), emojiparser.Parse(content); !reflect.DeepEqual(got, want) {
+		t.Fatalf("results differ from Parse")
+	}
+}
+
+func BenchmarkParseParallel(b *testing.B) {
+	content := randomMessage(rand.New(rand.NewSource(1)), 32<<10)
+	for _, chunks := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("chunks=%d", chunks), func(b *testing.B) {
+			for b.Loop() {
+				emojiparser.ParseParallel(content, chunks)
+			}
+		})
+	}
+}
+
+func TestParseParallelMarkupMatchesParse(t *testing.T) {
+	content := strings.Repeat("a `code :smile: span` b ||spoiler 😄 here|| c\n```\nfenced :tada: block\n```\n:smile: ", 40)
+	options := map[string]emojiparser.Option{
+		"markdown":    emojiparser.WithMarkdown(true),
+		"code spans":  emojiparser.WithSkipCodeSpans(true),
+		"code blocks": emojiparser.WithSkipCodeBlocks(true),
+		"spoilers":    emojiparser.WithSkipSpoilers(true),
+	}
+	for name, option := range options {
+		parser := newTestParser(t, option)
+		want := parser.Parse(content)
+		for _, chunks := range []int{2, 3, 8} {
+			if got := parser.ParseParallel(content, chunks); !reflect.DeepEqual(got, want) {
+				t.Fatalf("%s, %d chunks: %d results, Parse has %d", name, chunks, len(got), len(want))
+			}
+		}
+	}
+}
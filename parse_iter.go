@@ -0,0 +1,56 @@
+//go:build go1.23
+
+package emojiparser
+
+import "iter"
+
+// All iterates over the emojis in content using the default parser.
+func All(content string) iter.Seq[ParsedEmoji] {
+	return defaultParser.All(content)
+}
+
+// AllIndexed iterates over the emojis in content and their indexes using the
+// default parser.
+func AllIndexed(content string) iter.Seq2[int, ParsedEmoji] {
+	return defaultParser.AllIndexed(content)
+}
+
+// All iterates over the emojis Parse would return for content, in position
+// order. Content is scanned as the iteration goes, so breaking out of the
+// loop early stops the scan and no result slice is ever allocated. Each
+// iteration scans content again.
+func (p *DiscordEmojiParser) All(content string) iter.Seq[ParsedEmoji] {
+	return func(yield func(ParsedEmoji) bool) {
+		p.emojis(content, func(_ int, emoji ParsedEmoji) bool {
+			return yield(emoji)
+		})
+	}
+}
+
+// AllIndexed is All yielding each emoji with its index in Parse's results.
+func (p *DiscordEmojiParser) AllIndexed(content string) iter.Seq2[int, ParsedEmoji] {
+	return func(yield func(int, ParsedEmoji) bool) {
+		p.emojis(content, yield)
+	}
+}
+
+// emojis calls yield for each emoji in content until yield returns false.
+func (p *DiscordEmojiParser) emojis(content string, yield func(int, ParsedEmoji) bool) {
+	if !p.beginParse(content) {
+		return
+	}
+
+	state := p.state.Load()
+	scanned, m := p.rewriteContent(content)
+	var counts tokenCounts
+	p.scan(state, scanned, scanAll, nil, func(t token) bool {
+		emoji := p.emojiFor(state, scanned, t)
+		if m != nil {
+			emoji.Position = m.position(emoji.Position)
+		}
+		i := counts.custom + counts.unicode + counts.text
+		counts.add(t.kind)
+		return yield(i, emoji)
+	})
+	p.countTokens(counts)
+}
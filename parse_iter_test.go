@@ -0,0 +1,41 @@
+//go:build go1.23
+
+package emojiparser_test
+
+import (
+	"reflect"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestAll(t *testing.T) {
+	for _, content := range countInputs {
+		var got []emojiparser.ParsedEmoji
+		for emoji := range emojiparser.All(content) {
+			got = append(got, emoji)
+		}
+		want := emojiparser.Parse(content)
+		if len(got) != len(want) || len(want) > 0 && !reflect.DeepEqual(got, want) {
+			t.Fatalf("All(%q) = %v, want %v", content, got, want)
+		}
+	}
+}
+
+func TestAllIndexedBreak(t *testing.T) {
+	content := "😄 :tada: <:pepe:12345678901234567> 🔥"
+	want := emojiparser.Parse(content)
+	n := 0
+	for i, emoji := range emojiparser.AllIndexed(content) {
+		if i != n || !reflect.DeepEqual(emoji, want[i]) {
+			t.Fatalf("AllIndexed yielded %d, %v, want %d, %v", i, emoji, n, want[n])
+		}
+		n++
+		if i == 1 {
+			break
+		}
+	}
+	if n != 2 {
+		t.Fatalf("AllIndexed yielded %d emojis before the break, want 2", n)
+	}
+}
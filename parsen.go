@@ -0,0 +1,34 @@
+package emojiparser
+
+// ParseN parses at most n emojis from content using the default parser.
+func ParseN(content string, n int) []ParsedEmoji {
+	return defaultParser.ParseN(content, n)
+}
+
+// ParseN returns the first n results of p.Parse(content), or all of them if
+// n <= 0. Scanning stops once n emojis are found, so an emote wall costs no
+// more than its first n emojis. The scan finds custom emoji markup before
+// anything inside it, so the results are always a prefix of Parse's.
+func (p *DiscordEmojiParser) ParseN(content string, n int) []ParsedEmoji {
+	if n <= 0 {
+		return p.Parse(content)
+	}
+	if !p.beginParse(content) {
+		return []ParsedEmoji{}
+	}
+
+	state := p.state.Load()
+	scanned, m := p.rewriteContent(content)
+	results := make([]ParsedEmoji, 0, min(n, 16))
+	var counts tokenCounts
+	p.scan(state, scanned, scanAll, nil, func(t token) bool {
+		counts.add(t.kind)
+		results = append(results, p.emojiFor(state, scanned, t))
+		return len(results) < n
+	})
+	p.countTokens(counts)
+	if m != nil {
+		return m.restore(results)
+	}
+	return results
+}
@@ -0,0 +1,40 @@
+package emojiparser_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestParseN(t *testing.T) {
+	for _, content := range countInputs {
+		all := emojiparser.Parse(content)
+		for n := -1; n <= len(all)+1; n++ {
+			want := all
+			if n > 0 && n < len(all) {
+				want = all[:n]
+			}
+			if got := emojiparser.ParseN(content, n); !reflect.DeepEqual(got, want) {
+				t.Fatalf("ParseN(%q, %d) = %v, want %v", content, n, got, want)
+			}
+		}
+	}
+}
+
+func TestParseNCustomFirst(t *testing.T) {
+	content := "<:grinning:12345678901234567> " + strings.Repeat("😄", 5) + " <a:wave:12345678901234567>"
+	got := emojiparser.ParseN(content, 3)
+	if len(got) != 3 {
+		t.Fatalf("ParseN returned %d results, want 3", len(got))
+	}
+	if got[0].Type != emojiparser.EmojiTypeCustom || got[0].Name != "grinning" {
+		t.Fatalf("first result = %v, want the custom emoji", got[0])
+	}
+	for _, emoji := range got[1:] {
+		if emoji.Type != emojiparser.EmojiTypeUnicode || emoji.Position.From < got[0].Position.To {
+			t.Fatalf("result %v, want a unicode emoji after the custom one", emoji)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package emojiparser
+
+import "slices"
+
+// Positions returns the positions of all emojis in content using the default
+// parser.
+func Positions(content string) []EmojiPosition {
+	return defaultParser.Positions(content)
+}
+
+// ParseIndices returns the positions of emojis in content using the default
+// parser.
+func ParseIndices(content string, types ...EmojiType) []EmojiPosition {
+	return defaultParser.ParseIndices(content, types...)
+}
+
+// Positions returns the positions of all emojis in content, sorted and
+// non-overlapping. The positions are exactly those of Parse's results, but
+// names, code points, and links are never built, which makes it the cheaper
+// choice for redaction and masking.
+func (p *DiscordEmojiParser) Positions(content string) []EmojiPosition {
+	return p.ParseIndices(content)
+}
+
+// ParseIndices is Positions restricted to the given emoji types, or all types
+// if none are given. The positions are those of Parse's results of these
+// types: a shortcode inside custom emoji markup is still part of the markup,
+// even when custom emojis are left out.
+func (p *DiscordEmojiParser) ParseIndices(content string, types ...EmojiType) []EmojiPosition {
+	if !p.beginParse(content) {
+		return []EmojiPosition{}
+	}
+
+	state := p.state.Load()
+	if stripped, m := p.rewriteContent(content); m != nil {
+		return m.restorePositions(p.positions(state, stripped, types))
+	}
+	return p.positions(state, content, types)
+}
+
+func (p *DiscordEmojiParser) positions(state *parserState, content string, types []EmojiType) []EmojiPosition {
+	positions := make([]EmojiPosition, 0)
+	var counts tokenCounts
+	p.scan(state, content, scanAll, nil, func(t token) bool {
+		if len(types) > 0 && !slices.Contains(types, t.kind) {
+			return true
+		}
+		counts.add(t.kind)
+		positions = append(positions, EmojiPosition{From: t.from, To: t.to})
+		return true
+	})
+	p.countTokens(counts)
+	return positions
+}
@@ -0,0 +1,117 @@
+package emojiparser_test
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func parsePositions(results []emojiparser.ParsedEmoji) []emojiparser.EmojiPosition {
+	positions := make([]emojiparser.EmojiPosition, len(results))
+	for i, result := range results {
+		positions[i] = result.Position
+	}
+	return positions
+}
+
+func TestPositionsMatchParse(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	inputs := []string{"", "plain text", "<:pepe:12345678901234567>:smile:😄", ":tada:🎉<a:wave:12345678901234567>"}
+	for range 20 {
+		inputs = append(inputs, randomMessage(rng, 2000))
+	}
+
+	for i, content := range inputs {
+		got := emojiparser.Positions(content)
+		want := parsePositions(emojiparser.Parse(content))
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("input %d: Positions = %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestPositionsSortedAndDisjoint(t *testing.T) {
+	content := randomMessage(rand.New(rand.NewSource(3)), 4000)
+	positions := emojiparser.Positions(content)
+	if len(positions) == 0 {
+		t.Fatalf("expected emojis in %q", content)
+	}
+	for i := 1; i < len(positions); i++ {
+		if positions[i].From < positions[i-1].To {
+			t.Fatalf("positions %v and %v overlap or are out of order", positions[i-1], positions[i])
+		}
+	}
+}
+
+func TestPositionsRespectsOptions(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser(emojiparser.WithSkipTextPresentation(true))
+	if err != nil {
+		t.Fatalf("NewDiscordEmojiParser: %v", err)
+	}
+	content := "❤︎ ❤️"
+	got := parser.Positions(content)
+	want := parsePositions(parser.Parse(content))
+	if !reflect.DeepEqual(got, want) || len(got) != 1 {
+		t.Fatalf("Positions = %v, want %v", got, want)
+	}
+}
+
+func BenchmarkPositionsDense(b *testing.B) {
+	content := strings.Repeat("😄:tada:<:pepe:12345678901234567>🎉 ", 125)
+	b.ReportAllocs()
+	for b.Loop() {
+		emojiparser.Positions(content)
+	}
+}
+
+func TestParseIndicesByType(t *testing.T) {
+	rng := rand.New(rand.NewSource(4))
+	inputs := []string{"<:smile:12345678901234567> :smile: 😄", randomMessage(rng, 2000), randomMessage(rng, 2000)}
+	typeSets := [][]emojiparser.EmojiType{
+		nil,
+		{emojiparser.EmojiTypeText},
+		{emojiparser.EmojiTypeUnicode},
+		{emojiparser.EmojiTypeCustom, emojiparser.EmojiTypeText},
+	}
+	for i, content := range inputs {
+		for _, types := range typeSets {
+			var want []emojiparser.EmojiPosition
+			for _, result := range emojiparser.Parse(content) {
+				if len(types) == 0 || slices.Contains(types, result.Type) {
+					want = append(want, result.Position)
+				}
+			}
+			got := emojiparser.ParseIndices(content, types...)
+			if len(got) != len(want) || len(want) > 0 && !reflect.DeepEqual(got, want) {
+				t.Fatalf("input %d: ParseIndices(%v) = %v, want %v", i, types, got, want)
+			}
+		}
+	}
+
+	// The shortcode inside the markup belongs to the custom emoji.
+	if got := emojiparser.ParseIndices("<:smile:12345678901234567>", emojiparser.EmojiTypeText); len(got) != 0 {
+		t.Fatalf("ParseIndices found shortcodes inside custom markup: %v", got)
+	}
+}
+
+func BenchmarkParseIndicesVsParse(b *testing.B) {
+	content := randomMessage(rand.New(rand.NewSource(5)), 1<<20)
+	b.Run("Parse", func(b *testing.B) {
+		b.SetBytes(int64(len(content)))
+		b.ReportAllocs()
+		for b.Loop() {
+			emojiparser.Parse(content)
+		}
+	})
+	b.Run("ParseIndices", func(b *testing.B) {
+		b.SetBytes(int64(len(content)))
+		b.ReportAllocs()
+		for b.Loop() {
+			emojiparser.ParseIndices(content)
+		}
+	})
+}
@@ -0,0 +1,97 @@
+package emojiparser
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// variationSelector15 is U+FE0E, which requests text presentation.
+const variationSelector15 = "\uFE0E"
+
+// Presentation is the way an emoji was asked to be displayed.
+type Presentation uint8
+
+const (
+	// PresentationDefault means no variation selector was given, so the
+	// character's default presentation applies.
+	PresentationDefault Presentation = iota
+	// PresentationEmoji means U+FE0F (VS16) requested emoji presentation.
+	PresentationEmoji
+	// PresentationText means U+FE0E (VS15) requested text presentation.
+	PresentationText
+)
+
+// String returns "default", "emoji", or "text".
+func (p Presentation) String() string {
+	switch p {
+	case PresentationEmoji:
+		return "emoji"
+	case PresentationText:
+		return "text"
+	default:
+		return "default"
+	}
+}
+
+// EffectivePresentation resolves PresentationDefault to the default
+// presentation of the emoji's first character: text for characters such as
+// ⚧ and ☺ that only become emojis with U+FE0F, emoji otherwise. Text and
+// custom emojis are always displayed as emojis.
+func (e ParsedEmoji) EffectivePresentation() Presentation {
+	if e.Presentation != PresentationDefault {
+		return e.Presentation
+	}
+	if e.Type != EmojiTypeUnicode {
+		return PresentationEmoji
+	}
+	if first, _ := utf8.DecodeRuneInString(e.Unicode); isDefaultText(first) {
+		return PresentationText
+	}
+	return PresentationEmoji
+}
+
+// isDefaultText reports whether r is shown as text unless followed by U+FE0F.
+func isDefaultText(r rune) bool {
+	return r >= utf8.RuneSelf && !unicode.Is(emojiPresentation, r)
+}
+
+// isTextSymbol reports whether match is a single character that is shown as
+// text by default.
+func isTextSymbol(match string) bool {
+	r, size := utf8.DecodeRuneInString(match)
+	return size == len(match) && isDefaultText(r)
+}
+
+// leavesOut reports whether the options leave out a unicode match of key
+// with the given presentation. A text-default symbol that the tables key
+// with U+FE0F, such as © or ✈, is only matched without it when the
+// MatchBareTextSymbols option is set, so that it is not found in prose.
+func (p *DiscordEmojiParser) leavesOut(state *parserState, match, key string, presentation Presentation) bool {
+	switch {
+	case presentation == PresentationText && p.opts.SkipTextPresentation:
+		return true
+	case presentation == PresentationEmoji || !isTextSymbol(match):
+		return false
+	case p.opts.ExcludeTextSymbols:
+		return true
+	case p.opts.MatchBareTextSymbols || match == key:
+		return false
+	default:
+		_, bare := state.unicodeToName[match]
+		return !bare
+	}
+}
+
+// presentationOf returns the presentation requested for a unicode match
+// followed by rest.
+func presentationOf(match, rest string) Presentation {
+	switch {
+	case strings.Contains(match, variationSelector16) || strings.HasPrefix(rest, variationSelector16):
+		return PresentationEmoji
+	case strings.HasPrefix(rest, variationSelector15):
+		return PresentationText
+	default:
+		return PresentationDefault
+	}
+}
@@ -0,0 +1,144 @@
+// Code generated by presentationgen; DO NOT EDIT.
+
+package emojiparser
+
+import "unicode"
+
+// emojiPresentation holds the code points with the Emoji_Presentation
+// property in Unicode 15.0, which are shown as emojis without U+FE0F.
+var emojiPresentation = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x231a, Hi: 0x231b, Stride: 1},
+		{Lo: 0x23e9, Hi: 0x23ec, Stride: 1},
+		{Lo: 0x23f0, Hi: 0x23f0, Stride: 1},
+		{Lo: 0x23f3, Hi: 0x23f3, Stride: 1},
+		{Lo: 0x25fd, Hi: 0x25fe, Stride: 1},
+		{Lo: 0x2614, Hi: 0x2615, Stride: 1},
+		{Lo: 0x2648, Hi: 0x2653, Stride: 1},
+		{Lo: 0x267f, Hi: 0x267f, Stride: 1},
+		{Lo: 0x2693, Hi: 0x2693, Stride: 1},
+		{Lo: 0x26a1, Hi: 0x26a1, Stride: 1},
+		{Lo: 0x26aa, Hi: 0x26ab, Stride: 1},
+		{Lo: 0x26bd, Hi: 0x26be, Stride: 1},
+		{Lo: 0x26c4, Hi: 0x26c5, Stride: 1},
+		{Lo: 0x26ce, Hi: 0x26ce, Stride: 1},
+		{Lo: 0x26d4, Hi: 0x26d4, Stride: 1},
+		{Lo: 0x26ea, Hi: 0x26ea, Stride: 1},
+		{Lo: 0x26f2, Hi: 0x26f3, Stride: 1},
+		{Lo: 0x26f5, Hi: 0x26f5, Stride: 1},
+		{Lo: 0x26fa, Hi: 0x26fa, Stride: 1},
+		{Lo: 0x26fd, Hi: 0x26fd, Stride: 1},
+		{Lo: 0x2705, Hi: 0x2705, Stride: 1},
+		{Lo: 0x270a, Hi: 0x270b, Stride: 1},
+		{Lo: 0x2728, Hi: 0x2728, Stride: 1},
+		{Lo: 0x274c, Hi: 0x274c, Stride: 1},
+		{Lo: 0x274e, Hi: 0x274e, Stride: 1},
+		{Lo: 0x2753, Hi: 0x2755, Stride: 1},
+		{Lo: 0x2757, Hi: 0x2757, Stride: 1},
+		{Lo: 0x2795, Hi: 0x2797, Stride: 1},
+		{Lo: 0x27b0, Hi: 0x27b0, Stride: 1},
+		{Lo: 0x27bf, Hi: 0x27bf, Stride: 1},
+		{Lo: 0x2b1b, Hi: 0x2b1c, Stride: 1},
+		{Lo: 0x2b50, Hi: 0x2b50, Stride: 1},
+		{Lo: 0x2b55, Hi: 0x2b55, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f004, Hi: 0x1f004, Stride: 1},
+		{Lo: 0x1f0cf, Hi: 0x1f0cf, Stride: 1},
+		{Lo: 0x1f18e, Hi: 0x1f18e, Stride: 1},
+		{Lo: 0x1f191, Hi: 0x1f19a, Stride: 1},
+		{Lo: 0x1f1e6, Hi: 0x1f1ff, Stride: 1},
+		{Lo: 0x1f201, Hi: 0x1f201, Stride: 1},
+		{Lo: 0x1f21a, Hi: 0x1f21a, Stride: 1},
+		{Lo: 0x1f22f, Hi: 0x1f22f, Stride: 1},
+		{Lo: 0x1f232, Hi: 0x1f236, Stride: 1},
+		{Lo: 0x1f238, Hi: 0x1f23a, Stride: 1},
+		{Lo: 0x1f250, Hi: 0x1f251, Stride: 1},
+		{Lo: 0x1f300, Hi: 0x1f320, Stride: 1},
+		{Lo: 0x1f32d, Hi: 0x1f335, Stride: 1},
+		{Lo: 0x1f337, Hi: 0x1f37c, Stride: 1},
+		{Lo: 0x1f37e, Hi: 0x1f393, Stride: 1},
+		{Lo: 0x1f3a0, Hi: 0x1f3ca, Stride: 1},
+		{Lo: 0x1f3cf, Hi: 0x1f3d3, Stride: 1},
+		{Lo: 0x1f3e0, Hi: 0x1f3f0, Stride: 1},
+		{Lo: 0x1f3f4, Hi: 0x1f3f4, Stride: 1},
+		{Lo: 0x1f3f8, Hi: 0x1f43e, Stride: 1},
+		{Lo: 0x1f440, Hi: 0x1f440, Stride: 1},
+		{Lo: 0x1f442, Hi: 0x1f4fc, Stride: 1},
+		{Lo: 0x1f4ff, Hi: 0x1f53d, Stride: 1},
+		{Lo: 0x1f54b, Hi: 0x1f54e, Stride: 1},
+		{Lo: 0x1f550, Hi: 0x1f567, Stride: 1},
+		{Lo: 0x1f57a, Hi: 0x1f57a, Stride: 1},
+		{Lo: 0x1f595, Hi: 0x1f596, Stride: 1},
+		{Lo: 0x1f5a4, Hi: 0x1f5a4, Stride: 1},
+		{Lo: 0x1f5fb, Hi: 0x1f64f, Stride: 1},
+		{Lo: 0x1f680, Hi: 0x1f6c5, Stride: 1},
+		{Lo: 0x1f6cc, Hi: 0x1f6cc, Stride: 1},
+		{Lo: 0x1f6d0, Hi: 0x1f6d2, Stride: 1},
+		{Lo: 0x1f6d5, Hi: 0x1f6d7, Stride: 1},
+		{Lo: 0x1f6dc, Hi: 0x1f6df, Stride: 1},
+		{Lo: 0x1f6eb, Hi: 0x1f6ec, Stride: 1},
+		{Lo: 0x1f6f4, Hi: 0x1f6fc, Stride: 1},
+		{Lo: 0x1f7e0, Hi: 0x1f7eb, Stride: 1},
+		{Lo: 0x1f7f0, Hi: 0x1f7f0, Stride: 1},
+		{Lo: 0x1f90c, Hi: 0x1f93a, Stride: 1},
+		{Lo: 0x1f93c, Hi: 0x1f945, Stride: 1},
+		{Lo: 0x1f947, Hi: 0x1f9ff, Stride: 1},
+		{Lo: 0x1fa70, Hi: 0x1fa7c, Stride: 1},
+		{Lo: 0x1fa80, Hi: 0x1fa88, Stride: 1},
+		{Lo: 0x1fa90, Hi: 0x1fabd, Stride: 1},
+		{Lo: 0x1fabf, Hi: 0x1fac5, Stride: 1},
+		{Lo: 0x1face, Hi: 0x1fadb, Stride: 1},
+		{Lo: 0x1fae0, Hi: 0x1fae8, Stride: 1},
+		{Lo: 0x1faf0, Hi: 0x1faf8, Stride: 1},
+	},
+}
+
+// emojiModifierBase holds the code points with the Emoji_Modifier_Base
+// property in Unicode 15.0, which take a skin tone modifier.
+var emojiModifierBase = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x261d, Hi: 0x261d, Stride: 1},
+		{Lo: 0x26f9, Hi: 0x26f9, Stride: 1},
+		{Lo: 0x270a, Hi: 0x270d, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f385, Hi: 0x1f385, Stride: 1},
+		{Lo: 0x1f3c2, Hi: 0x1f3c4, Stride: 1},
+		{Lo: 0x1f3c7, Hi: 0x1f3c7, Stride: 1},
+		{Lo: 0x1f3ca, Hi: 0x1f3cc, Stride: 1},
+		{Lo: 0x1f442, Hi: 0x1f443, Stride: 1},
+		{Lo: 0x1f446, Hi: 0x1f450, Stride: 1},
+		{Lo: 0x1f466, Hi: 0x1f478, Stride: 1},
+		{Lo: 0x1f47c, Hi: 0x1f47c, Stride: 1},
+		{Lo: 0x1f481, Hi: 0x1f483, Stride: 1},
+		{Lo: 0x1f485, Hi: 0x1f487, Stride: 1},
+		{Lo: 0x1f48f, Hi: 0x1f48f, Stride: 1},
+		{Lo: 0x1f491, Hi: 0x1f491, Stride: 1},
+		{Lo: 0x1f4aa, Hi: 0x1f4aa, Stride: 1},
+		{Lo: 0x1f574, Hi: 0x1f575, Stride: 1},
+		{Lo: 0x1f57a, Hi: 0x1f57a, Stride: 1},
+		{Lo: 0x1f590, Hi: 0x1f590, Stride: 1},
+		{Lo: 0x1f595, Hi: 0x1f596, Stride: 1},
+		{Lo: 0x1f645, Hi: 0x1f647, Stride: 1},
+		{Lo: 0x1f64b, Hi: 0x1f64f, Stride: 1},
+		{Lo: 0x1f6a3, Hi: 0x1f6a3, Stride: 1},
+		{Lo: 0x1f6b4, Hi: 0x1f6b6, Stride: 1},
+		{Lo: 0x1f6c0, Hi: 0x1f6c0, Stride: 1},
+		{Lo: 0x1f6cc, Hi: 0x1f6cc, Stride: 1},
+		{Lo: 0x1f90c, Hi: 0x1f90c, Stride: 1},
+		{Lo: 0x1f90f, Hi: 0x1f90f, Stride: 1},
+		{Lo: 0x1f918, Hi: 0x1f91f, Stride: 1},
+		{Lo: 0x1f926, Hi: 0x1f926, Stride: 1},
+		{Lo: 0x1f930, Hi: 0x1f939, Stride: 1},
+		{Lo: 0x1f93c, Hi: 0x1f93e, Stride: 1},
+		{Lo: 0x1f977, Hi: 0x1f977, Stride: 1},
+		{Lo: 0x1f9b5, Hi: 0x1f9b6, Stride: 1},
+		{Lo: 0x1f9b8, Hi: 0x1f9b9, Stride: 1},
+		{Lo: 0x1f9bb, Hi: 0x1f9bb, Stride: 1},
+		{Lo: 0x1f9cd, Hi: 0x1f9cf, Stride: 1},
+		{Lo: 0x1f9d1, Hi: 0x1f9dd, Stride: 1},
+		{Lo: 0x1fac3, Hi: 0x1fac5, Stride: 1},
+		{Lo: 0x1faf0, Hi: 0x1faf8, Stride: 1},
+	},
+}
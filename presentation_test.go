@@ -0,0 +1,135 @@
+package emojiparser_test
+
+import (
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestPresentation(t *testing.T) {
+	// ⚧ defaults to text presentation; 😄 defaults to emoji presentation.
+	cases := []struct {
+		content   string
+		want      emojiparser.Presentation
+		effective emojiparser.Presentation
+	}{
+		{"⚧", emojiparser.PresentationDefault, emojiparser.PresentationText},
+		{"⚧\uFE0E", emojiparser.PresentationText, emojiparser.PresentationText},
+		{"⚧\uFE0F", emojiparser.PresentationEmoji, emojiparser.PresentationEmoji},
+		{"😄", emojiparser.PresentationDefault, emojiparser.PresentationEmoji},
+		{"😄\uFE0E", emojiparser.PresentationText, emojiparser.PresentationText},
+		{"✈\uFE0F", emojiparser.PresentationEmoji, emojiparser.PresentationEmoji},
+	}
+	for _, tc := range cases {
+		results := emojiparser.ParseUnicode(tc.content, nil)
+		if len(results) != 1 {
+			t.Fatalf("ParseUnicode(%+q): got %d results", tc.content, len(results))
+		}
+		if got := results[0].Presentation; got != tc.want {
+			t.Fatalf("ParseUnicode(%+q): presentation %s, want %s", tc.content, got, tc.want)
+		}
+		if got := results[0].EffectivePresentation(); got != tc.effective {
+			t.Fatalf("ParseUnicode(%+q): effective presentation %s, want %s", tc.content, got, tc.effective)
+		}
+	}
+
+	// Without U+FE0F a default-text character like ✈ is not an emoji,
+	// unless MatchBareTextSymbols is set.
+	bare := newTestParser(t, emojiparser.WithMatchBareTextSymbols(true))
+	for _, content := range []string{"✈", "✈\uFE0E"} {
+		if results := emojiparser.ParseUnicode(content, nil); len(results) != 0 {
+			t.Fatalf("ParseUnicode(%+q) = %v, want no results", content, results)
+		}
+		if results := bare.ParseUnicode(content, nil); len(results) != 1 || results[0].EffectivePresentation() != emojiparser.PresentationText {
+			t.Fatalf("ParseUnicode(%+q) with MatchBareTextSymbols = %v, want one text emoji", content, results)
+		}
+	}
+
+	if got := emojiparser.Parse(":smile:")[0].EffectivePresentation(); got != emojiparser.PresentationEmoji {
+		t.Fatalf("text emoji effective presentation = %s, want emoji", got)
+	}
+}
+
+func TestSkipTextPresentation(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser(emojiparser.WithSkipTextPresentation(true))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	results := parser.Parse("⚧\uFE0E ⚧ ⚧\uFE0F 😄\uFE0E 😄")
+	want := []emojiparser.Presentation{
+		emojiparser.PresentationDefault,
+		emojiparser.PresentationEmoji,
+		emojiparser.PresentationDefault,
+	}
+	if len(results) != len(want) {
+		t.Fatalf("got %d results, want %d: %v", len(results), len(want), results)
+	}
+	for i, result := range results {
+		if result.Presentation != want[i] {
+			t.Fatalf("result %d %v: presentation %s, want %s", i, result, result.Presentation, want[i])
+		}
+	}
+}
+
+func TestExcludeTextSymbols(t *testing.T) {
+	bare := &emojiparser.Assets{UnicodeEmojis: map[string]string{"©": "copyright", "™": "tm"}}
+	loose, err := emojiparser.NewDiscordEmojiParser()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := loose.MergeAssets(bare); err != nil {
+		t.Fatalf("merge: %v", err)
+	}
+	strict, err := loose.Clone(emojiparser.WithExcludeTextSymbols(true))
+	if err != nil {
+		t.Fatalf("clone: %v", err)
+	}
+
+	cases := []struct {
+		content       string
+		loose, strict int
+	}{
+		{"Product™ is © 2024", 2, 0},
+		{"signed ©️", 1, 1},
+		{"a ⚧ sign", 1, 0},
+		{"a ⚧️ sign", 1, 1},
+		{"a 😄 face", 1, 1},
+	}
+	for _, tc := range cases {
+		if got := len(loose.Parse(tc.content)); got != tc.loose {
+			t.Fatalf("Parse(%+q) without the option: %d results, want %d", tc.content, got, tc.loose)
+		}
+		if got := len(strict.Parse(tc.content)); got != tc.strict {
+			t.Fatalf("Parse(%+q) with ExcludeTextSymbols: %d results, want %d", tc.content, got, tc.strict)
+		}
+	}
+
+	if got := len(emojiparser.Parse("Product™ is © 2024")); got != 0 {
+		t.Fatalf("embedded tables matched %d bare symbols, want 0", got)
+	}
+	opted := newTestParser(t, emojiparser.WithMatchBareTextSymbols(true))
+	if got := len(opted.Parse("Product™ is © 2024")); got != 2 {
+		t.Fatalf("MatchBareTextSymbols matched %d bare symbols, want 2", got)
+	}
+	if got := len(strict.Parse("©2024 trademark™ but ©️")); got != 1 {
+		t.Fatalf("ExcludeTextSymbols matched %d symbols, want only ©️", got)
+	}
+}
+
+func TestASCIIKeysNeverMatch(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	digits := &emojiparser.Assets{UnicodeEmojis: map[string]string{"number_sign": "#\uFE0F", "one_sign": "1\uFE0F"}}
+	if _, err := parser.MergeAssets(digits); err != nil {
+		t.Fatalf("merge: %v", err)
+	}
+	if results := parser.Parse("1 #\uFE0F # 2024"); len(results) != 0 {
+		t.Fatalf("Parse of bare keycap bases = %v, want none", results)
+	}
+	if results := parser.Parse("1\u20E3"); len(results) != 1 || results[0].Name != "one" {
+		t.Fatalf("Parse of a keycap = %v, want one", results)
+	}
+}
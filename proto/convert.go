@@ -0,0 +1,116 @@
+// Package emojiproto converts parse results to and from the protobuf messages
+// defined in emoji.proto. It is a separate module so that only programs that
+// exchange results over protobuf depend on the protobuf runtime.
+package emojiproto
+
+import (
+	"errors"
+	"fmt"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+	pb "github.com/x1xo/emoji-parser/proto/emojipb"
+)
+
+//go:generate protoc --go_out=. --go_opt=module=github.com/x1xo/emoji-parser/proto emoji.proto
+
+// ErrInvalidMessage is returned by FromProto for messages that do not
+// describe a valid parse result.
+var ErrInvalidMessage = errors.New("invalid emoji message")
+
+var toProtoType = map[emojiparser.EmojiType]pb.EmojiType{
+	emojiparser.EmojiTypeUnicode: pb.EmojiType_EMOJI_TYPE_UNICODE,
+	emojiparser.EmojiTypeText:    pb.EmojiType_EMOJI_TYPE_TEXT,
+	emojiparser.EmojiTypeCustom:  pb.EmojiType_EMOJI_TYPE_CUSTOM,
+}
+
+var fromProtoType = map[pb.EmojiType]emojiparser.EmojiType{
+	pb.EmojiType_EMOJI_TYPE_UNICODE: emojiparser.EmojiTypeUnicode,
+	pb.EmojiType_EMOJI_TYPE_TEXT:    emojiparser.EmojiTypeText,
+	pb.EmojiType_EMOJI_TYPE_CUSTOM:  emojiparser.EmojiTypeCustom,
+}
+
+// ToProto converts a parse result to its message. Nil ID and Link leave the
+// optional fields unset; an unknown Type becomes EMOJI_TYPE_UNSPECIFIED. The
+// Presentation, Tone, and Qualification enums number their values as the Go
+// constants do, so they convert directly.
+func ToProto(emoji emojiparser.ParsedEmoji) *pb.ParsedEmoji {
+	return &pb.ParsedEmoji{
+		Id:      copyString(emoji.ID),
+		Name:    emoji.Name,
+		Type:    toProtoType[emoji.Type],
+		Unicode: emoji.Unicode,
+		Position: &pb.EmojiPosition{
+			From: int64(emoji.Position.From),
+			To:   int64(emoji.Position.To),
+		},
+		Link:     copyString(emoji.Link),
+		Animated: emoji.Animated,
+
+		Presentation:  pb.Presentation(emoji.Presentation),
+		Tone:          pb.SkinTone(emoji.Tone),
+		Qualification: pb.Qualification(emoji.Qualification),
+	}
+}
+
+// FromProto converts a message back to a parse result. Unset optional fields
+// become nil pointers. It fails with ErrInvalidMessage if the type is
+// unspecified or unknown, the position is missing or not a valid range, or
+// the presence of the id does not match the type (custom emojis have one,
+// unicode and text emojis do not), or the presentation, tone, or
+// qualification is not a known value.
+func FromProto(msg *pb.ParsedEmoji) (emojiparser.ParsedEmoji, error) {
+	if msg == nil {
+		return emojiparser.ParsedEmoji{}, fmt.Errorf("%w: nil message", ErrInvalidMessage)
+	}
+
+	emojiType, ok := fromProtoType[msg.GetType()]
+	if !ok {
+		return emojiparser.ParsedEmoji{}, fmt.Errorf("%w: unsupported type %v", ErrInvalidMessage, msg.GetType())
+	}
+	if (emojiType == emojiparser.EmojiTypeCustom) != (msg.Id != nil) {
+		return emojiparser.ParsedEmoji{}, fmt.Errorf("%w: %s emoji %q with id present = %t", ErrInvalidMessage, emojiType, msg.GetName(), msg.Id != nil)
+	}
+
+	position := msg.GetPosition()
+	if position == nil {
+		return emojiparser.ParsedEmoji{}, fmt.Errorf("%w: missing position", ErrInvalidMessage)
+	}
+	from, to := position.GetFrom(), position.GetTo()
+	if from < 0 || to < from || int64(int(to)) != to {
+		return emojiparser.ParsedEmoji{}, fmt.Errorf("%w: invalid position [%d:%d)", ErrInvalidMessage, from, to)
+	}
+
+	if _, ok := pb.Presentation_name[int32(msg.GetPresentation())]; !ok {
+		return emojiparser.ParsedEmoji{}, fmt.Errorf("%w: unsupported presentation %v", ErrInvalidMessage, msg.GetPresentation())
+	}
+	if _, ok := pb.SkinTone_name[int32(msg.GetTone())]; !ok {
+		return emojiparser.ParsedEmoji{}, fmt.Errorf("%w: unsupported tone %v", ErrInvalidMessage, msg.GetTone())
+	}
+	if _, ok := pb.Qualification_name[int32(msg.GetQualification())]; !ok {
+		return emojiparser.ParsedEmoji{}, fmt.Errorf("%w: unsupported qualification %v", ErrInvalidMessage, msg.GetQualification())
+	}
+
+	return emojiparser.ParsedEmoji{
+		ID:       copyString(msg.Id),
+		Name:     msg.GetName(),
+		Type:     emojiType,
+		Unicode:  msg.GetUnicode(),
+		Position: emojiparser.EmojiPosition{From: int(from), To: int(to)},
+		Link:     copyString(msg.Link),
+		Animated: msg.GetAnimated(),
+
+		Presentation:  emojiparser.Presentation(msg.GetPresentation()),
+		Tone:          emojiparser.SkinTone(msg.GetTone()),
+		Qualification: emojiparser.Qualification(msg.GetQualification()),
+	}, nil
+}
+
+// copyString returns a pointer to a copy of *value, so that the converted
+// value does not alias the original.
+func copyString(value *string) *string {
+	if value == nil {
+		return nil
+	}
+	copied := *value
+	return &copied
+}
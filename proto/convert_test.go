@@ -0,0 +1,87 @@
+package emojiproto_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+	emojiproto "github.com/x1xo/emoji-parser/proto"
+	pb "github.com/x1xo/emoji-parser/proto/emojipb"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRoundTrip(t *testing.T) {
+	// ☺ is only an emoji without U+FE0F when bare text symbols are matched.
+	parser, err := emojiparser.NewDiscordEmojiParser(emojiparser.WithMatchBareTextSymbols(true))
+	if err != nil {
+		t.Fatalf("NewDiscordEmojiParser: %v", err)
+	}
+	results := parser.Parse("hi 😄 <a:wave:12345678901234567> :tada: <:pepe:22345678901234567> \U0001F44D\U0001F3FD ❤\ufe0f ☺\ufe0e")
+	results = append(results, emojiparser.ParsedEmoji{Name: "nolink", Type: emojiparser.EmojiTypeUnicode, Unicode: "🫨"})
+
+	types := map[emojiparser.EmojiType]bool{}
+	for _, want := range results {
+		types[want.Type] = true
+
+		wire, err := proto.Marshal(emojiproto.ToProto(want))
+		if err != nil {
+			t.Fatalf("marshal %v: %v", want, err)
+		}
+		var msg pb.ParsedEmoji
+		if err := proto.Unmarshal(wire, &msg); err != nil {
+			t.Fatalf("unmarshal %v: %v", want, err)
+		}
+		if (msg.Id != nil) != (want.ID != nil) || (msg.Link != nil) != (want.Link != nil) {
+			t.Fatalf("%+v: optional field presence lost: %v", want, &msg)
+		}
+
+		got, err := emojiproto.FromProto(&msg)
+		if err != nil {
+			t.Fatalf("FromProto(%v): %v", &msg, err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("round trip = %+v, want %+v", got, want)
+		}
+	}
+	tones := map[emojiparser.SkinTone]bool{}
+	presentations := map[emojiparser.Presentation]bool{}
+	qualifications := map[emojiparser.Qualification]bool{}
+	for _, result := range results {
+		tones[result.Tone] = true
+		presentations[result.Presentation] = true
+		qualifications[result.Qualification] = true
+	}
+	if len(tones) < 2 || len(presentations) != 3 || len(qualifications) < 3 {
+		t.Fatalf("covered tones %v, presentations %v, qualifications %v", tones, presentations, qualifications)
+	}
+	if len(types) != 3 {
+		t.Fatalf("covered types %v, want all three", types)
+	}
+}
+
+func TestFromProtoValidation(t *testing.T) {
+	id := "12345678901234567"
+	position := &pb.EmojiPosition{From: 0, To: 4}
+	tests := []struct {
+		name string
+		msg  *pb.ParsedEmoji
+	}{
+		{"nil message", nil},
+		{"unspecified type", &pb.ParsedEmoji{Name: "smile", Position: position}},
+		{"unknown type", &pb.ParsedEmoji{Name: "smile", Type: pb.EmojiType(42), Position: position}},
+		{"custom without id", &pb.ParsedEmoji{Name: "wave", Type: pb.EmojiType_EMOJI_TYPE_CUSTOM, Position: position}},
+		{"unicode with id", &pb.ParsedEmoji{Id: &id, Name: "smile", Type: pb.EmojiType_EMOJI_TYPE_UNICODE, Position: position}},
+		{"missing position", &pb.ParsedEmoji{Name: "smile", Type: pb.EmojiType_EMOJI_TYPE_TEXT}},
+		{"negative position", &pb.ParsedEmoji{Name: "smile", Type: pb.EmojiType_EMOJI_TYPE_TEXT, Position: &pb.EmojiPosition{From: -1, To: 4}}},
+		{"unknown presentation", &pb.ParsedEmoji{Name: "smile", Type: pb.EmojiType_EMOJI_TYPE_TEXT, Position: position, Presentation: pb.Presentation(3)}},
+		{"unknown tone", &pb.ParsedEmoji{Name: "smile", Type: pb.EmojiType_EMOJI_TYPE_TEXT, Position: position, Tone: pb.SkinTone(6)}},
+		{"unknown qualification", &pb.ParsedEmoji{Name: "smile", Type: pb.EmojiType_EMOJI_TYPE_TEXT, Position: position, Qualification: pb.Qualification(5)}},
+		{"reversed position", &pb.ParsedEmoji{Name: "smile", Type: pb.EmojiType_EMOJI_TYPE_TEXT, Position: &pb.EmojiPosition{From: 4, To: 0}}},
+	}
+	for _, tt := range tests {
+		if _, err := emojiproto.FromProto(tt.msg); !errors.Is(err, emojiproto.ErrInvalidMessage) {
+			t.Fatalf("%s: error = %v, want ErrInvalidMessage", tt.name, err)
+		}
+	}
+}
@@ -0,0 +1,517 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: emoji.proto
+
+package emojipb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EmojiType mirrors emojiparser.EmojiType.
+type EmojiType int32
+
+const (
+	EmojiType_EMOJI_TYPE_UNSPECIFIED EmojiType = 0
+	EmojiType_EMOJI_TYPE_UNICODE     EmojiType = 1
+	EmojiType_EMOJI_TYPE_TEXT        EmojiType = 2
+	EmojiType_EMOJI_TYPE_CUSTOM      EmojiType = 3
+)
+
+// Enum value maps for EmojiType.
+var (
+	EmojiType_name = map[int32]string{
+		0: "EMOJI_TYPE_UNSPECIFIED",
+		1: "EMOJI_TYPE_UNICODE",
+		2: "EMOJI_TYPE_TEXT",
+		3: "EMOJI_TYPE_CUSTOM",
+	}
+	EmojiType_value = map[string]int32{
+		"EMOJI_TYPE_UNSPECIFIED": 0,
+		"EMOJI_TYPE_UNICODE":     1,
+		"EMOJI_TYPE_TEXT":        2,
+		"EMOJI_TYPE_CUSTOM":      3,
+	}
+)
+
+func (x EmojiType) Enum() *EmojiType {
+	p := new(EmojiType)
+	*p = x
+	return p
+}
+
+func (x EmojiType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmojiType) Descriptor() protoreflect.EnumDescriptor {
+	return file_emoji_proto_enumTypes[0].Descriptor()
+}
+
+func (EmojiType) Type() protoreflect.EnumType {
+	return &file_emoji_proto_enumTypes[0]
+}
+
+func (x EmojiType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmojiType.Descriptor instead.
+func (EmojiType) EnumDescriptor() ([]byte, []int) {
+	return file_emoji_proto_rawDescGZIP(), []int{0}
+}
+
+// Presentation mirrors emojiparser.Presentation, value for value.
+type Presentation int32
+
+const (
+	Presentation_PRESENTATION_DEFAULT Presentation = 0
+	Presentation_PRESENTATION_EMOJI   Presentation = 1
+	Presentation_PRESENTATION_TEXT    Presentation = 2
+)
+
+// Enum value maps for Presentation.
+var (
+	Presentation_name = map[int32]string{
+		0: "PRESENTATION_DEFAULT",
+		1: "PRESENTATION_EMOJI",
+		2: "PRESENTATION_TEXT",
+	}
+	Presentation_value = map[string]int32{
+		"PRESENTATION_DEFAULT": 0,
+		"PRESENTATION_EMOJI":   1,
+		"PRESENTATION_TEXT":    2,
+	}
+)
+
+func (x Presentation) Enum() *Presentation {
+	p := new(Presentation)
+	*p = x
+	return p
+}
+
+func (x Presentation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Presentation) Descriptor() protoreflect.EnumDescriptor {
+	return file_emoji_proto_enumTypes[1].Descriptor()
+}
+
+func (Presentation) Type() protoreflect.EnumType {
+	return &file_emoji_proto_enumTypes[1]
+}
+
+func (x Presentation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Presentation.Descriptor instead.
+func (Presentation) EnumDescriptor() ([]byte, []int) {
+	return file_emoji_proto_rawDescGZIP(), []int{1}
+}
+
+// SkinTone mirrors emojiparser.SkinTone, value for value.
+type SkinTone int32
+
+const (
+	SkinTone_SKIN_TONE_NONE SkinTone = 0
+	SkinTone_SKIN_TONE_1    SkinTone = 1
+	SkinTone_SKIN_TONE_2    SkinTone = 2
+	SkinTone_SKIN_TONE_3    SkinTone = 3
+	SkinTone_SKIN_TONE_4    SkinTone = 4
+	SkinTone_SKIN_TONE_5    SkinTone = 5
+)
+
+// Enum value maps for SkinTone.
+var (
+	SkinTone_name = map[int32]string{
+		0: "SKIN_TONE_NONE",
+		1: "SKIN_TONE_1",
+		2: "SKIN_TONE_2",
+		3: "SKIN_TONE_3",
+		4: "SKIN_TONE_4",
+		5: "SKIN_TONE_5",
+	}
+	SkinTone_value = map[string]int32{
+		"SKIN_TONE_NONE": 0,
+		"SKIN_TONE_1":    1,
+		"SKIN_TONE_2":    2,
+		"SKIN_TONE_3":    3,
+		"SKIN_TONE_4":    4,
+		"SKIN_TONE_5":    5,
+	}
+)
+
+func (x SkinTone) Enum() *SkinTone {
+	p := new(SkinTone)
+	*p = x
+	return p
+}
+
+func (x SkinTone) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SkinTone) Descriptor() protoreflect.EnumDescriptor {
+	return file_emoji_proto_enumTypes[2].Descriptor()
+}
+
+func (SkinTone) Type() protoreflect.EnumType {
+	return &file_emoji_proto_enumTypes[2]
+}
+
+func (x SkinTone) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SkinTone.Descriptor instead.
+func (SkinTone) EnumDescriptor() ([]byte, []int) {
+	return file_emoji_proto_rawDescGZIP(), []int{2}
+}
+
+// Qualification mirrors emojiparser.Qualification, value for value.
+type Qualification int32
+
+const (
+	Qualification_QUALIFICATION_NONE                Qualification = 0
+	Qualification_QUALIFICATION_FULLY_QUALIFIED     Qualification = 1
+	Qualification_QUALIFICATION_MINIMALLY_QUALIFIED Qualification = 2
+	Qualification_QUALIFICATION_UNQUALIFIED         Qualification = 3
+	Qualification_QUALIFICATION_COMPONENT           Qualification = 4
+)
+
+// Enum value maps for Qualification.
+var (
+	Qualification_name = map[int32]string{
+		0: "QUALIFICATION_NONE",
+		1: "QUALIFICATION_FULLY_QUALIFIED",
+		2: "QUALIFICATION_MINIMALLY_QUALIFIED",
+		3: "QUALIFICATION_UNQUALIFIED",
+		4: "QUALIFICATION_COMPONENT",
+	}
+	Qualification_value = map[string]int32{
+		"QUALIFICATION_NONE":                0,
+		"QUALIFICATION_FULLY_QUALIFIED":     1,
+		"QUALIFICATION_MINIMALLY_QUALIFIED": 2,
+		"QUALIFICATION_UNQUALIFIED":         3,
+		"QUALIFICATION_COMPONENT":           4,
+	}
+)
+
+func (x Qualification) Enum() *Qualification {
+	p := new(Qualification)
+	*p = x
+	return p
+}
+
+func (x Qualification) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Qualification) Descriptor() protoreflect.EnumDescriptor {
+	return file_emoji_proto_enumTypes[3].Descriptor()
+}
+
+func (Qualification) Type() protoreflect.EnumType {
+	return &file_emoji_proto_enumTypes[3]
+}
+
+func (x Qualification) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Qualification.Descriptor instead.
+func (Qualification) EnumDescriptor() ([]byte, []int) {
+	return file_emoji_proto_rawDescGZIP(), []int{3}
+}
+
+// EmojiPosition is the byte range [from, to) of an emoji in the parsed content.
+type EmojiPosition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          int64                  `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            int64                  `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmojiPosition) Reset() {
+	*x = EmojiPosition{}
+	mi := &file_emoji_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmojiPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmojiPosition) ProtoMessage() {}
+
+func (x *EmojiPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_emoji_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmojiPosition.ProtoReflect.Descriptor instead.
+func (*EmojiPosition) Descriptor() ([]byte, []int) {
+	return file_emoji_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EmojiPosition) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *EmojiPosition) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+// ParsedEmoji mirrors emojiparser.ParsedEmoji. The optional fields are unset
+// where the Go struct has a nil pointer.
+type ParsedEmoji struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          EmojiType              `protobuf:"varint,3,opt,name=type,proto3,enum=emojiparser.v1.EmojiType" json:"type,omitempty"`
+	Unicode       string                 `protobuf:"bytes,4,opt,name=unicode,proto3" json:"unicode,omitempty"`
+	Position      *EmojiPosition         `protobuf:"bytes,5,opt,name=position,proto3" json:"position,omitempty"`
+	Link          *string                `protobuf:"bytes,6,opt,name=link,proto3,oneof" json:"link,omitempty"`
+	Animated      bool                   `protobuf:"varint,7,opt,name=animated,proto3" json:"animated,omitempty"`
+	Presentation  Presentation           `protobuf:"varint,8,opt,name=presentation,proto3,enum=emojiparser.v1.Presentation" json:"presentation,omitempty"`
+	Tone          SkinTone               `protobuf:"varint,9,opt,name=tone,proto3,enum=emojiparser.v1.SkinTone" json:"tone,omitempty"`
+	Qualification Qualification          `protobuf:"varint,10,opt,name=qualification,proto3,enum=emojiparser.v1.Qualification" json:"qualification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParsedEmoji) Reset() {
+	*x = ParsedEmoji{}
+	mi := &file_emoji_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParsedEmoji) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParsedEmoji) ProtoMessage() {}
+
+func (x *ParsedEmoji) ProtoReflect() protoreflect.Message {
+	mi := &file_emoji_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParsedEmoji.ProtoReflect.Descriptor instead.
+func (*ParsedEmoji) Descriptor() ([]byte, []int) {
+	return file_emoji_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ParsedEmoji) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *ParsedEmoji) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ParsedEmoji) GetType() EmojiType {
+	if x != nil {
+		return x.Type
+	}
+	return EmojiType_EMOJI_TYPE_UNSPECIFIED
+}
+
+func (x *ParsedEmoji) GetUnicode() string {
+	if x != nil {
+		return x.Unicode
+	}
+	return ""
+}
+
+func (x *ParsedEmoji) GetPosition() *EmojiPosition {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *ParsedEmoji) GetLink() string {
+	if x != nil && x.Link != nil {
+		return *x.Link
+	}
+	return ""
+}
+
+func (x *ParsedEmoji) GetAnimated() bool {
+	if x != nil {
+		return x.Animated
+	}
+	return false
+}
+
+func (x *ParsedEmoji) GetPresentation() Presentation {
+	if x != nil {
+		return x.Presentation
+	}
+	return Presentation_PRESENTATION_DEFAULT
+}
+
+func (x *ParsedEmoji) GetTone() SkinTone {
+	if x != nil {
+		return x.Tone
+	}
+	return SkinTone_SKIN_TONE_NONE
+}
+
+func (x *ParsedEmoji) GetQualification() Qualification {
+	if x != nil {
+		return x.Qualification
+	}
+	return Qualification_QUALIFICATION_NONE
+}
+
+var File_emoji_proto protoreflect.FileDescriptor
+
+const file_emoji_proto_rawDesc = "" +
+	"\n" +
+	"\vemoji.proto\x12\x0eemojiparser.v1\"3\n" +
+	"\rEmojiPosition\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\x03R\x02to\"\xb4\x03\n" +
+	"\vParsedEmoji\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12-\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x19.emojiparser.v1.EmojiTypeR\x04type\x12\x18\n" +
+	"\aunicode\x18\x04 \x01(\tR\aunicode\x129\n" +
+	"\bposition\x18\x05 \x01(\v2\x1d.emojiparser.v1.EmojiPositionR\bposition\x12\x17\n" +
+	"\x04link\x18\x06 \x01(\tH\x01R\x04link\x88\x01\x01\x12\x1a\n" +
+	"\banimated\x18\a \x01(\bR\banimated\x12@\n" +
+	"\fpresentation\x18\b \x01(\x0e2\x1c.emojiparser.v1.PresentationR\fpresentation\x12,\n" +
+	"\x04tone\x18\t \x01(\x0e2\x18.emojiparser.v1.SkinToneR\x04tone\x12C\n" +
+	"\rqualification\x18\n" +
+	" \x01(\x0e2\x1d.emojiparser.v1.QualificationR\rqualificationB\x05\n" +
+	"\x03_idB\a\n" +
+	"\x05_link*k\n" +
+	"\tEmojiType\x12\x1a\n" +
+	"\x16EMOJI_TYPE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12EMOJI_TYPE_UNICODE\x10\x01\x12\x13\n" +
+	"\x0fEMOJI_TYPE_TEXT\x10\x02\x12\x15\n" +
+	"\x11EMOJI_TYPE_CUSTOM\x10\x03*W\n" +
+	"\fPresentation\x12\x18\n" +
+	"\x14PRESENTATION_DEFAULT\x10\x00\x12\x16\n" +
+	"\x12PRESENTATION_EMOJI\x10\x01\x12\x15\n" +
+	"\x11PRESENTATION_TEXT\x10\x02*s\n" +
+	"\bSkinTone\x12\x12\n" +
+	"\x0eSKIN_TONE_NONE\x10\x00\x12\x0f\n" +
+	"\vSKIN_TONE_1\x10\x01\x12\x0f\n" +
+	"\vSKIN_TONE_2\x10\x02\x12\x0f\n" +
+	"\vSKIN_TONE_3\x10\x03\x12\x0f\n" +
+	"\vSKIN_TONE_4\x10\x04\x12\x0f\n" +
+	"\vSKIN_TONE_5\x10\x05*\xad\x01\n" +
+	"\rQualification\x12\x16\n" +
+	"\x12QUALIFICATION_NONE\x10\x00\x12!\n" +
+	"\x1dQUALIFICATION_FULLY_QUALIFIED\x10\x01\x12%\n" +
+	"!QUALIFICATION_MINIMALLY_QUALIFIED\x10\x02\x12\x1d\n" +
+	"\x19QUALIFICATION_UNQUALIFIED\x10\x03\x12\x1b\n" +
+	"\x17QUALIFICATION_COMPONENT\x10\x04B,Z*github.com/x1xo/emoji-parser/proto/emojipbb\x06proto3"
+
+var (
+	file_emoji_proto_rawDescOnce sync.Once
+	file_emoji_proto_rawDescData []byte
+)
+
+func file_emoji_proto_rawDescGZIP() []byte {
+	file_emoji_proto_rawDescOnce.Do(func() {
+		file_emoji_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_emoji_proto_rawDesc), len(file_emoji_proto_rawDesc)))
+	})
+	return file_emoji_proto_rawDescData
+}
+
+var file_emoji_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_emoji_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_emoji_proto_goTypes = []any{
+	(EmojiType)(0),        // 0: emojiparser.v1.EmojiType
+	(Presentation)(0),     // 1: emojiparser.v1.Presentation
+	(SkinTone)(0),         // 2: emojiparser.v1.SkinTone
+	(Qualification)(0),    // 3: emojiparser.v1.Qualification
+	(*EmojiPosition)(nil), // 4: emojiparser.v1.EmojiPosition
+	(*ParsedEmoji)(nil),   // 5: emojiparser.v1.ParsedEmoji
+}
+var file_emoji_proto_depIdxs = []int32{
+	0, // 0: emojiparser.v1.ParsedEmoji.type:type_name -> emojiparser.v1.EmojiType
+	4, // 1: emojiparser.v1.ParsedEmoji.position:type_name -> emojiparser.v1.EmojiPosition
+	1, // 2: emojiparser.v1.ParsedEmoji.presentation:type_name -> emojiparser.v1.Presentation
+	2, // 3: emojiparser.v1.ParsedEmoji.tone:type_name -> emojiparser.v1.SkinTone
+	3, // 4: emojiparser.v1.ParsedEmoji.qualification:type_name -> emojiparser.v1.Qualification
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_emoji_proto_init() }
+func file_emoji_proto_init() {
+	if File_emoji_proto != nil {
+		return
+	}
+	file_emoji_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_emoji_proto_rawDesc), len(file_emoji_proto_rawDesc)),
+			NumEnums:      4,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_emoji_proto_goTypes,
+		DependencyIndexes: file_emoji_proto_depIdxs,
+		EnumInfos:         file_emoji_proto_enumTypes,
+		MessageInfos:      file_emoji_proto_msgTypes,
+	}.Build()
+	File_emoji_proto = out.File
+	file_emoji_proto_goTypes = nil
+	file_emoji_proto_depIdxs = nil
+}
@@ -0,0 +1,10 @@
+module github.com/x1xo/emoji-parser/proto
+
+go 1.25.6
+
+require (
+	github.com/x1xo/emoji-parser v0.0.0
+	google.golang.org/protobuf v1.36.12
+)
+
+replace github.com/x1xo/emoji-parser => ../
@@ -0,0 +1,132 @@
+package emojiparser
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Qualification is the status Unicode's emoji-test.txt gives an emoji
+// sequence, which depends on whether it has the U+FE0F its characters need
+// to be shown as emojis.
+type Qualification uint8
+
+const (
+	// QualificationNone is the qualification of custom emojis.
+	QualificationNone Qualification = iota
+	// FullyQualified means every character that needs U+FE0F has it.
+	FullyQualified
+	// MinimallyQualified means the first character has the U+FE0F it needs,
+	// but a later one in a ZWJ sequence does not.
+	MinimallyQualified
+	// Unqualified means the first character lacks the U+FE0F it needs.
+	Unqualified
+	// Component means a skin tone or hair style component on its own.
+	Component
+)
+
+// String returns the status as emoji-test.txt spells it, such as
+// "fully-qualified", or "" for QualificationNone.
+func (q Qualification) String() string {
+	switch q {
+	case FullyQualified:
+		return "fully-qualified"
+	case MinimallyQualified:
+		return "minimally-qualified"
+	case Unqualified:
+		return "unqualified"
+	case Component:
+		return "component"
+	default:
+		return ""
+	}
+}
+
+// keycapCombiner is U+20E3, which makes a keycap of the character before it.
+const keycapCombiner = '\u20E3'
+
+// isHairComponent reports whether r is one of the hair style components,
+// U+1F9B0 to U+1F9B3.
+func isHairComponent(r rune) bool {
+	return r >= '\U0001F9B0' && r <= '\U0001F9B3'
+}
+
+// qualificationOf returns the qualification of a unicode emoji.
+func qualificationOf(emoji string) Qualification {
+	if r, size := utf8.DecodeRuneInString(emoji); size == len(emoji) {
+		if _, ok := skinToneOf(r); ok || isHairComponent(r) {
+			return Component
+		}
+	}
+	for i, element := range elementsNeedingVS16(emoji) {
+		if element.needed && !element.present {
+			if i == 0 {
+				return Unqualified
+			}
+			return MinimallyQualified
+		}
+	}
+	return FullyQualified
+}
+
+// vs16Element is an element of a ZWJ sequence, with whether its first
+// character needs U+FE0F after it and has one.
+type vs16Element struct {
+	text            string
+	needed, present bool
+}
+
+// elementsNeedingVS16 splits emoji into its ZWJ sequence elements. The first
+// character of an element needs U+FE0F if it is shown as text by default and
+// not followed by a skin tone modifier. Keycap bases always need one.
+func elementsNeedingVS16(emoji string) []vs16Element {
+	texts := strings.Split(emoji, string(zeroWidthJoiner))
+	elements := make([]vs16Element, len(texts))
+	for i, text := range texts {
+		r, size := utf8.DecodeRuneInString(text)
+		rest := text[size:]
+		_, toned := skinToneOf(firstRune(rest))
+		elements[i] = vs16Element{
+			text:    text,
+			needed:  firstRune(strings.TrimPrefix(rest, variationSelector16)) == keycapCombiner || !toned && isDefaultText(r),
+			present: strings.HasPrefix(rest, variationSelector16),
+		}
+	}
+	return elements
+}
+
+// firstRune returns the first character of s.
+func firstRune(s string) rune {
+	r, _ := utf8.DecodeRuneInString(s)
+	return r
+}
+
+// FullyQualify upgrades emoji using the default parser.
+func FullyQualify(emoji string) string {
+	return defaultParser.FullyQualify(emoji)
+}
+
+// FullyQualify returns emoji, a single unicode emoji, with U+FE0F added
+// after each character that needs it, so that "❤" becomes "❤️" and
+// "🏳‍🌈" becomes "🏳️‍🌈". Anything else is returned unchanged.
+func (p *DiscordEmojiParser) FullyQualify(emoji string) string {
+	state := p.state.Load()
+	if n, _ := state.matchSequence(emoji); n == 0 || n != len(emoji) {
+		return emoji
+	}
+	return addVS16(emoji)
+}
+
+// addVS16 returns emoji with U+FE0F added after each character that needs it
+// and lacks it.
+func addVS16(emoji string) string {
+	elements := elementsNeedingVS16(emoji)
+	texts := make([]string, len(elements))
+	for i, element := range elements {
+		texts[i] = element.text
+		if element.needed && !element.present {
+			size := utf8.RuneLen(firstRune(element.text))
+			texts[i] = element.text[:size] + variationSelector16 + element.text[size:]
+		}
+	}
+	return strings.Join(texts, string(zeroWidthJoiner))
+}
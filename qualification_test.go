@@ -0,0 +1,84 @@
+package emojiparser_test
+
+import (
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestQualification(t *testing.T) {
+	tests := []struct {
+		content string
+		want    emojiparser.Qualification
+	}{
+		{"😄", emojiparser.FullyQualified},
+		{"❤\uFE0F", emojiparser.FullyQualified},
+		{"⚧", emojiparser.Unqualified},
+		{"©\uFE0F", emojiparser.FullyQualified},
+		{"1\uFE0F\u20E3", emojiparser.FullyQualified},
+		{"1\u20E3", emojiparser.Unqualified},
+		{"☝🏽", emojiparser.FullyQualified},
+		{"🏳\uFE0F\u200D🌈", emojiparser.FullyQualified},
+		{"🏳\u200D🌈", emojiparser.Unqualified},
+		{"🏳\uFE0F\u200D⚧\uFE0F", emojiparser.FullyQualified},
+		{"🏳\uFE0F\u200D⚧", emojiparser.MinimallyQualified},
+		{"👨\u200D❤\uFE0F\u200D👨", emojiparser.FullyQualified},
+		{"👨\u200D❤\u200D👨", emojiparser.MinimallyQualified},
+		{"🇺🇸", emojiparser.FullyQualified},
+	}
+	for _, tc := range tests {
+		results := emojiparser.ParseUnicode(tc.content, nil)
+		if len(results) != 1 || results[0].Position.To != len(tc.content) || results[0].Qualification != tc.want {
+			t.Fatalf("ParseUnicode(%+q) = %+v, want one emoji %v", tc.content, results, tc.want)
+		}
+	}
+
+	bare := newTestParser(t, emojiparser.WithMatchBareTextSymbols(true))
+	if results := bare.ParseUnicode("❤", nil); len(results) != 1 || results[0].Qualification != emojiparser.Unqualified {
+		t.Fatalf("ParseUnicode(\"❤\") with MatchBareTextSymbols = %+v, want one unqualified emoji", results)
+	}
+
+	// The tables have no components on their own.
+	parser := newTestParser(t)
+	if _, err := parser.MergeAssets(&emojiparser.Assets{UnicodeEmojis: map[string]string{"red_hair": "\U0001F9B0"}}); err != nil {
+		t.Fatalf("MergeAssets: %v", err)
+	}
+	if results := parser.Parse(":red_hair: \U0001F9B0"); len(results) != 2 ||
+		results[0].Qualification != emojiparser.Component || results[1].Qualification != emojiparser.Component {
+		t.Fatalf("Parse = %+v, want two components", results)
+	}
+
+	results := emojiparser.Parse(":heart: <:pepe:12345678901234567>")
+	if results[0].Qualification != emojiparser.FullyQualified || results[1].Qualification != emojiparser.QualificationNone {
+		t.Fatalf("Parse = %+v, want a fully-qualified shortcode and no qualification for custom emojis", results)
+	}
+	if got := emojiparser.Unqualified.String(); got != "unqualified" {
+		t.Fatalf("Unqualified.String() = %q", got)
+	}
+}
+
+func TestFullyQualify(t *testing.T) {
+	tests := map[string]string{
+		"❤":              "❤\uFE0F",
+		"❤\uFE0F":        "❤\uFE0F",
+		"⚧":              "⚧\uFE0F",
+		"1\u20E3":        "1\uFE0F\u20E3",
+		"🏳\u200D🌈":       "🏳\uFE0F\u200D🌈",
+		"🏳\uFE0F\u200D⚧": "🏳\uFE0F\u200D⚧\uFE0F",
+		"👁\u200D🗨":       "👁\uFE0F\u200D🗨\uFE0F",
+		"☝🏽":             "☝🏽",
+		"😄":              "😄",
+		"😄 ❤":            "😄 ❤",
+		"hello":          "hello",
+		"":               "",
+	}
+	for input, want := range tests {
+		if got := emojiparser.FullyQualify(input); got != want {
+			t.Fatalf("FullyQualify(%+q) = %+q, want %+q", input, got, want)
+		}
+		if got := emojiparser.ParseUnicode(emojiparser.FullyQualify(input), nil); len(got) == 1 && got[0].Position.To == len(want) &&
+			got[0].Qualification != emojiparser.FullyQualified {
+			t.Fatalf("FullyQualify(%+q) = %+q is %v", input, want, got[0].Qualification)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package emojiparser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// QuoteStyle selects the notation QuoteEmojis writes unicode emojis in.
+type QuoteStyle int
+
+const (
+	// QuoteCodePoints writes every code point of an emoji as \u{HEX}, so 😄
+	// becomes \u{1F604}.
+	QuoteCodePoints QuoteStyle = iota
+	// QuoteNames writes emojis as <emoji:name>, so 😄 becomes <emoji:smile>.
+	// Emojis whose name does not map back to the exact same sequence, such
+	// as a variant without U+FE0F, are written by code point instead, as in
+	// <emoji:U+2764>.
+	QuoteNames
+)
+
+const quotedNamePrefix = "<emoji:"
+
+// QuoteEmojis quotes emojis using the default parser.
+func QuoteEmojis(content string, style QuoteStyle) string {
+	return defaultParser.QuoteEmojis(content, style)
+}
+
+// UnquoteEmojis restores quoted emojis using the default parser.
+func UnquoteEmojis(content string, style QuoteStyle) string {
+	return defaultParser.UnquoteEmojis(content, style)
+}
+
+// QuoteEmojis replaces the unicode emojis in content with ASCII notation in
+// the given style, for logs and other ASCII-only sinks. Text and custom
+// emojis are ASCII already and pass through unchanged, as do characters that
+// are not part of an emoji. To keep the notation unambiguous, every
+// backslash in content is doubled, and with QuoteNames a literal "<emoji:"
+// is written as "\<emoji:". UnquoteEmojis with the same style reverses
+// QuoteEmojis exactly.
+func (p *DiscordEmojiParser) QuoteEmojis(content string, style QuoteStyle) string {
+	state := p.state.Load()
+	results := p.Parse(content)
+
+	var b strings.Builder
+	b.Grow(len(content))
+	last := 0
+	for _, result := range results {
+		if result.Type != EmojiTypeUnicode {
+			continue
+		}
+		writeQuotedText(&b, content[last:result.Position.From], style)
+		emoji := content[result.Position.From:result.Position.To]
+		if style == QuoteNames {
+			writeQuotedName(&b, state, emoji)
+		} else {
+			writeQuotedCodePoints(&b, emoji)
+		}
+		last = result.Position.To
+	}
+	writeQuotedText(&b, content[last:], style)
+	return b.String()
+}
+
+// writeQuotedText writes text that is not an emoji with the escapes
+// QuoteEmojis promises.
+func writeQuotedText(b *strings.Builder, text string, style QuoteStyle) {
+	for len(text) > 0 {
+		i := strings.IndexByte(text, '\\')
+		if style == QuoteNames {
+			if j := strings.Index(text, quotedNamePrefix); j >= 0 && (i < 0 || j < i) {
+				b.WriteString(text[:j])
+				b.WriteByte('\\')
+				b.WriteString(quotedNamePrefix)
+				text = text[j+len(quotedNamePrefix):]
+				continue
+			}
+		}
+		if i < 0 {
+			b.WriteString(text)
+			return
+		}
+		b.WriteString(text[:i+1])
+		b.WriteByte('\\')
+		text = text[i+1:]
+	}
+}
+
+func writeQuotedCodePoints(b *strings.Builder, emoji string) {
+	for _, r := range emoji {
+		fmt.Fprintf(b, "\\u{%X}", r)
+	}
+}
+
+func writeQuotedName(b *strings.Builder, state *parserState, emoji string) {
+	b.WriteString(quotedNamePrefix)
+	if name := state.preferredName(emoji); isShortcodeName(name) {
+		if value, ok := state.lookupShortcode(name); ok && value == emoji {
+			b.WriteString(name)
+			b.WriteByte('>')
+			return
+		}
+	}
+	b.WriteString("U+")
+	for i, r := range emoji {
+		if i > 0 {
+			b.WriteByte('-')
+		}
+		fmt.Fprintf(b, "%X", r)
+	}
+	b.WriteByte('>')
+}
+
+// UnquoteEmojis reverses QuoteEmojis with the same style. Notation that does
+// not decode, such as an unknown name or an invalid code point, is kept as
+// is.
+func (p *DiscordEmojiParser) UnquoteEmojis(content string, style QuoteStyle) string {
+	if !strings.ContainsAny(content, "\\<") {
+		return content
+	}
+	state := p.state.Load()
+
+	var b strings.Builder
+	b.Grow(len(content))
+	for i := 0; i < len(content); {
+		switch {
+		case content[i] == '\\' && i+1 < len(content) && content[i+1] == '\\':
+			b.WriteByte('\\')
+			i += 2
+			continue
+		case style == QuoteNames && content[i] == '\\' && strings.HasPrefix(content[i+1:], quotedNamePrefix):
+			b.WriteString(quotedNamePrefix)
+			i += 1 + len(quotedNamePrefix)
+			continue
+		case style == QuoteNames && content[i] == '<':
+			if emoji, n, ok := unquoteName(state, content[i:]); ok {
+				b.WriteString(emoji)
+				i += n
+				continue
+			}
+		case style != QuoteNames && content[i] == '\\':
+			if r, n, ok := unquoteCodePoint(content[i:]); ok {
+				b.WriteRune(r)
+				i += n
+				continue
+			}
+		}
+		b.WriteByte(content[i])
+		i++
+	}
+	return b.String()
+}
+
+// unquoteCodePoint decodes a \u{HEX} escape at the start of s and returns
+// its length.
+func unquoteCodePoint(s string) (rune, int, bool) {
+	if !strings.HasPrefix(s, "\\u{") {
+		return 0, 0, false
+	}
+	end := strings.IndexByte(s, '}')
+	if end < 0 || end-3 < 1 || end-3 > 6 {
+		return 0, 0, false
+	}
+	value, err := strconv.ParseUint(s[3:end], 16, 32)
+	if err != nil || !utf8.ValidRune(rune(value)) {
+		return 0, 0, false
+	}
+	return rune(value), end + 1, true
+}
+
+// unquoteName decodes an <emoji:name> or <emoji:U+HEX-HEX> token at the start
+// of s and returns its length.
+func unquoteName(state *parserState, s string) (string, int, bool) {
+	body, ok := strings.CutPrefix(s, quotedNamePrefix)
+	if !ok {
+		return "", 0, false
+	}
+	end := strings.IndexByte(body, '>')
+	if end < 0 {
+		return "", 0, false
+	}
+	token := body[:end]
+	n := len(quotedNamePrefix) + end + 1
+
+	if hex, ok := strings.CutPrefix(token, "U+"); ok {
+		var emoji strings.Builder
+		for part := range strings.SplitSeq(hex, "-") {
+			value, err := strconv.ParseUint(part, 16, 32)
+			if err != nil || len(part) == 0 || len(part) > 6 || !utf8.ValidRune(rune(value)) {
+				return "", 0, false
+			}
+			emoji.WriteRune(rune(value))
+		}
+		return emoji.String(), n, true
+	}
+	if !isShortcodeName(token) {
+		return "", 0, false
+	}
+	emoji, ok := state.lookupShortcode(token)
+	return emoji, n, ok
+}
@@ -0,0 +1,83 @@
+package emojiparser_test
+
+import (
+	"math/rand"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestQuoteEmojis(t *testing.T) {
+	tests := []struct {
+		content string
+		style   emojiparser.QuoteStyle
+		want    string
+	}{
+		{"hi 😄", emojiparser.QuoteCodePoints, `hi \u{1F604}`},
+		{"👨‍👩‍👧‍👦", emojiparser.QuoteCodePoints, `\u{1F468}\u{200D}\u{1F469}\u{200D}\u{1F467}\u{200D}\u{1F466}`},
+		{"hi 😄 :tada: <:pepe:12345678901234567>", emojiparser.QuoteNames, "hi <emoji:smile> :tada: <:pepe:12345678901234567>"},
+		{`C:\tmp`, emojiparser.QuoteCodePoints, `C:\\tmp`},
+		{"<emoji:smile>", emojiparser.QuoteNames, `\<emoji:smile>`},
+		{"<emoji:smile>", emojiparser.QuoteCodePoints, "<emoji:smile>"},
+	}
+	for _, tc := range tests {
+		if got := emojiparser.QuoteEmojis(tc.content, tc.style); got != tc.want {
+			t.Fatalf("QuoteEmojis(%q, %d) = %q, want %q", tc.content, tc.style, got, tc.want)
+		}
+	}
+}
+
+func TestQuoteNamesFallsBackToCodePoints(t *testing.T) {
+	// 🪅 is named "piñata", which is not usable in the notation.
+	got := emojiparser.QuoteEmojis("x🪅y", emojiparser.QuoteNames)
+	if want := "x<emoji:U+1FA85>y"; got != want {
+		t.Fatalf("QuoteEmojis = %q, want %q", got, want)
+	}
+	if back := emojiparser.UnquoteEmojis(got, emojiparser.QuoteNames); back != "x🪅y" {
+		t.Fatalf("UnquoteEmojis(%q) = %q", got, back)
+	}
+}
+
+func TestUnquoteEmojisKeepsInvalidNotation(t *testing.T) {
+	tests := []struct {
+		content string
+		style   emojiparser.QuoteStyle
+	}{
+		{`\u{110000}`, emojiparser.QuoteCodePoints},
+		{`\u{}`, emojiparser.QuoteCodePoints},
+		{`\u{1F604`, emojiparser.QuoteCodePoints},
+		{"<emoji:not_an_emoji>", emojiparser.QuoteNames},
+		{"<emoji:U+ZZ>", emojiparser.QuoteNames},
+		{"<emoji:smile", emojiparser.QuoteNames},
+	}
+	for _, tc := range tests {
+		if got := emojiparser.UnquoteEmojis(tc.content, tc.style); got != tc.content {
+			t.Fatalf("UnquoteEmojis(%q) = %q, want it unchanged", tc.content, got)
+		}
+	}
+}
+
+func TestQuoteEmojisRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(4))
+	corpus := []string{
+		"", `\`, `\\`, `\u{41}`, `\<emoji:smile>`, "<emoji:smile>", "<emoji:", "❤❤️❤︎",
+		"😄 :smile: <:pepe:12345678901234567> <a:wave:12345678901234567>",
+	}
+	for range 50 {
+		corpus = append(corpus, randomMessage(rng, 500)+`\<emoji:tada>\u{1F604}\`)
+	}
+
+	for _, style := range []emojiparser.QuoteStyle{emojiparser.QuoteCodePoints, emojiparser.QuoteNames} {
+		for _, content := range corpus {
+			quoted := emojiparser.QuoteEmojis(content, style)
+			for _, result := range emojiparser.Parse(quoted) {
+				if result.Type == emojiparser.EmojiTypeUnicode {
+					t.Fatalf("style %d: %q still contains %q", style, quoted, result.Unicode)
+				}
+			}
+			if got := emojiparser.UnquoteEmojis(quoted, style); got != content {
+				t.Fatalf("style %d: round trip of %q gave %q via %q", style, content, got, quoted)
+			}
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package emojiparser
+
+import (
+	"io"
+	"slices"
+)
+
+const (
+	// readerChunkSize is the size of the buffer ParseReader reads into.
+	readerChunkSize = 64 << 10
+	// readerMaxBuffer is how many bytes ParseReader holds without a place
+	// to cut them before it cuts them anyway.
+	readerMaxBuffer = 1 << 20
+)
+
+// ParseReader parses the emojis read from r using the default parser.
+func ParseReader(r io.Reader, fn func(ParsedEmoji) error) error {
+	return defaultParser.ParseReader(r, fn)
+}
+
+// ParseReader reads r to the end and calls fn for each emoji in it, in
+// position order, with positions as byte offsets from the start of the
+// stream. If fn returns an error, reading stops and ParseReader returns that
+// error; read errors other than io.EOF are returned as well.
+//
+// The stream is parsed in chunks that end after ASCII whitespace, as in
+// ParseParallel, so an emoji split across two reads is matched once, whole.
+// With markup skipping on, a chunk never ends inside a code span, code
+// block, spoiler, or link that may still be closed. The results are those
+// Parse would return for the whole stream, unless more than 1 MiB goes by
+// without such a place to cut: the stream is then cut anyway, off any emoji
+// found so far, and markup open at the cut is not carried over.
+func (p *DiscordEmojiParser) ParseReader(r io.Reader, fn func(ParsedEmoji) error) error {
+	buf := make([]byte, 0, readerChunkSize)
+	offset := 0
+	for {
+		buf = slices.Grow(buf, readerChunkSize)
+		n, err := r.Read(buf[len(buf) : len(buf)+readerChunkSize])
+		buf = buf[:len(buf)+n]
+		if err != nil && err != io.EOF {
+			return err
+		}
+
+		cut := len(buf)
+		if err == nil {
+			cut = p.streamCut(buf)
+		}
+		var results []ParsedEmoji
+		switch {
+		case cut > 0:
+			results = p.ParseBytes(buf[:cut])
+		case len(buf) >= readerMaxBuffer:
+			results = p.ParseBytes(buf)
+			cut = forcedCut(buf, results)
+		}
+		for _, emoji := range results {
+			if emoji.Position.To > cut {
+				break
+			}
+			emoji.Position.From += offset
+			emoji.Position.To += offset
+			if err := fn(emoji); err != nil {
+				return err
+			}
+		}
+		if cut > 0 {
+			buf = buf[:copy(buf, buf[cut:])]
+			offset += cut
+		}
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
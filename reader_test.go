@@ -0,0 +1,122 @@
+package emojiparser_test
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func parseReader(t *testing.T, r io.Reader) []emojiparser.ParsedEmoji {
+	t.Helper()
+	var results []emojiparser.ParsedEmoji
+	err := emojiparser.ParseReader(r, func(emoji emojiparser.ParsedEmoji) error {
+		results = append(results, emoji)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ParseReader: %v", err)
+	}
+	return results
+}
+
+func TestParseReaderAcrossChunks(t *testing.T) {
+	const chunk = 64 << 10
+	for _, emoji := range []string{"👨\u200d👩\u200d👧", ":smile:", "<:pepe:12345678901234567>"} {
+		content := strings.Repeat("x", chunk-3) + " " + emoji + " 😄 tail"
+		want := emojiparser.Parse(content)
+		if len(want) != 2 || want[0].Position.From >= chunk || want[0].Position.To <= chunk {
+			t.Fatalf("%q does not straddle the chunk boundary: %v", emoji, want)
+		}
+		if got := parseReader(t, strings.NewReader(content)); !reflect.DeepEqual(got, want) {
+			t.Fatalf("ParseReader(%q) = %v, want %v", emoji, got, want)
+		}
+	}
+}
+
+func TestParseReader(t *testing.T) {
+	content := strings.Repeat("gm 😄 :tada: <a:wave:12345678901234567>🔥\n", 3000)
+	want := emojiparser.Parse(content)
+	if got := parseReader(t, iotest.HalfReader(strings.NewReader(content))); !reflect.DeepEqual(got, want) {
+		t.Fatalf("ParseReader returned %d results, want %d", len(got), len(want))
+	}
+	long := strings.Repeat("😄", 40000)
+	if got := parseReader(t, strings.NewReader(long)); len(got) != 40000 {
+		t.Fatalf("ParseReader returned %d results for a run without whitespace, want 40000", len(got))
+	}
+}
+
+func TestParseReaderErrors(t *testing.T) {
+	stop := errors.New("stop")
+	calls := 0
+	err := emojiparser.ParseReader(strings.NewReader("😄 😄 😄"), func(emojiparser.ParsedEmoji) error {
+		calls++
+		return stop
+	})
+	if !errors.Is(err, stop) || calls != 1 {
+		t.Fatalf("ParseReader = %v after %d calls, want stop after 1", err, calls)
+	}
+	readErr := errors.New("read failed")
+	err = emojiparser.ParseReader(iotest.ErrReader(readErr), func(emojiparser.ParsedEmoji) error { return nil })
+	if !errors.Is(err, readErr) {
+		t.Fatalf("ParseReader = %v, want %v", err, readErr)
+	}
+}
+
+// markupStream has code spans, code blocks, spoilers, and masked links with
+// whitespace and emojis inside, for comparing the streaming APIs with Parse.
+var markupStream = strings.Repeat("a `code :smile: span` b ||spoiler 😄 here|| c\n```\nfenced :tada: block\n```\n"+
+	"[link 😄 text](https://x.com/a) :smile: one ` stray :tada: and `` two 😄 ``\n", 100)
+
+func TestParseReaderMarkdown(t *testing.T) {
+	parser := newTestParser(t, emojiparser.WithMarkdown(true))
+	want := parser.Parse(markupStream)
+	for _, r := range []io.Reader{strings.NewReader(markupStream), iotest.OneByteReader(strings.NewReader(markupStream))} {
+		var got []emojiparser.ParsedEmoji
+		err := parser.ParseReader(r, func(emoji emojiparser.ParsedEmoji) error {
+			got = append(got, emoji)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("ParseReader: %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("ParseReader returned %d results, Parse %d", len(got), len(want))
+		}
+	}
+}
+
+func TestParseReaderBoundedBuffer(t *testing.T) {
+	const size = 4 << 20
+	content := strings.Repeat("😄", size/4)
+	r := &countingReader{r: strings.NewReader(content)}
+	read := 0
+	err := emojiparser.ParseReader(r, func(emojiparser.ParsedEmoji) error {
+		if read == 0 {
+			read = r.n
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ParseReader: %v", err)
+	}
+	if read == 0 || read > 2<<20 {
+		t.Fatalf("first emoji reported after reading %d bytes, want the buffer capped", read)
+	}
+}
+
+// countingReader counts the bytes read through it.
+type countingReader struct {
+	r io.Reader
+	n int
+}
+
+func (c *countingReader) Read(b []byte) (int, error) {
+	n, err := c.r.Read(b)
+	c.n += n
+	return n, err
+}
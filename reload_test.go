@@ -0,0 +1,180 @@
+package emojiparser_test
+
+import (
+	"encoding/json"
+	"maps"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"testing/fstest"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func assetsFS(unicodeEmojis, unicodeEmojisSVG string) fstest.MapFS {
+	return fstest.MapFS{
+		"UnicodeEmojis.json":    {Data: []byte(unicodeEmojis)},
+		"UnicodeEmojisSVG.json": {Data: []byte(unicodeEmojisSVG)},
+	}
+}
+
+func TestReloadAssets(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser()
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+
+	err = parser.ReloadAssets(assetsFS(`{"grin": "😄", "😄": "grin"}`, `{"1f604": "feed"}`))
+	if err != nil {
+		t.Fatalf("reload: %v", err)
+	}
+	results := parser.Parse("😄 :grin: :smile:")
+	if len(results) != 2 {
+		t.Fatalf("expected 2 emojis after reload, got %d", len(results))
+	}
+	if results[0].Name != "grin" || results[1].Name != "grin" {
+		t.Fatalf("expected reloaded names, got %s and %s", results[0].Name, results[1].Name)
+	}
+
+	if err := parser.ReloadAssets(os.DirFS("assets")); err != nil {
+		t.Fatalf("reload from disk: %v", err)
+	}
+	if results := parser.Parse(":smile:"); len(results) != 1 {
+		t.Fatalf("expected original dataset after reload, got %d results", len(results))
+	}
+}
+
+func TestReloadAssetsKeepsStateOnError(t *testing.T) {
+	cases := map[string]struct {
+		fsys fstest.MapFS
+		want string
+	}{
+		"missing file": {
+			fsys: fstest.MapFS{"UnicodeEmojis.json": {Data: []byte(`{"grin": "😄"}`)}},
+			want: "UnicodeEmojisSVG.json",
+		},
+		"malformed json": {
+			fsys: assetsFS(`{"grin": "😄"`, `{}`),
+			want: "UnicodeEmojis.json",
+		},
+		"empty table": {
+			fsys: assetsFS(`{}`, `{}`),
+			want: "no entries",
+		},
+		"bad svg key": {
+			fsys: assetsFS(`{"grin": "😄"}`, `{"U+1F604": "feed"}`),
+			want: "U+1F604",
+		},
+	}
+
+	parser, err := emojiparser.NewDiscordEmojiParser()
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+	for name, tc := range cases {
+		err := parser.ReloadAssets(tc.fsys)
+		if err == nil {
+			t.Fatalf("%s: expected error", name)
+		}
+		if !strings.Contains(err.Error(), tc.want) {
+			t.Fatalf("%s: expected error mentioning %q, got %v", name, tc.want, err)
+		}
+		results := parser.Parse(":smile: 😄")
+		if len(results) != 2 || results[0].Name != "smile" {
+			t.Fatalf("%s: expected original state after failed reload", name)
+		}
+	}
+}
+
+func TestReloadAssetsConcurrentParse(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser()
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+	// Each dataset pairs a unicode name with an SVG hash, so a parse that mixed
+	// the two states would report one dataset's name with the other's link.
+	datasets := []fstest.MapFS{
+		assetsFS(`{"alpha": "😄", "😄": "alpha"}`, `{"1f604": "aaaa"}`),
+		assetsFS(`{"beta": "😄", "😄": "beta"}`, `{"1f604": "bbbb"}`),
+	}
+	if err := parser.ReloadAssets(datasets[0]); err != nil {
+		t.Fatalf("reload: %v", err)
+	}
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				results := parser.Parse("😄 :alpha: :beta:")
+				if len(results) != 2 {
+					t.Errorf("expected 2 emojis, got %d", len(results))
+					return
+				}
+				unicode, text := results[0], results[1]
+				wantHash := "aaaa"
+				if unicode.Name == "beta" {
+					wantHash = "bbbb"
+				}
+				if text.Name != unicode.Name || text.Link == nil || !strings.Contains(*text.Link, wantHash) {
+					t.Errorf("parse mixed states: unicode %q, text %q", unicode.Name, text.Name)
+					return
+				}
+			}
+		}()
+	}
+
+	for i := range 200 {
+		if err := parser.ReloadAssets(datasets[i%2]); err != nil {
+			t.Errorf("reload %d: %v", i, err)
+			break
+		}
+	}
+	close(done)
+	wg.Wait()
+}
+
+func TestAssetsMatchesLoadedTables(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := parser.Assets()
+	for file, table := range map[string]map[string]string{
+		"assets/UnicodeEmojis.json":    got.UnicodeEmojis,
+		"assets/UnicodeEmojisSVG.json": got.UnicodeEmojisSVG,
+	} {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("read %s: %v", file, err)
+		}
+		var want map[string]string
+		if err := json.Unmarshal(content, &want); err != nil {
+			t.Fatalf("parse %s: %v", file, err)
+		}
+		if !maps.Equal(table, want) {
+			t.Fatalf("Assets() differs from %s: %d entries, want %d", file, len(table), len(want))
+		}
+	}
+
+	delete(got.UnicodeEmojis, "smile")
+	if again := parser.Assets(); again.UnicodeEmojis["smile"] == "" {
+		t.Fatal("modifying the result of Assets() changed the parser's tables")
+	}
+
+	if _, err := parser.RegisterShortcode("octo", "🐙"); err != nil {
+		t.Fatalf("register shortcode: %v", err)
+	}
+	if got := parser.Assets().UnicodeEmojis["octo"]; got != "🐙" {
+		t.Fatalf("Assets() after RegisterShortcode: octo = %q, want 🐙", got)
+	}
+}
@@ -0,0 +1,164 @@
+package emojiparser
+
+import (
+	"html"
+	"strconv"
+	"strings"
+)
+
+// renderOptions holds the settings of a ToHTML call.
+type renderOptions struct {
+	class         string
+	width, height int
+}
+
+// RenderOption configures a single ToHTML call.
+type RenderOption func(*renderOptions)
+
+// WithImageClass sets the class attribute of emoji images. The default is
+// "emoji"; an empty class leaves the attribute out.
+func WithImageClass(class string) RenderOption {
+	return func(o *renderOptions) {
+		o.class = class
+	}
+}
+
+// WithImageSize sets the width and height attributes of emoji images. Zero
+// leaves an attribute out, which is the default.
+func WithImageSize(width, height int) RenderOption {
+	return func(o *renderOptions) {
+		o.width, o.height = width, height
+	}
+}
+
+// ToHTML renders content as HTML using the default parser.
+func ToHTML(content string, opts ...RenderOption) string {
+	return defaultParser.ToHTML(content, opts...)
+}
+
+// ToHTML renders content as HTML: text is escaped, and every emoji Parse
+// finds becomes an <img> of its Link, which for unicode and text emojis is
+// the Discord asset or the WithUnicodeLinkTemplate link. The alt text is the
+// unicode emoji, or :name: for custom emojis. An emoji without a link is
+// written as its escaped unicode instead, and one followed by U+FE0E, which
+// asks for it to be shown as text, is left as text with its selector. All
+// attribute values are escaped, so custom emoji names cannot inject markup.
+func (p *DiscordEmojiParser) ToHTML(content string, opts ...RenderOption) string {
+	o := renderOptions{class: "emoji"}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var b strings.Builder
+	b.Grow(len(content))
+	last := 0
+	for _, emoji := range p.Parse(content) {
+		if emoji.Presentation == PresentationText {
+			continue
+		}
+		b.WriteString(html.EscapeString(content[last:emoji.Position.From]))
+		writeEmojiImage(&b, emoji, o)
+		last = emoji.Position.To
+	}
+	b.WriteString(html.EscapeString(content[last:]))
+	return b.String()
+}
+
+// writeEmojiImage writes emoji as an <img> tag, or as its escaped unicode if
+// it has no link.
+func writeEmojiImage(b *strings.Builder, emoji ParsedEmoji, o renderOptions) {
+	if emoji.Link == nil {
+		b.WriteString(html.EscapeString(emoji.Unicode))
+		return
+	}
+	alt := emoji.Unicode
+	if emoji.Type == EmojiTypeCustom {
+		alt = ":" + emoji.Name + ":"
+	}
+	b.WriteString("<img")
+	if o.class != "" {
+		writeAttr(b, "class", o.class)
+	}
+	writeAttr(b, "src", *emoji.Link)
+	writeAttr(b, "alt", alt)
+	if o.width > 0 {
+		writeAttr(b, "width", strconv.Itoa(o.width))
+	}
+	if o.height > 0 {
+		writeAttr(b, "height", strconv.Itoa(o.height))
+	}
+	b.WriteString(">")
+}
+
+// writeAttr writes a quoted, escaped HTML attribute.
+func writeAttr(b *strings.Builder, name, value string) {
+	b.WriteString(" ")
+	b.WriteString(name)
+	b.WriteString(`="`)
+	b.WriteString(html.EscapeString(value))
+	b.WriteString(`"`)
+}
+
+// markdownSpecial lists the characters ToMarkdown escapes with a backslash.
+const markdownSpecial = "\\`*_{}[]()#+-.!|<>~"
+
+// ToMarkdown renders content as Markdown using the default parser.
+func ToMarkdown(content string) string {
+	return defaultParser.ToMarkdown(content)
+}
+
+// ToMarkdown renders content as Markdown with every emoji Parse finds written
+// as an image, ![name](link), using the emoji's Link as ToHTML does. An emoji
+// without a link, or followed by U+FE0E, is kept as it is, as in ToHTML.
+// Emojis Parse leaves out, such as those in code spans with
+// WithSkipCodeSpans, are kept as text. With
+// WithEscapeMarkdownText, Markdown syntax in the rest of content is escaped
+// so that the document renders the message literally.
+func (p *DiscordEmojiParser) ToMarkdown(content string) string {
+	var b strings.Builder
+	b.Grow(len(content))
+	last := 0
+	for _, emoji := range p.Parse(content) {
+		if emoji.Presentation == PresentationText {
+			continue
+		}
+		p.writeMarkdownText(&b, content[last:emoji.Position.From])
+		if emoji.Link == nil {
+			b.WriteString(content[emoji.Position.From:emoji.Position.To])
+		} else {
+			b.WriteString("![")
+			writeMarkdownEscaped(&b, emoji.Name)
+			b.WriteString("](")
+			b.WriteString(*emoji.Link)
+			b.WriteString(")")
+		}
+		last = emoji.Position.To
+	}
+	p.writeMarkdownText(&b, content[last:])
+	return b.String()
+}
+
+// writeMarkdownText writes text, escaped if the options ask for it.
+func (p *DiscordEmojiParser) writeMarkdownText(b *strings.Builder, text string) {
+	if !p.opts.EscapeMarkdownText {
+		b.WriteString(text)
+		return
+	}
+	writeMarkdownEscaped(b, text)
+}
+
+// writeMarkdownEscaped writes text with a backslash before every Markdown
+// special character.
+func writeMarkdownEscaped(b *strings.Builder, text string) {
+	for {
+		i := strings.IndexAny(text, markdownSpecial)
+		if i < 0 {
+			b.WriteString(text)
+			return
+		}
+		b.WriteString(text[:i])
+		b.WriteByte('\\')
+		b.WriteByte(text[i])
+		text = text[i+1:]
+	}
+}
@@ -0,0 +1,93 @@
+package emojiparser_test
+
+import (
+	"strings"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestToHTML(t *testing.T) {
+	content := `a<b & "c" 😄 <:pepe:12345678901234567>`
+	smile := emojiparser.Parse("😄")[0]
+	want := `a&lt;b &amp; &#34;c&#34; ` +
+		`<img class="emoji" src="` + *smile.Link + `" alt="😄"> ` +
+		`<img class="emoji" src="https://cdn.discordapp.com/emojis/12345678901234567.png" alt=":pepe:">`
+	if got := emojiparser.ToHTML(content); got != want {
+		t.Fatalf("ToHTML = %q, want %q", got, want)
+	}
+}
+
+func TestToHTMLOptions(t *testing.T) {
+	got := emojiparser.ToHTML(":tada:", emojiparser.WithImageClass(`big" onload="x`), emojiparser.WithImageSize(32, 24))
+	if !strings.Contains(got, `class="big&#34; onload=&#34;x"`) || !strings.Contains(got, `alt="🎉" width="32" height="24">`) {
+		t.Fatalf("ToHTML = %q", got)
+	}
+	if got := emojiparser.ToHTML("😄", emojiparser.WithImageClass("")); strings.Contains(got, "class=") {
+		t.Fatalf("ToHTML with no class = %q", got)
+	}
+}
+
+func TestToHTMLWithoutLink(t *testing.T) {
+	parser := newTestParser(t)
+	sequence := "\U0001F419\u200d\U0001F308"
+	if _, err := parser.MergeAssets(&emojiparser.Assets{
+		UnicodeEmojis: map[string]string{"rainbow_octopus": sequence},
+	}); err != nil {
+		t.Fatalf("merge: %v", err)
+	}
+	if emoji := parser.Parse(sequence); len(emoji) != 1 || emoji[0].Link != nil {
+		t.Fatalf("test emoji has a link: %v", emoji)
+	}
+	if got, want := parser.ToHTML("<"+sequence+">"), "&lt;"+sequence+"&gt;"; got != want {
+		t.Fatalf("ToHTML = %q, want %q", got, want)
+	}
+}
+
+func TestToHTMLTextPresentation(t *testing.T) {
+	parser := newTestParser(t, emojiparser.WithMatchBareTextSymbols(true))
+	for _, content := range []string{"✈\uFE0E", "fly ✈\uFE0E now", "😄\uFE0E"} {
+		if got := parser.ToHTML(content); got != content {
+			t.Fatalf("ToHTML(%+q) = %+q, want the text left as it is", content, got)
+		}
+	}
+	if got := parser.ToHTML("✈\uFE0F"); !strings.HasPrefix(got, "<img") || !strings.HasSuffix(got, ">") {
+		t.Fatalf("ToHTML of an emoji-presentation airplane = %+q, want an image", got)
+	}
+}
+
+func TestToHTMLRoundTrip(t *testing.T) {
+	content := "hi 😄 :tada: <a:wave:12345678901234567> 👍🏽"
+	parsed := emojiparser.ParseHTML(emojiparser.ToHTML(content))
+	want := emojiparser.Parse(content)
+	if len(parsed) != len(want) {
+		t.Fatalf("ParseHTML(ToHTML) found %d emojis, want %d", len(parsed), len(want))
+	}
+	for i := range parsed {
+		if parsed[i].Unicode != want[i].Unicode && parsed[i].Name != want[i].Name {
+			t.Fatalf("emoji %d = %v, want %v", i, parsed[i], want[i])
+		}
+	}
+}
+
+func TestToMarkdown(t *testing.T) {
+	smile := emojiparser.Parse("😄")[0]
+	content := "*hi* 😄 <a:party_blob:12345678901234567>"
+	want := "*hi* ![smile](" + *smile.Link + ") ![party\\_blob](https://cdn.discordapp.com/emojis/12345678901234567.gif)"
+	if got := emojiparser.ToMarkdown(content); got != want {
+		t.Fatalf("ToMarkdown = %q, want %q", got, want)
+	}
+	if got := emojiparser.ToMarkdown("hi 😄\uFE0E"); got != "hi 😄\uFE0E" {
+		t.Fatalf("ToMarkdown of a text-presentation emoji = %+q, want it unchanged", got)
+	}
+}
+
+func TestToMarkdownOptions(t *testing.T) {
+	parser := newTestParser(t, emojiparser.WithEscapeMarkdownText(true), emojiparser.WithSkipCodeSpans(true))
+	smile := emojiparser.Parse(":smile:")[0]
+	content := "`😄` *x* :smile:"
+	want := "\\`😄\\` \\*x\\* ![smile](" + *smile.Link + ")"
+	if got := parser.ToMarkdown(content); got != want {
+		t.Fatalf("ToMarkdown = %q, want %q", got, want)
+	}
+}
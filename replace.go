@@ -0,0 +1,54 @@
+package emojiparser
+
+import (
+	"slices"
+	"strings"
+)
+
+// Replace rewrites each emoji in content with fn using the default parser.
+func Replace(content string, fn func(ParsedEmoji) string) string {
+	return defaultParser.Replace(content, fn)
+}
+
+// ReplaceTypes rewrites emojis of the given types with fn using the default
+// parser.
+func ReplaceTypes(content string, fn func(ParsedEmoji) string, types ...EmojiType) string {
+	return defaultParser.ReplaceTypes(content, fn, types...)
+}
+
+// Replace calls fn for each emoji Parse finds in content, in order, and
+// returns content with every emoji replaced by what fn returned. Text between
+// emojis is copied unchanged, so a fn that returns
+// content[e.Position.From:e.Position.To] gives back content as it was.
+func (p *DiscordEmojiParser) Replace(content string, fn func(ParsedEmoji) string) string {
+	return p.ReplaceTypes(content, fn)
+}
+
+// ReplaceTypes is Replace restricted to the given emoji types, or all types if
+// none are given. Emojis of other types are kept as they are and fn is not
+// called for them. Like strings.Replace, it returns content itself when
+// there is nothing to replace.
+func (p *DiscordEmojiParser) ReplaceTypes(content string, fn func(ParsedEmoji) string, types ...EmojiType) string {
+	emojis := p.Parse(content)
+	if len(emojis) == 0 {
+		return content
+	}
+	var b strings.Builder
+	last := 0
+	for _, emoji := range emojis {
+		if len(types) > 0 && !slices.Contains(types, emoji.Type) {
+			continue
+		}
+		if last == 0 {
+			b.Grow(len(content))
+		}
+		b.WriteString(content[last:emoji.Position.From])
+		b.WriteString(fn(emoji))
+		last = emoji.Position.To
+	}
+	if last == 0 {
+		return content
+	}
+	b.WriteString(content[last:])
+	return b.String()
+}
@@ -0,0 +1,48 @@
+package emojiparser_test
+
+import (
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestReplace(t *testing.T) {
+	content := "hi 😄 <:pepe:12345678901234567> :tada:!"
+	got := emojiparser.Replace(content, func(e emojiparser.ParsedEmoji) string {
+		return "[" + string(e.Type) + "]"
+	})
+	if want := "hi [unicode] [custom] [text]!"; got != want {
+		t.Fatalf("Replace = %q, want %q", got, want)
+	}
+}
+
+func TestReplaceRoundTrip(t *testing.T) {
+	for _, content := range []string{
+		"",
+		"plain text",
+		"😄🔥:tada:<a:wave:12345678901234567>",
+		"a👨\u200d👩\u200d👧b :smile::skin-tone-2: é",
+	} {
+		got := emojiparser.Replace(content, func(e emojiparser.ParsedEmoji) string {
+			return content[e.Position.From:e.Position.To]
+		})
+		if got != content {
+			t.Fatalf("Replace round trip = %q, want %q", got, content)
+		}
+	}
+}
+
+func TestReplaceTypes(t *testing.T) {
+	content := "😄 <:pepe:12345678901234567> :tada:"
+	calls := 0
+	got := emojiparser.ReplaceTypes(content, func(e emojiparser.ParsedEmoji) string {
+		calls++
+		return ":" + e.Name + ":"
+	}, emojiparser.EmojiTypeCustom)
+	if want := "😄 :pepe: :tada:"; got != want {
+		t.Fatalf("ReplaceTypes = %q, want %q", got, want)
+	}
+	if calls != 1 {
+		t.Fatalf("fn called %d times, want 1", calls)
+	}
+}
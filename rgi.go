@@ -0,0 +1,46 @@
+package emojiparser
+
+import (
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// rgiEmojis holds the emojis of the embedded tables without U+FE0F, which
+// are the RGI emojis, less the lone regional indicators the tables name
+// :regional_indicator_x:.
+var rgiEmojis = sync.OnceValue(func() map[string]bool {
+	assets, err := defaultAssets()
+	if err != nil {
+		return nil
+	}
+	emojis := make(map[string]bool, len(assets.UnicodeEmojis))
+	for key := range assets.UnicodeEmojis {
+		if !containsNonASCII(key) || !isEmojiKey(key) {
+			continue
+		}
+		if r, size := utf8.DecodeRuneInString(key); size == len(key) {
+			if _, ok := regionalIndicatorLetter(r); ok {
+				continue
+			}
+		}
+		emojis[strings.ReplaceAll(key, variationSelector16, "")] = true
+	}
+	return emojis
+})
+
+// IsRGI reports whether s is a single RGI emoji using the default parser.
+func IsRGI(s string) bool {
+	return defaultParser.IsRGI(s)
+}
+
+// IsRGI reports whether s is exactly one emoji of the recommended for general
+// interchange (RGI) set, the emojis platforms such as Discord are expected to
+// support, written fully qualified. Sequences made of valid parts that are
+// not in the set, such as a flag with a skin tone or an unlisted ZWJ
+// sequence, are not RGI, and neither are emojis added with MergeAssets or
+// RegisterShortcode.
+func (p *DiscordEmojiParser) IsRGI(s string) bool {
+	bare := strings.ReplaceAll(s, variationSelector16, "")
+	return rgiEmojis()[bare] && addVS16(bare) == s
+}
@@ -0,0 +1,55 @@
+package emojiparser_test
+
+import (
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestIsRGI(t *testing.T) {
+	tests := map[string]bool{
+		"😄":             true,
+		"❤\uFE0F":       true,
+		"1\uFE0F\u20E3": true,
+		"👍🏽":            true,
+		"🇺🇸":            true,
+		"🏴\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007F": true,
+		"👨\u200D👩\u200D👧\u200D👦":                                        true,
+		"🏳\uFE0F\u200D🌈":                                                true,
+		"🫱🏻\u200D🫲🏿":                                                    true,
+		"©\uFE0F":                                                       true,
+
+		// Not fully qualified, or with extra selectors.
+		"❤":        false,
+		"1\u20E3":  false,
+		"🏳\u200D🌈": false,
+		"😄\uFE0F":  false,
+		"❤\uFE0E":  false,
+		// Valid parts, but not RGI.
+		"🇺🇸🏽":      false,
+		"👪🏽":       false,
+		"🇺":        false,
+		"🇿🇿":       false,
+		"🐙\u200D🌈": false,
+		"🏴\U000E0075\U000E0073\U000E0074\U000E0078\U000E007F": false,
+		// Extra characters.
+		"😄😄":      false,
+		" 😄":      false,
+		"😄\u200D": false,
+		":smile:": false,
+		"":        false,
+	}
+	for input, want := range tests {
+		if got := emojiparser.IsRGI(input); got != want {
+			t.Fatalf("IsRGI(%+q) = %v, want %v", input, got, want)
+		}
+	}
+
+	parser := newTestParser(t)
+	if _, err := parser.RegisterShortcode("rainbow_octopus", "🐙\u200D🌈"); err != nil {
+		t.Fatalf("RegisterShortcode: %v", err)
+	}
+	if parser.IsRGI("🐙\u200D🌈") {
+		t.Fatalf("IsRGI accepted a registered sequence")
+	}
+}
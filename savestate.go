@@ -0,0 +1,214 @@
+package emojiparser
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"slices"
+)
+
+// stateMagic starts every file written by SaveState. stateVersion must be
+// incremented whenever the layout written after it changes.
+const (
+	stateMagic   = "EMOJIPARSERSTATE"
+	stateVersion = 1
+)
+
+// Errors returned by LoadState. They are wrapped with details, so compare
+// with errors.Is.
+var (
+	ErrStateFormat  = errors.New("invalid parser state")
+	ErrStateVersion = errors.New("unsupported parser state version")
+)
+
+// SaveState writes the parser's tables, indexes, and custom emoji
+// registrations to w in a versioned binary format that LoadState reads back
+// without validating the tables or sorting anything. Options are not saved.
+// The output is deterministic for a given state.
+//
+// The layout is the magic string, the version as a uvarint, the unicode keys
+// in scan order with their names, the name, SVG, and preferred name tables
+// sorted by key, the custom emoji registrations, and a little-endian CRC-32
+// of everything before it. Strings are written as a uvarint length followed
+// by their bytes.
+func (p *DiscordEmojiParser) SaveState(w io.Writer) error {
+	state := p.state.Load()
+
+	buf := bytes.NewBufferString(stateMagic)
+	enc := stateEncoder{buf: buf}
+	enc.uvarint(stateVersion)
+
+	enc.uvarint(uint64(len(state.unicodeKeys)))
+	for _, key := range state.unicodeKeys {
+		enc.string(key)
+		enc.string(state.unicodeToName[key])
+	}
+	enc.table(state.nameToUnicode)
+	enc.table(state.svg)
+	enc.table(state.preferred)
+
+	names := state.customNames()
+	enc.uvarint(uint64(len(names)))
+	for _, name := range names {
+		enc.string(name)
+		enc.uvarint(uint64(len(state.customEmojis[name])))
+		for _, id := range state.customEmojis[name] {
+			enc.string(id)
+		}
+	}
+
+	buf.Write(binary.LittleEndian.AppendUint32(nil, crc32.ChecksumIEEE(buf.Bytes())))
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// LoadState creates a parser from the output of SaveState, configured with
+// opts. It fails with ErrStateVersion if the data was written by a different
+// version of the format, and with ErrStateFormat if it is not a saved state
+// or is damaged, including when the unicode keys are not in scan order,
+// longest first. The key trie the scan matches with is not saved, so loading
+// still rebuilds it from the keys.
+func LoadState(r io.Reader, opts ...Option) (*DiscordEmojiParser, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("load state: %w", err)
+	}
+	if len(data) < len(stateMagic)+4 || string(data[:len(stateMagic)]) != stateMagic {
+		return nil, fmt.Errorf("load state: %w: missing header", ErrStateFormat)
+	}
+
+	// The version is checked before the checksum, so that files from another
+	// version are reported as such even though their layout differs.
+	dec := stateDecoder{data: string(data[len(stateMagic) : len(data)-4])}
+	if version := dec.uvarint(); dec.err != nil || version != stateVersion {
+		return nil, fmt.Errorf("load state: %w: got %d, want %d", ErrStateVersion, version, stateVersion)
+	}
+	body, sum := data[:len(data)-4], binary.LittleEndian.Uint32(data[len(data)-4:])
+	if crc32.ChecksumIEEE(body) != sum {
+		return nil, fmt.Errorf("load state: %w: checksum mismatch", ErrStateFormat)
+	}
+
+	state := &parserState{}
+	n := dec.count()
+	state.unicodeKeys = make([]string, 0, n)
+	state.unicodeToName = make(map[string]string, n)
+	for range n {
+		key := dec.string()
+		state.unicodeKeys = append(state.unicodeKeys, key)
+		state.unicodeToName[key] = dec.string()
+	}
+	state.nameToUnicode = dec.table()
+	state.svg = dec.table()
+	state.preferred = dec.table()
+
+	if n := dec.count(); n > 0 {
+		state.customEmojis = make(map[string][]string, n)
+		for range n {
+			name := dec.string()
+			ids := make([]string, dec.count())
+			for i := range ids {
+				ids[i] = dec.string()
+			}
+			state.customEmojis[name] = ids
+		}
+	}
+
+	if dec.err == nil && dec.data != "" {
+		dec.err = errors.New("trailing data")
+	}
+	if dec.err == nil && slices.ContainsFunc(state.unicodeKeys, func(key string) bool { return key == "" }) {
+		dec.err = errors.New("empty unicode key")
+	}
+	if dec.err == nil && !slices.IsSortedFunc(state.unicodeKeys, func(a, b string) int { return len(b) - len(a) }) {
+		dec.err = errors.New("unicode keys are not sorted longest first")
+	}
+	if dec.err != nil {
+		return nil, fmt.Errorf("load state: %w: %v", ErrStateFormat, dec.err)
+	}
+	return newParser(indexState(state), opts)
+}
+
+type stateEncoder struct {
+	buf *bytes.Buffer
+}
+
+func (e stateEncoder) uvarint(v uint64) {
+	e.buf.Write(binary.AppendUvarint(nil, v))
+}
+
+func (e stateEncoder) string(s string) {
+	e.uvarint(uint64(len(s)))
+	e.buf.WriteString(s)
+}
+
+func (e stateEncoder) table(m map[string]string) {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	e.uvarint(uint64(len(keys)))
+	for _, key := range keys {
+		e.string(key)
+		e.string(m[key])
+	}
+}
+
+// stateDecoder reads from a single string, so decoded strings share its
+// memory. After the first error every read returns a zero value.
+type stateDecoder struct {
+	data string
+	err  error
+}
+
+func (d *stateDecoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	var v uint64
+	for i := 0; i < len(d.data) && i < binary.MaxVarintLen64; i++ {
+		b := d.data[i]
+		v |= uint64(b&0x7f) << (7 * i)
+		if b < 0x80 {
+			d.data = d.data[i+1:]
+			return v
+		}
+	}
+	d.err = errors.New("bad varint")
+	return 0
+}
+
+// count reads a length and checks it against the remaining data, in which
+// every counted item takes at least one byte.
+func (d *stateDecoder) count() int {
+	n := d.uvarint()
+	if n > uint64(len(d.data)) {
+		d.err = errors.New("count exceeds data")
+		return 0
+	}
+	return int(n)
+}
+
+func (d *stateDecoder) string() string {
+	n := d.count()
+	if d.err != nil {
+		return ""
+	}
+	s := d.data[:n]
+	d.data = d.data[n:]
+	return s
+}
+
+func (d *stateDecoder) table() map[string]string {
+	n := d.count()
+	m := make(map[string]string, n)
+	for range n {
+		key := d.string()
+		m[key] = d.string()
+	}
+	return m
+}
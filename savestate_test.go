@@ -0,0 +1,128 @@
+package emojiparser_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"reflect"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func savedState(t testing.TB, parser *emojiparser.DiscordEmojiParser) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := parser.SaveState(&buf); err != nil {
+		t.Fatalf("save state: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestSaveLoadStateRoundTrip(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := parser.RegisterShortcode("octo", "🐙"); err != nil {
+		t.Fatalf("register shortcode: %v", err)
+	}
+	if err := parser.RegisterCustomEmoji("wave", "12345678901234567"); err != nil {
+		t.Fatalf("register custom emoji: %v", err)
+	}
+
+	data := savedState(t, parser)
+	if again := savedState(t, parser); !bytes.Equal(data, again) {
+		t.Fatal("SaveState output is not deterministic")
+	}
+
+	loaded, err := emojiparser.LoadState(bytes.NewReader(data), emojiparser.WithCustomLinkTemplate("https://img.example/{id}"))
+	if err != nil {
+		t.Fatalf("load state: %v", err)
+	}
+
+	content := "hi 😄 :octo: 👨‍👩‍👧‍👦 1️⃣ :flag_de: piñata <:pepe:12345678901234567>"
+	want, got := parser.Parse(content), loaded.Parse(content)
+	if len(got) != len(want) {
+		t.Fatalf("loaded parser found %d results, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i].Name != want[i].Name || got[i].Unicode != want[i].Unicode || got[i].Position != want[i].Position {
+			t.Fatalf("result %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+	if link := *got[len(got)-1].Link; link != "https://img.example/12345678901234567" {
+		t.Fatalf("options not applied: custom link %q", link)
+	}
+	if !loaded.ContainsEmojiNamed("<:pepe:12345678901234567>", "wave") {
+		t.Fatal("custom emoji registration lost")
+	}
+	if !reflect.DeepEqual(loaded.Assets(), parser.Assets()) {
+		t.Fatal("loaded tables differ from the saved ones")
+	}
+	if !bytes.Equal(savedState(t, loaded), data) {
+		t.Fatal("saving a loaded state gives different output")
+	}
+}
+
+func TestLoadStateErrors(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data := savedState(t, parser)
+
+	newer := bytes.Clone(data)
+	newer[len("EMOJIPARSERSTATE")] = 2
+	corrupt := bytes.Clone(data)
+	corrupt[len(corrupt)/2] ^= 0xff
+
+	cases := []struct {
+		name string
+		data []byte
+		want error
+	}{
+		{"empty", nil, emojiparser.ErrStateFormat},
+		{"not a state", []byte(`{"UnicodeEmojis": {}}`), emojiparser.ErrStateFormat},
+		{"other version", newer, emojiparser.ErrStateVersion},
+		{"corrupt", corrupt, emojiparser.ErrStateFormat},
+		{"truncated", data[:len(data)/2], emojiparser.ErrStateFormat},
+	}
+	for _, tc := range cases {
+		if _, err := emojiparser.LoadState(bytes.NewReader(tc.data)); !errors.Is(err, tc.want) {
+			t.Fatalf("%s: error = %v, want %v", tc.name, err, tc.want)
+		}
+	}
+}
+
+func TestLoadStateKeyOrder(t *testing.T) {
+	// A hand-made state with its keys shortest first, which would cap the
+	// match length at that of 😄.
+	data := []byte("EMOJIPARSERSTATE\x01\x02")
+	for _, s := range []string{"😄", "smile", "👨\u200D👩\u200D👧", "family_mwg"} {
+		data = append(data, byte(len(s)))
+		data = append(data, s...)
+	}
+	data = append(data, 0, 0, 0, 0)
+	data = binary.LittleEndian.AppendUint32(data, crc32.ChecksumIEEE(data))
+
+	if _, err := emojiparser.LoadState(bytes.NewReader(data)); !errors.Is(err, emojiparser.ErrStateFormat) {
+		t.Fatalf("error = %v, want %v", err, emojiparser.ErrStateFormat)
+	}
+}
+
+func BenchmarkLoadState(b *testing.B) {
+	parser, err := emojiparser.NewDiscordEmojiParser()
+	if err != nil {
+		b.Fatal(err)
+	}
+	data := savedState(b, parser)
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := emojiparser.LoadState(bytes.NewReader(data)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
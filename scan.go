@@ -0,0 +1,405 @@
+package emojiparser
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// scanKinds selects the emoji types scan recognizes.
+type scanKinds uint8
+
+const (
+	scanCustom scanKinds = 1 << iota
+	scanUnicode
+	scanText
+
+	scanAll = scanCustom | scanUnicode | scanText
+)
+
+// token is an emoji found by scan.
+type token struct {
+	kind         EmojiType
+	from, to     int
+	name         string // shortcode or custom emoji name
+	unicode      string // unicode emoji, or the emoji a shortcode stands for
+	key          string // key a unicode emoji is named after
+	id           string // custom emoji id
+	animated     bool
+	presentation Presentation
+}
+
+// scan finds the emojis of the given kinds in a single left-to-right pass
+// over content and calls yield for each, in order, until yield returns
+// false. Tokens never overlap:
+// shortcodes inside custom markup are part of the markup, and no unicode key
+// contains the ASCII characters shortcodes and markup are made of.
+//
+// Shortcodes may share a colon, as in ":joy:sob:" or "a:b:smile:": scanning
+// resumes at the closing colon of every :name:, and a shortcode whose opening
+// colon closed the one reported before it starts after that colon.
+//
+// Matches overlapping skipRanges, or the markup the options say to leave
+// alone, are not reported. A unicode emoji running into one is dropped
+// whole, and scanning resumes after its first character. With the Escapes
+// option, escaped markup is skipped like a skip range.
+func (p *DiscordEmojiParser) scan(state *parserState, content string, kinds scanKinds, skipRanges []ParsedEmoji, yield func(token) bool) {
+	p.scanProgress(state, content, kinds, skipRanges, nil, yield)
+}
+
+// scanProgress is scan, calling progress, if it is not nil, with the offset
+// reached before each token is looked for, so that a long stretch without
+// emojis can still be stopped. Scanning stops when progress returns false.
+func (p *DiscordEmojiParser) scanProgress(state *parserState, content string, kinds scanKinds, skipRanges []ParsedEmoji, progress func(int) bool, yield func(token) bool) {
+	skip := newSkipCursor(skipRanges, p.markupSkipRanges(content))
+	textEnd := 0 // end of the last shortcode reported
+	for i := 0; i < len(content); {
+		// Jump over ASCII bytes that cannot start any token.
+		for i < len(content) && content[i] < utf8.RuneSelf && content[i] != '<' && content[i] != ':' && !state.asciiStarts[content[i]] {
+			i++
+		}
+		if i == len(content) {
+			break
+		}
+		if progress != nil && !progress(i) {
+			return
+		}
+
+		switch content[i] {
+		case '<':
+			// Escaped markup is skipped whole even when custom emojis are
+			// not wanted, so that its name is not taken for a shortcode.
+			if kinds&scanCustom != 0 || p.opts.Escapes {
+				if t, ok := p.matchCustom(content, i); ok && !skip.overlaps(i, t.to) {
+					if escaped := p.escaped(content, i); escaped || kinds&scanCustom != 0 {
+						if !escaped && !yield(t) {
+							return
+						}
+						i = t.to
+						continue
+					}
+				}
+			}
+		case ':', fullwidthColon[0]:
+			if kinds&scanText != 0 {
+				if name, to, ok := matchShortcode(content, i, p.opts.AcceptFullwidthColons); ok {
+					if p.escaped(content, i) {
+						i = to
+						continue
+					}
+					// The closing colon may open the next shortcode, as in
+					// ":joy:sob:". Only a reported shortcode keeps it, so
+					// the next one starts after it.
+					from := max(i, textEnd)
+					if unicode, ok := p.lookupShortcode(state, name); ok && !skip.overlaps(from, to) {
+						if tone, end, ok := toneShortcode(content, to); ok && !skip.overlaps(to, end) {
+							unicode = withSkinTone(unicode, tone)
+							to = end
+						}
+						if !yield(token{kind: EmojiTypeText, from: from, to: to, name: name, unicode: unicode}) {
+							return
+						}
+						textEnd = to
+					}
+					i = closingColon(content, to)
+					continue
+				}
+			}
+		}
+		if i < textEnd {
+			// The closing colon of the last shortcode opens no other one,
+			// and is not free for a unicode key starting with it.
+			i = textEnd
+			continue
+		}
+
+		if content[i] < utf8.RuneSelf && !state.asciiStarts[content[i]] {
+			i++
+			continue
+		}
+		if kinds&scanUnicode == 0 || skip.overlaps(i, i+1) {
+			_, size := utf8.DecodeRuneInString(content[i:])
+			i += size
+			continue
+		}
+
+		n, key := state.matchSequence(content[i:])
+		if n == 0 {
+			_, size := utf8.DecodeRuneInString(content[i:])
+			i += size
+			continue
+		}
+
+		to := i + n
+		if skip.overlaps(i, to) {
+			_, size := utf8.DecodeRuneInString(content[i:])
+			i += size
+			continue
+		}
+		match := content[i:to]
+		presentation := presentationOf(match, content[to:])
+		if p.leavesOut(state, match, key, presentation) {
+			i = to
+			continue
+		}
+		if !yield(token{kind: EmojiTypeUnicode, from: i, to: to, unicode: match, key: key, presentation: presentation}) {
+			return
+		}
+		i = to
+	}
+}
+
+// lookupShortcode resolves a shortcode name, folding its case if the
+// CaseInsensitiveShortcodes option is set. Names are ASCII, so the fold is
+// too. A name the tables lack that ends in _tone1 to _tone5 resolves to its
+// base with that skin tone, if the base takes one.
+func (p *DiscordEmojiParser) lookupShortcode(state *parserState, name string) (string, bool) {
+	unicode, ok := state.lookupShortcode(name)
+	if !ok && p.opts.CaseInsensitiveShortcodes {
+		unicode, ok = state.lookupShortcode(strings.ToLower(name))
+	}
+	if ok {
+		return unicode, true
+	}
+	if base, tone, ok := cutToneSuffix(name); ok {
+		if unicode, ok := p.lookupShortcode(state, base); ok && takesSkinTone(unicode) {
+			return withSkinTone(unicode, tone), true
+		}
+	}
+	return "", false
+}
+
+// escaped reports whether the markup at content[i:] is escaped with an odd
+// number of backslashes before it, if the Escapes option is set.
+func (p *DiscordEmojiParser) escaped(content string, i int) bool {
+	if !p.opts.Escapes {
+		return false
+	}
+	backslashes := 0
+	for i > 0 && content[i-1] == '\\' {
+		backslashes++
+		i--
+	}
+	return backslashes%2 == 1
+}
+
+// skipCursor answers whether intervals overlap a set of skip ranges, for
+// intervals that start at nondecreasing offsets as scan visits them.
+type skipCursor struct {
+	ranges []EmojiPosition // disjoint and sorted
+	next   int             // first range that may still overlap
+}
+
+// newSkipCursor sorts and merges the positions of ranges and extra, which
+// may come in any order and overlap each other.
+func newSkipCursor(ranges []ParsedEmoji, extra []EmojiPosition) skipCursor {
+	if len(ranges)+len(extra) == 0 {
+		return skipCursor{}
+	}
+	sorted := make([]EmojiPosition, 0, len(ranges)+len(extra))
+	for _, r := range ranges {
+		if r.Position.From < r.Position.To {
+			sorted = append(sorted, r.Position)
+		}
+	}
+	for _, r := range extra {
+		if r.From < r.To {
+			sorted = append(sorted, r)
+		}
+	}
+	slices.SortFunc(sorted, func(a, b EmojiPosition) int { return cmp.Compare(a.From, b.From) })
+	merged := sorted[:0]
+	for _, r := range sorted {
+		if last := len(merged) - 1; last >= 0 && r.From <= merged[last].To {
+			merged[last].To = max(merged[last].To, r.To)
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return skipCursor{ranges: merged}
+}
+
+// overlaps reports whether [from, to) intersects a skip range. from must not
+// be less than in the previous call.
+func (c *skipCursor) overlaps(from, to int) bool {
+	for c.next < len(c.ranges) && c.ranges[c.next].To <= from {
+		c.next++
+	}
+	return c.next < len(c.ranges) && c.ranges[c.next].From < to
+}
+
+// Discord's limits on custom emoji name lengths, enforced with
+// StrictCustomNames.
+const (
+	minCustomNameLen = 2
+	maxCustomNameLen = 32
+)
+
+// matchCustom matches custom emoji markup, <:name:id> or <a:name:id> with a
+// valid snowflake id, at content[from:].
+func (p *DiscordEmojiParser) matchCustom(content string, from int) (token, bool) {
+	i := from + 1
+	animated := strings.HasPrefix(content[i:], "a:")
+	if animated {
+		i++
+	}
+	if i >= len(content) || content[i] != ':' {
+		return token{}, false
+	}
+	nameStart := i + 1
+	nameEnd := nameStart
+	for nameEnd < len(content) && isWordByte(content[nameEnd]) {
+		nameEnd++
+	}
+	if nameEnd == nameStart || nameEnd >= len(content) || content[nameEnd] != ':' {
+		return token{}, false
+	}
+	if p.opts.StrictCustomNames && (nameEnd-nameStart < minCustomNameLen || nameEnd-nameStart > maxCustomNameLen) {
+		return token{}, false
+	}
+	idStart := nameEnd + 1
+	idEnd := idStart
+	for idEnd < len(content) && content[idEnd] >= '0' && content[idEnd] <= '9' {
+		idEnd++
+	}
+	if idEnd >= len(content) || content[idEnd] != '>' || !p.validSnowflake(content[idStart:idEnd]) {
+		return token{}, false
+	}
+	return token{
+		kind:     EmojiTypeCustom,
+		from:     from,
+		to:       idEnd + 1,
+		name:     content[nameStart:nameEnd],
+		id:       content[idStart:idEnd],
+		animated: animated,
+	}, true
+}
+
+// fullwidthColon is U+FF1A, which CJK input methods emit for ':'.
+const fullwidthColon = "\uFF1A"
+
+// matchShortcode matches :name: at content[from:], where the name is made of
+// ASCII letters, digits, and underscores, and returns the name and the end
+// of the match. With fullwidth, either colon may also be U+FF1A.
+func matchShortcode(content string, from int, fullwidth bool) (string, int, bool) {
+	start := colonEnd(content, from, fullwidth)
+	if start < 0 {
+		return "", 0, false
+	}
+	end := start
+	for end < len(content) && isWordByte(content[end]) {
+		end++
+	}
+	if end == start {
+		return "", 0, false
+	}
+	to := colonEnd(content, end, fullwidth)
+	if to < 0 {
+		return "", 0, false
+	}
+	return content[start:end], to, true
+}
+
+// closingColon returns the start of the colon ending at content[:to].
+func closingColon(content string, to int) int {
+	if content[to-1] == ':' {
+		return to - 1
+	}
+	return to - len(fullwidthColon)
+}
+
+// colonEnd returns the end of the colon at content[i:], or -1 if there is
+// none.
+func colonEnd(content string, i int, fullwidth bool) int {
+	switch {
+	case i < len(content) && content[i] == ':':
+		return i + 1
+	case fullwidth && strings.HasPrefix(content[i:], fullwidthColon):
+		return i + len(fullwidthColon)
+	}
+	return -1
+}
+
+// collect scans content for the given kinds and builds the results.
+func (p *DiscordEmojiParser) collect(state *parserState, content string, kinds scanKinds, skipRanges []ParsedEmoji) []ParsedEmoji {
+	return p.appendTokens(make([]ParsedEmoji, 0), state, content, kinds, skipRanges)
+}
+
+// appendTokens is collect appending the results to dst.
+func (p *DiscordEmojiParser) appendTokens(dst []ParsedEmoji, state *parserState, content string, kinds scanKinds, skipRanges []ParsedEmoji) []ParsedEmoji {
+	var counts tokenCounts
+	p.scan(state, content, kinds, skipRanges, func(t token) bool {
+		counts.add(t.kind)
+		dst = append(dst, p.emojiFor(state, content, t))
+		return true
+	})
+	p.countTokens(counts)
+	return dst
+}
+
+// tokenCounts tallies tokens by type for the metrics.
+type tokenCounts struct {
+	custom, unicode, text int
+}
+
+func (c *tokenCounts) add(kind EmojiType) {
+	switch kind {
+	case EmojiTypeCustom:
+		c.custom++
+	case EmojiTypeUnicode:
+		c.unicode++
+	default:
+		c.text++
+	}
+}
+
+// countTokens adds counts to the metrics if they are enabled.
+func (p *DiscordEmojiParser) countTokens(counts tokenCounts) {
+	p.countMatches(EmojiTypeCustom, counts.custom)
+	p.countMatches(EmojiTypeUnicode, counts.unicode)
+	p.countMatches(EmojiTypeText, counts.text)
+}
+
+// emojiFor builds the result for a token found in content.
+func (p *DiscordEmojiParser) emojiFor(state *parserState, content string, t token) ParsedEmoji {
+	position := EmojiPosition{From: t.from, To: t.to}
+	switch t.kind {
+	case EmojiTypeCustom:
+		emoji := p.newCustomEmoji(t.name, t.id, t.animated)
+		emoji.Unicode = content[t.from:t.to]
+		emoji.Position = position
+		return emoji
+	case EmojiTypeText:
+		return ParsedEmoji{
+			ID:       nil,
+			Name:     t.name,
+			Type:     EmojiTypeText,
+			Unicode:  t.unicode,
+			Position: position,
+			Link:     p.svgLink(state, t.unicode, t.unicode, t.name),
+			Animated: false,
+			Tone:     toneOf(t.unicode),
+
+			Qualification: qualificationOf(t.unicode),
+		}
+	}
+
+	name := state.unicodeToName[t.key]
+	if name == "" {
+		name = generatedName(t.key)
+	}
+	return ParsedEmoji{
+		ID:       nil,
+		Name:     name,
+		Type:     EmojiTypeUnicode,
+		Unicode:  t.unicode,
+		Position: position,
+		Link:     p.svgLink(state, t.unicode, t.key, name),
+		Animated: false,
+
+		Presentation:  t.presentation,
+		Tone:          toneOf(t.unicode),
+		Qualification: qualificationOf(t.unicode),
+	}
+}
@@ -0,0 +1,195 @@
+package emojiparser
+
+import (
+	"math/rand"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+var (
+	legacyCustomRegex = regexp.MustCompile(`<(a?):(\w+):(\d{17,20})>`)
+	legacyTextRegex   = regexp.MustCompile(`:([A-Za-z0-9_]+):`)
+)
+
+// The legacy passes are the three separate scans scan replaced; they are
+// kept here as the reference implementation for the differential tests.
+
+func legacyCustom(content string) []token {
+	var tokens []token
+	for _, m := range legacyCustomRegex.FindAllStringSubmatchIndex(content, -1) {
+		if _, err := strconv.ParseUint(content[m[6]:m[7]], 10, 64); err != nil {
+			continue
+		}
+		tokens = append(tokens, token{
+			kind: EmojiTypeCustom, from: m[0], to: m[1],
+			animated: m[3] > m[2], name: content[m[4]:m[5]], id: content[m[6]:m[7]],
+		})
+	}
+	return tokens
+}
+
+func legacyInside(index int, skip []token) bool {
+	for _, t := range skip {
+		if index >= t.from && index < t.to {
+			return true
+		}
+	}
+	return false
+}
+
+func legacyUnicode(p *DiscordEmojiParser, state *parserState, content string, skip []token) []token {
+	var tokens []token
+	for i := 0; i < len(content); {
+		if legacyInside(i, skip) {
+			_, size := utf8.DecodeRuneInString(content[i:])
+			i += size
+			continue
+		}
+		// Emojis are matched the same way as scan does; the passes differ
+		// in how they skip, merge, and order.
+		n, key := state.matchSequence(content[i:])
+		if n == 0 {
+			_, size := utf8.DecodeRuneInString(content[i:])
+			i += size
+			continue
+		}
+		to := i + n
+		match := content[i:to]
+		presentation := presentationOf(match, content[to:])
+		if !p.leavesOut(state, match, key, presentation) {
+			tokens = append(tokens, token{kind: EmojiTypeUnicode, from: i, to: to, unicode: match, key: key, presentation: presentation})
+		}
+		i = to
+	}
+	return tokens
+}
+
+func legacyText(p *DiscordEmojiParser, state *parserState, content string, skip []token) []token {
+	var tokens []token
+	end := 0
+	// Each search starts again at the closing colon of the previous match,
+	// which a following shortcode may share.
+	for offset := 0; offset < len(content); {
+		m := legacyTextRegex.FindStringSubmatchIndex(content[offset:])
+		if m == nil {
+			break
+		}
+		for i := range m {
+			m[i] += offset
+		}
+		offset = m[1] - 1
+		from := max(m[0], end)
+		if legacyInside(m[0], skip) {
+			continue
+		}
+		name := content[m[2]:m[3]]
+		if unicode, ok := p.lookupShortcode(state, name); ok {
+			to := m[1]
+			if tone, toneEnd, ok := toneShortcode(content, to); ok {
+				unicode, to = withSkinTone(unicode, tone), toneEnd
+				offset = to - 1
+			}
+			tokens = append(tokens, token{kind: EmojiTypeText, from: from, to: to, name: name, unicode: unicode})
+			end = to
+		}
+	}
+	return tokens
+}
+
+// legacyParse runs the three passes and merges them by position, custom
+// before text before unicode at the same offset.
+func legacyParse(p *DiscordEmojiParser, content string) []token {
+	state := p.state.Load()
+	custom := legacyCustom(content)
+	all := append(append(custom, legacyText(p, state, content, custom)...), legacyUnicode(p, state, content, custom)...)
+	sort.SliceStable(all, func(i, j int) bool { return all[i].from < all[j].from })
+	return all
+}
+
+func scanTokens(p *DiscordEmojiParser, content string, kinds scanKinds, skip []token) []token {
+	var skipRanges []ParsedEmoji
+	for _, t := range skip {
+		skipRanges = append(skipRanges, ParsedEmoji{Position: EmojiPosition{From: t.from, To: t.to}})
+	}
+	var tokens []token
+	p.scan(p.state.Load(), content, kinds, skipRanges, func(t token) bool {
+		tokens = append(tokens, t)
+		return true
+	})
+	return tokens
+}
+
+// checkScanMatchesLegacy compares scan with the legacy passes for all kinds
+// together and for each kind alone, with and without skip ranges.
+func checkScanMatchesLegacy(t *testing.T, p *DiscordEmojiParser, content string) {
+	t.Helper()
+	state := p.state.Load()
+	custom := legacyCustom(content)
+	checks := []struct {
+		name      string
+		got, want []token
+	}{
+		{"all", scanTokens(p, content, scanAll, nil), legacyParse(p, content)},
+		{"custom", scanTokens(p, content, scanCustom, nil), custom},
+		{"unicode", scanTokens(p, content, scanUnicode, nil), legacyUnicode(p, state, content, nil)},
+		{"unicode skipping custom", scanTokens(p, content, scanUnicode, custom), legacyUnicode(p, state, content, custom)},
+		{"text", scanTokens(p, content, scanText, nil), legacyText(p, state, content, nil)},
+		{"text skipping custom", scanTokens(p, content, scanText, custom), legacyText(p, state, content, custom)},
+	}
+	for _, check := range checks {
+		if !reflect.DeepEqual(check.got, check.want) {
+			t.Fatalf("%s: scan of %q differs from the legacy passes:\n got %+v\nwant %+v", check.name, content, check.got, check.want)
+		}
+	}
+}
+
+var scanFragments = []string{
+	"hello", " ", "\n", ":", "::", ":smile:", ":tada:", ":not_real:", ":flag_us:", ":a:",
+	"😄", "🎉", "👨‍👩‍👧‍👦", "🇺🇸", "🇺", "1️⃣", "1", "#", "#️⃣", "❤️", "❤\ufe0e", "⚧", "©", "©️", "👍🏽",
+	"<:pepe:12345678901234567>", "<a:wave:12345678901234567>", "<:broken:12>", "<:smile:12345678901234567>",
+	"<", ">", "<a:", "a:b:smile:", ":skin-tone-3:", ":thumbsup_tone2:", "piñata", "_", "é", "\xff", "\u200b",
+}
+
+func TestScanMatchesLegacy(t *testing.T) {
+	plain, err := NewDiscordEmojiParser()
+	if err != nil {
+		t.Fatalf("NewDiscordEmojiParser: %v", err)
+	}
+	filtered, err := NewDiscordEmojiParser(WithSkipTextPresentation(true), WithExcludeTextSymbols(true))
+	if err != nil {
+		t.Fatalf("NewDiscordEmojiParser: %v", err)
+	}
+
+	rng := rand.New(rand.NewSource(5))
+	for range 300 {
+		var b strings.Builder
+		for range rng.Intn(40) {
+			b.WriteString(scanFragments[rng.Intn(len(scanFragments))])
+		}
+		checkScanMatchesLegacy(t, plain, b.String())
+		checkScanMatchesLegacy(t, filtered, b.String())
+	}
+}
+
+func FuzzScanMatchesLegacy(f *testing.F) {
+	for _, fragment := range scanFragments {
+		f.Add(fragment)
+	}
+	f.Add("<:a:12345678901234567>:smile:")
+	f.Add(":abc:smile:")
+	f.Add("<a:x:12345678901234567890:>")
+	f.Add("::smile::tada::")
+
+	p, err := NewDiscordEmojiParser()
+	if err != nil {
+		f.Fatalf("NewDiscordEmojiParser: %v", err)
+	}
+	f.Fuzz(func(t *testing.T, content string) {
+		checkScanMatchesLegacy(t, p, content)
+	})
+}
@@ -0,0 +1,183 @@
+package emojiparser
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// ContainsEmojiNamed reports whether content contains the named emoji using the default parser.
+func ContainsEmojiNamed(content, name string) bool {
+	return defaultParser.ContainsEmojiNamed(content, name)
+}
+
+// FindEmojiNamed finds every occurrence of the named emoji using the default parser.
+func FindEmojiNamed(content, name string) []ParsedEmoji {
+	return defaultParser.FindEmojiNamed(content, name)
+}
+
+// emojiRepresentations lists every way an emoji can be written.
+type emojiRepresentations struct {
+	sequences  []string // unicode sequences
+	shortcodes []string // names, without colons
+	customIDs  []string // registered custom emoji IDs
+}
+
+// representations resolves name (with or without colons) to the unicode
+// sequence it maps to, every shortcode alias of that sequence, and the IDs of
+// custom emojis registered under name.
+func (s *parserState) representations(name string) emojiRepresentations {
+	name = strings.Trim(name, ":")
+	var reps emojiRepresentations
+	if sequence, ok := s.lookupShortcode(name); ok {
+		reps.sequences = []string{sequence}
+		reps.shortcodes = s.namesFor(sequence)
+		if !slices.Contains(reps.shortcodes, name) {
+			reps.shortcodes = append(slices.Clip(reps.shortcodes), name)
+		}
+	}
+	reps.customIDs = s.customEmojis[name]
+	return reps
+}
+
+// ContainsEmojiNamed reports whether content contains the emoji called name
+// in any representation: its unicode sequence, any of its shortcodes, or a
+// custom emoji registered under name. It stops at the first occurrence.
+func (p *DiscordEmojiParser) ContainsEmojiNamed(content, name string) bool {
+	found := false
+	p.findNamed(content, name, func(ParsedEmoji) bool {
+		found = true
+		return false
+	})
+	return found
+}
+
+// FindEmojiNamed returns every occurrence of the emoji called name, in any
+// of the representations ContainsEmojiNamed accepts, in position order. The
+// results are the ones Parse would report at those positions.
+func (p *DiscordEmojiParser) FindEmojiNamed(content, name string) []ParsedEmoji {
+	results := make([]ParsedEmoji, 0)
+	p.findNamed(content, name, func(emoji ParsedEmoji) bool {
+		results = append(results, emoji)
+		return true
+	})
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Position.From < results[j].Position.From
+	})
+	return results
+}
+
+// findNamed calls yield for each occurrence of the named emoji until yield
+// returns false. Occurrences are located with substring search and confirmed
+// with the targeted matchers below instead of parsing all of content.
+func (p *DiscordEmojiParser) findNamed(content, name string, yield func(ParsedEmoji) bool) {
+	state := p.state.Load()
+	reps := state.representations(name)
+
+	for _, sequence := range reps.sequences {
+		if !occurrences(content, sequence, func(from int) bool {
+			emoji, ok := p.unicodeMatchAt(state, content, from, from+len(sequence))
+			return !ok || yield(emoji)
+		}) {
+			return
+		}
+	}
+	for _, shortcode := range reps.shortcodes {
+		if !occurrences(content, ":"+shortcode+":", func(from int) bool {
+			emoji, ok := p.textMatchAt(state, content, from, from+len(shortcode)+2)
+			return !ok || yield(emoji)
+		}) {
+			return
+		}
+	}
+	for _, id := range reps.customIDs {
+		if !occurrences(content, ":"+id+">", func(at int) bool {
+			emoji, ok := p.customMatchEndingAt(content, at+len(id)+2)
+			return !ok || *emoji.ID != id || yield(emoji)
+		}) {
+			return
+		}
+	}
+}
+
+// occurrences calls yield with the start of every, possibly overlapping,
+// occurrence of substr in content, and reports false if yield returned false.
+func occurrences(content, substr string, yield func(int) bool) bool {
+	for offset := 0; offset <= len(content)-len(substr); {
+		i := strings.Index(content[offset:], substr)
+		if i < 0 {
+			break
+		}
+		if !yield(offset + i) {
+			return false
+		}
+		offset += i + 1
+	}
+	return true
+}
+
+// unicodeMatchAt reports whether Parse would report a unicode emoji spanning
+// exactly [from, to), by scanning only a window around it. The window starts
+// far enough back that the scan is aligned as it would be over all content.
+func (p *DiscordEmojiParser) unicodeMatchAt(state *parserState, content string, from, to int) (ParsedEmoji, bool) {
+	start := max(0, from-2*state.maxKeyLen)
+	for start > 0 && !utf8.RuneStart(content[start]) {
+		start--
+	}
+	end := min(len(content), to+state.maxKeyLen)
+	window := content[start:end]
+
+	for _, emoji := range p.parseUnicode(state, window, p.ParseDiscordCustom(window)) {
+		if emoji.Position.From+start == from && emoji.Position.To+start == to {
+			emoji.Position = EmojiPosition{From: from, To: to}
+			return emoji, true
+		}
+	}
+	return ParsedEmoji{}, false
+}
+
+// textMatchAt reports whether Parse would report a text emoji spanning
+// exactly [from, to). Shortcode matches never extend past a run of name
+// characters and colons, so only that run and a custom emoji enclosing it
+// need to be examined.
+func (p *DiscordEmojiParser) textMatchAt(state *parserState, content string, from, to int) (ParsedEmoji, bool) {
+	start, end := from, to
+	for start > 0 && (isWordByte(content[start-1]) || content[start-1] == ':') {
+		start--
+	}
+	for end < len(content) && (isWordByte(content[end]) || content[end] == ':') {
+		end++
+	}
+
+	if start > 0 && content[start-1] == '<' && end < len(content) && content[end] == '>' {
+		if custom := p.ParseDiscordCustom(content[start-1 : end+1]); len(custom) == 1 {
+			return ParsedEmoji{}, false
+		}
+	}
+
+	for _, emoji := range p.parseTextRepresentation(state, content[start:end], nil) {
+		if emoji.Position.From+start == from && emoji.Position.To+start == to {
+			emoji.Position = EmojiPosition{From: from, To: to}
+			return emoji, true
+		}
+	}
+	return ParsedEmoji{}, false
+}
+
+// customMatchEndingAt reports whether Parse would report a custom emoji whose
+// markup ends at end. Custom markup contains a single '<', so the candidate
+// starts at the last one before end.
+func (p *DiscordEmojiParser) customMatchEndingAt(content string, end int) (ParsedEmoji, bool) {
+	start := strings.LastIndexByte(content[:end], '<')
+	if start < 0 {
+		return ParsedEmoji{}, false
+	}
+	results := p.ParseDiscordCustom(content[start:end])
+	if len(results) != 1 || results[0].Position.From != 0 {
+		return ParsedEmoji{}, false
+	}
+	emoji := results[0]
+	emoji.Position = EmojiPosition{From: start, To: end}
+	return emoji, true
+}
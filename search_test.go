@@ -0,0 +1,95 @@
+package emojiparser_test
+
+import (
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestFindEmojiNamed(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser()
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+	if err := parser.RegisterCustomEmoji("tada", "12345678901234567"); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+
+	content := "🎉 then :tada: and <a:party:12345678901234567> but not <:tada:67890123456789012>"
+	results := parser.FindEmojiNamed(content, "tada")
+	if len(results) != 3 {
+		t.Fatalf("expected 3 occurrences, got %d: %+v", len(results), results)
+	}
+	want := []emojiparser.EmojiType{emojiparser.EmojiTypeUnicode, emojiparser.EmojiTypeText, emojiparser.EmojiTypeCustom}
+	parsed := parser.Parse(content)
+	for i, kind := range want {
+		if results[i].Type != kind {
+			t.Fatalf("result %d: expected %s, got %s", i, kind, results[i].Type)
+		}
+		if results[i].Position != parsed[i].Position {
+			t.Fatalf("result %d: position %v disagrees with Parse %v", i, results[i].Position, parsed[i].Position)
+		}
+	}
+}
+
+func TestContainsEmojiNamed(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser()
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+	if err := parser.RegisterCustomEmoji("partyblob", "12345678901234567"); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+
+	cases := []struct {
+		content string
+		name    string
+		want    bool
+	}{
+		{"yay 🎉", "tada", true},
+		{"yay :tada:", "tada", true},
+		{"yay :thumbsup:", "+1", true},
+		{"yay 👍", ":thumbup:", true},
+		{"<a:partyblob:12345678901234567>", "partyblob", true},
+		{"<:partyblob:67890123456789012>", "partyblob", false},
+		{"a:b:tada:", "tada", false},
+		{"<:tada:67890123456789012>", "tada", false},
+		{"👍🏽", "thumbsup", false},
+		{"nothing here", "tada", false},
+		{"🎉", "no_such_emoji", false},
+	}
+	for _, tc := range cases {
+		if got := parser.ContainsEmojiNamed(tc.content, tc.name); got != tc.want {
+			t.Fatalf("ContainsEmojiNamed(%q, %q) = %v, want %v", tc.content, tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestRegisterCustomEmojiValidation(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser()
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+	if err := parser.RegisterCustomEmoji("bad name", "12345678901234567"); err == nil {
+		t.Fatalf("expected error for invalid name")
+	}
+	if err := parser.RegisterCustomEmoji("ok", "12"); err == nil {
+		t.Fatalf("expected error for invalid id")
+	}
+}
+
+func TestRegisterCustomEmojiSurvivesMerge(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser()
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+	if err := parser.RegisterCustomEmoji("partyblob", "12345678901234567"); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	if _, err := parser.RegisterShortcode("octo", "🐙"); err != nil {
+		t.Fatalf("register shortcode: %v", err)
+	}
+	if !parser.ContainsEmojiNamed("<:x:12345678901234567>", "partyblob") {
+		t.Fatalf("expected registration to survive a merge")
+	}
+}
@@ -0,0 +1,70 @@
+package emojiparser
+
+// SegmentKind tells plain text and emoji segments apart.
+type SegmentKind uint8
+
+const (
+	// SegmentText is text between emojis.
+	SegmentText SegmentKind = iota
+	// SegmentEmoji is a single emoji of any type.
+	SegmentEmoji
+)
+
+// String returns "text" or "emoji".
+func (k SegmentKind) String() string {
+	if k == SegmentEmoji {
+		return "emoji"
+	}
+	return "text"
+}
+
+// Segment is a piece of content returned by Segments.
+type Segment struct {
+	Kind SegmentKind
+	// Raw is the segment's substring of content.
+	Raw      string
+	Position EmojiPosition
+	// Emoji is the parsed emoji of an emoji segment, and nil for text.
+	Emoji *ParsedEmoji
+}
+
+// Segments splits content into text and emoji segments using the default
+// parser.
+func Segments(content string) []Segment {
+	return defaultParser.Segments(content)
+}
+
+// Segments splits content into an ordered list of text and emoji segments.
+// The emoji segments are Parse's results, and the Raw strings of all segments
+// concatenate back to content. Text segments are never empty, so adjacent
+// emojis have no segment between them and empty content has no segments.
+func (p *DiscordEmojiParser) Segments(content string) []Segment {
+	emojis := p.Parse(content)
+	segments := make([]Segment, 0, 2*len(emojis)+1)
+	last := 0
+	for i := range emojis {
+		emoji := &emojis[i]
+		segments = appendTextSegment(segments, content, last, emoji.Position.From)
+		segments = append(segments, Segment{
+			Kind:     SegmentEmoji,
+			Raw:      content[emoji.Position.From:emoji.Position.To],
+			Position: emoji.Position,
+			Emoji:    emoji,
+		})
+		last = emoji.Position.To
+	}
+	return appendTextSegment(segments, content, last, len(content))
+}
+
+// appendTextSegment appends content[from:to] as a text segment unless it is
+// empty.
+func appendTextSegment(segments []Segment, content string, from, to int) []Segment {
+	if from == to {
+		return segments
+	}
+	return append(segments, Segment{
+		Kind:     SegmentText,
+		Raw:      content[from:to],
+		Position: EmojiPosition{From: from, To: to},
+	})
+}
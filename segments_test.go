@@ -0,0 +1,60 @@
+package emojiparser_test
+
+import (
+	"strings"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestSegments(t *testing.T) {
+	segments := emojiparser.Segments("hi 😄🔥<:pepe:12345678901234567>:tada: bye")
+	want := []struct {
+		kind emojiparser.SegmentKind
+		raw  string
+	}{
+		{emojiparser.SegmentText, "hi "},
+		{emojiparser.SegmentEmoji, "😄"},
+		{emojiparser.SegmentEmoji, "🔥"},
+		{emojiparser.SegmentEmoji, "<:pepe:12345678901234567>"},
+		{emojiparser.SegmentEmoji, ":tada:"},
+		{emojiparser.SegmentText, " bye"},
+	}
+	if len(segments) != len(want) {
+		t.Fatalf("Segments returned %d segments, want %d: %v", len(segments), len(want), segments)
+	}
+	for i, segment := range segments {
+		if segment.Kind != want[i].kind || segment.Raw != want[i].raw {
+			t.Fatalf("segment %d = %v %q, want %v %q", i, segment.Kind, segment.Raw, want[i].kind, want[i].raw)
+		}
+		if (segment.Emoji != nil) != (segment.Kind == emojiparser.SegmentEmoji) {
+			t.Fatalf("segment %d: Emoji = %v for kind %v", i, segment.Emoji, segment.Kind)
+		}
+	}
+	if segments[3].Emoji.Type != emojiparser.EmojiTypeCustom || segments[4].Emoji.Type != emojiparser.EmojiTypeText {
+		t.Fatalf("emoji types = %v, %v", segments[3].Emoji.Type, segments[4].Emoji.Type)
+	}
+}
+
+func TestSegmentsRoundTrip(t *testing.T) {
+	for _, content := range countInputs {
+		var b strings.Builder
+		for _, segment := range emojiparser.Segments(content) {
+			if segment.Raw == "" || segment.Raw != content[segment.Position.From:segment.Position.To] {
+				t.Fatalf("Segments(%q): segment %q at %v", content, segment.Raw, segment.Position)
+			}
+			b.WriteString(segment.Raw)
+		}
+		if b.String() != content {
+			t.Fatalf("Segments(%q) concatenate to %q", content, b.String())
+		}
+	}
+}
+
+func TestSegmentsSkipMarkup(t *testing.T) {
+	parser := newTestParser(t, emojiparser.WithMarkdown(true))
+	segments := parser.Segments("`😄` 🔥")
+	if len(segments) != 2 || segments[0].Raw != "`😄` " || segments[1].Raw != "🔥" {
+		t.Fatalf("Segments = %v", segments)
+	}
+}
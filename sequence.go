@@ -0,0 +1,81 @@
+package emojiparser
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// matchKey returns the longest unicode key content starts with and the
+// length of the match in content, or 0 and "". U+FE0F is optional on both
+// sides: a key matches whether or not content spells the selectors the key
+// has, and a selector in content the key lacks, such as a trailing one, is
+// part of the match. Keys that are a single ASCII character, like the bases
+// of keycaps, never match: a digit is not an emoji without U+20E3.
+func (s *parserState) matchKey(content string) (int, string) {
+	return s.keys.match(content)
+}
+
+// isASCIIKey reports whether key is one ASCII character, with or without
+// U+FE0F.
+func isASCIIKey(key string) bool {
+	return len(strings.TrimSuffix(key, variationSelector16)) == 1
+}
+
+// matchSequence matches a unicode emoji at the start of content and returns
+// its length and the key it is named after. Keys joined by U+200D form a
+// single emoji even when the whole sequence is not a key, such as a ZWJ
+// sequence newer than the tables; it is named after its first key. A skin
+// tone modifier after a modifier base is part of the emoji too.
+func (s *parserState) matchSequence(content string) (int, string) {
+	n, key := s.matchElement(content)
+	if n == 0 {
+		return 0, ""
+	}
+	for strings.HasPrefix(content[n:], zeroWidthJoinerString) {
+		next, _ := s.matchElement(content[n+len(zeroWidthJoinerString):])
+		if next == 0 {
+			break
+		}
+		n += len(zeroWidthJoinerString) + next
+	}
+	return n, key
+}
+
+// matchElement matches a key at the start of content, together with a skin
+// tone modifier the key takes but does not include. Flags are matched even
+// when the tables do not know them; such a flag is its own key.
+func (s *parserState) matchElement(content string) (int, string) {
+	n, key := s.matchKey(content)
+	if flag := flagSequence(content); flag > n {
+		return flag, content[:flag]
+	}
+	if n == 0 {
+		return 0, ""
+	}
+	if takesSkinTone(key) {
+		if r, size := utf8.DecodeRuneInString(content[n:]); size > 0 {
+			if _, ok := skinToneOf(r); ok {
+				n += size
+			}
+		}
+	}
+	return n, key
+}
+
+const zeroWidthJoinerString = string(zeroWidthJoiner)
+
+// svgHash returns the SVG hash to use for an emoji matched as key: its own,
+// or else that of the emoji without skin tones or of the key. The tables
+// leave U+FE0F out of some code point keys, so each candidate is also tried
+// without it.
+func (s *parserState) svgHash(emoji, key string) (string, bool) {
+	for _, candidate := range []string{emoji, withoutSkinTones(emoji), key} {
+		if hash, ok := s.svg[toCodePoint(candidate, "-")]; ok {
+			return hash, true
+		}
+		if hash, ok := s.svg[toCodePoint(strings.ReplaceAll(candidate, variationSelector16, ""), "-")]; ok {
+			return hash, true
+		}
+	}
+	return "", false
+}
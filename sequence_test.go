@@ -0,0 +1,115 @@
+package emojiparser_test
+
+import (
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+// wantEmoji describes the single unicode emoji a test input must parse to.
+type wantEmoji struct {
+	content string
+	name    string
+	unicode string
+	from    int
+	to      int
+}
+
+func checkSingleEmoji(t *testing.T, tests []wantEmoji) {
+	t.Helper()
+	for _, tc := range tests {
+		results := emojiparser.Parse(tc.content)
+		if len(results) != 1 {
+			t.Fatalf("Parse(%q) = %v, want one emoji", tc.content, results)
+		}
+		got := results[0]
+		if got.Type != emojiparser.EmojiTypeUnicode || got.Name != tc.name || got.Unicode != tc.unicode ||
+			got.Position != (emojiparser.EmojiPosition{From: tc.from, To: tc.to}) {
+			t.Fatalf("Parse(%q) = %+v, want %s %q at [%d:%d)", tc.content, got, tc.name, tc.unicode, tc.from, tc.to)
+		}
+	}
+}
+
+func TestZWJSequences(t *testing.T) {
+	checkSingleEmoji(t, []wantEmoji{
+		// Professions.
+		{"🧑‍💻", "technologist", "🧑‍💻", 0, 11},
+		{"👩‍🔬", "woman_scientist", "👩‍🔬", 0, 11},
+		{"👩🏽‍💻", "woman_technologist_medium_skin_tone", "👩🏽‍💻", 0, 15},
+		// Families.
+		{"👨‍👩‍👧‍👦", "family_mwgb", "👨‍👩‍👧‍👦", 0, 25},
+		{"x 👨‍👨‍👧 y", "family_mmg", "👨‍👨‍👧", 2, 20},
+		// Heart on fire and friends.
+		{"❤️‍🔥", "heart_on_fire", "❤️‍🔥", 0, 13},
+		{"🐻‍❄️", "polar_bear", "🐻‍❄️", 0, 13},
+		// Sequences the tables do not know are named after their base.
+		{"🐶‍🔥", "dog", "🐶‍🔥", 0, 11},
+		{"🧑‍🧑‍🧒", "adult", "🧑‍🧑‍🧒", 0, 18},
+	})
+}
+
+func TestZWJSequenceFallbackLink(t *testing.T) {
+	sequence := emojiparser.Parse("🐶‍🔥")[0]
+	base := emojiparser.Parse("🐶")[0]
+	if sequence.Link == nil || base.Link == nil || *sequence.Link != *base.Link {
+		t.Fatalf("unknown sequence link = %v, want the base link %v", sequence.Link, base.Link)
+	}
+}
+
+func TestZWJWithoutFollowingEmoji(t *testing.T) {
+	for _, content := range []string{"🧑‍💻‍", "🧑‍💻‍x", "🧑‍💻‍‍🔥"} {
+		results := emojiparser.Parse(content)
+		if len(results) == 0 || results[0].Unicode != "🧑‍💻" || results[0].Position.To != 11 {
+			t.Fatalf("Parse(%q) = %+v, want the technologist without the trailing joiner", content, results)
+		}
+	}
+}
+
+func TestVariationSelectorSpellings(t *testing.T) {
+	checkSingleEmoji(t, []wantEmoji{
+		{"❤️", "heart", "❤️", 0, 6},
+		{"a ☂️ b", "umbrella2", "☂️", 2, 8},
+		{"✈️", "airplane", "✈️", 0, 6},
+		// A selector the key lacks is part of the emoji.
+		{"😄️", "smile", "😄️", 0, 7},
+		// Selectors are optional inside sequences too.
+		{"❤‍🔥", "heart_on_fire", "❤‍🔥", 0, 10},
+	})
+
+	// Text-default symbols only match without U+FE0F when asked to.
+	parser := newTestParser(t, emojiparser.WithMatchBareTextSymbols(true))
+	for _, pair := range [][2]string{{"❤", "❤️"}, {"☂", "☂️"}, {"✈", "✈️"}} {
+		if results := emojiparser.Parse(pair[0]); len(results) != 0 {
+			t.Fatalf("Parse(%q) = %v, want none", pair[0], results)
+		}
+		results := parser.Parse(pair[0])
+		if len(results) != 1 || results[0].Unicode != pair[0] || results[0].Position.To != len(pair[0]) {
+			t.Fatalf("Parse(%q) with MatchBareTextSymbols = %+v, want the bare symbol", pair[0], results)
+		}
+		bare, qualified := results[0], parser.Parse(pair[1])[0]
+		if bare.Link == nil || qualified.Link == nil || *bare.Link != *qualified.Link {
+			t.Fatalf("links of %q and %q = %v and %v, want the same asset", pair[0], pair[1], bare.Link, qualified.Link)
+		}
+	}
+}
+
+func TestKeycaps(t *testing.T) {
+	checkSingleEmoji(t, []wantEmoji{
+		{"1️⃣", "one", "1️⃣", 0, 7},
+		{"1⃣", "one", "1⃣", 0, 4},
+		{"press 0️⃣ now", "zero", "0️⃣", 6, 13},
+		{"#️⃣", "hash", "#️⃣", 0, 7},
+		{"#⃣", "hash", "#⃣", 0, 4},
+		{"*⃣", "keycap_asterisk", "*⃣", 0, 4},
+		{"🔟", "keycap_ten", "🔟", 0, 4},
+	})
+
+	// Digits and # on their own are text.
+	if results := emojiparser.Parse("123 #1 *"); len(results) != 0 {
+		t.Fatalf("Parse of plain digits = %v, want none", results)
+	}
+	results := emojiparser.Parse("room 12⃣3")
+	if len(results) != 1 || results[0].Name != "two" || results[0].Position != (emojiparser.EmojiPosition{From: 6, To: 10}) {
+		t.Fatalf("Parse(room 12⃣3) = %v, want two at [6:10)", results)
+	}
+}
@@ -0,0 +1,25 @@
+package emojiparser
+
+// PreferredShortcode returns the preferred shortcode of a unicode emoji using the default parser.
+func PreferredShortcode(unicode string) (string, bool) {
+	return defaultParser.PreferredShortcode(unicode)
+}
+
+// PreferredShortcode returns the name to write unicode as in :name: form.
+// When several shortcodes map to the emoji, the shortest is preferred, then
+// the lexicographically smallest, so 🙂 is "slight_smile" rather than
+// "slightly_smiling_face". This is the name Demojize and QuoteEmojis write;
+// unicode results from Parse and the entries of AllEmojis keep Discord's
+// primary name, which can be a longer alias. It reports false for unknown
+// emojis and for emojis without a name that can be written between colons.
+func (p *DiscordEmojiParser) PreferredShortcode(unicode string) (string, bool) {
+	state := p.state.Load()
+	if _, ok := state.unicodeToName[unicode]; !ok || !isEmojiKey(unicode) {
+		return "", false
+	}
+	name := state.preferredName(unicode)
+	if !isShortcodeName(name) {
+		return "", false
+	}
+	return name, true
+}
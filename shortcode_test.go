@@ -0,0 +1,54 @@
+package emojiparser_test
+
+import (
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestPreferredShortcode(t *testing.T) {
+	cases := map[string]string{
+		"🙂":  "slight_smile",
+		"🤣":  "rofl",
+		"👍":  "thumbup",
+		"💯":  "100",
+		"😄":  "smile",
+		"🇺🇸": "flag_us",
+	}
+	for emoji, want := range cases {
+		got, ok := emojiparser.PreferredShortcode(emoji)
+		if !ok || got != want {
+			t.Fatalf("PreferredShortcode(%q) = %q, %v, want %q", emoji, got, ok, want)
+		}
+	}
+
+	// Parse keeps Discord's primary name, which need not be the shortest.
+	for emoji, want := range map[string]string{"🙂": "slightly_smiling_face", "🤣": "rolling_on_the_floor_laughing", "😄": "smile"} {
+		results := emojiparser.Parse(emoji)
+		if len(results) != 1 || results[0].Name != want {
+			t.Fatalf("Parse(%q) = %v, want the name %q", emoji, results, want)
+		}
+	}
+
+	for _, input := range []string{"", "smile", "piñata", "x😄"} {
+		if got, ok := emojiparser.PreferredShortcode(input); ok {
+			t.Fatalf("PreferredShortcode(%q) = %q, want no shortcode", input, got)
+		}
+	}
+}
+
+func TestPreferredShortcodeAfterRegister(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := parser.RegisterShortcode("yep", "👍"); err != nil {
+		t.Fatalf("register shortcode: %v", err)
+	}
+	if got, _ := parser.PreferredShortcode("👍"); got != "yep" {
+		t.Fatalf("PreferredShortcode after registering a shorter alias = %q, want yep", got)
+	}
+	if got := parser.Parse("👍")[0].Name; got != "thumbup" {
+		t.Fatalf("Parse name = %q, want thumbup", got)
+	}
+}
@@ -0,0 +1,291 @@
+package emojiparser
+
+import "strings"
+
+// markupKinds selects the markup markupRanges finds.
+type markupKinds uint8
+
+const (
+	markupCodeSpans markupKinds = 1 << iota
+	markupCodeBlocks
+	markupURLs
+	markupSpoilers
+	markupQuotes
+	markupLinks
+
+	markupAll = markupCodeSpans | markupCodeBlocks | markupURLs | markupSpoilers | markupQuotes | markupLinks
+)
+
+// markupKinds returns the markup the options say to leave out.
+func (o Options) markupKinds() markupKinds {
+	if o.Markdown {
+		return markupAll
+	}
+	var kinds markupKinds
+	if o.SkipCodeSpans {
+		kinds |= markupCodeSpans
+	}
+	if o.SkipCodeBlocks {
+		kinds |= markupCodeBlocks
+	}
+	if o.SkipURLs {
+		kinds |= markupURLs
+	}
+	if o.SkipSpoilers {
+		kinds |= markupSpoilers
+	}
+	return kinds
+}
+
+// markupSkipRanges returns the spans of content in which Discord does not
+// render emojis and the options say to leave out, or nil if none are set.
+func (p *DiscordEmojiParser) markupSkipRanges(content string) []EmojiPosition {
+	kinds := p.opts.markupKinds()
+	if kinds == 0 {
+		return nil
+	}
+	return markupRanges(content, kinds)
+}
+
+// MarkdownSkipRanges returns the spans of content that WithMarkdown leaves
+// out, sorted and non-overlapping: code, spoilers, URLs, block quote
+// markers, and the destinations of masked links.
+func MarkdownSkipRanges(content string) []EmojiPosition {
+	return markupRanges(content, markupAll)
+}
+
+// codeFence opens and closes a code block, and spoilerBars a spoiler.
+const (
+	codeFence   = "```"
+	spoilerBars = "||"
+)
+
+// markupRanges returns the spans of the given kinds of markup in content, in
+// a single left-to-right pass: markup starting inside an earlier span is
+// part of that span, so a backtick in a URL does not open inline code.
+//
+// A code block opens with a fence anywhere in a line and closes with the
+// next fence, or runs to the end of content without one. An inline code span
+// opens with a run of backticks and closes with the next run of the same
+// length; a run without one is literal text. Code is stepped over even when
+// it is not wanted, since nothing inside it is markup. A spoiler runs from ||
+// to the next || outside code. URLs are described at urlEnd, quotes at
+// quoteEnd, and links at linkDestination.
+func markupRanges(content string, kinds markupKinds) []EmojiPosition {
+	ranges, _ := markupScan(content, kinds)
+	return ranges
+}
+
+// markupScan is markupRanges, also returning the offset of the first markup
+// that text appended to content could still change, such as a code span or
+// spoiler without its closing delimiter, or len(content) if there is none.
+// Text cut at that offset, after whitespace, has the same markup on its own
+// as in the longer content.
+func markupScan(content string, kinds markupKinds) ([]EmojiPosition, int) {
+	var ranges []EmojiPosition
+	pending := len(content)
+	// destination is the (url) of a masked link whose text is being
+	// scanned; it is skipped once reached.
+	var destination EmojiPosition
+	for i := 0; i < len(content); {
+		if destination.To > 0 && i >= destination.From {
+			if i == destination.From {
+				ranges = append(ranges, destination)
+				i = destination.To
+			}
+			destination = EmojiPosition{}
+			continue
+		}
+
+		end := 0
+		switch c := content[i]; {
+		case c == '`':
+			var block bool
+			end, block = codeEnd(content, i, kinds&markupCodeBlocks != 0)
+			if end == 0 {
+				// The whole run is literal, unless a closing run follows.
+				pending = min(pending, i)
+				i += backtickRun(content, i)
+				continue
+			}
+			if block && !strings.Contains(content[i+len(codeFence):end], codeFence) {
+				pending = min(pending, i)
+			}
+			if block && kinds&markupCodeBlocks == 0 || !block && kinds&markupCodeSpans == 0 {
+				i = end
+				continue
+			}
+		case c == '|' && kinds&markupSpoilers != 0:
+			end = spoilerEnd(content, i, kinds&markupCodeBlocks != 0)
+			// Code that closes later may also swallow the closing bars.
+			if strings.HasPrefix(content[i:], spoilerBars) && (end == 0 || strings.IndexByte(content[i:end], '`') >= 0) {
+				pending = min(pending, i)
+			}
+		case c == '<' && kinds&markupURLs != 0:
+			end = suppressedURLEnd(content, i)
+		case (c == 'h' || c == 'H') && kinds&markupURLs != 0:
+			end = urlEnd(content, i)
+		case c == '>' && kinds&markupQuotes != 0:
+			end = quoteEnd(content, i)
+		case c == '[' && kinds&markupLinks != 0 && destination.To == 0:
+			destination = linkDestination(content, i)
+			if destination.To == 0 && strings.IndexAny(content[i+1:], "]\n") < 0 {
+				pending = min(pending, i)
+			}
+		}
+		if end > i {
+			ranges = append(ranges, EmojiPosition{From: i, To: end})
+			i = end
+			continue
+		}
+		i++
+	}
+	return ranges, pending
+}
+
+// codeEnd returns the end of the code block, if blocks is set, or inline code
+// span at content[i:] and whether it is a block, or 0.
+func codeEnd(content string, i int, blocks bool) (int, bool) {
+	if blocks && strings.HasPrefix(content[i:], codeFence) {
+		if closing := strings.Index(content[i+len(codeFence):], codeFence); closing >= 0 {
+			return i + 2*len(codeFence) + closing, true
+		}
+		return len(content), true
+	}
+	n := backtickRun(content, i)
+	return max(closingBacktickRun(content, i+n, n), 0), false
+}
+
+// spoilerEnd returns the end of the spoiler at content[i:], or 0. Bars inside
+// code do not close it, and a spoiler is never empty.
+func spoilerEnd(content string, i int, blocks bool) int {
+	if !strings.HasPrefix(content[i:], spoilerBars) {
+		return 0
+	}
+	for j := i + len(spoilerBars); j < len(content); {
+		switch {
+		case content[j] == '`':
+			if end, _ := codeEnd(content, j, blocks); end > 0 {
+				j = end
+			} else {
+				j += backtickRun(content, j)
+			}
+		case j > i+len(spoilerBars) && strings.HasPrefix(content[j:], spoilerBars):
+			return j + len(spoilerBars)
+		default:
+			j++
+		}
+	}
+	return 0
+}
+
+// quoteEnd returns the end of the block quote marker at content[i:], "> " or
+// ">>> " at the start of a line, or 0.
+func quoteEnd(content string, i int) int {
+	if i > 0 && content[i-1] != '\n' {
+		return 0
+	}
+	for _, marker := range []string{">>> ", "> "} {
+		if strings.HasPrefix(content[i:], marker) {
+			return i + len(marker)
+		}
+	}
+	return 0
+}
+
+// linkDestination returns the destination of the masked link
+// [text](https://...) at content[i:], parentheses included, or the zero
+// position. The text is shown and may contain emojis; the destination is
+// not. Like Discord, only http and https destinations make a link.
+func linkDestination(content string, i int) EmojiPosition {
+	textEnd := strings.IndexAny(content[i+1:], "]\n")
+	if textEnd < 0 || content[i+1+textEnd] != ']' {
+		return EmojiPosition{}
+	}
+	from := i + 1 + textEnd + 1
+	if from >= len(content) || content[from] != '(' || urlEnd(content, from+1) == 0 {
+		return EmojiPosition{}
+	}
+	for to := from + 1; to < len(content); to++ {
+		switch {
+		case content[to] == ')':
+			return EmojiPosition{From: from, To: to + 1}
+		case isMarkdownSpace(content[to]):
+			return EmojiPosition{}
+		}
+	}
+	return EmojiPosition{}
+}
+
+// backtickRun returns the number of backticks at content[i:].
+func backtickRun(content string, i int) int {
+	n := 0
+	for i+n < len(content) && content[i+n] == '`' {
+		n++
+	}
+	return n
+}
+
+// closingBacktickRun returns the end of the first run of exactly n
+// backticks at or after from, with text between from and it, or -1.
+func closingBacktickRun(content string, from, n int) int {
+	for i := from; i < len(content); {
+		next := strings.IndexByte(content[i:], '`')
+		if next < 0 {
+			return -1
+		}
+		i += next
+		run := backtickRun(content, i)
+		if run == n && i > from {
+			return i + run
+		}
+		i += run
+	}
+	return -1
+}
+
+// urlEnd returns the end of the http or https URL at content[i:], or 0. Like
+// Discord, the URL runs to whitespace or '<', less any trailing punctuation
+// and markdown delimiters, so "see https://x.com/a." ends before the dot and
+// "||https://x.com||" before the spoiler bars.
+func urlEnd(content string, i int) int {
+	rest := content[i:]
+	scheme := 0
+	switch {
+	case len(rest) >= 8 && strings.EqualFold(rest[:8], "https://"):
+		scheme = 8
+	case len(rest) >= 7 && strings.EqualFold(rest[:7], "http://"):
+		scheme = 7
+	default:
+		return 0
+	}
+	end := i + scheme
+	for end < len(content) && !isMarkdownSpace(content[end]) && content[end] != '<' {
+		end++
+	}
+	end = i + scheme + len(strings.TrimRight(content[i+scheme:end], urlTrailing))
+	if end == i+scheme {
+		return 0
+	}
+	return end
+}
+
+// urlTrailing holds the characters a URL never ends with.
+const urlTrailing = ".,:;\"')]}*_~|>"
+
+// suppressedURLEnd returns the end of the <http://...> link at content[i:],
+// whose angle brackets stop Discord from embedding it, or 0.
+func suppressedURLEnd(content string, i int) int {
+	if urlEnd(content, i+1) == 0 {
+		return 0
+	}
+	for end := i + 1; end < len(content); end++ {
+		switch {
+		case content[end] == '>':
+			return end + 1
+		case isMarkdownSpace(content[end]):
+			return 0
+		}
+	}
+	return 0
+}
@@ -0,0 +1,192 @@
+package emojiparser_test
+
+import (
+	"slices"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+// parseNames returns the names of the emojis parser finds in content.
+func parseNames(parser *emojiparser.DiscordEmojiParser, content string) []string {
+	var names []string
+	for _, result := range parser.Parse(content) {
+		names = append(names, result.Name)
+	}
+	return names
+}
+
+func checkNames(t *testing.T, parser *emojiparser.DiscordEmojiParser, tests map[string][]string) {
+	t.Helper()
+	for content, want := range tests {
+		if got := parseNames(parser, content); !slices.Equal(got, want) {
+			t.Fatalf("Parse(%q) = %v, want %v", content, got, want)
+		}
+	}
+}
+
+func newTestParser(t *testing.T, opts ...emojiparser.Option) *emojiparser.DiscordEmojiParser {
+	t.Helper()
+	parser, err := emojiparser.NewDiscordEmojiParser(opts...)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return parser
+}
+
+func TestSkipCodeSpans(t *testing.T) {
+	parser := newTestParser(t, emojiparser.WithSkipCodeSpans(true))
+	checkNames(t, parser, map[string][]string{
+		"use `:smile:` to 😄":                         {"smile"},
+		"`😄` and `<:pepe:12345678901234567>` :tada:": {"tada"},
+		"``a ` :smile: `` :tada:":                    {"tada"},
+		"`` :smile: ` :tada:":                        {"smile", "tada"},
+		"a ` :smile: b":                              {"smile"},
+		"`a` :smile: `b`":                            {"smile"},
+		"``` :smile: ``":                             {"smile"},
+		"``:smile:``` :tada: ``":                     nil,
+	})
+
+	if got := parseNames(newTestParser(t), "use `:smile:`"); len(got) != 1 {
+		t.Fatalf("Parse without the option = %v, want the shortcode", got)
+	}
+}
+
+func TestSkipCodeSpansWithSkipRanges(t *testing.T) {
+	parser := newTestParser(t, emojiparser.WithSkipCodeSpans(true))
+	content := "<:smile:12345678901234567> `:tada:` :tada: 😄 `🎉`"
+	custom := parser.ParseDiscordCustom(content)
+	if len(custom) != 1 {
+		t.Fatalf("ParseDiscordCustom = %v", custom)
+	}
+	if text := parser.ParseTextRepresentation(content, custom); len(text) != 1 || text[0].Name != "tada" {
+		t.Fatalf("ParseTextRepresentation = %v, want the :tada: outside code", text)
+	}
+	if unicode := parser.ParseUnicode(content, custom); len(unicode) != 1 || unicode[0].Name != "smile" {
+		t.Fatalf("ParseUnicode = %v, want the 😄 outside code", unicode)
+	}
+}
+
+func TestSkipCodeBlocks(t *testing.T) {
+	parser := newTestParser(t, emojiparser.WithSkipCodeBlocks(true))
+	checkNames(t, parser, map[string][]string{
+		"```yaml\nstatus: :heavy_check_mark:\n```\n:tada:":       {"tada"},
+		"config: ```\n:smile: 😄\n``` done 🎉":                     {"tada"},
+		"```go\nx := \"`:smile:`\" // ``:tada:``\n``` :smile:":   {"smile"},
+		"```\nlog: :x: failed\n:tada:":                           nil,
+		"before :smile: ```\nunclosed <:pepe:12345678901234567>": {"smile"},
+		"`:smile:` ```:tada:```":                                 {"smile"},
+		"``````:smile:":                                          {"smile"},
+	})
+
+	// Inline code is only skipped with its own option.
+	both := newTestParser(t, emojiparser.WithSkipCodeBlocks(true), emojiparser.WithSkipCodeSpans(true))
+	checkNames(t, both, map[string][]string{
+		"`:smile:` ```\n:tada:\n``` :joy:": {"joy"},
+		"`a ``` :smile:` :tada:":           {"tada"},
+	})
+}
+
+func TestSkipURLs(t *testing.T) {
+	parser := newTestParser(t, emojiparser.WithSkipURLs(true))
+	checkNames(t, parser, map[string][]string{
+		"https://example.com/:smile:/edit :tada:":  {"tada"},
+		"https://example.com/🍕 🍕":                  {"pizza"},
+		"<https://example.com/:smile:> :tada:":     {"tada"},
+		"HTTP://EXAMPLE.COM/😄":                     nil,
+		"see https://x.com/:smile::tada:":          nil,
+		"https://x.com/a.:smile:":                  nil,
+		"https://x.com/a :smile:":                  {"smile"},
+		"https://x.com/a<:pepe:12345678901234567>": {"pepe"},
+		"||https://x.com/a|| :smile:":              {"smile"},
+		"(https://x.com/😄) 🎉":                      {"tada"},
+		"ftp://x.com/:smile: https:// :tada:":      {"smile", "tada"},
+		"<https://x.com/:smile: > :tada:":          {"tada"},
+		"https://x.com/`a :smile: `b`":             {"smile"},
+	})
+}
+
+func TestSkipURLsPositions(t *testing.T) {
+	parser := newTestParser(t, emojiparser.WithSkipURLs(true), emojiparser.WithSkipCodeSpans(true))
+	content := "https://example.com/😄/:smile: `:joy:` then 😄 :tada:"
+	results := parser.Parse(content)
+	if len(results) != 2 {
+		t.Fatalf("Parse = %v, want two emojis", results)
+	}
+	for _, result := range results {
+		if content[result.Position.From:result.Position.To] != map[string]string{"smile": "😄", "tada": ":tada:"}[result.Name] {
+			t.Fatalf("result %v does not cover its emoji in %q", result, content)
+		}
+	}
+}
+
+func TestSkipSpoilers(t *testing.T) {
+	parser := newTestParser(t, emojiparser.WithSkipSpoilers(true))
+	checkNames(t, parser, map[string][]string{
+		"||like this 😈|| 😄":                                       {"smile"},
+		"||<:pepe:12345678901234567>|| <:wave:12345678901234567>": {"wave"},
+		"|| :smile: unbalanced":                                   {"smile"},
+		":smi||le: ||:tada:||":                                    {"tada"},
+		"||||:smile:":                                             {"smile"},
+		"|||| :smile: ||":                                         nil,
+		"||a|| :smile: ||b||":                                     {"smile"},
+	})
+	if got := parseNames(newTestParser(t), "||😄||"); len(got) != 1 {
+		t.Fatalf("Parse without the option = %v, want the emoji", got)
+	}
+}
+
+func TestSkipSpoilersWithCode(t *testing.T) {
+	spoilers := newTestParser(t, emojiparser.WithSkipSpoilers(true))
+	checkNames(t, spoilers, map[string][]string{
+		// Bars inside code are literal, so the spoiler is the outer pair.
+		"||a `||` :smile:|| :tada:": {"tada"},
+		// A code span is not a spoiler, and an unpaired bar is literal.
+		"`||` :smile: ||":     {"smile"},
+		"`:joy:` ||:smile:||": {"joy"},
+	})
+
+	both := newTestParser(t, emojiparser.WithSkipSpoilers(true), emojiparser.WithSkipCodeSpans(true))
+	checkNames(t, both, map[string][]string{
+		"`:joy:` ||:smile:|| :tada:": {"tada"},
+		"`||` :smile: ||":            {"smile"},
+		"||`:joy:`|| `||` :tada:":    {"tada"},
+	})
+}
+
+func TestMarkdown(t *testing.T) {
+	parser := newTestParser(t, emojiparser.WithMarkdown(true))
+	checkNames(t, parser, map[string][]string{
+		"> quoted :smile:\n>>> and 🎉":                              {"smile", "tada"},
+		"[click :smile:](https://x.com/:tada:) :joy:":              {"smile", "joy"},
+		"[not a link](ftp://x.com/:tada:)":                         {"tada"},
+		"`:joy:` ```\n:x:\n``` ||:tada:|| https://x.com/😄 :smile:": {"smile"},
+		"[`:joy:` :smile:](https://x.com/) <https://x.com/:tada:>": {"smile"},
+		"a > b :smile:": {"smile"},
+	})
+}
+
+func TestMarkdownSkipRanges(t *testing.T) {
+	content := "> hi `code` [a](https://x.com) ||s||"
+	want := []emojiparser.EmojiPosition{{From: 0, To: 2}, {From: 5, To: 11}, {From: 15, To: 30}, {From: 31, To: 36}}
+	if got := emojiparser.MarkdownSkipRanges(content); !slices.Equal(got, want) {
+		t.Fatalf("MarkdownSkipRanges = %v, want %v", got, want)
+	}
+	if got := emojiparser.MarkdownSkipRanges("plain :smile:"); len(got) != 0 {
+		t.Fatalf("MarkdownSkipRanges of plain text = %v", got)
+	}
+}
+
+func FuzzMarkdownSkipRanges(f *testing.F) {
+	for _, seed := range []string{"`a`", "```\nx", "||a||", "[a](https://b)", "> q", "<https://x>", "[", "](", "``", "|||"} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, content string) {
+		ranges := emojiparser.MarkdownSkipRanges(content)
+		for i, r := range ranges {
+			if r.From < 0 || r.From >= r.To || r.To > len(content) || i > 0 && r.From < ranges[i-1].To {
+				t.Fatalf("MarkdownSkipRanges(%q) = %v: range %d is empty, out of bounds, or out of order", content, ranges, i)
+			}
+		}
+	})
+}
@@ -0,0 +1,66 @@
+package emojiparser
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	// discordEpoch is the first millisecond of 2015, which Discord snowflake
+	// timestamps count from.
+	discordEpoch = 1420070400000
+
+	defaultMinSnowflakeDigits = 17
+	defaultMaxSnowflakeDigits = 20
+
+	// maxSnowflakeDigits is the length of the largest uint64.
+	maxSnowflakeDigits = 20
+)
+
+// snowflakeDigits returns the bounds on custom emoji id lengths, with zero
+// values replaced by the defaults.
+func (o Options) snowflakeDigits() (minDigits, maxDigits int) {
+	minDigits, maxDigits = o.MinSnowflakeDigits, o.MaxSnowflakeDigits
+	if minDigits == 0 {
+		minDigits = defaultMinSnowflakeDigits
+	}
+	if maxDigits == 0 {
+		maxDigits = defaultMaxSnowflakeDigits
+	}
+	return minDigits, maxDigits
+}
+
+// validSnowflake reports whether id is a custom emoji id the parser accepts:
+// digits only, within the configured lengths, and no larger than a uint64.
+func (p *DiscordEmojiParser) validSnowflake(id string) bool {
+	minDigits, maxDigits := p.opts.snowflakeDigits()
+	if len(id) < minDigits || len(id) > maxDigits {
+		return false
+	}
+	_, err := strconv.ParseUint(id, 10, 64)
+	return err == nil
+}
+
+// SnowflakeTime returns the time encoded in a Discord snowflake id, such as
+// the ID of a custom emoji: when the emoji was uploaded.
+func SnowflakeTime(id string) (time.Time, error) {
+	n, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("snowflake %q: %w", id, err)
+	}
+	return time.UnixMilli(int64(n>>22) + discordEpoch).UTC(), nil
+}
+
+// CreatedAt returns the upload time of a custom emoji, decoded from its ID.
+// It is the zero time for unicode and text emojis.
+func (e ParsedEmoji) CreatedAt() time.Time {
+	if e.ID == nil {
+		return time.Time{}
+	}
+	created, err := SnowflakeTime(*e.ID)
+	if err != nil {
+		return time.Time{}
+	}
+	return created
+}
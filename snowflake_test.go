@@ -0,0 +1,80 @@
+package emojiparser_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestSnowflakeBounds(t *testing.T) {
+	tests := []struct {
+		id   string
+		want bool
+	}{
+		{"1234567890123456", false},
+		{"12345678901234567", true},
+		{"12345678901234567890", true},
+		{"123456789012345678901", false},
+		{"18446744073709551615", true},
+		{"18446744073709551616", false},
+		{"99999999999999999999", false},
+	}
+	for _, tc := range tests {
+		results := emojiparser.ParseDiscordCustom("<:pepe:" + tc.id + ">")
+		if got := len(results) == 1; got != tc.want {
+			t.Fatalf("ParseDiscordCustom with id %s = %v, want match %v", tc.id, results, tc.want)
+		}
+	}
+}
+
+func TestInvalidSnowflakeFallsThrough(t *testing.T) {
+	results := emojiparser.Parse("<:smile:99999999999999999999999999>")
+	if len(results) != 1 || results[0].Type != emojiparser.EmojiTypeText || results[0].Name != "smile" {
+		t.Fatalf("Parse = %v, want the :smile: shortcode", results)
+	}
+}
+
+func TestWithSnowflakeDigits(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser(emojiparser.WithSnowflakeDigits(16, 18))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	content := "<:a:1234567890123456> <:b:1234567890123456789>"
+	if results := parser.ParseDiscordCustom(content); len(results) != 1 || results[0].Name != "a" {
+		t.Fatalf("ParseDiscordCustom = %v, want only the 16-digit id", results)
+	}
+	if err := parser.RegisterCustomEmoji("pepe", "1234567890123456"); err != nil {
+		t.Fatalf("RegisterCustomEmoji with a 16-digit id: %v", err)
+	}
+
+	for _, bounds := range [][2]int{{0, 0}, {-1, 20}, {18, 17}, {17, 21}} {
+		_, err := emojiparser.NewDiscordEmojiParser(emojiparser.WithSnowflakeDigits(bounds[0], bounds[1]))
+		if valid := bounds == [2]int{0, 0}; (err == nil) != valid {
+			t.Fatalf("WithSnowflakeDigits(%d, %d): err = %v", bounds[0], bounds[1], err)
+		}
+		if err != nil && !strings.Contains(err.Error(), "snowflake digits") {
+			t.Fatalf("WithSnowflakeDigits(%d, %d): err = %v", bounds[0], bounds[1], err)
+		}
+	}
+}
+
+func TestSnowflakeTime(t *testing.T) {
+	want := time.Date(2016, 4, 30, 11, 18, 25, 796e6, time.UTC)
+	got, err := emojiparser.SnowflakeTime("175928847299117063")
+	if err != nil || !got.Equal(want) {
+		t.Fatalf("SnowflakeTime = %v, %v, want %v", got, err, want)
+	}
+	if _, err := emojiparser.SnowflakeTime("18446744073709551616"); err == nil {
+		t.Fatalf("SnowflakeTime accepted an overflowing id")
+	}
+
+	custom := emojiparser.Parse("<:pepe:175928847299117063> 😄")
+	if created := custom[0].CreatedAt(); !created.Equal(want) {
+		t.Fatalf("CreatedAt = %v, want %v", created, want)
+	}
+	if created := custom[1].CreatedAt(); !created.IsZero() {
+		t.Fatalf("unicode CreatedAt = %v, want the zero time", created)
+	}
+}
@@ -0,0 +1,86 @@
+package emojiparser
+
+import (
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SplitMessage splits content into chunks using the default parser.
+func SplitMessage(content string, limit int) []string {
+	return defaultParser.SplitMessage(content, limit)
+}
+
+// SplitMessage splits content into chunks of at most limit characters
+// (Unicode code points, which is what Discord's 2000 character message limit
+// counts) without cutting through an emoji, a custom emoji tag, or a
+// multi-byte character. Each chunk is made as long as possible, ending after
+// the last newline that fits, else after the last whitespace, else at the
+// last position that does not cut an emoji. Separators stay at the end of
+// the chunk before the cut, so the chunks concatenate back to content.
+//
+// An emoji longer than limit, such as a custom emoji tag with a small limit,
+// cannot be split; it is returned as a chunk of its own, which exceeds
+// limit. A limit below 1 returns content as a single chunk, and empty content returns
+// no chunks.
+func (p *DiscordEmojiParser) SplitMessage(content string, limit int) []string {
+	if content == "" {
+		return nil
+	}
+	if limit < 1 {
+		return []string{content}
+	}
+
+	results := p.Parse(content)
+	var chunks []string
+	for start := 0; start < len(content); {
+		end, count := start, 0
+		lastNewline, lastSpace, lastValid := -1, -1, -1
+		for end < len(content) && count < limit {
+			r, size := utf8.DecodeRuneInString(content[end:])
+			end += size
+			count++
+			if _, inside := splitsEmoji(results, end); inside {
+				continue
+			}
+			lastValid = end
+			switch {
+			case r == '\n':
+				lastNewline = end
+			case unicode.IsSpace(r):
+				lastSpace = end
+			}
+		}
+		if end == len(content) {
+			chunks = append(chunks, content[start:])
+			break
+		}
+
+		cut := lastValid
+		if lastNewline > 0 {
+			cut = lastNewline
+		} else if lastSpace > 0 {
+			cut = lastSpace
+		}
+		if cut < 0 {
+			// Everything from start up to the limit lies in one emoji.
+			emoji, _ := splitsEmoji(results, end)
+			cut = emoji.To
+		}
+		chunks = append(chunks, content[start:cut])
+		start = cut
+	}
+	return chunks
+}
+
+// splitsEmoji returns the position of the result that a cut at offset would
+// split. Results must be sorted by position and must not overlap.
+func splitsEmoji(results []ParsedEmoji, offset int) (EmojiPosition, bool) {
+	i := sort.Search(len(results), func(i int) bool {
+		return results[i].Position.To > offset
+	})
+	if i < len(results) && results[i].Position.From < offset {
+		return results[i].Position, true
+	}
+	return EmojiPosition{}, false
+}
@@ -0,0 +1,79 @@
+package emojiparser_test
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func checkChunks(t *testing.T, content string, limit int, chunks []string) {
+	t.Helper()
+	if got := strings.Join(chunks, ""); got != content {
+		t.Fatalf("chunks %q do not concatenate to the content", chunks)
+	}
+	for _, chunk := range chunks {
+		if !utf8.ValidString(chunk) {
+			t.Fatalf("chunk %q cuts a character", chunk)
+		}
+	}
+}
+
+func TestSplitMessageKeepsEmojis(t *testing.T) {
+	family := "👨‍👩‍👧‍👦"
+	// A naive cut after 10 characters lands inside the family sequence.
+	content := "abcdefgh" + family + "ijk"
+	chunks := emojiparser.SplitMessage(content, 10)
+	checkChunks(t, content, 10, chunks)
+	want := []string{"abcdefgh", family + "ijk"}
+	if strings.Join(chunks, "|") != strings.Join(want, "|") {
+		t.Fatalf("chunks = %q, want %q", chunks, want)
+	}
+
+	custom := "<a:wave:12345678901234567>"
+	content = "hello " + custom + " world"
+	chunks = emojiparser.SplitMessage(content, 20)
+	checkChunks(t, content, 20, chunks)
+	if chunks[0] != "hello " || chunks[1] != custom || chunks[2] != " world" {
+		t.Fatalf("chunks = %q, want the custom tag alone", chunks)
+	}
+}
+
+func TestSplitMessagePrefersNewlines(t *testing.T) {
+	content := "first line\nsecond part of it\nthird"
+	chunks := emojiparser.SplitMessage(content, 25)
+	checkChunks(t, content, 25, chunks)
+	want := []string{"first line\n", "second part of it\nthird"}
+	if strings.Join(chunks, "|") != strings.Join(want, "|") {
+		t.Fatalf("chunks = %q, want %q", chunks, want)
+	}
+
+	content = "one two three four"
+	chunks = emojiparser.SplitMessage(content, 10)
+	checkChunks(t, content, 10, chunks)
+	if chunks[0] != "one two " {
+		t.Fatalf("chunks = %q, want a cut after whitespace", chunks)
+	}
+	for _, chunk := range chunks {
+		if utf8.RuneCountInString(chunk) > 10 {
+			t.Fatalf("chunk %q exceeds the limit", chunk)
+		}
+	}
+}
+
+func TestSplitMessageCountsCharacters(t *testing.T) {
+	content := strings.Repeat("é", 30)
+	chunks := emojiparser.SplitMessage(content, 10)
+	checkChunks(t, content, 10, chunks)
+	if len(chunks) != 3 {
+		t.Fatalf("got %d chunks, want 3", len(chunks))
+	}
+
+	if chunks := emojiparser.SplitMessage("", 10); len(chunks) != 0 {
+		t.Fatalf("empty content gave %q", chunks)
+	}
+	if chunks := emojiparser.SplitMessage("short", 2000); len(chunks) != 1 {
+		t.Fatalf("short content gave %q", chunks)
+	}
+}
@@ -0,0 +1,284 @@
+package emojiparser
+
+import (
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// parserState holds the indexes built from the asset tables. A state is never
+// mutated once built; changes produce a new state that is swapped in whole,
+// so a parse sees either the old or the new tables.
+//
+// The raw UnicodeEmojis table is not kept: every entry maps a name to an emoji
+// or an emoji to a name, so it is the union of nameToUnicode and
+// unicodeToName, which is what assets rebuilds it from.
+type parserState struct {
+	nameToUnicode map[string]string
+	unicodeToName map[string]string
+	svg           map[string]string // UnicodeEmojisSVG: code point key to hash
+	unicodeKeys   []string
+	keys          keyTrie // unicodeKeys indexed for matching
+	maxKeyLen     int     // byte length of the longest unicode key
+	maxNameLen    int     // byte length of the longest shortcode name
+	keyHasSpace   bool    // some unicode key contains ASCII whitespace
+
+	// preferred holds the preferred shortcode of the emojis for which it is
+	// not the name in unicodeToName.
+	preferred map[string]string
+
+	// asciiStarts marks the ASCII bytes some unicode key begins with, such
+	// as the digits of keycap sequences. Every other ASCII byte can be
+	// skipped by the unicode scan.
+	asciiStarts [utf8.RuneSelf]bool
+
+	// customEmojis holds the IDs registered with RegisterCustomEmoji by
+	// name. Registrations are not asset data, so they carry over when the
+	// tables are reloaded or merged.
+	customEmojis map[string][]string
+
+	// cache holds indexes that most callers never need. They depend only on
+	// the tables, so states sharing tables may share a cache.
+	cache *stateCache
+}
+
+// stateCache holds indexes of a parserState that are built on first use.
+type stateCache struct {
+	namesOnce   sync.Once
+	sortedNames []string
+
+	svgHashesOnce sync.Once
+	svgHashes     map[string]string // SVG hash to code point key
+
+	aliasesOnce sync.Once
+	aliases     map[string][]string // emoji to its sorted shortcode names
+
+	emojisOnce   sync.Once
+	sortedEmojis []string // emoji keys in code point order
+
+	qualifiedOnce sync.Once
+	qualified     map[string]string // emoji without U+FE0F to its key
+}
+
+// namesFor returns the sorted shortcode names that map to emoji.
+func (s *parserState) namesFor(emoji string) []string {
+	c := s.cache
+	c.aliasesOnce.Do(func() {
+		c.aliases = make(map[string][]string, len(s.unicodeToName))
+		for _, name := range s.names() {
+			target := s.nameToUnicode[name]
+			c.aliases[target] = append(c.aliases[target], name)
+		}
+	})
+	return c.aliases[emoji]
+}
+
+// codePointForHash returns the code point key whose SVG asset has hash.
+func (s *parserState) codePointForHash(hash string) (string, bool) {
+	c := s.cache
+	c.svgHashesOnce.Do(func() {
+		c.svgHashes = make(map[string]string, len(s.svg))
+		for codePoint, h := range s.svg {
+			c.svgHashes[h] = codePoint
+		}
+	})
+	codePoint, ok := c.svgHashes[hash]
+	return codePoint, ok
+}
+
+// qualifiedForm returns the emoji key that equals s once every U+FE0F is
+// removed from both. Keys that are shortcode names, not emojis, are left
+// out.
+func (s *parserState) qualifiedForm(emoji string) (string, bool) {
+	c := s.cache
+	c.qualifiedOnce.Do(func() {
+		c.qualified = make(map[string]string, len(s.unicodeKeys))
+		for _, key := range s.unicodeKeys {
+			if !isEmojiKey(key) {
+				continue
+			}
+			c.qualified[strings.ReplaceAll(key, variationSelector16, "")] = key
+		}
+	})
+	key, ok := c.qualified[strings.ReplaceAll(emoji, variationSelector16, "")]
+	return key, ok
+}
+
+// emojis returns the unicode keys that are emojis, in code point order.
+// Keys that are shortcode names are left out.
+func (s *parserState) emojis() []string {
+	c := s.cache
+	c.emojisOnce.Do(func() {
+		c.sortedEmojis = make([]string, 0, len(s.unicodeKeys))
+		for _, key := range s.unicodeKeys {
+			if isEmojiKey(key) {
+				c.sortedEmojis = append(c.sortedEmojis, key)
+			}
+		}
+		// UTF-8 byte order is code point order.
+		sort.Strings(c.sortedEmojis)
+	})
+	return c.sortedEmojis
+}
+
+// isEmojiKey reports whether a unicode key is an emoji rather than a
+// shortcode name with non-ASCII letters, such as "piñata". Names are made of
+// letters, digits, '_', '-' and '+', with at least one of them ASCII, so a
+// letterlike symbol such as ℹ, alone or with U+FE0F, is an emoji.
+func isEmojiKey(key string) bool {
+	hasASCII := false
+	for _, r := range key {
+		if r < utf8.RuneSelf {
+			hasASCII = true
+		}
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '-' && r != '+' {
+			return true
+		}
+	}
+	return !hasASCII
+}
+
+// preferredName returns the shortcode emoji is written as, falling back to
+// Discord's primary name.
+func (s *parserState) preferredName(emoji string) string {
+	if name, ok := s.preferred[emoji]; ok {
+		return name
+	}
+	return s.unicodeToName[emoji]
+}
+
+// preferredNames picks the preferred shortcode of every emoji: the shortest
+// name that can be written as :name:, with ties broken lexicographically.
+// Only the choices that differ from unicodeToName are returned.
+func preferredNames(nameToUnicode, unicodeToName map[string]string) map[string]string {
+	best := make(map[string]string, len(unicodeToName))
+	for name, emoji := range nameToUnicode {
+		if !isShortcodeName(name) {
+			continue
+		}
+		if current, ok := best[emoji]; !ok || len(name) < len(current) || len(name) == len(current) && name < current {
+			best[emoji] = name
+		}
+	}
+
+	preferred := make(map[string]string)
+	for emoji, name := range best {
+		if unicodeToName[emoji] != name {
+			preferred[emoji] = name
+		}
+	}
+	return preferred
+}
+
+// entry returns the value of key in the UnicodeEmojis table the state was
+// built from.
+func (s *parserState) entry(key string) (string, bool) {
+	if value, ok := s.nameToUnicode[key]; ok {
+		return value, true
+	}
+	value, ok := s.unicodeToName[key]
+	return value, ok
+}
+
+// assets rebuilds the tables the state was built from. The maps are new, so
+// the caller may modify them.
+func (s *parserState) assets() *Assets {
+	unicodeEmojis := maps.Clone(s.nameToUnicode)
+	maps.Copy(unicodeEmojis, s.unicodeToName)
+	return &Assets{
+		UnicodeEmojis:    unicodeEmojis,
+		UnicodeEmojisSVG: maps.Clone(s.svg),
+	}
+}
+
+// customNames returns the names of registered custom emojis in sorted order.
+func (s *parserState) customNames() []string {
+	names := make([]string, 0, len(s.customEmojis))
+	for name := range s.customEmojis {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// customName returns the first name, in sorted order, that the custom emoji
+// with id is registered under.
+func (s *parserState) customName(id string) (string, bool) {
+	for _, name := range s.customNames() {
+		if slices.Contains(s.customEmojis[name], id) {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// names returns every shortcode name in sorted order.
+func (s *parserState) names() []string {
+	c := s.cache
+	c.namesOnce.Do(func() {
+		c.sortedNames = make([]string, 0, len(s.nameToUnicode))
+		for name := range s.nameToUnicode {
+			c.sortedNames = append(c.sortedNames, name)
+		}
+		sort.Strings(c.sortedNames)
+	})
+	return c.sortedNames
+}
+
+// newParserState validates assets and builds the lookup indexes for them.
+func newParserState(assets *Assets) (*parserState, error) {
+	if err := validateAssets(assets); err != nil {
+		return nil, err
+	}
+
+	nameToUnicode := make(map[string]string)
+	unicodeToName := make(map[string]string)
+	for key, value := range assets.UnicodeEmojis {
+		if containsNonASCII(key) {
+			unicodeToName[key] = value
+		}
+		if containsNonASCII(value) {
+			nameToUnicode[key] = value
+		}
+	}
+
+	unicodeKeys := make([]string, 0, len(unicodeToName))
+	for key := range unicodeToName {
+		unicodeKeys = append(unicodeKeys, key)
+	}
+	sort.Slice(unicodeKeys, func(i, j int) bool {
+		return len(unicodeKeys[i]) > len(unicodeKeys[j])
+	})
+
+	return indexState(&parserState{
+		nameToUnicode: nameToUnicode,
+		unicodeToName: unicodeToName,
+		svg:           assets.UnicodeEmojisSVG,
+		preferred:     preferredNames(nameToUnicode, unicodeToName),
+		unicodeKeys:   unicodeKeys,
+	}), nil
+}
+
+// indexState fills in the fields of s derived from its unicode keys, which
+// must already be sorted longest first, and from its names.
+func indexState(s *parserState) *parserState {
+	for name := range s.nameToUnicode {
+		s.maxNameLen = max(s.maxNameLen, len(name))
+	}
+	for _, key := range s.unicodeKeys {
+		s.keyHasSpace = s.keyHasSpace || strings.ContainsAny(key, asciiSpace)
+		if key[0] < utf8.RuneSelf {
+			s.asciiStarts[key[0]] = true
+		}
+	}
+	if len(s.unicodeKeys) > 0 {
+		s.maxKeyLen = len(s.unicodeKeys[0])
+	}
+	s.keys = newKeyTrie(s.unicodeKeys)
+	s.cache = &stateCache{}
+	return s
+}
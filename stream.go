@@ -0,0 +1,35 @@
+package emojiparser
+
+import "strings"
+
+// markdownSpace lists the whitespace bytes that end URLs and other markup.
+const markdownSpace = " \t\n\r"
+
+// streamCut returns how much of buf, the start of a longer stream, can be
+// parsed on its own with the results Parse gives for the whole stream, or 0.
+// The cut follows the last whitespace byte, as in ParseParallel, and with
+// markup skipping on it is moved back before any code span, code block,
+// spoiler, or link text that the rest of the stream could still close.
+func (p *DiscordEmojiParser) streamCut(buf []byte) int {
+	content := bytesView(buf)
+	kinds := p.opts.markupKinds()
+	separators := asciiSpace
+	switch {
+	case p.state.Load().keyHasSpace:
+		separators = "\n"
+	case kinds != 0:
+		separators = markdownSpace
+	}
+	cut := strings.LastIndexAny(content, separators) + 1
+	if kinds == 0 {
+		return cut
+	}
+	for cut > 0 {
+		_, pending := markupScan(content[:cut], kinds)
+		if pending == cut {
+			return cut
+		}
+		cut = strings.LastIndexAny(content[:pending], separators) + 1
+	}
+	return 0
+}
@@ -0,0 +1,49 @@
+package emojiparser
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Strip removes every emoji from content using the default parser.
+func Strip(content string) string {
+	return defaultParser.Strip(content)
+}
+
+// Strip removes every emoji Parse finds in content: unicode emojis, text
+// shortcodes of known emojis and Discord custom emoji tags. The surrounding
+// text is kept byte for byte, so "co😄ol" strips to "cool". With
+// WithStripSpace, whitespace a removed emoji leaves doubled is collapsed and
+// whitespace it leaves at either end of the string is dropped, so
+// "hi 😄 there 🔥" strips to "hi there".
+func (p *DiscordEmojiParser) Strip(content string) string {
+	emojis := p.Parse(content)
+	if len(emojis) == 0 {
+		return content
+	}
+	var b strings.Builder
+	b.Grow(len(content))
+	b.WriteString(content[:emojis[0].Position.From])
+	for i, emoji := range emojis {
+		next := len(content)
+		if i+1 < len(emojis) {
+			next = emojis[i+1].Position.From
+		}
+		text := content[emoji.Position.To:next]
+		if p.opts.StripSpace && (b.Len() == 0 || endsWithSpace(b.String())) {
+			text = strings.TrimLeftFunc(text, unicode.IsSpace)
+		}
+		b.WriteString(text)
+	}
+	if !p.opts.StripSpace || strings.TrimFunc(content[emojis[len(emojis)-1].Position.To:], unicode.IsSpace) != "" {
+		return b.String()
+	}
+	return strings.TrimRightFunc(b.String(), unicode.IsSpace)
+}
+
+// endsWithSpace reports whether s ends with a whitespace rune.
+func endsWithSpace(s string) bool {
+	r, _ := utf8.DecodeLastRuneInString(s)
+	return unicode.IsSpace(r)
+}
@@ -0,0 +1,53 @@
+package emojiparser_test
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestStrip(t *testing.T) {
+	cases := map[string]string{
+		"😄hello":                           "hello",
+		"hello😄":                           "hello",
+		"co😄🔥ol":                           "cool",
+		"a:smile:b":                        "ab",
+		"hi <:pepe:12345678901234567> you": "hi  you",
+		"hi 😄 there 🔥":                     "hi  there ",
+		"é😄ü":                              "éü",
+		":unknown: stays":                  ":unknown: stays",
+		"plain":                            "plain",
+		"😄✨:tada:":                         "",
+	}
+	for input, want := range cases {
+		got := emojiparser.Strip(input)
+		if got != want {
+			t.Fatalf("Strip(%q) = %q, want %q", input, got, want)
+		}
+		if !utf8.ValidString(got) {
+			t.Fatalf("Strip(%q) = %q, not valid UTF-8", input, got)
+		}
+	}
+}
+
+func TestStripSpace(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser(emojiparser.WithStripSpace(true))
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+	cases := map[string]string{
+		"hi 😄 there 🔥":  "hi there",
+		"😄 hi":          "hi",
+		"hi 😄 🔥 there":  "hi there",
+		"a  b 😄":        "a  b",
+		"hi😄there":      "hithere",
+		"  😄 padded  x": "  padded  x",
+		"😄 🔥":           "",
+	}
+	for input, want := range cases {
+		if got := parser.Strip(input); got != want {
+			t.Fatalf("Strip(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
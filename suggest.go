@@ -0,0 +1,96 @@
+package emojiparser
+
+import (
+	"slices"
+	"sort"
+	"strings"
+)
+
+// SuggestShortcodes returns known shortcodes close to name using the default parser.
+func SuggestShortcodes(name string, limit int) []string {
+	return defaultParser.SuggestShortcodes(name, limit)
+}
+
+// SuggestShortcodes returns up to limit known shortcode names and registered
+// custom emoji names closest to name by edit distance, nearest first and alphabetically among equals.
+// Surrounding colons and ASCII case in name are ignored. Names further than
+// a third of the input's length (at least 1, at most 3 edits) are never
+// suggested, so unrelated input returns an empty slice. A limit of zero or
+// less returns every name within that distance.
+func (p *DiscordEmojiParser) SuggestShortcodes(name string, limit int) []string {
+	query := strings.ToLower(strings.Trim(name, ":"))
+	if query == "" {
+		return []string{}
+	}
+	cutoff := min(max(len(query)/3, 1), 3)
+
+	type candidate struct {
+		name     string
+		distance int
+	}
+	state := p.state.Load()
+	var candidates []candidate
+	for _, known := range slices.Concat(state.names(), state.customNames()) {
+		if abs(len(known)-len(query)) > cutoff {
+			continue
+		}
+		if distance := editDistance(query, known, cutoff); distance <= cutoff {
+			candidates = append(candidates, candidate{name: known, distance: distance})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+		return candidates[i].name < candidates[j].name
+	})
+
+	if limit <= 0 || limit > len(candidates) {
+		limit = len(candidates)
+	}
+	suggestions := make([]string, limit)
+	for i := range suggestions {
+		suggestions[i] = candidates[i].name
+	}
+	return suggestions
+}
+
+// editDistance returns the optimal string alignment distance between a and
+// b, counting an adjacent transposition as one edit. Once every alignment
+// exceeds cutoff it stops early and returns cutoff+1.
+func editDistance(a, b string, cutoff int) int {
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				curr[j] = min(curr[j], prev2[j-2]+1)
+			}
+			rowMin = min(rowMin, curr[j])
+		}
+		if rowMin > cutoff {
+			return cutoff + 1
+		}
+		prev2, prev, curr = prev, curr, prev2
+	}
+	return prev[len(b)]
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,47 @@
+package emojiparser_test
+
+import (
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestSuggestShortcodes(t *testing.T) {
+	cases := map[string]string{
+		"smle":     "smile",
+		":smiel:":  "smile",
+		"thumbsup": "thumbsup",
+		"Tada":     "tada",
+	}
+	for input, want := range cases {
+		suggestions := emojiparser.SuggestShortcodes(input, 5)
+		if len(suggestions) == 0 || suggestions[0] != want {
+			t.Fatalf("SuggestShortcodes(%q) = %v, want %q first", input, suggestions, want)
+		}
+		if len(suggestions) > 5 {
+			t.Fatalf("SuggestShortcodes(%q) returned %d names, limit was 5", input, len(suggestions))
+		}
+	}
+}
+
+func TestSuggestShortcodesGibberish(t *testing.T) {
+	for _, input := range []string{"qzxjvkwpy", "", "::"} {
+		suggestions := emojiparser.SuggestShortcodes(input, 5)
+		if suggestions == nil || len(suggestions) != 0 {
+			t.Fatalf("SuggestShortcodes(%q) = %v, want empty slice", input, suggestions)
+		}
+	}
+}
+
+func TestSuggestShortcodesRegistered(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser()
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+	if _, err := parser.RegisterShortcode("partyblob", "🎉"); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	if suggestions := parser.SuggestShortcodes("partyblb", 1); len(suggestions) != 1 || suggestions[0] != "partyblob" {
+		t.Fatalf("expected registered name to be suggested, got %v", suggestions)
+	}
+}
@@ -0,0 +1,47 @@
+package emojiparser
+
+// SVGHash returns the SVG asset hash of emoji using the default parser.
+func SVGHash(emoji string) (string, bool) {
+	return defaultParser.SVGHash(emoji)
+}
+
+// SVGLink returns the SVG asset link of emoji using the default parser.
+func SVGLink(emoji string) (string, bool) {
+	return defaultParser.SVGLink(emoji)
+}
+
+// SVGHash returns the hash of the Discord SVG asset for a unicode emoji,
+// found the way Parse finds the asset of its results: U+FE0F may be present
+// or missing in either emoji or the table's code points, and an emoji with
+// skin tones falls back to the asset of the emoji without them. Emojis the
+// tables have no asset for report false.
+func (p *DiscordEmojiParser) SVGHash(emoji string) (string, bool) {
+	state := p.state.Load()
+	return state.svgHash(emoji, state.tableKey(emoji))
+}
+
+// SVGLink returns the link of the Discord SVG asset for a unicode emoji, the
+// same link Parse reports for it, for prefetching the assets of emojis that
+// are not in any parsed content. With WithUnicodeLinkTemplate, the link is
+// built from the template. Emojis without an asset report false either way.
+func (p *DiscordEmojiParser) SVGLink(emoji string) (string, bool) {
+	state := p.state.Load()
+	key := state.tableKey(emoji)
+	if _, ok := state.svgHash(emoji, key); !ok {
+		return "", false
+	}
+	name := state.unicodeToName[key]
+	if name == "" {
+		name = generatedName(key)
+	}
+	return *p.svgLink(state, emoji, key, name), true
+}
+
+// tableKey returns the table key that equals emoji once U+FE0F is ignored, or
+// emoji itself if there is none.
+func (s *parserState) tableKey(emoji string) string {
+	if key, ok := s.qualifiedForm(emoji); ok {
+		return key
+	}
+	return emoji
+}
@@ -0,0 +1,56 @@
+package emojiparser_test
+
+import (
+	"strings"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestSVGHash(t *testing.T) {
+	heart, ok := emojiparser.SVGHash("❤\ufe0f")
+	if !ok {
+		t.Fatal("SVGHash found no asset for the red heart")
+	}
+	if got, ok := emojiparser.SVGHash("❤"); !ok || got != heart {
+		t.Fatalf("SVGHash without U+FE0F = %q, %v; want %q", got, ok, heart)
+	}
+	if _, ok := emojiparser.SVGHash("\U0001F44D\U0001F3FF"); !ok {
+		t.Fatal("SVGHash found no asset for a toned thumbs up")
+	}
+	for _, emoji := range []string{"", "x", "smile", "\U0001F1FF\U0001F1FF"} {
+		if hash, ok := emojiparser.SVGHash(emoji); ok {
+			t.Fatalf("SVGHash(%q) = %q, want none", emoji, hash)
+		}
+	}
+}
+
+func TestSVGLinkMatchesParse(t *testing.T) {
+	unicode := emojiparser.ParseUnicode("😄", nil)
+	text := emojiparser.ParseTextRepresentation(":smile:", nil)
+	if len(unicode) != 1 || len(text) != 1 || unicode[0].Link == nil || text[0].Link == nil {
+		t.Fatalf("ParseUnicode = %v, ParseTextRepresentation = %v", unicode, text)
+	}
+	link, ok := emojiparser.SVGLink("😄")
+	if !ok {
+		t.Fatal("SVGLink found no asset for smile")
+	}
+	if *unicode[0].Link != link || *text[0].Link != link {
+		t.Fatalf("links differ: unicode %q, text %q, SVGLink %q", *unicode[0].Link, *text[0].Link, link)
+	}
+	hash, _ := emojiparser.SVGHash("😄")
+	if want := "https://discord.com/assets/" + hash + ".svg"; link != want {
+		t.Fatalf("SVGLink = %q, want %q", link, want)
+	}
+}
+
+func TestSVGLinkTemplate(t *testing.T) {
+	parser := newTestParser(t, emojiparser.WithUnicodeLinkTemplate("https://cdn.example/{name}/{codepoints}.{ext}"))
+	link, ok := parser.SVGLink("❤")
+	if !ok || !strings.HasPrefix(link, "https://cdn.example/heart/2764") {
+		t.Fatalf("SVGLink = %q, %v", link, ok)
+	}
+	if link, ok := parser.SVGLink("x"); ok {
+		t.Fatalf("SVGLink(%q) = %q, want none", "x", link)
+	}
+}
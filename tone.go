@@ -0,0 +1,145 @@
+package emojiparser
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SkinTone is the Fitzpatrick skin tone modifier applied to an emoji.
+type SkinTone uint8
+
+const (
+	// ToneNone means the emoji has no skin tone modifier.
+	ToneNone SkinTone = iota
+	// Tone1 is U+1F3FB, light skin tone.
+	Tone1
+	// Tone2 is U+1F3FC, medium-light skin tone.
+	Tone2
+	// Tone3 is U+1F3FD, medium skin tone.
+	Tone3
+	// Tone4 is U+1F3FE, medium-dark skin tone.
+	Tone4
+	// Tone5 is U+1F3FF, dark skin tone.
+	Tone5
+)
+
+const firstSkinTone = '\U0001F3FB'
+
+// String returns the tone as Discord names it in shortcodes, "tone1" to
+// "tone5", or "" for ToneNone.
+func (t SkinTone) String() string {
+	if t < Tone1 || t > Tone5 {
+		return ""
+	}
+	return "tone" + string(rune('0'+t))
+}
+
+// toneDescriptions are the CLDR names of the tones, indexed by tone.
+var toneDescriptions = [...]string{"", "light skin tone", "medium-light skin tone", "medium skin tone", "medium-dark skin tone", "dark skin tone"}
+
+// Description returns the tone's name in words, such as "medium skin tone",
+// or "" for ToneNone.
+func (t SkinTone) Description() string {
+	if t > Tone5 {
+		return ""
+	}
+	return toneDescriptions[t]
+}
+
+// Modifier returns the modifier character of the tone, or "" for ToneNone.
+func (t SkinTone) Modifier() string {
+	if t < Tone1 || t > Tone5 {
+		return ""
+	}
+	return string(firstSkinTone + rune(t-Tone1))
+}
+
+// skinToneOf returns the tone of r if it is a skin tone modifier.
+func skinToneOf(r rune) (SkinTone, bool) {
+	if r < firstSkinTone || r > firstSkinTone+4 {
+		return ToneNone, false
+	}
+	return Tone1 + SkinTone(r-firstSkinTone), true
+}
+
+// toneOf returns the first skin tone modifier in emoji. Sequences of several
+// people, such as 🧑🏿‍🤝‍🧑🏻, can carry more than one.
+func toneOf(emoji string) SkinTone {
+	for _, r := range emoji {
+		if tone, ok := skinToneOf(r); ok {
+			return tone
+		}
+	}
+	return ToneNone
+}
+
+// withoutSkinTones returns emoji with its skin tone modifiers removed.
+func withoutSkinTones(emoji string) string {
+	if toneOf(emoji) == ToneNone {
+		return emoji
+	}
+	return strings.Map(func(r rune) rune {
+		if _, ok := skinToneOf(r); ok {
+			return -1
+		}
+		return r
+	}, emoji)
+}
+
+// takesSkinTone reports whether key is a single modifier base, possibly
+// followed by U+FE0F, that a skin tone modifier can follow.
+func takesSkinTone(key string) bool {
+	r, size := utf8.DecodeRuneInString(key)
+	rest := key[size:]
+	return (rest == "" || rest == variationSelector16) && unicode.Is(emojiModifierBase, r)
+}
+
+// toneShortcodePrefix starts the shortcodes Discord and Slack put after an
+// emoji's own to give it a skin tone, :skin-tone-1: to :skin-tone-5:.
+const toneShortcodePrefix = ":skin-tone-"
+
+// toneShortcode matches a :skin-tone-N: shortcode at content[i:] and returns
+// its tone and end.
+func toneShortcode(content string, i int) (SkinTone, int, bool) {
+	rest, ok := strings.CutPrefix(content[i:], toneShortcodePrefix)
+	if !ok || len(rest) < 2 || rest[0] < '1' || rest[0] > '5' || rest[1] != ':' {
+		return ToneNone, 0, false
+	}
+	return Tone1 + SkinTone(rest[0]-'1'), i + len(toneShortcodePrefix) + 2, true
+}
+
+// cutToneSuffix splits a shortcode name ending in _tone1 to _tone5 into its
+// base and tone.
+func cutToneSuffix(name string) (string, SkinTone, bool) {
+	n := len(name) - len("_tone1")
+	if n <= 0 || name[n:len(name)-1] != "_tone" || name[len(name)-1] < '1' || name[len(name)-1] > '5' {
+		return "", ToneNone, false
+	}
+	return name[:n], Tone1 + SkinTone(name[len(name)-1]-'1'), true
+}
+
+// cutSkinToneSuffix is cutToneSuffix that also accepts the spelled out
+// suffixes of Discord's primary names, as in thumbsup_medium_dark_skin_tone.
+func cutSkinToneSuffix(name string) (string, SkinTone, bool) {
+	if base, tone, ok := cutToneSuffix(name); ok {
+		return base, tone, true
+	}
+	base, found := "", ToneNone
+	for tone := Tone1; tone <= Tone5; tone++ {
+		suffix := "_" + strings.NewReplacer(" ", "_", "-", "_").Replace(tone.Description())
+		if strings.HasSuffix(name, suffix) && len(name) > len(suffix) && (found == ToneNone || len(name)-len(suffix) < len(base)) {
+			base, found = name[:len(name)-len(suffix)], tone
+		}
+	}
+	return base, found, found != ToneNone
+}
+
+// withSkinTone returns emoji with tone applied, or emoji unchanged if it does
+// not take a skin tone.
+func withSkinTone(emoji string, tone SkinTone) string {
+	if !takesSkinTone(emoji) {
+		return emoji
+	}
+	return strings.TrimSuffix(emoji, variationSelector16) + tone.Modifier()
+}
@@ -0,0 +1,106 @@
+package emojiparser_test
+
+import (
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestSkinToneFolding(t *testing.T) {
+	checkSingleEmoji(t, []wantEmoji{
+		{"👍🏽", "thumbup_tone3", "👍🏽", 0, 8},
+		{"hi 👋🏻!", "wave_tone1", "👋🏻", 3, 11},
+		{"🧑🏿‍🤝‍🧑🏻", "people_holding_hands_dark_skin_tone_light_skin_tone", "🧑🏿‍🤝‍🧑🏻", 0, 26},
+		// Modifier bases without a toned key keep their modifier.
+		{"👪🏽", "family", "👪🏽", 0, 8},
+		{"🤼🏿", "wrestling", "🤼🏿", 0, 8},
+		// And so do toned components of unknown sequences.
+		{"👋🏽‍🔥", "wave_tone3", "👋🏽‍🔥", 0, 15},
+	})
+}
+
+func TestSkinTone(t *testing.T) {
+	tests := []struct {
+		content string
+		want    emojiparser.SkinTone
+	}{
+		{"👍", emojiparser.ToneNone},
+		{"👍🏻", emojiparser.Tone1},
+		{"👍🏿", emojiparser.Tone5},
+		{"👪🏼", emojiparser.Tone2},
+		{"🧑🏿‍🤝‍🧑🏻", emojiparser.Tone5},
+	}
+	for _, tc := range tests {
+		results := emojiparser.Parse(tc.content)
+		if len(results) != 1 || results[0].Tone != tc.want {
+			t.Fatalf("Parse(%q) = %+v, want tone %v", tc.content, results, tc.want)
+		}
+	}
+	for _, tone := range []emojiparser.SkinTone{emojiparser.Tone1, emojiparser.Tone4} {
+		results := emojiparser.Parse(":ok_hand:" + tone.Modifier())
+		if len(results) != 1 || results[0].Tone != emojiparser.ToneNone {
+			t.Fatalf("shortcode followed by %v = %+v, want no tone", tone, results)
+		}
+	}
+	if got := emojiparser.Tone4.String(); got != "tone4" {
+		t.Fatalf("Tone4.String() = %q, want tone4", got)
+	}
+	if got := emojiparser.ToneNone.String() + emojiparser.ToneNone.Modifier(); got != "" {
+		t.Fatalf("ToneNone = %q, want empty", got)
+	}
+}
+
+func TestSkinToneWithoutBase(t *testing.T) {
+	// 😄 is not a modifier base, so the modifier is not part of it.
+	results := emojiparser.Parse("😄🏽")
+	if len(results) != 1 || results[0].Name != "smile" || results[0].Position.To != 4 || results[0].Tone != emojiparser.ToneNone {
+		t.Fatalf("Parse(😄🏽) = %+v, want a lone smile", results)
+	}
+	if results := emojiparser.Parse("🏽 and 🏿"); len(results) != 0 {
+		t.Fatalf("lone modifiers parsed as %+v", results)
+	}
+}
+
+func TestSkinToneFallbackLink(t *testing.T) {
+	toned := emojiparser.Parse("👪🏽")[0]
+	base := emojiparser.Parse("👪")[0]
+	if toned.Link == nil || base.Link == nil || *toned.Link != *base.Link {
+		t.Fatalf("toned link = %v, want the untoned link %v", toned.Link, base.Link)
+	}
+}
+
+func TestSkinToneShortcodes(t *testing.T) {
+	tests := []struct {
+		content, name, unicode string
+		from, to               int
+		tone                   emojiparser.SkinTone
+	}{
+		{":thumbsup::skin-tone-3:", "thumbsup", "👍🏽", 0, 23, emojiparser.Tone3},
+		{"hi :wave::skin-tone-1:!", "wave", "👋🏻", 3, 22, emojiparser.Tone1},
+		{":thumbsup_tone2:", "thumbsup_tone2", "👍🏼", 0, 16, emojiparser.Tone2},
+		{":sleeping_accommodation_tone5:", "sleeping_accommodation_tone5", "🛌🏿", 0, 30, emojiparser.Tone5},
+		// Bases without skin tones swallow the suffix unchanged.
+		{":smile::skin-tone-4:", "smile", "😄", 0, 20, emojiparser.ToneNone},
+		{":thumbsup_tone2::skin-tone-4:", "thumbsup_tone2", "👍🏼", 0, 29, emojiparser.Tone2},
+		// Out of range and detached suffixes are left alone.
+		{":thumbsup::skin-tone-6:", "thumbsup", "👍", 0, 10, emojiparser.ToneNone},
+		{":thumbsup: :skin-tone-3:", "thumbsup", "👍", 0, 10, emojiparser.ToneNone},
+	}
+	for _, tc := range tests {
+		results := emojiparser.ParseTextRepresentation(tc.content, nil)
+		if len(results) != 1 {
+			t.Fatalf("ParseTextRepresentation(%q) = %+v, want one emoji", tc.content, results)
+		}
+		got := results[0]
+		if got.Name != tc.name || got.Unicode != tc.unicode || got.Position.From != tc.from || got.Position.To != tc.to || got.Tone != tc.tone {
+			t.Fatalf("ParseTextRepresentation(%q) = %+v, want %s %q at [%d:%d) with tone %v",
+				tc.content, got, tc.name, tc.unicode, tc.from, tc.to, tc.tone)
+		}
+	}
+
+	for _, content := range []string{":smile_tone3:", ":skin-tone-3:", ":nope::skin-tone-3:"} {
+		if results := emojiparser.ParseTextRepresentation(content, nil); len(results) != 0 {
+			t.Fatalf("ParseTextRepresentation(%q) = %+v, want none", content, results)
+		}
+	}
+}
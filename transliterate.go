@@ -0,0 +1,142 @@
+package emojiparser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// transliterateOptions holds the settings of a Transliterate call.
+type transliterateOptions struct {
+	open, close string
+	describe    func(ParsedEmoji) (string, bool)
+	dropUnknown bool
+}
+
+// TransliterateOption configures a single Transliterate call.
+type TransliterateOption func(*transliterateOptions)
+
+// WithDescriptionWrap sets the text Transliterate puts around descriptions.
+// The default is "(" and ")"; ":" and ":" or two empty strings also work.
+func WithDescriptionWrap(open, close string) TransliterateOption {
+	return func(o *transliterateOptions) {
+		o.open, o.close = open, close
+	}
+}
+
+// WithDescriber makes Transliterate ask describe for each description first,
+// as a hook for localized descriptions. When describe reports false, the
+// default English description is used.
+func WithDescriber(describe func(ParsedEmoji) (string, bool)) TransliterateOption {
+	return func(o *transliterateOptions) {
+		o.describe = describe
+	}
+}
+
+// WithDropUnknown makes Transliterate remove emojis that have no name
+// instead of describing them by code point.
+func WithDropUnknown(drop bool) TransliterateOption {
+	return func(o *transliterateOptions) {
+		o.dropUnknown = drop
+	}
+}
+
+// Transliterate replaces emojis with descriptions using the default parser.
+func Transliterate(content string, opts ...TransliterateOption) string {
+	return defaultParser.Transliterate(content, opts...)
+}
+
+// Transliterate replaces every emoji Parse finds with a description in words,
+// for text-to-speech and plain text, so "Great job 👍🏽" becomes "Great job
+// (thumbsup, medium skin tone)". Descriptions are built from the emoji's
+// name with underscores as spaces, its skin tones, and for custom emojis
+// whether they are animated, as in "(custom emoji partyblob, animated)".
+// Adjacent emojis share one wrap, separated by commas: "🎉👍" becomes "(tada,
+// thumbsup)". An emoji without a name is described by its code points, as
+// in "U+1FA85", unless WithDropUnknown removes it.
+func (p *DiscordEmojiParser) Transliterate(content string, opts ...TransliterateOption) string {
+	o := transliterateOptions{open: "(", close: ")"}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var b strings.Builder
+	b.Grow(len(content))
+	last := 0
+	open := false
+	for _, emoji := range p.Parse(content) {
+		description, ok := o.description(emoji)
+		if open && (emoji.Position.From != last || !ok) {
+			b.WriteString(o.close)
+			open = false
+		}
+		b.WriteString(content[last:emoji.Position.From])
+		last = emoji.Position.To
+		if !ok {
+			continue
+		}
+		if open {
+			b.WriteString(", ")
+		} else {
+			b.WriteString(o.open)
+			open = true
+		}
+		b.WriteString(description)
+	}
+	if open {
+		b.WriteString(o.close)
+	}
+	b.WriteString(content[last:])
+	return b.String()
+}
+
+// description returns the description of emoji, or false if it is dropped.
+func (o transliterateOptions) description(emoji ParsedEmoji) (string, bool) {
+	if o.describe != nil {
+		if description, ok := o.describe(emoji); ok {
+			return description, true
+		}
+	}
+	if emoji.Type == EmojiTypeCustom {
+		description := "custom emoji " + spokenName(emoji.Name)
+		if emoji.Animated {
+			description += ", animated"
+		}
+		return description, true
+	}
+	name := emoji.Name
+	for {
+		base, _, ok := cutSkinToneSuffix(name)
+		if !ok {
+			break
+		}
+		name = base
+	}
+	if name == "" {
+		if o.dropUnknown {
+			return "", false
+		}
+		return codePointNames(emoji.Unicode), true
+	}
+	description := spokenName(name)
+	for _, r := range emoji.Unicode {
+		if tone, ok := skinToneOf(r); ok {
+			description += ", " + tone.Description()
+		}
+	}
+	return description, true
+}
+
+// spokenName returns a shortcode name with its underscores as spaces.
+func spokenName(name string) string {
+	return strings.ReplaceAll(name, "_", " ")
+}
+
+// codePointNames returns the code points of emoji as U+XXXX, separated by
+// spaces.
+func codePointNames(emoji string) string {
+	names := make([]string, 0, len(emoji)/4+1)
+	for _, r := range emoji {
+		names = append(names, fmt.Sprintf("U+%04X", r))
+	}
+	return strings.Join(names, " ")
+}
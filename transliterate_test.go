@@ -0,0 +1,41 @@
+package emojiparser_test
+
+import (
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestTransliterate(t *testing.T) {
+	cases := map[string]string{
+		"Great job 👍🏽":                        "Great job (thumbup, medium skin tone)",
+		"🎉👍 done":                             "(tada, thumbup) done",
+		"😄 😄":                                 "(smile) (smile)",
+		"gg <a:party_blob:12345678901234567>": "gg (custom emoji party blob, animated)",
+		":smile:<:pepe:12345678901234567>":    "(smile, custom emoji pepe)",
+		"🧑🏿\u200d🤝\u200d🧑🏻":                   "(people holding hands, dark skin tone, light skin tone)",
+		"no emojis here":                      "no emojis here",
+	}
+	for input, want := range cases {
+		if got := emojiparser.Transliterate(input); got != want {
+			t.Fatalf("Transliterate(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestTransliterateOptions(t *testing.T) {
+	describe := func(emoji emojiparser.ParsedEmoji) (string, bool) {
+		if emoji.Name == "tada" {
+			return "fiesta", true
+		}
+		return "", false
+	}
+	got := emojiparser.Transliterate("🎉😄 ok", emojiparser.WithDescriptionWrap(":", ":"), emojiparser.WithDescriber(describe))
+	if want := ":fiesta, smile: ok"; got != want {
+		t.Fatalf("Transliterate = %q, want %q", got, want)
+	}
+	got = emojiparser.Transliterate("a😄b", emojiparser.WithDescriptionWrap("", ""))
+	if want := "asmileb"; got != want {
+		t.Fatalf("Transliterate = %q, want %q", got, want)
+	}
+}
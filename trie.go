@@ -0,0 +1,89 @@
+package emojiparser
+
+import (
+	"bytes"
+	"strings"
+)
+
+// keyTrie indexes the unicode keys byte by byte, with U+FE0F left out, so a
+// match costs time proportional to its length rather than to the number of
+// keys. It is built once per state and never modified.
+type keyTrie struct {
+	nodes []trieNode
+}
+
+// trieNode is a node of a keyTrie. Its children are reached by the bytes in
+// labels; next holds their indexes in the same order.
+type trieNode struct {
+	labels []byte
+	next   []int32
+	key    string // key spelled by the path to this node, or ""
+}
+
+// newKeyTrie indexes keys. Keys that differ only in U+FE0F share a node, which
+// keeps the longest of them. Keys that are a single ASCII character are left
+// out, since they never match.
+func newKeyTrie(keys []string) keyTrie {
+	t := keyTrie{nodes: []trieNode{{}}}
+	for _, key := range keys {
+		if isASCIIKey(key) {
+			continue
+		}
+		node := int32(0)
+		for i := 0; i < len(key); i++ {
+			if strings.HasPrefix(key[i:], variationSelector16) {
+				i += len(variationSelector16) - 1
+				continue
+			}
+			node = t.child(node, key[i])
+		}
+		if current := t.nodes[node].key; len(key) > len(current) {
+			t.nodes[node].key = key
+		}
+	}
+	return t
+}
+
+// child returns the child of node reached by b, adding it if needed.
+func (t *keyTrie) child(node int32, b byte) int32 {
+	n := &t.nodes[node]
+	if i := bytes.IndexByte(n.labels, b); i >= 0 {
+		return n.next[i]
+	}
+	next := int32(len(t.nodes))
+	n.labels = append(n.labels, b)
+	n.next = append(n.next, next)
+	t.nodes = append(t.nodes, trieNode{})
+	return next
+}
+
+// match returns the length of the longest key content starts with and the
+// key, or 0 and "". Selectors in content are skipped wherever they are after
+// the first character, so they are optional in both content and the keys, and
+// those directly after a key are part of the match.
+func (t *keyTrie) match(content string) (int, string) {
+	if len(t.nodes) == 0 {
+		return 0, ""
+	}
+	n, key := 0, ""
+	node := int32(0)
+	for i := 0; ; i++ {
+		if node != 0 {
+			for strings.HasPrefix(content[i:], variationSelector16) {
+				i += len(variationSelector16)
+			}
+			if k := t.nodes[node].key; k != "" {
+				n, key = i, k
+			}
+		}
+		if i == len(content) {
+			return n, key
+		}
+		current := &t.nodes[node]
+		j := bytes.IndexByte(current.labels, content[i])
+		if j < 0 {
+			return n, key
+		}
+		node = current.next[j]
+	}
+}
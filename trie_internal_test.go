@@ -0,0 +1,84 @@
+package emojiparser
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// linearMatch is the key matching the trie replaced: the first of the keys,
+// sorted longest first, that content starts with once U+FE0F is removed from
+// both, and a selector after it.
+func linearMatch(keys []string, content string) (int, string) {
+	for _, key := range keys {
+		if n := matchIgnoringVS16(content, key); n > 0 && !isASCIIKey(key) {
+			if strings.HasPrefix(content[n:], variationSelector16) {
+				n += len(variationSelector16)
+			}
+			return n, key
+		}
+	}
+	return 0, ""
+}
+
+// matchIgnoringVS16 returns the length of the prefix of content that equals
+// key once every U+FE0F is removed from both, or 0.
+func matchIgnoringVS16(content, key string) int {
+	i := 0
+	for j := 0; j < len(key); {
+		switch {
+		case strings.HasPrefix(key[j:], variationSelector16):
+			j += len(variationSelector16)
+			if strings.HasPrefix(content[i:], variationSelector16) {
+				i += len(variationSelector16)
+			}
+		case i > 0 && strings.HasPrefix(content[i:], variationSelector16):
+			i += len(variationSelector16)
+		case i < len(content) && content[i] == key[j]:
+			i++
+			j++
+		default:
+			return 0
+		}
+	}
+	return i
+}
+
+func checkTrieMatchesLinear(t *testing.T, state *parserState, content string) {
+	t.Helper()
+	gotN, gotKey := state.keys.match(content)
+	wantN, wantKey := linearMatch(state.unicodeKeys, content)
+	if gotN != wantN || gotKey != wantKey {
+		t.Fatalf("match(%+q) = %d %+q, want %d %+q", content, gotN, gotKey, wantN, wantKey)
+	}
+}
+
+func TestKeyTrieMatchesLinearScan(t *testing.T) {
+	state := defaultParser.state.Load()
+	for _, key := range state.unicodeKeys {
+		bare := strings.ReplaceAll(key, variationSelector16, "")
+		for _, content := range []string{key, bare, key + variationSelector16, bare + " x", key[:len(key)-1]} {
+			checkTrieMatchesLinear(t, state, content)
+		}
+	}
+
+	rng := rand.New(rand.NewSource(7))
+	for range 2000 {
+		var b strings.Builder
+		for range 1 + rng.Intn(4) {
+			b.WriteString(scanFragments[rng.Intn(len(scanFragments))])
+		}
+		checkTrieMatchesLinear(t, state, b.String())
+	}
+}
+
+func TestKeyTrieEmpty(t *testing.T) {
+	var empty keyTrie
+	if n, key := empty.match("😄"); n != 0 || key != "" {
+		t.Fatalf("zero keyTrie matched %d %q", n, key)
+	}
+	trie := newKeyTrie([]string{"1", "#️"})
+	if n, key := trie.match("1#️"); n != 0 || key != "" {
+		t.Fatalf("trie of ASCII keys matched %d %q", n, key)
+	}
+}
@@ -0,0 +1,71 @@
+package emojiparser
+
+import (
+	"strings"
+	"unicode"
+)
+
+// TrimEmoji removes leading and trailing emojis using the default parser.
+func TrimEmoji(s string) string {
+	return defaultParser.TrimEmoji(s)
+}
+
+// TrimLeftEmoji removes leading emojis using the default parser.
+func TrimLeftEmoji(s string) string {
+	return defaultParser.TrimLeftEmoji(s)
+}
+
+// TrimRightEmoji removes trailing emojis using the default parser.
+func TrimRightEmoji(s string) string {
+	return defaultParser.TrimRightEmoji(s)
+}
+
+// TrimEmoji removes emojis of all types from both ends of s, repeating until
+// neither end is an emoji. Emojis in the interior are kept, and a string made
+// only of emojis trims to "". With WithTrimEmojiSpace, whitespace next to a
+// removed emoji is removed as well, so "🔥 general 🔥" trims to "general".
+func (p *DiscordEmojiParser) TrimEmoji(s string) string {
+	return p.TrimRightEmoji(p.TrimLeftEmoji(s))
+}
+
+// TrimLeftEmoji is like TrimEmoji but only trims the start of s.
+func (p *DiscordEmojiParser) TrimLeftEmoji(s string) string {
+	trimmed := false
+	for {
+		rest := s
+		if p.opts.TrimEmojiSpace {
+			rest = strings.TrimLeftFunc(s, unicode.IsSpace)
+		}
+		emoji, ok := p.StartsWithEmoji(rest)
+		if !ok {
+			break
+		}
+		s = rest[emoji.Position.To:]
+		trimmed = true
+	}
+	if trimmed && p.opts.TrimEmojiSpace {
+		s = strings.TrimLeftFunc(s, unicode.IsSpace)
+	}
+	return s
+}
+
+// TrimRightEmoji is like TrimEmoji but only trims the end of s.
+func (p *DiscordEmojiParser) TrimRightEmoji(s string) string {
+	trimmed := false
+	for {
+		rest := s
+		if p.opts.TrimEmojiSpace {
+			rest = strings.TrimRightFunc(s, unicode.IsSpace)
+		}
+		emoji, ok := p.EndsWithEmoji(rest)
+		if !ok {
+			break
+		}
+		s = rest[:emoji.Position.From]
+		trimmed = true
+	}
+	if trimmed && p.opts.TrimEmojiSpace {
+		s = strings.TrimRightFunc(s, unicode.IsSpace)
+	}
+	return s
+}
@@ -0,0 +1,52 @@
+package emojiparser_test
+
+import (
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestTrimEmoji(t *testing.T) {
+	cases := map[string]string{
+		"🔥general🔥":                         "general",
+		"🔥✨:tada:general":                   "general",
+		"gen🔥eral":                          "gen🔥eral",
+		"🔥gen 😄 eral🔥":                      "gen 😄 eral",
+		"chat<:pepe:12345678901234567>":     "chat",
+		"🔥 general 🔥":                       " general ",
+		"😄:tada:<a:wave:12345678901234567>": "",
+		"plain":                             "plain",
+	}
+	for input, want := range cases {
+		if got := emojiparser.TrimEmoji(input); got != want {
+			t.Fatalf("TrimEmoji(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestTrimLeftRightEmoji(t *testing.T) {
+	if got := emojiparser.TrimLeftEmoji("🔥🔥a🔥"); got != "a🔥" {
+		t.Fatalf("TrimLeftEmoji = %q", got)
+	}
+	if got := emojiparser.TrimRightEmoji("🔥a🔥🔥"); got != "🔥a" {
+		t.Fatalf("TrimRightEmoji = %q", got)
+	}
+}
+
+func TestTrimEmojiSpace(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser(emojiparser.WithTrimEmojiSpace(true))
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+	cases := map[string]string{
+		"🔥 general 🔥":   "general",
+		" 🔥 😄 general":  "general",
+		" general ":     " general ",
+		"🔥 gen 😄 eral ": "gen 😄 eral ",
+	}
+	for input, want := range cases {
+		if got := parser.TrimEmoji(input); got != want {
+			t.Fatalf("TrimEmoji(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
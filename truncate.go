@@ -0,0 +1,62 @@
+package emojiparser
+
+import "unicode/utf8"
+
+// Truncate shortens content to at most maxBytes bytes using the default
+// parser.
+func Truncate(content string, maxBytes int) string {
+	return defaultParser.Truncate(content, maxBytes)
+}
+
+// TruncateRunes shortens content to at most maxRunes runes using the default
+// parser.
+func TruncateRunes(content string, maxRunes int) string {
+	return defaultParser.TruncateRunes(content, maxRunes)
+}
+
+// Truncate returns content unchanged if it is at most maxBytes bytes long, and
+// otherwise its longest prefix that fits without cutting a multi-byte
+// character or any emoji Parse finds, such as a ZWJ sequence or a custom
+// emoji tag. With WithTruncateEllipsis, the ellipsis is appended to a
+// shortened result and counts against maxBytes. If not even the first
+// character or emoji fits, Truncate returns "".
+func (p *DiscordEmojiParser) Truncate(content string, maxBytes int) string {
+	if len(content) <= maxBytes {
+		return content
+	}
+	return p.truncateAt(content, maxBytes-len(p.opts.TruncateEllipsis))
+}
+
+// TruncateRunes is Truncate with the limit counted in runes, which is how
+// Discord counts message and embed lengths.
+func (p *DiscordEmojiParser) TruncateRunes(content string, maxRunes int) string {
+	if utf8.RuneCountInString(content) <= maxRunes {
+		return content
+	}
+	limit := maxRunes - utf8.RuneCountInString(p.opts.TruncateEllipsis)
+	cut := 0
+	for n := 0; n < limit && cut < len(content); n++ {
+		_, size := utf8.DecodeRuneInString(content[cut:])
+		cut += size
+	}
+	return p.truncateAt(content, cut)
+}
+
+// truncateAt cuts content at or before the byte offset limit, which is less
+// than len(content), and appends the ellipsis.
+func (p *DiscordEmojiParser) truncateAt(content string, limit int) string {
+	if limit <= 0 {
+		return ""
+	}
+	cut := limit
+	for cut > 0 && !utf8.RuneStart(content[cut]) {
+		cut--
+	}
+	if emoji, ok := splitsEmoji(p.Parse(content), cut); ok {
+		cut = emoji.From
+	}
+	if cut == 0 {
+		return ""
+	}
+	return content[:cut] + p.opts.TruncateEllipsis
+}
@@ -0,0 +1,69 @@
+package emojiparser_test
+
+import (
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestTruncate(t *testing.T) {
+	family := "👨\u200d👩\u200d👧"
+	cases := []struct {
+		content string
+		max     int
+		want    string
+	}{
+		{"hello", 5, "hello"},
+		{"hello", 3, "hel"},
+		{"héllo", 2, "h"},
+		{"ab" + family + "cd", 10, "ab"},
+		{"ab" + family + "cd", 20, "ab" + family},
+		{"ab<:pepe:12345678901234567>", 20, "ab"},
+		{family + "tail", 10, ""},
+		{"<:pepe:12345678901234567>", 3, ""},
+		{"abc", 0, ""},
+	}
+	for _, c := range cases {
+		if got := emojiparser.Truncate(c.content, c.max); got != c.want {
+			t.Fatalf("Truncate(%q, %d) = %q, want %q", c.content, c.max, got, c.want)
+		}
+	}
+}
+
+func TestTruncateRunes(t *testing.T) {
+	cases := []struct {
+		content string
+		max     int
+		want    string
+	}{
+		{"héllo", 5, "héllo"},
+		{"héllo", 2, "hé"},
+		{"a😄👍🏽b", 3, "a😄"},
+		{"a😄👍🏽b", 4, "a😄👍🏽"},
+		{"😄<a:wave:12345678901234567>", 10, "😄"},
+	}
+	for _, c := range cases {
+		if got := emojiparser.TruncateRunes(c.content, c.max); got != c.want {
+			t.Fatalf("TruncateRunes(%q, %d) = %q, want %q", c.content, c.max, got, c.want)
+		}
+	}
+}
+
+func TestTruncateEllipsis(t *testing.T) {
+	parser := newTestParser(t, emojiparser.WithTruncateEllipsis("…"))
+	if got := parser.Truncate("hello world", 8); got != "hello…" {
+		t.Fatalf("Truncate = %q, want %q", got, "hello…")
+	}
+	if got := parser.TruncateRunes("hello world", 8); got != "hello w…" {
+		t.Fatalf("TruncateRunes = %q, want %q", got, "hello w…")
+	}
+	if got := parser.Truncate("short", 8); got != "short" {
+		t.Fatalf("Truncate = %q, want the content unchanged", got)
+	}
+	if got := parser.TruncateRunes("😄😄 tail", 2); got != "😄…" {
+		t.Fatalf("TruncateRunes = %q, want %q", got, "😄…")
+	}
+	if got := parser.TruncateRunes("😄😄 tail", 1); got != "" {
+		t.Fatalf("TruncateRunes = %q, want \"\"", got)
+	}
+}
@@ -0,0 +1,21 @@
+package emojiparser_test
+
+import (
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestParseUnicodeASCIIStartingKeys(t *testing.T) {
+	content := "press 1️⃣ or #️⃣, then hit the piñata 😄"
+	results := emojiparser.ParseUnicode(content, nil)
+	want := []string{"1️⃣", "#️⃣", "piñata", "😄"}
+	if len(results) != len(want) {
+		t.Fatalf("expected %d emojis, got %d: %+v", len(want), len(results), results)
+	}
+	for i, unicode := range want {
+		if results[i].Unicode != unicode || content[results[i].Position.From:results[i].Position.To] != unicode {
+			t.Fatalf("result %d: expected %q, got %q at %v", i, unicode, results[i].Unicode, results[i].Position)
+		}
+	}
+}
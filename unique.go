@@ -0,0 +1,41 @@
+package emojiparser
+
+// ParseUnique parses the distinct emojis in content using the default
+// parser.
+func ParseUnique(content string) ([]ParsedEmoji, []int) {
+	return defaultParser.ParseUnique(content)
+}
+
+// ParseUnique returns one result per distinct emoji in content, in the order
+// of first occurrence, with the first occurrence's position, and the number
+// of times each occurs. counts[i] is the count of emojis[i]. Emojis are told
+// apart by EmojiKey, so :smile: and 😄 are one emoji while 👍 and 👍🏽 are two.
+// Only the first occurrence of each emoji is built into a result.
+func (p *DiscordEmojiParser) ParseUnique(content string) (emojis []ParsedEmoji, counts []int) {
+	emojis, counts = make([]ParsedEmoji, 0), make([]int, 0)
+	if !p.beginParse(content) {
+		return emojis, counts
+	}
+
+	state := p.state.Load()
+	scanned, m := p.rewriteContent(content)
+	index := make(map[EmojiKey]int)
+	var tokens tokenCounts
+	p.scan(state, scanned, scanAll, nil, func(t token) bool {
+		tokens.add(t.kind)
+		key := keyOf(t)
+		if i, ok := index[key]; ok {
+			counts[i]++
+			return true
+		}
+		index[key] = len(emojis)
+		emojis = append(emojis, p.emojiFor(state, scanned, t))
+		counts = append(counts, 1)
+		return true
+	})
+	p.countTokens(tokens)
+	if m != nil {
+		m.restore(emojis)
+	}
+	return emojis, counts
+}
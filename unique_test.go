@@ -0,0 +1,40 @@
+package emojiparser_test
+
+import (
+	"reflect"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestParseUnique(t *testing.T) {
+	content := "😂 👍 😂 👍🏽 <:pepe:12345678901234567> 😂 <:frog:12345678901234567> :smile: 😄 <:pepe:76543210987654321>"
+	all := emojiparser.Parse(content)
+	emojis, counts := emojiparser.ParseUnique(content)
+	want := []struct {
+		result int
+		count  int
+	}{
+		{0, 3}, // 😂
+		{1, 1}, // 👍
+		{3, 1}, // 👍🏽
+		{4, 2}, // <:pepe:12345678901234567> and <:frog:12345678901234567>
+		{7, 2}, // :smile: and 😄
+		{9, 1}, // <:pepe:76543210987654321>
+	}
+	if len(emojis) != len(want) || len(counts) != len(want) {
+		t.Fatalf("ParseUnique returned %d emojis and %d counts, want %d", len(emojis), len(counts), len(want))
+	}
+	for i, w := range want {
+		if !reflect.DeepEqual(emojis[i], all[w.result]) || counts[i] != w.count {
+			t.Fatalf("entry %d = %v x%d, want %v x%d", i, emojis[i], counts[i], all[w.result], w.count)
+		}
+	}
+}
+
+func TestParseUniqueEmpty(t *testing.T) {
+	emojis, counts := emojiparser.ParseUnique("no emojis")
+	if len(emojis) != 0 || len(counts) != 0 {
+		t.Fatalf("ParseUnique = %v, %v, want none", emojis, counts)
+	}
+}
@@ -0,0 +1,53 @@
+package emojiparser
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// InvalidUTF8Error is returned by ParseStrictUTF8 for content that is not
+// valid UTF-8.
+type InvalidUTF8Error struct {
+	Offset int // byte offset of the first invalid sequence
+}
+
+func (e *InvalidUTF8Error) Error() string {
+	return fmt.Sprintf("invalid UTF-8 at byte %d", e.Offset)
+}
+
+// ParseStrictUTF8 parses content using the default parser and reports
+// invalid UTF-8.
+func ParseStrictUTF8(content string) ([]ParsedEmoji, error) {
+	return defaultParser.ParseStrictUTF8(content)
+}
+
+// ParseStrictUTF8 is Parse for callers that want to know about invalid UTF-8,
+// such as truncated multi-byte sequences. The results are the same as
+// Parse's; if content is not valid UTF-8, they come with an
+// *InvalidUTF8Error for the first invalid sequence.
+//
+// Parse itself steps over invalid bytes one at a time, and never includes
+// one in a result's Position.
+func (p *DiscordEmojiParser) ParseStrictUTF8(content string) ([]ParsedEmoji, error) {
+	results := p.Parse(content)
+	if offset := invalidUTF8(content); offset >= 0 {
+		return results, &InvalidUTF8Error{Offset: offset}
+	}
+	return results, nil
+}
+
+// invalidUTF8 returns the offset of the first invalid UTF-8 sequence in
+// content, or -1 if there is none.
+func invalidUTF8(content string) int {
+	if utf8.ValidString(content) {
+		return -1
+	}
+	for i := 0; i < len(content); {
+		r, size := utf8.DecodeRuneInString(content[i:])
+		if r == utf8.RuneError && size == 1 {
+			return i
+		}
+		i += size
+	}
+	return -1
+}
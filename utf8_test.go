@@ -0,0 +1,73 @@
+package emojiparser_test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+	"unicode/utf8"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestParseStrictUTF8(t *testing.T) {
+	results, err := emojiparser.ParseStrictUTF8("hi 😄 :tada:")
+	if err != nil || len(results) != 2 {
+		t.Fatalf("ParseStrictUTF8 of valid content = %v, %v", results, err)
+	}
+
+	// "😄" truncated to its first three bytes, before a whole one.
+	content := ":smile: \xf0\x9f\x98😄"
+	results, err = emojiparser.ParseStrictUTF8(content)
+	var invalid *emojiparser.InvalidUTF8Error
+	if !errors.As(err, &invalid) || invalid.Offset != 8 {
+		t.Fatalf("ParseStrictUTF8(%q) error = %v, want offset 8", content, err)
+	}
+	if len(results) != 2 || results[1].Name != "smile" || results[1].Position.From != 11 {
+		t.Fatalf("ParseStrictUTF8(%q) = %v, want the same results as Parse", content, results)
+	}
+}
+
+// checkRuneAligned fails unless every result of content spans whole, valid
+// runes.
+func checkRuneAligned(t *testing.T, content string) {
+	t.Helper()
+	boundaries := map[int]bool{len(content): true}
+	for i := range content {
+		boundaries[i] = true
+	}
+	for _, result := range emojiparser.Parse(content) {
+		from, to := result.Position.From, result.Position.To
+		if !boundaries[from] || !boundaries[to] || !utf8.ValidString(content[from:to]) {
+			t.Fatalf("Parse(%q): %s at [%d:%d) does not span whole runes", content, result.Name, from, to)
+		}
+	}
+}
+
+func TestParseCorruptedUTF8(t *testing.T) {
+	base := []byte("<:pepe:12345678901234567> 😄 :tada: 👨‍👩‍👧‍👦 🇺🇸 👍🏽 1️⃣ ❤️ piñata")
+	rng := rand.New(rand.NewSource(7))
+	for range 500 {
+		corrupted := append([]byte(nil), base...)
+		for range 1 + rng.Intn(4) {
+			i := rng.Intn(len(corrupted))
+			switch rng.Intn(3) {
+			case 0: // truncate a sequence
+				corrupted = append(corrupted[:i], corrupted[i+1:]...)
+			case 1:
+				corrupted[i] = byte(rng.Intn(256))
+			default:
+				corrupted = append(corrupted[:i], append([]byte{0x80 | byte(rng.Intn(64))}, corrupted[i:]...)...)
+			}
+		}
+		checkRuneAligned(t, string(corrupted))
+	}
+}
+
+func FuzzParseRuneAligned(f *testing.F) {
+	f.Add("😄\xf0\x9f\x98:smile:")
+	f.Add("\xe2\x9d\xa4\xef\xb8")
+	f.Add("🇺\xf0\x9f\x87")
+	f.Fuzz(func(t *testing.T, content string) {
+		checkRuneAligned(t, content)
+	})
+}
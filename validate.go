@@ -0,0 +1,164 @@
+package emojiparser
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// variationSelector16 is U+FE0F, which requests emoji presentation.
+const variationSelector16 = "\uFE0F"
+
+// Errors returned by ValidateSingleEmoji. They are wrapped with details about
+// the offending input, so compare with errors.Is.
+var (
+	ErrEmptyEmoji     = errors.New("empty emoji input")
+	ErrNotEmoji       = errors.New("input is not an emoji")
+	ErrMultipleEmojis = errors.New("input contains more than one emoji")
+	ErrExtraText      = errors.New("input contains text besides the emoji")
+
+	// ErrNotUnicodeEmoji is returned by ValidateUnicodeEmojiField for
+	// shortcodes and custom emojis.
+	ErrNotUnicodeEmoji = errors.New("input is not a unicode emoji")
+)
+
+// ValidateSingleEmoji validates s using the default parser.
+func ValidateSingleEmoji(s string) (ParsedEmoji, error) {
+	return defaultParser.ValidateSingleEmoji(s)
+}
+
+// ValidateUnicodeEmojiField validates s using the default parser.
+func ValidateUnicodeEmojiField(s string) error {
+	return defaultParser.ValidateUnicodeEmojiField(s)
+}
+
+// IsOnlyEmojis reports whether content consists of emojis using the default parser.
+func IsOnlyEmojis(content string) bool {
+	return defaultParser.IsOnlyEmojis(content)
+}
+
+// ValidateSingleEmoji succeeds only when s is exactly one emoji: a unicode
+// sequence, a :name: shortcode, or custom emoji markup. Surrounding
+// whitespace is ignored unless the parser was created with
+// WithStrictSingleEmoji. The returned position refers to s.
+//
+// Use it for Discord fields that accept a single emoji, such as default
+// reactions and role icons. IsOnlyEmojis is the looser check that allows
+// several emojis.
+func (p *DiscordEmojiParser) ValidateSingleEmoji(s string) (ParsedEmoji, error) {
+	trimmed, offset := s, 0
+	if !p.opts.StrictSingleEmoji {
+		trimmed = strings.TrimLeftFunc(s, unicode.IsSpace)
+		offset = len(s) - len(trimmed)
+		trimmed = strings.TrimRightFunc(trimmed, unicode.IsSpace)
+	}
+	result, err := p.validateSingle(trimmed)
+	if err != nil {
+		return ParsedEmoji{}, err
+	}
+	result.Position.From += offset
+	result.Position.To += offset
+	return result, nil
+}
+
+// validateSingle returns the emoji s consists of, with no surrounding text.
+func (p *DiscordEmojiParser) validateSingle(s string) (ParsedEmoji, error) {
+	if s == "" {
+		return ParsedEmoji{}, ErrEmptyEmoji
+	}
+
+	results := p.Parse(s)
+	switch {
+	case len(results) == 0:
+		return ParsedEmoji{}, fmt.Errorf("%w: %q", ErrNotEmoji, s)
+	case len(results) > 1:
+		return ParsedEmoji{}, fmt.Errorf("%w: found %d", ErrMultipleEmojis, len(results))
+	}
+
+	result := results[0]
+	if result.Position.From > 0 {
+		return ParsedEmoji{}, fmt.Errorf("%w: %q before the emoji", ErrExtraText, s[:result.Position.From])
+	}
+	if result.Position.To < len(s) {
+		return ParsedEmoji{}, fmt.Errorf("%w: %q after the emoji", ErrExtraText, s[result.Position.To:])
+	}
+	return result, nil
+}
+
+// ValidateUnicodeEmojiField succeeds only when s is exactly one known unicode
+// emoji, as required by Discord fields such as a role's unicode_emoji.
+// Whitespace is never ignored. Besides the fully-qualified form, the
+// minimally-qualified form is accepted, in which only a U+FE0F directly after
+// the first code point is kept. Shortcodes and custom emojis fail with
+// ErrNotUnicodeEmoji; other failures use the errors of ValidateSingleEmoji.
+func (p *DiscordEmojiParser) ValidateUnicodeEmojiField(s string) error {
+	state := p.state.Load()
+	if full, ok := state.qualifiedForm(s); ok && isMinimallyQualified(s, full) {
+		return nil
+	}
+
+	result, err := p.validateSingle(s)
+	switch {
+	case err != nil:
+		return err
+	case result.Type == EmojiTypeCustom:
+		return fmt.Errorf("%w: %q is a custom emoji", ErrNotUnicodeEmoji, s)
+	case result.Type == EmojiTypeText:
+		return fmt.Errorf("%w: %q is a shortcode for %q", ErrNotUnicodeEmoji, s, result.Unicode)
+	case isEmojiKey(result.Unicode):
+		// Parsing accepts any spelling of the variation selectors; the
+		// field does not.
+		return fmt.Errorf("%w: %q is neither the fully- nor the minimally-qualified form", ErrNotEmoji, s)
+	default:
+		// A unicode match that is not an emoji key, like a shortcode name
+		// with non-ASCII letters.
+		return fmt.Errorf("%w: %q is a shortcode for %q", ErrNotUnicodeEmoji, s, result.Name)
+	}
+}
+
+// isMinimallyQualified reports whether s is full with zero or more U+FE0F
+// removed, but not one that directly follows the first code point.
+func isMinimallyQualified(s, full string) bool {
+	first, size := utf8.DecodeRuneInString(full)
+	if first == utf8.RuneError || !strings.HasPrefix(s, full[:size]) {
+		return false
+	}
+	s, full = s[size:], full[size:]
+	if strings.HasPrefix(full, variationSelector16) && !strings.HasPrefix(s, variationSelector16) {
+		return false
+	}
+
+	for s != "" {
+		switch {
+		case full == "":
+			return false
+		case s[0] == full[0]:
+			s, full = s[1:], full[1:]
+		case strings.HasPrefix(full, variationSelector16):
+			full = full[len(variationSelector16):]
+		default:
+			return false
+		}
+	}
+	return strings.Trim(full, variationSelector16) == ""
+}
+
+// IsOnlyEmojis reports whether content contains at least one emoji and
+// nothing but emojis and whitespace.
+func (p *DiscordEmojiParser) IsOnlyEmojis(content string) bool {
+	results := p.Parse(content)
+	if len(results) == 0 {
+		return false
+	}
+
+	last := 0
+	for _, result := range results {
+		if strings.TrimFunc(content[last:result.Position.From], unicode.IsSpace) != "" {
+			return false
+		}
+		last = result.Position.To
+	}
+	return strings.TrimFunc(content[last:], unicode.IsSpace) == ""
+}
@@ -0,0 +1,111 @@
+package emojiparser_test
+
+import (
+	"errors"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestValidateSingleEmojiAccepts(t *testing.T) {
+	cases := map[string]emojiparser.EmojiType{
+		"😄":                          emojiparser.EmojiTypeUnicode,
+		"👨‍👩‍👧‍👦":                    emojiparser.EmojiTypeUnicode,
+		":tada:":                     emojiparser.EmojiTypeText,
+		"<a:wave:12345678901234567>": emojiparser.EmojiTypeCustom,
+		"  😄\n":                      emojiparser.EmojiTypeUnicode,
+	}
+	for input, want := range cases {
+		result, err := emojiparser.ValidateSingleEmoji(input)
+		if err != nil {
+			t.Fatalf("ValidateSingleEmoji(%q): %v", input, err)
+		}
+		if result.Type != want {
+			t.Fatalf("ValidateSingleEmoji(%q): expected type %s, got %s", input, want, result.Type)
+		}
+		if got := input[result.Position.From:result.Position.To]; got == "" || got[0] == ' ' {
+			t.Fatalf("ValidateSingleEmoji(%q): position %v does not point at the emoji", input, result.Position)
+		}
+	}
+}
+
+func TestValidateSingleEmojiRejects(t *testing.T) {
+	cases := map[string]error{
+		"":             emojiparser.ErrEmptyEmoji,
+		"   ":          emojiparser.ErrEmptyEmoji,
+		"hello":        emojiparser.ErrNotEmoji,
+		":not_a_name:": emojiparser.ErrNotEmoji,
+		"😄😄":           emojiparser.ErrMultipleEmojis,
+		"😄 :tada:":     emojiparser.ErrMultipleEmojis,
+		"😄!":           emojiparser.ErrExtraText,
+		"go 😄":         emojiparser.ErrExtraText,
+	}
+	for input, want := range cases {
+		if _, err := emojiparser.ValidateSingleEmoji(input); !errors.Is(err, want) {
+			t.Fatalf("ValidateSingleEmoji(%q): expected %v, got %v", input, want, err)
+		}
+	}
+}
+
+func TestValidateSingleEmojiStrictWhitespace(t *testing.T) {
+	parser, err := emojiparser.NewDiscordEmojiParser(emojiparser.WithStrictSingleEmoji(true))
+	if err != nil {
+		t.Fatalf("new parser: %v", err)
+	}
+	if _, err := parser.ValidateSingleEmoji(" 😄"); !errors.Is(err, emojiparser.ErrExtraText) {
+		t.Fatalf("expected surrounding whitespace to be rejected, got %v", err)
+	}
+	if _, err := parser.ValidateSingleEmoji("😄"); err != nil {
+		t.Fatalf("expected bare emoji to pass: %v", err)
+	}
+}
+
+func TestIsOnlyEmojis(t *testing.T) {
+	cases := map[string]bool{
+		"😄 :tada: <:pepe:12345678901234567>": true,
+		"😄😄":                                 true,
+		"":                                   false,
+		"  ":                                 false,
+		"😄 hi":                               false,
+	}
+	for input, want := range cases {
+		if got := emojiparser.IsOnlyEmojis(input); got != want {
+			t.Fatalf("IsOnlyEmojis(%q) = %v, want %v", input, got, want)
+		}
+	}
+}
+
+func TestValidateUnicodeEmojiField(t *testing.T) {
+	cases := []struct {
+		input string
+		want  error
+	}{
+		{"😄", nil},
+		{"👨‍👩‍👧‍👦", nil},
+		{"❤️", nil},
+		{"1️⃣", nil},
+		{"⛹️‍♂️", nil},
+		{"⛹️‍♂", nil},
+		{"", emojiparser.ErrEmptyEmoji},
+		{"hello", emojiparser.ErrNotEmoji},
+		{"❤", emojiparser.ErrNotEmoji},
+		{"1⃣", emojiparser.ErrNotEmoji},
+		{":tada:", emojiparser.ErrNotUnicodeEmoji},
+		{"<:wave:12345678901234567>", emojiparser.ErrNotUnicodeEmoji},
+		{"piñata", emojiparser.ErrNotUnicodeEmoji},
+		{"😄😄", emojiparser.ErrMultipleEmojis},
+		{"😄!", emojiparser.ErrExtraText},
+		{" 😄", emojiparser.ErrExtraText},
+		{"😄️", emojiparser.ErrNotEmoji},
+		{"⛹‍♂️", emojiparser.ErrNotEmoji},
+	}
+	for _, tc := range cases {
+		err := emojiparser.ValidateUnicodeEmojiField(tc.input)
+		if tc.want == nil && err != nil {
+			t.Fatalf("ValidateUnicodeEmojiField(%q): %v", tc.input, err)
+		}
+		if !errors.Is(err, tc.want) {
+			t.Fatalf("ValidateUnicodeEmojiField(%q): expected %v, got %v", tc.input, tc.want, err)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package emojiparser
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// VisibleLength counts the visible units of content using the default
+// parser.
+func VisibleLength(content string) int {
+	return defaultParser.VisibleLength(content)
+}
+
+// VisibleLength returns the number of units VisibleUnits splits content into,
+// which is how long content looks rather than how many bytes or runes it
+// has. Every emoji Parse finds is one unit, whatever its length:
+//
+//   - a custom emoji tag such as <:pepe:12345678901234567> is one unit;
+//   - a known shortcode such as :smile: is one unit, and an unknown one is
+//     counted as its text, one unit per character;
+//   - a skin tone modifier belongs to the emoji it follows, so 👍🏽 is one
+//     unit, and so do the emojis a zero width joiner joins into one;
+//   - a flag, a pair of regional indicators such as 🇺🇸, is one unit.
+//
+// Any other character is one unit together with the combining marks and
+// zero width joiners that follow it, so "é" is one unit whether or not it is
+// decomposed. Whitespace and newlines are one unit each.
+func (p *DiscordEmojiParser) VisibleLength(content string) int {
+	n := 0
+	p.visibleUnits(content, func(Segment) bool {
+		n++
+		return true
+	})
+	return n
+}
+
+// visibleUnits calls yield for each unit VisibleUnits iterates over until
+// yield returns false.
+func (p *DiscordEmojiParser) visibleUnits(content string, yield func(Segment) bool) {
+	results := p.Parse(content)
+	for i := 0; i < len(content); {
+		if len(results) > 0 && results[0].Position.From == i {
+			emoji := &results[0]
+			results = results[1:]
+			if !yield(Segment{Kind: SegmentEmoji, Raw: content[i:emoji.Position.To], Position: emoji.Position, Emoji: emoji}) {
+				return
+			}
+			i = emoji.Position.To
+			continue
+		}
+
+		_, size := utf8.DecodeRuneInString(content[i:])
+		end := i + size
+		for end < len(content) && (len(results) == 0 || results[0].Position.From > end) {
+			next, nextSize := utf8.DecodeRuneInString(content[end:])
+			if !extendsUnit(next) {
+				break
+			}
+			end += nextSize
+		}
+		if !yield(Segment{Kind: SegmentText, Raw: content[i:end], Position: EmojiPosition{From: i, To: end}}) {
+			return
+		}
+		i = end
+	}
+}
+
+// extendsUnit reports whether r belongs to the visible unit before it.
+func extendsUnit(r rune) bool {
+	return r == zeroWidthJoiner || unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc)
+}
@@ -0,0 +1,21 @@
+//go:build go1.23
+
+package emojiparser
+
+import "iter"
+
+// VisibleUnits iterates over the visible units of content using the default
+// parser.
+func VisibleUnits(content string) iter.Seq[Segment] {
+	return defaultParser.VisibleUnits(content)
+}
+
+// VisibleUnits iterates over the units VisibleLength counts, in order, as
+// segments: an emoji segment for each emoji Parse finds and a text segment
+// for each other character with the marks attached to it. The segments'
+// Raw strings concatenate back to content.
+func (p *DiscordEmojiParser) VisibleUnits(content string) iter.Seq[Segment] {
+	return func(yield func(Segment) bool) {
+		p.visibleUnits(content, yield)
+	}
+}
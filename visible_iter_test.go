@@ -0,0 +1,30 @@
+//go:build go1.23
+
+package emojiparser_test
+
+import (
+	"strings"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestVisibleUnits(t *testing.T) {
+	content := "e\u0301 👍🏽:tada:x"
+	var raws []string
+	var b strings.Builder
+	for unit := range emojiparser.VisibleUnits(content) {
+		raws = append(raws, unit.Raw)
+		b.WriteString(unit.Raw)
+		if (unit.Emoji != nil) != (unit.Kind == emojiparser.SegmentEmoji) {
+			t.Fatalf("unit %q: Emoji = %v for kind %v", unit.Raw, unit.Emoji, unit.Kind)
+		}
+	}
+	want := []string{"e\u0301", " ", "👍🏽", ":tada:", "x"}
+	if strings.Join(raws, "|") != strings.Join(want, "|") {
+		t.Fatalf("VisibleUnits = %q, want %q", raws, want)
+	}
+	if b.String() != content {
+		t.Fatalf("units concatenate to %q", b.String())
+	}
+}
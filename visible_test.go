@@ -0,0 +1,31 @@
+package emojiparser_test
+
+import (
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestVisibleLength(t *testing.T) {
+	cases := map[string]int{
+		"":                              0,
+		"hello":                         5,
+		"héllo":                         5,
+		"he\u0301llo":                   5,
+		"😄":                             1,
+		"👍🏽":                            1,
+		"👨\u200d👩\u200d👧":               1,
+		"🇺🇸🇧🇬":                          2,
+		"<:pepe:12345678901234567>":     1,
+		"<a:wave:12345678901234567>!":   2,
+		":smile:":                       1,
+		":nope:":                        6,
+		"hi 😄\nyo":                      7,
+		"a<:pepe:12345678901234567>b😄c": 5,
+	}
+	for content, want := range cases {
+		if got := emojiparser.VisibleLength(content); got != want {
+			t.Fatalf("VisibleLength(%q) = %d, want %d", content, got, want)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+// Command wasm exposes the parser to JavaScript. Build it with
+//
+//	GOOS=js GOARCH=wasm go build -o emoji.wasm ./wasm
+//
+// and load it with wasm_exec.js from the Go distribution, as in loader.js.
+// It defines two global functions: parseEmojis(content), which returns the
+// parse results as a JSON string, and replaceShortcodes(content), which
+// returns content with :name: shortcodes replaced by their emojis.
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"unicode/utf8"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+// jsEmoji is a parse result as seen from JavaScript. Start and End index the
+// content as a JavaScript string, in UTF-16 code units.
+type jsEmoji struct {
+	Type     emojiparser.EmojiType `json:"type"`
+	Name     string                `json:"name"`
+	Unicode  string                `json:"unicode"`
+	ID       *string               `json:"id"`
+	Link     *string               `json:"link"`
+	Animated bool                  `json:"animated"`
+	Start    int                   `json:"start"`
+	End      int                   `json:"end"`
+}
+
+// parseEmojisJSON parses content and encodes the results for JavaScript.
+func parseEmojisJSON(content string) string {
+	results := emojiparser.Parse(content)
+	toUTF16 := utf16Offsets(content)
+
+	emojis := make([]jsEmoji, 0, len(results))
+	for _, result := range results {
+		emojis = append(emojis, jsEmoji{
+			Type:     result.Type,
+			Name:     result.Name,
+			Unicode:  result.Unicode,
+			ID:       result.ID,
+			Link:     result.Link,
+			Animated: result.Animated,
+			Start:    toUTF16(result.Position.From),
+			End:      toUTF16(result.Position.To),
+		})
+	}
+
+	encoded, err := json.Marshal(emojis)
+	if err != nil {
+		// jsEmoji only holds strings, numbers, and booleans.
+		panic(err)
+	}
+	return string(encoded)
+}
+
+// replaceShortcodes replaces the :name: shortcodes in content with the
+// emojis they stand for.
+func replaceShortcodes(content string) string {
+	results := emojiparser.ParseTextRepresentation(content, emojiparser.ParseDiscordCustom(content))
+	if len(results) == 0 {
+		return content
+	}
+
+	var b strings.Builder
+	b.Grow(len(content))
+	last := 0
+	for _, result := range results {
+		b.WriteString(content[last:result.Position.From])
+		b.WriteString(result.Unicode)
+		last = result.Position.To
+	}
+	b.WriteString(content[last:])
+	return b.String()
+}
+
+// utf16Offsets returns a function that converts byte offsets in content to
+// UTF-16 code unit offsets. Offsets must be passed in increasing order, as
+// parse results are.
+func utf16Offsets(content string) func(int) int {
+	byteOffset, unitOffset := 0, 0
+	return func(offset int) int {
+		for byteOffset < offset && byteOffset < len(content) {
+			r, size := utf8.DecodeRuneInString(content[byteOffset:])
+			byteOffset += size
+			unitOffset++
+			if r >= 0x10000 {
+				unitOffset++
+			}
+		}
+		return unitOffset
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+	"unicode/utf16"
+)
+
+func TestUTF16Offsets(t *testing.T) {
+	content := "a👋é:x:"
+	toUTF16 := utf16Offsets(content)
+	// a=1 byte, 👋=4 bytes/2 units, é=2 bytes/1 unit.
+	for _, tc := range []struct{ byteOffset, want int }{
+		{0, 0}, {1, 1}, {5, 3}, {7, 4}, {10, 7},
+	} {
+		if got := toUTF16(tc.byteOffset); got != tc.want {
+			t.Fatalf("toUTF16(%d) = %d, want %d", tc.byteOffset, got, tc.want)
+		}
+	}
+}
+
+func TestParseEmojisJSON(t *testing.T) {
+	content := "hi 👋 :tada: <a:wave:12345678901234567>"
+	var emojis []jsEmoji
+	if err := json.Unmarshal([]byte(parseEmojisJSON(content)), &emojis); err != nil {
+		t.Fatalf("parseEmojisJSON returned invalid JSON: %v", err)
+	}
+	if len(emojis) != 3 {
+		t.Fatalf("got %d emojis, want 3: %+v", len(emojis), emojis)
+	}
+
+	units := utf16.Encode([]rune(content))
+	want := []string{"👋", ":tada:", "<a:wave:12345678901234567>"}
+	for i, emoji := range emojis {
+		got := string(utf16.Decode(units[emoji.Start:emoji.End]))
+		if got != want[i] {
+			t.Fatalf("emoji %d spans %q in UTF-16, want %q", i, got, want[i])
+		}
+	}
+	if !emojis[2].Animated || emojis[2].ID == nil || *emojis[2].ID != "12345678901234567" {
+		t.Fatalf("custom emoji = %+v", emojis[2])
+	}
+}
+
+func TestParseEmojisJSONEmpty(t *testing.T) {
+	if got := parseEmojisJSON("no emojis here"); got != "[]" {
+		t.Fatalf("parseEmojisJSON = %s, want []", got)
+	}
+}
+
+func TestReplaceShortcodes(t *testing.T) {
+	for _, tc := range []struct{ in, want string }{
+		{"ship it :rocket:", "ship it 🚀"},
+		{":tada::tada:", "🎉🎉"},
+		{"no :notanemoji: here", "no :notanemoji: here"},
+		{"<:tada:12345678901234567>", "<:tada:12345678901234567>"},
+	} {
+		if got := replaceShortcodes(tc.in); got != tc.want {
+			t.Fatalf("replaceShortcodes(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
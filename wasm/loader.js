@@ -0,0 +1,40 @@
+// Loads emoji.wasm in a browser or in Node.js (18 or later).
+//
+//   GOOS=js GOARCH=wasm go build -o emoji.wasm ./wasm
+//   cp "$(go env GOROOT)/lib/wasm/wasm_exec.js" .
+//
+// In a page, include wasm_exec.js with a <script> tag before this file.
+// In Node.js, run `node loader.js`.
+
+async function loadEmojiParser(url = "emoji.wasm") {
+  const go = new Go();
+  let instance;
+  if (typeof window === "undefined") {
+    const fs = await import("node:fs/promises");
+    ({ instance } = await WebAssembly.instantiate(await fs.readFile(url), go.importObject));
+  } else {
+    ({ instance } = await WebAssembly.instantiateStreaming(fetch(url), go.importObject));
+  }
+  // run resolves only when the Go program exits, which it never does.
+  go.run(instance);
+
+  return {
+    parse: (content) => JSON.parse(globalThis.parseEmojis(content)),
+    replaceShortcodes: (content) => globalThis.replaceShortcodes(content),
+  };
+}
+
+async function main() {
+  if (typeof window === "undefined") {
+    await import("./wasm_exec.js");
+  }
+  const parser = await loadEmojiParser();
+  const content = "hi 👋🏽 :tada: <a:wave:12345678901234567>";
+  for (const emoji of parser.parse(content)) {
+    // start and end index the JavaScript string directly.
+    console.log(emoji.type, emoji.name, content.slice(emoji.start, emoji.end));
+  }
+  console.log(parser.replaceShortcodes("ship it :rocket:"));
+}
+
+main();
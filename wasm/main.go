@@ -0,0 +1,26 @@
+//go:build js && wasm
+
+package main
+
+import "syscall/js"
+
+func main() {
+	js.Global().Set("parseEmojis", js.FuncOf(func(this js.Value, args []js.Value) any {
+		return parseEmojisJSON(stringArg(args))
+	}))
+	js.Global().Set("replaceShortcodes", js.FuncOf(func(this js.Value, args []js.Value) any {
+		return replaceShortcodes(stringArg(args))
+	}))
+
+	// Keep the functions callable after main returns control to JavaScript.
+	select {}
+}
+
+// stringArg returns the first argument as a string, or "" if it is missing
+// or not a string.
+func stringArg(args []js.Value) string {
+	if len(args) == 0 || args[0].Type() != js.TypeString {
+		return ""
+	}
+	return args[0].String()
+}
@@ -0,0 +1,13 @@
+//go:build !(js && wasm)
+
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	fmt.Fprintln(os.Stderr, "build with GOOS=js GOARCH=wasm to get the WebAssembly module")
+	os.Exit(1)
+}
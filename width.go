@@ -0,0 +1,161 @@
+package emojiparser
+
+import (
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+// WrapOptions configures how DisplayWidth and Wrap measure emojis. The zero
+// value measures unicode and custom emojis as two columns, the width
+// terminals and monospace fonts give emoji, and shortcodes as their text.
+type WrapOptions struct {
+	// EmojiWidth is the width of a unicode emoji. Zero means 2.
+	EmojiWidth int
+
+	// CustomWidth is the width of custom emoji markup such as
+	// <:pepe:12345678901234567>. Zero means EmojiWidth; a negative value
+	// measures the markup as the text it is, as inside a code block.
+	CustomWidth int
+
+	// RenderShortcodes measures known :name: shortcodes as emojis of
+	// EmojiWidth instead of as their text.
+	RenderShortcodes bool
+}
+
+func (o WrapOptions) emojiWidth() int {
+	if o.EmojiWidth > 0 {
+		return o.EmojiWidth
+	}
+	return 2
+}
+
+// widthOf returns the width of an emoji result.
+func (o WrapOptions) widthOf(emoji ParsedEmoji) int {
+	switch emoji.Type {
+	case EmojiTypeCustom:
+		if o.CustomWidth < 0 {
+			return textWidth(emoji.Unicode)
+		}
+		if o.CustomWidth > 0 {
+			return o.CustomWidth
+		}
+	case EmojiTypeText:
+		if !o.RenderShortcodes {
+			return emoji.Position.To - emoji.Position.From
+		}
+	}
+	return o.emojiWidth()
+}
+
+// DisplayWidth measures content using the default parser.
+func DisplayWidth(content string, opts WrapOptions) int {
+	return defaultParser.DisplayWidth(content, opts)
+}
+
+// DisplayWidth returns the number of columns content takes up in monospace
+// output. Emojis are measured as opts describes, East Asian wide characters
+// as two columns, combining marks and other invisible characters as none,
+// and everything else as one. Newlines take no columns, so for multi-line
+// content the result is the sum of the widths of the lines.
+func (p *DiscordEmojiParser) DisplayWidth(content string, opts WrapOptions) int {
+	width := 0
+	for _, a := range p.atoms(content, opts) {
+		width += a.width
+	}
+	return width
+}
+
+// atomKind classifies the atoms Wrap works with.
+type atomKind uint8
+
+const (
+	atomText atomKind = iota
+	atomSpace
+	atomNewline
+)
+
+// atom is a piece of content that is never split: an emoji, a character
+// with the combining marks that follow it, a space, or a newline.
+type atom struct {
+	kind     atomKind
+	from, to int
+	width    int
+}
+
+// atoms splits content into atoms.
+func (p *DiscordEmojiParser) atoms(content string, opts WrapOptions) []atom {
+	results := p.Parse(content)
+	atoms := make([]atom, 0, len(content))
+	for i := 0; i < len(content); {
+		if len(results) > 0 && results[0].Position.From == i {
+			emoji := results[0]
+			results = results[1:]
+			atoms = append(atoms, atom{kind: atomText, from: i, to: emoji.Position.To, width: opts.widthOf(emoji)})
+			i = emoji.Position.To
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(content[i:])
+		switch {
+		case r == '\n':
+			atoms = append(atoms, atom{kind: atomNewline, from: i, to: i + size})
+			i += size
+			continue
+		case unicode.IsSpace(r):
+			atoms = append(atoms, atom{kind: atomSpace, from: i, to: i + size, width: 1})
+			i += size
+			continue
+		}
+
+		end := i + size
+		for end < len(content) && (len(results) == 0 || results[0].Position.From > end) {
+			next, nextSize := utf8.DecodeRuneInString(content[end:])
+			if runeWidth(next) != 0 || next == '\n' {
+				break
+			}
+			end += nextSize
+		}
+		atoms = append(atoms, atom{kind: atomText, from: i, to: end, width: runeWidth(r)})
+		i = end
+	}
+	return atoms
+}
+
+// textWidth returns the width of text that holds no emojis.
+func textWidth(s string) int {
+	width := 0
+	for _, r := range s {
+		width += runeWidth(r)
+	}
+	return width
+}
+
+// runeWidth returns the number of columns r takes up outside of an emoji.
+func runeWidth(r rune) int {
+	switch {
+	case r < 0x20 || r == 0x7f:
+		return 0
+	case r < 0x300:
+		return 1
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 0
+	case isWide(r):
+		return 2
+	}
+	return 1
+}
+
+// wideRanges are the East Asian Wide and Fullwidth blocks.
+var wideRanges = [][2]rune{
+	{0x1100, 0x115F}, {0x2E80, 0x303E}, {0x3041, 0x33FF}, {0x3400, 0x4DBF},
+	{0x4E00, 0x9FFF}, {0xA000, 0xA4CF}, {0xA960, 0xA97F}, {0xAC00, 0xD7A3},
+	{0xF900, 0xFAFF}, {0xFE10, 0xFE19}, {0xFE30, 0xFE6F}, {0xFF00, 0xFF60},
+	{0xFFE0, 0xFFE6}, {0x1F300, 0x1F64F}, {0x1F900, 0x1F9FF}, {0x20000, 0x2FFFD},
+	{0x30000, 0x3FFFD},
+}
+
+func isWide(r rune) bool {
+	i := sort.Search(len(wideRanges), func(i int) bool { return wideRanges[i][1] >= r })
+	return i < len(wideRanges) && wideRanges[i][0] <= r
+}
@@ -0,0 +1,35 @@
+package emojiparser_test
+
+import (
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestDisplayWidth(t *testing.T) {
+	custom := "<:pepe:12345678901234567>"
+	tests := []struct {
+		content string
+		opts    emojiparser.WrapOptions
+		want    int
+	}{
+		{"", emojiparser.WrapOptions{}, 0},
+		{"abc", emojiparser.WrapOptions{}, 3},
+		{"a😄b", emojiparser.WrapOptions{}, 4},
+		{"👨‍👩‍👧‍👦", emojiparser.WrapOptions{}, 2},
+		{"é", emojiparser.WrapOptions{}, 1},
+		{"日本語", emojiparser.WrapOptions{}, 6},
+		{":smile:", emojiparser.WrapOptions{}, 7},
+		{":smile:", emojiparser.WrapOptions{RenderShortcodes: true}, 2},
+		{custom, emojiparser.WrapOptions{}, 2},
+		{custom, emojiparser.WrapOptions{CustomWidth: 3}, 3},
+		{custom, emojiparser.WrapOptions{CustomWidth: -1}, len(custom)},
+		{"😄", emojiparser.WrapOptions{EmojiWidth: 1}, 1},
+		{"a\nb", emojiparser.WrapOptions{}, 2},
+	}
+	for _, tc := range tests {
+		if got := emojiparser.DisplayWidth(tc.content, tc.opts); got != tc.want {
+			t.Fatalf("DisplayWidth(%q, %+v) = %d, want %d", tc.content, tc.opts, got, tc.want)
+		}
+	}
+}
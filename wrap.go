@@ -0,0 +1,101 @@
+package emojiparser
+
+// Wrap wraps content using the default parser.
+func Wrap(content string, width int, opts WrapOptions) []string {
+	return defaultParser.Wrap(content, width, opts)
+}
+
+// Wrap breaks content into lines of at most width columns, measured as
+// DisplayWidth measures them. Lines break at whitespace, which is dropped at
+// the break; newlines in content always end a line. A word wider than width
+// is broken between characters, but never inside an emoji or custom emoji
+// markup, so a single emoji wider than width gets a line of its own that
+// exceeds width. A width below 1 only splits content at newlines, and empty
+// content returns no lines.
+func (p *DiscordEmojiParser) Wrap(content string, width int, opts WrapOptions) []string {
+	if content == "" {
+		return nil
+	}
+	atoms := p.atoms(content, opts)
+	var lines []string
+	for len(atoms) > 0 {
+		end := 0
+		for end < len(atoms) && atoms[end].kind != atomNewline {
+			end++
+		}
+		lines = wrapLine(lines, content, atoms[:end], width)
+		if end == len(atoms) {
+			break
+		}
+		atoms = atoms[end+1:]
+		if len(atoms) == 0 {
+			// content ends with a newline.
+			lines = append(lines, "")
+		}
+	}
+	return lines
+}
+
+// wrapLine appends the wrapped lines of a line of content without newlines,
+// given as its atoms.
+func wrapLine(lines []string, content string, atoms []atom, width int) []string {
+	if len(atoms) == 0 {
+		return append(lines, "")
+	}
+	if width < 1 {
+		return append(lines, content[atoms[0].from:atoms[len(atoms)-1].to])
+	}
+
+	from, to := atoms[0].from, atoms[0].from // the line being built
+	used := 0                                // its width
+	hasWord := false
+	for i := 0; i < len(atoms); {
+		// The spaces before the next word.
+		spaceStart, spaceWidth := i, 0
+		for i < len(atoms) && atoms[i].kind == atomSpace {
+			spaceWidth += atoms[i].width
+			i++
+		}
+		if i == len(atoms) {
+			break // trailing spaces are dropped
+		}
+		wordStart, wordWidth := i, 0
+		for i < len(atoms) && atoms[i].kind == atomText {
+			wordWidth += atoms[i].width
+			i++
+		}
+		word := atoms[wordStart:i]
+
+		switch {
+		case !hasWord:
+			// Indentation at the start of the line is kept.
+			if spaceStart < wordStart && used+spaceWidth+wordWidth <= width {
+				used += spaceWidth
+			} else {
+				from = word[0].from
+			}
+		case used+spaceWidth+wordWidth <= width:
+			used += spaceWidth
+		default:
+			lines = append(lines, content[from:to])
+			from, used = word[0].from, 0
+		}
+		to = word[0].from
+		hasWord = true
+
+		if used+wordWidth <= width {
+			to, used = word[len(word)-1].to, used+wordWidth
+			continue
+		}
+		// The word does not fit on a line of its own: break it between
+		// atoms, keeping zero-width atoms with the atom before them.
+		for _, a := range word {
+			if used+a.width > width && used > 0 && a.width > 0 {
+				lines = append(lines, content[from:to])
+				from, used = a.from, 0
+			}
+			to, used = a.to, used+a.width
+		}
+	}
+	return append(lines, content[from:to])
+}
@@ -0,0 +1,70 @@
+package emojiparser_test
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestWrap(t *testing.T) {
+	custom := "<:pepe:12345678901234567>"
+	tests := []struct {
+		name    string
+		content string
+		width   int
+		opts    emojiparser.WrapOptions
+		want    []string
+	}{
+		{"empty", "", 10, emojiparser.WrapOptions{}, nil},
+		{"fits", "hello world", 20, emojiparser.WrapOptions{}, []string{"hello world"}},
+		{"words", "the quick brown fox", 10, emojiparser.WrapOptions{}, []string{"the quick", "brown fox"}},
+		{"consecutive emojis", "🔥🔥🔥🔥🔥", 4, emojiparser.WrapOptions{}, []string{"🔥🔥", "🔥🔥", "🔥"}},
+		{"zwj sequences", "👨‍👩‍👧‍👦👨‍👩‍👧‍👦", 3, emojiparser.WrapOptions{}, []string{"👨‍👩‍👧‍👦", "👨‍👩‍👧‍👦"}},
+		{"custom at the wrap point", "gg " + custom + " wp", 5, emojiparser.WrapOptions{}, []string{"gg " + custom, "wp"}},
+		{"custom just past the wrap point", "ggg " + custom + " wp", 5, emojiparser.WrapOptions{}, []string{"ggg", custom + " wp"}},
+		{"custom as text", "gg " + custom + " wp", 10, emojiparser.WrapOptions{CustomWidth: -1}, []string{"gg", custom, "wp"}},
+		{"hard break", "abcdefgh", 3, emojiparser.WrapOptions{}, []string{"abc", "def", "gh"}},
+		{"hard break around emoji", "ab😄cd", 3, emojiparser.WrapOptions{}, []string{"ab", "😄c", "d"}},
+		{"wide characters", "日本語テキスト", 6, emojiparser.WrapOptions{}, []string{"日本語", "テキス", "ト"}},
+		{"combining marks", "ééé", 2, emojiparser.WrapOptions{}, []string{"éé", "é"}},
+		{"newlines", "a b\n\nc\n", 10, emojiparser.WrapOptions{}, []string{"a b", "", "c", ""}},
+		{"indentation", "  a b c", 5, emojiparser.WrapOptions{}, []string{"  a b", "c"}},
+		{"no width", "a b\nc", 0, emojiparser.WrapOptions{}, []string{"a b", "c"}},
+		{"spaces only", "   ", 2, emojiparser.WrapOptions{}, []string{""}},
+	}
+	for _, tc := range tests {
+		if got := emojiparser.Wrap(tc.content, tc.width, tc.opts); !reflect.DeepEqual(got, tc.want) {
+			t.Fatalf("%s: Wrap(%q, %d) = %q, want %q", tc.name, tc.content, tc.width, got, tc.want)
+		}
+	}
+}
+
+func TestWrapProperties(t *testing.T) {
+	rng := rand.New(rand.NewSource(6))
+	dropSpace := func(s string) string {
+		return strings.Map(func(r rune) rune {
+			if unicode.IsSpace(r) {
+				return -1
+			}
+			return r
+		}, s)
+	}
+	for range 50 {
+		content := randomMessage(rng, 300)
+		// Shortcodes are never broken, and :smile: is 7 wide.
+		width := 7 + rng.Intn(30)
+		lines := emojiparser.Wrap(content, width, emojiparser.WrapOptions{})
+		for _, line := range lines {
+			if w := emojiparser.DisplayWidth(line, emojiparser.WrapOptions{}); w > width {
+				t.Fatalf("line %q is %d wide, more than %d", line, w, width)
+			}
+		}
+		if got, want := dropSpace(strings.Join(lines, "")), dropSpace(content); got != want {
+			t.Fatalf("Wrap(%q, %d) lost content: %q", content, width, lines)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package emojiparser
+
+import (
+	"errors"
+	"io"
+	"unicode/utf8"
+)
+
+// ErrWriterClosed is returned by writes to a closed stripping writer.
+var ErrWriterClosed = errors.New("write to closed stripping writer")
+
+const (
+	// stripWriterMaxBuffer is how many bytes without whitespace a stripping
+	// writer holds back before it flushes them anyway.
+	stripWriterMaxBuffer = 16 << 10
+	// stripWriterTail is how many bytes a forced flush keeps back, which is
+	// more than any emoji it may still have to match.
+	stripWriterTail = 512
+)
+
+// strippingWriter removes emojis from what is written through it.
+type strippingWriter struct {
+	w      io.Writer
+	p      *DiscordEmojiParser
+	buf    []byte
+	closed bool
+}
+
+// NewStrippingWriter returns a writer that forwards everything written to it
+// to w with the emojis p finds removed, and all other bytes unchanged. A nil
+// p uses the default parser.
+//
+// Text is held back until a write ends in ASCII whitespace, so an emoji split
+// across writes is still removed, and with markup skipping on, while a code
+// span, code block, spoiler, or link may still be closed, so the output is
+// that of Strip on everything written. At most about 16 KiB is held back;
+// beyond that it is flushed up to a point that does not cut a match found so
+// far, and markup open there is not carried over. Close flushes the rest but
+// does not close w.
+func NewStrippingWriter(w io.Writer, p *DiscordEmojiParser) io.WriteCloser {
+	if p == nil {
+		p = defaultParser
+	}
+	return &strippingWriter{w: w, p: p}
+}
+
+// Write buffers b and forwards the text that can no longer be part of an
+// emoji. It returns len(b) unless w fails.
+func (s *strippingWriter) Write(b []byte) (int, error) {
+	if s.closed {
+		return 0, ErrWriterClosed
+	}
+	s.buf = append(s.buf, b...)
+	if cut := s.p.streamCut(s.buf); cut > 0 {
+		return len(b), s.flush(cut, s.p.Parse(bytesView(s.buf[:cut])))
+	}
+	if len(s.buf) > stripWriterMaxBuffer {
+		results := s.p.Parse(bytesView(s.buf))
+		return len(b), s.flush(forcedCut(s.buf, results), results)
+	}
+	return len(b), nil
+}
+
+// Close flushes the buffered text. Closing again does nothing.
+func (s *strippingWriter) Close() error {
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	err := s.flush(len(s.buf), s.p.Parse(bytesView(s.buf)))
+	s.buf = nil
+	return err
+}
+
+// flush writes s.buf[:cut] without the results that end by cut, and keeps the
+// rest of s.buf.
+func (s *strippingWriter) flush(cut int, results []ParsedEmoji) error {
+	last := 0
+	for _, emoji := range results {
+		if emoji.Position.To > cut {
+			break
+		}
+		if _, err := s.w.Write(s.buf[last:emoji.Position.From]); err != nil {
+			return err
+		}
+		last = emoji.Position.To
+	}
+	if _, err := s.w.Write(s.buf[last:cut]); err != nil {
+		return err
+	}
+	s.buf = s.buf[:copy(s.buf, s.buf[cut:])]
+	return nil
+}
+
+// forcedCut returns where to flush buf when it has grown too long: on a rune
+// boundary stripWriterTail bytes from the end, moved off any result it would
+// cut.
+func forcedCut(buf []byte, results []ParsedEmoji) int {
+	cut := len(buf) - stripWriterTail
+	for cut > 0 && !utf8.RuneStart(buf[cut]) {
+		cut--
+	}
+	for _, emoji := range results {
+		if emoji.Position.From < cut && cut < emoji.Position.To {
+			if emoji.Position.From > 0 {
+				return emoji.Position.From
+			}
+			return emoji.Position.To
+		}
+	}
+	return cut
+}
@@ -0,0 +1,89 @@
+package emojiparser_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func TestStrippingWriter(t *testing.T) {
+	content := "hi 😄 there <:pepe:12345678901234567> :tada:!\nco🔥ol 👨\u200d👩\u200d👧 end"
+	for _, size := range []int{1, 2, 3, 5, 7, len(content)} {
+		var out strings.Builder
+		w := emojiparser.NewStrippingWriter(&out, nil)
+		for i := 0; i < len(content); i += size {
+			piece := content[i:min(i+size, len(content))]
+			if n, err := w.Write([]byte(piece)); n != len(piece) || err != nil {
+				t.Fatalf("Write(%q) = %d, %v", piece, n, err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("Close: %v", err)
+		}
+		if want := emojiparser.Strip(content); out.String() != want {
+			t.Fatalf("writes of %d bytes: got %q, want %q", size, out.String(), want)
+		}
+	}
+}
+
+func TestStrippingWriterBoundedBuffer(t *testing.T) {
+	var out strings.Builder
+	w := emojiparser.NewStrippingWriter(&out, nil)
+	colons := strings.Repeat(":", 1024)
+	for range 64 {
+		if _, err := w.Write([]byte(colons)); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+	}
+	if held := 64*1024 - out.Len(); held > 17*1024 {
+		t.Fatalf("%d bytes without whitespace held back", held)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if out.String() != strings.Repeat(colons, 64) {
+		t.Fatalf("colons were not forwarded unchanged")
+	}
+	if _, err := w.Write([]byte("x")); !errors.Is(err, emojiparser.ErrWriterClosed) {
+		t.Fatalf("Write after Close = %v, want ErrWriterClosed", err)
+	}
+}
+
+func TestStrippingWriterForcedCut(t *testing.T) {
+	var out strings.Builder
+	w := emojiparser.NewStrippingWriter(&out, nil)
+	content := strings.Repeat("😄a", 8000)
+	for i := 0; i < len(content); i += 1000 {
+		if _, err := w.Write([]byte(content[i:min(i+1000, len(content))])); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if want := strings.Repeat("a", 8000); out.String() != want {
+		t.Fatalf("got %d bytes, want %d bytes of a", out.Len(), len(want))
+	}
+}
+
+func TestStrippingWriterMarkdown(t *testing.T) {
+	parser := newTestParser(t, emojiparser.WithMarkdown(true))
+	want := parser.Strip(markupStream)
+	for _, size := range []int{1, 7, 64, 4096, len(markupStream)} {
+		var out strings.Builder
+		w := emojiparser.NewStrippingWriter(&out, parser)
+		for i := 0; i < len(markupStream); i += size {
+			if _, err := w.Write([]byte(markupStream[i:min(i+size, len(markupStream))])); err != nil {
+				t.Fatalf("Write: %v", err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("Close: %v", err)
+		}
+		if out.String() != want {
+			t.Fatalf("writes of %d bytes differ from Strip", size)
+		}
+	}
+}
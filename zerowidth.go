@@ -0,0 +1,210 @@
+package emojiparser
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+const zeroWidthJoiner = '\u200D'
+
+// isIgnoredZeroWidth reports whether IgnoreZeroWidth skips r: U+200B (zero
+// width space) and U+FEFF (zero width no-break space). U+200D is never
+// skipped, since it is a legitimate part of ZWJ sequences.
+func isIgnoredZeroWidth(r rune) bool {
+	return r == '\u200B' || r == '\uFEFF'
+}
+
+// isInvisible reports whether r is an invisible formatting character other
+// than U+200D that DetectObfuscation looks for.
+func isInvisible(r rune) bool {
+	switch r {
+	case '\u00AD', '\u180E', '\u200B', '\u200C', '\u2060', '\u2061', '\u2062', '\u2063', '\u2064', '\uFEFF':
+		return true
+	}
+	return false
+}
+
+// offsetMap records the bytes rewriting content removed, so positions in the
+// rewritten content can be mapped back to the original. Removed characters
+// are recorded where they were; the bytes saved by composing characters into
+// one are recorded at the composite's last byte, so that positions on either
+// side of it map to either side of what it replaced.
+type offsetMap struct {
+	at     []int // offset of each removal in the rewritten content
+	origAt []int // offset of each removal in the original content
+	shift  []int // shift[k] is the size in bytes of the first k removals
+}
+
+// add records the removal of size bytes.
+func (m *offsetMap) add(at, origAt, size int) {
+	m.at = append(m.at, at)
+	m.origAt = append(m.origAt, origAt)
+	m.shift = append(m.shift, m.shift[len(m.shift)-1]+size)
+}
+
+// stripInvisible returns content without the characters remove reports, and
+// a map of what was removed. The map is nil if nothing was removed.
+func stripInvisible(content string, remove func(rune) bool) (string, *offsetMap) {
+	return rewrite(content, remove, false)
+}
+
+// rewriteContent strips the characters IgnoreZeroWidth skips and composes
+// characters as NormalizeNFC asks, if the options are set. The map is nil if
+// content is unchanged.
+func (p *DiscordEmojiParser) rewriteContent(content string) (string, *offsetMap) {
+	var remove func(rune) bool
+	if p.opts.IgnoreZeroWidth {
+		remove = isIgnoredZeroWidth
+	}
+	if remove == nil && !p.opts.NormalizeNFC {
+		return content, nil
+	}
+	return rewrite(content, remove, p.opts.NormalizeNFC)
+}
+
+// position maps a position in the stripped content back to the original. The
+// result covers removed characters inside the match but not those around it.
+func (m *offsetMap) position(pos EmojiPosition) EmojiPosition {
+	from := sort.SearchInts(m.at, pos.From+1)
+	to := sort.SearchInts(m.at, pos.To)
+	return EmojiPosition{From: pos.From + m.shift[from], To: pos.To + m.shift[to]}
+}
+
+// restore maps the positions of results back to the original content in
+// place and returns results.
+func (m *offsetMap) restore(results []ParsedEmoji) []ParsedEmoji {
+	for i := range results {
+		results[i].Position = m.position(results[i].Position)
+	}
+	return results
+}
+
+// restorePositions is restore for plain positions.
+func (m *offsetMap) restorePositions(positions []EmojiPosition) []EmojiPosition {
+	for i := range positions {
+		positions[i] = m.position(positions[i])
+	}
+	return positions
+}
+
+// strippedRanges returns a copy of ranges with positions in the original
+// content mapped to the stripped content.
+func (m *offsetMap) strippedRanges(ranges []ParsedEmoji) []ParsedEmoji {
+	if len(ranges) == 0 {
+		return ranges
+	}
+	stripped := make([]ParsedEmoji, len(ranges))
+	for i, item := range ranges {
+		stripped[i].Position = EmojiPosition{From: m.stripped(item.Position.From), To: m.stripped(item.Position.To)}
+	}
+	return stripped
+}
+
+func (m *offsetMap) stripped(offset int) int {
+	return offset - m.shift[sort.SearchInts(m.origAt, offset)]
+}
+
+// DetectObfuscation reports suspicious invisible characters using the
+// default parser.
+func DetectObfuscation(content string) []EmojiPosition {
+	return defaultParser.DetectObfuscation(content)
+}
+
+// DetectObfuscation reports the invisible characters in content, such as
+// U+200B, U+2060, or U+FEFF, that sit inside or right next to something that
+// parses as an emoji once they are removed. These are the characters used to
+// slip emojis past filters, as in "🔥\u200b🔥" or ":fi\u200bre:". A U+200D is
+// only reported when it is not part of a known ZWJ sequence. Characters in
+// plain text away from any emoji are not reported. The positions are sorted
+// and each covers a single character.
+func (p *DiscordEmojiParser) DetectObfuscation(content string) []EmojiPosition {
+	var chars []EmojiPosition
+	for i, r := range content {
+		if r >= utf8.RuneSelf && (isInvisible(r) || r == zeroWidthJoiner) {
+			chars = append(chars, EmojiPosition{From: i, To: i + utf8.RuneLen(r)})
+		}
+	}
+	if len(chars) == 0 {
+		return []EmojiPosition{}
+	}
+
+	state := p.state.Load()
+	// Known ZWJ sequences still match whole with the other invisible
+	// characters removed. Stray joiners only disappear in the second pass.
+	withJoiners, known := p.invisiblePositions(state, content, isInvisible)
+	withoutJoiners, _ := p.invisiblePositions(state, content, func(r rune) bool {
+		return isInvisible(r) || r == zeroWidthJoiner
+	})
+
+	results := make([]EmojiPosition, 0)
+	for _, char := range chars {
+		matches := withJoiners
+		if r, _ := utf8.DecodeRuneInString(content[char.From:]); r == zeroWidthJoiner {
+			if containing(known, char.From) {
+				continue
+			}
+			matches = withoutJoiners
+		}
+		if containing(matches, char.From) || touching(matches, invisibleRun(content, char)) {
+			results = append(results, char)
+		}
+	}
+	return results
+}
+
+// invisiblePositions returns the emoji positions in content with the
+// characters remove reports stripped, mapped back to content, and the
+// positions of the unicode emojis among them that are keys as a whole.
+func (p *DiscordEmojiParser) invisiblePositions(state *parserState, content string, remove func(rune) bool) (all, known []EmojiPosition) {
+	stripped, m := stripInvisible(content, remove)
+	p.scan(state, stripped, scanAll, nil, func(t token) bool {
+		all = append(all, EmojiPosition{From: t.from, To: t.to})
+		if t.kind == EmojiTypeUnicode && t.key == t.unicode {
+			known = append(known, EmojiPosition{From: t.from, To: t.to})
+		}
+		return true
+	})
+	if m != nil {
+		m.restorePositions(all)
+		m.restorePositions(known)
+	}
+	return all, known
+}
+
+// invisibleRun extends char over the adjacent invisible characters.
+func invisibleRun(content string, char EmojiPosition) EmojiPosition {
+	run := char
+	for run.From > 0 {
+		r, size := utf8.DecodeLastRuneInString(content[:run.From])
+		if !isInvisible(r) && r != zeroWidthJoiner {
+			break
+		}
+		run.From -= size
+	}
+	for run.To < len(content) {
+		r, size := utf8.DecodeRuneInString(content[run.To:])
+		if !isInvisible(r) && r != zeroWidthJoiner {
+			break
+		}
+		run.To += size
+	}
+	return run
+}
+
+// containing reports whether one of the sorted positions contains offset.
+func containing(positions []EmojiPosition, offset int) bool {
+	i := sort.Search(len(positions), func(i int) bool { return positions[i].To > offset })
+	return i < len(positions) && positions[i].From <= offset
+}
+
+// touching reports whether one of the sorted positions ends where run starts
+// or starts where run ends.
+func touching(positions []EmojiPosition, run EmojiPosition) bool {
+	i := sort.Search(len(positions), func(i int) bool { return positions[i].To >= run.From })
+	for ; i < len(positions) && positions[i].From <= run.To; i++ {
+		if positions[i].To == run.From || positions[i].From == run.To {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+package emojiparser_test
+
+import (
+	"reflect"
+	"testing"
+
+	emojiparser "github.com/x1xo/emoji-parser"
+)
+
+func zeroWidthParser(t *testing.T) *emojiparser.DiscordEmojiParser {
+	t.Helper()
+	parser, err := emojiparser.NewDiscordEmojiParser(emojiparser.WithIgnoreZeroWidth(true))
+	if err != nil {
+		t.Fatalf("NewDiscordEmojiParser: %v", err)
+	}
+	return parser
+}
+
+func TestIgnoreZeroWidth(t *testing.T) {
+	parser := zeroWidthParser(t)
+	tests := []struct {
+		content string
+		names   []string
+		want    []emojiparser.EmojiPosition
+	}{
+		{"🔥\u200b🔥", []string{"flame", "flame"}, []emojiparser.EmojiPosition{{From: 0, To: 4}, {From: 7, To: 11}}},
+		{"x :fi\u200bre:\ufeff", []string{"fire"}, []emojiparser.EmojiPosition{{From: 2, To: 11}}},
+		{"<:pe\u200bpe:12345678901234567>", []string{"pepe"}, []emojiparser.EmojiPosition{{From: 0, To: 28}}},
+		{"👨‍👩‍👧‍👦", []string{"family_mwgb"}, []emojiparser.EmojiPosition{{From: 0, To: 25}}},
+	}
+	for _, tc := range tests {
+		results := parser.Parse(tc.content)
+		var names []string
+		var positions []emojiparser.EmojiPosition
+		for _, result := range results {
+			names = append(names, result.Name)
+			positions = append(positions, result.Position)
+		}
+		if !reflect.DeepEqual(positions, tc.want) || !reflect.DeepEqual(names, tc.names) {
+			t.Fatalf("Parse(%q) = %v %v, want %v %v", tc.content, names, positions, tc.names, tc.want)
+		}
+		if got := parser.Positions(tc.content); !reflect.DeepEqual(got, tc.want) {
+			t.Fatalf("Positions(%q) = %v, want %v", tc.content, got, tc.want)
+		}
+	}
+}
+
+func TestIgnoreZeroWidthOffByDefault(t *testing.T) {
+	if got := emojiparser.Parse(":fi\u200bre:"); len(got) != 0 {
+		t.Fatalf("Parse = %+v, want no results", got)
+	}
+	if got := emojiparser.Parse("🔥\u200b🔥"); len(got) != 2 || got[1].Position.From != 7 {
+		t.Fatalf("Parse = %+v", got)
+	}
+}
+
+func TestIgnoreZeroWidthSkipRanges(t *testing.T) {
+	parser := zeroWidthParser(t)
+	content := "<:pe\u200bpe:12345678901234567>\u200b🔥"
+	custom := parser.ParseDiscordCustom(content)
+	if len(custom) != 1 || custom[0].Position != (emojiparser.EmojiPosition{From: 0, To: 28}) {
+		t.Fatalf("ParseDiscordCustom = %+v", custom)
+	}
+	unicode := parser.ParseUnicode(content, custom)
+	if len(unicode) != 1 || unicode[0].Position != (emojiparser.EmojiPosition{From: 31, To: 35}) {
+		t.Fatalf("ParseUnicode = %+v", unicode)
+	}
+}
+
+func TestDetectObfuscation(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []emojiparser.EmojiPosition
+	}{
+		{"legit zwj sequence", "👨‍👩‍👧‍👦", nil},
+		{"plain text", "hello\u200bworld", nil},
+		{"between emojis", "🔥\u200b🔥", []emojiparser.EmojiPosition{{From: 4, To: 7}}},
+		{"run between emojis", "🔥\u2060\ufeff🔥", []emojiparser.EmojiPosition{{From: 4, To: 7}, {From: 7, To: 10}}},
+		{"inside shortcode", "say :fi\u200bre:", []emojiparser.EmojiPosition{{From: 7, To: 10}}},
+		{"stray joiner", "🔥‍🔥", []emojiparser.EmojiPosition{{From: 4, To: 7}}},
+		{"inside zwj sequence", "👨\u200b‍👩‍👧‍👦", []emojiparser.EmojiPosition{{From: 4, To: 7}}},
+		{"before emoji", "look \u200b🔥", []emojiparser.EmojiPosition{{From: 5, To: 8}}},
+	}
+	for _, tc := range tests {
+		got := emojiparser.DetectObfuscation(tc.content)
+		if len(got) == 0 && len(tc.want) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Fatalf("%s: DetectObfuscation(%q) = %v, want %v", tc.name, tc.content, got, tc.want)
+		}
+	}
+}